@@ -68,6 +68,14 @@ func NewExpression(timeRange timeutil.TimeRange, interval int64, selectItems []s
 	}
 }
 
+// ResultFieldName returns the field name of select item in result, alias if set, else rewritten expression.
+func ResultFieldName(selectItem stmt.Expr) string {
+	if item, ok := selectItem.(*stmt.SelectItem); ok && len(item.Alias) > 0 {
+		return item.Alias
+	}
+	return selectItem.Rewrite()
+}
+
 // Eval evaluates the select item's Expression
 func (e *expression) Eval(timeSeries series.GroupedIterator) {
 	if len(e.selectItems) == 0 {
@@ -83,11 +91,7 @@ func (e *expression) Eval(timeSeries series.GroupedIterator) {
 	for _, selectItem := range e.selectItems {
 		values := e.eval(nil, selectItem)
 		if len(values) != 0 {
-			if item, ok := selectItem.(*stmt.SelectItem); ok && len(item.Alias) > 0 {
-				e.resultSet[item.Alias] = values[0]
-			} else {
-				e.resultSet[item.Rewrite()] = values[0]
-			}
+			e.resultSet[ResultFieldName(selectItem)] = values[0]
 		}
 	}
 }
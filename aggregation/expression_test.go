@@ -366,3 +366,14 @@ func TestExpression_NotSupport_Expr(t *testing.T) {
 	resultSet = expression.ResultSet()
 	assert.Equal(t, 0, len(resultSet))
 }
+
+func TestResultFieldName(t *testing.T) {
+	q, err := sql.Parse("select f, max(f) as m, f1+f2 from cpu")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	var names []string
+	for _, item := range query.SelectItems {
+		names = append(names, ResultFieldName(item))
+	}
+	assert.Equal(t, []string{"f", "m", "f1+f2"}, names)
+}
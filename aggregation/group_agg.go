@@ -30,6 +30,8 @@ type GroupingAggregator interface {
 	Aggregate(it series.GroupedIterator)
 	// ResultSet returns the result set of aggregator
 	ResultSet() series.GroupedIterators
+	// Size returns the number of groups in aggregator
+	Size() int
 }
 
 type groupingAggregator struct {
@@ -107,6 +109,11 @@ func (ga *groupingAggregator) ResultSet() series.GroupedIterators {
 	return seriesList
 }
 
+// Size returns the number of groups in aggregator.
+func (ga *groupingAggregator) Size() int {
+	return len(ga.aggregates)
+}
+
 // getAggregator returns the time series aggregator by the tag of time series.
 func (ga *groupingAggregator) getAggregator(tags string) (agg FieldAggregates) {
 	// get series aggregator
@@ -102,6 +102,7 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 			}
 
 			agg.Aggregate(gIt)
+			assert.Equal(t, 1, agg.Size())
 			rs := agg.ResultSet()
 			assert.NotNil(t, rs)
 		})
@@ -115,6 +116,7 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 			End:   now + 3*timeutil.OneHour,
 		},
 		AggregatorSpecs{})
+	assert.Zero(t, agg.Size())
 	rs := agg.ResultSet()
 	assert.Nil(t, rs)
 }
@@ -19,6 +19,7 @@ package aggregation
 
 import (
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/series/field"
 )
 
@@ -81,3 +82,15 @@ func (a *aggregatorSpec) AddFunctionType(funcType function.FuncType) {
 func (a *aggregatorSpec) Functions() map[function.FuncType]function.FuncType {
 	return a.functions
 }
+
+// MemSize returns the estimated memory size(bytes) of field aggregators which aggregate
+// point count values based on aggregator specs, one float array for each aggregate type.
+func (specs AggregatorSpecs) MemSize(pointCount int) int64 {
+	var numOfArrays int
+	for _, spec := range specs {
+		for f := range spec.Functions() {
+			numOfArrays += len(spec.GetFieldType().GetFuncFieldParams(f))
+		}
+	}
+	return int64(numOfArrays) * int64(collections.FloatArrayMemSize(pointCount))
+}
@@ -38,3 +38,12 @@ func TestAggregatorSpec_AddFunctionType(t *testing.T) {
 	agg.AddFunctionType(function.Sum)
 	assert.Equal(t, 1, len(agg.Functions()))
 }
+
+func TestAggregatorSpecs_MemSize(t *testing.T) {
+	assert.Zero(t, AggregatorSpecs{}.MemSize(10))
+	sum := NewAggregatorSpec("f1", field.SumField)
+	sum.AddFunctionType(function.Sum)
+	max := NewAggregatorSpec("f2", field.MaxField)
+	max.AddFunctionType(function.Max)
+	assert.Equal(t, int64(2*(80+2)), AggregatorSpecs{sum, max}.MemSize(10))
+}
@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// for testing
+	backupCommandFn = command.BackupCommand
+	// BackupDatabasePath represents database backup api path.
+	BackupDatabasePath = "/database/backup"
+	// RestoreDatabasePath represents database restore api path.
+	RestoreDatabasePath = "/database/restore"
+)
+
+// DatabaseBackupAPI represents database backup/restore by manual.
+type DatabaseBackupAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewDatabaseBackupAPI creates database backup api.
+func NewDatabaseBackupAPI(deps *depspkg.HTTPDeps) *DatabaseBackupAPI {
+	return &DatabaseBackupAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "DatabaseBackupAPI"),
+	}
+}
+
+// Register adds database backup admin url route.
+func (db *DatabaseBackupAPI) Register(route gin.IRoutes) {
+	route.PUT(BackupDatabasePath, db.Backup)
+	route.PUT(RestoreDatabasePath, db.Restore)
+}
+
+// Backup creates a point-in-time copy of database on each storage node under backup dir.
+func (db *DatabaseBackupAPI) Backup(c *gin.Context) {
+	db.execute(c, stmtpkg.BackupOpBackup)
+}
+
+// Restore rebuilds database from the backup on each storage node under backup dir.
+func (db *DatabaseBackupAPI) Restore(c *gin.Context) {
+	db.execute(c, stmtpkg.BackupOpRestore)
+}
+
+// execute executes backup/restore command.
+func (db *DatabaseBackupAPI) execute(c *gin.Context, opType stmtpkg.BackupOpType) {
+	param := &models.BackupParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rs, err := backupCommandFn(c.Request.Context(), db.deps, nil, &stmtpkg.Backup{
+		Type:     opType,
+		Database: param.Database,
+		Dir:      param.Dir,
+	})
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDatabaseBackupAPI(t *testing.T) {
+	defer func() {
+		backupCommandFn = command.BackupCommand
+	}()
+	api := NewDatabaseBackupAPI(&depspkg.HTTPDeps{})
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"db"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: backup failure
+	backupCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"db","dir":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: backup/restore successfully
+	var stmts []*stmtpkg.Backup
+	backupCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		stmts = append(stmts, stmt.(*stmtpkg.Backup))
+		return map[string]interface{}{}, nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"db","dir":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"database":"db","dir":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*stmtpkg.Backup{
+		{Type: stmtpkg.BackupOpBackup, Database: "db", Dir: "/tmp/backup"},
+		{Type: stmtpkg.BackupOpRestore, Database: "db", Dir: "/tmp/backup"},
+	}, stmts)
+}
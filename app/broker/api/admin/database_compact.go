@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// for testing
+	compactCommandFn = command.CompactCommand
+	// CompactDatabasePath represents database manual compaction api path.
+	CompactDatabasePath = "/database/compact"
+)
+
+// DatabaseCompactAPI represents database compaction/rollup by manual.
+type DatabaseCompactAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewDatabaseCompactAPI creates database compaction api.
+func NewDatabaseCompactAPI(deps *depspkg.HTTPDeps) *DatabaseCompactAPI {
+	return &DatabaseCompactAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "DatabaseCompactAPI"),
+	}
+}
+
+// Register adds database compaction admin url route.
+func (dc *DatabaseCompactAPI) Register(route gin.IRoutes) {
+	route.PUT(CompactDatabasePath, dc.SubmitCompactTask)
+}
+
+// SubmitCompactTask submits the task which does compaction/rollup job over database,
+// if current node is not master, the task will be forwarded to master node.
+func (dc *DatabaseCompactAPI) SubmitCompactTask(c *gin.Context) {
+	param := &models.CompactParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	compactStmt := &stmtpkg.Compact{
+		Type:       stmtpkg.CompactOpCompact,
+		Database:   param.Database,
+		FamilyTime: param.FamilyTime,
+	}
+	if param.Type == models.CompactTypeRollup {
+		compactStmt.Type = stmtpkg.CompactOpRollup
+	}
+	for _, shardID := range param.ShardIDs {
+		compactStmt.ShardIDs = append(compactStmt.ShardIDs, int32(shardID))
+	}
+	rs, err := compactCommandFn(c.Request.Context(), dc.deps, nil, compactStmt)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
@@ -0,0 +1,71 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDatabaseCompactAPI(t *testing.T) {
+	defer func() {
+		compactCommandFn = command.CompactCommand
+	}()
+	api := NewDatabaseCompactAPI(&depspkg.HTTPDeps{})
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath, `{"database":"db"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: submit failure
+	compactCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath, `{"type":"compact","database":"db"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: submit successfully
+	var stmts []*stmtpkg.Compact
+	compactCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		stmts = append(stmts, stmt.(*stmtpkg.Compact))
+		rs := "ok"
+		return &rs, nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath, `{"type":"compact","database":"db"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath,
+		`{"type":"rollup","database":"db","shardIds":[1],"familyTime":10}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*stmtpkg.Compact{
+		{Type: stmtpkg.CompactOpCompact, Database: "db"},
+		{Type: stmtpkg.CompactOpRollup, Database: "db", ShardIDs: []int32{1}, FamilyTime: 10},
+	}, stmts)
+}
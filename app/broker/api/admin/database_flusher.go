@@ -19,18 +19,21 @@ package admin
 
 import (
 	"fmt"
-	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
 )
 
 var (
 	// for testing
-	httpGet = http.Get
+	newRestyFn = client.NewRestyClient
 	// FlushDatabasePath represents database flush api path.
 	FlushDatabasePath = "/database/flush"
 )
@@ -66,32 +69,62 @@ func (df *DatabaseFlusherAPI) SubmitFlushTask(c *gin.Context) {
 		httppkg.Error(c, err)
 		return
 	}
+	err = df.flush(c, param.Cluster, param.Database)
+	df.audit(c, param.Cluster, param.Database, err)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// flush submits the flush task if current node is master, else forwards request to master node.
+func (df *DatabaseFlusherAPI) flush(c *gin.Context, cluster, database string) error {
 	if df.deps.Master.IsMaster() {
 		// if current node is master, submits the flush task
-		if err := df.deps.Master.FlushDatabase(param.Cluster, param.Database); err != nil {
-			httppkg.Error(c, err)
-			return
-		}
-	} else {
-		// if current node is not master, need forward to master node
-		masterNode := df.deps.Master.GetMaster().Node
-		resp, err := httpGet(fmt.Sprintf("http://%s"+c.Request.RequestURI, masterNode.Indicator())) // TODO use grpc??
-		if resp != nil {
-			if resp.Body != nil {
-				if err0 := resp.Body.Close(); err0 != nil {
-					df.logger.Error("close http response body", logger.Error(err0))
-				}
-			}
+		return df.deps.Master.FlushDatabase(cluster, database)
+	}
+	// if current node is not master, need forward to master node
+	master := df.deps.Master.GetMaster()
+	if master == nil || master.Node == nil {
+		return fmt.Errorf("master not found")
+	}
+	address := master.Node.HTTPAddress()
+	req := newRestyFn().R()
+	// forwards the credential of caller(peer credential takes precedence if set), master authenticates it again
+	if subject, ok := auth.SubjectFromContext(c.Request.Context()); ok {
+		req.SetHeader("Authorization", subject.Credential)
+	}
+	resp, err := req.SetHeader("Accept", "application/json").
+		SetBody(map[string]string{"cluster": cluster, "database": database}).
+		Put(address + constants.APIVersion1CliPath + FlushDatabasePath)
+	if err == nil && resp.IsError() {
+		err = fmt.Errorf("master handle error after forward: %s", resp.String())
+	}
+	if err != nil {
+		df.logger.Error("forward flush task to master",
+			logger.String("url", address), logger.String("database", database), logger.Error(err))
+		return err
+	}
+	return nil
+}
 
-			if resp.StatusCode != http.StatusOK {
-				httppkg.Error(c, fmt.Errorf("master handle error after forward"))
-				return
-			}
-		}
-		if err != nil {
-			httppkg.Error(c, err)
-			return
-		}
+// audit records the audit entry of flush request.
+func (df *DatabaseFlusherAPI) audit(c *gin.Context, cluster, database string, err error) {
+	if df.deps.Audit == nil {
+		return
 	}
-	httppkg.OK(c, "success")
+	entry := &models.AuditEntry{
+		SourceIP:  c.ClientIP(),
+		Statement: fmt.Sprintf("flush database %s on %s", database, cluster),
+		Success:   err == nil,
+		Result:    "success",
+	}
+	if subject, ok := auth.SubjectFromContext(c.Request.Context()); ok {
+		entry.User = subject.Name
+	}
+	if err != nil {
+		entry.Result = err.Error()
+	}
+	df.deps.Audit.Record(c.Request.Context(), entry)
 }
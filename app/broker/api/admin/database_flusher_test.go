@@ -18,31 +18,31 @@
 package admin
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	resty "github.com/go-resty/resty/v2"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
+	"github.com/lindb/lindb/internal/audit"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/models"
 )
 
-type mockIOReader struct {
-}
-
-func (m *mockIOReader) Close() error {
-	return fmt.Errorf("err")
-}
-
-func (m *mockIOReader) Read(_ []byte) (n int, err error) {
-	return 0, fmt.Errorf("err")
-}
-
 func TestNewDatabaseFlusherAPI(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -74,63 +74,112 @@ func TestNewDatabaseFlusherAPI(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
 
-	defer func() {
-		httpGet = http.Get
-	}()
+	// master not found
+	master.EXPECT().IsMaster().Return(false)
+	master.EXPECT().GetMaster().Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 
-	// forward master
+	// forward master failure
 	master.EXPECT().IsMaster().Return(false)
 	master.EXPECT().GetMaster().Return(&models.Master{
 		Node: &models.StatelessNode{
 			HostIP:   "127.0.0.1",
-			HTTPPort: 12345,
+			HTTPPort: 1,
 		},
 	})
-	httpGet = func(url string) (resp *http.Response, err error) {
-		return nil, fmt.Errorf("err")
-	}
 	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
 
-	httpGet = func(url string) (resp *http.Response, err error) {
-		return nil, nil
+func TestDatabaseFlusherAPI_Forward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newRestyFn = client.NewRestyClient
+		ctrl.Finish()
+	}()
+	credential := "Basic " + base64.StdEncoding.EncodeToString([]byte("u1:pwd"))
+	// master with auth enabled over https
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != credential {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, constants.APIVersion1CliPath+FlushDatabasePath, r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"cluster":"test","database":"db"}`, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	newRestyFn = func() *resty.Client {
+		return resty.NewWithClient(server.Client())
 	}
-	master.EXPECT().IsMaster().Return(false)
-	master.EXPECT().GetMaster().Return(&models.Master{
-		Node: &models.StatelessNode{
-			HostIP:   "127.0.0.1",
-			HTTPPort: 12345,
-		},
+	u, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+	masterNode := &models.Master{Node: &models.StatelessNode{HostIP: u.Hostname(), HTTPPort: uint16(port), HTTPS: true}}
+
+	master := coordinator.NewMockMasterController(ctrl)
+	flushAPI := NewDatabaseFlusherAPI(&deps.HTTPDeps{
+		Master: master,
+	})
+	withSubject := false
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if withSubject {
+			c.Request = c.Request.WithContext(auth.WithSubject(c.Request.Context(),
+				&auth.Subject{Name: "u1", Credential: credential}))
+		}
 	})
+	flushAPI.Register(r)
+
+	// no credential forwarded, master rejects
+	master.EXPECT().IsMaster().Return(false)
+	master.EXPECT().GetMaster().Return(masterNode)
+	resp := mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// forward the credential of caller
+	withSubject = true
+	master.EXPECT().IsMaster().Return(false)
+	master.EXPECT().GetMaster().Return(masterNode)
 	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
-	httpGet = func(url string) (resp *http.Response, err error) {
-		return &http.Response{
-			StatusCode: http.StatusInternalServerError,
-		}, nil
-	}
-	master.EXPECT().IsMaster().Return(false)
-	master.EXPECT().GetMaster().Return(&models.Master{
-		Node: &models.StatelessNode{
-			HostIP:   "127.0.0.1",
-			HTTPPort: 12345,
-		},
+}
+
+func TestDatabaseFlusherAPI_Audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	master := coordinator.NewMockMasterController(ctrl)
+	recorder := audit.NewMockRecorder(ctrl)
+	flushAPI := NewDatabaseFlusherAPI(&deps.HTTPDeps{
+		Master: master,
+		Audit:  recorder,
 	})
-	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithSubject(c.Request.Context(), &auth.Subject{Name: "admin"}))
+	})
+	flushAPI.Register(r)
+
+	master.EXPECT().IsMaster().Return(true)
+	master.EXPECT().FlushDatabase("test", "db").Return(fmt.Errorf("err"))
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Equal(t, "admin", entry.User)
+		assert.Equal(t, "flush database db on test", entry.Statement)
+		assert.False(t, entry.Success)
+		assert.Equal(t, "err", entry.Result)
+	})
+	resp := mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 
-	httpGet = func(url string) (resp *http.Response, err error) {
-		return &http.Response{
-			StatusCode: http.StatusOK,
-			Body:       &mockIOReader{},
-		}, nil
-	}
-	master.EXPECT().IsMaster().Return(false)
-	master.EXPECT().GetMaster().Return(&models.Master{
-		Node: &models.StatelessNode{
-			HostIP:   "127.0.0.1",
-			HTTPPort: 12345,
-		},
+	master.EXPECT().IsMaster().Return(true)
+	master.EXPECT().FlushDatabase("test", "db").Return(nil)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.True(t, entry.Success)
 	})
 	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
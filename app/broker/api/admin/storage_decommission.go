@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// for testing
+	storageCommandFn = command.StorageCommand
+	// StorageDecommissionPath represents storage node decommission api path.
+	StorageDecommissionPath = "/storage/decommission"
+)
+
+// StorageDecommissionAPI represents storage node decommission.
+type StorageDecommissionAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewStorageDecommissionAPI creates storage node decommission api.
+func NewStorageDecommissionAPI(deps *depspkg.HTTPDeps) *StorageDecommissionAPI {
+	return &StorageDecommissionAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "StorageDecommissionAPI"),
+	}
+}
+
+// Register adds storage node decommission admin url route.
+func (sd *StorageDecommissionAPI) Register(route gin.IRoutes) {
+	route.PUT(StorageDecommissionPath, sd.Decommission)
+}
+
+// Decommission starts decommission of storage node, replicas on it will be moved to other nodes,
+// if current node is not master, the request will be forwarded to master node.
+func (sd *StorageDecommissionAPI) Decommission(c *gin.Context) {
+	param := &models.DecommissionParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rs, err := storageCommandFn(c.Request.Context(), sd.deps, nil, &stmtpkg.Storage{
+		Type:   stmtpkg.StorageOpDecommission,
+		Value:  param.Storage,
+		NodeID: int64(param.NodeID),
+	})
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// for testing
+	tokenCommandFn = command.TokenCommand
+	// TokenPath represents api token admin api path.
+	TokenPath = "/token"
+)
+
+type tokenNameParam struct {
+	Name string `form:"name" binding:"required"`
+}
+
+// TokenAPI represents api token(for non-interactive client) admin rest api.
+type TokenAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewTokenAPI creates api token admin api.
+func NewTokenAPI(deps *depspkg.HTTPDeps) *TokenAPI {
+	return &TokenAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "TokenAPI"),
+	}
+}
+
+// Register adds api token admin url route.
+func (t *TokenAPI) Register(route gin.IRoutes) {
+	route.POST(TokenPath, t.Create)
+	route.GET(TokenPath, t.List)
+	route.DELETE(TokenPath, t.Drop)
+}
+
+// Create creates api token, returns the plain token which is only shown once.
+func (t *TokenAPI) Create(c *gin.Context) {
+	param := &models.APITokenParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	t.execute(c, &stmtpkg.Token{
+		Type:        stmtpkg.TokenOpCreate,
+		Name:        param.Name,
+		Database:    param.Database,
+		Namespace:   param.Namespace,
+		Permissions: param.Permissions,
+	})
+}
+
+// List lists all api tokens without secret.
+func (t *TokenAPI) List(c *gin.Context) {
+	t.execute(c, &stmtpkg.Token{Type: stmtpkg.TokenOpShow})
+}
+
+// Drop drops(revokes) api token by name.
+func (t *TokenAPI) Drop(c *gin.Context) {
+	param := &tokenNameParam{}
+	if err := c.ShouldBindQuery(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	t.execute(c, &stmtpkg.Token{Type: stmtpkg.TokenOpDrop, Name: param.Name})
+}
+
+// execute executes api token command.
+func (t *TokenAPI) execute(c *gin.Context, stmt *stmtpkg.Token) {
+	rs, err := tokenCommandFn(c.Request.Context(), t.deps, nil, stmt)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestTokenAPI(t *testing.T) {
+	defer func() {
+		tokenCommandFn = command.TokenCommand
+	}()
+	api := NewTokenAPI(&depspkg.HTTPDeps{})
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPost, TokenPath, `{"name":"t1"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodDelete, TokenPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: command failure
+	tokenCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet, TokenPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: create/list/drop successfully
+	var stmts []*stmtpkg.Token
+	tokenCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		stmts = append(stmts, stmt.(*stmtpkg.Token))
+		return "ok", nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, TokenPath,
+		`{"name":"t1","database":"db","namespace":"ns","permissions":["write"]}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodGet, TokenPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodDelete, TokenPath+"?name=t1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*stmtpkg.Token{
+		{Type: stmtpkg.TokenOpCreate, Name: "t1", Database: "db", Namespace: "ns", Permissions: []string{"write"}},
+		{Type: stmtpkg.TokenOpShow},
+		{Type: stmtpkg.TokenOpDrop, Name: "t1"},
+	}, stmts)
+}
@@ -0,0 +1,148 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/validate"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// alertCommandFn represents alert rule command function define.
+type alertCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Alert) (interface{}, error)
+
+// alertCommands registers all alert rule related commands.
+var alertCommands = map[stmtpkg.AlertOpType]alertCommandFn{
+	stmtpkg.AlertOpCreate: createAlert,
+	stmtpkg.AlertOpShow:   listAlerts,
+	stmtpkg.AlertOpDrop:   dropAlert,
+}
+
+// AlertCommand executes lin query language for alert rule related,
+// rules are stored in broker's repo, then evaluated by the elected rule scheduler.
+func AlertCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	alertStmt := stmt.(*stmtpkg.Alert)
+	if commandFn, ok := alertCommands[alertStmt.Type]; ok {
+		return commandFn(ctx, deps, alertStmt)
+	}
+	return nil, nil
+}
+
+// createAlert creates alert rule if not exist.
+func createAlert(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Alert) (interface{}, error) {
+	rule := &models.AlertRule{
+		Name:      strings.TrimSpace(stmt.Name),
+		Database:  strings.TrimSpace(stmt.Database),
+		Namespace: stmt.Namespace,
+		SQL:       stmt.SQL,
+		Condition: models.AlertCondition{
+			Field:     stmt.Field,
+			Operator:  stmt.Operator,
+			Threshold: stmt.Threshold,
+		},
+		Interval: stmt.Interval,
+		For:      stmt.For,
+		Labels:   stmt.Labels,
+		Webhook:  stmt.Webhook,
+		Format:   models.NotifyFormat(stmt.Format),
+	}
+	if err := validate.Validator.Struct(rule); err != nil {
+		return nil, err
+	}
+	if _, ok := deps.StateMgr.GetDatabaseCfg(rule.Database); !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	data := encoding.JSONMarshal(rule)
+	log.Info("Creating alert rule", logger.String("rule", string(data)))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetAlertRulePath(rule.Name), data, func(_ []byte) error {
+		return constants.ErrRuleExist
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, constants.ErrRuleExist
+	}
+	rs := "Create alert ok"
+	return &rs, nil
+}
+
+// listAlerts lists the state of all alert rules, includes the rule which has no alert.
+func listAlerts(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.Alert) (interface{}, error) {
+	rules, err := deps.Repo.List(ctx, constants.AlertRulePath)
+	if err != nil {
+		return nil, err
+	}
+	stateList, err := deps.Repo.List(ctx, constants.AlertStatePath)
+	if err != nil {
+		return nil, err
+	}
+	stateMap := make(map[string]*models.AlertRuleState)
+	for _, val := range stateList {
+		ruleState := &models.AlertRuleState{}
+		if err := encoding.JSONUnmarshal(val.Value, ruleState); err != nil {
+			log.Warn("unmarshal alert state error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		stateMap[ruleState.Rule] = ruleState
+	}
+	var states models.AlertRuleStates
+	for _, val := range rules {
+		rule := &models.AlertRule{}
+		if err := encoding.JSONUnmarshal(val.Value, rule); err != nil {
+			log.Warn("unmarshal alert rule error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		ruleState, ok := stateMap[rule.Name]
+		if !ok {
+			ruleState = &models.AlertRuleState{Rule: rule.Name}
+		}
+		states = append(states, ruleState)
+	}
+	return states, nil
+}
+
+// dropAlert drops alert rule and its state by name.
+func dropAlert(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Alert) (interface{}, error) {
+	name := strings.TrimSpace(stmt.Name)
+	path := constants.GetAlertRulePath(name)
+	if _, err := deps.Repo.Get(ctx, path); err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, constants.ErrRuleNotFound
+		}
+		return nil, err
+	}
+	// the state of alert is deleted by rule scheduler after the rule stopped
+	if err := deps.Repo.Delete(ctx, path); err != nil {
+		return nil, err
+	}
+	rs := "Drop alert ok"
+	return &rs, nil
+}
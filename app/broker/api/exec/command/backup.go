@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// backupPaths represents the storage node api path of backup operation.
+var backupPaths = map[stmtpkg.BackupOpType]string{
+	stmtpkg.BackupOpBackup:  "/database/backup",
+	stmtpkg.BackupOpRestore: "/database/restore",
+}
+
+// BackupCommand executes database backup/restore on each alive node of the storage which database belongs to.
+// Each node backups/restores its local shards under the dir of itself.
+// NOTICE: backup only includes flushed data(memory database/write-ahead log isn't included),
+// restore requires the database has no data on storage node(e.g. re-created database).
+func BackupCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	backupStmt := stmt.(*stmtpkg.Backup)
+	path, ok := backupPaths[backupStmt.Type]
+	if !ok {
+		return nil, nil
+	}
+	databaseName := strings.TrimSpace(backupStmt.Database)
+	if databaseName == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(databaseName)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, fmt.Errorf("storage not found")
+	}
+	liveNodes := storage.LiveNodes
+	var nodes []models.Node
+	for id := range liveNodes {
+		n := liveNodes[id]
+		nodes = append(nodes, &n)
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("storage alive node not found")
+	}
+	return doBackup(nodes, &models.BackupParam{
+		Database: databaseName,
+		Dir:      backupStmt.Dir,
+	}, path)
+}
+
+// doBackup sends backup/restore request to each alive node, returns the result of each node.
+func doBackup(nodes []models.Node, param *models.BackupParam, path string) (interface{}, error) {
+	size := len(nodes)
+	result := make([]interface{}, size)
+	errs := make([]error, size)
+	var wait sync.WaitGroup
+	wait.Add(size)
+	for idx := range nodes {
+		i := idx
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			address := node.HTTPAddress()
+			var rs interface{}
+			resp, err := NewRestyFn().R().
+				SetHeader("Accept", "application/json").
+				SetBody(param).
+				SetResult(&rs).
+				Put(address + constants.APIVersion1CliPath + path)
+			if err == nil && resp.IsError() {
+				err = fmt.Errorf("%s", resp.String())
+			}
+			if err != nil {
+				log.Error("do database backup on storage node",
+					logger.String("url", address), logger.String("path", path), logger.Error(err))
+				errs[i] = err
+				return
+			}
+			result[i] = rs
+		}()
+	}
+	wait.Wait()
+	rs := make(map[string]interface{})
+	var failures []string
+	for idx := range nodes {
+		indicator := nodes[idx].Indicator()
+		if err := errs[idx]; err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", indicator, err))
+			continue
+		}
+		rs[indicator] = result[idx]
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("database backup/restore failure on storage node, %s", strings.Join(failures, "; "))
+	}
+	return rs, nil
+}
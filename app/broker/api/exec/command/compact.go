@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// CompactCommand executes database manual compaction/rollup, the job is submitted by master to all storage nodes,
+// and runs in background(queued by family if its compaction job is running),
+// the progress can be checked via compaction state of storage nodes.
+func CompactCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	compactStmt := stmt.(*stmtpkg.Compact)
+	databaseName := strings.TrimSpace(compactStmt.Database)
+	if databaseName == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(databaseName)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	param := &models.CompactParam{
+		Database:   databaseName,
+		FamilyTime: compactStmt.FamilyTime,
+	}
+	for _, shardID := range compactStmt.ShardIDs {
+		param.ShardIDs = append(param.ShardIDs, models.ShardID(shardID))
+	}
+	switch compactStmt.Type {
+	case stmtpkg.CompactOpCompact:
+		param.Type = models.CompactTypeCompact
+	case stmtpkg.CompactOpRollup:
+		param.Type = models.CompactTypeRollup
+	case stmtpkg.CompactOpShowState:
+		return getStateFromStorage(deps, &stmtpkg.State{StorageName: databaseCfg.Storage, Database: databaseName},
+			"/database/compact/state", func() interface{} {
+				var state []models.FamilyCompactionState
+				return &state
+			})
+	default:
+		return nil, nil
+	}
+	if err := submitCompactJob(deps, databaseCfg.Storage, param); err != nil {
+		return nil, err
+	}
+	rs := "Submit compaction job ok"
+	return &rs, nil
+}
+
+// submitCompactJob submits compaction job by master, forwards the job to master if current node isn't master.
+func submitCompactJob(deps *depspkg.HTTPDeps, storageName string, param *models.CompactParam) error {
+	if deps.Master.IsMaster() {
+		return deps.Master.CompactDatabase(storageName, param)
+	}
+	master := deps.Master.GetMaster()
+	if master == nil || master.Node == nil {
+		return fmt.Errorf("master not found")
+	}
+	address := master.Node.HTTPAddress()
+	resp, err := NewRestyFn().R().
+		SetHeader("Accept", "application/json").
+		SetBody(param).
+		Put(address + constants.APIVersion1CliPath + "/database/compact")
+	if err == nil && resp.IsError() {
+		err = fmt.Errorf("%s", resp.String())
+	}
+	if err != nil {
+		log.Error("forward compaction job to master",
+			logger.String("url", address), logger.Any("param", param), logger.Error(err))
+		return err
+	}
+	return nil
+}
@@ -23,8 +23,7 @@ import (
 	"strconv"
 	"strings"
 
-	resty "github.com/go-resty/resty/v2"
-
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
@@ -41,7 +40,7 @@ import (
 // for testing
 var (
 	// NewRestyFn represents new resty client.
-	NewRestyFn           = resty.New
+	NewRestyFn           = client.NewRestyClient
 	NewStateMachineCliFn = client.NewStateMachineCli
 )
 
@@ -100,7 +99,11 @@ func exploreStateRepoData(ctx context.Context, deps *depspkg.HTTPDeps,
 		masterNode := deps.Master.GetMaster()
 		address := masterNode.Node.HTTPAddress()
 		var meta []interface{}
-		_, err := NewRestyFn().R().SetQueryParams(map[string]string{
+		req := NewRestyFn().R()
+		if subject, ok := auth.SubjectFromContext(ctx); ok {
+			req.SetHeader("Authorization", subject.Credential)
+		}
+		_, err := req.SetQueryParams(map[string]string{
 			"sql": fmt.Sprintf("show storage metedata where path='%s' and storage='%s'",
 				metadataStmt.Type, metadataStmt.StorageName)}).
 			SetHeader("Accept", "application/json").
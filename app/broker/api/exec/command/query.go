@@ -26,26 +26,138 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	brokerquery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/query/tracker"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
 // QueryCommand executes metric query.
 func QueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	rs, err := executeQuery(ctx, deps, param, stmt, func(metricQuery brokerquery.MetricQuery) (*models.ResultSet, error) {
+		return metricQuery.WaitResponse()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// QueryChunkedCommand executes metric query, emits the series groups by fn as soon as each time window completed,
+// then returns the result set without series.
+func QueryChunkedCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, stmt stmtpkg.Statement,
+	fn func(series *models.Series) error) (*models.ResultSet, error) {
+	return executeQuery(ctx, deps, param, stmt, func(metricQuery brokerquery.MetricQuery) (*models.ResultSet, error) {
+		return metricQuery.WaitChunked(fn)
+	})
+}
+
+// executeQuery creates metric query with request tracking after admitted by query class, then waits the result by fn.
+// If slow query log enabled, collects execution stats of sampled query for recording slow query.
+func executeQuery(ctx context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, stmt stmtpkg.Statement,
+	fn func(metricQuery brokerquery.MetricQuery) (*models.ResultSet, error)) (*models.ResultSet, error) {
 	if strings.TrimSpace(param.Database) == "" {
 		return nil, constants.ErrDatabaseNameRequired
 	}
+	class, err := models.ParseQueryClass(param.Class)
+	if err != nil {
+		return nil, err
+	}
+	maxReadLag, err := param.ParseMaxReadLag()
+	if err != nil {
+		return nil, err
+	}
+	if maxReadLag != 0 {
+		ctx = context.WithValue(ctx, constants.ContextKeyMaxReadLag, maxReadLag)
+	}
+	// check concurrent queries quota of database's tenant
+	releaseTenant, err := deps.TenantMgr.AcquireQuery(param.Database)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseTenant()
+
+	caller, _ := ctx.Value(constants.ContextKeyCaller).(string)
+	start := time.Now()
 	req := &models.Request{
-		DB:    param.Database,
-		SQL:   param.SQL,
-		Start: time.Now().UnixNano(),
+		DB:     param.Database,
+		SQL:    param.SQL,
+		Start:  start.UnixNano(),
+		Class:  string(class),
+		Queued: true,
+		Caller: caller,
 	}
 
 	// track request
 	reqID := brokerquery.GetRequestManager().NewRequest(req)
 	defer brokerquery.GetRequestManager().CompleteRequest(reqID)
 
-	metricQuery := deps.QueryFactory.NewMetricQuery(context.WithValue(ctx, constants.ContextKeySQL, req),
-		deps.Node, param.Database, stmt.(*stmtpkg.Query))
-	return metricQuery.WaitResponse()
+	// wait admission of query class
+	release, err := deps.QueryAdmission.Admit(ctx, class)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// take the slot of query limiter after admitted, queries waiting in queue of class don't hold it,
+	// so the flood of a class cannot starve other classes.
+	var rs *models.ResultSet
+	err = deps.QueryLimiter.Do(func() (queryErr error) {
+		brokerquery.GetRequestManager().AdmitRequest(reqID, time.Since(start))
+		rs, queryErr = doQuery(ctx, deps, param, req, stmt.(*stmtpkg.Query), fn)
+		return queryErr
+	})
+	return rs, err
+}
+
+// doQuery executes the metric query which is admitted, then waits the result by fn.
+func doQuery(ctx context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, req *models.Request, queryStmt *stmtpkg.Query,
+	fn func(metricQuery brokerquery.MetricQuery) (*models.ResultSet, error)) (*models.ResultSet, error) {
+	explain := queryStmt.Explain
+	slowQueryEnabled := tracker.SlowQueryThreshold() > 0
+	if !explain && tracker.SampleSlowQueryStats() {
+		// collect full execution stats of sampled query for slow query log, copy the statement of caller
+		sampled := *queryStmt
+		sampled.Explain = true
+		queryStmt = &sampled
+	}
+	ctx = context.WithValue(ctx, constants.ContextKeySQL, req)
+	ctx = context.WithValue(ctx, constants.ContextKeyQueryClass, models.QueryClass(req.Class))
+	metricQuery := deps.QueryFactory.NewMetricQuery(ctx, deps.Node, param.Database, queryStmt)
+	rs, err := fn(metricQuery)
+	if slowQueryEnabled {
+		recordSlowQuery(req, rs, err)
+	}
+	if rs != nil && !explain {
+		rs.Stats = nil
+	}
+	return rs, err
+}
+
+// recordSlowQuery records the query into slow query log if its cost exceeds the threshold.
+func recordSlowQuery(req *models.Request, rs *models.ResultSet, err error) {
+	cost := time.Since(time.Unix(0, req.Start))
+	if !tracker.IsSlowQuery(cost) {
+		return
+	}
+	slowReq := &models.SlowRequest{
+		Role:      "broker",
+		RequestID: req.RequestID,
+		DB:        req.DB,
+		SQL:       req.SQL,
+		Caller:    req.Caller,
+		Start:     req.Start,
+		Cost:      cost.Nanoseconds(),
+	}
+	if err != nil {
+		slowReq.ErrMsg = err.Error()
+	}
+	if rs != nil {
+		slowReq.Stats = rs.Stats
+		slowReq.ScannedSeries = rs.Scanned.Series
+		slowReq.ScannedFamilies = rs.Scanned.Families
+		slowReq.ScannedBytes = rs.Scanned.Bytes
+	}
+	tracker.RecordSlowQuery(slowReq)
 }
@@ -30,7 +30,15 @@ import (
 )
 
 // RequestCommand executes requests/request related statement.
-func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	if requestStmt, ok := stmt.(*stmtpkg.Request); ok && requestStmt.Type == stmtpkg.SlowRequests {
+		return getSlowRequests(deps), nil
+	}
+	return getAliveRequests(deps), nil
+}
+
+// getAliveRequests returns current alive requests of all live broker nodes.
+func getAliveRequests(deps *depspkg.HTTPDeps) []*models.Request {
 	liveNodes := deps.StateMgr.GetLiveNodes()
 	var nodes []models.Node
 	for idx := range liveNodes {
@@ -38,7 +46,7 @@ func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.Execute
 	}
 	size := len(nodes)
 	if size == 0 {
-		return nil, nil
+		return nil
 	}
 	result := make(map[string][]*models.Request)
 	var wait sync.WaitGroup
@@ -75,5 +83,54 @@ func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.Execute
 	sort.Slice(rs, func(i, j int) bool {
 		return rs[i].Start < rs[j].Start
 	})
-	return rs, nil
+	return rs
+}
+
+// getSlowRequests returns recent slow requests of all live broker and storage nodes, the newest first.
+func getSlowRequests(deps *depspkg.HTTPDeps) models.SlowRequests {
+	var nodes []models.Node
+	liveNodes := deps.StateMgr.GetLiveNodes()
+	for idx := range liveNodes {
+		nodes = append(nodes, &liveNodes[idx])
+	}
+	for _, storage := range deps.StateMgr.GetStorageList() {
+		for id := range storage.LiveNodes {
+			n := storage.LiveNodes[id]
+			nodes = append(nodes, &n)
+		}
+	}
+	var (
+		rs    models.SlowRequests
+		mutex sync.Mutex
+		wait  sync.WaitGroup
+	)
+	wait.Add(len(nodes))
+	for idx := range nodes {
+		node := nodes[idx]
+		go func() {
+			defer wait.Done()
+			address := node.HTTPAddress()
+			var slowRequests []*models.SlowRequest
+			_, err := NewRestyFn().R().
+				SetHeader("Accept", "application/json").
+				SetResult(&slowRequests).
+				Get(address + constants.APIVersion1CliPath + "/state/slow-requests")
+			if err != nil {
+				log.Error("get recent slow requests from alive node", logger.String("url", address), logger.Error(err))
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, req := range slowRequests {
+				req.Node = node.Indicator()
+				rs = append(rs, req)
+			}
+		}()
+	}
+	wait.Wait()
+
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Start > rs[j].Start
+	})
+	return rs
 }
@@ -0,0 +1,120 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/validate"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// recordingRuleCommandFn represents recording rule command function define.
+type recordingRuleCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.RecordingRule) (interface{}, error)
+
+// recordingRuleCommands registers all recording rule related commands.
+var recordingRuleCommands = map[stmtpkg.RecordingRuleOpType]recordingRuleCommandFn{
+	stmtpkg.RecordingRuleOpCreate: createRecordingRule,
+	stmtpkg.RecordingRuleOpShow:   listRecordingRules,
+	stmtpkg.RecordingRuleOpDrop:   dropRecordingRule,
+}
+
+// RecordingRuleCommand executes lin query language for recording rule related,
+// rules are stored in broker's repo, then evaluated by the elected rule scheduler.
+func RecordingRuleCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	ruleStmt := stmt.(*stmtpkg.RecordingRule)
+	if commandFn, ok := recordingRuleCommands[ruleStmt.Type]; ok {
+		return commandFn(ctx, deps, ruleStmt)
+	}
+	return nil, nil
+}
+
+// createRecordingRule creates recording rule if not exist.
+func createRecordingRule(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.RecordingRule) (interface{}, error) {
+	rule := &models.RecordingRule{
+		Name:      strings.TrimSpace(stmt.Name),
+		Database:  strings.TrimSpace(stmt.Database),
+		Namespace: stmt.Namespace,
+		SQL:       stmt.SQL,
+		Metric:    stmt.Metric,
+		Interval:  stmt.Interval,
+	}
+	if err := validate.Validator.Struct(rule); err != nil {
+		return nil, err
+	}
+	if _, ok := deps.StateMgr.GetDatabaseCfg(rule.Database); !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	data := encoding.JSONMarshal(rule)
+	log.Info("Creating recording rule", logger.String("rule", string(data)))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetRecordingRulePath(rule.Name), data, func(_ []byte) error {
+		return constants.ErrRuleExist
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, constants.ErrRuleExist
+	}
+	rs := "Create recording rule ok"
+	return &rs, nil
+}
+
+// listRecordingRules lists all recording rules.
+func listRecordingRules(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.RecordingRule) (interface{}, error) {
+	data, err := deps.Repo.List(ctx, constants.RecordingRulePath)
+	if err != nil {
+		return nil, err
+	}
+	var rules models.RecordingRules
+	for _, val := range data {
+		rule := &models.RecordingRule{}
+		if err := encoding.JSONUnmarshal(val.Value, rule); err != nil {
+			log.Warn("unmarshal recording rule error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// dropRecordingRule drops recording rule by name.
+func dropRecordingRule(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.RecordingRule) (interface{}, error) {
+	path := constants.GetRecordingRulePath(strings.TrimSpace(stmt.Name))
+	if _, err := deps.Repo.Get(ctx, path); err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, constants.ErrRuleNotFound
+		}
+		return nil, err
+	}
+	if err := deps.Repo.Delete(ctx, path); err != nil {
+		return nil, err
+	}
+	rs := "Drop recording rule ok"
+	return &rs, nil
+}
@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
@@ -26,6 +27,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/validate"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
@@ -40,6 +42,8 @@ func SchemaCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.Execut
 		return saveDataBase(ctx, deps, schemaStmt)
 	case stmtpkg.DropDatabaseSchemaType:
 		return dropDatabase(ctx, deps, schemaStmt)
+	case stmtpkg.AlterDatabaseReplicaSchemaType:
+		return alterDatabaseReplica(ctx, deps, schemaStmt)
 	case stmtpkg.DatabaseNameSchemaType:
 		dbs, err := listDataBases(ctx, deps)
 		if err != nil {
@@ -113,6 +117,23 @@ func saveDataBase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	opt.Default()
 	database.Option = opt // reset option after set default value
 
+	// validate tenant which owns database
+	if database.Tenant != "" {
+		if _, err := getTenant(ctx, deps, database.Tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	// replica factor of existing database only can be changed by alter stmt
+	existDatabase, err := getDatabase(ctx, deps, database.Name)
+	if err != nil && !errors.Is(err, constants.ErrDatabaseNotFound) {
+		return nil, err
+	}
+	if existDatabase != nil && existDatabase.ReplicaFactor != database.ReplicaFactor {
+		return nil, fmt.Errorf("%w, use 'ALTER DATABASE %s REPLICA %d' instead",
+			constants.ErrReplicaFactorChanged, database.Name, database.ReplicaFactor)
+	}
+
 	log.Info("Saving Database", logger.String("config", stmt.Value))
 	if err := deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(database.Name), data); err != nil {
 		return nil, err
@@ -120,3 +141,56 @@ func saveDataBase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	rs := "Create database ok"
 	return &rs, nil
 }
+
+// alterDatabaseReplica changes the replica factor of database, master adds/drops replicas of shards online.
+func alterDatabaseReplica(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Schema) (interface{}, error) {
+	database, err := getDatabase(ctx, deps, stmt.Value)
+	if err != nil {
+		return nil, err
+	}
+	if database.ReplicaFactor == stmt.ReplicaFactor {
+		rs := fmt.Sprintf("Replica factor of database[%s] is already %d", database.Name, database.ReplicaFactor)
+		return &rs, nil
+	}
+	storage, ok := deps.StateMgr.GetStorage(database.Storage)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	// replicas of shard must be placed on different storage nodes
+	availableNodes := 0
+	for nodeID := range storage.LiveNodes {
+		if !storage.IsDecommissioned(nodeID) {
+			availableNodes++
+		}
+	}
+	if stmt.ReplicaFactor > availableNodes {
+		return nil, fmt.Errorf("%w: replica factor %d > available storage nodes %d",
+			constants.ErrReplicaFactorTooLarge, stmt.ReplicaFactor, availableNodes)
+	}
+	prevReplicaFactor := database.ReplicaFactor
+	database.ReplicaFactor = stmt.ReplicaFactor
+	log.Info("alter replica factor of database", logger.String("database", database.Name),
+		logger.Int("from", prevReplicaFactor), logger.Int("to", database.ReplicaFactor))
+	if err := deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(database.Name), encoding.JSONMarshal(database)); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Alter replica factor of database[%s] %d -> %d ok, progress can be checked via SHOW REBALANCE",
+		database.Name, prevReplicaFactor, database.ReplicaFactor)
+	return &rs, nil
+}
+
+// getDatabase returns the config of database by name.
+func getDatabase(ctx context.Context, deps *depspkg.HTTPDeps, databaseName string) (*models.Database, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(databaseName))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, constants.ErrDatabaseNotFound
+		}
+		return nil, err
+	}
+	database := &models.Database{}
+	if err := encoding.JSONUnmarshal(data, database); err != nil {
+		return nil, err
+	}
+	return database, nil
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -50,9 +51,11 @@ type storageCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *
 
 // storageCommands registers all storage related commands.
 var storageCommands = map[stmtpkg.StorageOpType]storageCommandFn{
-	stmtpkg.StorageOpShow:    listStorages,
-	stmtpkg.StorageOpCreate:  createStorage,
-	stmtpkg.StorageOpRecover: recoverStorage,
+	stmtpkg.StorageOpShow:          listStorages,
+	stmtpkg.StorageOpCreate:        createStorage,
+	stmtpkg.StorageOpRecover:       recoverStorage,
+	stmtpkg.StorageOpShowRebalance: showRebalance,
+	stmtpkg.StorageOpDecommission:  decommissionNode,
 }
 
 // StorageCommand executes lin query language for storage related.
@@ -95,6 +98,77 @@ func listStorages(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.Storag
 	return storages, nil
 }
 
+// showRebalance returns the replica moves of shard rebalance, returns moves of all storage clusters if storage not set.
+func showRebalance(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+	var values [][]byte
+	if stmt.Value != "" {
+		data, err := deps.Repo.Get(ctx, constants.GetReplicaMovePath(stmt.Value))
+		if err != nil && !errors.Is(err, state.ErrNotExist) {
+			return nil, err
+		}
+		values = append(values, data)
+	} else {
+		kvs, err := deps.Repo.List(ctx, constants.ReplicaMovePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range kvs {
+			values = append(values, kv.Value)
+		}
+	}
+	var rs models.ReplicaMoves
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		var moves models.ReplicaMoves
+		if err := encoding.JSONUnmarshal(value, &moves); err != nil {
+			return nil, err
+		}
+		rs = append(rs, moves...)
+	}
+	return rs, nil
+}
+
+// decommissionNode starts decommission of storage node by master, forwards the request to master if current node isn't master.
+// If storage not set, uses the storage cluster if only one exists.
+func decommissionNode(_ context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+	storageName := strings.TrimSpace(stmt.Value)
+	if storageName == "" {
+		storages := deps.StateMgr.GetStorageList()
+		if len(storages) != 1 {
+			return nil, constants.ErrStorageNameRequired
+		}
+		storageName = storages[0].Name
+	}
+	param := &models.DecommissionParam{Storage: storageName, NodeID: models.NodeID(stmt.NodeID)}
+	if deps.Master.IsMaster() {
+		if err := deps.Master.DecommissionNode(param.Storage, param.NodeID); err != nil {
+			return nil, err
+		}
+	} else {
+		master := deps.Master.GetMaster()
+		if master == nil || master.Node == nil {
+			return nil, fmt.Errorf("master not found")
+		}
+		address := master.Node.HTTPAddress()
+		resp, err := NewRestyFn().R().
+			SetHeader("Accept", "application/json").
+			SetBody(param).
+			Put(address + constants.APIVersion1CliPath + "/storage/decommission")
+		if err == nil && resp.IsError() {
+			err = fmt.Errorf("%s", resp.String())
+		}
+		if err != nil {
+			log.Error("forward storage node decommission to master",
+				logger.String("url", address), logger.Any("param", param), logger.Error(err))
+			return nil, err
+		}
+	}
+	rs := fmt.Sprintf("Decommission storage node %s ok, progress can be checked via SHOW STORAGE ALIVE", param.NodeID)
+	return &rs, nil
+}
+
 // createStorage creates config of storage cluster.
 func createStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
 	data := []byte(stmt.Value)
@@ -0,0 +1,202 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// tenantCommandFn represents tenant command function define.
+type tenantCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Tenant) (interface{}, error)
+
+// tenantCommands registers all tenant related commands.
+var tenantCommands = map[stmtpkg.TenantOpType]tenantCommandFn{
+	stmtpkg.TenantOpCreate:    createTenant,
+	stmtpkg.TenantOpAlter:     alterTenant,
+	stmtpkg.TenantOpDrop:      dropTenant,
+	stmtpkg.TenantOpShow:      listTenants,
+	stmtpkg.TenantOpShowUsage: showTenantUsage,
+}
+
+// TenantCommand executes lin query language for tenant related,
+// tenants are stored in broker's repo, then watched by tenant manager of all brokers.
+func TenantCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	tenantStmt := stmt.(*stmtpkg.Tenant)
+	if commandFn, ok := tenantCommands[tenantStmt.Type]; ok {
+		return commandFn(ctx, deps, tenantStmt)
+	}
+	return nil, nil
+}
+
+// createTenant creates tenant with quotas if not exist.
+func createTenant(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Tenant) (interface{}, error) {
+	name, err := validateTenantName(stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	tenant := &models.Tenant{Name: name, CreatedAt: timeutil.Now()}
+	applyTenantQuotas(&tenant.Quota, stmt.Quotas)
+	log.Info("Creating tenant", logger.String("tenant", name), logger.Any("quota", tenant.Quota))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetTenantPath(name), encoding.JSONMarshal(tenant), func(_ []byte) error {
+		return constants.ErrTenantExist
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, constants.ErrTenantExist
+	}
+	rs := "Create tenant ok"
+	return &rs, nil
+}
+
+// alterTenant changes the quotas of tenant, quotas not specified are kept.
+func alterTenant(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Tenant) (interface{}, error) {
+	name, err := validateTenantName(stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := getTenant(ctx, deps, name)
+	if err != nil {
+		return nil, err
+	}
+	applyTenantQuotas(&tenant.Quota, stmt.Quotas)
+	log.Info("Changing quotas of tenant", logger.String("tenant", name), logger.Any("quota", tenant.Quota))
+	if err := deps.Repo.Put(ctx, constants.GetTenantPath(name), encoding.JSONMarshal(tenant)); err != nil {
+		return nil, err
+	}
+	rs := "Alter tenant ok"
+	return &rs, nil
+}
+
+// dropTenant drops tenant by name, tenant which still owns databases cannot be dropped.
+func dropTenant(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Tenant) (interface{}, error) {
+	name, err := validateTenantName(stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getTenant(ctx, deps, name); err != nil {
+		return nil, err
+	}
+	var databases []string
+	for _, db := range deps.StateMgr.GetDatabases() {
+		if db.Tenant == name {
+			databases = append(databases, db.Name)
+		}
+	}
+	if len(databases) > 0 {
+		sort.Strings(databases)
+		return nil, fmt.Errorf("tenant '%s' still owns databases: %s", name, strings.Join(databases, ", "))
+	}
+	log.Info("Dropping tenant", logger.String("tenant", name))
+	if err := deps.Repo.Delete(ctx, constants.GetTenantPath(name)); err != nil {
+		return nil, err
+	}
+	rs := "Drop tenant ok"
+	return &rs, nil
+}
+
+// listTenants lists all tenants with quotas.
+func listTenants(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.Tenant) (interface{}, error) {
+	values, err := deps.Repo.List(ctx, constants.TenantPath)
+	if err != nil {
+		return nil, err
+	}
+	tenants := models.Tenants{}
+	for _, val := range values {
+		tenant := &models.Tenant{}
+		if err := encoding.JSONUnmarshal(val.Value, tenant); err != nil {
+			log.Warn("unmarshal tenant error", logger.String("tenant", val.Key))
+			continue
+		}
+		tenants = append(tenants, tenant)
+	}
+	sort.Slice(tenants, func(i, j int) bool {
+		return tenants[i].Name < tenants[j].Name
+	})
+	return tenants, nil
+}
+
+// showTenantUsage returns the resource usage of tenants collected by current broker, all tenants if name not set.
+func showTenantUsage(_ context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Tenant) (interface{}, error) {
+	usages := deps.TenantMgr.Usage()
+	if stmt.Name == "" {
+		return usages, nil
+	}
+	for _, usage := range usages {
+		if usage.Name == stmt.Name {
+			return models.TenantUsages{usage}, nil
+		}
+	}
+	return nil, constants.ErrTenantNotFound
+}
+
+// applyTenantQuotas sets the quotas specified by statement.
+func applyTenantQuotas(quota *models.TenantQuota, quotas map[string]int64) {
+	for name, value := range quotas {
+		switch name {
+		case stmtpkg.TenantIngestRate:
+			quota.IngestRate = value
+		case stmtpkg.TenantMaxSeries:
+			quota.MaxSeries = value
+		case stmtpkg.TenantMaxQueries:
+			quota.MaxQueries = value
+		case stmtpkg.TenantMaxDisk:
+			quota.MaxDiskBytes = value
+		}
+	}
+}
+
+// getTenant returns the tenant from repo by name.
+func getTenant(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.Tenant, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetTenantPath(name))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, constants.ErrTenantNotFound
+		}
+		return nil, err
+	}
+	tenant := &models.Tenant{}
+	if err := encoding.JSONUnmarshal(data, tenant); err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
+
+// validateTenantName validates tenant name.
+func validateTenantName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("invalid tenant name '%s'", name)
+	}
+	return name, nil
+}
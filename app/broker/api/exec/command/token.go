@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// TokenCommand executes lin query language for api token related,
+// tokens are stored(hashed) in broker's repo, then watched by auth manager of all brokers.
+func TokenCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	tokenStmt := stmt.(*stmtpkg.Token)
+	switch tokenStmt.Type {
+	case stmtpkg.TokenOpCreate:
+		return createToken(ctx, deps, tokenStmt)
+	case stmtpkg.TokenOpDrop:
+		if err := auth.DropAPIToken(ctx, deps.Repo, tokenStmt.Name); err != nil {
+			return nil, err
+		}
+		rs := "Drop token ok"
+		return &rs, nil
+	case stmtpkg.TokenOpShow:
+		return auth.ListAPITokens(ctx, deps.Repo)
+	default:
+		return nil, nil
+	}
+}
+
+// createToken creates api token, returns the plain token which is only shown once.
+func createToken(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Token) (interface{}, error) {
+	token := &models.APIToken{
+		Name:      stmt.Name,
+		Database:  strings.TrimSpace(stmt.Database),
+		Namespace: stmt.Namespace,
+	}
+	for _, permission := range stmt.Permissions {
+		token.Permissions = append(token.Permissions, models.Permission(permission))
+	}
+	if _, ok := deps.StateMgr.GetDatabaseCfg(token.Database); !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		token.CreatedBy = subject.Name
+	}
+	plain, err := auth.CreateAPIToken(ctx, deps.Repo, token)
+	if err != nil {
+		return nil, err
+	}
+	return &plain, nil
+}
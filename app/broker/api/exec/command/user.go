@@ -0,0 +1,421 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// userCommandFn represents user command function define.
+type userCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error)
+
+// userCommands registers all user related commands.
+var userCommands = map[stmtpkg.UserOpType]userCommandFn{
+	stmtpkg.UserOpCreate:       createUser,
+	stmtpkg.UserOpAlter:        alterUser,
+	stmtpkg.UserOpDrop:         dropUser,
+	stmtpkg.UserOpShow:         listUsers,
+	stmtpkg.UserOpRevokeTokens: revokeUserTokens,
+}
+
+// roleCommandFn represents role command function define.
+type roleCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Role) (interface{}, error)
+
+// roleCommands registers all role related commands.
+var roleCommands = map[stmtpkg.RoleOpType]roleCommandFn{
+	stmtpkg.RoleOpCreate: createRole,
+	stmtpkg.RoleOpDrop:   dropRole,
+	stmtpkg.RoleOpShow:   listRoles,
+}
+
+// hashPasswordFn represents hash password function, for testing.
+var hashPasswordFn = auth.HashPassword
+
+// UserCommand executes lin query language for user related,
+// users are stored in broker's repo, then watched by auth manager of all brokers.
+func UserCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	userStmt := stmt.(*stmtpkg.User)
+	if commandFn, ok := userCommands[userStmt.Type]; ok {
+		return commandFn(ctx, deps, userStmt)
+	}
+	return nil, nil
+}
+
+// RoleCommand executes lin query language for role related.
+func RoleCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	roleStmt := stmt.(*stmtpkg.Role)
+	if commandFn, ok := roleCommands[roleStmt.Type]; ok {
+		return commandFn(ctx, deps, roleStmt)
+	}
+	return nil, nil
+}
+
+// GrantCommand executes lin query language for grant/revoke privileges(role) to user(role).
+func GrantCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	grantStmt := stmt.(*stmtpkg.Grant)
+	op := "Grant"
+	if grantStmt.Revoke {
+		op = "Revoke"
+	}
+	var err error
+	switch {
+	case grantStmt.Role != "":
+		err = grantRole(ctx, deps, grantStmt)
+	case grantStmt.ToRole:
+		err = grantRolePrivileges(ctx, deps, grantStmt)
+	default:
+		err = grantUserPrivileges(ctx, deps, grantStmt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rs := op + " ok"
+	return &rs, nil
+}
+
+// createUser creates user if not exist, password is stored as hash.
+// The token generation starts from current time, so that tokens of dropped user with same name are invalid.
+func createUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	name, err := validateUserName(deps, stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	if stmt.Password == "" {
+		return nil, fmt.Errorf("password of user '%s' is empty", name)
+	}
+	hash, err := hashPasswordFn(stmt.Password)
+	if err != nil {
+		return nil, err
+	}
+	user := &models.User{Name: name, Password: hash, Generation: time.Now().UnixNano()}
+	log.Info("Creating user", logger.String("user", name))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetUserPath(name),
+		encoding.JSONMarshal(user), func(_ []byte) error {
+			return constants.ErrUserExist
+		})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, constants.ErrUserExist
+	}
+	rs := "Create user ok"
+	return &rs, nil
+}
+
+// alterUser changes the password of user, all tokens issued to user are revoked.
+func alterUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	name, err := validateUserName(deps, stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	if stmt.Password == "" {
+		return nil, fmt.Errorf("password of user '%s' is empty", name)
+	}
+	user, err := getUser(ctx, deps, name)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := hashPasswordFn(stmt.Password)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = hash
+	user.Generation++
+	log.Info("Changing password of user", logger.String("user", name))
+	if err := deps.Repo.Put(ctx, constants.GetUserPath(name), encoding.JSONMarshal(user)); err != nil {
+		return nil, err
+	}
+	rs := "Alter user ok"
+	return &rs, nil
+}
+
+// dropUser drops user by name.
+func dropUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	name, err := validateUserName(deps, stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getUser(ctx, deps, name); err != nil {
+		return nil, err
+	}
+	log.Info("Dropping user", logger.String("user", name))
+	if err := deps.Repo.Delete(ctx, constants.GetUserPath(name)); err != nil {
+		return nil, err
+	}
+	rs := "Drop user ok"
+	return &rs, nil
+}
+
+// revokeUserTokens revokes all tokens issued to user by login api, via increasing the token generation of user.
+func revokeUserTokens(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	name, err := validateUserName(deps, stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	user, err := getUser(ctx, deps, name)
+	if err != nil {
+		return nil, err
+	}
+	user.Generation++
+	log.Info("Revoking tokens of user", logger.String("user", name))
+	if err := deps.Repo.Put(ctx, constants.GetUserPath(name), encoding.JSONMarshal(user)); err != nil {
+		return nil, err
+	}
+	rs := "Revoke tokens ok"
+	return &rs, nil
+}
+
+// listUsers lists all users without password, includes the built-in admin user.
+func listUsers(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.User) (interface{}, error) {
+	var users models.Users
+	if admin := deps.BrokerCfg.BrokerBase.Auth.Admin.UserName; admin != "" {
+		users = append(users, &models.User{Name: admin, Roles: []string{models.RoleAdmin}})
+	}
+	values, err := deps.Repo.List(ctx, constants.UserPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range values {
+		user := &models.User{}
+		if err := encoding.JSONUnmarshal(val.Value, user); err != nil {
+			log.Warn("unmarshal user error", logger.String("user", val.Key))
+			continue
+		}
+		user.Password = ""
+		user.Generation = 0
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// createRole creates role if not exist.
+func createRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Role) (interface{}, error) {
+	name, err := validateRoleName(stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Creating role", logger.String("role", name))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetRolePath(name),
+		encoding.JSONMarshal(&models.Role{Name: name}), func(_ []byte) error {
+			return constants.ErrRoleExist
+		})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, constants.ErrRoleExist
+	}
+	rs := "Create role ok"
+	return &rs, nil
+}
+
+// dropRole drops role by name, users granted this role lose its privileges.
+func dropRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Role) (interface{}, error) {
+	name, err := validateRoleName(stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getRole(ctx, deps, name); err != nil {
+		return nil, err
+	}
+	log.Info("Dropping role", logger.String("role", name))
+	if err := deps.Repo.Delete(ctx, constants.GetRolePath(name)); err != nil {
+		return nil, err
+	}
+	rs := "Drop role ok"
+	return &rs, nil
+}
+
+// listRoles lists all roles, includes the built-in admin role.
+func listRoles(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.Role) (interface{}, error) {
+	roles := models.Roles{{Name: models.RoleAdmin}}
+	values, err := deps.Repo.List(ctx, constants.RolePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range values {
+		role := &models.Role{}
+		if err := encoding.JSONUnmarshal(val.Value, role); err != nil {
+			log.Warn("unmarshal role error", logger.String("role", val.Key))
+			continue
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// grantRole grants(revokes) role to(from) user.
+func grantRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Grant) error {
+	name, err := validateUserName(deps, stmt.Grantee)
+	if err != nil {
+		return err
+	}
+	user, err := getUser(ctx, deps, name)
+	if err != nil {
+		return err
+	}
+	if stmt.Role != models.RoleAdmin {
+		if _, err := getRole(ctx, deps, stmt.Role); err != nil {
+			return err
+		}
+	}
+	roles := user.Roles[:0:0]
+	for _, role := range user.Roles {
+		if role != stmt.Role {
+			roles = append(roles, role)
+		}
+	}
+	if !stmt.Revoke {
+		roles = append(roles, stmt.Role)
+	}
+	user.Roles = roles
+	log.Info("Changing roles of user", logger.String("user", name), logger.Any("roles", roles))
+	return deps.Repo.Put(ctx, constants.GetUserPath(name), encoding.JSONMarshal(user))
+}
+
+// grantUserPrivileges grants(revokes) privileges to(from) user.
+func grantUserPrivileges(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Grant) error {
+	name, err := validateUserName(deps, stmt.Grantee)
+	if err != nil {
+		return err
+	}
+	user, err := getUser(ctx, deps, name)
+	if err != nil {
+		return err
+	}
+	if err := changePrivileges(deps, &user.Privileges, stmt); err != nil {
+		return err
+	}
+	log.Info("Changing privileges of user", logger.String("user", name),
+		logger.String("privileges", user.Privileges.String()))
+	return deps.Repo.Put(ctx, constants.GetUserPath(name), encoding.JSONMarshal(user))
+}
+
+// grantRolePrivileges grants(revokes) privileges to(from) role.
+func grantRolePrivileges(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Grant) error {
+	name, err := validateRoleName(stmt.Grantee)
+	if err != nil {
+		return err
+	}
+	role, err := getRole(ctx, deps, name)
+	if err != nil {
+		return err
+	}
+	if err := changePrivileges(deps, &role.Privileges, stmt); err != nil {
+		return err
+	}
+	log.Info("Changing privileges of role", logger.String("role", name),
+		logger.String("privileges", role.Privileges.String()))
+	return deps.Repo.Put(ctx, constants.GetRolePath(name), encoding.JSONMarshal(role))
+}
+
+// changePrivileges grants(revokes) the privileges of statement, database must exist except all databases.
+func changePrivileges(deps *depspkg.HTTPDeps, privileges *models.Privileges, stmt *stmtpkg.Grant) error {
+	database := strings.TrimSpace(stmt.Database)
+	if database != models.AllDatabases && !stmt.Revoke {
+		if _, ok := deps.StateMgr.GetDatabaseCfg(database); !ok {
+			return constants.ErrDatabaseNotFound
+		}
+	}
+	for _, permission := range stmt.Permissions {
+		privilege := models.Privilege{
+			Permission: models.Permission(permission),
+			Database:   database,
+			Namespace:  stmt.Namespace,
+		}
+		if stmt.Revoke {
+			privileges.Revoke(privilege)
+		} else {
+			privileges.Grant(privilege)
+		}
+	}
+	return nil
+}
+
+// getUser returns the user from repo by name.
+func getUser(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.User, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetUserPath(name))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, constants.ErrUserNotFound
+		}
+		return nil, err
+	}
+	user := &models.User{}
+	if err := encoding.JSONUnmarshal(data, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// getRole returns the role from repo by name.
+func getRole(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.Role, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetRolePath(name))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, constants.ErrRoleNotFound
+		}
+		return nil, err
+	}
+	role := &models.Role{}
+	if err := encoding.JSONUnmarshal(data, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// validateUserName validates user name, the built-in admin user cannot be changed by statement.
+func validateUserName(deps *depspkg.HTTPDeps, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("invalid user name '%s'", name)
+	}
+	if name == deps.BrokerCfg.BrokerBase.Auth.Admin.UserName {
+		return "", fmt.Errorf("built-in user '%s' cannot be changed", name)
+	}
+	return name, nil
+}
+
+// validateRoleName validates role name, the built-in admin role cannot be changed by statement.
+func validateRoleName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("invalid role name '%s'", name)
+	}
+	if name == models.RoleAdmin {
+		return "", fmt.Errorf("built-in role '%s' cannot be changed", name)
+	}
+	return name, nil
+}
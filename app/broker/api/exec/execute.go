@@ -20,13 +20,22 @@ package exec
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
+	"sort"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/app/broker/api/exec/command"
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/export"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
 	sqlpkg "github.com/lindb/lindb/sql"
@@ -35,7 +44,10 @@ import (
 
 // for testing
 var (
-	sqlParseFn = sqlpkg.Parse
+	sqlParseFn            = sqlpkg.Parse
+	queryChunkedCommandFn = command.QueryChunkedCommand
+	// exportBatchSize represents the max num. of rows written by export writer in one batch.
+	exportBatchSize = 4096
 )
 
 // statementExecFn represents statement execution funcation define.
@@ -57,6 +69,16 @@ var (
 		stmtpkg.MetricMetadataStatement: command.MetricMetadataCommand,
 		stmtpkg.QueryStatement:          command.QueryCommand,
 		stmtpkg.RequestStatement:        command.RequestCommand,
+		stmtpkg.BackupStatement:         command.BackupCommand,
+		stmtpkg.CompactStatement:        command.CompactCommand,
+		stmtpkg.RecordingRuleStatement:  command.RecordingRuleCommand,
+		stmtpkg.AlertStatement:          command.AlertCommand,
+		stmtpkg.UserStatement:           command.UserCommand,
+		stmtpkg.RoleStatement:           command.RoleCommand,
+		stmtpkg.GrantStatement:          command.GrantCommand,
+		stmtpkg.TokenStatement:          command.TokenCommand,
+		stmtpkg.TenantStatement:         command.TenantCommand,
+		stmtpkg.AuditStatement:          command.AuditCommand,
 	}
 )
 
@@ -83,13 +105,16 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 	route.PUT(ExecutePath, e.Execute)
 }
 
-// Execute executes lin query language with rate limit.
+// Execute executes lin query language with rate limit, metric query is also admitted by its workload class.
 // 1. metric data/metadata query statement;
 // 2. cluster metadata/state query statement;
 // 3. database/storage management statement;
 //
 // @Summary execute lin query language
 // @Description Execute lin query language with rate limit, then return different response based on execution statement.
+// @Description Metric query is also admitted by workload class(dashboard/alerting/ad-hoc/internal, default ad-hoc),
+// @Description alerting/internal classes are reserved for system workload, which only can be claimed by admin.
+// @Description Metric query result can be exported as csv/arrow/parquet by format(one row per tags and timestamp).
 // @Description 1. metric data/metadata query statement;
 // @Description 2. cluster metadata/state query statement;
 // @Description 3. database/storage management statement;
@@ -99,6 +124,8 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 // @Produce json
 // @Success 200 {object} models.ResultSet
 // @Success 200 {object} models.Metadata
+// @Success 200 {object} models.ResultChunk "NDJSON chunks if chunked=true for metric query"
+// @Success 200 {file} file "csv/arrow/parquet data if format set for metric query"
 // @Failure 404 {string} string "not found"
 // @Failure 500 {string} string "can't parse lin query language"
 // @Failure 500 {string} string "internal error"
@@ -106,18 +133,15 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 // @Router /exec [put]
 // @Router /exec [post]
 func (e *ExecuteAPI) Execute(c *gin.Context) {
-	if err := e.deps.QueryLimiter.Do(func() error {
-		return e.execute(c)
-	}); err != nil {
+	if err := e.parseAndExecute(c); err != nil {
 		httppkg.Error(c, err)
 	}
 }
 
-// execute lin query language.
-func (e *ExecuteAPI) execute(c *gin.Context) error {
-	ctx, cancel := e.deps.WithTimeout()
-	defer cancel()
-
+// parseAndExecute parses lin query language, then executes it under the limit.
+// query limiter is the outer bound of all statements, metric query takes the slot of query limiter
+// only after admitted by admission controller of its workload class.
+func (e *ExecuteAPI) parseAndExecute(c *gin.Context) error {
 	param := models.ExecuteParam{}
 	err := c.ShouldBind(&param)
 	if err != nil {
@@ -127,9 +151,63 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := auth.AuthorizeStatement(c.Request.Context(), param.Database, stmt); err != nil {
+		// record the denied state-mutating statement
+		_, _ = audit.Execute(c.Request.Context(), e.deps.Audit, e.deps.Repo, newAuditEntry(c, &param), stmt,
+			func() (interface{}, error) {
+				return nil, err
+			})
+		return err
+	}
+	if param.Format != "" {
+		if stmt.StatementType() != stmtpkg.QueryStatement || param.Chunked {
+			return errors.New("export format only supports metric query without chunked")
+		}
+		if _, err := export.ParseFormat(param.Format); err != nil {
+			return err
+		}
+	}
+	if stmt.StatementType() == stmtpkg.QueryStatement {
+		class, err := models.ParseQueryClass(param.Class)
+		if err != nil {
+			return err
+		}
+		if class.Privileged() {
+			// alerting/internal classes are reserved for system workload
+			if err := auth.AuthorizeAdmin(c.Request.Context()); err != nil {
+				return err
+			}
+		}
+		param.Class = string(class)
+		// timeout of query class includes queue wait
+		ctx, cancel := context.WithTimeout(e.deps.Ctx, e.deps.QueryAdmission.Timeout(class))
+		defer cancel()
+		return e.execute(context.WithValue(ctx, constants.ContextKeyCaller, c.ClientIP()), c, &param, stmt)
+	}
+	return e.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := e.deps.WithTimeout()
+		defer cancel()
+		return e.execute(ctx, c, &param, stmt)
+	})
+}
 
+// execute lin query language.
+func (e *ExecuteAPI) execute(ctx context.Context, c *gin.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) error {
+	if subject, ok := auth.SubjectFromContext(c.Request.Context()); ok {
+		// keep authenticated subject for forwarding request to other node
+		ctx = auth.WithSubject(ctx, subject)
+	}
+	if param.Chunked && stmt.StatementType() == stmtpkg.QueryStatement {
+		return e.chunked(ctx, c, param, stmt)
+	}
+	if param.Format != "" {
+		return e.export(ctx, c, param, stmt)
+	}
 	if commandFn, ok := commands[stmt.StatementType()]; ok {
-		result, err := commandFn(ctx, e.deps, &param, stmt)
+		result, err := audit.Execute(ctx, e.deps.Audit, e.deps.Repo, newAuditEntry(c, param), stmt,
+			func() (interface{}, error) {
+				return commandFn(ctx, e.deps, param, stmt)
+			})
 		if err != nil {
 			return err
 		}
@@ -142,3 +220,164 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 	return errors.New("can't parse lin query language")
 }
+
+// newAuditEntry creates the audit entry with user/source ip of request.
+func newAuditEntry(c *gin.Context, param *models.ExecuteParam) *models.AuditEntry {
+	entry := &models.AuditEntry{
+		SourceIP:  c.ClientIP(),
+		Statement: param.SQL,
+	}
+	if subject, ok := auth.SubjectFromContext(c.Request.Context()); ok {
+		entry.User = subject.Name
+	}
+	return entry
+}
+
+// chunked executes metric query, writes result in chunks(NDJSON) as soon as each time window of query completed.
+// returns error if query fails before writing chunks started, else writes error chunk.
+func (e *ExecuteAPI) chunked(ctx context.Context, c *gin.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) error {
+	started := false
+	writeChunk := func(chunk *models.ResultChunk) error {
+		if !started {
+			c.Header("Content-Type", constants.ContentTypeNDJSON)
+			c.Status(http.StatusOK)
+			started = true
+		}
+		data := encoding.JSONMarshal(chunk)
+		data = append(data, '\n')
+		if _, err := c.Writer.Write(data); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+	rs, err := queryChunkedCommandFn(ctx, e.deps, param, stmt, func(series *models.Series) error {
+		return writeChunk(&models.ResultChunk{Type: models.SeriesChunk, Series: series})
+	})
+	if err != nil {
+		if !started {
+			return err
+		}
+		e.logger.Warn("write chunked query result failure", logger.String("sql", param.SQL), logger.Error(err))
+		_ = writeChunk(&models.ResultChunk{Type: models.ErrorChunk, Error: err.Error()})
+		return nil
+	}
+	if err := writeChunk(&models.ResultChunk{Type: models.EndChunk, Meta: rs}); err != nil {
+		e.logger.Warn("write end chunk of query result failure", logger.String("sql", param.SQL), logger.Error(err))
+	}
+	return nil
+}
+
+// export executes metric query, writes result in batches with export format(one row per tags and timestamp,
+// one column per field), rows are ordered by time window, series(order by of query) then timestamp.
+// NOTICE: broker merges the result of each time window before emitting series, so the memory is bounded by
+// merged result of time window, the export table is never built for whole result.
+// returns error if query fails before writing started, else the response is truncated.
+func (e *ExecuteAPI) export(ctx context.Context, c *gin.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) error {
+	format, err := export.ParseFormat(param.Format)
+	if err != nil {
+		return err
+	}
+	queryStmt, ok := stmt.(*stmtpkg.Query)
+	if !ok {
+		return errors.New("export format only supports metric query")
+	}
+	batch := newExportBatch(queryStmt)
+	var writer export.Writer
+	start := func() (err error) {
+		if writer != nil {
+			return nil
+		}
+		c.Header("Content-Type", format.ContentType())
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", queryStmt.MetricName+"."+string(format)))
+		c.Status(http.StatusOK)
+		writer, err = export.NewWriter(c.Writer, format, batch.Columns)
+		return err
+	}
+	flush := func() error {
+		if err := writer.Write(batch); err != nil {
+			return err
+		}
+		batch.Reset()
+		c.Writer.Flush()
+		return nil
+	}
+	_, err = queryChunkedCommandFn(ctx, e.deps, param, stmt, func(series *models.Series) error {
+		if err := start(); err != nil {
+			return err
+		}
+		appendExportRows(batch, queryStmt.GroupBy, series)
+		if batch.NumRows >= exportBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		// write the schema if result is empty
+		err = start()
+	}
+	if err == nil && batch.NumRows > 0 {
+		err = flush()
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		if writer == nil {
+			return err
+		}
+		e.logger.Warn("write export query result failure", logger.String("sql", param.SQL), logger.Error(err))
+	}
+	return nil
+}
+
+// newExportBatch creates the batch of export, columns: group by tags, timestamp, fields(select items).
+func newExportBatch(queryStmt *stmtpkg.Query) *export.Table {
+	batch := &export.Table{}
+	for _, tagKey := range queryStmt.GroupBy {
+		batch.Columns = append(batch.Columns, export.NewColumn(tagKey, export.StringColumn))
+	}
+	batch.Columns = append(batch.Columns, export.NewColumn("timestamp", export.TimestampColumn))
+	fields := make(map[string]struct{})
+	for _, selectItem := range queryStmt.SelectItems {
+		name := aggregation.ResultFieldName(selectItem)
+		if _, ok := fields[name]; ok {
+			continue
+		}
+		fields[name] = struct{}{}
+		batch.Columns = append(batch.Columns, export.NewColumn(name, export.FloatColumn))
+	}
+	return batch
+}
+
+// appendExportRows appends the rows of series into batch, one row per timestamp(ordered).
+func appendExportRows(batch *export.Table, groupBy []string, series *models.Series) {
+	fieldColumns := batch.Columns[len(groupBy)+1:]
+	var timestamps []int64
+	exists := make(map[int64]struct{})
+	for _, column := range fieldColumns {
+		for timestamp := range series.Fields[column.Name] {
+			if _, ok := exists[timestamp]; !ok {
+				exists[timestamp] = struct{}{}
+				timestamps = append(timestamps, timestamp)
+			}
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+	for _, timestamp := range timestamps {
+		for idx, tagKey := range groupBy {
+			column := batch.Columns[idx]
+			column.Strings = append(column.Strings, series.Tags[tagKey])
+		}
+		column := batch.Columns[len(groupBy)]
+		column.Timestamps = append(column.Timestamps, timestamp)
+		for _, column := range fieldColumns {
+			value, ok := series.Fields[column.Name][timestamp]
+			column.Floats = append(column.Floats, value)
+			column.Valid = append(column.Valid, ok)
+		}
+		batch.NumRows++
+	}
+}
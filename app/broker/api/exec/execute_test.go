@@ -26,6 +26,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -35,11 +36,15 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/app/broker/api/exec/command"
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/app/broker/tenant"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
@@ -47,10 +52,13 @@ import (
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/export"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
 	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -70,8 +78,15 @@ func TestExecuteAPI_Execute(t *testing.T) {
 	queryFactory := brokerQuery.NewMockFactory(ctrl)
 	stateMgr := broker.NewMockStateManager(ctrl)
 	opt := &option.DatabaseOption{}
+	queryCfg := config.NewDefaultQuery()
+	queryCfg.Internal = config.QueryClass{Concurrency: 1, Timeout: ltoml.Duration(time.Second)}
+	admission := brokerQuery.NewAdmissionController(queryCfg)
+	tenantMgr := tenant.NewMockManager(ctrl)
+	tenantMgr.EXPECT().AcquireQuery("quota").Return(nil, fmt.Errorf("%w: queries", constants.ErrQuotaExceeded)).AnyTimes()
+	tenantMgr.EXPECT().AcquireQuery(gomock.Any()).Return(func() {}, nil).AnyTimes()
 	api := NewExecuteAPI(&deps.HTTPDeps{
 		Ctx:          context.Background(),
+		TenantMgr:    tenantMgr,
 		Repo:         repo,
 		RepoFactory:  repoFct,
 		Master:       master,
@@ -79,6 +94,7 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		QueryFactory: queryFactory,
 		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
 			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			Auth: config.Auth{Admin: config.User{UserName: "admin"}},
 		}},
 		QueryLimiter: concurrent.NewLimiter(
 			context.TODO(),
@@ -86,11 +102,14 @@ func TestExecuteAPI_Execute(t *testing.T) {
 			time.Second*5,
 			metrics.NewLimitStatistics("exec", linmetric.BrokerRegistry),
 		),
+		QueryAdmission: admission,
 	})
 	cfg := `{\"config\":{\"namespace\":\"test\",\"timeout\":10,\"dialTimeout\":10,`
 	cfg += `\"leaseTTL\":10,\"endpoints\":[\"http://localhost:2379\"]}}`
 	databaseCfg := `{\"name\":\"test\",\"storage\":\"cluster-test\",\"numOfShard\":12,`
 	databaseCfg += `\"replicaFactor\":3,\"option\":{\"intervals\":[{\"interval\":\"10s\"}]}}`
+	tenantDatabaseCfg := `{\"name\":\"test\",\"storage\":\"cluster-test\",\"numOfShard\":12,\"tenant\":\"t1\",`
+	tenantDatabaseCfg += `\"replicaFactor\":3,\"option\":{\"intervals\":[{\"interval\":\"10s\"}]}}`
 	r := gin.New()
 	api.Register(r)
 
@@ -191,6 +210,59 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "chunked query metric failure before writing chunks",
+			reqBody: `{"sql":"select f from mem","db":"test","chunked":true}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "chunked query metric failure after writing chunks",
+			reqBody: `{"sql":"select f from mem","db":"test","chunked":true}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).
+					DoAndReturn(func(fn func(series *models.Series) error) (*models.ResultSet, error) {
+						_ = fn(models.NewSeries(nil, ""))
+						return nil, fmt.Errorf("err")
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+				assert.Len(t, lines, 2)
+				assert.Contains(t, lines[0], `"type":"series"`)
+				assert.Contains(t, lines[1], `"type":"error"`)
+			},
+		},
+		{
+			name:    "chunked query metric successfully",
+			reqBody: `{"sql":"select f from mem","db":"test","chunked":true}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).
+					DoAndReturn(func(fn func(series *models.Series) error) (*models.ResultSet, error) {
+						_ = fn(models.NewSeries(map[string]string{"host": "a"}, "a"))
+						_ = fn(models.NewSeries(map[string]string{"host": "b"}, "b"))
+						return &models.ResultSet{MetricName: "mem"}, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, constants.ContentTypeNDJSON, resp.Header().Get("Content-Type"))
+				lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+				assert.Len(t, lines, 3)
+				assert.Contains(t, lines[2], `"type":"end"`)
+			},
+		},
 		{
 			name:    "get database list err",
 			reqBody: `{"sql":"show databases"}`,
@@ -301,6 +373,13 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusInternalServerError, resp.Code)
 			},
 		},
+		{
+			name:    "query metric, quota of tenant exceeded",
+			reqBody: `{"sql":"select f from mem","db":"quota"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+			},
+		},
 		{
 			name:    "query metric successfully",
 			reqBody: `{"sql":"select f from mem","db":"test"}`,
@@ -313,6 +392,222 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "query metric slow, record slow query",
+			reqBody: `{"sql":"select f from mem","db":"test"}`,
+			prepare: func() {
+				tracker.SetSlowQueryLog(time.Nanosecond, 10)
+				tracker.SetSlowQueryStatsSampleRate(1)
+				t.Cleanup(func() {
+					tracker.SetSlowQueryLog(0, 0)
+					tracker.SetSlowQueryStatsSampleRate(0)
+				})
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+						// collect stats for sampled slow query
+						assert.True(t, q.Explain)
+						return metricQuery
+					})
+				stats := models.NewQueryStats()
+				stats.LeafNodes["1.1.1.1:9000"] = &models.LeafNodeStats{ScannedSeries: 10, ScannedFamilies: 2, ScannedBytes: 80}
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{
+					Stats:   stats,
+					Scanned: models.ScanStats{Series: 10, Families: 2, Bytes: 80},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.NotContains(t, resp.Body.String(), "stats")
+				slowQueries := tracker.GetSlowQueries()
+				assert.Len(t, slowQueries, 1)
+				assert.Equal(t, "select f from mem", slowQueries[0].SQL)
+				assert.Equal(t, "broker", slowQueries[0].Role)
+				assert.Equal(t, int64(10), slowQueries[0].ScannedSeries)
+				assert.Equal(t, int64(2), slowQueries[0].ScannedFamilies)
+				assert.Equal(t, int64(80), slowQueries[0].ScannedBytes)
+				assert.NotNil(t, slowQueries[0].Stats)
+			},
+		},
+		{
+			name:    "query metric slow, not sampled, record scan stats",
+			reqBody: `{"sql":"select f from mem","db":"test"}`,
+			prepare: func() {
+				tracker.SetSlowQueryLog(time.Nanosecond, 10)
+				tracker.SetSlowQueryStatsSampleRate(0)
+				t.Cleanup(func() {
+					tracker.SetSlowQueryLog(0, 0)
+				})
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+						assert.False(t, q.Explain)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{
+					Scanned: models.ScanStats{Series: 5, Families: 1, Bytes: 40},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				slowQueries := tracker.GetSlowQueries()
+				assert.Equal(t, int64(5), slowQueries[0].ScannedSeries)
+				assert.Nil(t, slowQueries[0].Stats)
+			},
+		},
+		{
+			name:    "export format only supports metric query",
+			reqBody: `{"sql":"show databases","format":"csv"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "export format not supports chunked",
+			reqBody: `{"sql":"select f from mem","db":"test","format":"csv","chunked":true}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "unknown export format",
+			reqBody: `{"sql":"select f from mem","db":"test","format":"xml"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "unknown export format")
+			},
+		},
+		{
+			name:    "export query result failure",
+			reqBody: `{"sql":"select f from mem","db":"test","format":"csv"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "export query result, empty result",
+			reqBody: `{"sql":"select f from mem","db":"test","format":"csv"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).Return(&models.ResultSet{MetricName: "mem"}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "timestamp,f\n", resp.Body.String())
+			},
+		},
+		{
+			name:    "export query result as csv in batches",
+			reqBody: `{"sql":"select f,f2 from mem group by host","db":"test","format":"csv"}`,
+			prepare: func() {
+				exportBatchSize = 2
+				t.Cleanup(func() {
+					exportBatchSize = 4096
+				})
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).
+					DoAndReturn(func(fn func(series *models.Series) error) (*models.ResultSet, error) {
+						assert.NoError(t, fn(&models.Series{
+							Tags:   map[string]string{"host": "1.1.1.2"},
+							Fields: map[string]map[int64]float64{"f": {20: 2, 10: 1.5}, "f2": {20: 3}, "f3": {30: 1}},
+						}))
+						assert.NoError(t, fn(&models.Series{
+							Tags:   map[string]string{"host": "1.1.1.1"},
+							Fields: map[string]map[int64]float64{"f2": {10: 4}},
+						}))
+						return &models.ResultSet{MetricName: "mem"}, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "text/csv; charset=utf-8", resp.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="mem.csv"`, resp.Header().Get("Content-Disposition"))
+				assert.Equal(t, "host,timestamp,f,f2\n1.1.1.2,10,1.5,\n1.1.1.2,20,2,3\n1.1.1.1,10,,4\n", resp.Body.String())
+			},
+		},
+		{
+			name:    "export query result, write failure after started",
+			reqBody: `{"sql":"select f from mem","db":"test","format":"parquet"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).
+					DoAndReturn(func(fn func(series *models.Series) error) (*models.ResultSet, error) {
+						_ = fn(&models.Series{Fields: map[string]map[int64]float64{"f": {10: 1}}})
+						return nil, fmt.Errorf("err")
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "application/vnd.apache.parquet", resp.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:    "export query result as parquet",
+			reqBody: `{"sql":"select f from mem","db":"test","format":"parquet"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitChunked(gomock.Any()).
+					DoAndReturn(func(fn func(series *models.Series) error) (*models.ResultSet, error) {
+						assert.NoError(t, fn(&models.Series{Fields: map[string]map[int64]float64{"f": {10: 1}}}))
+						return &models.ResultSet{MetricName: "mem"}, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "application/vnd.apache.parquet", resp.Header().Get("Content-Type"))
+				table, err := export.ReadParquet(resp.Body.Bytes())
+				assert.NoError(t, err)
+				assert.Equal(t, 1, table.NumRows)
+				assert.Equal(t, "f", table.Columns[1].Name)
+				assert.Equal(t, []float64{1}, table.Columns[1].Floats)
+			},
+		},
+		{
+			name:    "query metric with unknown class",
+			reqBody: `{"sql":"select f from mem","db":"test","class":"unknown"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "unknown query class")
+			},
+		},
+		{
+			name:    "query metric rejected, queue of class is full",
+			reqBody: `{"sql":"select f from mem","db":"test","class":"internal"}`,
+			prepare: func() {
+				release, err := admission.Admit(context.TODO(), models.InternalQuery)
+				assert.NoError(t, err)
+				t.Cleanup(release)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "too many queries waiting in queue")
+			},
+		},
+		{
+			name:    "query metric with class successfully",
+			reqBody: `{"sql":"select f from mem","db":"test","class":"alerting"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ models.Node, _ string, _ *stmtpkg.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, models.AlertingQuery, ctx.Value(constants.ContextKeyQueryClass))
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{
 			name:    "get database list err",
 			reqBody: `{"sql":"show databases"}`,
@@ -463,6 +758,144 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "show rebalance, list replica moves failure",
+			reqBody: `{"sql":"show rebalance"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.ReplicaMovePath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show rebalance, unmarshal replica moves failure",
+			reqBody: `{"sql":"show rebalance"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.ReplicaMovePath).Return(
+					[]state.KeyValue{{Key: "", Value: []byte("xx")}}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show rebalance successfully",
+			reqBody: `{"sql":"show rebalance"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.ReplicaMovePath).Return(
+					[]state.KeyValue{{Key: "", Value: encoding.JSONMarshal(models.ReplicaMoves{{Storage: "s"}})}}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show rebalance of storage, get replica moves failure",
+			reqBody: `{"sql":"show rebalance s"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetReplicaMovePath("s")).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show rebalance of storage, replica moves not exist",
+			reqBody: `{"sql":"show rebalance s"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetReplicaMovePath("s")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, resp.Code)
+			},
+		},
+		{
+			name:    "decommission storage node, storage not set",
+			reqBody: `{"sql":"decommission storage node 1"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetStorageList().Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "decommission storage node, decommission failure",
+			reqBody: `{"sql":"decommission storage node 1"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{{Name: "s"}})
+				master.EXPECT().IsMaster().Return(true)
+				master.EXPECT().DecommissionNode("s", models.NodeID(1)).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "decommission storage node successfully",
+			reqBody: `{"sql":"decommission storage node 1 on s"}`,
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(true)
+				master.EXPECT().DecommissionNode("s", models.NodeID(1)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "decommission storage node, but master not found",
+			reqBody: `{"sql":"decommission storage node 1 on s"}`,
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(false)
+				master.EXPECT().GetMaster().Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "decommission storage node, but forward to master failure",
+			reqBody: `{"sql":"decommission storage node 1 on s"}`,
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(false)
+				backend = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+					rw.WriteHeader(http.StatusInternalServerError)
+				}))
+				u, err := url.Parse(backend.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{
+					HostIP:   u.Hostname(),
+					HTTPPort: uint16(p),
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "decommission storage node, forward to master successfully",
+			reqBody: `{"sql":"decommission storage node 1 on s"}`,
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(false)
+				backend = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+					_, _ = rw.Write([]byte(`"ok"`))
+				}))
+				u, err := url.Parse(backend.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{
+					HostIP:   u.Hostname(),
+					HTTPPort: uint16(p),
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{
 			name:    "create storage json err",
 			reqBody: `{"sql":"create storage ` + cfg + `"}`,
@@ -986,49 +1419,205 @@ func TestExecuteAPI_Execute(t *testing.T) {
 			},
 		},
 		{
-			name:    "create database, persist failure",
+			name:    "create database, get database failure",
 			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
 			prepare: func() {
-				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, fmt.Errorf("err"))
 			},
 			assert: func(resp *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusInternalServerError, resp.Code)
 			},
 		},
 		{
-			name:    "create database, option validation failure",
+			name:    "create database, unmarshal exist database failure",
 			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
 			prepare: func() {
-				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
-					return &stmtpkg.Schema{
-						Type: stmtpkg.CreateDatabaseSchemaType,
-						Value: string(encoding.JSONMarshal(&models.Database{
-							Name:          "test",
-							Storage:       "cluster-test",
-							NumOfShard:    12,
-							ReplicaFactor: 3,
-							Option: &option.DatabaseOption{
-								Intervals: option.Intervals{{Interval: 10}},
-								Ahead:     "10",
-							},
-						})),
-					}, nil
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("xx"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create database, cannot change replica factor of exist database",
+			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","replicaFactor":1}`), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "ALTER DATABASE test REPLICA 3")
+			},
+		},
+		{
+			name:    "create database, persist failure",
+			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create database, option validation failure",
+			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
+			prepare: func() {
+				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
+					return &stmtpkg.Schema{
+						Type: stmtpkg.CreateDatabaseSchemaType,
+						Value: string(encoding.JSONMarshal(&models.Database{
+							Name:          "test",
+							Storage:       "cluster-test",
+							NumOfShard:    12,
+							ReplicaFactor: 3,
+							Option: &option.DatabaseOption{
+								Intervals: option.Intervals{{Interval: 10}},
+								Ahead:     "10",
+							},
+						})),
+					}, nil
 				}
 			},
 			assert: func(resp *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusInternalServerError, resp.Code)
 			},
 		},
+		{
+			name:    "update database successfully",
+			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","replicaFactor":3}`), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{
 			name:    "create database successfully",
 			reqBody: `{"sql":"create database ` + databaseCfg + `"}`,
 			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "create database, tenant not found",
+			reqBody: `{"sql":"create database ` + tenantDatabaseCfg + `"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create database with tenant successfully",
+			reqBody: `{"sql":"create database ` + tenantDatabaseCfg + `"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return([]byte(`{"name":"t1"}`), nil)
 				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 			assert: func(resp *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "alter replica factor, database not found",
+			reqBody: `{"sql":"alter database test replica 2"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter replica factor, replica factor not changed",
+			reqBody: `{"sql":"alter database test replica 2"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","storage":"s1","replicaFactor":2}`), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Body.String(), "already 2")
+			},
+		},
+		{
+			name:    "alter replica factor, storage not found",
+			reqBody: `{"sql":"alter database test replica 2"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","storage":"s1","replicaFactor":1}`), nil)
+				stateMgr.EXPECT().GetStorage("s1").Return(nil, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter replica factor, replica factor too large",
+			reqBody: `{"sql":"alter database test replica 2"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","storage":"s1","replicaFactor":1}`), nil)
+				stateMgr.EXPECT().GetStorage("s1").Return(&models.StorageState{
+					LiveNodes:     map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}},
+					Decommissions: map[models.NodeID]*models.NodeDecommission{2: {NodeID: 2}},
+				}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "available storage nodes 1")
+			},
+		},
+		{
+			name:    "alter replica factor, persist failure",
+			reqBody: `{"sql":"alter database test replica 2"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","storage":"s1","replicaFactor":1}`), nil)
+				stateMgr.EXPECT().GetStorage("s1").Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}},
+				}, true)
+				repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseConfigPath("test"), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter replica factor successfully",
+			reqBody: `{"sql":"alter database test replica 2"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return([]byte(`{"name":"test","storage":"s1","replicaFactor":1}`), nil)
+				stateMgr.EXPECT().GetStorage("s1").Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}},
+				}, true)
+				repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseConfigPath("test"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						database := &models.Database{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, database))
+						assert.Equal(t, 2, database.ReplicaFactor)
+						assert.Equal(t, "s1", database.Storage)
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Body.String(), "Alter replica factor of database[test] 1")
+			},
+		},
 		{
 			name:    "drop database, but delete cfg failure",
 			reqBody: `{"sql":"drop database test"}`,
@@ -1117,6 +1706,53 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "show slow requests, but no alive node",
+			reqBody: `{"sql":"show slow requests"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetLiveNodes().Return(nil)
+				stateMgr.EXPECT().GetStorageList().Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, resp.Code)
+			},
+		},
+		{
+			name:    "show slow requests successfully",
+			reqBody: `{"sql":"show slow requests"}`,
+			prepare: func() {
+				newNode := func(body string) models.StatelessNode {
+					svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Add("content-type", "application/json")
+						_, _ = w.Write([]byte(body))
+					}))
+					t.Cleanup(svr.Close)
+					u, err := url.Parse(svr.URL)
+					assert.NoError(t, err)
+					p, err := strconv.Atoi(u.Port())
+					assert.NoError(t, err)
+					return models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(p)}
+				}
+				stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{
+					newNode(`[{"role":"broker","start":1}]`),
+					{HostIP: "127.0.0.1", HTTPPort: 1}, // unreachable
+				})
+				stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{{
+					LiveNodes: map[models.NodeID]models.StatefulNode{
+						1: {StatelessNode: newNode(`[{"role":"storage","start":2}]`), ID: 1},
+					},
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var rs models.SlowRequests
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rs))
+				assert.Len(t, rs, 2)
+				assert.Equal(t, "storage", rs[0].Role)
+				assert.Equal(t, "broker", rs[1].Role)
+				assert.NotEmpty(t, rs[0].Node)
+			},
+		},
 		{
 			name:    "recover storage, but storage not found",
 			reqBody: `{"sql":"recover storage test"}`,
@@ -1172,32 +1808,1271 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
-	}
-
-	for _, tt := range cases {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			defer func() {
-				sqlParseFn = sql.Parse
-				command.NewRestyFn = resty.New
-				command.NewStateMachineCliFn = client.NewStateMachineCli
-			}()
-			command.NewRestyFn = func() *resty.Client {
-				c := resty.New()
-				c.SetTimeout(time.Second)
-				return c
-			}
-			if tt.prepare != nil {
-				tt.prepare()
-			}
-			resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, tt.reqBody)
-			if tt.assert != nil {
-				tt.assert(resp)
-			}
-			if backend != nil {
-				backend.Close()
-				backend = nil
-			}
-		})
-	}
+		{
+			name:    "backup database, but database not found",
+			reqBody: `{"sql":"backup database test to '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "backup database, but storage not found",
+			reqBody: `{"sql":"backup database test to '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				stateMgr.EXPECT().GetStorage(gomock.Any()).Return(nil, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "backup database, but alive node not found",
+			reqBody: `{"sql":"backup database test to '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				stateMgr.EXPECT().GetStorage(gomock.Any()).Return(&models.StorageState{}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "backup database, but storage node failure",
+			reqBody: `{"sql":"backup database test to '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				backend = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+					rw.WriteHeader(http.StatusInternalServerError)
+					_, _ = rw.Write([]byte(`"backup dir exist"`))
+				}))
+				u, err := url.Parse(backend.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				stateMgr.EXPECT().GetStorage(gomock.Any()).Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+						StatelessNode: models.StatelessNode{
+							HostIP:   u.Hostname(),
+							HTTPPort: uint16(p),
+						},
+						ID: 1,
+					}}}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "backup database, but request storage node failure",
+			reqBody: `{"sql":"backup database test to '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				stateMgr.EXPECT().GetStorage(gomock.Any()).Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+						StatelessNode: models.StatelessNode{
+							HostIP:   "127.0.01", // mock host err
+							HTTPPort: 8080,
+						},
+						ID: 1,
+					}}}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "backup database successfully",
+			reqBody: `{"sql":"backup database test to '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				mockSrv(encoding.JSONMarshal(&models.BackupManifest{Database: "test"}))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "restore database successfully",
+			reqBody: `{"sql":"restore database test from '/tmp/backup'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				mockSrv([]byte(`"success"`))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "compact database, but database not found",
+			reqBody: `{"sql":"compact database test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "compact database, but submit job failure",
+			reqBody: `{"sql":"compact database test shard 1"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				master.EXPECT().IsMaster().Return(true)
+				master.EXPECT().CompactDatabase("test", &models.CompactParam{
+					Type:     models.CompactTypeCompact,
+					Database: "test",
+					ShardIDs: []models.ShardID{1},
+				}).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "rollup database successfully",
+			reqBody: `{"sql":"rollup database test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				master.EXPECT().IsMaster().Return(true)
+				master.EXPECT().CompactDatabase("test", &models.CompactParam{
+					Type:     models.CompactTypeRollup,
+					Database: "test",
+				}).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "compact database, but master not found",
+			reqBody: `{"sql":"compact database test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				master.EXPECT().IsMaster().Return(false)
+				master.EXPECT().GetMaster().Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "compact database, but forward to master failure",
+			reqBody: `{"sql":"compact database test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				master.EXPECT().IsMaster().Return(false)
+				backend = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+					rw.WriteHeader(http.StatusInternalServerError)
+				}))
+				u, err := url.Parse(backend.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{
+					HostIP:   u.Hostname(),
+					HTTPPort: uint16(p),
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "compact database, forward to master successfully",
+			reqBody: `{"sql":"compact database test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				master.EXPECT().IsMaster().Return(false)
+				backend = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+					_, _ = rw.Write([]byte(`"ok"`))
+				}))
+				u, err := url.Parse(backend.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{
+					HostIP:   u.Hostname(),
+					HTTPPort: uint16(p),
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show compaction state successfully",
+			reqBody: `{"sql":"show compaction database test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{Storage: "test"}, true)
+				mockSrv([]byte(`[{"family":"10","compacting":true}]`))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "create recording rule, database not found",
+			reqBody: `{"sql":"create recording rule r1 on test into m every 1m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create recording rule, rule exist",
+			reqBody: `{"sql":"create recording rule r1 on test into m every 1m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetRecordingRulePath("r1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+						return false, check([]byte{1, 2, 3})
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create recording rule, put failure",
+			reqBody: `{"sql":"create recording rule r1 on test into m every 1m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create recording rule, rule invalid",
+			reqBody: `{"sql":"create recording rule r1 on test into m every 1m as 'select f from cpu'"}`,
+			prepare: func() {
+				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
+					return &stmtpkg.RecordingRule{Type: stmtpkg.RecordingRuleOpCreate, Name: "r1"}, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create recording rule successfully",
+			reqBody: `{"sql":"create recording rule r1 on test into m every 1m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetRecordingRulePath("r1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						rule := &models.RecordingRule{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, rule))
+						assert.Equal(t, "m", rule.Metric)
+						assert.Equal(t, timeutil.OneMinute, rule.Interval)
+						return true, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show recording rules, list failure",
+			reqBody: `{"sql":"show recording rules"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.RecordingRulePath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show recording rules successfully, with one wrong data",
+			reqBody: `{"sql":"show recording rules"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.RecordingRulePath).Return([]state.KeyValue{
+					{Key: "r1", Value: []byte(`{"name":"r1","metric":"m"}`)},
+					{Key: "r2", Value: []byte(`[]`)},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var rules models.RecordingRules
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rules))
+				assert.Len(t, rules, 1)
+			},
+		},
+		{
+			name:    "drop recording rule, rule not found",
+			reqBody: `{"sql":"drop recording rule r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRecordingRulePath("r1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop recording rule, get failure",
+			reqBody: `{"sql":"drop recording rule r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop recording rule, delete failure",
+			reqBody: `{"sql":"drop recording rule r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil)
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop recording rule successfully",
+			reqBody: `{"sql":"drop recording rule r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetRecordingRulePath("r1")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "create alert, database not found",
+			reqBody: `{"sql":"create alert a1 on test when 'f > 1' every 1m for 5m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create alert, rule invalid",
+			reqBody: `{"sql":"create alert a1 on test when 'f > 1' every 1m for 5m as 'select f from cpu'"}`,
+			prepare: func() {
+				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
+					return &stmtpkg.Alert{Type: stmtpkg.AlertOpCreate, Name: "a1"}, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create alert, rule exist",
+			reqBody: `{"sql":"create alert a1 on test when 'f > 1' every 1m for 5m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetAlertRulePath("a1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+						return false, check([]byte{1, 2, 3})
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create alert, put failure",
+			reqBody: `{"sql":"create alert a1 on test when 'f > 1' every 1m for 5m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create alert successfully",
+			reqBody: `{"sql":"create alert a1 on test when 'f > 1' every 1m for 5m as 'select f from cpu'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetAlertRulePath("a1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						rule := &models.AlertRule{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, rule))
+						assert.Equal(t, "f > 1", rule.Condition.String())
+						assert.Equal(t, 5*timeutil.OneMinute, rule.For)
+						return true, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show alerts, list rule failure",
+			reqBody: `{"sql":"show alerts"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.AlertRulePath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show alerts, list state failure",
+			reqBody: `{"sql":"show alerts"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.AlertRulePath).Return(nil, nil)
+				repo.EXPECT().List(gomock.Any(), constants.AlertStatePath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show alerts successfully, with wrong data",
+			reqBody: `{"sql":"show alerts"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.AlertRulePath).Return([]state.KeyValue{
+					{Key: "a1", Value: []byte(`{"name":"a1"}`)},
+					{Key: "a2", Value: []byte(`{"name":"a2"}`)},
+					{Key: "a3", Value: []byte(`[]`)},
+				}, nil)
+				repo.EXPECT().List(gomock.Any(), constants.AlertStatePath).Return([]state.KeyValue{
+					{Key: "a1", Value: []byte(`{"rule":"a1","alerts":{"host=1":{"status":"firing"}}}`)},
+					{Key: "a3", Value: []byte(`[]`)},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var states models.AlertRuleStates
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &states))
+				assert.Len(t, states, 2)
+				assert.Len(t, states[0].Alerts, 1)
+				assert.Equal(t, "a2", states[1].Rule)
+			},
+		},
+		{
+			name:    "drop alert, rule not found",
+			reqBody: `{"sql":"drop alert a1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetAlertRulePath("a1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop alert, get failure",
+			reqBody: `{"sql":"drop alert a1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop alert, delete rule failure",
+			reqBody: `{"sql":"drop alert a1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetAlertRulePath("a1")).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop alert successfully",
+			reqBody: `{"sql":"drop alert a1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetAlertRulePath("a1")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "create user, built-in user",
+			reqBody: `{"sql":"create user admin password 'pwd'"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, empty password",
+			reqBody: `{"sql":"create user u1 password ''"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, user exist",
+			reqBody: `{"sql":"create user u1 password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("u1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+						return false, check([]byte{1, 2, 3})
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, put failure",
+			reqBody: `{"sql":"create user u1 password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user successfully",
+			reqBody: `{"sql":"create user u1 password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("u1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, user))
+						assert.Equal(t, "u1", user.Name)
+						assert.NotEqual(t, "pwd", user.Password)
+						return true, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "alter user, user not found",
+			reqBody: `{"sql":"alter user u1 password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter user successfully",
+			reqBody: `{"sql":"alter user u1 password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserPath("u1"), gomock.Any()).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke tokens of user, user not found",
+			reqBody: `{"sql":"alter user u1 revoke tokens"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "revoke tokens of user, put failure",
+			reqBody: `{"sql":"alter user u1 revoke tokens"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserPath("u1"), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "revoke tokens of user successfully",
+			reqBody: `{"sql":"alter user u1 revoke tokens"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1","generation":1}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserPath("u1"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, user))
+						assert.Equal(t, int64(2), user.Generation)
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "drop user, get failure",
+			reqBody: `{"sql":"drop user u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop user successfully",
+			reqBody: `{"sql":"drop user u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetUserPath("u1")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show users, list failure",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show users successfully, with wrong data",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.UserPath).Return([]state.KeyValue{
+					{Key: "u1", Value: []byte(`{"name":"u1","password":"hash","roles":["r1"]}`)},
+					{Key: "u2", Value: []byte("[]")},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var users models.Users
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &users))
+				assert.Len(t, users, 2)
+				assert.Equal(t, "admin", users[0].Name)
+				assert.Empty(t, users[1].Password)
+			},
+		},
+		{
+			name:    "create role, built-in role",
+			reqBody: `{"sql":"create role admin"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create role, role exist",
+			reqBody: `{"sql":"create role r1"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetRolePath("r1"), gomock.Any(), gomock.Any()).
+					Return(false, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create role successfully",
+			reqBody: `{"sql":"create role r1"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetRolePath("r1"), gomock.Any(), gomock.Any()).
+					Return(true, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "drop role, role not found",
+			reqBody: `{"sql":"drop role r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRolePath("r1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop role successfully",
+			reqBody: `{"sql":"drop role r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRolePath("r1")).Return([]byte(`{"name":"r1"}`), nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetRolePath("r1")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show roles successfully",
+			reqBody: `{"sql":"show roles"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.RolePath).Return([]state.KeyValue{
+					{Key: "r1", Value: []byte(`{"name":"r1"}`)},
+					{Key: "r2", Value: []byte("[]")},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var roles models.Roles
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &roles))
+				assert.Len(t, roles, 2)
+			},
+		},
+		{
+			name:    "grant role, role not found",
+			reqBody: `{"sql":"grant role r1 to u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRolePath("r1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant admin role successfully",
+			reqBody: `{"sql":"grant role admin to u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserPath("u1"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, user))
+						assert.Equal(t, []string{models.RoleAdmin}, user.Roles)
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke role successfully",
+			reqBody: `{"sql":"revoke role r1 from u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).
+					Return([]byte(`{"name":"u1","roles":["r1","r2"]}`), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRolePath("r1")).Return([]byte(`{"name":"r1"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserPath("u1"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, user))
+						assert.Equal(t, []string{"r2"}, user.Roles)
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "grant privileges to user, database not found",
+			reqBody: `{"sql":"grant read on test to u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant privileges to user successfully",
+			reqBody: `{"sql":"grant all on test namespace ns to u1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte(`{"name":"u1"}`), nil)
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserPath("u1"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, user))
+						assert.Equal(t, "read on test.ns, write on test.ns", user.Privileges.String())
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke privileges from role successfully",
+			reqBody: `{"sql":"revoke write on * from role r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRolePath("r1")).
+					Return([]byte(`{"name":"r1","privileges":[{"permission":"write","database":"*"}]}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetRolePath("r1"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						role := &models.Role{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, role))
+						assert.Empty(t, role.Privileges)
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "create token, database not found",
+			reqBody: `{"sql":"create token t1 on test for write"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create token, put failure",
+			reqBody: `{"sql":"create token t1 on test for write"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTokenPath("t1"), gomock.Any(), gomock.Any()).
+					Return(false, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create token successfully",
+			reqBody: `{"sql":"create token t1 on test namespace ns for write"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTokenPath("t1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						token := &models.APIToken{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, token))
+						assert.Equal(t, "write on test.ns", token.Privileges().String())
+						assert.NotEmpty(t, token.Hash)
+						return true, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Body.String(), "lin_")
+			},
+		},
+		{
+			name:    "drop token, token not found",
+			reqBody: `{"sql":"drop token t1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop token successfully",
+			reqBody: `{"sql":"drop token t1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).Return([]byte("{}"), nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetTokenPath("t1")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show tokens successfully",
+			reqBody: `{"sql":"show tokens"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.TokenPath).Return([]state.KeyValue{
+					{Key: "t1", Value: []byte(`{"name":"t1","hash":"abc"}`)},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.NotContains(t, resp.Body.String(), "abc")
+			},
+		},
+		{
+			name:    "create tenant, put failure",
+			reqBody: `{"sql":"create tenant t1 ingest_rate 1000 max_disk '10GiB'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTenantPath("t1"), gomock.Any(), gomock.Any()).
+					Return(false, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create tenant, tenant exists",
+			reqBody: `{"sql":"create tenant t1"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTenantPath("t1"), gomock.Any(), gomock.Any()).
+					Return(false, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create tenant successfully",
+			reqBody: `{"sql":"create tenant t1 ingest_rate 1000 max_disk '10GiB'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTenantPath("t1"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						tenant := &models.Tenant{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, tenant))
+						assert.Equal(t, models.TenantQuota{IngestRate: 1000, MaxDiskBytes: 10 * 1024 * 1024 * 1024}, tenant.Quota)
+						return true, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "alter tenant, tenant not found",
+			reqBody: `{"sql":"alter tenant t1 max_series 100"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter tenant, unmarshal failure",
+			reqBody: `{"sql":"alter tenant t1 max_series 100"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return([]byte("abc"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter tenant successfully",
+			reqBody: `{"sql":"alter tenant t1 max_series 100"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).
+					Return([]byte(`{"name":"t1","quota":{"ingestRate":10}}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetTenantPath("t1"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						tenant := &models.Tenant{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, tenant))
+						assert.Equal(t, models.TenantQuota{IngestRate: 10, MaxSeries: 100}, tenant.Quota)
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "drop tenant, tenant owns databases",
+			reqBody: `{"sql":"drop tenant t1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return([]byte(`{"name":"t1"}`), nil)
+				stateMgr.EXPECT().GetDatabases().Return([]models.Database{{Name: "db2", Tenant: "t1"}, {Name: "db1", Tenant: "t1"}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "db1, db2")
+			},
+		},
+		{
+			name:    "drop tenant successfully",
+			reqBody: `{"sql":"drop tenant t1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return([]byte(`{"name":"t1"}`), nil)
+				stateMgr.EXPECT().GetDatabases().Return([]models.Database{{Name: "db1"}})
+				repo.EXPECT().Delete(gomock.Any(), constants.GetTenantPath("t1")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show tenants, list failure",
+			reqBody: `{"sql":"show tenants"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.TenantPath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show tenants successfully",
+			reqBody: `{"sql":"show tenants"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.TenantPath).Return([]state.KeyValue{
+					{Key: "t2", Value: []byte(`{"name":"t2"}`)},
+					{Key: "t1", Value: []byte(`{"name":"t1"}`)},
+					{Key: "t3", Value: []byte("abc")},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var tenants models.Tenants
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &tenants))
+				assert.Len(t, tenants, 2)
+				assert.Equal(t, "t1", tenants[0].Name)
+			},
+		},
+		{
+			name:    "show tenant usage, tenant not found",
+			reqBody: `{"sql":"show tenant usage t2"}`,
+			prepare: func() {
+				tenantMgr.EXPECT().Usage().Return(models.TenantUsages{{Name: "t1"}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show tenant usage successfully",
+			reqBody: `{"sql":"show tenant usage t1"}`,
+			prepare: func() {
+				tenantMgr.EXPECT().Usage().Return(models.TenantUsages{{Name: "t1"}, {Name: "t2"}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var usages models.TenantUsages
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &usages))
+				assert.Len(t, usages, 1)
+			},
+		},
+		{
+			name:    "show all tenants usage successfully",
+			reqBody: `{"sql":"show tenant usage"}`,
+			prepare: func() {
+				tenantMgr.EXPECT().Usage().Return(models.TenantUsages{{Name: "t1"}, {Name: "t2"}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "grant privileges to role, role not found",
+			reqBody: `{"sql":"grant read on * to role r1"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRolePath("r1")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				sqlParseFn = sql.Parse
+				command.NewRestyFn = resty.New
+				command.NewStateMachineCliFn = client.NewStateMachineCli
+			}()
+			command.NewRestyFn = func() *resty.Client {
+				c := resty.New()
+				c.SetTimeout(time.Second)
+				return c
+			}
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, tt.reqBody)
+			if tt.assert != nil {
+				tt.assert(resp)
+			}
+			if backend != nil {
+				backend.Close()
+				backend = nil
+			}
+		})
+	}
+}
+
+func TestExecuteAPI_QueryLimiter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantMgr := tenant.NewMockManager(ctrl)
+	tenantMgr.EXPECT().AcquireQuery(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx:       context.Background(),
+		BrokerCfg: &config.Broker{},
+		TenantMgr: tenantMgr,
+		// no concurrency, query limiter always timeout
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			0,
+			time.Millisecond*10,
+			metrics.NewLimitStatistics("exec_limiter", linmetric.BrokerRegistry),
+		),
+		QueryAdmission: brokerQuery.NewAdmissionController(config.NewDefaultQuery()),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	// metric query is bounded by query limiter too
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"select f from cpu","db":"test"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, resp.Body.String(), concurrent.ErrConcurrencyLimiterTimeout.Error())
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show databases"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestExecuteAPI_QueryLimiter_AdmitByClassFirst(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantMgr := tenant.NewMockManager(ctrl)
+	tenantMgr.EXPECT().AcquireQuery(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	queryFactory := brokerQuery.NewMockFactory(ctrl)
+	queryCfg := config.NewDefaultQuery()
+	// ad-hoc query always waits in queue of class until timeout
+	queryCfg.AdHoc = config.QueryClass{Concurrency: 0, QueueSize: 1, Timeout: ltoml.Duration(time.Second)}
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx:          context.Background(),
+		BrokerCfg:    &config.Broker{},
+		TenantMgr:    tenantMgr,
+		QueryFactory: queryFactory,
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			1,
+			time.Millisecond*100,
+			metrics.NewLimitStatistics("exec_limiter_class", linmetric.BrokerRegistry),
+		),
+		QueryAdmission: brokerQuery.NewAdmissionController(queryCfg),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"select f from cpu","db":"test"}`)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	}()
+	time.Sleep(200 * time.Millisecond)
+	// ad-hoc query waiting in queue doesn't hold the slot of query limiter
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+	metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{MetricName: "cpu"}, nil)
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"select f from cpu","db":"test","class":"alerting"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	wg.Wait()
+}
+
+func TestExecuteAPI_Authorize(t *testing.T) {
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx:       context.Background(),
+		BrokerCfg: &config.Broker{},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec_authorize", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithSubject(c.Request.Context(), &auth.Subject{
+			Name: "reader",
+			Privileges: models.Privileges{
+				{Permission: models.PermissionRead, Database: "test"},
+			},
+		}))
+	})
+	api.Register(r)
+
+	// admin statement
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"create user u1 password 'pwd'"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show storages"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"create tenant t1"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// no read privilege on database
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"select f from cpu","db":"test2"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// privileged query class
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"select f from cpu","db":"test","class":"alerting"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"select f from cpu","db":"test","class":"internal"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestExecuteAPI_Audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	recorder := audit.NewMockRecorder(ctrl)
+	httpDeps := &deps.HTTPDeps{
+		Ctx:       context.Background(),
+		Repo:      repo,
+		BrokerCfg: &config.Broker{},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec_audit", linmetric.BrokerRegistry),
+		),
+	}
+	api := NewExecuteAPI(httpDeps)
+	r := gin.New()
+	subject := &auth.Subject{Name: "admin", Admin: true}
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithSubject(c.Request.Context(), subject))
+	})
+	api.Register(r)
+
+	// audit log disabled
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show audit log"}`)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	httpDeps.Audit = recorder
+	// show audit log
+	recorder.EXPECT().List(gomock.Any(), 10).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show audit log limit 10"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	recorder.EXPECT().List(gomock.Any(), 0).Return(models.AuditEntries{{User: "admin", Statement: "drop tenant t1"}}, nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show audit log"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var entries models.AuditEntries
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &entries))
+	assert.Len(t, entries, 1)
+
+	// record state-mutating statement
+	gomock.InOrder(
+		repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return(nil, state.ErrNotExist),
+		repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTenantPath("t1"), gomock.Any(), gomock.Any()).Return(true, nil),
+		repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return([]byte(`{"name":"t1"}`), nil),
+	)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Equal(t, "admin", entry.User)
+		assert.Equal(t, "create tenant t1", entry.Statement)
+		assert.Empty(t, entry.Before)
+		assert.Equal(t, `{"name":"t1"}`, entry.After)
+		assert.True(t, entry.Success)
+		assert.Equal(t, "Create tenant ok", entry.Result)
+	})
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"create tenant t1"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// record denied statement
+	subject = &auth.Subject{Name: "reader"}
+	repo.EXPECT().Get(gomock.Any(), constants.GetTenantPath("t1")).Return(nil, state.ErrNotExist).Times(2)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Equal(t, "reader", entry.User)
+		assert.False(t, entry.Success)
+	})
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"create tenant t1"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
 }
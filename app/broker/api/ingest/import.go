@@ -0,0 +1,225 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	// for testing
+	newRestyFn = client.NewRestyClient
+	// ImportPath represents bulk import http api router path.
+	ImportPath = "/import"
+)
+
+// Import represents bulk import api that loads history data into storage directly,
+// the rows are routed by shard same as writing, but are sent to all replicas of shard,
+// then storage builds data files directly which bypasses write-ahead log and writable time range limit.
+//
+// Import of each replica is idempotent, the hash of shard rows is recorded as import id with the new data file,
+// so retrying a failed request only imports the rows into the families of replicas which haven't applied it.
+type Import struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewImport creates a bulk import api instance.
+func NewImport(deps *depspkg.HTTPDeps) *Import {
+	return &Import{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "ImportAPI"),
+	}
+}
+
+// Register adds the bulk import url route.
+func (i *Import) Register(route gin.IRoutes) {
+	route.POST(ImportPath, i.Import)
+}
+
+// Import processes flat/proto/influx protocol data with ingest limit, then imports data into storage directly.
+//
+// @BasePath /api/v1
+// @Summary bulk import history metric data
+// @Schemes
+// @Description receive metric data, then parse the data based on content type(flat buffer/proto buffer/influx).
+// @Description import data into all replicas of shard directly, without writable time range limit.
+// @Description import is idempotent, a failed request can be retried with same body.
+// @Description rows: the num. of rows imported into all replicas, skipped: the num. of rows skipped by storage.
+// @Tags Write
+// @Accept application/flatbuffer
+// @Accept application/protobuf
+// @Accept application/influx
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param string body string ture "metric data"
+// @Produce json
+// @Success 200 {object} models.ImportResult
+// @Failure 500 {string} string "internal error"
+// @Router /import [post]
+func (i *Import) Import(c *gin.Context) {
+	var rs *models.ImportResult
+	if err := i.deps.IngestLimiter.Do(func() (err error) {
+		rs, err = i.importRows(c)
+		return err
+	}); err != nil {
+		httppkg.Error(c, err)
+	} else {
+		httppkg.OK(c, rs)
+	}
+}
+
+// importRows parses rows from request, then sends rows of each shard to all replicas.
+func (i *Import) importRows(c *gin.Context) (*models.ImportResult, error) {
+	var param struct {
+		Database  string `form:"db" binding:"required"`
+		Namespace string `form:"ns"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		return nil, err
+	}
+	databaseCfg, ok := i.deps.StateMgr.GetDatabaseCfg(param.Database)
+	if !ok || databaseCfg.Option == nil || len(databaseCfg.Option.Intervals) == 0 {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := i.deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, fmt.Errorf("storage(%s) not found", databaseCfg.Storage)
+	}
+	rows, err := parseRows(c.Request, param.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Release()
+	if err := authorizeRows(c.Request.Context(), param.Database, rows); err != nil {
+		return nil, err
+	}
+	if err := i.deps.TenantMgr.AllowWrite(param.Database, rows.NumOfPoints()); err != nil {
+		return nil, err
+	}
+
+	sort.Sort(databaseCfg.Option.Intervals)
+	interval := databaseCfg.Option.Intervals[0].Interval
+	// build import request for each replica of shard
+	type importRequest struct {
+		shardID models.ShardID
+		node    models.StatefulNode
+		data    []byte
+	}
+	var requests []importRequest
+	shardingIterator := rows.NewShardGroupIterator(int32(databaseCfg.NumOfShard))
+	for shardingIterator.HasRowsForNextShard() {
+		shardIdx, familyIterator := shardingIterator.FamilyRowsForNextShard(interval)
+		shardID := models.ShardID(shardIdx)
+		shardState, ok := storage.ShardStates[param.Database][shardID]
+		if !ok || len(shardState.Replica.Replicas) == 0 {
+			return nil, fmt.Errorf("shard(%d) of database(%s) not found", shardID, param.Database)
+		}
+		var buf bytes.Buffer
+		for familyIterator.HasNextFamily() {
+			_, familyRows := familyIterator.NextFamily()
+			for idx := range familyRows {
+				if _, err := familyRows[idx].WriteTo(&buf); err != nil {
+					return nil, err
+				}
+			}
+		}
+		// import data into all replicas, because replication is bypassed
+		for _, nodeID := range shardState.Replica.Replicas {
+			node, ok := storage.LiveNodes[nodeID]
+			if !ok {
+				return nil, fmt.Errorf("replica node(%d) of shard(%d) isn't alive", nodeID, shardID)
+			}
+			requests = append(requests, importRequest{shardID: shardID, node: node, data: buf.Bytes()})
+		}
+	}
+	errs := make([]error, len(requests))
+	results := make([]*models.ImportResult, len(requests))
+	var wait sync.WaitGroup
+	wait.Add(len(requests))
+	for idx := range requests {
+		j := idx
+		go func() {
+			defer wait.Done()
+			req := requests[j]
+			address := req.node.HTTPAddress()
+			result := &models.ImportResult{}
+			resp, err := newRestyFn().R().
+				SetQueryParams(map[string]string{
+					"db":    param.Database,
+					"shard": strconv.Itoa(req.shardID.Int()),
+				}).
+				SetHeader("Content-Type", "application/octet-stream").
+				SetBody(req.data).
+				SetResult(result).
+				Post(address + constants.APIVersion1CliPath + "/database/import")
+			if err == nil && resp.IsError() {
+				err = fmt.Errorf("%s", resp.String())
+			}
+			if err != nil {
+				i.logger.Error("import rows into storage node failure",
+					logger.String("url", address), logger.String("database", param.Database),
+					logger.Any("shardID", req.shardID), logger.Error(err))
+				errs[j] = fmt.Errorf("%s(shard:%d): %s", req.node.Indicator(), req.shardID, err)
+				return
+			}
+			results[j] = result
+		}()
+	}
+	wait.Wait()
+
+	// rows imported of shard are the min. rows written by its replicas
+	written := make(map[models.ShardID]int)
+	var failures []string
+	for idx, err := range errs {
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+		shardID := requests[idx].shardID
+		if n, ok := written[shardID]; !ok || results[idx].Rows < n {
+			written[shardID] = results[idx].Rows
+		}
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("import rows failure(retry with same body), %s", strings.Join(failures, "; "))
+	}
+	rs := &models.ImportResult{Shards: len(written)}
+	for _, n := range written {
+		rs.Rows += n
+	}
+	rs.Skipped = rows.Len() - rs.Rows
+	auth.RecordWrittenRows(c.Request.Context(), rs.Rows)
+	return rs, nil
+}
@@ -0,0 +1,167 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-http-utils/headers"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/app/broker/tenant"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+func TestImport_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
+	api := NewImport(&deps.HTTPDeps{
+		StateMgr:  stateMgr,
+		TenantMgr: tenantMgr,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("import_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	// mock storage node
+	var (
+		lock     sync.Mutex
+		received = make(map[string]int)
+		failure  bool
+		skipped  int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if failure {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data, _ := io.ReadAll(req.Body)
+		batch := metric.NewStorageBatchRows()
+		batch.UnmarshalRows(data)
+		assert.Equal(t, constants.APIVersion1CliPath+"/database/import", req.URL.Path)
+		assert.Equal(t, "test", req.URL.Query().Get("db"))
+		received[req.URL.Query().Get("shard")] += batch.Len()
+		rows := batch.Len()
+		if skipped > 0 && rows > 0 {
+			skipped--
+			rows--
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"rows":%d,"skipped":%d,"shards":1}`, rows, batch.Len()-rows)))
+	}))
+	defer server.Close()
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	httpPort, _ := strconv.Atoi(port)
+	node := models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(httpPort)}}
+
+	databaseCfg := models.Database{
+		Name:       "test",
+		Storage:    "cluster",
+		NumOfShard: 2,
+		Option:     &option.DatabaseOption{Intervals: option.Intervals{{Interval: timeutil.Interval(10 * timeutil.OneSecond)}}},
+	}
+	storage := models.NewStorageState("cluster")
+	storage.LiveNodes[1] = node
+	storage.ShardStates["test"] = map[models.ShardID]models.ShardState{
+		0: {ID: 0, Replica: models.Replica{Replicas: []models.NodeID{1}}},
+		1: {ID: 1, Replica: models.Replica{Replicas: []models.NodeID{1}}},
+	}
+
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeInflux)
+	body := "cpu,host=a usage=1 1659407860000\ncpu,host=b usage=2 1659407860000\n" +
+		"cpu,host=c usage=3 1659407860000\ncpu,host=d usage=4 1262304000000\n"
+	path := ImportPath + "?db=test&precision=ms"
+
+	// case 1: missing db param
+	resp := mock.DoRequest(t, r, http.MethodPost, ImportPath, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: storage not found
+	stateMgr.EXPECT().GetDatabaseCfg("test").Return(databaseCfg, true).AnyTimes()
+	stateMgr.EXPECT().GetStorage("cluster").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: parse rows failure
+	stateMgr.EXPECT().GetStorage("cluster").Return(storage, true).AnyTimes()
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 5: quota of tenant exceeded
+	tenantMgr.EXPECT().AllowWrite("test", 8).Return(fmt.Errorf("%w: disk bytes", constants.ErrQuotaExceeded))
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	tenantMgr.EXPECT().AllowWrite("test", 8).Return(nil).AnyTimes()
+	// case 6: replica node not alive
+	delete(storage.LiveNodes, 1)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	storage.LiveNodes[1] = node
+	// case 7: storage node import failure
+	failure = true
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	failure = false
+	// case 8: import successfully, out of writable time range rows are imported
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"rows":4,"skipped":0,"shards":2}`, resp.Body.String())
+	assert.Equal(t, 4, received["0"]+received["1"])
+	assert.Len(t, received, 2)
+	// case 9: rows skipped by storage node
+	skipped = 1
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"rows":3,"skipped":1,"shards":2}`, resp.Body.String())
+	// case 10: shard not found
+	delete(storage.ShardStates["test"], 1)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
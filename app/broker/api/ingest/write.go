@@ -20,6 +20,7 @@ package ingest
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -27,6 +28,7 @@ import (
 
 	commonconstants "github.com/lindb/common/constants"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	ingestCommon "github.com/lindb/lindb/ingestion/common"
@@ -35,7 +37,7 @@ import (
 	"github.com/lindb/lindb/ingestion/proto"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
-	"github.com/lindb/lindb/pkg/http"
+	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/series/metric"
 )
 
@@ -104,9 +106,9 @@ func (w *Write) Write(c *gin.Context) {
 	if err := w.deps.IngestLimiter.Do(func() error {
 		return w.write(c)
 	}); err != nil {
-		http.Error(c, err)
+		httppkg.Error(c, err)
 	} else {
-		http.NoContent(c)
+		httppkg.NoContent(c)
 	}
 }
 
@@ -124,31 +126,66 @@ func (w *Write) write(c *gin.Context) (err error) {
 		w.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
 	defer cancel()
 
-	if param.Namespace == "" {
-		param.Namespace = commonconstants.DefaultNamespace
-	}
-	enrichedTags, err := ingestCommon.ExtractEnrichTags(c.Request)
+	rows, err := parseRows(c.Request, param.Namespace)
 	if err != nil {
 		return err
 	}
-	contentType := strings.ToLower(strings.Trim(c.Request.Header.Get(headers.ContentType), " "))
-	var rows *metric.BrokerBatchRows
+	if err := authorizeRows(c.Request.Context(), param.Database, rows); err != nil {
+		rows.Release()
+		return err
+	}
+	numOfRows := rows.Len()
+	if err := w.deps.TenantMgr.AllowWrite(param.Database, rows.NumOfPoints()); err != nil {
+		rows.Release()
+		return err
+	}
+	if err := w.deps.CM.Write(ctx, param.Database, rows); err != nil {
+		return err
+	}
+	auth.RecordWrittenRows(c.Request.Context(), numOfRows)
+	return nil
+}
+
+// authorizeRows checks if the user of request can write rows into database,
+// namespaces of rows are checked if user only has the privilege of some namespaces.
+func authorizeRows(ctx context.Context, database string, rows *metric.BrokerBatchRows) error {
+	return auth.AuthorizeWrite(ctx, database, func() []string {
+		namespaces := make(map[string]struct{})
+		for _, row := range rows.Rows() {
+			m := row.Metric()
+			namespaces[string(m.Namespace())] = struct{}{}
+		}
+		rs := make([]string, 0, len(namespaces))
+		for namespace := range namespaces {
+			rs = append(rs, namespace)
+		}
+		return rs
+	})
+}
+
+// parseRows parses flat/proto/influx protocol data of request based on content type.
+func parseRows(req *http.Request, namespace string) (rows *metric.BrokerBatchRows, err error) {
+	if namespace == "" {
+		namespace = commonconstants.DefaultNamespace
+	}
+	enrichedTags, err := ingestCommon.ExtractEnrichTags(req)
+	if err != nil {
+		return nil, err
+	}
+	contentType := strings.ToLower(strings.Trim(req.Header.Get(headers.ContentType), " "))
 	switch {
 	case strings.HasPrefix(contentType, constants.ContentTypeFlat):
-		rows, err = flat.Parse(c.Request, enrichedTags, param.Namespace)
+		rows, err = flat.Parse(req, enrichedTags, namespace)
 	case strings.HasPrefix(contentType, constants.ContentTypeInflux):
-		rows, err = influx.Parse(c.Request, enrichedTags, param.Namespace)
+		rows, err = influx.Parse(req, enrichedTags, namespace)
 	case strings.HasPrefix(contentType, constants.ContentTypeProto):
-		rows, err = proto.Parse(c.Request, enrichedTags, param.Namespace)
+		rows, err = proto.Parse(req, enrichedTags, namespace)
 	default:
 		err = fmt.Errorf("not support content type: %s, only support %s/%s/%s", contentType,
 			constants.ContentTypeFlat, constants.ContentTypeProto, constants.ContentTypeInflux)
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if err := w.deps.CM.Write(ctx, param.Database, rows); err != nil {
-		return err
-	}
-	return nil
+	return rows, nil
 }
@@ -20,6 +20,7 @@ package ingest
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -32,13 +33,16 @@ import (
 
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/app/broker/tenant"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/replica"
@@ -50,6 +54,7 @@ func TestWrite_Flat(t *testing.T) {
 	defer ctrl.Finish()
 
 	cm := replica.NewMockChannelManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
 	api := NewWrite(&deps.HTTPDeps{
 		BrokerCfg: &config.Broker{
 			BrokerBase: config.BrokerBase{
@@ -58,13 +63,15 @@ func TestWrite_Flat(t *testing.T) {
 				},
 			},
 		},
-		CM: cm,
+		CM:        cm,
+		TenantMgr: tenantMgr,
 		IngestLimiter: concurrent.NewLimiter(
 			context.TODO(),
 			32,
 			time.Second,
 			metrics.NewLimitStatistics("flat_write_test", linmetric.BrokerRegistry)),
 	})
+	tenantMgr.EXPECT().AllowWrite(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	r := gin.New()
 	api.Register(r)
 
@@ -112,6 +119,7 @@ func TestWrite_Influx(t *testing.T) {
 	defer ctrl.Finish()
 
 	cm := replica.NewMockChannelManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
 	api := NewWrite(&deps.HTTPDeps{
 		BrokerCfg: &config.Broker{
 			BrokerBase: config.BrokerBase{
@@ -120,13 +128,15 @@ func TestWrite_Influx(t *testing.T) {
 				},
 			},
 		},
-		CM: cm,
+		CM:        cm,
+		TenantMgr: tenantMgr,
 		IngestLimiter: concurrent.NewLimiter(
 			context.TODO(),
 			32,
 			time.Second,
 			metrics.NewLimitStatistics("test", linmetric.BrokerRegistry)),
 	})
+	tenantMgr.EXPECT().AllowWrite(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	r := gin.New()
 	api.Register(r)
 
@@ -173,6 +183,7 @@ func TestWrite_Proto(t *testing.T) {
 	defer ctrl.Finish()
 
 	cm := replica.NewMockChannelManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
 	api := NewWrite(&deps.HTTPDeps{
 		BrokerCfg: &config.Broker{
 			BrokerBase: config.BrokerBase{
@@ -181,13 +192,15 @@ func TestWrite_Proto(t *testing.T) {
 				},
 			},
 		},
-		CM: cm,
+		CM:        cm,
+		TenantMgr: tenantMgr,
 		IngestLimiter: concurrent.NewLimiter(
 			context.TODO(),
 			32,
 			time.Second,
 			metrics.NewLimitStatistics("test", linmetric.BrokerRegistry)),
 	})
+	tenantMgr.EXPECT().AllowWrite(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	r := gin.New()
 	api.Register(r)
 
@@ -225,3 +238,71 @@ func TestWrite_Proto(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test&ns=ns4&enrich_tag=a=b", string(data), header)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
+
+func TestWrite_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM:        cm,
+		TenantMgr: tenantMgr,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("authorize_write_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithSubject(c.Request.Context(), &auth.Subject{
+			Name:  "writer",
+			Token: "writer",
+			Privileges: models.Privileges{
+				{Permission: models.PermissionWrite, Database: "test", Namespace: "ns"},
+			},
+		}))
+	})
+	api.Register(r)
+
+	converter := metric.NewProtoConverter()
+	var brokerRow metric.BrokerRow
+	err := converter.ConvertTo(&protoMetricsV1.Metric{
+		Name:      "cpu",
+		Timestamp: timeutil.Now(),
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+	}, &brokerRow)
+	assert.NoError(t, err)
+	var buf bytes.Buffer
+	_, _ = brokerRow.WriteTo(&buf)
+	body := buf.String()
+
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeFlat)
+
+	// no privilege on default namespace
+	resp := mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", body, header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// no privilege on other database
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test2&ns=ns", body, header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+
+	// quota of tenant exceeded
+	tenantMgr.EXPECT().AllowWrite("test", 1).Return(fmt.Errorf("%w: ingest rate", constants.ErrQuotaExceeded))
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test&ns=ns", body, header)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	tenantMgr.EXPECT().AllowWrite("test", 1).Return(nil)
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test&ns=ns", body, header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
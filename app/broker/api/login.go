@@ -20,63 +20,62 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/pkg/http"
-	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 )
 
 var (
-	createTokenFn = middleware.CreateToken
-	LoginPath     = "/login"
+	LoginPath = "/login"
 )
 
 // LoginAPI represents login param
 type LoginAPI struct {
-	user config.User
+	authMgr auth.Manager
 
 	logger *logger.Logger
 }
 
 // NewLoginAPI creates login api instance
-func NewLoginAPI(user config.User) *LoginAPI {
+func NewLoginAPI(authMgr auth.Manager) *LoginAPI {
 	return &LoginAPI{
-		user:   user,
-		logger: logger.GetLogger("Broker", "LoginAPI"),
+		authMgr: authMgr,
+		logger:  logger.GetLogger("Broker", "LoginAPI"),
 	}
 }
 
 // Register adds login url route.
 func (l *LoginAPI) Register(route gin.IRoutes) {
 	route.PUT(LoginPath, l.Login)
+	route.POST(LoginPath, l.Login)
 }
 
-// Login responses unique token
-// empty use name or password will responses error msg
-// invalid use name or password will responses error msg
+// Login responses unique token which is used as Authorization header of request,
+// empty/invalid user name or password will responses error msg.
+//
+// @Summary login
+// @Description login with user name and password, returns the token for Authorization header.
+// @Tags Login
+// @Accept json
+// @Param param body config.User ture "user name and password"
+// @Produce json
+// @Success 200 {string} string "token"
+// @Failure 401 {string} string "username or password is invalid"
+// @Failure 500 {string} string "internal error"
+// @Router /login [put]
+// @Router /login [post]
 func (l *LoginAPI) Login(c *gin.Context) {
 	user := config.User{}
 	err := c.ShouldBind(&user)
 	if err != nil {
-		l.logger.Error("cannot get user info from request")
-		http.OK(c, "")
-		return
-	}
-	// user name is error
-	if l.user.UserName != user.UserName {
-		l.logger.Error("username is invalid")
-		http.OK(c, "")
-		return
-	}
-	// password is error
-	if l.user.Password != user.Password {
-		l.logger.Error("password is invalid")
-		http.OK(c, "")
+		http.Error(c, err)
 		return
 	}
-	token, err := createTokenFn(user)
+	token, err := l.authMgr.Login(user.UserName, user.Password)
 	if err != nil {
-		http.OK(c, "")
+		l.logger.Warn("user login failure", logger.String("user", user.UserName), logger.Error(err))
+		http.Error(c, err)
 		return
 	}
 	http.OK(c, token)
@@ -26,55 +26,32 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/app/broker/auth"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/mock"
-	httppkg "github.com/lindb/lindb/pkg/http/middleware"
 )
 
 func TestLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	defer func() {
-		createTokenFn = httppkg.CreateToken
-		ctrl.Finish()
-	}()
+	defer ctrl.Finish()
 
-	user := config.User{UserName: "admin", Password: "admin123"}
-	api := NewLoginAPI(user)
+	authMgr := auth.NewMockManager(ctrl)
+	api := NewLoginAPI(authMgr)
 	r := gin.New()
 	api.Register(r)
 
-	resp := mock.DoRequest(t, r, http.MethodPut, LoginPath, "")
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// create success
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, "")
-	assert.Equal(t, http.StatusOK, resp.Code)
+	// user login failure, password is empty
+	resp := mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 
 	// user failure error password
+	authMgr.EXPECT().Login("admin", "admin1234").Return("", fmt.Errorf("%w: invalid", constants.ErrUnauthorized))
 	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin1234"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user failure error user name
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123", "password": "admin1234"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user failure error password
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123", "password": "admin12dd34"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user login failure  password is empty
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// token create fail
-	createTokenFn = func(user config.User) (string, error) {
-		return "", fmt.Errorf("err")
-	}
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
 
-	// token create ok
-	createTokenFn = httppkg.CreateToken
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
+	// login ok
+	authMgr.EXPECT().Login("admin", "admin123").Return("token", nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, LoginPath, `{"username": "admin", "password": "admin123"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `"token"`, resp.Body.String())
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 )
@@ -33,11 +34,14 @@ var (
 
 // ReverseProxy represents the http reverse proxy to target's api.
 type ReverseProxy struct {
+	transport *http.Transport
 }
 
 // NewReverseProxy creates a ReverseProxy instance.
 func NewReverseProxy() *ReverseProxy {
-	return &ReverseProxy{}
+	return &ReverseProxy{
+		transport: client.NewTransport(),
+	}
 }
 
 // Register adds proxy url route.
@@ -67,24 +71,29 @@ func (p *ReverseProxy) Proxy(c *gin.Context) {
 	}
 	director := func(req *http.Request) {
 		req.URL.Scheme = "http"
+		if p.transport.TLSClientConfig != nil {
+			// https enabled for cluster nodes
+			req.URL.Scheme = "https"
+		}
 		req.URL.Host = param.Target
 		req.URL.Path = param.Path
 		req.URL.RawQuery = c.Request.URL.RawQuery
 	}
 	proxy := &httputil.ReverseProxy{
 		Director:  director,
-		Transport: &transport{},
+		Transport: &transport{base: p.transport},
 	}
 	proxy.ServeHTTP(c.Writer, c.Request)
 }
 
 // transport implements http.RoundTripper.
 type transport struct {
+	base http.RoundTripper
 }
 
 // RoundTrip removes cors http header.
 func (t transport) RoundTrip(r *http.Request) (*http.Response, error) {
-	resp, err := http.DefaultTransport.RoundTrip(r)
+	resp, err := t.base.RoundTrip(r)
 	// if target server enable cors, maybe add duplicate Access-Control-Allow-Origin header.
 	resp.Header.Del("Access-Control-Allow-Origin")
 	return resp, err
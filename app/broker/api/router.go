@@ -24,25 +24,36 @@ import (
 	"github.com/lindb/lindb/app/broker/api/exec"
 	"github.com/lindb/lindb/app/broker/api/ingest"
 	"github.com/lindb/lindb/app/broker/api/state"
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 )
 
 // API represents broker http api.
 type API struct {
+	authentication middleware.Authentication
+	login          *LoginAPI
+
 	execute *exec.ExecuteAPI
 
 	database           *admin.DatabaseAPI
 	flusher            *admin.DatabaseFlusherAPI
+	backup             *admin.DatabaseBackupAPI
+	compact            *admin.DatabaseCompactAPI
 	storage            *admin.StorageClusterAPI
+	decommission       *admin.StorageDecommissionAPI
+	token              *admin.TokenAPI
 	brokerStateMachine *state.BrokerStateMachineAPI
 	request            *state.RequestAPI
 	metricExplore      *monitoring.ExploreAPI
 	log                *monitoring.LoggerAPI
 	config             *monitoring.ConfigAPI
 	write              *ingest.Write
+	importer           *ingest.Import
 	env                *monitoring.EnvAPI
 	proxy              *ReverseProxy
 }
@@ -50,16 +61,23 @@ type API struct {
 // NewAPI creates broker http api.
 func NewAPI(deps *depspkg.HTTPDeps) *API {
 	return &API{
+		authentication:     middleware.NewAuthentication(deps.AuthMgr),
+		login:              NewLoginAPI(deps.AuthMgr),
 		execute:            exec.NewExecuteAPI(deps),
 		database:           admin.NewDatabaseAPI(deps),
 		flusher:            admin.NewDatabaseFlusherAPI(deps),
+		backup:             admin.NewDatabaseBackupAPI(deps),
+		compact:            admin.NewDatabaseCompactAPI(deps),
 		storage:            admin.NewStorageClusterAPI(deps),
+		decommission:       admin.NewStorageDecommissionAPI(deps),
+		token:              admin.NewTokenAPI(deps),
 		brokerStateMachine: state.NewBrokerStateMachineAPI(deps),
 		request:            state.NewRequestAPI(),
 		metricExplore:      monitoring.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
 		log:                monitoring.NewLoggerAPI(deps.BrokerCfg.Logging.Dir),
 		config:             monitoring.NewConfigAPI(deps.Node, deps.BrokerCfg),
 		write:              ingest.NewWrite(deps),
+		importer:           ingest.NewImport(deps),
 		env:                monitoring.NewEnvAPI(deps.BrokerCfg.Monitor, constants.BrokerRole),
 		proxy:              NewReverseProxy(),
 	}
@@ -68,19 +86,31 @@ func NewAPI(deps *depspkg.HTTPDeps) *API {
 // RegisterRouter registers http api router.
 func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	v1 := router.Group(constants.APIVersion1)
+	api.login.Register(v1)
+	// write/import/exec api need authentication if auth enabled
+	secured := v1.Group("", api.authentication.Validate())
+
 	// execute lin query language statement
-	api.execute.Register(v1)
+	api.execute.Register(secured)
 
-	api.database.Register(v1)
-	api.flusher.Register(v1)
-	api.storage.Register(v1)
+	// cluster management api need admin role if auth enabled
+	admin := secured.Group("", requireAdmin)
+	api.database.Register(admin)
+	api.flusher.Register(admin)
+	api.backup.Register(admin)
+	api.compact.Register(admin)
+	api.storage.Register(admin)
+	api.decommission.Register(admin)
+	api.token.Register(admin)
 
 	// state
 	api.brokerStateMachine.Register(v1)
 	api.request.Register(v1)
 
 	// write metric data
-	api.write.Register(v1)
+	api.write.Register(secured)
+	// bulk import history metric data
+	api.importer.Register(secured)
 
 	// monitoring
 	api.metricExplore.Register(v1)
@@ -90,3 +120,13 @@ func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	api.env.Register(v1)
 	api.proxy.Register(v1)
 }
+
+// requireAdmin aborts the request if the authenticated user has no admin role.
+func requireAdmin(c *gin.Context) {
+	if err := auth.AuthorizeAdmin(c.Request.Context()); err != nil {
+		httppkg.Error(c, err)
+		c.Abort()
+		return
+	}
+	c.Next()
+}
@@ -18,16 +18,42 @@
 package api
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
 )
 
 func TestNewRouter(t *testing.T) {
 	r := NewAPI(&deps.HTTPDeps{BrokerCfg: &config.Broker{}})
 	r.RegisterRouter(gin.New().Group(constants.APIRoot))
 }
+
+func TestRequireAdmin(t *testing.T) {
+	var subject *auth.Subject
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if subject != nil {
+			c.Request = c.Request.WithContext(auth.WithSubject(c.Request.Context(), subject))
+		}
+	}, requireAdmin)
+	r.GET("/admin", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	// auth disabled
+	resp := mock.DoRequest(t, r, http.MethodGet, "/admin", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	subject = &auth.Subject{Name: "u1"}
+	resp = mock.DoRequest(t, r, http.MethodGet, "/admin", "")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	subject = &auth.Subject{Name: "admin", Admin: true}
+	resp = mock.DoRequest(t, r, http.MethodGet, "/admin", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
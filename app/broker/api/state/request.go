@@ -22,10 +22,12 @@ import (
 
 	"github.com/lindb/lindb/pkg/http"
 	brokerquery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/query/tracker"
 )
 
 var (
-	RequestsPath = "/state/requests"
+	RequestsPath     = "/state/requests"
+	SlowRequestsPath = "/state/slow-requests"
 )
 
 // RequestAPI represents request state related api.
@@ -40,9 +42,15 @@ func NewRequestAPI() *RequestAPI {
 // Register adds request state url route.
 func (api *RequestAPI) Register(route gin.IRoutes) {
 	route.GET(RequestsPath, api.GetAllAliveRequests)
+	route.GET(SlowRequestsPath, api.GetSlowRequests)
 }
 
 // GetAllAliveRequests returns all alive request.
 func (api *RequestAPI) GetAllAliveRequests(c *gin.Context) {
 	http.OK(c, brokerquery.GetRequestManager().GetAliveRequests())
 }
+
+// GetSlowRequests returns recent slow requests of current broker node.
+func (api *RequestAPI) GetSlowRequests(c *gin.Context) {
+	http.OK(c, tracker.GetSlowQueries())
+}
@@ -34,4 +34,7 @@ func TestRequestAPI(t *testing.T) {
 
 	resp := mock.DoRequest(t, r, http.MethodGet, RequestsPath, "")
 	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = mock.DoRequest(t, r, http.MethodGet, SlowRequestsPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
 }
@@ -0,0 +1,115 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// AuthorizeStatement checks if the subject of context can execute the statement on database,
+// metric data/metadata query needs read permission, cluster/user management needs admin role.
+func AuthorizeStatement(ctx context.Context, database string, stmt stmtpkg.Statement) error {
+	subject, ok := SubjectFromContext(ctx)
+	if !ok || subject.Admin {
+		// auth disabled or admin
+		return nil
+	}
+	switch s := stmt.(type) {
+	case *stmtpkg.Use:
+		return nil
+	case *stmtpkg.Query:
+		return authorize(subject, models.PermissionRead, database, s.Namespace)
+	case *stmtpkg.MetricMetadata:
+		return authorize(subject, models.PermissionRead, database, s.Namespace)
+	case *stmtpkg.Schema:
+		if s.Type == stmtpkg.DatabaseNameSchemaType || s.Type == stmtpkg.DatabaseSchemaType {
+			return nil
+		}
+	case *stmtpkg.RecordingRule:
+		if s.Type == stmtpkg.RecordingRuleOpShow {
+			return nil
+		}
+	case *stmtpkg.Alert:
+		if s.Type == stmtpkg.AlertOpShow {
+			return nil
+		}
+	case *stmtpkg.User:
+		// user can change own password/revoke own tokens, api token isn't a user
+		self := s.Name == subject.Name && subject.Token == ""
+		if self && (s.Type == stmtpkg.UserOpAlter || s.Type == stmtpkg.UserOpRevokeTokens) {
+			return nil
+		}
+	}
+	return adminRequired(subject)
+}
+
+// AuthorizeAdmin checks if the subject of context has admin role, used for cluster management api.
+func AuthorizeAdmin(ctx context.Context) error {
+	subject, ok := SubjectFromContext(ctx)
+	if !ok || subject.Admin {
+		return nil
+	}
+	return adminRequired(subject)
+}
+
+// adminRequired returns the permission denied error which needs admin role.
+func adminRequired(subject *Subject) error {
+	return fmt.Errorf("%w: user '%s' needs admin role", constants.ErrPermissionDenied, subject.Name)
+}
+
+// AuthorizeWrite checks if the subject of context can write data into database,
+// namespacesFn returns the namespaces of written rows, which is invoked only if privilege is namespace scoped.
+func AuthorizeWrite(ctx context.Context, database string, namespacesFn func() []string) error {
+	subject, ok := SubjectFromContext(ctx)
+	if !ok || subject.Admin {
+		return nil
+	}
+	// privilege on all namespaces of database
+	if subject.Privileges.Match(models.PermissionWrite, database, "") {
+		return nil
+	}
+	namespaces := namespacesFn()
+	if len(namespaces) == 0 {
+		return authorize(subject, models.PermissionWrite, database, "")
+	}
+	for _, namespace := range namespaces {
+		if err := authorize(subject, models.PermissionWrite, database, namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorize checks if subject has the permission on database/namespace, empty namespace is default namespace.
+func authorize(subject *Subject, permission models.Permission, database, namespace string) error {
+	if namespace == "" {
+		namespace = commonconstants.DefaultNamespace
+	}
+	if subject.Can(permission, database, namespace) {
+		return nil
+	}
+	return fmt.Errorf("%w: user '%s' has no %s privilege on %s.%s",
+		constants.ErrPermissionDenied, subject.Name, permission, database, namespace)
+}
@@ -0,0 +1,124 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestAuthorizeStatement(t *testing.T) {
+	// auth disabled
+	assert.NoError(t, AuthorizeStatement(context.TODO(), "db", &stmtpkg.Storage{}))
+	// admin
+	admin := WithSubject(context.TODO(), &Subject{Name: "admin", Admin: true})
+	assert.NoError(t, AuthorizeStatement(admin, "db", &stmtpkg.Storage{}))
+
+	ctx := WithSubject(context.TODO(), &Subject{
+		Name: "u1",
+		Privileges: models.Privileges{
+			{Permission: models.PermissionRead, Database: "db"},
+			{Permission: models.PermissionRead, Database: "db2", Namespace: "ns"},
+		},
+	})
+	allowed := []struct {
+		database string
+		stmt     stmtpkg.Statement
+	}{
+		{"", &stmtpkg.Use{Name: "db"}},
+		{"db", &stmtpkg.Query{}},
+		{"db2", &stmtpkg.Query{Namespace: "ns"}},
+		{"db", &stmtpkg.MetricMetadata{Namespace: "ns"}},
+		{"", &stmtpkg.Schema{Type: stmtpkg.DatabaseNameSchemaType}},
+		{"", &stmtpkg.Schema{Type: stmtpkg.DatabaseSchemaType}},
+		{"", &stmtpkg.RecordingRule{Type: stmtpkg.RecordingRuleOpShow}},
+		{"", &stmtpkg.Alert{Type: stmtpkg.AlertOpShow}},
+		{"", &stmtpkg.User{Type: stmtpkg.UserOpAlter, Name: "u1"}},
+		{"", &stmtpkg.User{Type: stmtpkg.UserOpRevokeTokens, Name: "u1"}},
+	}
+	for _, tt := range allowed {
+		assert.NoError(t, AuthorizeStatement(ctx, tt.database, tt.stmt), "%v", tt.stmt)
+	}
+	denied := []struct {
+		database string
+		stmt     stmtpkg.Statement
+	}{
+		{"db3", &stmtpkg.Query{}},
+		{"db2", &stmtpkg.Query{}},
+		{"db2", &stmtpkg.MetricMetadata{Namespace: "ns2"}},
+		{"", &stmtpkg.Schema{Type: stmtpkg.CreateDatabaseSchemaType}},
+		{"", &stmtpkg.Storage{}},
+		{"", &stmtpkg.Broker{}},
+		{"", &stmtpkg.State{}},
+		{"", &stmtpkg.RecordingRule{Type: stmtpkg.RecordingRuleOpCreate}},
+		{"", &stmtpkg.Alert{Type: stmtpkg.AlertOpDrop}},
+		{"", &stmtpkg.User{Type: stmtpkg.UserOpAlter, Name: "u2"}},
+		{"", &stmtpkg.User{Type: stmtpkg.UserOpRevokeTokens, Name: "u2"}},
+		{"", &stmtpkg.User{Type: stmtpkg.UserOpCreate, Name: "u2"}},
+		{"", &stmtpkg.Grant{}},
+		{"", &stmtpkg.Token{Type: stmtpkg.TokenOpCreate}},
+	}
+	for _, tt := range denied {
+		assert.ErrorIs(t, AuthorizeStatement(ctx, tt.database, tt.stmt), constants.ErrPermissionDenied, "%v", tt.stmt)
+	}
+	// api token cannot change password of user with same name
+	tokenCtx := WithSubject(context.TODO(), &Subject{Name: "u1", Token: "u1"})
+	assert.ErrorIs(t, AuthorizeStatement(tokenCtx, "", &stmtpkg.User{Type: stmtpkg.UserOpAlter, Name: "u1"}),
+		constants.ErrPermissionDenied)
+}
+
+func TestAuthorizeWrite(t *testing.T) {
+	called := false
+	namespacesFn := func(namespaces ...string) func() []string {
+		return func() []string {
+			called = true
+			return namespaces
+		}
+	}
+	// auth disabled
+	assert.NoError(t, AuthorizeWrite(context.TODO(), "db", namespacesFn()))
+	assert.NoError(t, AuthorizeWrite(WithSubject(context.TODO(), &Subject{Admin: true}), "db", namespacesFn()))
+
+	ctx := WithSubject(context.TODO(), &Subject{
+		Name: "u1",
+		Privileges: models.Privileges{
+			{Permission: models.PermissionWrite, Database: "db"},
+			{Permission: models.PermissionWrite, Database: "db2", Namespace: "ns"},
+			{Permission: models.PermissionRead, Database: "db3"},
+		},
+	})
+	assert.NoError(t, AuthorizeWrite(ctx, "db", namespacesFn("ns")))
+	assert.False(t, called)
+	assert.NoError(t, AuthorizeWrite(ctx, "db2", namespacesFn("ns")))
+	assert.True(t, called)
+	assert.ErrorIs(t, AuthorizeWrite(ctx, "db2", namespacesFn("ns", "ns2")), constants.ErrPermissionDenied)
+	assert.ErrorIs(t, AuthorizeWrite(ctx, "db2", namespacesFn()), constants.ErrPermissionDenied)
+	assert.ErrorIs(t, AuthorizeWrite(ctx, "db3", namespacesFn()), constants.ErrPermissionDenied)
+}
+
+func TestAuthorizeAdmin(t *testing.T) {
+	assert.NoError(t, AuthorizeAdmin(context.TODO()))
+	assert.NoError(t, AuthorizeAdmin(WithSubject(context.TODO(), &Subject{Admin: true})))
+	assert.ErrorIs(t, AuthorizeAdmin(WithSubject(context.TODO(), &Subject{Name: "u1"})), constants.ErrPermissionDenied)
+}
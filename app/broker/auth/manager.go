@@ -0,0 +1,405 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+//go:generate mockgen -source=./manager.go -destination=./manager_mock.go -package=auth
+
+// for testing
+var (
+	createTokenFn = middleware.CreateToken
+)
+
+var log = logger.GetLogger("Broker", "Auth")
+
+// signingKeySize represents the size of random key which signs the token issued by login api.
+const signingKeySize = 32
+
+// errSigningKeyExist represents the signing key is generated by other broker.
+var errSigningKeyExist = errors.New("signing key exist")
+
+// Manager represents the manager of users/roles/api tokens, which authenticates the request of broker api.
+type Manager interface {
+	middleware.Authenticator
+	// Start starts watching the changes of users/roles/api tokens if auth enabled.
+	Start() error
+	// Login authenticates user by name/password, returns the token if success.
+	Login(userName, password string) (string, error)
+	// Stop stops watching the changes of users/roles/api tokens.
+	Stop()
+}
+
+// manager implements Manager interface.
+type manager struct {
+	cfg     config.Auth
+	repo    state.Repository
+	factory discovery.Factory
+	// signingKey represents the random key of cluster which signs the token issued by login api.
+	signingKey []byte
+
+	discoveries []discovery.Discovery
+	users       map[string]*models.User
+	roles       map[string]*models.Role
+	// tokens represents api tokens, key is the id of token.
+	tokens map[string]*models.APIToken
+	// verified caches the password digest of user which is verified by basic auth, avoid bcrypt for each request.
+	verified map[string][sha256.Size]byte
+
+	mutex sync.RWMutex
+}
+
+// NewManager creates the user/role manager.
+func NewManager(cfg config.Auth, repo state.Repository, factory discovery.Factory) Manager {
+	return &manager{
+		cfg:      cfg,
+		repo:     repo,
+		factory:  factory,
+		users:    make(map[string]*models.User),
+		roles:    make(map[string]*models.Role),
+		tokens:   make(map[string]*models.APIToken),
+		verified: make(map[string][sha256.Size]byte),
+	}
+}
+
+// Start starts watching the changes of users/roles/api tokens if auth enabled.
+func (m *manager) Start() error {
+	if !m.cfg.Enable {
+		return nil
+	}
+	if err := m.loadSigningKey(); err != nil {
+		return err
+	}
+	m.discoveries = []discovery.Discovery{
+		m.factory.CreateDiscovery(constants.UserPath, &userListener{m: m}),
+		m.factory.CreateDiscovery(constants.RolePath, &roleListener{m: m}),
+		m.factory.CreateDiscovery(constants.TokenPath, &tokenListener{m: m}),
+	}
+	for _, d := range m.discoveries {
+		if err := d.Discovery(true); err != nil {
+			return err
+		}
+	}
+	log.Info("auth enabled, users/roles/api tokens are watched")
+	return nil
+}
+
+// loadSigningKey loads the signing key from repo, the random key is generated by the first started broker,
+// so that token issued by any broker of cluster is valid for all brokers.
+func (m *manager) loadSigningKey() error {
+	key := make([]byte, signingKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	_, err := m.repo.PutWithTX(context.TODO(), constants.SigningKeyPath, []byte(hex.EncodeToString(key)),
+		func(_ []byte) error {
+			return errSigningKeyExist
+		})
+	if err != nil && !errors.Is(err, errSigningKeyExist) {
+		return err
+	}
+	// read again, maybe generated by other broker concurrently
+	signingKey, err := m.repo.Get(context.TODO(), constants.SigningKeyPath)
+	if err != nil {
+		return err
+	}
+	if len(signingKey) == 0 {
+		return errors.New("signing key of token is empty")
+	}
+	m.signingKey = signingKey
+	return nil
+}
+
+// Stop stops watching the changes of users/roles/api tokens.
+func (m *manager) Stop() {
+	for _, d := range m.discoveries {
+		d.Close()
+	}
+}
+
+// Authenticate authenticates the request by basic auth, bearer token issued by login api or api token,
+// returns the context with authenticated subject.
+func (m *manager) Authenticate(r *http.Request) (context.Context, error) {
+	if !m.cfg.Enable {
+		return r.Context(), nil
+	}
+	header := r.Header.Get("Authorization")
+	var (
+		subject *Subject
+		err     error
+	)
+	switch {
+	case header == "":
+		return nil, fmt.Errorf("%w: authorization header is missing", constants.ErrUnauthorized)
+	case strings.HasPrefix(header, "Basic "):
+		userName, password, _ := r.BasicAuth()
+		subject, err = m.authenticate(userName, password)
+	case isAPIToken(strings.TrimPrefix(header, "Bearer ")):
+		subject, err = m.validateAPIToken(strings.TrimPrefix(header, "Bearer "))
+	default:
+		subject, err = m.validateToken(strings.TrimPrefix(header, "Bearer "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	subject.Credential = header
+	return WithSubject(r.Context(), subject), nil
+}
+
+// Login authenticates user by name/password, returns the token if success.
+func (m *manager) Login(userName, password string) (string, error) {
+	if !m.cfg.Enable {
+		return "", errors.New("auth is disabled")
+	}
+	if _, err := m.authenticate(userName, password); err != nil {
+		return "", err
+	}
+	var generation int64
+	if userName != m.cfg.Admin.UserName {
+		m.mutex.RLock()
+		user, ok := m.users[userName]
+		m.mutex.RUnlock()
+		if !ok {
+			return "", constants.ErrUserNotFound
+		}
+		generation = user.Generation
+	}
+	return createTokenFn(userName, generation, m.signingKey, m.cfg.TokenTTL.Duration())
+}
+
+// authenticate checks the password of user, returns the subject if password matched.
+func (m *manager) authenticate(userName, password string) (*Subject, error) {
+	invalid := fmt.Errorf("%w: username or password is invalid", constants.ErrUnauthorized)
+	if userName == "" {
+		return nil, invalid
+	}
+	if userName == m.cfg.Admin.UserName {
+		if subtle.ConstantTimeCompare([]byte(password), []byte(m.cfg.Admin.Password)) != 1 {
+			return nil, invalid
+		}
+		return &Subject{Name: userName, Admin: true}, nil
+	}
+	digest := sha256.Sum256([]byte(password))
+	m.mutex.RLock()
+	user, ok := m.users[userName]
+	verified, cached := m.verified[userName]
+	m.mutex.RUnlock()
+	if !ok {
+		return nil, invalid
+	}
+	if !cached || verified != digest {
+		if err := ComparePassword(user.Password, password); err != nil {
+			return nil, invalid
+		}
+		m.mutex.Lock()
+		// make sure user not changed during verifying
+		if m.users[userName] == user {
+			m.verified[userName] = digest
+		}
+		m.mutex.Unlock()
+	}
+	return m.subject(user), nil
+}
+
+// validateToken validates the token issued by login api, returns the subject of token.
+// Token is invalid after user dropped or the token generation of user changed(password changed/tokens revoked).
+func (m *manager) validateToken(token string) (*Subject, error) {
+	claims, err := middleware.ParseToken(token, func(_ string) ([]byte, error) {
+		return m.signingKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", constants.ErrUnauthorized, err)
+	}
+	if claims.UserName == m.cfg.Admin.UserName {
+		return &Subject{Name: claims.UserName, Admin: true}, nil
+	}
+	m.mutex.RLock()
+	user, ok := m.users[claims.UserName]
+	m.mutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: user not found", constants.ErrUnauthorized)
+	}
+	if user.Generation != claims.Generation {
+		return nil, fmt.Errorf("%w: token revoked", constants.ErrUnauthorized)
+	}
+	return m.subject(user), nil
+}
+
+// validateAPIToken validates the api token, returns the subject which only has the privileges of token.
+func (m *manager) validateAPIToken(token string) (*Subject, error) {
+	invalid := fmt.Errorf("%w: api token is invalid", constants.ErrUnauthorized)
+	id, secret, ok := parseAPIToken(token)
+	if !ok {
+		return nil, invalid
+	}
+	m.mutex.RLock()
+	apiToken, ok := m.tokens[id]
+	m.mutex.RUnlock()
+	if !ok {
+		return nil, invalid
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(apiToken.Hash)) != 1 {
+		apiTokenStatistics.AuthFailures.WithTagValues(apiToken.Name).Incr()
+		return nil, invalid
+	}
+	apiTokenStatistics.Requests.WithTagValues(apiToken.Name).Incr()
+	return &Subject{
+		Name:       apiToken.Name,
+		Privileges: apiToken.Privileges(),
+		Token:      apiToken.Name,
+	}, nil
+}
+
+// subject returns the subject of user, includes the privileges of its roles.
+func (m *manager) subject(user *models.User) *Subject {
+	subject := &Subject{
+		Name:       user.Name,
+		Admin:      user.HasRole(models.RoleAdmin),
+		Privileges: append(models.Privileges{}, user.Privileges...),
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for _, roleName := range user.Roles {
+		if role, ok := m.roles[roleName]; ok {
+			subject.Privileges = append(subject.Privileges, role.Privileges...)
+		}
+	}
+	return subject
+}
+
+// userListener listens the changes of users.
+type userListener struct {
+	m *manager
+}
+
+// OnCreate caches the user when user created/modified.
+func (l *userListener) OnCreate(key string, resource []byte) {
+	user := &models.User{}
+	if err := encoding.JSONUnmarshal(resource, user); err != nil {
+		log.Error("unmarshal user error", logger.String("key", key), logger.Error(err))
+		return
+	}
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	l.m.users[user.Name] = user
+	delete(l.m.verified, user.Name)
+}
+
+// OnDelete removes the user when user dropped.
+func (l *userListener) OnDelete(key string) {
+	name := path.Base(key)
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	delete(l.m.users, name)
+	delete(l.m.verified, name)
+}
+
+// roleListener listens the changes of roles.
+type roleListener struct {
+	m *manager
+}
+
+// OnCreate caches the role when role created/modified.
+func (l *roleListener) OnCreate(key string, resource []byte) {
+	role := &models.Role{}
+	if err := encoding.JSONUnmarshal(resource, role); err != nil {
+		log.Error("unmarshal role error", logger.String("key", key), logger.Error(err))
+		return
+	}
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	l.m.roles[role.Name] = role
+}
+
+// OnDelete removes the role when role dropped.
+func (l *roleListener) OnDelete(key string) {
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	delete(l.m.roles, path.Base(key))
+}
+
+// tokenListener listens the changes of api tokens.
+type tokenListener struct {
+	m *manager
+}
+
+// OnCreate caches the api token when token created.
+func (l *tokenListener) OnCreate(key string, resource []byte) {
+	token := &models.APIToken{}
+	if err := encoding.JSONUnmarshal(resource, token); err != nil {
+		log.Error("unmarshal api token error", logger.String("key", key), logger.Error(err))
+		return
+	}
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	l.deleteByName(token.Name)
+	l.m.tokens[token.ID] = token
+}
+
+// OnDelete removes the api token when token dropped.
+func (l *tokenListener) OnDelete(key string) {
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	l.deleteByName(path.Base(key))
+}
+
+// deleteByName removes the api token by name, because the key of repo is the name of token.
+func (l *tokenListener) deleteByName(name string) {
+	for id, token := range l.m.tokens {
+		if token.Name == name {
+			delete(l.m.tokens, id)
+		}
+	}
+}
+
+// HashPassword returns the hash of password which is stored in repo.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// ComparePassword compares the hash of password with plain password, returns nil if matched.
+func ComparePassword(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
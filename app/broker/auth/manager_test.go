@@ -0,0 +1,294 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func newTestCfg() config.Auth {
+	return config.Auth{
+		Enable:   true,
+		TokenTTL: ltoml.Duration(time.Hour),
+		Admin:    config.User{UserName: "admin", Password: "admin123"},
+	}
+}
+
+func newTestManager() *manager {
+	m := NewManager(newTestCfg(), nil, nil).(*manager)
+	m.signingKey = []byte("signing-key")
+	return m
+}
+
+func newRequest(authorization string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/exec", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return req
+}
+
+func basicAuth(userName, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(userName+":"+password))
+}
+
+func TestManager_Disabled(t *testing.T) {
+	m := NewManager(config.Auth{}, nil, nil)
+	assert.NoError(t, m.Start())
+	ctx, err := m.Authenticate(newRequest(""))
+	assert.NoError(t, err)
+	_, ok := SubjectFromContext(ctx)
+	assert.False(t, ok)
+	_, err = m.Login("admin", "admin123")
+	assert.Error(t, err)
+	m.Stop()
+}
+
+func TestManager_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	factory := discovery.NewMockFactory(ctrl)
+	userDiscovery := discovery.NewMockDiscovery(ctrl)
+	roleDiscovery := discovery.NewMockDiscovery(ctrl)
+	tokenDiscovery := discovery.NewMockDiscovery(ctrl)
+	factory.EXPECT().CreateDiscovery(constants.UserPath, gomock.Any()).Return(userDiscovery).AnyTimes()
+	factory.EXPECT().CreateDiscovery(constants.RolePath, gomock.Any()).Return(roleDiscovery).AnyTimes()
+	factory.EXPECT().CreateDiscovery(constants.TokenPath, gomock.Any()).Return(tokenDiscovery).AnyTimes()
+
+	m := NewManager(newTestCfg(), repo, factory)
+	// generate signing key failure
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.SigningKeyPath, gomock.Any(), gomock.Any()).
+		Return(false, fmt.Errorf("err"))
+	assert.Error(t, m.Start())
+	// signing key generated by other broker, load failure
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.SigningKeyPath, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+			return false, check([]byte("key"))
+		}).AnyTimes()
+	repo.EXPECT().Get(gomock.Any(), constants.SigningKeyPath).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, m.Start())
+	repo.EXPECT().Get(gomock.Any(), constants.SigningKeyPath).Return(nil, nil)
+	assert.Error(t, m.Start())
+
+	repo.EXPECT().Get(gomock.Any(), constants.SigningKeyPath).Return([]byte("key"), nil).AnyTimes()
+	userDiscovery.EXPECT().Discovery(true).Return(fmt.Errorf("err"))
+	assert.Error(t, m.Start())
+	assert.Equal(t, []byte("key"), m.(*manager).signingKey)
+
+	userDiscovery.EXPECT().Discovery(true).Return(nil)
+	roleDiscovery.EXPECT().Discovery(true).Return(nil)
+	tokenDiscovery.EXPECT().Discovery(true).Return(nil)
+	assert.NoError(t, m.Start())
+
+	userDiscovery.EXPECT().Close()
+	roleDiscovery.EXPECT().Close()
+	tokenDiscovery.EXPECT().Close()
+	m.Stop()
+}
+
+func TestManager_Authenticate(t *testing.T) {
+	m := newTestManager()
+	users := &userListener{m: m}
+	roles := &roleListener{m: m}
+	hash, err := HashPassword("pwd")
+	assert.NoError(t, err)
+	users.OnCreate(constants.GetUserPath("u1"), encoding.JSONMarshal(&models.User{
+		Name:       "u1",
+		Password:   hash,
+		Roles:      []string{"r1", "r2"},
+		Privileges: models.Privileges{{Permission: models.PermissionRead, Database: "db"}},
+	}))
+	users.OnCreate(constants.GetUserPath("u2"), []byte("abc"))
+	roles.OnCreate(constants.GetRolePath("r1"), encoding.JSONMarshal(&models.Role{
+		Name:       "r1",
+		Privileges: models.Privileges{{Permission: models.PermissionWrite, Database: "db"}},
+	}))
+	roles.OnCreate(constants.GetRolePath("r2"), []byte("abc"))
+	assert.Len(t, m.users, 1)
+	assert.Len(t, m.roles, 1)
+
+	// missing credential
+	_, err = m.Authenticate(newRequest(""))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	// built-in admin
+	ctx, err := m.Authenticate(newRequest(basicAuth("admin", "admin123")))
+	assert.NoError(t, err)
+	subject, ok := SubjectFromContext(ctx)
+	assert.True(t, ok)
+	assert.True(t, subject.Admin)
+	_, err = m.Authenticate(newRequest(basicAuth("admin", "admin")))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	_, err = m.Authenticate(newRequest(basicAuth("", "")))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	// user
+	for i := 0; i < 2; i++ {
+		// second time hits verified cache
+		ctx, err = m.Authenticate(newRequest(basicAuth("u1", "pwd")))
+		assert.NoError(t, err)
+		subject, _ = SubjectFromContext(ctx)
+		assert.False(t, subject.Admin)
+		assert.True(t, subject.Can(models.PermissionRead, "db", "ns"))
+		assert.True(t, subject.Can(models.PermissionWrite, "db", "ns"))
+		assert.False(t, subject.Can(models.PermissionWrite, "db2", "ns"))
+		assert.Equal(t, basicAuth("u1", "pwd"), subject.Credential)
+	}
+	_, err = m.Authenticate(newRequest(basicAuth("u1", "pwd1")))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	_, err = m.Authenticate(newRequest(basicAuth("u3", "pwd")))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+
+	// token
+	token, err := m.Login("u1", "pwd")
+	assert.NoError(t, err)
+	ctx, err = m.Authenticate(newRequest("Bearer " + token))
+	assert.NoError(t, err)
+	subject, _ = SubjectFromContext(ctx)
+	assert.Equal(t, "u1", subject.Name)
+	token, err = m.Login("admin", "admin123")
+	assert.NoError(t, err)
+	ctx, err = m.Authenticate(newRequest(token))
+	assert.NoError(t, err)
+	subject, _ = SubjectFromContext(ctx)
+	assert.True(t, subject.Admin)
+	_, err = m.Authenticate(newRequest("Bearer abc"))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	_, err = m.Login("u1", "pwd1")
+	assert.Error(t, err)
+
+	// user dropped, token invalid
+	token, err = m.Login("u1", "pwd")
+	assert.NoError(t, err)
+	users.OnDelete(constants.GetUserPath("u1"))
+	_, err = m.Authenticate(newRequest("Bearer " + token))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	// token of user which not exist
+	token, err = middleware.CreateToken("u1", 1, m.signingKey, time.Minute)
+	assert.NoError(t, err)
+	_, err = m.validateToken(token)
+	assert.Error(t, err)
+	m.users["u1"] = &models.User{Name: "u1", Password: hash, Generation: 1}
+	_, err = m.validateToken(token)
+	assert.NoError(t, err)
+	// token revoked
+	m.users["u1"] = &models.User{Name: "u1", Password: hash, Generation: 2}
+	_, err = m.validateToken(token)
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	// token signed by other key
+	token, err = middleware.CreateToken("u1", 2, []byte(hash), time.Minute)
+	assert.NoError(t, err)
+	_, err = m.validateToken(token)
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	delete(m.users, "u1")
+
+	roles.OnDelete(constants.GetRolePath("r1"))
+	assert.Empty(t, m.roles)
+}
+
+func TestManager_APIToken(t *testing.T) {
+	m := newTestManager()
+	tokens := &tokenListener{m: m}
+	secret := "secret"
+	token := &models.APIToken{
+		Name:        "t1",
+		ID:          "id1",
+		Hash:        hashSecret(secret),
+		Database:    "db",
+		Namespace:   "ns",
+		Permissions: []models.Permission{models.PermissionWrite},
+	}
+	tokens.OnCreate(constants.GetTokenPath("t1"), encoding.JSONMarshal(token))
+	tokens.OnCreate(constants.GetTokenPath("t2"), []byte("abc"))
+	assert.Len(t, m.tokens, 1)
+
+	ctx, err := m.Authenticate(newRequest("Bearer lin_id1_" + secret))
+	assert.NoError(t, err)
+	subject, _ := SubjectFromContext(ctx)
+	assert.Equal(t, "t1", subject.Token)
+	assert.False(t, subject.Admin)
+	assert.True(t, subject.Can(models.PermissionWrite, "db", "ns"))
+	assert.False(t, subject.Can(models.PermissionRead, "db", "ns"))
+	assert.False(t, subject.Can(models.PermissionWrite, "db", "ns2"))
+	RecordWrittenRows(ctx, 10)
+	RecordWrittenRows(context.TODO(), 10)
+
+	for _, invalid := range []string{"lin_id1_secret2", "lin_id2_" + secret, "lin_id1"} {
+		_, err = m.Authenticate(newRequest("Bearer " + invalid))
+		assert.ErrorIs(t, err, constants.ErrUnauthorized, invalid)
+	}
+
+	// token re-created with same name
+	token.ID = "id2"
+	tokens.OnCreate(constants.GetTokenPath("t1"), encoding.JSONMarshal(token))
+	assert.Len(t, m.tokens, 1)
+	_, err = m.Authenticate(newRequest("Bearer lin_id1_" + secret))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+
+	// token dropped
+	tokens.OnDelete(constants.GetTokenPath("t1"))
+	assert.Empty(t, m.tokens)
+	_, err = m.Authenticate(newRequest("Bearer lin_id2_" + secret))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+}
+
+func TestManager_Login_token_failure(t *testing.T) {
+	defer func() {
+		createTokenFn = middleware.CreateToken
+	}()
+	createTokenFn = func(_ string, _ int64, _ []byte, _ time.Duration) (string, error) {
+		return "", fmt.Errorf("err")
+	}
+	m := newTestManager()
+	_, err := m.Login("admin", "admin123")
+	assert.Error(t, err)
+}
+
+func TestPassword(t *testing.T) {
+	hash, err := HashPassword("pwd")
+	assert.NoError(t, err)
+	assert.NoError(t, ComparePassword(hash, "pwd"))
+	assert.Error(t, ComparePassword(hash, "pwd1"))
+}
+
+func TestSubjectFromContext(t *testing.T) {
+	_, ok := SubjectFromContext(context.TODO())
+	assert.False(t, ok)
+	ctx := WithSubject(context.TODO(), &Subject{Name: "u1"})
+	subject, ok := SubjectFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "u1", subject.Name)
+}
@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/lindb/lindb/models"
+)
+
+// subjectKey represents the context key of authenticated subject.
+type subjectKey struct{}
+
+// Subject represents the authenticated user of request, includes the privileges of its roles.
+type Subject struct {
+	Name string
+	// Admin represents the user has admin role, which has all privileges.
+	Admin      bool
+	Privileges models.Privileges
+	// Token represents the name of api token if request is authenticated by api token.
+	Token string
+	// Credential represents the raw credential(authorization header) of request, used for forwarding request.
+	Credential string
+}
+
+// Can checks if subject has the permission on database/namespace.
+func (s *Subject) Can(permission models.Permission, database, namespace string) bool {
+	return s.Admin || s.Privileges.Match(permission, database, namespace)
+}
+
+// WithSubject returns the context with authenticated subject.
+func WithSubject(ctx context.Context, subject *Subject) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// SubjectFromContext returns the authenticated subject of context, returns false if auth disabled.
+func SubjectFromContext(ctx context.Context) (*Subject, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(*Subject)
+	return subject, ok && subject != nil
+}
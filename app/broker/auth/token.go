@@ -0,0 +1,150 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/validate"
+)
+
+const (
+	// apiTokenPrefix represents the prefix of api token, format: lin_<id>_<secret>.
+	apiTokenPrefix = "lin_"
+	apiTokenIDLen  = 8
+	apiTokenLen    = 24
+)
+
+// for testing
+var (
+	randReadFn = rand.Read
+)
+
+var apiTokenStatistics = metrics.NewAPITokenStatistics()
+
+// CreateAPIToken generates the secret of api token, then stores the token with the hash of secret,
+// returns the plain token which cannot be retrieved again.
+func CreateAPIToken(ctx context.Context, repo state.Repository, token *models.APIToken) (string, error) {
+	token.Name = strings.TrimSpace(token.Name)
+	if token.Name == "" || strings.Contains(token.Name, "/") {
+		return "", fmt.Errorf("invalid token name '%s'", token.Name)
+	}
+	if err := validate.Validator.Struct(token); err != nil {
+		return "", err
+	}
+	id, err := randomHex(apiTokenIDLen)
+	if err != nil {
+		return "", err
+	}
+	secret, err := randomHex(apiTokenLen)
+	if err != nil {
+		return "", err
+	}
+	token.ID = id
+	token.Hash = hashSecret(secret)
+	token.CreatedAt = timeutil.Now()
+	log.Info("Creating api token", logger.String("token", token.Name),
+		logger.String("database", token.Database), logger.String("namespace", token.Namespace))
+	ok, err := repo.PutWithTX(ctx, constants.GetTokenPath(token.Name), encoding.JSONMarshal(token), func(_ []byte) error {
+		return constants.ErrTokenExist
+	})
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", constants.ErrTokenExist
+	}
+	return apiTokenPrefix + id + "_" + secret, nil
+}
+
+// ListAPITokens lists all api tokens without the hash of secret.
+func ListAPITokens(ctx context.Context, repo state.Repository) (models.APITokens, error) {
+	values, err := repo.List(ctx, constants.TokenPath)
+	if err != nil {
+		return nil, err
+	}
+	var tokens models.APITokens
+	for _, val := range values {
+		token := &models.APIToken{}
+		if err := encoding.JSONUnmarshal(val.Value, token); err != nil {
+			log.Warn("unmarshal api token error", logger.String("token", val.Key))
+			continue
+		}
+		token.Hash = ""
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// DropAPIToken drops(revokes) api token by name, the token is invalid immediately.
+func DropAPIToken(ctx context.Context, repo state.Repository, name string) error {
+	path := constants.GetTokenPath(strings.TrimSpace(name))
+	if _, err := repo.Get(ctx, path); err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return constants.ErrTokenNotFound
+		}
+		return err
+	}
+	log.Info("Dropping api token", logger.String("token", name))
+	return repo.Delete(ctx, path)
+}
+
+// RecordWrittenRows records the number of rows written by api token of context.
+func RecordWrittenRows(ctx context.Context, rows int) {
+	if subject, ok := SubjectFromContext(ctx); ok && subject.Token != "" {
+		apiTokenStatistics.WrittenRows.WithTagValues(subject.Token).Add(float64(rows))
+	}
+}
+
+// isAPIToken checks if token is api token(not issued by login api).
+func isAPIToken(token string) bool {
+	return strings.HasPrefix(token, apiTokenPrefix)
+}
+
+// parseAPIToken parses the id/secret of api token.
+func parseAPIToken(token string) (id, secret string, ok bool) {
+	return strings.Cut(strings.TrimPrefix(token, apiTokenPrefix), "_")
+}
+
+// hashSecret returns the hash of token secret, sha256 is enough for random secret.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomHex returns the random bytes as hex string.
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := randReadFn(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
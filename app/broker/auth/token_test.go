@@ -0,0 +1,143 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func newTestToken() *models.APIToken {
+	return &models.APIToken{
+		Name:        "t1",
+		Database:    "db",
+		Permissions: []models.Permission{models.PermissionWrite},
+	}
+}
+
+func TestCreateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	// invalid name
+	_, err := CreateAPIToken(context.TODO(), repo, &models.APIToken{Name: "a/b"})
+	assert.Error(t, err)
+	// invalid token
+	_, err = CreateAPIToken(context.TODO(), repo, &models.APIToken{Name: "t1"})
+	assert.Error(t, err)
+	// put failure
+	repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("err"))
+	_, err = CreateAPIToken(context.TODO(), repo, newTestToken())
+	assert.Error(t, err)
+	// token exist
+	repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+			return false, check(nil)
+		})
+	_, err = CreateAPIToken(context.TODO(), repo, newTestToken())
+	assert.ErrorIs(t, err, constants.ErrTokenExist)
+	repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+	_, err = CreateAPIToken(context.TODO(), repo, newTestToken())
+	assert.ErrorIs(t, err, constants.ErrTokenExist)
+	// create successfully
+	var stored []byte
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.GetTokenPath("t1"), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+			stored = data
+			return true, nil
+		})
+	plain, err := CreateAPIToken(context.TODO(), repo, newTestToken())
+	assert.NoError(t, err)
+	assert.True(t, isAPIToken(plain))
+	assert.False(t, strings.Contains(string(stored), plain))
+	token := &models.APIToken{}
+	assert.NoError(t, encoding.JSONUnmarshal(stored, token))
+	id, secret, ok := parseAPIToken(plain)
+	assert.True(t, ok)
+	assert.Equal(t, token.ID, id)
+	assert.Equal(t, token.Hash, hashSecret(secret))
+	assert.NotZero(t, token.CreatedAt)
+}
+
+func TestCreateAPIToken_rand_failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		randReadFn = rand.Read
+		ctrl.Finish()
+	}()
+
+	repo := state.NewMockRepository(ctrl)
+	cases := []int{0, 1}
+	for _, failAt := range cases {
+		count := 0
+		randReadFn = func(b []byte) (int, error) {
+			if count == failAt {
+				return 0, fmt.Errorf("err")
+			}
+			count++
+			return len(b), nil
+		}
+		_, err := CreateAPIToken(context.TODO(), repo, newTestToken())
+		assert.Error(t, err)
+	}
+}
+
+func TestListAPITokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().List(gomock.Any(), constants.TokenPath).Return(nil, fmt.Errorf("err"))
+	_, err := ListAPITokens(context.TODO(), repo)
+	assert.Error(t, err)
+
+	repo.EXPECT().List(gomock.Any(), constants.TokenPath).Return([]state.KeyValue{
+		{Key: "t1", Value: []byte(`{"name":"t1","hash":"abc"}`)},
+		{Key: "t2", Value: []byte("[]")},
+	}, nil)
+	tokens, err := ListAPITokens(context.TODO(), repo)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Hash)
+}
+
+func TestDropAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).Return(nil, state.ErrNotExist)
+	assert.ErrorIs(t, DropAPIToken(context.TODO(), repo, "t1"), constants.ErrTokenNotFound)
+	repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, DropAPIToken(context.TODO(), repo, "t1"))
+	repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).Return([]byte("{}"), nil)
+	repo.EXPECT().Delete(gomock.Any(), constants.GetTokenPath("t1")).Return(nil)
+	assert.NoError(t, DropAPIToken(context.TODO(), repo, "t1"))
+}
@@ -20,9 +20,12 @@ package deps
 import (
 	"context"
 
+	"github.com/lindb/lindb/app/broker/auth"
+	"github.com/lindb/lindb/app/broker/tenant"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/state"
@@ -45,8 +48,16 @@ type HTTPDeps struct {
 	CM            replica.ChannelManager
 	IngestLimiter *concurrent.Limiter
 	QueryLimiter  *concurrent.Limiter
+	// QueryAdmission limits metric query by workload class.
+	QueryAdmission brokerQuery.AdmissionController
 
 	QueryFactory brokerQuery.Factory
+	// AuthMgr authenticates the request of broker api.
+	AuthMgr auth.Manager
+	// TenantMgr enforces the quotas of tenant for ingestion/query.
+	TenantMgr tenant.Manager
+	// Audit records the audit entries of state-mutating operations.
+	Audit audit.Recorder
 
 	GlobalKeyValues tag.Tags
 }
@@ -0,0 +1,300 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/validate"
+)
+
+// alertNameLabel represents the label of alert which value is the name of rule.
+const alertNameLabel = "alertname"
+
+// alertRule represents the scheduled alert rule, which evaluates the query at the end of each interval,
+// then moves the alert of each series through pending/firing/resolved, the state is persisted in repo.
+type alertRule struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	rule   *models.AlertRule
+	cfg    *SchedulerCfg
+	state  *models.AlertRuleState
+
+	running    sync.WaitGroup
+	statistics *metrics.AlertRuleStatistics
+}
+
+// newAlertRuleRunner creates the alert rule runner from rule definition.
+func newAlertRuleRunner(ctx context.Context, resource []byte, cfg *SchedulerCfg) (ruleRunner, error) {
+	rule := &models.AlertRule{}
+	if err := encoding.JSONUnmarshal(resource, rule); err != nil {
+		return nil, err
+	}
+	if err := validate.Validator.Struct(rule); err != nil {
+		return nil, err
+	}
+	return newAlertRule(ctx, rule, cfg), nil
+}
+
+// newAlertRule creates an alert rule runner.
+func newAlertRule(ctx context.Context, rule *models.AlertRule, cfg *SchedulerCfg) *alertRule {
+	c, cancel := context.WithCancel(ctx)
+	return &alertRule{
+		ctx:    c,
+		cancel: cancel,
+		rule:   rule,
+		cfg:    cfg,
+		state: &models.AlertRuleState{
+			Rule:   rule.Name,
+			Alerts: make(map[string]*models.Alert),
+		},
+		statistics: metrics.NewAlertRuleStatistics(rule.Name),
+	}
+}
+
+// start starts the evaluation loop of alert rule.
+func (r *alertRule) start() {
+	r.running.Add(1)
+	go func() {
+		defer r.running.Done()
+		r.run()
+	}()
+}
+
+// stop stops the evaluation loop of alert rule, waits until the running evaluation finished.
+func (r *alertRule) stop() {
+	r.cancel()
+	r.running.Wait()
+}
+
+// drop stops the alert rule, then deletes the persisted state.
+// NOTICE: must delete state after the evaluation loop exited, else the running evaluation may rewrite it.
+func (r *alertRule) drop(ctx context.Context) {
+	r.stop()
+	if err := r.cfg.Repo.Delete(ctx, constants.GetAlertStatePath(r.rule.Name)); err != nil {
+		log.Warn("delete alert rule state failure",
+			logger.String("rule", r.rule.Name), logger.Error(err))
+	}
+}
+
+// run restores the persisted state, then evaluates the rule at evaluation delay after each interval boundary.
+func (r *alertRule) run() {
+	r.loadState()
+	runEvery(r.ctx, r.rule.Interval, r.cfg.EvaluationDelay, func(end int64) {
+		if err := r.evaluate(end); err != nil {
+			log.Warn("evaluate alert rule failure",
+				logger.String("rule", r.rule.Name), logger.Error(err))
+		}
+	})
+}
+
+// loadState restores the alert state persisted by previous leader, so that pending/firing alerts survive fail over.
+func (r *alertRule) loadState() {
+	data, err := r.cfg.Repo.Get(r.ctx, constants.GetAlertStatePath(r.rule.Name))
+	if err != nil {
+		return
+	}
+	ruleState := &models.AlertRuleState{}
+	if err := encoding.JSONUnmarshal(data, ruleState); err != nil {
+		log.Warn("unmarshal alert state error, ignore it",
+			logger.String("rule", r.rule.Name), logger.Error(err))
+		return
+	}
+	ruleState.Rule = r.rule.Name
+	if ruleState.Alerts == nil {
+		ruleState.Alerts = make(map[string]*models.Alert)
+	}
+	r.state = ruleState
+}
+
+// saveState persists the alert state into repo.
+func (r *alertRule) saveState() {
+	if r.ctx.Err() != nil {
+		// rule is stopped(maybe dropped), cannot write state back
+		return
+	}
+	if err := r.cfg.Repo.Put(r.ctx, constants.GetAlertStatePath(r.rule.Name), encoding.JSONMarshal(r.state)); err != nil {
+		log.Warn("persist alert state failure",
+			logger.String("rule", r.rule.Name), logger.Error(err))
+	}
+}
+
+// evaluate executes the query of rule with time range [end-interval, end), transits the alerts,
+// then sends notification for the alerts which status changed, the alerts not delivered are re-sent
+// in next evaluation until delivered.
+func (r *alertRule) evaluate(end int64) (err error) {
+	start := time.Now()
+	r.statistics.Evaluations.Incr()
+	defer func() {
+		r.state.LastEvaluation = end
+		r.state.LastError = ""
+		if err != nil {
+			r.statistics.EvaluationFailures.Incr()
+			r.state.LastError = err.Error()
+		}
+		r.saveState()
+		r.statistics.Duration.UpdateSince(start)
+	}()
+
+	rs, err := executeQuery(r.ctx, r.cfg, r.rule.Database, r.rule.SQL, end-r.rule.Interval, end)
+	if err != nil {
+		return err
+	}
+	alerts := r.transit(end, r.matchAlerts(rs))
+	if len(alerts) == 0 {
+		return nil
+	}
+	if r.rule.Webhook != "" {
+		if err := notifyFn(r.ctx, r.rule, alerts); err != nil {
+			r.statistics.NotificationFailures.Incr()
+			return fmt.Errorf("send alert notification failure: %w", err)
+		}
+		r.statistics.Notifications.Incr()
+	}
+	for _, alert := range alerts {
+		alert.Notified = true
+	}
+	return nil
+}
+
+// matchAlerts returns the series which matches the condition of rule, key: alert key of series.
+func (r *alertRule) matchAlerts(rs *models.ResultSet) map[string]*models.Alert {
+	active := make(map[string]*models.Alert)
+	if rs == nil {
+		return active
+	}
+	for _, series := range rs.Series {
+		value, ok := r.matchSeries(series)
+		if !ok {
+			continue
+		}
+		labels := make(map[string]string)
+		for tagKey, tagValue := range series.Tags {
+			if tagValue != "" {
+				labels[tagKey] = tagValue
+			}
+		}
+		for key, val := range r.rule.Labels {
+			labels[key] = val
+		}
+		labels[alertNameLabel] = r.rule.Name
+		active[models.AlertKey(labels)] = &models.Alert{Labels: labels, Value: value}
+	}
+	return active
+}
+
+// matchSeries checks if the latest value of field matches the condition, all fields are checked if field not set.
+func (r *alertRule) matchSeries(series *models.Series) (float64, bool) {
+	condition := &r.rule.Condition
+	fieldNames := make([]string, 0, len(series.Fields))
+	if condition.Field != "" {
+		fieldNames = append(fieldNames, condition.Field)
+	} else {
+		for fieldName := range series.Fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+	}
+	for _, fieldName := range fieldNames {
+		value, ok := latestValue(series.Fields[fieldName])
+		if ok && condition.Match(value) {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// latestValue returns the value of latest point, ignores NaN/Inf value.
+func latestValue(points map[int64]float64) (value float64, ok bool) {
+	latest := int64(math.MinInt64)
+	for timestamp, val := range points {
+		if math.IsNaN(val) || math.IsInf(val, 0) || timestamp < latest {
+			continue
+		}
+		latest = timestamp
+		value = val
+		ok = true
+	}
+	return value, ok
+}
+
+// transit moves the alerts of rule through pending/firing/resolved based on active alerts of this evaluation,
+// returns the alerts which need to be notified(status changed or not delivered).
+func (r *alertRule) transit(end int64, active map[string]*models.Alert) (notifications []*models.Alert) {
+	alerts := r.state.Alerts
+	for key, alert := range alerts {
+		_, isActive := active[key]
+		switch {
+		case alert.Status == models.AlertResolved:
+			if alert.Notified || isActive {
+				// resolved alert has been delivered, or becomes active again(re-created as pending)
+				delete(alerts, key)
+				continue
+			}
+			// re-send resolved alert which isn't delivered
+			notifications = append(notifications, alert)
+		case isActive:
+		case alert.Status == models.AlertFiring:
+			alert.Status = models.AlertResolved
+			alert.ResolvedAt = end
+			alert.Notified = false
+			notifications = append(notifications, alert)
+		default:
+			delete(alerts, key)
+		}
+	}
+	for key, current := range active {
+		alert, ok := alerts[key]
+		if !ok {
+			alert = &models.Alert{
+				Status:   models.AlertPending,
+				Labels:   current.Labels,
+				ActiveAt: end,
+			}
+			alerts[key] = alert
+		}
+		alert.Value = current.Value
+		switch {
+		case alert.Status == models.AlertPending && end-alert.ActiveAt >= r.rule.For:
+			alert.Status = models.AlertFiring
+			alert.FiredAt = end
+			alert.Notified = false
+			notifications = append(notifications, alert)
+		case alert.Status == models.AlertFiring && !alert.Notified:
+			// re-send firing alert which isn't delivered
+			notifications = append(notifications, alert)
+		case alert.Status == models.AlertFiring && r.rule.Format == models.NotifyAlertmanager:
+			// Alertmanager resolves the alert which isn't re-sent, so re-send firing alerts each evaluation
+			notifications = append(notifications, alert)
+		}
+	}
+	sort.Slice(notifications, func(i, j int) bool {
+		return models.AlertKey(notifications[i].Labels) < models.AlertKey(notifications[j].Labels)
+	})
+	return notifications
+}
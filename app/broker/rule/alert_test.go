@@ -0,0 +1,344 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestAlertRule() *models.AlertRule {
+	return &models.AlertRule{
+		Name:      "high-cpu",
+		Database:  "test",
+		SQL:       "select max(usage) from cpu group by host",
+		Condition: models.AlertCondition{Field: "usage", Operator: ">", Threshold: 90},
+		Interval:  timeutil.OneMinute,
+		For:       2 * timeutil.OneMinute,
+		Labels:    map[string]string{"severity": "critical"},
+		Webhook:   "http://localhost/hook",
+	}
+}
+
+func TestNewAlertRuleRunner(t *testing.T) {
+	r, err := newAlertRuleRunner(context.TODO(), []byte("abc"), &SchedulerCfg{})
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	r, err = newAlertRuleRunner(context.TODO(), encoding.JSONMarshal(&models.AlertRule{Name: "a"}), &SchedulerCfg{})
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	r, err = newAlertRuleRunner(context.TODO(), encoding.JSONMarshal(newTestAlertRule()), &SchedulerCfg{})
+	assert.NoError(t, err)
+	assert.NotNil(t, r)
+}
+
+func TestAlertRule_loadState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	r := newAlertRule(context.TODO(), newTestAlertRule(), &SchedulerCfg{Repo: repo})
+	path := constants.GetAlertStatePath("high-cpu")
+	repo.EXPECT().Get(gomock.Any(), path).Return(nil, state.ErrNotExist)
+	r.loadState()
+	assert.Empty(t, r.state.Alerts)
+	repo.EXPECT().Get(gomock.Any(), path).Return([]byte("abc"), nil)
+	r.loadState()
+	assert.Empty(t, r.state.Alerts)
+	repo.EXPECT().Get(gomock.Any(), path).Return(encoding.JSONMarshal(&models.AlertRuleState{}), nil)
+	r.loadState()
+	assert.Equal(t, "high-cpu", r.state.Rule)
+	assert.NotNil(t, r.state.Alerts)
+	repo.EXPECT().Get(gomock.Any(), path).Return(encoding.JSONMarshal(&models.AlertRuleState{
+		Rule:   "high-cpu",
+		Alerts: map[string]*models.Alert{"host=1": {Status: models.AlertFiring}},
+	}), nil)
+	r.loadState()
+	assert.Equal(t, models.AlertFiring, r.state.Alerts["host=1"].Status)
+}
+
+func TestAlertRule_evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		notifyFn = notify
+		ctrl.Finish()
+	}()
+	queryFactory := brokerQuery.NewMockFactory(ctrl)
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	admission := brokerQuery.NewMockAdmissionController(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	admission.EXPECT().Timeout(models.AlertingQuery).Return(time.Second).AnyTimes()
+	admission.EXPECT().Admit(gomock.Any(), models.AlertingQuery).Return(func() {}, nil).AnyTimes()
+	queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).Return(metricQuery).AnyTimes()
+	var saved *models.AlertRuleState
+	repo.EXPECT().Put(gomock.Any(), constants.GetAlertStatePath("high-cpu"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, val []byte) error {
+			saved = &models.AlertRuleState{}
+			return encoding.JSONUnmarshal(val, saved)
+		}).AnyTimes()
+	var notified [][]*models.Alert
+	notifyFn = func(_ context.Context, _ *models.AlertRule, alerts []*models.Alert) error {
+		notified = append(notified, alerts)
+		return nil
+	}
+
+	r := newAlertRule(context.TODO(), newTestAlertRule(), &SchedulerCfg{
+		Repo:           repo,
+		QueryFactory:   queryFactory,
+		QueryAdmission: admission,
+	})
+	high := &models.ResultSet{Series: []*models.Series{
+		{
+			Tags:   map[string]string{"host": "1.1.1.1"},
+			Fields: map[string]map[int64]float64{"usage": {10: 80, 20: 95}},
+		},
+		{
+			Tags:   map[string]string{"host": "1.1.1.2"},
+			Fields: map[string]map[int64]float64{"usage": {10: 95, 20: 10}},
+		},
+	}}
+	key := models.AlertKey(map[string]string{"alertname": "high-cpu", "host": "1.1.1.1", "severity": "critical"})
+	evaluate := func(end int64, rs *models.ResultSet, err error) error {
+		metricQuery.EXPECT().WaitResponse().Return(rs, err)
+		return r.evaluate(end)
+	}
+
+	// query failure
+	assert.Error(t, evaluate(timeutil.OneMinute, nil, fmt.Errorf("err")))
+	assert.Equal(t, "err", saved.LastError)
+	assert.Equal(t, timeutil.OneMinute, saved.LastEvaluation)
+	// pending
+	assert.NoError(t, evaluate(2*timeutil.OneMinute, high, nil))
+	assert.Empty(t, saved.LastError)
+	assert.Len(t, saved.Alerts, 1)
+	assert.Equal(t, models.AlertPending, saved.Alerts[key].Status)
+	assert.Equal(t, 95.0, saved.Alerts[key].Value)
+	assert.Empty(t, notified)
+	// keep pending
+	assert.NoError(t, evaluate(3*timeutil.OneMinute, high, nil))
+	assert.Equal(t, models.AlertPending, saved.Alerts[key].Status)
+	assert.Empty(t, notified)
+	// firing
+	assert.NoError(t, evaluate(4*timeutil.OneMinute, high, nil))
+	assert.Equal(t, models.AlertFiring, saved.Alerts[key].Status)
+	assert.Equal(t, 4*timeutil.OneMinute, saved.Alerts[key].FiredAt)
+	assert.Len(t, notified, 1)
+	// keep firing, no notification
+	assert.NoError(t, evaluate(5*timeutil.OneMinute, high, nil))
+	assert.Len(t, notified, 1)
+	// resolved
+	assert.NoError(t, evaluate(6*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Equal(t, models.AlertResolved, saved.Alerts[key].Status)
+	assert.Equal(t, 6*timeutil.OneMinute, saved.Alerts[key].ResolvedAt)
+	assert.Len(t, notified, 2)
+	// resolved alert removed
+	assert.NoError(t, evaluate(7*timeutil.OneMinute, nil, nil))
+	assert.Empty(t, saved.Alerts)
+	// pending alert removed if condition not matched
+	assert.NoError(t, evaluate(8*timeutil.OneMinute, high, nil))
+	assert.Len(t, saved.Alerts, 1)
+	assert.NoError(t, evaluate(9*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Empty(t, saved.Alerts)
+	assert.Len(t, notified, 2)
+
+	// notify failure
+	r.rule.For = 0
+	notifyFn = func(_ context.Context, _ *models.AlertRule, _ []*models.Alert) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, evaluate(10*timeutil.OneMinute, high, nil))
+	assert.Equal(t, models.AlertFiring, saved.Alerts[key].Status)
+	assert.False(t, saved.Alerts[key].Notified)
+	assert.Contains(t, saved.LastError, "notification")
+	// firing alert not delivered is re-sent
+	notifyOK := func(_ context.Context, _ *models.AlertRule, alerts []*models.Alert) error {
+		notified = append(notified, alerts)
+		return nil
+	}
+	notified = nil
+	notifyFn = notifyOK
+	assert.NoError(t, evaluate(11*timeutil.OneMinute, high, nil))
+	assert.Len(t, notified, 1)
+	assert.Equal(t, models.AlertFiring, notified[0][0].Status)
+	assert.True(t, saved.Alerts[key].Notified)
+	// delivered firing alert isn't re-sent
+	assert.NoError(t, evaluate(12*timeutil.OneMinute, high, nil))
+	assert.Len(t, notified, 1)
+
+	// resolved alert not delivered is kept and re-sent
+	notifyFn = func(_ context.Context, _ *models.AlertRule, _ []*models.Alert) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, evaluate(13*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Equal(t, models.AlertResolved, saved.Alerts[key].Status)
+	assert.False(t, saved.Alerts[key].Notified)
+	assert.Error(t, evaluate(14*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Equal(t, models.AlertResolved, saved.Alerts[key].Status)
+	notifyFn = notifyOK
+	assert.NoError(t, evaluate(15*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Len(t, notified, 2)
+	assert.Equal(t, models.AlertResolved, notified[1][0].Status)
+	assert.Equal(t, 13*timeutil.OneMinute, notified[1][0].ResolvedAt)
+	assert.True(t, saved.Alerts[key].Notified)
+	assert.NoError(t, evaluate(16*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Empty(t, saved.Alerts)
+	// resolved alert not delivered becomes active again, re-created as pending
+	r.rule.For = timeutil.OneMinute
+	assert.NoError(t, evaluate(17*timeutil.OneMinute, high, nil))
+	assert.NoError(t, evaluate(18*timeutil.OneMinute, high, nil))
+	notifyFn = func(_ context.Context, _ *models.AlertRule, _ []*models.Alert) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, evaluate(19*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Equal(t, models.AlertResolved, saved.Alerts[key].Status)
+	notifyFn = notifyOK
+	assert.NoError(t, evaluate(20*timeutil.OneMinute, high, nil))
+	assert.Equal(t, models.AlertPending, saved.Alerts[key].Status)
+	assert.Equal(t, 20*timeutil.OneMinute, saved.Alerts[key].ActiveAt)
+
+	// no webhook, alerts are not notified, resolved alert removed
+	r.rule.Webhook = ""
+	r.rule.For = 0
+	notified = nil
+	assert.NoError(t, evaluate(21*timeutil.OneMinute, high, nil))
+	assert.Equal(t, models.AlertFiring, saved.Alerts[key].Status)
+	assert.NoError(t, evaluate(22*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Equal(t, models.AlertResolved, saved.Alerts[key].Status)
+	assert.NoError(t, evaluate(23*timeutil.OneMinute, &models.ResultSet{}, nil))
+	assert.Empty(t, saved.Alerts)
+	assert.Empty(t, notified)
+	r.rule.Webhook = newTestAlertRule().Webhook
+
+	// alertmanager re-sends firing alerts
+	r.rule.Format = models.NotifyAlertmanager
+	assert.NoError(t, evaluate(24*timeutil.OneMinute, high, nil))
+	assert.NoError(t, evaluate(25*timeutil.OneMinute, high, nil))
+	assert.Len(t, notified, 2)
+
+	// stopped rule doesn't persist state
+	saved = nil
+	r.stop()
+	assert.NoError(t, evaluate(26*timeutil.OneMinute, high, nil))
+	assert.Nil(t, saved)
+}
+
+func TestAlertRule_run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		sqlParseFn = sql.Parse
+		ctrl.Finish()
+	}()
+	evaluated := make(chan struct{}, 1)
+	sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+		select {
+		case evaluated <- struct{}{}:
+		default:
+		}
+		return nil, fmt.Errorf("err")
+	}
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+	rule := newTestAlertRule()
+	rule.Interval = 10
+	r := newAlertRule(context.TODO(), rule, &SchedulerCfg{Repo: repo})
+	r.start()
+	<-evaluated
+	r.stop()
+}
+
+func TestAlertRule_drop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		sqlParseFn = sql.Parse
+		ctrl.Finish()
+	}()
+	evaluated := make(chan struct{}, 1)
+	sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+		select {
+		case evaluated <- struct{}{}:
+		default:
+		}
+		return nil, fmt.Errorf("err")
+	}
+	var deleted atomic.Bool
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist).AnyTimes()
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ []byte) error {
+			// running evaluation cannot rewrite the state after deleted
+			assert.False(t, deleted.Load())
+			return nil
+		}).AnyTimes()
+	rule := newTestAlertRule()
+	rule.Interval = 10
+	r := newAlertRule(context.TODO(), rule, &SchedulerCfg{Repo: repo})
+	r.start()
+	<-evaluated
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAlertStatePath(rule.Name)).DoAndReturn(
+		func(_ context.Context, _ string) error {
+			deleted.Store(true)
+			return nil
+		})
+	r.drop(context.TODO())
+
+	// delete state failure
+	r = newAlertRule(context.TODO(), rule, &SchedulerCfg{Repo: repo})
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAlertStatePath(rule.Name)).Return(fmt.Errorf("err"))
+	r.drop(context.TODO())
+}
+
+func TestAlertRule_matchSeries(t *testing.T) {
+	rule := newTestAlertRule()
+	rule.Condition.Field = ""
+	r := newAlertRule(context.TODO(), rule, &SchedulerCfg{})
+	value, ok := r.matchSeries(&models.Series{Fields: map[string]map[int64]float64{
+		"a": {10: 1},
+		"b": {10: 99, 20: math.NaN()},
+	}})
+	assert.True(t, ok)
+	assert.Equal(t, 99.0, value)
+	_, ok = r.matchSeries(&models.Series{Fields: map[string]map[int64]float64{"a": {10: math.Inf(1)}}})
+	assert.False(t, ok)
+
+	alerts := r.matchAlerts(&models.ResultSet{Series: []*models.Series{{
+		Tags:   map[string]string{"host": "1.1.1.1", "empty": "", "severity": "info"},
+		Fields: map[string]map[int64]float64{"a": {10: 100}},
+	}}})
+	assert.Len(t, alerts, 1)
+	for _, alert := range alerts {
+		// rule labels override series tags
+		assert.Equal(t, map[string]string{"alertname": "high-cpu", "host": "1.1.1.1", "severity": "critical"}, alert.Labels)
+	}
+}
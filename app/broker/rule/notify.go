@@ -0,0 +1,110 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/models"
+)
+
+// for testing
+var (
+	newRestyFn = resty.New
+	notifyFn   = notify
+)
+
+// notifyTimeout represents the timeout of sending notification.
+const notifyTimeout = 10 * time.Second
+
+// webhookPayload represents the payload of generic webhook notification.
+type webhookPayload struct {
+	Rule      string          `json:"rule"`
+	Database  string          `json:"database"`
+	Condition string          `json:"condition"`
+	Alerts    []*models.Alert `json:"alerts"`
+}
+
+// alertmanagerAlert represents the alert of Alertmanager api v2(POST /api/v2/alerts).
+type alertmanagerAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     string            `json:"startsAt,omitempty"`
+	EndsAt       string            `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// notify sends the alerts to webhook of rule, the payload is built based on the format of rule.
+func notify(ctx context.Context, rule *models.AlertRule, alerts []*models.Alert) error {
+	var body interface{}
+	switch rule.Format {
+	case models.NotifyAlertmanager:
+		body = buildAlertmanagerPayload(rule, alerts)
+	default:
+		body = &webhookPayload{
+			Rule:      rule.Name,
+			Database:  rule.Database,
+			Condition: rule.Condition.String(),
+			Alerts:    alerts,
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+	resp, err := newRestyFn().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		Post(rule.Webhook)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("status: %d, body: %s", resp.StatusCode(), resp.String())
+	}
+	return nil
+}
+
+// buildAlertmanagerPayload builds the alert list of Alertmanager, resolved alert has the end time.
+func buildAlertmanagerPayload(rule *models.AlertRule, alerts []*models.Alert) []*alertmanagerAlert {
+	rs := make([]*alertmanagerAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		amAlert := &alertmanagerAlert{
+			Labels: alert.Labels,
+			Annotations: map[string]string{
+				"value":     fmt.Sprintf("%v", alert.Value),
+				"condition": rule.Condition.String(),
+				"query":     rule.SQL,
+			},
+			StartsAt: formatRFC3339(alert.ActiveAt),
+		}
+		if alert.Status == models.AlertResolved {
+			amAlert.EndsAt = formatRFC3339(alert.ResolvedAt)
+		}
+		rs = append(rs, amAlert)
+	}
+	return rs
+}
+
+// formatRFC3339 formats the timestamp(millisecond) as RFC3339.
+func formatRFC3339(timestamp int64) string {
+	return time.UnixMilli(timestamp).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestNotify(t *testing.T) {
+	var body []byte
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	rule := newTestAlertRule()
+	rule.Webhook = server.URL
+	alerts := []*models.Alert{
+		{
+			Status:   models.AlertFiring,
+			Labels:   map[string]string{"alertname": "high-cpu", "host": "1.1.1.1"},
+			Value:    95,
+			ActiveAt: timeutil.OneMinute,
+		},
+		{
+			Status:     models.AlertResolved,
+			Labels:     map[string]string{"alertname": "high-cpu", "host": "1.1.1.2"},
+			ActiveAt:   timeutil.OneMinute,
+			ResolvedAt: 2 * timeutil.OneMinute,
+		},
+	}
+	// generic webhook
+	assert.NoError(t, notify(context.TODO(), rule, alerts))
+	payload := &webhookPayload{}
+	assert.NoError(t, json.Unmarshal(body, payload))
+	assert.Equal(t, "high-cpu", payload.Rule)
+	assert.Equal(t, "usage > 90", payload.Condition)
+	assert.Len(t, payload.Alerts, 2)
+
+	// alertmanager
+	rule.Format = models.NotifyAlertmanager
+	assert.NoError(t, notify(context.TODO(), rule, alerts))
+	var amAlerts []*alertmanagerAlert
+	assert.NoError(t, json.Unmarshal(body, &amAlerts))
+	assert.Len(t, amAlerts, 2)
+	assert.Equal(t, "1970-01-01T00:01:00Z", amAlerts[0].StartsAt)
+	assert.Empty(t, amAlerts[0].EndsAt)
+	assert.Equal(t, "95", amAlerts[0].Annotations["value"])
+	assert.Equal(t, "1970-01-01T00:02:00Z", amAlerts[1].EndsAt)
+
+	// response error
+	status = http.StatusBadRequest
+	assert.Error(t, notify(context.TODO(), rule, alerts))
+	// request error
+	rule.Webhook = "http://127.0.0.1:1"
+	assert.Error(t, notify(context.TODO(), rule, alerts))
+}
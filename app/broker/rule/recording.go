@@ -0,0 +1,200 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// recordingRule represents the scheduled recording rule, which evaluates the query at the end of each interval,
+// then writes the result back into database as the target metric.
+type recordingRule struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	rule   *models.RecordingRule
+	cfg    *SchedulerCfg
+
+	running    sync.WaitGroup
+	statistics *metrics.RecordingRuleStatistics
+}
+
+// newRecordingRule creates a recording rule runner.
+func newRecordingRule(ctx context.Context, rule *models.RecordingRule, cfg *SchedulerCfg) *recordingRule {
+	c, cancel := context.WithCancel(ctx)
+	return &recordingRule{
+		ctx:        c,
+		cancel:     cancel,
+		rule:       rule,
+		cfg:        cfg,
+		statistics: metrics.NewRecordingRuleStatistics(rule.Name),
+	}
+}
+
+// newRecordingRuleRunner creates the recording rule runner from rule definition.
+func newRecordingRuleRunner(ctx context.Context, resource []byte, cfg *SchedulerCfg) (ruleRunner, error) {
+	rule := &models.RecordingRule{}
+	if err := encoding.JSONUnmarshal(resource, rule); err != nil {
+		return nil, err
+	}
+	if rule.Interval <= 0 {
+		return nil, fmt.Errorf("invalid interval of recording rule: %d", rule.Interval)
+	}
+	return newRecordingRule(ctx, rule, cfg), nil
+}
+
+// start starts the evaluation loop of recording rule.
+func (r *recordingRule) start() {
+	r.running.Add(1)
+	go func() {
+		defer r.running.Done()
+		r.run()
+	}()
+}
+
+// stop stops the evaluation loop of recording rule, waits until the running evaluation finished.
+func (r *recordingRule) stop() {
+	r.cancel()
+	r.running.Wait()
+}
+
+// drop stops the recording rule, recording rule has no resource to clean up.
+func (r *recordingRule) drop(_ context.Context) {
+	r.stop()
+}
+
+// run evaluates the rule at evaluation delay after each interval boundary, the time range of each evaluation is the last interval.
+func (r *recordingRule) run() {
+	runEvery(r.ctx, r.rule.Interval, r.cfg.EvaluationDelay, func(end int64) {
+		if err := r.evaluate(end); err != nil {
+			log.Warn("evaluate recording rule failure",
+				logger.String("rule", r.rule.Name), logger.Error(err))
+		}
+	})
+}
+
+// evaluate executes the query of rule with time range [end-interval, end), then writes the result back.
+func (r *recordingRule) evaluate(end int64) (err error) {
+	start := time.Now()
+	r.statistics.Evaluations.Incr()
+	defer func() {
+		if err != nil {
+			r.statistics.EvaluationFailures.Incr()
+		}
+		r.statistics.Duration.UpdateSince(start)
+	}()
+
+	rs, err := executeQuery(r.ctx, r.cfg, r.rule.Database, r.rule.SQL, end-r.rule.Interval, end)
+	if err != nil {
+		return err
+	}
+	rows, err := buildRows(r.rule, rs)
+	if err != nil {
+		return err
+	}
+	defer rows.Release()
+	if rows.Len() == 0 {
+		return nil
+	}
+	// rule results count against the quotas of tenant like other writes
+	if err := r.cfg.TenantMgr.AllowWrite(r.rule.Database, rows.NumOfPoints()); err != nil {
+		return err
+	}
+	writeCtx, writeCancel := context.WithTimeout(r.ctx, r.cfg.WriteTimeout)
+	defer writeCancel()
+	if err := r.cfg.CM.Write(writeCtx, r.rule.Database, rows); err != nil {
+		return err
+	}
+	r.statistics.WrittenRows.Add(float64(rows.Len()))
+	return nil
+}
+
+// buildRows builds the rows of target metric from query result, each row represents the fields of series
+// at same timestamp, fields are written as gauge(last value), so re-evaluation overwrites the previous value.
+func buildRows(rule *models.RecordingRule, rs *models.ResultSet) (*metric.BrokerBatchRows, error) {
+	rows := metric.NewBrokerBatchRows()
+	if rs == nil {
+		return rows, nil
+	}
+	builder, releaseFunc := commonseries.NewRowBuilder()
+	defer releaseFunc(builder)
+
+	for _, series := range rs.Series {
+		points := make(map[int64]struct{})
+		fieldNames := make([]string, 0, len(series.Fields))
+		for fieldName, fieldPoints := range series.Fields {
+			fieldNames = append(fieldNames, fieldName)
+			for timestamp := range fieldPoints {
+				points[timestamp] = struct{}{}
+			}
+		}
+		sort.Strings(fieldNames)
+		for timestamp := range points {
+			builder.Reset()
+			builder.AddMetricName([]byte(rule.Metric))
+			if rule.Namespace != "" {
+				builder.AddNameSpace([]byte(rule.Namespace))
+			}
+			builder.AddTimestamp(timestamp)
+			for tagKey, tagValue := range series.Tags {
+				if tagValue == "" {
+					continue
+				}
+				if err := builder.AddTag([]byte(tagKey), []byte(tagValue)); err != nil {
+					return nil, err
+				}
+			}
+			for _, fieldName := range fieldNames {
+				value, ok := series.Fields[fieldName][timestamp]
+				if !ok || math.IsNaN(value) || math.IsInf(value, 0) {
+					continue
+				}
+				if err := builder.AddSimpleField([]byte(fieldName), flatMetricsV1.SimpleFieldTypeLast, value); err != nil {
+					return nil, err
+				}
+			}
+			if builder.SimpleFieldsLen() == 0 {
+				continue
+			}
+			data, err := builder.Build()
+			if err != nil {
+				return nil, err
+			}
+			if err := rows.TryAppend(func(row *metric.BrokerRow) error {
+				row.FromBlock(data)
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
@@ -0,0 +1,238 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/tenant"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestRecordingRule_evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		sqlParseFn = sql.Parse
+		ctrl.Finish()
+	}()
+	queryFactory := brokerQuery.NewMockFactory(ctrl)
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	admission := brokerQuery.NewMockAdmissionController(ctrl)
+	cm := replica.NewMockChannelManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
+	admission.EXPECT().Timeout(models.AlertingQuery).Return(time.Second).AnyTimes()
+
+	rule := &models.RecordingRule{
+		Name:     "r1",
+		Database: "test",
+		SQL:      "select sum(f) from cpu group by host",
+		Metric:   "cpu:f:1m",
+		Interval: timeutil.OneMinute,
+	}
+	r := newRecordingRule(context.TODO(), rule, &SchedulerCfg{
+		QueryFactory:   queryFactory,
+		QueryAdmission: admission,
+		CM:             cm,
+		TenantMgr:      tenantMgr,
+		WriteTimeout:   time.Second,
+	})
+	rs := &models.ResultSet{Series: []*models.Series{{
+		Tags:   map[string]string{"host": "1.1.1.1"},
+		Fields: map[string]map[int64]float64{"f": {timeutil.OneMinute: 10}},
+	}}}
+	release := func() {}
+
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "parse sql failure",
+			prepare: func() {
+				sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "not metric query",
+			prepare: func() {
+				sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+					return &stmtpkg.Use{}, nil
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "admit failure",
+			prepare: func() {
+				admission.EXPECT().Admit(gomock.Any(), models.AlertingQuery).Return(nil, brokerQuery.ErrQueryQueueFull)
+			},
+			wantErr: true,
+		},
+		{
+			name: "query failure",
+			prepare: func() {
+				admission.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(release, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty result",
+			prepare: func() {
+				admission.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(release, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{}, nil)
+			},
+		},
+		{
+			name: "quota exceeded",
+			prepare: func() {
+				admission.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(release, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(rs, nil)
+				tenantMgr.EXPECT().AllowWrite("test", 1).Return(fmt.Errorf("%w: ingest rate", constants.ErrQuotaExceeded))
+			},
+			wantErr: true,
+		},
+		{
+			name: "write failure",
+			prepare: func() {
+				admission.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(release, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(rs, nil)
+				tenantMgr.EXPECT().AllowWrite("test", 1).Return(nil)
+				cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "evaluate successfully",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, timeutil.TimeRange{Start: timeutil.OneMinute, End: 2*timeutil.OneMinute - 1}, q.TimeRange)
+						return metricQuery
+					})
+				admission.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(release, nil)
+				metricQuery.EXPECT().WaitResponse().Return(rs, nil)
+				tenantMgr.EXPECT().AllowWrite("test", 1).Return(nil)
+				cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, rows *metric.BrokerBatchRows) error {
+						assert.Equal(t, 1, rows.Len())
+						return nil
+					})
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				sqlParseFn = sql.Parse
+			}()
+			tt.prepare()
+			err := r.evaluate(2 * timeutil.OneMinute)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecordingRule_run(t *testing.T) {
+	defer func() {
+		sqlParseFn = sql.Parse
+	}()
+	evaluated := make(chan struct{}, 1)
+	sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+		select {
+		case evaluated <- struct{}{}:
+		default:
+		}
+		return nil, fmt.Errorf("err")
+	}
+	r := newRecordingRule(context.TODO(), &models.RecordingRule{Name: "r1", Interval: 10}, &SchedulerCfg{})
+	r.start()
+	<-evaluated
+	r.drop(context.TODO())
+}
+
+func TestBuildRows(t *testing.T) {
+	rows, err := buildRows(&models.RecordingRule{Metric: "m"}, nil)
+	assert.NoError(t, err)
+	assert.Zero(t, rows.Len())
+
+	rows, err = buildRows(&models.RecordingRule{Metric: "svc:requests:1m", Namespace: "ns"}, &models.ResultSet{
+		Series: []*models.Series{
+			{
+				Tags: map[string]string{"service": "a", "empty": ""},
+				Fields: map[string]map[int64]float64{
+					"requests": {10: 1, 20: 2, 30: math.NaN()},
+					"errors":   {10: 3},
+				},
+			},
+			{
+				Fields: map[string]map[int64]float64{"requests": {10: math.Inf(1)}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	// 30 only has NaN, series 2 only has Inf
+	assert.Equal(t, 2, rows.Len())
+	for _, row := range rows.Rows() {
+		m := row.Metric()
+		assert.Equal(t, "svc:requests:1m", string(m.Name()))
+		assert.Equal(t, "ns", string(m.Namespace()))
+		assert.Equal(t, 1, m.KeyValuesLength())
+		if m.Timestamp() == 10 {
+			assert.Equal(t, 2, m.SimpleFieldsLength())
+		} else {
+			assert.Equal(t, 1, m.SimpleFieldsLength())
+		}
+	}
+
+	// invalid tag
+	_, err = buildRows(&models.RecordingRule{Metric: "m"}, &models.ResultSet{
+		Series: []*models.Series{{
+			Tags:   map[string]string{"": "a"},
+			Fields: map[string]map[int64]float64{"f": {10: 1}},
+		}},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	sqlParseFn = sql.Parse
+	nowFn      = timeutil.Now
+)
+
+// ruleRunner represents the scheduled rule which evaluates periodically.
+type ruleRunner interface {
+	// start starts the evaluation loop of rule.
+	start()
+	// stop stops the evaluation loop of rule, waits until the running evaluation finished.
+	stop()
+	// drop stops the rule, then cleans up the resources of rule when rule deleted.
+	drop(ctx context.Context)
+}
+
+// runEvery invokes evaluate at delay after each interval boundary until ctx done,
+// end represents the boundary which evaluation is triggered by, the delay makes sure that
+// the points before the boundary are written(broker batch, replication) when evaluating.
+func runEvery(ctx context.Context, interval int64, delay time.Duration, evaluate func(end int64)) {
+	for {
+		now := nowFn()
+		end := ((now-delay.Milliseconds())/interval + 1) * interval
+		timer := time.NewTimer(time.Duration(end-now)*time.Millisecond + delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		evaluate(end)
+	}
+}
+
+// executeQuery executes the metric query of rule with time range [start, end), query is admitted as alerting workload.
+func executeQuery(ctx context.Context, cfg *SchedulerCfg, database, sql string, start, end int64) (*models.ResultSet, error) {
+	stmt, err := sqlParseFn(sql)
+	if err != nil {
+		return nil, err
+	}
+	queryStmt, ok := stmt.(*stmtpkg.Query)
+	if !ok {
+		return nil, fmt.Errorf("query of rule must be metric query")
+	}
+	queryStmt.TimeRange = timeutil.TimeRange{Start: start, End: end - 1}
+
+	queryCtx, cancel := context.WithTimeout(ctx, cfg.QueryAdmission.Timeout(models.AlertingQuery))
+	defer cancel()
+	release, err := cfg.QueryAdmission.Admit(queryCtx, models.AlertingQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	queryCtx = context.WithValue(queryCtx, constants.ContextKeyQueryClass, models.AlertingQuery)
+	return cfg.QueryFactory.NewMetricQuery(queryCtx, cfg.Node, database, queryStmt).WaitResponse()
+}
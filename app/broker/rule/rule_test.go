@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestRunEvery(t *testing.T) {
+	defer func() {
+		nowFn = timeutil.Now
+	}()
+	cases := []struct {
+		now int64
+		end int64
+	}{
+		// boundary just passed, but delay not elapsed, evaluate the boundary after delay
+		{now: 1000, end: 1000},
+		{now: 1040, end: 1000},
+		// delay elapsed, evaluate next boundary
+		{now: 1050, end: 1100},
+		{now: 1060, end: 1100},
+	}
+	for _, tt := range cases {
+		nowFn = func() int64 {
+			return tt.now
+		}
+		ctx, cancel := context.WithCancel(context.TODO())
+		start := time.Now()
+		runEvery(ctx, 100, 50*time.Millisecond, func(end int64) {
+			assert.Equal(t, tt.end, end)
+			// evaluate at end+delay
+			assert.GreaterOrEqual(t, time.Since(start), time.Duration(tt.end+50-tt.now)*time.Millisecond)
+			cancel()
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/app/broker/tenant"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/coordinator/elect"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/replica"
+)
+
+//go:generate mockgen -source=./scheduler.go -destination=./scheduler_mock.go -package=rule
+
+// for testing
+var (
+	newElectionFn = elect.NewElectionWithPath
+)
+
+var log = logger.GetLogger("Broker", "RuleScheduler")
+
+// SchedulerCfg represents the config for rule scheduler creating.
+type SchedulerCfg struct {
+	Ctx  context.Context
+	TTL  int64 // scheduler elect keepalive ttl
+	Node models.Node
+	Repo state.Repository
+
+	DiscoveryFactory discovery.Factory
+	QueryFactory     brokerQuery.Factory
+	QueryAdmission   brokerQuery.AdmissionController
+	CM               replica.ChannelManager
+	TenantMgr        tenant.Manager
+	// WriteTimeout represents the timeout of writing rule result.
+	WriteTimeout time.Duration
+	// EvaluationDelay represents the delay of evaluating rule after each interval boundary.
+	EvaluationDelay time.Duration
+}
+
+// Scheduler represents the scheduler which evaluates rules periodically,
+// only the elected broker(leader) schedules rules in broker cluster.
+type Scheduler interface {
+	// Start starts election of scheduler, if success schedules all rules.
+	Start()
+	// IsLeader returns if current node is the leader of scheduler.
+	IsLeader() bool
+	// Stop stops the scheduler, cancels all scheduled rules.
+	Stop()
+}
+
+// ruleFactory creates the rule runner from the rule definition stored in repo.
+type ruleFactory func(ctx context.Context, resource []byte, cfg *SchedulerCfg) (ruleRunner, error)
+
+// ruleListener listens the rule changes under the path of one kind of rule.
+type ruleListener struct {
+	s       *scheduler
+	path    string
+	newRule ruleFactory
+}
+
+// OnCreate schedules the rule when rule created/modified.
+func (l *ruleListener) OnCreate(key string, resource []byte) {
+	l.s.schedule(l.path, key, resource, l.newRule)
+}
+
+// OnDelete stops the rule and cleans up its resources when rule deleted.
+func (l *ruleListener) OnDelete(key string) {
+	l.s.unschedule(l.path, key)
+}
+
+// scheduler implements Scheduler interface.
+type scheduler struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *SchedulerCfg
+
+	elect       elect.Election
+	listeners   []*ruleListener
+	discoveries []discovery.Discovery
+	// rules represents the scheduled rules, key: rule kind path + rule key.
+	rules map[string]ruleRunner
+
+	mutex sync.Mutex
+}
+
+// NewScheduler creates a rule scheduler.
+func NewScheduler(cfg *SchedulerCfg) Scheduler {
+	ctx, cancel := context.WithCancel(cfg.Ctx)
+	s := &scheduler{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+		rules:  make(map[string]ruleRunner),
+	}
+	s.listeners = []*ruleListener{
+		{s: s, path: constants.RecordingRulePath, newRule: newRecordingRuleRunner},
+		{s: s, path: constants.AlertRulePath, newRule: newAlertRuleRunner},
+	}
+	s.elect = newElectionFn(ctx, cfg.Repo, cfg.Node, cfg.TTL, constants.RuleSchedulerPath, s)
+	return s
+}
+
+// Start starts election of scheduler, if success schedules all rules.
+func (s *scheduler) Start() {
+	s.elect.Initialize()
+	s.elect.Elect()
+}
+
+// IsLeader returns if current node is the leader of scheduler.
+func (s *scheduler) IsLeader() bool {
+	return s.elect.IsMaster()
+}
+
+// Stop stops the scheduler, cancels all scheduled rules.
+func (s *scheduler) Stop() {
+	s.elect.Close()
+	s.OnResignation()
+	s.cancel()
+}
+
+// OnFailOver invoked after electing, current node become the leader, starts watching rules.
+func (s *scheduler) OnFailOver() error {
+	log.Info("rule scheduler fail over, starting schedule rules")
+	s.mutex.Lock()
+	if len(s.discoveries) > 0 {
+		s.mutex.Unlock()
+		return nil
+	}
+	discoveries := make([]discovery.Discovery, 0, len(s.listeners))
+	for _, listener := range s.listeners {
+		discoveries = append(discoveries, s.cfg.DiscoveryFactory.CreateDiscovery(listener.path, listener))
+	}
+	s.discoveries = discoveries
+	s.mutex.Unlock()
+
+	// discovery invokes OnCreate for existing rules, so cannot hold the lock
+	for _, ruleDiscovery := range discoveries {
+		if err := ruleDiscovery.Discovery(true); err != nil {
+			s.OnResignation()
+			return err
+		}
+	}
+	return nil
+}
+
+// OnResignation invoked current node is leader, before re-electing, stops all rules.
+func (s *scheduler) OnResignation() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.discoveries) > 0 {
+		log.Info("rule scheduler resign, stopping all rules")
+		for _, ruleDiscovery := range s.discoveries {
+			ruleDiscovery.Close()
+		}
+		s.discoveries = nil
+	}
+	for key, r := range s.rules {
+		r.stop()
+		delete(s.rules, key)
+	}
+}
+
+// schedule creates the rule runner, then replaces the old one if exist.
+func (s *scheduler) schedule(path, key string, resource []byte, newRule ruleFactory) {
+	r, err := newRule(s.ctx, resource, s.cfg)
+	if err != nil {
+		log.Error("ignore invalid rule",
+			logger.String("key", key), logger.String("data", string(resource)), logger.Error(err))
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.discoveries) == 0 {
+		// current node isn't leader
+		return
+	}
+	ruleKey := path + "/" + key
+	if old, ok := s.rules[ruleKey]; ok {
+		old.stop()
+	}
+	s.rules[ruleKey] = r
+	r.start()
+	log.Info("schedule rule", logger.String("key", ruleKey))
+}
+
+// unschedule drops the rule runner if exist.
+func (s *scheduler) unschedule(path, key string) {
+	ruleKey := path + "/" + key
+	s.mutex.Lock()
+	r, ok := s.rules[ruleKey]
+	if ok {
+		delete(s.rules, ruleKey)
+	}
+	s.mutex.Unlock()
+
+	if ok {
+		// waits the running evaluation without holding the lock
+		r.drop(s.ctx)
+		log.Info("drop rule", logger.String("key", ruleKey))
+	}
+}
@@ -0,0 +1,137 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/coordinator/elect"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestScheduler_Lifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newElectionFn = elect.NewElectionWithPath
+		ctrl.Finish()
+	}()
+	election := elect.NewMockElection(ctrl)
+	newElectionFn = func(_ context.Context, _ state.Repository, _ models.Node, _ int64,
+		path string, _ elect.Listener) elect.Election {
+		assert.Equal(t, constants.RuleSchedulerPath, path)
+		return election
+	}
+	s := NewScheduler(&SchedulerCfg{Ctx: context.TODO(), Node: &models.StatelessNode{}})
+	election.EXPECT().Initialize()
+	election.EXPECT().Elect()
+	s.Start()
+	election.EXPECT().IsMaster().Return(true)
+	assert.True(t, s.IsLeader())
+	election.EXPECT().Close()
+	s.Stop()
+}
+
+func TestScheduler_Rules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newElectionFn = elect.NewElectionWithPath
+		ctrl.Finish()
+	}()
+	newElectionFn = func(_ context.Context, _ state.Repository, _ models.Node, _ int64,
+		_ string, _ elect.Listener) elect.Election {
+		return elect.NewMockElection(ctrl)
+	}
+	discoveryFct := discovery.NewMockFactory(ctrl)
+	ruleDiscovery := discovery.NewMockDiscovery(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist).AnyTimes()
+	s := NewScheduler(&SchedulerCfg{
+		Ctx:              context.TODO(),
+		Node:             &models.StatelessNode{},
+		Repo:             repo,
+		DiscoveryFactory: discoveryFct,
+	})
+	s1 := s.(*scheduler)
+	assert.Len(t, s1.listeners, 2)
+	recording := s1.listeners[0]
+	alert := s1.listeners[1]
+	rule := encoding.JSONMarshal(&models.RecordingRule{Name: "r1", Interval: timeutil.OneHour})
+	// not leader
+	recording.OnCreate("r1", rule)
+	assert.Empty(t, s1.rules)
+
+	alertDiscovery := discovery.NewMockDiscovery(ctrl)
+	// discovery failure
+	discoveryFct.EXPECT().CreateDiscovery(constants.RecordingRulePath, recording).Return(ruleDiscovery)
+	discoveryFct.EXPECT().CreateDiscovery(constants.AlertRulePath, alert).Return(alertDiscovery)
+	ruleDiscovery.EXPECT().Discovery(true).Return(fmt.Errorf("err"))
+	ruleDiscovery.EXPECT().Close()
+	alertDiscovery.EXPECT().Close()
+	assert.Error(t, s1.OnFailOver())
+	assert.Empty(t, s1.discoveries)
+
+	discoveryFct.EXPECT().CreateDiscovery(constants.RecordingRulePath, recording).Return(ruleDiscovery)
+	discoveryFct.EXPECT().CreateDiscovery(constants.AlertRulePath, alert).Return(alertDiscovery)
+	ruleDiscovery.EXPECT().Discovery(true).Return(nil)
+	alertDiscovery.EXPECT().Discovery(true).Return(nil)
+	assert.NoError(t, s1.OnFailOver())
+	// already leader
+	assert.NoError(t, s1.OnFailOver())
+
+	recording.OnCreate("r1", []byte("abc"))
+	recording.OnCreate("r1", encoding.JSONMarshal(&models.RecordingRule{Name: "r1"}))
+	alert.OnCreate("r1", []byte("abc"))
+	alert.OnCreate("r1", encoding.JSONMarshal(&models.AlertRule{Name: "r1"}))
+	assert.Empty(t, s1.rules)
+	recording.OnCreate("r1", rule)
+	recording.OnCreate("r2", rule)
+	assert.Len(t, s1.rules, 2)
+	// modify rule
+	recording.OnCreate("r1", rule)
+	assert.Len(t, s1.rules, 2)
+	// alert rule with same key
+	alert.OnCreate("r1", encoding.JSONMarshal(&models.AlertRule{
+		Name: "r1", Database: "db", SQL: "select f from cpu",
+		Condition: models.AlertCondition{Operator: ">", Threshold: 1},
+		Interval:  timeutil.OneHour,
+	}))
+	assert.Len(t, s1.rules, 3)
+	recording.OnDelete("r1")
+	recording.OnDelete("r3")
+	assert.Len(t, s1.rules, 2)
+	// drop alert rule deletes its state
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAlertStatePath("r1")).Return(nil)
+	alert.OnDelete("r1")
+	assert.Len(t, s1.rules, 1)
+
+	ruleDiscovery.EXPECT().Close()
+	alertDiscovery.EXPECT().Close()
+	s1.OnResignation()
+	assert.Empty(t, s1.rules)
+	assert.Empty(t, s1.discoveries)
+}
@@ -28,12 +28,18 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/app/broker/api"
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/app/broker/rule"
+	"github.com/lindb/lindb/app/broker/tenant"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/internal/audit"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
@@ -45,9 +51,11 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query"
 	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
@@ -61,11 +69,16 @@ var (
 	newRegistry            = discovery.NewRegistry
 	newRepositoryFactory   = state.NewRepositoryFactory
 	newGRPCServer          = rpc.NewGRPCServer
+	newClientCredentialsFn = rpc.NewClientCredentials
 	newTaskClientFactory   = rpc.NewTaskClientFactory
 	newStateManager        = broker.NewStateManager
 	newChannelManager      = replica.NewChannelManager
 	newTaskManager         = brokerQuery.NewTaskManager
 	newMasterController    = coordinator.NewMasterController
+	newRuleScheduler       = rule.NewScheduler
+	newAuthManager         = auth.NewManager
+	newTenantManager       = tenant.NewManager
+	newAuditRecorder       = audit.NewRecorder
 	newNativeProtoPusher   = monitoring.NewNativeProtoPusher
 	serveGRPCFn            = serveGRPC
 )
@@ -74,6 +87,8 @@ var (
 type srv struct {
 	channelManager replica.ChannelManager
 	taskManager    brokerQuery.TaskManager
+	queryFactory   brokerQuery.Factory
+	queryAdmission brokerQuery.AdmissionController
 }
 
 // factory represents all factories for broker
@@ -100,6 +115,10 @@ type runtime struct {
 	factory             factory
 	httpServer          httppkg.Server
 	master              coordinator.MasterController
+	ruleScheduler       rule.Scheduler
+	authMgr             auth.Manager
+	tenantMgr           tenant.Manager
+	audit               audit.Recorder
 	registry            discovery.Registry
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            broker.StateManager
@@ -151,6 +170,10 @@ func (r *runtime) Run() error {
 		r.state = server.Failed
 		return fmt.Errorf("cannot get server's ip address, error: %s", err)
 	}
+	if err = r.initTLS(); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("init tls config failure, error: %s", err)
+	}
 
 	hostName, err := hostName()
 	if err != nil {
@@ -162,6 +185,7 @@ func (r *runtime) Run() error {
 		HostName:   hostName,
 		GRPCPort:   r.config.BrokerBase.GRPC.Port,
 		HTTPPort:   r.config.BrokerBase.HTTP.Port,
+		HTTPS:      r.config.BrokerBase.HTTP.TLS.Enable,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
 	}
@@ -254,8 +278,27 @@ func (r *runtime) Run() error {
 	}
 	r.log.Info("broker state machine started successfully")
 
+	// start auth manager, watch users/roles if authentication enabled
+	r.authMgr = newAuthManager(r.config.BrokerBase.Auth, r.repo, discoveryFactory)
+	if err := r.authMgr.Start(); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("start auth manager error:%s", err)
+	}
+	// start tenant manager, watch tenants and collect the usage of tenants
+	r.tenantMgr = newTenantManager(r.ctx, discoveryFactory, r.stateMgr)
+	if err := r.tenantMgr.Start(); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("start tenant manager error:%s", err)
+	}
+	// audit log of DDL/administrative operations
+	if r.audit, err = newAuditRecorder(&r.config.BrokerBase.Audit, r.node.Indicator(), r.repo); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("create audit recorder error:%s", err)
+	}
 	// start http server
 	r.startHTTPServer()
+	// start rule scheduler, only the elected broker evaluates rules
+	r.startRuleScheduler(discoveryFactory)
 
 	if r.enableSystemMonitor {
 		// start system collector
@@ -302,6 +345,25 @@ func (r *runtime) Stop() {
 		}
 	}
 
+	if r.ruleScheduler != nil {
+		r.log.Info("stopping rule scheduler...")
+		r.ruleScheduler.Stop()
+	}
+
+	if r.authMgr != nil {
+		r.authMgr.Stop()
+	}
+
+	if r.tenantMgr != nil {
+		r.tenantMgr.Stop()
+	}
+
+	if r.audit != nil {
+		if err := r.audit.Close(); err != nil {
+			r.log.Error("close audit recorder error", logger.Error(err))
+		}
+	}
+
 	if r.master != nil {
 		r.log.Info("stopping master...")
 		r.master.Stop()
@@ -352,7 +414,6 @@ func (r *runtime) Stop() {
 func (r *runtime) startHTTPServer() {
 	r.log.Info("starting HTTP server")
 	r.httpServer = httppkg.NewServer(r.config.BrokerBase.HTTP, true, linmetric.BrokerRegistry)
-	// TODO login api is not registered
 	httpAPI := api.NewAPI(&deps.HTTPDeps{
 		Ctx:         r.ctx,
 		Node:        r.node,
@@ -362,6 +423,9 @@ func (r *runtime) startHTTPServer() {
 		RepoFactory: r.repoFactory,
 		StateMgr:    r.stateMgr,
 		CM:          r.srv.channelManager,
+		AuthMgr:     r.authMgr,
+		TenantMgr:   r.tenantMgr,
+		Audit:       r.audit,
 		IngestLimiter: concurrent.NewLimiter(
 			r.ctx,
 			r.config.BrokerBase.Ingestion.MaxConcurrency,
@@ -374,10 +438,8 @@ func (r *runtime) startHTTPServer() {
 			r.config.Query.Timeout.Duration(),
 			metrics.NewLimitStatistics("query", linmetric.BrokerRegistry),
 		),
-		QueryFactory: brokerQuery.NewQueryFactory(
-			r.stateMgr,
-			r.srv.taskManager,
-		),
+		QueryAdmission:  r.srv.queryAdmission,
+		QueryFactory:    r.srv.queryFactory,
 		GlobalKeyValues: r.globalKeyValues,
 	})
 	httpAPI.RegisterRouter(r.httpServer.GetAPIRouter())
@@ -389,6 +451,42 @@ func (r *runtime) startHTTPServer() {
 	}()
 }
 
+// startRuleScheduler starts rule scheduler which evaluates recording/alert rules after elected.
+func (r *runtime) startRuleScheduler(discoveryFactory discovery.Factory) {
+	r.ruleScheduler = newRuleScheduler(&rule.SchedulerCfg{
+		Ctx:              r.ctx,
+		TTL:              int64(r.config.Coordinator.LeaseTTL.Duration().Seconds()),
+		Node:             r.node,
+		Repo:             r.repo,
+		DiscoveryFactory: discoveryFactory,
+		QueryFactory:     r.srv.queryFactory,
+		QueryAdmission:   r.srv.queryAdmission,
+		CM:               r.srv.channelManager,
+		TenantMgr:        r.tenantMgr,
+		WriteTimeout:     r.config.BrokerBase.Ingestion.IngestTimeout.Duration(),
+		EvaluationDelay:  r.config.BrokerBase.Rule.EvaluationDelay.Duration(),
+	})
+	r.ruleScheduler.Start()
+}
+
+// initTLS initializes tls config/credential of grpc/http client for accessing other nodes.
+func (r *runtime) initTLS() error {
+	if r.config.BrokerBase.Auth.Enable {
+		client.SetDefaultPeerAuth(r.config.BrokerBase.Auth.Admin)
+	}
+	creds, err := newClientCredentialsFn(r.config.BrokerBase.GRPC.TLS)
+	if err != nil {
+		return err
+	}
+	rpc.GetBrokerClientConnFactory().SetTransportCredentials(creds)
+	httpTLS, err := tlsutil.NewClientConfig(r.config.BrokerBase.HTTP.TLS)
+	if err != nil {
+		return err
+	}
+	tlsutil.SetDefaultClientConfig(httpTLS)
+	return nil
+}
+
 // startStateRepo starts state repository
 func (r *runtime) startStateRepo() error {
 	// set a sub namespace
@@ -406,6 +504,11 @@ func (r *runtime) buildServiceDependency() {
 	// create replica channel mgr.
 	cm := newChannelManager(r.ctx, rpc.NewClientStreamFactory(r.ctx, r.node, rpc.GetBrokerClientConnFactory()), r.stateMgr)
 
+	// limit memory used by merging query results
+	flow.SetMemoryLimit(int64(r.config.Query.MaxMemoryPerQuery), int64(r.config.Query.MaxMemory))
+	// record the query which cost exceeds the threshold into slow query log
+	tracker.SetSlowQueryLog(r.config.Query.SlowQueryThreshold.Duration(), r.config.Query.SlowQueryHistory)
+	tracker.SetSlowQueryStatsSampleRate(r.config.Query.SlowQueryStatsSampleRate)
 	taskManager := newTaskManager(
 		r.ctx,
 		r.node,
@@ -418,9 +521,15 @@ func (r *runtime) buildServiceDependency() {
 	// close connections in connection-manager
 	r.factory.taskClient.SetTaskReceiver(taskManager)
 
+	var resultCache brokerQuery.ResultCache
+	if r.config.Query.ResultCache.Enabled {
+		resultCache = brokerQuery.NewResultCache(&r.config.Query.ResultCache)
+	}
 	s := srv{
 		channelManager: cm,
 		taskManager:    taskManager,
+		queryFactory:   brokerQuery.NewQueryFactory(r.stateMgr, taskManager, resultCache),
+		queryAdmission: brokerQuery.NewAdmissionController(&r.config.Query),
 	}
 	r.srv = s
 }
@@ -469,6 +578,7 @@ func (r *runtime) nativePusher() {
 	r.pusher = newNativeProtoPusher(
 		r.ctx,
 		r.config.Monitor.URL,
+		r.config.Monitor.Token,
 		r.config.Monitor.ReportInterval.Duration(),
 		r.config.Monitor.PushTimeout.Duration(),
 		linmetric.BrokerRegistry,
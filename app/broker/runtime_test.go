@@ -27,11 +27,16 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/lindb/lindb/app/broker/auth"
+	"github.com/lindb/lindb/app/broker/rule"
+	"github.com/lindb/lindb/app/broker/tenant"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
 	brokerpkg "github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
@@ -99,6 +104,15 @@ func TestBrokerRuntime_Run(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "init tls config failure",
+			prepare: func() {
+				newClientCredentialsFn = func(_ config.TLS) (credentials.TransportCredentials, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
 		{
 			name: "get host name/create state repo failure",
 			prepare: func() {
@@ -165,6 +179,102 @@ func TestBrokerRuntime_Run(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "start auth manager failure",
+			prepare: func() {
+				repoFct.EXPECT().CreateBrokerRepo(gomock.Any()).Return(repo, nil)
+				registry := discovery.NewMockRegistry(ctrl)
+				registry.EXPECT().Register(gomock.Any()).Return(nil)
+				newRegistry = func(repo state.Repository, prefixPath string, ttl time.Duration) discovery.Registry {
+					return registry
+				}
+				mc := coordinator.NewMockMasterController(ctrl)
+				newMasterController = func(cfg *coordinator.MasterCfg) coordinator.MasterController {
+					return mc
+				}
+				mc.EXPECT().WatchMasterElected(gomock.Any()).DoAndReturn(func(fn func(_ *models.Master)) {
+					fn(&models.Master{})
+				})
+				mc.EXPECT().Start()
+				smFct := discovery.NewMockStateMachineFactory(ctrl)
+				smFct.EXPECT().Start().Return(nil)
+				newStateMachineFactory = func(ctx context.Context, discoveryFactory discovery.Factory,
+					stateMgr brokerpkg.StateManager) discovery.StateMachineFactory {
+					return smFct
+				}
+				authMgr := auth.NewMockManager(ctrl)
+				authMgr.EXPECT().Start().Return(fmt.Errorf("err"))
+				newAuthManager = func(_ config.Auth, _ state.Repository, _ discovery.Factory) auth.Manager {
+					return authMgr
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "start tenant manager failure",
+			prepare: func() {
+				repoFct.EXPECT().CreateBrokerRepo(gomock.Any()).Return(repo, nil)
+				registry := discovery.NewMockRegistry(ctrl)
+				registry.EXPECT().Register(gomock.Any()).Return(nil)
+				newRegistry = func(repo state.Repository, prefixPath string, ttl time.Duration) discovery.Registry {
+					return registry
+				}
+				mc := coordinator.NewMockMasterController(ctrl)
+				newMasterController = func(cfg *coordinator.MasterCfg) coordinator.MasterController {
+					return mc
+				}
+				mc.EXPECT().WatchMasterElected(gomock.Any()).DoAndReturn(func(fn func(_ *models.Master)) {
+					fn(&models.Master{})
+				})
+				mc.EXPECT().Start()
+				smFct := discovery.NewMockStateMachineFactory(ctrl)
+				smFct.EXPECT().Start().Return(nil)
+				newStateMachineFactory = func(ctx context.Context, discoveryFactory discovery.Factory,
+					stateMgr brokerpkg.StateManager) discovery.StateMachineFactory {
+					return smFct
+				}
+				tenantMgr := tenant.NewMockManager(ctrl)
+				tenantMgr.EXPECT().Start().Return(fmt.Errorf("err"))
+				newTenantManager = func(_ context.Context, _ discovery.Factory, _ brokerpkg.StateManager) tenant.Manager {
+					return tenantMgr
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "create audit recorder failure",
+			prepare: func() {
+				repoFct.EXPECT().CreateBrokerRepo(gomock.Any()).Return(repo, nil)
+				registry := discovery.NewMockRegistry(ctrl)
+				registry.EXPECT().Register(gomock.Any()).Return(nil)
+				newRegistry = func(repo state.Repository, prefixPath string, ttl time.Duration) discovery.Registry {
+					return registry
+				}
+				mc := coordinator.NewMockMasterController(ctrl)
+				newMasterController = func(cfg *coordinator.MasterCfg) coordinator.MasterController {
+					return mc
+				}
+				mc.EXPECT().WatchMasterElected(gomock.Any()).DoAndReturn(func(fn func(_ *models.Master)) {
+					fn(&models.Master{})
+				})
+				mc.EXPECT().Start()
+				smFct := discovery.NewMockStateMachineFactory(ctrl)
+				smFct.EXPECT().Start().Return(nil)
+				newStateMachineFactory = func(ctx context.Context, discoveryFactory discovery.Factory,
+					stateMgr brokerpkg.StateManager) discovery.StateMachineFactory {
+					return smFct
+				}
+				tenantMgr := tenant.NewMockManager(ctrl)
+				tenantMgr.EXPECT().Start().Return(nil)
+				newTenantManager = func(_ context.Context, _ discovery.Factory, _ brokerpkg.StateManager) tenant.Manager {
+					return tenantMgr
+				}
+				newAuditRecorder = func(_ *config.Audit, _ string, _ state.Repository) (audit.Recorder, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
 		{
 			name: "broker successfully",
 			prepare: func() {
@@ -188,6 +298,16 @@ func TestBrokerRuntime_Run(t *testing.T) {
 					stateMgr brokerpkg.StateManager) discovery.StateMachineFactory {
 					return smFct
 				}
+				tenantMgr := tenant.NewMockManager(ctrl)
+				tenantMgr.EXPECT().Start().Return(nil)
+				newTenantManager = func(_ context.Context, _ discovery.Factory, _ brokerpkg.StateManager) tenant.Manager {
+					return tenantMgr
+				}
+				ruleScheduler := rule.NewMockScheduler(ctrl)
+				ruleScheduler.EXPECT().Start()
+				newRuleScheduler = func(cfg *rule.SchedulerCfg) rule.Scheduler {
+					return ruleScheduler
+				}
 			},
 			wantErr: false,
 		},
@@ -200,11 +320,16 @@ func TestBrokerRuntime_Run(t *testing.T) {
 				getHostIP = hostutil.GetHostIP
 				hostName = os.Hostname
 				newGRPCServer = rpc.NewGRPCServer
+				newClientCredentialsFn = rpc.NewClientCredentials
 				newTaskClientFactory = rpc.NewTaskClientFactory
 				newStateManager = brokerpkg.NewStateManager
 				newChannelManager = replica.NewChannelManager
 				newTaskManager = brokerQuery.NewTaskManager
 				newMasterController = coordinator.NewMasterController
+				newRuleScheduler = rule.NewScheduler
+				newAuthManager = auth.NewManager
+				newTenantManager = tenant.NewManager
+				newAuditRecorder = audit.NewRecorder
 				newRegistry = discovery.NewRegistry
 				serveGRPCFn = serveGRPC
 
@@ -244,6 +369,10 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 	httpServer := http.NewMockServer(ctrl)
 	registry := discovery.NewMockRegistry(ctrl)
 	mc := coordinator.NewMockMasterController(ctrl)
+	ruleScheduler := rule.NewMockScheduler(ctrl)
+	authMgr := auth.NewMockManager(ctrl)
+	tenantMgr := tenant.NewMockManager(ctrl)
+	auditRecorder := audit.NewMockRecorder(ctrl)
 	smFct := discovery.NewMockStateMachineFactory(ctrl)
 	repo := state.NewMockRepository(ctrl)
 	stateMgr := brokerpkg.NewMockStateManager(ctrl)
@@ -261,6 +390,10 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 				pusher.EXPECT().Stop()
 				httpServer.EXPECT().Close(gomock.Any()).Return(fmt.Errorf("err"))
 				registry.EXPECT().Close().Return(fmt.Errorf("err"))
+				ruleScheduler.EXPECT().Stop()
+				authMgr.EXPECT().Stop()
+				tenantMgr.EXPECT().Stop()
+				auditRecorder.EXPECT().Close().Return(fmt.Errorf("err"))
 				mc.EXPECT().Stop()
 				smFct.EXPECT().Stop()
 				repo.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -276,6 +409,10 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 				pusher.EXPECT().Stop()
 				httpServer.EXPECT().Close(gomock.Any()).Return(nil)
 				registry.EXPECT().Close().Return(nil)
+				ruleScheduler.EXPECT().Stop()
+				authMgr.EXPECT().Stop()
+				tenantMgr.EXPECT().Stop()
+				auditRecorder.EXPECT().Close().Return(nil)
 				mc.EXPECT().Stop()
 				smFct.EXPECT().Stop()
 				repo.EXPECT().Close().Return(nil)
@@ -298,6 +435,10 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 				httpServer:          httpServer,
 				registry:            registry,
 				master:              mc,
+				ruleScheduler:       ruleScheduler,
+				authMgr:             authMgr,
+				tenantMgr:           tenantMgr,
+				audit:               auditRecorder,
 				stateMachineFactory: smFct,
 				repo:                repo,
 				stateMgr:            stateMgr,
@@ -338,7 +479,7 @@ func TestBrokerRuntime_push_metric(t *testing.T) {
 	}()
 
 	pusher := monitoring.NewMockNativePusher(ctrl)
-	newNativeProtoPusher = func(ctx context.Context, endpoint string,
+	newNativeProtoPusher = func(ctx context.Context, endpoint, token string,
 		interval time.Duration, pushTimeout time.Duration,
 		r *linmetric.Registry, globalKeyValues tag.Tags) monitoring.NativePusher {
 		return pusher
@@ -381,7 +522,7 @@ func resetNewDepsMock() {
 		stateMgr brokerpkg.StateManager) discovery.StateMachineFactory {
 		return nil
 	}
-	newNativeProtoPusher = func(ctx context.Context, endpoint string, interval time.Duration,
+	newNativeProtoPusher = func(ctx context.Context, endpoint, token string, interval time.Duration,
 		pushTimeout time.Duration, r *linmetric.Registry, globalKeyValues tag.Tags) monitoring.NativePusher {
 		return nil
 	}
@@ -0,0 +1,436 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"golang.org/x/time/rate"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+//go:generate mockgen -source=./manager.go -destination=./manager_mock.go -package=tenant
+
+// for testing
+var (
+	newRestyFn      = client.NewRestyClient
+	collectInterval = time.Minute
+)
+
+// DatabaseUsagePath represents the path of database usage api in storage node.
+const DatabaseUsagePath = "/state/tsdb/usage"
+
+var (
+	log        = logger.GetLogger("Broker", "Tenant")
+	statistics = metrics.NewTenantStatistics()
+)
+
+// Manager represents the manager of tenants, which enforces the quotas of tenant for ingestion/query,
+// the number of series/disk bytes of tenant are collected from storage nodes periodically.
+// Ingest rate/concurrent queries are limited by each broker locally without coordination,
+// each broker allows the share of quota which divided by the number of live brokers.
+type Manager interface {
+	// Start starts watching the changes of tenants and collecting the usage of tenants.
+	Start() error
+	// AllowWrite checks if the points can be written into database based on the quotas of its tenant,
+	// points represents the number of field points(fields*rows) of the batch.
+	AllowWrite(database string, points int) error
+	// AcquireQuery acquires a query slot of database's tenant, returns the release function if success.
+	AcquireQuery(database string) (release func(), err error)
+	// Usage returns the resource usage of all tenants.
+	Usage() models.TenantUsages
+	// Stop stops watching the changes of tenants and collecting the usage.
+	Stop()
+}
+
+// tenantState represents the quotas and the resource usage of tenant.
+type tenantState struct {
+	tenant     *models.Tenant
+	limiter    *rate.Limiter // nil if ingest rate is unlimited
+	maxQueries atomic.Int64  // max concurrent queries of current broker, 0 if unlimited
+	usage      *tenantUsage
+}
+
+// tenantUsage represents the resource usage of tenant, which is kept when tenant modified.
+type tenantUsage struct {
+	queries       atomic.Int64
+	writtenPoints atomic.Int64 // points written since last collecting
+	ingestRate    atomic.Float64
+	numOfSeries   atomic.Int64
+	diskBytes     atomic.Int64
+}
+
+// newTenantState creates the state of tenant, keeps the usage of old state if exists.
+func newTenantState(tenant *models.Tenant, old *tenantState, brokers int64) *tenantState {
+	s := &tenantState{tenant: tenant, usage: &tenantUsage{}}
+	share := tenant.Quota.PerBroker(brokers)
+	if share.IngestRate > 0 {
+		s.limiter = rate.NewLimiter(rate.Limit(share.IngestRate), int(share.IngestRate))
+	}
+	s.maxQueries.Store(share.MaxQueries)
+	if old != nil {
+		s.usage = old.usage
+	}
+	return s
+}
+
+// share changes the quota share of current broker when the number of live brokers changed.
+func (s *tenantState) share(brokers int64) {
+	share := s.tenant.Quota.PerBroker(brokers)
+	if s.limiter != nil {
+		s.limiter.SetLimit(rate.Limit(share.IngestRate))
+		s.limiter.SetBurst(int(share.IngestRate))
+	}
+	s.maxQueries.Store(share.MaxQueries)
+}
+
+// manager implements Manager interface.
+type manager struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	factory  discovery.Factory
+	stateMgr broker.StateManager
+
+	discovery   discovery.Discovery
+	tenants     map[string]*tenantState
+	lastCollect time.Time
+	// brokers represents the number of live brokers which share the quotas.
+	brokers atomic.Int64
+
+	mutex sync.RWMutex
+}
+
+// NewManager creates the tenant manager.
+func NewManager(ctx context.Context, factory discovery.Factory, stateMgr broker.StateManager) Manager {
+	c, cancel := context.WithCancel(ctx)
+	m := &manager{
+		ctx:         c,
+		cancel:      cancel,
+		factory:     factory,
+		stateMgr:    stateMgr,
+		tenants:     make(map[string]*tenantState),
+		lastCollect: time.Now(),
+	}
+	m.brokers.Store(1)
+	return m
+}
+
+// Start starts watching the changes of tenants and collecting the usage of tenants.
+func (m *manager) Start() error {
+	m.refreshBrokers()
+	m.discovery = m.factory.CreateDiscovery(constants.TenantPath, &tenantListener{m: m})
+	if err := m.discovery.Discovery(true); err != nil {
+		return err
+	}
+	go m.collectLoop()
+	log.Info("tenant manager started")
+	return nil
+}
+
+// Stop stops watching the changes of tenants and collecting the usage.
+func (m *manager) Stop() {
+	m.cancel()
+	if m.discovery != nil {
+		m.discovery.Close()
+	}
+}
+
+// AllowWrite checks if the points can be written into database based on the quotas of its tenant.
+func (m *manager) AllowWrite(database string, points int) error {
+	s := m.getTenantState(database)
+	if s == nil {
+		return nil
+	}
+	name := s.tenant.Name
+	quota := s.tenant.Quota
+	var err error
+	switch {
+	case quota.MaxSeries > 0 && s.usage.numOfSeries.Load() >= quota.MaxSeries:
+		err = fmt.Errorf("%w: number of series of tenant[%s] exceeds %d", constants.ErrQuotaExceeded, name, quota.MaxSeries)
+	case quota.MaxDiskBytes > 0 && s.usage.diskBytes.Load() >= quota.MaxDiskBytes:
+		err = fmt.Errorf("%w: disk bytes of tenant[%s] exceeds %d", constants.ErrQuotaExceeded, name, quota.MaxDiskBytes)
+	case s.limiter != nil && points > s.limiter.Burst():
+		// the whole batch is charged, a batch larger than the share of current broker per second never passes
+		err = fmt.Errorf("%w: batch of %d points exceeds ingest rate of tenant[%s] on current broker(%d points/s)",
+			constants.ErrQuotaExceeded, points, name, s.limiter.Burst())
+	case s.limiter != nil && !s.limiter.AllowN(time.Now(), points):
+		err = fmt.Errorf("%w: ingest rate of tenant[%s] exceeds %d points/s", constants.ErrQuotaExceeded, name, quota.IngestRate)
+	}
+	if err != nil {
+		statistics.WriteRejected.WithTagValues(name).Incr()
+		return err
+	}
+	s.usage.writtenPoints.Add(int64(points))
+	statistics.WrittenPoints.WithTagValues(name).Add(float64(points))
+	return nil
+}
+
+// AcquireQuery acquires a query slot of database's tenant, returns the release function if success.
+func (m *manager) AcquireQuery(database string) (release func(), err error) {
+	s := m.getTenantState(database)
+	if s == nil {
+		return func() {}, nil
+	}
+	name := s.tenant.Name
+	maxQueries := s.maxQueries.Load()
+	if n := s.usage.queries.Inc(); maxQueries > 0 && n > maxQueries {
+		s.usage.queries.Dec()
+		statistics.QueryRejected.WithTagValues(name).Incr()
+		return nil, fmt.Errorf("%w: concurrent queries of tenant[%s] exceeds %d", constants.ErrQuotaExceeded, name, maxQueries)
+	}
+	statistics.Queries.WithTagValues(name).Incr()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.usage.queries.Dec()
+			statistics.Queries.WithTagValues(name).Decr()
+		})
+	}, nil
+}
+
+// Usage returns the resource usage of all tenants.
+func (m *manager) Usage() models.TenantUsages {
+	databases := m.tenantDatabases()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	rs := make(models.TenantUsages, 0, len(m.tenants))
+	for name, s := range m.tenants {
+		rs = append(rs, &models.TenantUsage{
+			Name:        name,
+			Databases:   databases[name],
+			Quota:       s.tenant.Quota,
+			Brokers:     m.brokers.Load(),
+			IngestRate:  s.usage.ingestRate.Load(),
+			Queries:     s.usage.queries.Load(),
+			NumOfSeries: s.usage.numOfSeries.Load(),
+			DiskBytes:   s.usage.diskBytes.Load(),
+		})
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name < rs[j].Name
+	})
+	return rs
+}
+
+// getTenantState returns the state of database's tenant, returns nil if database not belong to any tenant.
+func (m *manager) getTenantState(database string) *tenantState {
+	cfg, ok := m.stateMgr.GetDatabaseCfg(database)
+	if !ok || cfg.Tenant == "" {
+		return nil
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.tenants[cfg.Tenant]
+}
+
+// tenantDatabases returns the sorted database names of each tenant.
+func (m *manager) tenantDatabases() map[string][]string {
+	rs := make(map[string][]string)
+	for _, db := range m.stateMgr.GetDatabases() {
+		if db.Tenant != "" {
+			rs[db.Tenant] = append(rs[db.Tenant], db.Name)
+		}
+	}
+	for _, names := range rs {
+		sort.Strings(names)
+	}
+	return rs
+}
+
+// refreshBrokers refreshes the number of live brokers, changes the quota share of current broker if changed.
+func (m *manager) refreshBrokers() {
+	brokers := int64(len(m.stateMgr.GetLiveNodes()))
+	if brokers < 1 {
+		brokers = 1
+	}
+	if m.brokers.Swap(brokers) == brokers {
+		return
+	}
+	log.Info("number of live brokers changed, refresh quota share of tenants", logger.Int64("brokers", brokers))
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for _, s := range m.tenants {
+		s.share(brokers)
+	}
+}
+
+// collectLoop collects the usage of tenants periodically until manager stopped.
+func (m *manager) collectLoop() {
+	ticker := time.NewTicker(collectInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.collect()
+		}
+	}
+}
+
+// collect collects the number of series/disk bytes of databases from storage nodes,
+// then sums up the usage of each tenant, also calculates the ingest rate of current broker.
+func (m *manager) collect() {
+	m.refreshBrokers()
+	now := time.Now()
+	elapsed := now.Sub(m.lastCollect).Seconds()
+	m.lastCollect = now
+
+	databases := m.tenantDatabases()
+	var usages map[string]*databaseUsage
+	if len(databases) > 0 {
+		usages = m.fetchDatabaseUsage()
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for name, s := range m.tenants {
+		if elapsed > 0 {
+			s.usage.ingestRate.Store(float64(s.usage.writtenPoints.Swap(0)) / elapsed)
+		}
+		var numOfSeries, diskBytes int64
+		for _, db := range databases[name] {
+			if usage, ok := usages[db]; ok {
+				numOfSeries += usage.numOfSeries()
+				diskBytes += usage.diskBytes
+			}
+		}
+		s.usage.numOfSeries.Store(numOfSeries)
+		s.usage.diskBytes.Store(diskBytes)
+		statistics.NumOfSeries.WithTagValues(name).Update(float64(numOfSeries))
+		statistics.DiskBytes.WithTagValues(name).Update(float64(diskBytes))
+	}
+	statistics.UsageCollected.Incr()
+}
+
+// databaseUsage represents the usage of database in storage cluster.
+type databaseUsage struct {
+	// shardSeries represents the number of series of each shard, max value of all replicas.
+	shardSeries map[models.ShardID]int64
+	// diskBytes represents the disk bytes of all replicas.
+	diskBytes int64
+}
+
+// numOfSeries returns the number of series of all shards.
+func (u *databaseUsage) numOfSeries() (rs int64) {
+	for _, n := range u.shardSeries {
+		rs += n
+	}
+	return rs
+}
+
+// fetchDatabaseUsage fetches the usage of databases from all live storage nodes.
+func (m *manager) fetchDatabaseUsage() map[string]*databaseUsage {
+	var nodes []models.StatefulNode
+	for _, storage := range m.stateMgr.GetStorageList() {
+		for id := range storage.LiveNodes {
+			nodes = append(nodes, storage.LiveNodes[id])
+		}
+	}
+	result := make([][]models.DatabaseUsage, len(nodes))
+	var wait sync.WaitGroup
+	wait.Add(len(nodes))
+	for idx := range nodes {
+		i := idx
+		go func() {
+			defer wait.Done()
+			address := nodes[i].HTTPAddress()
+			var usages []models.DatabaseUsage
+			resp, err := newRestyFn().R().
+				SetHeader("Accept", "application/json").
+				SetResult(&usages).
+				Get(address + constants.APIVersion1CliPath + DatabaseUsagePath)
+			if err == nil && resp.IsError() {
+				err = fmt.Errorf("%s", resp.String())
+			}
+			if err != nil {
+				statistics.UsageFailures.Incr()
+				log.Warn("get database usage from storage node failure", logger.String("url", address), logger.Error(err))
+				return
+			}
+			result[i] = usages
+		}()
+	}
+	wait.Wait()
+
+	rs := make(map[string]*databaseUsage)
+	for _, usages := range result {
+		for idx := range usages {
+			usage := usages[idx]
+			dbUsage, ok := rs[usage.Database]
+			if !ok {
+				dbUsage = &databaseUsage{shardSeries: make(map[models.ShardID]int64)}
+				rs[usage.Database] = dbUsage
+			}
+			dbUsage.diskBytes += usage.MetaBytes
+			for _, shard := range usage.Shards {
+				dbUsage.diskBytes += shard.DiskBytes
+				if shard.NumOfSeries > dbUsage.shardSeries[shard.ShardID] {
+					dbUsage.shardSeries[shard.ShardID] = shard.NumOfSeries
+				}
+			}
+		}
+	}
+	return rs
+}
+
+// min returns the smaller one.
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// tenantListener listens the changes of tenants.
+type tenantListener struct {
+	m *manager
+}
+
+// OnCreate caches the tenant when tenant created/modified.
+func (l *tenantListener) OnCreate(key string, resource []byte) {
+	tenant := &models.Tenant{}
+	if err := encoding.JSONUnmarshal(resource, tenant); err != nil {
+		log.Error("unmarshal tenant error", logger.String("key", key), logger.Error(err))
+		return
+	}
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	l.m.tenants[tenant.Name] = newTenantState(tenant, l.m.tenants[tenant.Name], l.m.brokers.Load())
+}
+
+// OnDelete removes the tenant when tenant dropped.
+func (l *tenantListener) OnDelete(key string) {
+	l.m.mutex.Lock()
+	defer l.m.mutex.Unlock()
+	delete(l.m.tenants, path.Base(key))
+}
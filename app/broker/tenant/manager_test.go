@@ -0,0 +1,263 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func newTestManager(t *testing.T, stateMgr broker.StateManager, tenants ...*models.Tenant) *manager {
+	m := NewManager(context.TODO(), nil, stateMgr).(*manager)
+	l := &tenantListener{m: m}
+	for _, tenant := range tenants {
+		l.OnCreate(constants.GetTenantPath(tenant.Name), encoding.JSONMarshal(tenant))
+	}
+	assert.Len(t, m.tenants, len(tenants))
+	return m
+}
+
+func TestManager_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		collectInterval = time.Minute
+		ctrl.Finish()
+	}()
+	collectInterval = time.Millisecond
+
+	factory := discovery.NewMockFactory(ctrl)
+	d := discovery.NewMockDiscovery(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	factory.EXPECT().CreateDiscovery(constants.TenantPath, gomock.Any()).Return(d).AnyTimes()
+	stateMgr.EXPECT().GetDatabases().Return(nil).AnyTimes()
+	stateMgr.EXPECT().GetLiveNodes().Return(nil).AnyTimes()
+
+	m := NewManager(context.TODO(), factory, stateMgr)
+	d.EXPECT().Discovery(true).Return(fmt.Errorf("err"))
+	assert.Error(t, m.Start())
+
+	d.EXPECT().Discovery(true).Return(nil)
+	assert.NoError(t, m.Start())
+	time.Sleep(10 * time.Millisecond)
+	d.EXPECT().Close()
+	m.Stop()
+}
+
+func TestTenantListener(t *testing.T) {
+	m := newTestManager(t, nil, &models.Tenant{Name: "t1", Quota: models.TenantQuota{IngestRate: 10}})
+	l := &tenantListener{m: m}
+	l.OnCreate(constants.GetTenantPath("t2"), []byte("abc"))
+	assert.Len(t, m.tenants, 1)
+	assert.NotNil(t, m.tenants["t1"].limiter)
+
+	// keep usage after tenant modified
+	m.tenants["t1"].usage.numOfSeries.Store(10)
+	l.OnCreate(constants.GetTenantPath("t1"), encoding.JSONMarshal(&models.Tenant{Name: "t1"}))
+	assert.Nil(t, m.tenants["t1"].limiter)
+	assert.Equal(t, int64(10), m.tenants["t1"].usage.numOfSeries.Load())
+
+	l.OnDelete(constants.GetTenantPath("t1"))
+	assert.Empty(t, m.tenants)
+}
+
+func TestManager_AllowWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Name: "db", Tenant: "t1"}, true).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg("db2").Return(models.Database{Name: "db2"}, true).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg("db3").Return(models.Database{Name: "db3", Tenant: "t3"}, true).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
+	m := newTestManager(t, stateMgr, &models.Tenant{
+		Name:  "t1",
+		Quota: models.TenantQuota{IngestRate: 10, MaxSeries: 100, MaxDiskBytes: 1000},
+	})
+
+	// database not found/not belong to tenant/tenant not found
+	assert.NoError(t, m.AllowWrite("db0", 100))
+	assert.NoError(t, m.AllowWrite("db2", 100))
+	assert.NoError(t, m.AllowWrite("db3", 100))
+
+	usage := m.tenants["t1"].usage
+	// batch larger than burst is rejected without consuming the bucket
+	assert.ErrorIs(t, m.AllowWrite("db", 100), constants.ErrQuotaExceeded)
+	assert.Zero(t, usage.writtenPoints.Load())
+	// the whole batch is charged
+	assert.NoError(t, m.AllowWrite("db", 8))
+	assert.Equal(t, int64(8), usage.writtenPoints.Load())
+	// rate exceeded
+	assert.ErrorIs(t, m.AllowWrite("db", 3), constants.ErrQuotaExceeded)
+	assert.Equal(t, int64(8), usage.writtenPoints.Load())
+
+	usage.numOfSeries.Store(100)
+	assert.ErrorIs(t, m.AllowWrite("db", 1), constants.ErrQuotaExceeded)
+	usage.numOfSeries.Store(0)
+	usage.diskBytes.Store(1000)
+	assert.ErrorIs(t, m.AllowWrite("db", 1), constants.ErrQuotaExceeded)
+}
+
+func TestManager_ShareQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Name: "db", Tenant: "t1"}, true).AnyTimes()
+	m := newTestManager(t, stateMgr, &models.Tenant{
+		Name:  "t1",
+		Quota: models.TenantQuota{IngestRate: 100, MaxQueries: 3},
+	})
+	s := m.tenants["t1"]
+	assert.Equal(t, 100, s.limiter.Burst())
+	assert.Equal(t, int64(3), s.maxQueries.Load())
+
+	// broker joined
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "1.1.1.1"}, {HostIP: "1.1.1.2"}})
+	m.refreshBrokers()
+	assert.Equal(t, 50, s.limiter.Burst())
+	assert.Equal(t, int64(2), s.maxQueries.Load())
+	release, err := m.AcquireQuery("db")
+	assert.NoError(t, err)
+	_, err = m.AcquireQuery("db")
+	assert.NoError(t, err)
+	_, err = m.AcquireQuery("db")
+	assert.ErrorIs(t, err, constants.ErrQuotaExceeded)
+	release()
+	// tenant modified after broker joined
+	(&tenantListener{m: m}).OnCreate(constants.GetTenantPath("t1"), encoding.JSONMarshal(&models.Tenant{
+		Name:  "t1",
+		Quota: models.TenantQuota{IngestRate: 10},
+	}))
+	assert.Equal(t, 5, m.tenants["t1"].limiter.Burst())
+	// brokers not changed
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "1.1.1.1"}, {HostIP: "1.1.1.2"}})
+	m.refreshBrokers()
+	assert.Equal(t, 5, m.tenants["t1"].limiter.Burst())
+	stateMgr.EXPECT().GetLiveNodes().Return(nil)
+	m.refreshBrokers()
+	assert.Equal(t, 10, m.tenants["t1"].limiter.Burst())
+}
+
+func TestManager_AcquireQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Name: "db", Tenant: "t1"}, true).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
+	m := newTestManager(t, stateMgr, &models.Tenant{Name: "t1", Quota: models.TenantQuota{MaxQueries: 1}})
+
+	release, err := m.AcquireQuery("db0")
+	assert.NoError(t, err)
+	release()
+
+	release, err = m.AcquireQuery("db")
+	assert.NoError(t, err)
+	_, err = m.AcquireQuery("db")
+	assert.ErrorIs(t, err, constants.ErrQuotaExceeded)
+	release()
+	release()
+	assert.Equal(t, int64(0), m.tenants["t1"].usage.queries.Load())
+	release, err = m.AcquireQuery("db")
+	assert.NoError(t, err)
+	release()
+}
+
+func TestManager_Collect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newStorageNode := func(handler http.HandlerFunc) models.StatefulNode {
+		svr := httptest.NewServer(handler)
+		t.Cleanup(svr.Close)
+		u, err := url.Parse(svr.URL)
+		assert.NoError(t, err)
+		p, err := strconv.Atoi(u.Port())
+		assert.NoError(t, err)
+		return models.StatefulNode{StatelessNode: models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(p)}}
+	}
+	usageHandler := func(shardSeries int64) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Add("content-type", "application/json")
+			_, _ = w.Write(encoding.JSONMarshal([]models.DatabaseUsage{
+				{
+					Database:  "db1",
+					MetaBytes: 10,
+					Shards: []models.ShardUsage{
+						{ShardID: 1, NumOfSeries: shardSeries, DiskBytes: 100},
+						{ShardID: 2, NumOfSeries: 5, DiskBytes: 100},
+					},
+				},
+				{Database: "db2", MetaBytes: 10},
+			}))
+		}
+	}
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabases().Return([]models.Database{
+		{Name: "db1", Tenant: "t1"},
+		{Name: "db2", Tenant: "t1"},
+		{Name: "db3"},
+	}).AnyTimes()
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "1.1.1.1"}, {HostIP: "1.1.1.2"}}).AnyTimes()
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{{
+		Name: "s1",
+		LiveNodes: map[models.NodeID]models.StatefulNode{
+			1: newStorageNode(usageHandler(10)),
+			2: newStorageNode(usageHandler(8)),
+			3: newStorageNode(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}),
+		},
+	}}).AnyTimes()
+	m := newTestManager(t, stateMgr,
+		&models.Tenant{Name: "t1", Quota: models.TenantQuota{MaxSeries: 100, MaxQueries: 10}},
+		&models.Tenant{Name: "t2"},
+	)
+	m.tenants["t1"].usage.writtenPoints.Store(100)
+	m.lastCollect = time.Now().Add(-10 * time.Second)
+	m.collect()
+
+	usages := m.Usage()
+	assert.Len(t, usages, 2)
+	assert.Equal(t, "t1", usages[0].Name)
+	assert.Equal(t, []string{"db1", "db2"}, usages[0].Databases)
+	assert.Equal(t, int64(15), usages[0].NumOfSeries)
+	assert.Equal(t, int64(440), usages[0].DiskBytes)
+	assert.InDelta(t, 10, usages[0].IngestRate, 0.1)
+	assert.Equal(t, int64(100), usages[0].Quota.MaxSeries)
+	// quota shared by 2 live brokers
+	assert.Equal(t, int64(2), usages[0].Brokers)
+	assert.Equal(t, int64(5), m.tenants["t1"].maxQueries.Load())
+	assert.Equal(t, "t2", usages[1].Name)
+	assert.Empty(t, usages[1].Databases)
+}
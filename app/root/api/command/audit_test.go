@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/root/deps"
+	"github.com/lindb/lindb/internal/audit"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestAuditCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// audit log disabled
+	rs, err := AuditCommand(context.TODO(), &depspkg.HTTPDeps{}, nil, &stmt.Audit{})
+	assert.NoError(t, err)
+	assert.Nil(t, rs)
+
+	recorder := audit.NewMockRecorder(ctrl)
+	recorder.EXPECT().List(gomock.Any(), 10).Return(models.AuditEntries{{Statement: "create broker"}}, nil)
+	rs, err = AuditCommand(context.TODO(), &depspkg.HTTPDeps{Audit: recorder}, nil, &stmt.Audit{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/lindb/lindb/app/root/api/command"
 	depspkg "github.com/lindb/lindb/app/root/deps"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	sqlpkg "github.com/lindb/lindb/sql"
@@ -51,6 +52,7 @@ var (
 	commands = map[stmtpkg.StatementType]statementExecFn{
 		stmtpkg.BrokerStatement: command.BrokerCommand,
 		stmtpkg.SchemaStatement: command.SchemaCommand,
+		stmtpkg.AuditStatement:  command.AuditCommand,
 		// stmtpkg.MetadataStatement:       command.MetadataCommand,
 		// stmtpkg.StateStatement:          command.StateCommand,
 		// stmtpkg.MetricMetadataStatement: command.MetricMetadataCommand,
@@ -123,7 +125,9 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 
 	if commandFn, ok := commands[stmt.StatementType()]; ok {
-		result, err := commandFn(ctx, e.deps, &param, stmt)
+		result, err := audit.Execute(ctx, e.deps.Audit, e.deps.Repo, newAuditEntry(c, &param), stmt, func() (interface{}, error) {
+			return commandFn(ctx, e.deps, &param, stmt)
+		})
 		if err != nil {
 			return err
 		}
@@ -136,3 +140,16 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 	return errors.New("can't parse lin query language")
 }
+
+// newAuditEntry creates an audit entry of request, the user is the basic auth user name of request
+// (root api has no authentication, user name isn't verified).
+func newAuditEntry(c *gin.Context, param *models.ExecuteParam) *models.AuditEntry {
+	entry := &models.AuditEntry{
+		SourceIP:  c.ClientIP(),
+		Statement: param.SQL,
+	}
+	if user, _, ok := c.Request.BasicAuth(); ok {
+		entry.User = user
+	}
+	return entry
+}
@@ -19,6 +19,7 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -32,7 +33,9 @@ import (
 
 	"github.com/lindb/lindb/app/root/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/root"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/mock"
@@ -290,3 +293,48 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteAPI_Audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	recorder := audit.NewMockRecorder(ctrl)
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx:   context.Background(),
+		Repo:  repo,
+		Audit: recorder,
+		Cfg: &config.Root{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec_audit", linmetric.RootRegistry),
+		),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	recorder.EXPECT().List(gomock.Any(), 0).Return(models.AuditEntries{{Statement: "drop database db"}}, nil)
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show audit log"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	gomock.InOrder(
+		repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("db")).Return(nil, state.ErrNotExist),
+		repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseConfigPath("db"), gomock.Any()).Return(fmt.Errorf("err")),
+		repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("db")).Return(nil, state.ErrNotExist),
+	)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Equal(t, "admin", entry.User)
+		assert.False(t, entry.Success)
+		assert.Equal(t, "err", entry.Result)
+	})
+	databaseCfg := `{\"name\":\"db\",\"routers\":[{\"broker\":\"b1\",\"database\":\"db\"}]}`
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("admin:admin123")))
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"create database `+databaseCfg+`"}`, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
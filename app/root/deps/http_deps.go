@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator/root"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/pkg/state"
 )
@@ -34,6 +35,8 @@ type HTTPDeps struct {
 	Repo         state.Repository
 	RepoFactory  state.RepositoryFactory
 	StateMgr     root.StateManager
+	// Audit records the audit entries of state-mutating operations.
+	Audit audit.Recorder
 }
 
 func (deps *HTTPDeps) WithTimeout() (context.Context, context.CancelFunc) {
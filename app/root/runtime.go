@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/root"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
@@ -38,13 +39,15 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	"github.com/lindb/lindb/series/tag"
 )
 
 // just for testing
 var (
-	getHostIP = hostutil.GetHostIP
-	hostName  = os.Hostname
+	getHostIP        = hostutil.GetHostIP
+	hostName         = os.Hostname
+	newAuditRecorder = audit.NewRecorder
 )
 
 type runtime struct {
@@ -61,6 +64,7 @@ type runtime struct {
 	httpServer      httppkg.Server
 	globalKeyValues tag.Tags
 	stateMgr        root.StateManager
+	audit           audit.Recorder
 
 	logger *logger.Logger
 }
@@ -90,6 +94,13 @@ func (r *runtime) Run() error {
 		r.state = server.Failed
 		return fmt.Errorf("failed to get server ip address, error: %s", err)
 	}
+	// init tls config of http client for accessing other nodes
+	httpTLS, err := tlsutil.NewClientConfig(r.config.HTTP.TLS)
+	if err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("init tls config failure, error: %s", err)
+	}
+	tlsutil.SetDefaultClientConfig(httpTLS)
 	hostName, err := hostName()
 	if err != nil {
 		r.logger.Error("failed to get host name", logger.Error(err))
@@ -100,6 +111,7 @@ func (r *runtime) Run() error {
 		GRPCPort:   r.config.GRPC.Port,
 		HostName:   hostName,
 		HTTPPort:   r.config.HTTP.Port,
+		HTTPS:      r.config.HTTP.TLS.Enable,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
 	}
@@ -117,6 +129,11 @@ func (r *runtime) Run() error {
 		return err
 	}
 	r.stateMgr = root.NewStateManager(r.ctx, r.repoFactory)
+	// audit log of DDL/administrative operations
+	if r.audit, err = newAuditRecorder(&r.config.Audit, r.node.Indicator(), r.repo); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("create audit recorder error:%s", err)
+	}
 	// start http server
 	r.startHTTPServer()
 
@@ -143,6 +160,12 @@ func (r *runtime) Stop() {
 		}
 	}
 
+	if r.audit != nil {
+		if err := r.audit.Close(); err != nil {
+			r.logger.Error("close audit recorder error", logger.Error(err))
+		}
+	}
+
 	r.state = server.Terminated
 }
 
@@ -161,6 +184,7 @@ func (r *runtime) startHTTPServer() {
 		Repo:        r.repo,
 		RepoFactory: r.repoFactory,
 		StateMgr:    r.stateMgr,
+		Audit:       r.audit,
 		QueryLimiter: concurrent.NewLimiter(
 			r.ctx,
 			r.config.Query.QueryConcurrency,
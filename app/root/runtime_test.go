@@ -27,10 +27,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/audit"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/pkg/hostutil"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
 )
 
 var cfg = config.Root{
@@ -101,3 +103,22 @@ func TestRootRun_GetHost_Err(t *testing.T) {
 	root.Stop()
 	assert.NoError(t, err)
 }
+
+func TestRootRun_Audit_Err(t *testing.T) {
+	cluster := mock.StartEtcdCluster(t, "http://localhost:8110")
+	defer func() {
+		newAuditRecorder = audit.NewRecorder
+		cluster.Terminate(t)
+	}()
+	newAuditRecorder = func(_ *config.Audit, _ string, _ state.Repository) (audit.Recorder, error) {
+		return nil, fmt.Errorf("err")
+	}
+	cfg.Coordinator.Endpoints = cluster.Endpoints
+	cfg.GRPC.Port = 8886
+	cfg.HTTP.Port = 3992
+	root := NewRootRuntime("test-version", &cfg)
+	err := root.Run()
+	assert.Error(t, err)
+	assert.Equal(t, server.Failed, root.State())
+	root.Stop()
+}
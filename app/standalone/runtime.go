@@ -70,6 +70,12 @@ type runtime struct {
 // NewStandaloneRuntime creates the runtime
 func NewStandaloneRuntime(version string, cfg *config.Standalone, embedEtcd bool) server.Service {
 	ctx, cancel := context.WithCancel(context.Background())
+	// storage/initializer use built-in admin user of broker if authentication enabled
+	storageBase := cfg.StorageBase
+	if cfg.BrokerBase.Auth.Enable {
+		storageBase.BrokerAuth = cfg.BrokerBase.Auth.Admin
+	}
+	brokerEndpoint := fmt.Sprintf("http://localhost:%d", cfg.BrokerBase.HTTP.Port)
 	return &runtime{
 		version:     version,
 		embedEtcd:   embedEtcd,
@@ -89,12 +95,12 @@ func NewStandaloneRuntime(version string, cfg *config.Standalone, embedEtcd bool
 			&config.Storage{
 				Query:       cfg.Query,
 				Coordinator: cfg.Coordinator,
-				StorageBase: cfg.StorageBase,
+				StorageBase: storageBase,
 				Monitor:     cfg.Monitor,
 				Logging:     cfg.Logging,
 			}),
 		cfg:         cfg,
-		initializer: bootstrap.NewClusterInitializer(fmt.Sprintf("http://localhost:%d", cfg.BrokerBase.HTTP.Port)),
+		initializer: bootstrap.NewClusterInitializer(brokerEndpoint, storageBase.BrokerAuth),
 		ctx:         ctx,
 		cancel:      cancel,
 	}
@@ -0,0 +1,119 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	// BackupDatabasePath represents the path of database backup.
+	BackupDatabasePath = "/database/backup"
+	// RestoreDatabasePath represents the path of database restore.
+	RestoreDatabasePath = "/database/restore"
+)
+
+// BackupAPI represents database backup/restore rest api of storage node.
+type BackupAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewBackupAPI creates a database backup api instance.
+func NewBackupAPI(engine tsdb.Engine) *BackupAPI {
+	return &BackupAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "BackupAPI"),
+	}
+}
+
+// Register adds the route for database backup api.
+func (api *BackupAPI) Register(route gin.IRoutes) {
+	route.PUT(BackupDatabasePath, api.Backup)
+	route.PUT(RestoreDatabasePath, api.Restore)
+}
+
+// Backup creates a point-in-time copy of database under backup dir of current node.
+func (api *BackupAPI) Backup(c *gin.Context) {
+	param := &models.BackupParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	dir, err := backupPath(param.Dir)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	manifest, err := api.engine.BackupDatabase(param.Database, dir)
+	if err != nil {
+		api.logger.Error("backup database failure",
+			logger.String("database", param.Database), logger.String("dir", param.Dir), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("backup database successfully",
+		logger.String("database", param.Database), logger.String("dir", param.Dir),
+		logger.Int("files", len(manifest.Files)))
+	httppkg.OK(c, manifest)
+}
+
+// Restore rebuilds database from the backup under backup dir of current node.
+func (api *BackupAPI) Restore(c *gin.Context) {
+	param := &models.BackupParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	dir, err := backupPath(param.Dir)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := api.engine.RestoreDatabase(param.Database, dir); err != nil {
+		api.logger.Error("restore database failure",
+			logger.String("database", param.Database), logger.String("dir", param.Dir), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("restore database successfully",
+		logger.String("database", param.Database), logger.String("dir", param.Dir))
+	httppkg.OK(c, "success")
+}
+
+// backupPath returns the path of backup dir under the configured backup root dir,
+// rejects the dir which escapes backup root dir.
+func backupPath(dir string) (string, error) {
+	root := filepath.Clean(config.GlobalStorageConfig().TSDB.BackupDir)
+	path := filepath.Join(root, dir)
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("backup dir[%s] must be under backup root dir", dir)
+	}
+	return path, nil
+}
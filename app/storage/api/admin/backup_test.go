@@ -0,0 +1,102 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestBackupAPI_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewBackupAPI(engine)
+	r := gin.New()
+	api.Register(r)
+	dir := filepath.Join(config.GlobalStorageConfig().TSDB.BackupDir, "20221019")
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: dir escapes backup root dir
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test","dir":"../data"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: backup failure
+	engine.EXPECT().BackupDatabase("test", dir).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test","dir":"20221019"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: backup successfully
+	engine.EXPECT().BackupDatabase("test", dir).Return(&models.BackupManifest{Database: "test"}, nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test","dir":"20221019"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestBackupAPI_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewBackupAPI(engine)
+	r := gin.New()
+	api.Register(r)
+	dir := filepath.Join(config.GlobalStorageConfig().TSDB.BackupDir, "20221019")
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"dir":"20221019"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: dir escapes backup root dir
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"database":"test","dir":"../../etc"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: restore failure
+	engine.EXPECT().RestoreDatabase("test", dir).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"database":"test","dir":"20221019"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: restore successfully
+	engine.EXPECT().RestoreDatabase("test", dir).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"database":"test","dir":"20221019"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestBackupAPI_backupPath(t *testing.T) {
+	root := config.GlobalStorageConfig().TSDB.BackupDir
+	path, err := backupPath("20221019/full")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "20221019", "full"), path)
+	// absolute path is joined under backup root dir
+	path, err = backupPath("/tmp/backup")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "tmp", "backup"), path)
+
+	for _, dir := range []string{"", ".", "..", "../backup2", "a/../../b"} {
+		_, err = backupPath(dir)
+		assert.Error(t, err, dir)
+	}
+}
@@ -0,0 +1,95 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	// CompactDatabasePath represents the path of database manual compaction.
+	CompactDatabasePath = "/database/compact"
+	// CompactionStatePath represents the path of database compaction state.
+	CompactionStatePath = "/database/compact/state"
+)
+
+// CompactAPI represents database manual compaction rest api of storage node.
+type CompactAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewCompactAPI creates a database compaction api instance.
+func NewCompactAPI(engine tsdb.Engine) *CompactAPI {
+	return &CompactAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "CompactAPI"),
+	}
+}
+
+// Register adds the route for database compaction api.
+func (api *CompactAPI) Register(route gin.IRoutes) {
+	route.PUT(CompactDatabasePath, api.Compact)
+	route.GET(CompactionStatePath, api.GetCompactionState)
+}
+
+// Compact submits compaction/rollup job of database, the job runs in background,
+// ignore it if database not exist in current node.
+func (api *CompactAPI) Compact(c *gin.Context) {
+	param := &models.CompactParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db, ok := api.engine.GetDatabase(param.Database)
+	if !ok {
+		httppkg.OK(c, "success")
+		return
+	}
+	if err := db.Compact(param); err != nil {
+		api.logger.Error("submit database compaction job failure",
+			logger.String("database", param.Database), logger.Any("param", param), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("submit database compaction job successfully",
+		logger.String("database", param.Database), logger.Any("param", param))
+	httppkg.OK(c, "success")
+}
+
+// GetCompactionState returns the compaction state of database.
+func (api *CompactAPI) GetCompactionState(c *gin.Context) {
+	var param struct {
+		DB string `form:"db" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db, ok := api.engine.GetDatabase(param.DB)
+	if !ok {
+		httppkg.NotFound(c)
+		return
+	}
+	httppkg.OK(c, db.GetCompactionState())
+}
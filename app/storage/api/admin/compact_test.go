@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestCompactAPI_Compact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewCompactAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath, `{"database":"test"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not exist in current node
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath, `{"type":"compact","database":"test"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	// case 3: compact failure
+	engine.EXPECT().GetDatabase("test").Return(db, true).AnyTimes()
+	db.EXPECT().Compact(&models.CompactParam{
+		Type:       models.CompactTypeRollup,
+		Database:   "test",
+		ShardIDs:   []models.ShardID{1},
+		FamilyTime: 10,
+	}).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath,
+		`{"type":"rollup","database":"test","shardIds":[1],"familyTime":10}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: compact successfully
+	db.EXPECT().Compact(gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactDatabasePath, `{"type":"compact","database":"test"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestCompactAPI_GetCompactionState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewCompactAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, CompactionStatePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, CompactionStatePath+"?db=test", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// case 3: get state successfully
+	engine.EXPECT().GetDatabase("test").Return(db, true)
+	db.EXPECT().GetCompactionState().Return([]models.FamilyCompactionState{{Family: "10", Compacting: true}})
+	resp = mock.DoRequest(t, r, http.MethodGet, CompactionStatePath+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,70 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/replica"
+)
+
+var (
+	// FenceShardPath represents the path of fencing client writes of shard.
+	FenceShardPath = "/database/shard/fence"
+)
+
+// FenceAPI represents shard write fence rest api of storage node, which is used by replica move,
+// the leader of shard rejects client writes before its leadership is transferred,
+// so that the new leader can catch up its final write ahead log before it is retired.
+type FenceAPI struct {
+	walMgr replica.WriteAheadLogManager
+	logger *logger.Logger
+}
+
+// NewFenceAPI creates a shard write fence api instance.
+func NewFenceAPI(walMgr replica.WriteAheadLogManager) *FenceAPI {
+	return &FenceAPI{
+		walMgr: walMgr,
+		logger: logger.GetLogger("Storage", "FenceAPI"),
+	}
+}
+
+// Register adds the route for shard write fence api.
+func (api *FenceAPI) Register(route gin.IRoutes) {
+	route.PUT(FenceShardPath, api.Fence)
+}
+
+// Fence rejects client writes of shard until lease expired, then returns the write ahead log replica state of database,
+// the append index of shard's family logs in the state is final while fence keeps.
+func (api *FenceAPI) Fence(c *gin.Context) {
+	param := &models.FenceParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	api.walMgr.GetOrCreateLog(param.Database).Fence(param.ShardID, time.Duration(param.Lease)*time.Millisecond)
+	api.logger.Info("fence client writes of shard",
+		logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+		logger.Int64("lease", param.Lease))
+	httppkg.OK(c, api.walMgr.GetReplicaState(param.Database))
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/replica"
+)
+
+func TestFenceAPI_Fence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	wal := replica.NewMockWriteAheadLog(ctrl)
+	api := NewFenceAPI(walMgr)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, FenceShardPath, `{"shardId":1}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: fence shard, returns replica state
+	walMgr.EXPECT().GetOrCreateLog("test").Return(wal)
+	wal.EXPECT().Fence(models.ShardID(1), 15*time.Second)
+	walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{{ShardID: 1, Append: 10}})
+	resp = mock.DoRequest(t, r, http.MethodPut, FenceShardPath, `{"database":"test","shardId":1,"lease":15000}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"append":10`)
+}
@@ -0,0 +1,114 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	// ImportDatabasePath represents the path of bulk importing history data into shard.
+	ImportDatabasePath = "/database/import"
+)
+
+// ImportAPI represents bulk importing rest api of storage node,
+// which builds data files of shard directly, bypasses the write-ahead log.
+type ImportAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewImportAPI creates a bulk importing api instance.
+func NewImportAPI(engine tsdb.Engine) *ImportAPI {
+	return &ImportAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "ImportAPI"),
+	}
+}
+
+// Register adds the route for bulk importing api.
+func (api *ImportAPI) Register(route gin.IRoutes) {
+	route.POST(ImportDatabasePath, api.Import)
+}
+
+// Import imports the rows(size-prefixed flat metric rows, same as replication) of request body into shard.
+// The hash of request body is the import id, which is recorded by each family of shard,
+// so that the retried request(same body) skips the families which already applied it.
+func (api *ImportAPI) Import(c *gin.Context) {
+	param := &models.ImportParam{}
+	if err := c.ShouldBindQuery(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db, ok := api.engine.GetDatabase(param.Database)
+	if !ok {
+		httppkg.Error(c, constants.ErrDatabaseNotFound)
+		return
+	}
+	shard, ok := db.GetShard(param.ShardID)
+	if !ok {
+		httppkg.Error(c, fmt.Errorf("shard(%d) of database(%s) not found", param.ShardID, param.Database))
+		return
+	}
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rows, err := unmarshalRows(data)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	importID := xxhash.Sum64(data)
+	written, err := shard.Import(importID, rows)
+	if err != nil {
+		api.logger.Error("import rows into shard failure",
+			logger.String("database", param.Database), logger.Any("shardID", param.ShardID), logger.Any("importID", importID),
+			logger.Int("written", written), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("import rows into shard successfully",
+		logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+		logger.Int("rows", written), logger.Int("skipped", len(rows)-written))
+	httppkg.OK(c, &models.ImportResult{Rows: written, Skipped: len(rows) - written, Shards: 1})
+}
+
+// unmarshalRows unmarshalls size-prefixed rows, returns error if data is corrupted.
+func unmarshalRows(data []byte) (rows []metric.StorageRow, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("bad import rows binary")
+		}
+	}()
+	batch := metric.NewStorageBatchRows()
+	batch.UnmarshalRows(data)
+	return batch.Rows(), nil
+}
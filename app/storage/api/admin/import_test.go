@@ -0,0 +1,100 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestImportAPI_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	api := NewImportAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	var buf bytes.Buffer
+	_, err := metric.NewProtoConverter().MarshalProtoMetricListV1To(protoMetricsV1.MetricList{
+		Metrics: []*protoMetricsV1.Metric{{
+			Name:      "cpu",
+			Timestamp: timeutil.Now(),
+			SimpleFields: []*protoMetricsV1.SimpleField{{
+				Name:  "usage",
+				Value: 1.0,
+				Type:  protoMetricsV1.SimpleFieldType_LAST,
+			}},
+		}},
+	}, &buf)
+	assert.NoError(t, err)
+	body := buf.String()
+	path := ImportDatabasePath + "?db=test&shard=1"
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodPost, ImportDatabasePath, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: shard not found
+	engine.EXPECT().GetDatabase("test").Return(db, true).AnyTimes()
+	db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: bad rows
+	db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true).AnyTimes()
+	resp = mock.DoRequest(t, r, http.MethodPost, path, "bad")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 5: import failure
+	shard.EXPECT().Import(xxhash.Sum64String(body), gomock.Any()).Return(0, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 6: import successfully
+	shard.EXPECT().Import(xxhash.Sum64String(body), gomock.Any()).DoAndReturn(func(_ uint64, rows []metric.StorageRow) (int, error) {
+		assert.Len(t, rows, 1)
+		assert.Equal(t, "cpu", string(rows[0].Name()))
+		return 1, nil
+	})
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"rows":1,"skipped":0,"shards":1}`, resp.Body.String())
+	// case 7: rows skipped
+	shard.EXPECT().Import(xxhash.Sum64String(body), gomock.Any()).Return(0, nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, path, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"rows":0,"skipped":1,"shards":1}`, resp.Body.String())
+}
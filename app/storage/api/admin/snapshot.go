@@ -0,0 +1,172 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	// SnapshotShardPath represents the path of fetching shard's family snapshot.
+	SnapshotShardPath = "/database/snapshot"
+	// InstallSnapshotPath represents the path of installing shard's family snapshot which copied from source node.
+	InstallSnapshotPath = "/database/snapshot/install"
+)
+
+// for testing
+var (
+	newStorageAdminCliFn = client.NewStorageAdminCli
+	mkTempDirFn          = os.MkdirTemp
+	snapshotDirFn        = snapshotDir
+)
+
+// SnapshotAPI represents shard's family snapshot rest api of storage node, which is used by replica move,
+// the snapshot is restored as database on the node which doesn't host the database,
+// else it is merged into the existing database, because the ids of metadata are node local.
+type SnapshotAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewSnapshotAPI creates a snapshot api instance.
+func NewSnapshotAPI(engine tsdb.Engine) *SnapshotAPI {
+	return &SnapshotAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "SnapshotAPI"),
+	}
+}
+
+// Register adds the route for snapshot api.
+func (api *SnapshotAPI) Register(route gin.IRoutes) {
+	route.GET(SnapshotShardPath, api.Snapshot)
+	route.PUT(InstallSnapshotPath, api.Install)
+}
+
+// Snapshot creates a point-in-time copy of shard, then writes it into response as tar archive.
+func (api *SnapshotAPI) Snapshot(c *gin.Context) {
+	var param struct {
+		Database string         `form:"database" binding:"required"`
+		ShardID  models.ShardID `form:"shardID"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	dir, err := api.createTempDir()
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	defer api.removeTempDir(dir)
+
+	manifest, err := api.engine.SnapshotShard(param.Database, param.ShardID, dir)
+	if err != nil {
+		api.logger.Error("snapshot shard failure",
+			logger.String("database", param.Database), logger.Any("shardID", param.ShardID), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	c.Header("Content-Type", "application/x-tar")
+	c.Status(http.StatusOK)
+	if err := fileutil.TarDir(dir, c.Writer); err != nil {
+		// response header written, just log it, receiver cannot extract broken archive.
+		api.logger.Error("write snapshot archive failure",
+			logger.String("database", param.Database), logger.Any("shardID", param.ShardID), logger.Error(err))
+		return
+	}
+	api.logger.Info("write snapshot archive successfully",
+		logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+		logger.Int("files", len(manifest.Files)))
+}
+
+// Install fetches shard's family snapshot from source node, then restores it as local database.
+func (api *SnapshotAPI) Install(c *gin.Context) {
+	param := &models.SnapshotParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if _, ok := api.engine.GetShard(param.Database, param.ShardID); ok {
+		// snapshot installed(retry by master)
+		httppkg.OK(c, "success")
+		return
+	}
+	if err := api.install(param); err != nil {
+		api.logger.Error("install snapshot failure",
+			logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+			logger.String("source", param.Source.Indicator()), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("install snapshot successfully",
+		logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+		logger.String("source", param.Source.Indicator()))
+	httppkg.OK(c, "success")
+}
+
+// install fetches snapshot archive into temp dir, then installs it into local database.
+func (api *SnapshotAPI) install(param *models.SnapshotParam) error {
+	dir, err := api.createTempDir()
+	if err != nil {
+		return err
+	}
+	defer api.removeTempDir(dir)
+
+	if err := newStorageAdminCliFn().FetchSnapshot(&param.Source, param.Database, param.ShardID,
+		func(archive io.Reader) error {
+			return fileutil.Untar(archive, dir)
+		}); err != nil {
+		return err
+	}
+	return api.engine.InstallShardSnapshot(param.Database, param.ShardID, dir)
+}
+
+// createTempDir creates temp dir for snapshot.
+func (api *SnapshotAPI) createTempDir() (string, error) {
+	parent := snapshotDirFn()
+	if err := fileutil.MkDirIfNotExist(parent); err != nil {
+		return "", err
+	}
+	return mkTempDirFn(parent, "snapshot-")
+}
+
+// removeTempDir removes temp dir of snapshot.
+func (api *SnapshotAPI) removeTempDir(dir string) {
+	if err := fileutil.RemoveDir(dir); err != nil {
+		api.logger.Warn("remove snapshot temp dir failure", logger.String("dir", dir), logger.Error(err))
+	}
+}
+
+// snapshotDir returns the parent dir of snapshot temp dir, which is beside tsdb dir(same device for hard link),
+// cannot under tsdb dir, because all children of tsdb dir are loaded as database.
+func snapshotDir() string {
+	return filepath.Join(filepath.Dir(filepath.Clean(config.GlobalStorageConfig().TSDB.Dir)), "snapshot")
+}
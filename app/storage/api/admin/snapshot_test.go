@@ -0,0 +1,195 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestSnapshotAPI_Snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tmpDir := t.TempDir()
+	defer func() {
+		mkTempDirFn = os.MkdirTemp
+		snapshotDirFn = snapshotDir
+		ctrl.Finish()
+	}()
+	snapshotDirFn = func() string {
+		return tmpDir
+	}
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewSnapshotAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: param invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, SnapshotShardPath+"?shardID=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: create temp dir failure
+	mkTempDirFn = func(_, _ string) (string, error) {
+		return "", fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet, SnapshotShardPath+"?database=db&shardID=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	mkTempDirFn = os.MkdirTemp
+	// case 3: snapshot failure
+	engine.EXPECT().SnapshotShard("db", models.ShardID(1), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, SnapshotShardPath+"?database=db&shardID=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: snapshot successfully
+	engine.EXPECT().SnapshotShard("db", models.ShardID(1), gomock.Any()).
+		DoAndReturn(func(_ string, _ models.ShardID, dir string) (*models.BackupManifest, error) {
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, "OPTIONS"), []byte("options"), 0600))
+			return &models.BackupManifest{Files: []string{"OPTIONS"}}, nil
+		})
+	resp = mock.DoRequest(t, r, http.MethodGet, SnapshotShardPath+"?database=db&shardID=1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	tr := tar.NewReader(resp.Body)
+	header, err := tr.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "OPTIONS", header.Name)
+	// temp dir removed
+	files, err := fileutil.ListDir(tmpDir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestSnapshotAPI_Install(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tmpDir := t.TempDir()
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		snapshotDirFn = snapshotDir
+		ctrl.Finish()
+	}()
+	snapshotDirFn = func() string {
+		return tmpDir
+	}
+	cli := client.NewMockStorageAdminCli(ctrl)
+	newStorageAdminCliFn = func() client.StorageAdminCli {
+		return cli
+	}
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewSnapshotAPI(engine)
+	r := gin.New()
+	api.Register(r)
+	body := `{"database":"db","shardId":1,"source":{"hostIp":"127.0.0.1","httpPort":9000}}`
+
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			body: `{"shardId":1}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "snapshot installed",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("db", models.ShardID(1)).Return(nil, true)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "fetch snapshot failure",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("db", models.ShardID(1)).Return(nil, false)
+				cli.EXPECT().FetchSnapshot(gomock.Any(), "db", models.ShardID(1), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "extract snapshot failure",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("db", models.ShardID(1)).Return(nil, false)
+				cli.EXPECT().FetchSnapshot(gomock.Any(), "db", models.ShardID(1), gomock.Any()).
+					DoAndReturn(func(_ models.Node, _ string, _ models.ShardID, fn func(archive io.Reader) error) error {
+						return fn(bytes.NewBufferString("bad archive"))
+					})
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "install failure",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("db", models.ShardID(1)).Return(nil, false)
+				cli.EXPECT().FetchSnapshot(gomock.Any(), "db", models.ShardID(1), gomock.Any()).Return(nil)
+				engine.EXPECT().InstallShardSnapshot("db", models.ShardID(1), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "install successfully",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("db", models.ShardID(1)).Return(nil, false)
+				cli.EXPECT().FetchSnapshot(gomock.Any(), "db", models.ShardID(1), gomock.Any()).
+					DoAndReturn(func(node models.Node, _ string, _ models.ShardID, fn func(archive io.Reader) error) error {
+						assert.Equal(t, "http://127.0.0.1:9000", node.HTTPAddress())
+						src := t.TempDir()
+						assert.NoError(t, os.WriteFile(filepath.Join(src, "OPTIONS"), []byte("options"), 0600))
+						buf := &bytes.Buffer{}
+						assert.NoError(t, fileutil.TarDir(src, buf))
+						return fn(buf)
+					})
+				engine.EXPECT().InstallShardSnapshot("db", models.ShardID(1), gomock.Any()).
+					DoAndReturn(func(_ string, _ models.ShardID, dir string) error {
+						assert.True(t, fileutil.Exist(filepath.Join(dir, "OPTIONS")))
+						return nil
+					})
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPut, InstallSnapshotPath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
@@ -22,11 +22,13 @@ import (
 
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/query/tracker"
 )
 
 const (
-	RequestPath  = "/state/request"
-	RequestsPath = "/state/requests"
+	RequestPath      = "/state/request"
+	RequestsPath     = "/state/requests"
+	SlowRequestsPath = "/state/slow-requests"
 )
 
 // RequestAPI represents lin query request stats related api.
@@ -42,6 +44,7 @@ func NewRequestAPI() *RequestAPI {
 func (r *RequestAPI) Register(route gin.IRoutes) {
 	route.GET(RequestPath, r.GetRequestState)
 	route.GET(RequestsPath, r.GetAllAliveRequests)
+	route.GET(SlowRequestsPath, r.GetSlowRequests)
 }
 
 // GetRequestState returns request stats by given request id.
@@ -66,3 +69,8 @@ func (r *RequestAPI) GetRequestState(c *gin.Context) {
 func (r *RequestAPI) GetAllAliveRequests(c *gin.Context) {
 	httppkg.OK(c, query.GetPipelineManager().GetAllAlivePipelines())
 }
+
+// GetSlowRequests returns recent slow requests of current storage node.
+func (r *RequestAPI) GetSlowRequests(c *gin.Context) {
+	httppkg.OK(c, tracker.GetSlowQueries())
+}
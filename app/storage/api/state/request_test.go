@@ -44,6 +44,10 @@ func TestRequestAPI(t *testing.T) {
 		resp := mock.DoRequest(t, r, http.MethodGet, RequestsPath, "")
 		assert.Equal(t, http.StatusOK, resp.Code)
 	})
+	t.Run("get slow requests", func(t *testing.T) {
+		resp := mock.DoRequest(t, r, http.MethodGet, SlowRequestsPath, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
 	t.Run("param invalid", func(t *testing.T) {
 		resp := mock.DoRequest(t, r, http.MethodGet, RequestPath, "")
 		assert.Equal(t, http.StatusInternalServerError, resp.Code)
@@ -0,0 +1,82 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"sort"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	DatabaseUsagePath = "/state/tsdb/usage"
+)
+
+// UsageAPI represents the resource usage of local databases rest api.
+type UsageAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewUsageAPI creates a database usage api instance.
+func NewUsageAPI(engine tsdb.Engine) *UsageAPI {
+	return &UsageAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "UsageAPI"),
+	}
+}
+
+// Register adds database usage url route.
+func (u *UsageAPI) Register(route gin.IRoutes) {
+	route.GET(DatabaseUsagePath, u.GetDatabaseUsage)
+}
+
+// GetDatabaseUsage returns the resource usage(number of series/disk bytes) of local databases,
+// returns all databases if db param not set.
+func (u *UsageAPI) GetDatabaseUsage(c *gin.Context) {
+	var param struct {
+		DB string `form:"db"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	var rs []models.DatabaseUsage
+	for name, db := range u.engine.GetAllDatabases() {
+		if param.DB != "" && param.DB != name {
+			continue
+		}
+		usage, err := db.GetUsage()
+		if err != nil {
+			u.logger.Warn("get database usage failure",
+				logger.String("database", name), logger.Error(err))
+			httppkg.Error(c, err)
+			return
+		}
+		rs = append(rs, *usage)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Database < rs[j].Database
+	})
+	httppkg.OK(c, rs)
+}
@@ -0,0 +1,87 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestUsageAPI_GetDatabaseUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db1 := tsdb.NewMockDatabase(ctrl)
+	db2 := tsdb.NewMockDatabase(ctrl)
+	api := NewUsageAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	engine.EXPECT().GetAllDatabases().Return(map[string]tsdb.Database{"db1": db1, "db2": db2}).AnyTimes()
+	cases := []struct {
+		name    string
+		reqBody string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name:    "get usage failure",
+			reqBody: "?db=db1",
+			prepare: func() {
+				db1.EXPECT().GetUsage().Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "get usage of one database",
+			reqBody: "?db=db1",
+			prepare: func() {
+				db1.EXPECT().GetUsage().Return(&models.DatabaseUsage{Database: "db1"}, nil)
+			},
+		},
+		{
+			name: "get usage of all databases",
+			prepare: func() {
+				db1.EXPECT().GetUsage().Return(&models.DatabaseUsage{Database: "db1"}, nil)
+				db2.EXPECT().GetUsage().Return(&models.DatabaseUsage{Database: "db2"}, nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			resp := mock.DoRequest(t, r, http.MethodGet, DatabaseUsagePath+tt.reqBody, "")
+			if tt.wantErr {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			} else {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			}
+		})
+	}
+}
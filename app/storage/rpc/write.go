@@ -61,7 +61,7 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		return status.Error(codes.InvalidArgument, "replicas cannot be empty")
 	}
 
-	p, err := r.getOrCreatePartition(
+	wal, p, err := r.getOrCreatePartition(
 		familyState.Database,
 		familyState.Shard.ID,
 		familyState.FamilyTime,
@@ -88,8 +88,8 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		}
 
 		resp := &protoWriteV1.WriteResponse{}
-		// write wal log
-		err = p.WriteLog(req.Record)
+		// write wal log, rejected if shard is fenced(leadership transferring)
+		err = wal.WriteLog(familyState.Shard.ID, p, req.Record)
 
 		if err != nil {
 			resp.Err = err.Error()
@@ -114,17 +114,17 @@ func (r *WriteHandler) getFamilyInfoFromCtx(ctx context.Context) (familyState mo
 	return
 }
 
-// getOrCreatePartition returns write ahead log's partition if it exists, else creates a new partition.
+// getOrCreatePartition returns write ahead log and its partition if it exists, else creates a new partition.
 func (r *WriteHandler) getOrCreatePartition(
 	database string,
 	shardID models.ShardID,
 	familyTime int64,
 	leader models.NodeID,
-) (replica.Partition, error) {
+) (replica.WriteAheadLog, replica.Partition, error) {
 	wal := r.walMgr.GetOrCreateLog(database)
 	p, err := wal.GetOrCreatePartition(shardID, familyTime, leader)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return p, nil
+	return wal, p, nil
 }
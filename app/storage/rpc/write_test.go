@@ -90,13 +90,13 @@ func TestWriteHandler_Write(t *testing.T) {
 	assert.NoError(t, err)
 	// case 9: write wal err
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(fmt.Errorf("err"))
+	wal.EXPECT().WriteLog(gomock.Any(), p, gomock.Any()).Return(fmt.Errorf("err"))
 	replicaServer.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
 	// case 10: write wal ok
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	wal.EXPECT().WriteLog(gomock.Any(), p, gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Send(gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
 	err = r.Write(replicaServer)
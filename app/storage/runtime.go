@@ -27,31 +27,38 @@ import (
 	"strconv"
 	"time"
 
+	storageadmin "github.com/lindb/lindb/app/storage/api/admin"
 	stateapi "github.com/lindb/lindb/app/storage/api/state"
 	rpchandler "github.com/lindb/lindb/app/storage/rpc"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/internal/bootstrap"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/tier"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
@@ -77,7 +84,9 @@ var (
 	newStateMachineFactory    = storage.NewStateMachineFactory
 	newDatabaseLifecycleFn    = NewDatabaseLifecycle
 	newEngineFn               = tsdb.NewEngine
+	newObjectStoreFn          = tier.NewObjectStore
 	newWriteAheadLogManagerFn = replica.NewWriteAheadLogManager
+	newClientCredentialsFn    = rpc.NewClientCredentials
 	mkDirIfNotExistFn         = fileutil.MkDirIfNotExist
 	readFileFn                = os.ReadFile
 	writeFileFn               = os.WriteFile
@@ -138,7 +147,7 @@ func NewStorageRuntime(version string, myID int, cfg *config.Storage) server.Ser
 			cfg.Query.IdleTimeout.Duration(),
 			metrics.NewConcurrentStatistics("storage-query", linmetric.StorageRegistry)),
 		delayInit:   time.Second,
-		initializer: bootstrap.NewClusterInitializer(cfg.StorageBase.BrokerEndpoint),
+		initializer: bootstrap.NewClusterInitializer(cfg.StorageBase.BrokerEndpoint, cfg.StorageBase.BrokerAuth),
 		log:         logger.GetLogger("Storage", "Runtime"),
 	}
 }
@@ -165,11 +174,33 @@ func (r *runtime) Run() error {
 		r.state = server.Failed
 		return fmt.Errorf("failed to get server ip address, error: %s", err)
 	}
+	if err = r.initTLS(); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("init tls config failure, error: %s", err)
+	}
 
+	tierCfg := &config.GlobalStorageConfig().Tier
+	objectStore, err := newObjectStoreFn(tierCfg)
+	if err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("failed to create cold tier storage, error: %s", err)
+	}
+	tsdbCfg := &config.GlobalStorageConfig().TSDB
 	opt := kv.StoreOptions{
-		Dir: config.GlobalStorageConfig().TSDB.Dir,
+		Dir:          tsdbCfg.Dir,
+		Tier:         objectStore,
+		OffloadAfter: tierCfg.OffloadAfter.Duration(),
+		IOLimiter: kv.NewIOLimiter(kv.IOLimiterOptions{
+			Rate:          int64(tsdbCfg.IORateLimit),
+			MinRate:       int64(tsdbCfg.IOMinRateLimit),
+			BusyThreshold: int64(tsdbCfg.IOBusyThreshold),
+		}),
 	}
 	kv.Options.Store(&opt)
+	// limit memory used by query execution(aggregation/grouping)
+	flow.SetMemoryLimit(int64(r.config.Query.MaxMemoryPerQuery), int64(r.config.Query.MaxMemory))
+	// record the query which cost exceeds the threshold into slow query log
+	tracker.SetSlowQueryLog(r.config.Query.SlowQueryThreshold.Duration(), r.config.Query.SlowQueryHistory)
 	r.jobScheduler = kv.NewJobScheduler(r.ctx, opt)
 	r.jobScheduler.Startup() // startup kv compact job scheduler
 
@@ -193,6 +224,7 @@ func (r *runtime) Run() error {
 			GRPCPort:   r.config.StorageBase.GRPC.Port,
 			HostName:   hostName,
 			HTTPPort:   r.config.StorageBase.HTTP.Port,
+			HTTPS:      r.config.StorageBase.HTTP.TLS.Enable,
 			OnlineTime: timeutil.Now(),
 			Version:    config.Version,
 		},
@@ -325,6 +357,22 @@ func (r *runtime) State() server.State {
 	return r.state
 }
 
+// initTLS initializes tls config/credential of grpc/http client for accessing other nodes.
+func (r *runtime) initTLS() error {
+	client.SetDefaultPeerAuth(r.config.StorageBase.BrokerAuth)
+	creds, err := newClientCredentialsFn(r.config.StorageBase.GRPC.TLS)
+	if err != nil {
+		return err
+	}
+	rpc.GetStorageClientConnFactory().SetTransportCredentials(creds)
+	httpTLS, err := tlsutil.NewClientConfig(r.config.StorageBase.HTTP.TLS)
+	if err != nil {
+		return err
+	}
+	tlsutil.SetDefaultClientConfig(httpTLS)
+	return nil
+}
+
 // startStateRepo starts state repository
 func (r *runtime) startStateRepo() error {
 	repo, err := r.repoFactory.CreateStorageRepo(&r.config.Coordinator)
@@ -416,6 +464,22 @@ func (r *runtime) startHTTPServer() {
 	requestAPI.Register(v1)
 	metadataAPI := stateapi.NewMetadataAPI(r.engine)
 	metadataAPI.Register(v1)
+	usageAPI := stateapi.NewUsageAPI(r.engine)
+	usageAPI.Register(v1)
+	// admin api which changes/exports data, only accessed by other nodes of cluster
+	adminRouter := v1.Group("",
+		middleware.NewAuthentication(middleware.NewPeerAuthenticator(
+			r.config.StorageBase.BrokerAuth, r.config.StorageBase.PeerNames)).Validate())
+	backupAPI := storageadmin.NewBackupAPI(r.engine)
+	backupAPI.Register(adminRouter)
+	compactAPI := storageadmin.NewCompactAPI(r.engine)
+	compactAPI.Register(adminRouter)
+	importAPI := storageadmin.NewImportAPI(r.engine)
+	importAPI.Register(adminRouter)
+	snapshotAPI := storageadmin.NewSnapshotAPI(r.engine)
+	snapshotAPI.Register(adminRouter)
+	fenceAPI := storageadmin.NewFenceAPI(r.walMgr)
+	fenceAPI.Register(adminRouter)
 
 	go func() {
 		if err := r.httpServer.Run(); err != http.ErrServerClosed {
@@ -476,6 +540,7 @@ func (r *runtime) nativePusher() {
 	r.pusher = monitoring.NewNativeProtoPusher(
 		r.ctx,
 		r.config.Monitor.URL,
+		r.config.Monitor.Token,
 		r.config.Monitor.ReportInterval.Duration(),
 		r.config.Monitor.PushTimeout.Duration(),
 		linmetric.StorageRegistry,
@@ -28,6 +28,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
@@ -216,6 +217,18 @@ func TestStorageRun_Err(t *testing.T) {
 	}
 	err = storage.Run()
 	assert.Error(t, err)
+
+	// init tls config failure
+	defer func() {
+		newClientCredentialsFn = rpc.NewClientCredentials
+	}()
+	newClientCredentialsFn = func(_ config.TLS) (credentials.TransportCredentials, error) {
+		return nil, fmt.Errorf("err")
+	}
+	cfg.StorageBase.TSDB.Dir = filepath.Join(t.TempDir(), "8")
+	storage = NewStorageRuntime("test-version", 8, &cfg)
+	err = storage.Run()
+	assert.Error(t, err)
 }
 
 func TestStorage_MyID(t *testing.T) {
@@ -23,8 +23,10 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/fatih/color"
@@ -33,7 +35,10 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/export"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	"github.com/lindb/lindb/sql"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
@@ -48,7 +53,8 @@ var (
 )
 
 const (
-	HTTPScheme = "http://"
+	HTTPScheme  = "http://"
+	HTTPSScheme = "https://"
 )
 
 type inputCtx struct {
@@ -57,6 +63,11 @@ type inputCtx struct {
 
 var (
 	endpoint string
+	userName string
+	password string
+	caFile   string
+	certFile string
+	keyFile  string
 	// tokens represents suggest token.
 	tokens = []prompt.Suggest{
 		{Text: "show"},
@@ -84,8 +95,11 @@ var (
 		{Text: "key"},
 		{Text: "values"},
 		{Text: "and"},
+		{Text: "export"},
+		{Text: "to"},
 	}
 	spacesPattern = regexp.MustCompile(`\s+`)
+	exportPattern = regexp.MustCompile(`(?is)^export\s+(.+)\s+to\s+'([^']+)'$`)
 	inputC        = &inputCtx{}
 	query         = ""
 	live          = true
@@ -94,6 +108,11 @@ var (
 
 func init() {
 	flag.StringVar(&endpoint, "endpoint", "http://localhost:9000", "Broker HTTP Endpoint")
+	flag.StringVar(&userName, "username", "", "User name, used if broker authentication enabled")
+	flag.StringVar(&password, "password", "", "Password of user")
+	flag.StringVar(&caFile, "ca-file", "", "CA file for verifying broker's certificate, used if endpoint is https")
+	flag.StringVar(&certFile, "cert-file", "", "Client certificate file, used if broker requires client certificate")
+	flag.StringVar(&keyFile, "key-file", "", "Client private key file")
 }
 
 // printErr prints error message.
@@ -118,11 +137,16 @@ func executor(in string) {
 			return
 		}
 		blocks := strings.Split(spacesPattern.ReplaceAllString(query, " "), " ")
-		switch blocks[0] {
+		switch strings.ToLower(blocks[0]) {
 		case "exit":
 			fmt.Println("Good Bye :)")
 			exit(0)
 			return
+		case "export":
+			if err := exportQuery(query); err != nil {
+				printErr(err)
+			}
+			return
 		default:
 			stmt, err := sql.Parse(query)
 			if err != nil {
@@ -136,8 +160,11 @@ func executor(in string) {
 				fmt.Printf("Database changed(current:%s)\n", inputC.db)
 				return
 			case *stmtpkg.Storage:
-				if s.Type == stmtpkg.StorageOpShow {
+				switch s.Type {
+				case stmtpkg.StorageOpShow:
 					result = &models.Storages{}
+				case stmtpkg.StorageOpShowRebalance:
+					result = &models.ReplicaMoves{}
 				}
 			case *stmtpkg.State:
 				// execute state query
@@ -154,6 +181,39 @@ func executor(in string) {
 				case stmtpkg.DatabaseSchemaType:
 					result = &models.Databases{}
 				}
+			case *stmtpkg.Request:
+				if s.Type == stmtpkg.SlowRequests {
+					result = &models.SlowRequests{}
+				}
+			case *stmtpkg.RecordingRule:
+				if s.Type == stmtpkg.RecordingRuleOpShow {
+					result = &models.RecordingRules{}
+				}
+			case *stmtpkg.Alert:
+				if s.Type == stmtpkg.AlertOpShow {
+					result = &models.AlertRuleStates{}
+				}
+			case *stmtpkg.User:
+				if s.Type == stmtpkg.UserOpShow {
+					result = &models.Users{}
+				}
+			case *stmtpkg.Role:
+				if s.Type == stmtpkg.RoleOpShow {
+					result = &models.Roles{}
+				}
+			case *stmtpkg.Token:
+				if s.Type == stmtpkg.TokenOpShow {
+					result = &models.APITokens{}
+				}
+			case *stmtpkg.Tenant:
+				switch s.Type {
+				case stmtpkg.TenantOpShow:
+					result = &models.Tenants{}
+				case stmtpkg.TenantOpShowUsage:
+					result = &models.TenantUsages{}
+				}
+			case *stmtpkg.Audit:
+				result = &models.AuditEntries{}
 			case *stmtpkg.MetricMetadata:
 				if strings.TrimSpace(inputC.db) == "" {
 					printErr(errors.New("please select database(use ...)"))
@@ -182,6 +242,38 @@ func executor(in string) {
 	live = false
 }
 
+// exportQuery executes metric query, then writes the result into file with the format of file extension,
+// syntax: EXPORT <query> TO '<file>'.
+func exportQuery(in string) error {
+	matches := exportPattern.FindStringSubmatch(strings.TrimSpace(in))
+	if len(matches) != 3 {
+		return errors.New("invalid export statement, syntax: EXPORT <query> TO '<file(.csv/.arrow/.parquet)>'")
+	}
+	if strings.TrimSpace(inputC.db) == "" {
+		return errors.New("please select database(use ...)")
+	}
+	fileName := matches[2]
+	format, err := export.ParseFormat(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if err != nil {
+		return fmt.Errorf("unknown export format of file '%s', file extension should be .csv/.arrow/.parquet", fileName)
+	}
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	n := time.Now()
+	size, err := cli.ExecuteExport(models.ExecuteParam{SQL: matches[1], Database: inputC.db, Format: string(format)}, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(fileName)
+		return err
+	}
+	fmt.Printf("Export OK, %s written to %s (%s)\n", ltoml.Size(size), fileName, ltoml.Duration(time.Since(n)))
+	return nil
+}
+
 // completer returns prompt suggest.
 func completer(bc string) []prompt.Suggest {
 	if bc == "" {
@@ -195,9 +287,17 @@ func completer(bc string) []prompt.Suggest {
 func main() {
 	flag.Parse()
 
-	if !strings.HasPrefix(endpoint, HTTPScheme) {
+	if !strings.HasPrefix(endpoint, HTTPScheme) && !strings.HasPrefix(endpoint, HTTPSScheme) {
 		endpoint = fmt.Sprintf("%s%s", HTTPScheme, endpoint)
 	}
+	if strings.HasPrefix(endpoint, HTTPSScheme) {
+		tlsCfg, err := tlsutil.NewClientConfig(config.TLS{Enable: true, CAFile: caFile, CertFile: certFile, KeyFile: keyFile})
+		if err != nil {
+			printErr(err)
+			return
+		}
+		tlsutil.SetDefaultClientConfig(tlsCfg)
+	}
 	endpointURL, err := urlParse(endpoint)
 	if err != nil {
 		printErr(err)
@@ -208,6 +308,9 @@ func main() {
 
 	apiEndpoint := endpoint + constants.APIVersion1CliPath
 	cli = newExecuteCli(apiEndpoint)
+	if userName != "" {
+		cli.SetBasicAuth(userName, password)
+	}
 
 	// first retry connect and get master state
 	master := &models.Master{}
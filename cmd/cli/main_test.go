@@ -19,8 +19,10 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
 
 	prompt "github.com/c-bata/go-prompt"
@@ -101,10 +103,12 @@ func Test_executor(t *testing.T) {
 	mockCli := client.NewMockExecuteCli(ctrl)
 	cli = mockCli
 
+	dir := t.TempDir()
 	cases := []struct {
 		name    string
 		in      string
 		prepare func()
+		assert  func()
 	}{
 		{
 			name: "exit",
@@ -201,6 +205,62 @@ func Test_executor(t *testing.T) {
 			name: "parse query sql failure",
 			in:   "select f;",
 		},
+		{
+			name: "export, invalid statement",
+			in:   "export select f from cpu;",
+		},
+		{
+			name: "export, but not use database",
+			in:   "export select f from cpu to 'cpu.csv';",
+			prepare: func() {
+				inputC.db = ""
+			},
+		},
+		{
+			name: "export, unknown file format",
+			in:   "export select f from cpu to 'cpu.xml';",
+			prepare: func() {
+				inputC.db = "test"
+			},
+		},
+		{
+			name: "export, create file failure",
+			in:   "export select f from cpu to '" + filepath.Join(dir, "not-exist", "cpu.csv") + "';",
+			prepare: func() {
+				inputC.db = "test"
+			},
+		},
+		{
+			name: "export, execute failure",
+			in:   "EXPORT select f from cpu TO '" + filepath.Join(dir, "cpu.arrow") + "';",
+			prepare: func() {
+				inputC.db = "test"
+				mockCli.EXPECT().ExecuteExport(gomock.Any(), gomock.Any()).Return(int64(0), fmt.Errorf("err"))
+			},
+			assert: func() {
+				assert.NoFileExists(t, filepath.Join(dir, "cpu.arrow"))
+			},
+		},
+		{
+			name: "export successfully",
+			in:   "export select f from cpu to '" + filepath.Join(dir, "cpu.csv") + "';",
+			prepare: func() {
+				inputC.db = "test"
+				mockCli.EXPECT().ExecuteExport(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(param models.ExecuteParam, w io.Writer) (int64, error) {
+						assert.Equal(t, "select f from cpu", param.SQL)
+						assert.Equal(t, "test", param.Database)
+						assert.Equal(t, "csv", param.Format)
+						n, err := w.Write([]byte("timestamp,f\n"))
+						return int64(n), err
+					})
+			},
+			assert: func() {
+				data, err := os.ReadFile(filepath.Join(dir, "cpu.csv"))
+				assert.NoError(t, err)
+				assert.Equal(t, "timestamp,f\n", string(data))
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -214,6 +274,9 @@ func Test_executor(t *testing.T) {
 				tt.prepare()
 			}
 			executor(tt.in)
+			if tt.assert != nil {
+				tt.assert()
+			}
 		})
 	}
 }
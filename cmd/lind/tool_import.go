@@ -0,0 +1,274 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+	"github.com/spf13/cobra"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/pkg/export"
+)
+
+// maxLineSize represents the max size of line in line protocol file.
+const maxLineSize = 4 * 1024 * 1024
+
+// importOptions represents the options of bulk import tool.
+var importOptions struct {
+	endpoint  string
+	database  string
+	namespace string
+	format    string
+	metric    string
+	fieldType string
+	precision string
+	batchSize int
+}
+
+// fieldTypes represents the field type of float column when importing csv/parquet file.
+var fieldTypes = map[string]flatMetricsV1.SimpleFieldType{
+	"last":  flatMetricsV1.SimpleFieldTypeLast,
+	"first": flatMetricsV1.SimpleFieldTypeFirst,
+	"sum":   flatMetricsV1.SimpleFieldTypeDeltaSum,
+	"min":   flatMetricsV1.SimpleFieldTypeMin,
+	"max":   flatMetricsV1.SimpleFieldTypeMax,
+}
+
+// newToolCmd returns a new tool-cmd.
+func newToolCmd() *cobra.Command {
+	toolCmd := &cobra.Command{
+		Use:   "tool",
+		Short: "Tools for operating LinDB cluster",
+	}
+	importCmd := &cobra.Command{
+		Use:   "import [files]",
+		Short: "Bulk import history data from csv/parquet/line protocol files",
+		Long: `Bulk import history data from csv/parquet/line protocol files.
+The data is imported into data files of storage directly, which bypasses write-ahead log and writable time range.
+For csv/parquet file, the columns before timestamp column are tags, the columns after timestamp column are fields.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runImport,
+	}
+	flags := importCmd.Flags()
+	flags.StringVar(&importOptions.endpoint, "endpoint", "http://localhost:9000", "broker HTTP endpoint")
+	flags.StringVar(&importOptions.database, "db", "", "target database name")
+	flags.StringVar(&importOptions.namespace, "ns", "", "target namespace, default value: default-ns")
+	flags.StringVar(&importOptions.format, "format", "",
+		"file format(csv/parquet/line), detected by file extension if not set(.csv/.parquet, others as line protocol)")
+	flags.StringVar(&importOptions.metric, "metric", "", "metric name of csv/parquet file")
+	flags.StringVar(&importOptions.fieldType, "field-type", "last",
+		"field type of csv/parquet file(last/first/sum/min/max)")
+	flags.StringVar(&importOptions.precision, "precision", "", "timestamp precision of line protocol(ns/us/ms/s/m/h)")
+	flags.IntVar(&importOptions.batchSize, "batch-size", 10000, "num. of rows of each import request")
+	_ = importCmd.MarkFlagRequired("db")
+	toolCmd.AddCommand(importCmd)
+	return toolCmd
+}
+
+// runImport imports the files one by one.
+func runImport(_ *cobra.Command, files []string) error {
+	if importOptions.batchSize <= 0 {
+		return fmt.Errorf("batch size must be positive")
+	}
+	cli := client.NewImportCli(strings.TrimSuffix(importOptions.endpoint, "/") + constants.APIVersion1CliPath)
+	for _, file := range files {
+		format := importOptions.format
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file)), ".")
+		}
+		var (
+			rows int
+			err  error
+		)
+		switch format {
+		case string(export.CSV), string(export.Parquet):
+			rows, err = importTableFile(cli, file, export.Format(format))
+		default:
+			rows, err = importLineFile(cli, file)
+		}
+		if err != nil {
+			return fmt.Errorf("import file '%s' failure: %w", file, err)
+		}
+		fmt.Printf("import %d rows from file '%s' successfully\n", rows, file)
+	}
+	return nil
+}
+
+// importLineFile imports line protocol file in batch.
+func importLineFile(cli client.ImportCli, file string) (int, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	params := map[string]string{"ns": importOptions.namespace, "precision": importOptions.precision}
+	var (
+		buf   bytes.Buffer
+		count int
+		total int
+	)
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+		rs, err := cli.Import(importOptions.database, constants.ContentTypeInflux, params, buf.Bytes())
+		if err != nil {
+			return err
+		}
+		total += rs.Rows
+		buf.Reset()
+		count = 0
+		return nil
+	}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+		count++
+		if count >= importOptions.batchSize {
+			if err := flush(); err != nil {
+				return total, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return total, err
+	}
+	return total, flush()
+}
+
+// importTableFile imports csv/parquet file in batch, each row of table is converted to a metric row.
+func importTableFile(cli client.ImportCli, file string, format export.Format) (int, error) {
+	if importOptions.metric == "" {
+		return 0, fmt.Errorf("metric name is required for %s file", format)
+	}
+	fieldType, ok := fieldTypes[strings.ToLower(importOptions.fieldType)]
+	if !ok {
+		return 0, fmt.Errorf("unknown field type '%s'", importOptions.fieldType)
+	}
+	table, err := readTable(file, format)
+	if err != nil {
+		return 0, err
+	}
+	var timestamps *export.Column
+	for _, column := range table.Columns {
+		if column.Type == export.TimestampColumn {
+			timestamps = column
+		}
+	}
+	if timestamps == nil {
+		return 0, fmt.Errorf("timestamp column not found")
+	}
+	params := map[string]string{"ns": importOptions.namespace}
+	builder, releaseFunc := commonseries.NewRowBuilder()
+	defer releaseFunc(builder)
+
+	var (
+		buf   bytes.Buffer
+		count int
+		total int
+	)
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+		rs, err := cli.Import(importOptions.database, constants.ContentTypeFlat, params, buf.Bytes())
+		if err != nil {
+			return err
+		}
+		total += rs.Rows
+		buf.Reset()
+		count = 0
+		return nil
+	}
+	for row := 0; row < table.NumRows; row++ {
+		builder.Reset()
+		builder.AddMetricName([]byte(importOptions.metric))
+		builder.AddTimestamp(timestamps.Timestamps[row])
+		fields := 0
+		for _, column := range table.Columns {
+			switch column.Type {
+			case export.StringColumn:
+				if value := column.Strings[row]; value != "" {
+					if err := builder.AddTag([]byte(column.Name), []byte(value)); err != nil {
+						return total, err
+					}
+				}
+			case export.FloatColumn:
+				if !column.Valid[row] {
+					continue
+				}
+				if err := builder.AddSimpleField([]byte(column.Name), fieldType, column.Floats[row]); err != nil {
+					return total, err
+				}
+				fields++
+			}
+		}
+		if fields == 0 {
+			// all fields are null
+			continue
+		}
+		data, err := builder.Build()
+		if err != nil {
+			return total, err
+		}
+		buf.Write(data)
+		count++
+		if count >= importOptions.batchSize {
+			if err := flush(); err != nil {
+				return total, err
+			}
+		}
+	}
+	return total, flush()
+}
+
+// readTable reads the table from csv/parquet file.
+func readTable(file string, format export.Format) (*export.Table, error) {
+	if format == export.Parquet {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		return export.ReadParquet(data)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return export.ReadCSV(f)
+}
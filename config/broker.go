@@ -30,6 +30,7 @@ type HTTP struct {
 	IdleTimeout  ltoml.Duration `toml:"idle-timeout"`
 	WriteTimeout ltoml.Duration `toml:"write-timeout"`
 	ReadTimeout  ltoml.Duration `toml:"read-timeout"`
+	TLS          TLS            `toml:"tls"`
 }
 
 func (h *HTTP) TOML() string {
@@ -121,12 +122,158 @@ gc-task-interval = "%s"`,
 	)
 }
 
+// Rebalance represents shard rebalance configuration of master.
+type Rebalance struct {
+	Enable             bool           `toml:"enable"`
+	CheckInterval      ltoml.Duration `toml:"check-interval"`
+	MaxConcurrentMoves int            `toml:"max-concurrent-moves"`
+	Threshold          float64        `toml:"threshold"`
+	OfflineTimeout     ltoml.Duration `toml:"offline-timeout"`
+	CatchUpLag         int64          `toml:"catch-up-lag"`
+}
+
+func (r *Rebalance) TOML() string {
+	return fmt.Sprintf(`
+## Enable automatic shard rebalancing when storage nodes join or leave,
+## replica moves are visible via SHOW REBALANCE.
+## Storage node decommission isn't affected by this option.
+## Default: %v
+enable = %v
+## Interval for how often master checks the load of storage nodes and drives replica moves
+## Default: %s
+check-interval = "%s"
+## Max number of replica moves running at the same time in a storage cluster
+## Default: %d
+max-concurrent-moves = %d
+## Replica moves are planned if the load(shards/disk/series) of a node exceeds average by this ratio
+## Default: %v
+threshold = %v
+## Replicas on offline storage node are moved to other nodes after this timeout
+## Default: %s
+offline-timeout = "%s"
+## Source replica is retired after new replica's lag of write ahead log is less than this value
+## Default: %d
+catch-up-lag = %d`,
+		r.Enable,
+		r.Enable,
+		r.CheckInterval.String(),
+		r.CheckInterval.String(),
+		r.MaxConcurrentMoves,
+		r.MaxConcurrentMoves,
+		r.Threshold,
+		r.Threshold,
+		r.OfflineTimeout.String(),
+		r.OfflineTimeout.String(),
+		r.CatchUpLag,
+		r.CatchUpLag,
+	)
+}
+
+// NewDefaultRebalance returns a new default rebalance config.
+func NewDefaultRebalance() *Rebalance {
+	return &Rebalance{
+		Enable:             false,
+		CheckInterval:      ltoml.Duration(time.Minute),
+		MaxConcurrentMoves: 1,
+		Threshold:          0.2,
+		OfflineTimeout:     ltoml.Duration(time.Minute * 30),
+		CatchUpLag:         100,
+	}
+}
+
+// Election represents shard leader election configuration of master.
+type Election struct {
+	WaitInSyncReplica bool           `toml:"wait-in-sync-replica"`
+	Timeout           ltoml.Duration `toml:"timeout"`
+}
+
+func (e *Election) TOML() string {
+	return fmt.Sprintf(`
+## The most caught-up live replica is elected as shard leader when leader fails,
+## if enabled, only elect the replica which has caught up the last append index of previous leader
+## (or the max progress of a quorum of replicas if previous leader is offline), shard stays offline until then.
+## Default: %v
+wait-in-sync-replica = %v
+## Timeout for fetching the progress of write ahead log from replica
+## Default: %s
+timeout = "%s"`,
+		e.WaitInSyncReplica,
+		e.WaitInSyncReplica,
+		e.Timeout.String(),
+		e.Timeout.String(),
+	)
+}
+
+// NewDefaultElection returns a new default election config.
+func NewDefaultElection() *Election {
+	return &Election{
+		Timeout: ltoml.Duration(time.Second * 3),
+	}
+}
+
+// Rule represents the evaluation configuration of recording/alert rules.
+type Rule struct {
+	EvaluationDelay ltoml.Duration `toml:"evaluation-delay"`
+}
+
+func (r *Rule) TOML() string {
+	return fmt.Sprintf(`
+## Delay of evaluating rule after each interval boundary, the last interval [end-interval, end)
+## is queried at end+delay, so that the points near the boundary are written(broker batch, replication).
+## It should be greater than write batch-timeout plus replication latency.
+## Default: %s
+evaluation-delay = "%s"`,
+		r.EvaluationDelay.String(),
+		r.EvaluationDelay.String(),
+	)
+}
+
+// NewDefaultRule returns a new default rule config.
+func NewDefaultRule() *Rule {
+	return &Rule{
+		EvaluationDelay: ltoml.Duration(time.Second * 10),
+	}
+}
+
+// Auth represents authentication/authorization configuration of broker.
+type Auth struct {
+	Enable   bool           `toml:"enable"`
+	TokenTTL ltoml.Duration `toml:"token-ttl"`
+	Admin    User           `toml:"admin"`
+}
+
+func (a *Auth) TOML() string {
+	return fmt.Sprintf(`
+## Enable authentication/authorization for write/import/exec api,
+## users/roles are managed by CREATE USER/GRANT/REVOKE statements.
+## Default: %v
+enable = %v
+## Expiration of token issued by login api
+## Default: %s
+token-ttl = "%s"
+
+## Built-in admin user which has all privileges, cannot be dropped,
+## password has no default value, must be set if auth enabled.
+[broker.auth.admin]%s`,
+		a.Enable,
+		a.Enable,
+		a.TokenTTL.Duration().String(),
+		a.TokenTTL.Duration().String(),
+		a.Admin.TOML(),
+	)
+}
+
 // BrokerBase represents a broker configuration
 type BrokerBase struct {
 	HTTP      HTTP      `toml:"http"`
 	Ingestion Ingestion `toml:"ingestion"`
 	Write     Write     `toml:"write"`
 	GRPC      GRPC      `toml:"grpc"`
+	Auth      Auth      `toml:"auth"`
+	Audit     Audit     `toml:"audit"`
+	Rebalance Rebalance `toml:"rebalance"`
+	Election  Election  `toml:"election"`
+	Rule      Rule      `toml:"rule"`
 }
 
 // TOML returns broker's base configuration string as toml format.
@@ -138,6 +285,9 @@ func (bb *BrokerBase) TOML() string {
 ## Controls how HTTP Server are configured.
 [broker.http]%s
 
+## HTTPS configuration of HTTP Server.
+[broker.http.tls]%s
+
 ## Ingestion configuration for broker handle ingest request.
 [broker.ingestion]%s
 
@@ -145,11 +295,36 @@ func (bb *BrokerBase) TOML() string {
 [broker.write]%s
 
 ## Controls how GRPC Server are configured.
-[broker.grpc]%s`,
+[broker.grpc]%s
+
+## Mutual TLS configuration of GRPC Server/Client.
+[broker.grpc.tls]%s
+
+## Authentication/authorization configuration.
+[broker.auth]%s
+
+## Audit log configuration of DDL/administrative operations.
+[broker.audit]%s
+
+## Shard rebalance configuration of master.
+[broker.rebalance]%s
+
+## Shard leader election configuration of master.
+[broker.election]%s
+
+## Recording/alert rule evaluation configuration.
+[broker.rule]%s`,
 		bb.HTTP.TOML(),
+		bb.HTTP.TLS.TOML(),
 		bb.Ingestion.TOML(),
 		bb.Write.TOML(),
 		bb.GRPC.TOML(),
+		bb.GRPC.TLS.TOML(),
+		bb.Auth.TOML(),
+		bb.Audit.TOML(),
+		bb.Rebalance.TOML(),
+		bb.Election.TOML(),
+		bb.Rule.TOML(),
 	)
 }
 
@@ -175,6 +350,17 @@ func NewDefaultBrokerBase() *BrokerBase {
 			MaxConcurrentStreams: 1024,
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
 		},
+		Auth: Auth{
+			TokenTTL: ltoml.Duration(time.Hour * 24),
+			// no default password, must be set if auth enabled
+			Admin: User{
+				UserName: "admin",
+			},
+		},
+		Audit:     *NewDefaultAudit(),
+		Rebalance: *NewDefaultRebalance(),
+		Election:  *NewDefaultElection(),
+		Rule:      *NewDefaultRule(),
 	}
 }
 
@@ -242,6 +428,9 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.HTTP.IdleTimeout <= 0 {
 		brokerBaseCfg.HTTP.IdleTimeout = defaultBrokerCfg.HTTP.IdleTimeout
 	}
+	if err := checkTLSCfg(&brokerBaseCfg.HTTP.TLS); err != nil {
+		return err
+	}
 
 	// ingestion
 	if brokerBaseCfg.Ingestion.IngestTimeout <= 0 {
@@ -260,6 +449,44 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.Write.GCTaskInterval <= 0 {
 		brokerBaseCfg.Write.GCTaskInterval = defaultBrokerCfg.Write.GCTaskInterval
 	}
+	// rule check
+	if brokerBaseCfg.Rule.EvaluationDelay <= 0 {
+		brokerBaseCfg.Rule.EvaluationDelay = defaultBrokerCfg.Rule.EvaluationDelay
+	}
+	// auth check
+	if brokerBaseCfg.Auth.TokenTTL <= 0 {
+		brokerBaseCfg.Auth.TokenTTL = defaultBrokerCfg.Auth.TokenTTL
+	}
+	if brokerBaseCfg.Auth.Enable && (brokerBaseCfg.Auth.Admin.UserName == "" || brokerBaseCfg.Auth.Admin.Password == "") {
+		return fmt.Errorf("admin username/password cannot be empty if auth enabled")
+	}
+	// audit check
+	checkAuditCfg(&brokerBaseCfg.Audit)
+	// rebalance check
+	checkRebalanceCfg(&brokerBaseCfg.Rebalance)
+	// election check
+	if brokerBaseCfg.Election.Timeout <= 0 {
+		brokerBaseCfg.Election.Timeout = NewDefaultElection().Timeout
+	}
 
 	return nil
 }
+
+func checkRebalanceCfg(rebalanceCfg *Rebalance) {
+	defaultRebalance := NewDefaultRebalance()
+	if rebalanceCfg.CheckInterval <= 0 {
+		rebalanceCfg.CheckInterval = defaultRebalance.CheckInterval
+	}
+	if rebalanceCfg.MaxConcurrentMoves <= 0 {
+		rebalanceCfg.MaxConcurrentMoves = defaultRebalance.MaxConcurrentMoves
+	}
+	if rebalanceCfg.Threshold <= 0 {
+		rebalanceCfg.Threshold = defaultRebalance.Threshold
+	}
+	if rebalanceCfg.OfflineTimeout <= 0 {
+		rebalanceCfg.OfflineTimeout = defaultRebalance.OfflineTimeout
+	}
+	if rebalanceCfg.CatchUpLag < 0 {
+		rebalanceCfg.CatchUpLag = defaultRebalance.CatchUpLag
+	}
+}
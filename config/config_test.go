@@ -111,6 +111,43 @@ func Test_checkBrokerBaseCfg(t *testing.T) {
 	assert.NotZero(t, brokerCfg3.HTTP.IdleTimeout)
 	assert.NotZero(t, brokerCfg3.HTTP.WriteTimeout)
 	assert.NotZero(t, brokerCfg3.Ingestion.IngestTimeout)
+	assert.NotZero(t, brokerCfg3.Auth.TokenTTL)
+	assert.NotZero(t, brokerCfg3.Audit.MaxEntries)
+	assert.NotZero(t, brokerCfg3.Rebalance.CheckInterval)
+	assert.NotZero(t, brokerCfg3.Rebalance.MaxConcurrentMoves)
+	assert.NotZero(t, brokerCfg3.Rebalance.Threshold)
+	assert.NotZero(t, brokerCfg3.Rebalance.OfflineTimeout)
+	assert.NotZero(t, brokerCfg3.Election.Timeout)
+	assert.NotZero(t, brokerCfg3.Rule.EvaluationDelay)
+
+	// auth enabled without admin
+	brokerCfg4 := &BrokerBase{
+		GRPC: GRPC{Port: 2379},
+		HTTP: HTTP{Port: 9000},
+		Auth: Auth{Enable: true},
+	}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg4))
+	// auth enabled with default admin which has no password
+	brokerCfg4 = NewDefaultBrokerBase()
+	brokerCfg4.Auth.Enable = true
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg4))
+	brokerCfg4.Auth.Admin.Password = "pwd"
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg4))
+
+	// https enabled without cert
+	brokerCfg5 := &BrokerBase{
+		GRPC: GRPC{Port: 2379},
+		HTTP: HTTP{Port: 9000, TLS: TLS{Enable: true}},
+	}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg5))
+}
+
+func Test_checkTLSCfg(t *testing.T) {
+	assert.NoError(t, checkTLSCfg(&TLS{}))
+	assert.Error(t, checkTLSCfg(&TLS{Enable: true, CertFile: "cert.pem"}))
+	assert.Error(t, checkTLSCfg(&TLS{Enable: true, CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: true}))
+	assert.NoError(t, checkTLSCfg(&TLS{Enable: true, CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: true, CAFile: "ca.pem"}))
+	assert.Error(t, checkGRPCCfg(&GRPC{Port: 2379, TLS: TLS{Enable: true}}))
 }
 
 func Test_checkStorageBaseCfg(t *testing.T) {
@@ -146,6 +183,22 @@ func Test_checkStorageBaseCfg(t *testing.T) {
 	assert.NotZero(t, storageCfg4.TSDB.FlushConcurrency)
 	assert.NotZero(t, storageCfg4.TSDB.MaxSeriesIDsNumber)
 	assert.NotZero(t, storageCfg4.TSDB.MaxTagKeysNumber)
+	assert.Equal(t, "zstd", storageCfg4.TSDB.Compression)
+	storageCfg4.TSDB.Compression = "none"
+	assert.NoError(t, checkStorageBaseCfg(storageCfg4))
+	assert.Equal(t, "none", storageCfg4.TSDB.Compression)
+	storageCfg4.TSDB.Compression = "lz4"
+	assert.Error(t, checkStorageBaseCfg(storageCfg4))
+
+	// peer names without client-auth
+	storageCfg5 := &StorageBase{
+		GRPC:      GRPC{Port: 2379},
+		TSDB:      TSDB{Dir: "/tmp/lindb"},
+		PeerNames: []string{"*.lindb.local"},
+	}
+	assert.Error(t, checkStorageBaseCfg(storageCfg5))
+	storageCfg5.HTTP.TLS = TLS{Enable: true, CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: true, CAFile: "ca.pem"}
+	assert.NoError(t, checkStorageBaseCfg(storageCfg5))
 }
 
 func Test_checkCoordinatorCfg(t *testing.T) {
@@ -160,3 +213,18 @@ func Test_checkCoordinatorCfg(t *testing.T) {
 
 	assert.Equal(t, "/1/2", repo.WithSubNamespace("2").Namespace)
 }
+
+func Test_checkQueryCfg(t *testing.T) {
+	queryCfg := &Query{AdHoc: QueryClass{Concurrency: 10, QueueSize: -1}, SlowQueryThreshold: -1}
+	checkQueryCfg(queryCfg)
+	defaultQuery := NewDefaultQuery()
+	assert.Zero(t, queryCfg.SlowQueryThreshold)
+	assert.Equal(t, defaultQuery.SlowQueryHistory, queryCfg.SlowQueryHistory)
+	assert.Equal(t, defaultQuery.Dashboard.Concurrency, queryCfg.Dashboard.Concurrency)
+	assert.Equal(t, defaultQuery.Dashboard.Timeout, queryCfg.Dashboard.Timeout)
+	assert.Equal(t, 10, queryCfg.AdHoc.Concurrency)
+	assert.Zero(t, queryCfg.AdHoc.QueueSize)
+	assert.Equal(t, defaultQuery.AdHoc.Timeout, queryCfg.AdHoc.Timeout)
+	assert.Equal(t, defaultQuery.ResultCache.MaxSize, queryCfg.ResultCache.MaxSize)
+	assert.Equal(t, defaultQuery.ResultCache.TTL, queryCfg.ResultCache.TTL)
+}
@@ -20,6 +20,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -33,6 +34,15 @@ type Configuration interface {
 	TOML() string
 }
 
+// secretPattern matches the non-empty value of configuration items which contain secrets.
+var secretPattern = regexp.MustCompile(`(?m)^(\s*(?:password|secret-access-key)\s*=\s*)".+"\s*$`)
+
+// RedactTOML returns the toml config string with the values of secrets masked,
+// used when the configuration is exposed by api.
+func RedactTOML(toml string) string {
+	return secretPattern.ReplaceAllString(toml, `${1}"******"`)
+}
+
 // RepoState represents state repository config
 type RepoState struct {
 	Namespace   string         `toml:"namespace" json:"namespace" validate:"required"`
@@ -88,7 +98,7 @@ dial-timeout = "%s"
 ## Default: "%s"
 username = "%s"
 ## Password is a password for etcd authentication.
-## Default: "%s"
+## Default: ""
 password = "%s"`,
 		rs.Namespace,
 		rs.Namespace,
@@ -103,7 +113,6 @@ password = "%s"`,
 		rs.Username,
 		rs.Username,
 		rs.Password,
-		rs.Password,
 	)
 }
 
@@ -122,6 +131,7 @@ type GRPC struct {
 	Port                 uint16         `toml:"port"`
 	MaxConcurrentStreams int            `toml:"max-concurrent-streams"`
 	ConnectTimeout       ltoml.Duration `toml:"connect-timeout"`
+	TLS                  TLS            `toml:"tls"`
 }
 
 func (g *GRPC) TOML() string {
@@ -144,6 +154,87 @@ connect-timeout = "%s"`,
 	)
 }
 
+// TLS represents tls config of server/client, certificate files are reloaded when changed.
+type TLS struct {
+	Enable     bool   `toml:"enable"`
+	CertFile   string `toml:"cert-file"`
+	KeyFile    string `toml:"key-file"`
+	CAFile     string `toml:"ca-file"`
+	ClientAuth bool   `toml:"client-auth"`
+	ServerName string `toml:"server-name"`
+}
+
+func (t *TLS) TOML() string {
+	return fmt.Sprintf(`
+## Enable tls, certificate/key/ca files are reloaded without restart when changed.
+## Default: %v
+enable = %v
+## Certificate file(PEM) of this node, used as server certificate and client certificate.
+## Default: "%s"
+cert-file = "%s"
+## Private key file(PEM) of certificate.
+## Default: "%s"
+key-file = "%s"
+## CA file(PEM) for verifying peer's certificate, using system root CAs if not set.
+## Default: "%s"
+ca-file = "%s"
+## Require and verify client certificate(mutual tls), ca-file must be set to the dedicated cluster CA.
+## Default: %v
+client-auth = %v
+## Server name for verifying server certificate when connecting other nodes, using host if not set.
+## Default: "%s"
+server-name = "%s"`,
+		t.Enable,
+		t.Enable,
+		t.CertFile,
+		t.CertFile,
+		t.KeyFile,
+		t.KeyFile,
+		t.CAFile,
+		t.CAFile,
+		t.ClientAuth,
+		t.ClientAuth,
+		t.ServerName,
+		t.ServerName,
+	)
+}
+
+// Audit represents audit log configuration of DDL/administrative operations.
+type Audit struct {
+	Enable     bool   `toml:"enable"`
+	MaxEntries int    `toml:"max-entries"`
+	File       string `toml:"file"`
+}
+
+func (a *Audit) TOML() string {
+	return fmt.Sprintf(`
+## Enable audit log, records user/source ip/statement/before and after config/result
+## of state-mutating statements, queryable via SHOW AUDIT LOG.
+## Default: %v
+enable = %v
+## Max number of audit entries kept in coordinator, the oldest entries are removed.
+## Default: %d
+max-entries = %d
+## File sink which audit entries are appended to(json per line), disable if not set.
+## Default: "%s"
+file = "%s"`,
+		a.Enable,
+		a.Enable,
+		a.MaxEntries,
+		a.MaxEntries,
+		a.File,
+		a.File,
+	)
+}
+
+// NewDefaultAudit returns a new default audit config.
+func NewDefaultAudit() *Audit {
+	return &Audit{
+		Enable:     true,
+		MaxEntries: 10000,
+	}
+}
+
 // BrokerCluster represents config of broker cluster.
 type BrokerCluster struct {
 	Config *RepoState `json:"config"`
@@ -154,11 +245,95 @@ type StorageCluster struct {
 	Config *RepoState `json:"config"`
 }
 
+// QueryClass represents the admission config of a query workload class.
+type QueryClass struct {
+	Concurrency int            `toml:"concurrency"`
+	QueueSize   int            `toml:"queue-size"`
+	Timeout     ltoml.Duration `toml:"timeout"`
+}
+
+// TOML returns query class's configuration string as toml format.
+func (c *QueryClass) TOML(name string) string {
+	return fmt.Sprintf(`[query.%s]
+## Number of queries of this class allowed to execute concurrently
+## Default: %d
+concurrency = %d
+## Number of queries of this class allowed to wait for executing, new query is rejected if queue is full
+## Default: %d
+queue-size = %d
+## Maximum timeout threshold(include queue wait) for query of this class.
+## Default: %s
+timeout = "%s"`,
+		name,
+		c.Concurrency,
+		c.Concurrency,
+		c.QueueSize,
+		c.QueueSize,
+		c.Timeout,
+		c.Timeout,
+	)
+}
+
+// QueryResultCache represents the config of broker side metric query result cache.
+type QueryResultCache struct {
+	Enabled               bool           `toml:"enabled"`
+	MaxSize               ltoml.Size     `toml:"max-size"`
+	TTL                   ltoml.Duration `toml:"ttl"`
+	LateWriteWindow       ltoml.Duration `toml:"late-write-window"`
+	RespectDatabaseBehind bool           `toml:"respect-database-behind"`
+}
+
+// TOML returns query result cache's configuration string as toml format.
+func (c *QueryResultCache) TOML() string {
+	return fmt.Sprintf(`[query.result-cache]
+## Whether to cache the immutable time buckets of metric query result in broker,
+## then only queries the uncovered tail from storage for identical query.
+## Default: %v
+enabled = %v
+## Maximum memory used by cached results, least recently used results are evicted if exceeded.
+## Default: %s
+max-size = "%s"
+## Cached result is expired after ttl, bounds staleness caused by late writes.
+## Default: %s
+ttl = "%s"
+## Buckets which end within this window before now are treated as mutable and never cached.
+## Default: %s
+late-write-window = "%s"
+## Whether to use the database's write behind option as late write window if it's longer,
+## if disabled, late writes within database's write behind may be invisible until cached result expired.
+## Default: %v
+respect-database-behind = %v`,
+		c.Enabled,
+		c.Enabled,
+		c.MaxSize.String(),
+		c.MaxSize.String(),
+		c.TTL,
+		c.TTL,
+		c.LateWriteWindow,
+		c.LateWriteWindow,
+		c.RespectDatabaseBehind,
+		c.RespectDatabaseBehind,
+	)
+}
+
 // Query represents query rpc config
 type Query struct {
-	QueryConcurrency int            `toml:"query-concurrency"`
-	IdleTimeout      ltoml.Duration `toml:"idle-timeout"`
-	Timeout          ltoml.Duration `toml:"timeout"`
+	QueryConcurrency  int            `toml:"query-concurrency"`
+	IdleTimeout       ltoml.Duration `toml:"idle-timeout"`
+	Timeout           ltoml.Duration `toml:"timeout"`
+	MaxMemoryPerQuery ltoml.Size     `toml:"max-memory-per-query"`
+	MaxMemory         ltoml.Size     `toml:"max-memory"`
+	// slow query log
+	SlowQueryThreshold       ltoml.Duration `toml:"slow-query-threshold"`
+	SlowQueryHistory         int            `toml:"slow-query-history"`
+	SlowQueryStatsSampleRate float64        `toml:"slow-query-stats-sample-rate"`
+	// admission control for workload classes of metric query, selected by each request(alerting/internal need admin).
+	Dashboard QueryClass `toml:"dashboard"`
+	Alerting  QueryClass `toml:"alerting"`
+	AdHoc     QueryClass `toml:"ad-hoc"`
+	Internal  QueryClass `toml:"internal"`
+	// result cache of metric query in broker.
+	ResultCache QueryResultCache `toml:"result-cache"`
 }
 
 func (q *Query) TOML() string {
@@ -171,21 +346,100 @@ query-concurrency = %d
 idle-timeout = "%s"
 ## Maximum timeout threshold for query.
 ## Default: %s
-timeout = "%s"`,
+timeout = "%s"
+## Maximum memory a single query may use on each node(aggregation/grouping/merge),
+## query will be aborted if exceeded, 0 means unlimited.
+## Default: %s
+max-memory-per-query = "%s"
+## Maximum memory all running queries may use on each node, 0 means unlimited.
+## Default: %s
+max-memory = "%s"
+## Query which costs longer than threshold is written into slow query log(slow.log) with its cost and
+## scanned series/families/bytes, full execution stats are included if sampled(see slow-query-stats-sample-rate),
+## 0 means disabled.
+## Default: %s
+slow-query-threshold = "%s"
+## Number of recent slow queries kept in memory for SHOW SLOW REQUESTS.
+## Default: %d
+slow-query-history = %d
+## Ratio of metric queries which collect full execution stats(explain stages) of all nodes for slow query log,
+## scan stats are collected for all queries, 0 means only the query requested explain has full stats.
+## Default: %.2f
+slow-query-stats-sample-rate = %.2f
+
+## Admission control for workload classes(dashboard/alerting/ad-hoc/internal) of metric query,
+## class is selected by each request(ad-hoc if not set), query-concurrency is still the outer bound.
+## Query takes the slot of query-concurrency only after admitted by its class, keep the sum of
+## concurrency of all classes not greater than query-concurrency, so that each class can always run its quota.
+## NOTICE: classes are isolated in broker only, storage nodes execute queries of all classes by shared executor pool.
+%s
+
+%s
+
+%s
+
+%s
+
+%s`,
 		q.QueryConcurrency,
 		q.QueryConcurrency,
 		q.IdleTimeout,
 		q.IdleTimeout,
 		q.Timeout,
 		q.Timeout,
+		q.MaxMemoryPerQuery.String(),
+		q.MaxMemoryPerQuery.String(),
+		q.MaxMemory.String(),
+		q.MaxMemory.String(),
+		q.SlowQueryThreshold,
+		q.SlowQueryThreshold,
+		q.SlowQueryHistory,
+		q.SlowQueryHistory,
+		q.SlowQueryStatsSampleRate,
+		q.SlowQueryStatsSampleRate,
+		q.Dashboard.TOML("dashboard"),
+		q.Alerting.TOML("alerting"),
+		q.AdHoc.TOML("ad-hoc"),
+		q.Internal.TOML("internal"),
+		q.ResultCache.TOML(),
 	)
 }
 
 func NewDefaultQuery() *Query {
 	return &Query{
-		QueryConcurrency: 1024,
-		IdleTimeout:      ltoml.Duration(5 * time.Second),
-		Timeout:          ltoml.Duration(5 * time.Second),
+		QueryConcurrency:         1024,
+		IdleTimeout:              ltoml.Duration(5 * time.Second),
+		Timeout:                  ltoml.Duration(5 * time.Second),
+		MaxMemoryPerQuery:        ltoml.Size(1024 * 1024 * 1024),
+		SlowQueryThreshold:       ltoml.Duration(2 * time.Second),
+		SlowQueryHistory:         128,
+		SlowQueryStatsSampleRate: 0.01,
+		Dashboard: QueryClass{
+			Concurrency: 512,
+			QueueSize:   1024,
+			Timeout:     ltoml.Duration(5 * time.Second),
+		},
+		Alerting: QueryClass{
+			Concurrency: 256,
+			QueueSize:   512,
+			Timeout:     ltoml.Duration(5 * time.Second),
+		},
+		AdHoc: QueryClass{
+			Concurrency: 128,
+			QueueSize:   256,
+			Timeout:     ltoml.Duration(5 * time.Second),
+		},
+		Internal: QueryClass{
+			Concurrency: 128,
+			QueueSize:   256,
+			Timeout:     ltoml.Duration(5 * time.Second),
+		},
+		ResultCache: QueryResultCache{
+			MaxSize:               ltoml.Size(256 * 1024 * 1024),
+			TTL:                   ltoml.Duration(5 * time.Minute),
+			LateWriteWindow:       ltoml.Duration(time.Minute),
+			RespectDatabaseBehind: true,
+		},
 	}
 }
 
@@ -218,9 +472,28 @@ func checkGRPCCfg(grpcCfg *GRPC) error {
 	if grpcCfg.ConnectTimeout <= 0 {
 		grpcCfg.ConnectTimeout = ltoml.Duration(time.Second * 3)
 	}
+	return checkTLSCfg(&grpcCfg.TLS)
+}
+
+func checkTLSCfg(tlsCfg *TLS) error {
+	if !tlsCfg.Enable {
+		return nil
+	}
+	if tlsCfg.CertFile == "" || tlsCfg.KeyFile == "" {
+		return fmt.Errorf("tls cert-file/key-file cannot be empty")
+	}
+	if tlsCfg.ClientAuth && tlsCfg.CAFile == "" {
+		return fmt.Errorf("tls ca-file cannot be empty when client-auth enabled")
+	}
 	return nil
 }
 
+func checkAuditCfg(auditCfg *Audit) {
+	if auditCfg.MaxEntries <= 0 {
+		auditCfg.MaxEntries = NewDefaultAudit().MaxEntries
+	}
+}
+
 func checkQueryCfg(queryCfg *Query) {
 	defaultQuery := NewDefaultQuery()
 	if queryCfg.QueryConcurrency <= 0 {
@@ -232,4 +505,41 @@ func checkQueryCfg(queryCfg *Query) {
 	if queryCfg.IdleTimeout <= 0 {
 		queryCfg.IdleTimeout = defaultQuery.IdleTimeout
 	}
+	if queryCfg.SlowQueryThreshold < 0 {
+		queryCfg.SlowQueryThreshold = 0
+	}
+	if queryCfg.SlowQueryHistory <= 0 {
+		queryCfg.SlowQueryHistory = defaultQuery.SlowQueryHistory
+	}
+	if queryCfg.SlowQueryStatsSampleRate < 0 {
+		queryCfg.SlowQueryStatsSampleRate = 0
+	}
+	if queryCfg.SlowQueryStatsSampleRate > 1 {
+		queryCfg.SlowQueryStatsSampleRate = 1
+	}
+	checkQueryClassCfg(&queryCfg.Dashboard, &defaultQuery.Dashboard)
+	checkQueryClassCfg(&queryCfg.Alerting, &defaultQuery.Alerting)
+	checkQueryClassCfg(&queryCfg.AdHoc, &defaultQuery.AdHoc)
+	checkQueryClassCfg(&queryCfg.Internal, &defaultQuery.Internal)
+	if queryCfg.ResultCache.MaxSize <= 0 {
+		queryCfg.ResultCache.MaxSize = defaultQuery.ResultCache.MaxSize
+	}
+	if queryCfg.ResultCache.TTL <= 0 {
+		queryCfg.ResultCache.TTL = defaultQuery.ResultCache.TTL
+	}
+	if queryCfg.ResultCache.LateWriteWindow < 0 {
+		queryCfg.ResultCache.LateWriteWindow = 0
+	}
+}
+
+func checkQueryClassCfg(classCfg, defaultCfg *QueryClass) {
+	if classCfg.Concurrency <= 0 {
+		classCfg.Concurrency = defaultCfg.Concurrency
+	}
+	if classCfg.QueueSize < 0 {
+		classCfg.QueueSize = 0
+	}
+	if classCfg.Timeout <= 0 {
+		classCfg.Timeout = defaultCfg.Timeout
+	}
 }
@@ -43,3 +43,19 @@ func TestGeneral_string(t *testing.T) {
 		strings.Join(repo.Endpoints, ","), repo.LeaseTTL, repo.Timeout, repo.DialTimeout),
 		repo.String())
 }
+
+func TestRedactTOML(t *testing.T) {
+	toml := RedactTOML((&Broker{BrokerBase: BrokerBase{Auth: Auth{Admin: User{UserName: "admin", Password: "pwd"}}}}).TOML())
+	assert.NotContains(t, toml, `"pwd"`)
+	assert.Contains(t, toml, `password = "******"`)
+	assert.Contains(t, toml, `username = "admin"`)
+	// empty secret isn't masked
+	assert.Contains(t, RedactTOML(`password = ""`), `password = ""`)
+	// secret key of tiered storage
+	toml = RedactTOML((&Tier{AccessKeyID: "id", SecretAccessKey: "secret"}).TOML())
+	assert.NotContains(t, toml, `"secret"`)
+	assert.Contains(t, toml, `secret-access-key = "******"`)
+	assert.Contains(t, toml, `access-key-id = "id"`)
+	toml = RedactTOML((&RepoState{Password: "pwd"}).TOML())
+	assert.NotContains(t, toml, `"pwd"`)
+}
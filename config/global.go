@@ -75,6 +75,7 @@ func LoadAndSetRootConfig(cfgName, defaultPath string, rootCfg *Root) error {
 		return fmt.Errorf("decode root config file error: %s", err)
 	}
 	checkQueryCfg(&rootCfg.Query)
+	checkAuditCfg(&rootCfg.Audit)
 	if err := checkCoordinatorCfg(&rootCfg.Coordinator); err != nil {
 		return fmt.Errorf("failed check coordinator config: %s", err)
 	}
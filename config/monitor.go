@@ -35,6 +35,7 @@ type Monitor struct {
 	PushTimeout    ltoml.Duration `toml:"push-timeout"`
 	ReportInterval ltoml.Duration `toml:"report-interval"`
 	URL            string         `toml:"url"`
+	Token          string         `toml:"token"`
 }
 
 // TOML returns Monitor's toml config
@@ -51,13 +52,18 @@ push-timeout = "%s"
 report-interval = "%s"
 ## URL is the target of broker native ingestion url
 ## Default: %s
-url = "%s"`,
+url = "%s"
+## API token which is used to push metrics if broker authentication enabled, created by: CREATE TOKEN
+## Default: "%s"
+token = "%s"`,
 		m.PushTimeout.String(),
 		m.PushTimeout.String(),
 		m.ReportInterval.String(),
 		m.ReportInterval.String(),
 		m.URL,
 		m.URL,
+		m.Token,
+		m.Token,
 	)
 }
 
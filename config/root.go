@@ -30,6 +30,7 @@ type Root struct {
 	Query       Query     `toml:"query"`
 	HTTP        HTTP      `toml:"http"`
 	GRPC        GRPC      `toml:"grpc"`
+	Audit       Audit     `toml:"audit"`
 	Monitor     Monitor   `toml:"monitor"`
 	Logging     Logging   `toml:"logging"`
 }
@@ -45,14 +46,26 @@ func (r *Root) TOML() string {
 ## Controls how HTTP Server are configured.
 [http]%s
 
+## HTTPS configuration of HTTP Server.
+[http.tls]%s
+
 ## Controls how GRPC Server are configured.
 [grpc]%s
+
+## Mutual TLS configuration of GRPC Server/Client.
+[grpc.tls]%s
+
+## Audit log configuration of DDL/administrative operations.
+[audit]%s
 %s
 %s`,
 		r.Coordinator.TOML(),
 		r.Query.TOML(),
 		r.HTTP.TOML(),
+		r.HTTP.TLS.TOML(),
 		r.GRPC.TOML(),
+		r.GRPC.TLS.TOML(),
+		r.Audit.TOML(),
 		r.Monitor.TOML(),
 		r.Logging.TOML(),
 	)
@@ -79,6 +92,7 @@ func NewDefaultRoot() *Root {
 			MaxConcurrentStreams: 1024,
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
 		},
+		Audit:   *NewDefaultAudit(),
 		Monitor: *NewDefaultMonitor(),
 		Logging: *NewDefaultLogging(),
 	}
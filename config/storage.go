@@ -18,6 +18,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -31,11 +32,16 @@ import (
 // TSDB represents the tsdb configuration.
 type TSDB struct {
 	Dir                      string         `toml:"dir"`
+	BackupDir                string         `toml:"backup-dir"`
 	MaxMemDBSize             ltoml.Size     `toml:"max-memdb-size"`
 	MutableMemDBTTL          ltoml.Duration `toml:"mutable-memdb-ttl"`
 	MaxMemUsageBeforeFlush   float64        `toml:"max-mem-usage-before-flush"`
 	TargetMemUsageAfterFlush float64        `toml:"target-mem-usage-after-flush"`
 	FlushConcurrency         int            `toml:"flush-concurrency"`
+	Compression              string         `toml:"compression"`
+	IORateLimit              ltoml.Size     `toml:"io-rate-limit"`
+	IOMinRateLimit           ltoml.Size     `toml:"io-min-rate-limit"`
+	IOBusyThreshold          int            `toml:"io-busy-threshold"`
 	MaxSeriesIDsNumber       int            `toml:"max-seriesIDs"`
 	SeriesSequenceCache      uint32         `toml:"series-sequence-cache"`
 	MetaSequenceCache        uint32         `toml:"meta-sequence-cache"`
@@ -47,6 +53,10 @@ func (t *TSDB) TOML() string {
 ## The TSDB directory where the time series data and meta file stores.
 ## Default: %s
 dir = "%s"
+## The directory where the database backups store,
+## backup/restore only accepts the path under this directory.
+## Default: %s
+backup-dir = "%s"
 
 ## Flush configuration
 ## 
@@ -71,6 +81,26 @@ target-mem-usage-after-flush = %.2f
 ## Default: %d
 flush-concurrency = %d
 
+## Block compression(none/snappy/zstd) of kv table files for data, index and metadata families,
+## it is kept in family option when family is created, so changing it only applies to new families.
+## Default: %s
+compression = "%s"
+
+## Background io(flush/compaction/rollup) limitation
+##
+## Max bytes per second of background io shared by flush, compaction and rollup,
+## flush is never throttled, it only consumes the quota so that compaction and rollup yield to it.
+## 0 means unlimited.
+## Default: %s
+io-rate-limit = "%s"
+## Background io rate is reduced linearly to this rate when foreground load is high.
+## Default: %s
+io-min-rate-limit = "%s"
+## Foreground load(written rows and queries per second) at which
+## background io rate reaches io-min-rate-limit, 0 means not adapting to foreground load.
+## Default: %d
+io-busy-threshold = %d
+
 ## Time Series limitation
 ## 
 ## Limit for time series of metric.
@@ -81,6 +111,8 @@ max-seriesIDs = %d
 max-tagKeys = %d`,
 		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
 		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		strings.ReplaceAll(t.BackupDir, "\\", "\\\\"),
+		strings.ReplaceAll(t.BackupDir, "\\", "\\\\"),
 		t.MaxMemDBSize.String(),
 		t.MaxMemDBSize.String(),
 		t.MutableMemDBTTL.String(),
@@ -91,6 +123,14 @@ max-tagKeys = %d`,
 		t.TargetMemUsageAfterFlush,
 		t.FlushConcurrency,
 		t.FlushConcurrency,
+		t.Compression,
+		t.Compression,
+		t.IORateLimit.String(),
+		t.IORateLimit.String(),
+		t.IOMinRateLimit.String(),
+		t.IOMinRateLimit.String(),
+		t.IOBusyThreshold,
+		t.IOBusyThreshold,
 		t.MaxSeriesIDsNumber,
 		t.MaxSeriesIDsNumber,
 		t.MaxTagKeysNumber,
@@ -101,15 +141,23 @@ max-tagKeys = %d`,
 // StorageBase represents a storage configuration
 type StorageBase struct {
 	BrokerEndpoint  string         `toml:"broker-endpoint"` // Broker http endpoint, auto register current storage cluster.
+	BrokerAuth      User           `toml:"broker-auth"`     // Broker admin user, used if broker authentication enabled.
+	PeerNames       []string       `toml:"peer-names"`      // Names of cluster nodes in client certificate(mutual tls).
 	TTLTaskInterval ltoml.Duration `toml:"ttl-task-interval"`
 	HTTP            HTTP           `toml:"http"`
 	GRPC            GRPC           `toml:"grpc"`
 	TSDB            TSDB           `toml:"tsdb"`
 	WAL             WAL            `toml:"wal"`
+	Tier            Tier           `toml:"tier"`
 }
 
 // TOML returns StorageBase's toml config string
 func (s *StorageBase) TOML() string {
+	names := s.PeerNames
+	if names == nil {
+		names = []string{}
+	}
+	peerNames, _ := json.Marshal(names)
 	return fmt.Sprintf(`
 ## Storage related configuration
 [storage]
@@ -119,26 +167,114 @@ ttl-task-interval = "%s"
 ## Broker http endpoint which storage self register address
 ## Default: %s
 broker-endpoint = "%s"
+## Names(DNS SAN or CN of client certificate, "*." prefix for wildcard) of cluster nodes,
+## peer is trusted by internal admin api without credential only if its client certificate matches,
+## which must be verified by the dedicated cluster ca-file of storage http tls(client-auth enabled).
+## Default: %s
+peer-names = %s
+
+## Broker admin user which storage self register with, if broker authentication enabled.
+## Also required by internal admin api of storage, unless peer's client certificate matches peer-names.
+[storage.broker-auth]%s
 
 ## Storage HTTP related configuration.
 [storage.http]%s
 
+## Storage HTTPS configuration.
+[storage.http.tls]%s
+
 ## Storage GRPC related configuration.
 [storage.grpc]%s
 
+## Storage GRPC mutual TLS configuration.
+[storage.grpc.tls]%s
+
 ## Write Ahead Log related configuration.
 [storage.wal]%s
 
 ## TSDB related configuration.
-[storage.tsdb]%s`,
+[storage.tsdb]%s
+
+## Tiered storage related configuration.
+[storage.tier]%s`,
 		s.TTLTaskInterval,
 		s.TTLTaskInterval,
 		s.BrokerEndpoint,
 		s.BrokerEndpoint,
+		peerNames,
+		peerNames,
+		s.BrokerAuth.TOML(),
 		s.HTTP.TOML(),
+		s.HTTP.TLS.TOML(),
 		s.GRPC.TOML(),
+		s.GRPC.TLS.TOML(),
 		s.WAL.TOML(),
 		s.TSDB.TOML(),
+		s.Tier.TOML(),
+	)
+}
+
+// Tier represents config for offloading cold kv table files to tiered storage.
+type Tier struct {
+	Type            string         `toml:"type"`
+	Dir             string         `toml:"dir"`
+	Endpoint        string         `toml:"endpoint"`
+	Region          string         `toml:"region"`
+	Bucket          string         `toml:"bucket"`
+	AccessKeyID     string         `toml:"access-key-id"`
+	SecretAccessKey string         `toml:"secret-access-key"`
+	OffloadAfter    ltoml.Duration `toml:"offload-after"`
+	Timeout         ltoml.Duration `toml:"timeout"`
+}
+
+// Enabled returns if tiered storage is enabled.
+func (t *Tier) Enabled() bool {
+	return t.Type != ""
+}
+
+func (t *Tier) TOML() string {
+	return fmt.Sprintf(`
+## Type of cold tier storage, empty means disabled.
+## Available types: fs(local cold path), s3(S3-compatible object store)
+## Default: "%s"
+type = "%s"
+## Cold path which stores offloaded files when type is fs.
+## Default: "%s"
+dir = "%s"
+## S3-compatible endpoint/region/bucket/credential when type is s3.
+## Default: "%s"
+endpoint = "%s"
+## Default: "%s"
+region = "%s"
+## Default: "%s"
+bucket = "%s"
+## Default: "%s"
+access-key-id = "%s"
+## Default: ""
+secret-access-key = "%s"
+## Fully compacted table files are offloaded if they have not been modified this long.
+## Default: %s
+offload-after = "%s"
+## Timeout of each request(upload/download file) to S3-compatible object store.
+## Default: %s
+timeout = "%s"`,
+		t.Type,
+		t.Type,
+		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		t.Endpoint,
+		t.Endpoint,
+		t.Region,
+		t.Region,
+		t.Bucket,
+		t.Bucket,
+		t.AccessKeyID,
+		t.AccessKeyID,
+		t.SecretAccessKey,
+		t.OffloadAfter.String(),
+		t.OffloadAfter.String(),
+		t.Timeout.String(),
+		t.Timeout.String(),
 	)
 }
 
@@ -212,6 +348,7 @@ func NewDefaultStorageBase() *StorageBase {
 	return &StorageBase{
 		TTLTaskInterval: ltoml.Duration(time.Hour * 24),
 		BrokerEndpoint:  "http://localhost:9000",
+		PeerNames:       []string{},
 		HTTP: HTTP{
 			Port:         2892,
 			IdleTimeout:  ltoml.Duration(time.Minute * 2),
@@ -230,16 +367,24 @@ func NewDefaultStorageBase() *StorageBase {
 		},
 		TSDB: TSDB{
 			Dir:                      filepath.Join(defaultParentDir, "storage", "data"),
+			BackupDir:                filepath.Join(defaultParentDir, "storage", "backup"),
 			MaxMemDBSize:             ltoml.Size(500 * 1024 * 1024),
 			MutableMemDBTTL:          ltoml.Duration(time.Minute * 30),
 			MaxMemUsageBeforeFlush:   0.75,
 			TargetMemUsageAfterFlush: 0.6,
 			FlushConcurrency:         int(math.Ceil(float64(runtime.GOMAXPROCS(-1)) / 2)),
+			Compression:              "zstd",
+			IOBusyThreshold:          200000,
 			MaxSeriesIDsNumber:       200000,
 			SeriesSequenceCache:      1000,
 			MetaSequenceCache:        100,
 			MaxTagKeysNumber:         32,
 		},
+		Tier: Tier{
+			Dir:          filepath.Join(defaultParentDir, "storage", "cold"),
+			OffloadAfter: ltoml.Duration(time.Hour * 24 * 7),
+			Timeout:      ltoml.Duration(time.Minute * 5),
+		},
 	}
 }
 
@@ -266,6 +411,10 @@ func checkTSDBCfg(tsdbCfg *TSDB) error {
 	if tsdbCfg.Dir == "" {
 		return fmt.Errorf("tsdb dir cannot be empty")
 	}
+	if tsdbCfg.BackupDir == "" {
+		// beside tsdb dir, cannot under tsdb dir, because all children of tsdb dir are loaded as database.
+		tsdbCfg.BackupDir = filepath.Join(filepath.Dir(filepath.Clean(tsdbCfg.Dir)), "backup")
+	}
 	if tsdbCfg.MaxMemDBSize <= 0 {
 		tsdbCfg.MaxMemDBSize = defaultStorageCfg.TSDB.MaxMemDBSize
 	}
@@ -281,6 +430,16 @@ func checkTSDBCfg(tsdbCfg *TSDB) error {
 	if tsdbCfg.FlushConcurrency <= 0 {
 		tsdbCfg.FlushConcurrency = defaultStorageCfg.TSDB.FlushConcurrency
 	}
+	switch strings.ToLower(tsdbCfg.Compression) {
+	case "":
+		tsdbCfg.Compression = defaultStorageCfg.TSDB.Compression
+	case "none", "snappy", "zstd":
+	default:
+		return fmt.Errorf("unknown tsdb compression: %s", tsdbCfg.Compression)
+	}
+	if tsdbCfg.IOBusyThreshold < 0 {
+		tsdbCfg.IOBusyThreshold = 0
+	}
 	if tsdbCfg.MaxSeriesIDsNumber <= 0 {
 		tsdbCfg.MaxSeriesIDsNumber = defaultStorageCfg.TSDB.MaxSeriesIDsNumber
 	}
@@ -300,9 +459,42 @@ func checkStorageBaseCfg(storageBaseCfg *StorageBase) error {
 	if err := checkGRPCCfg(&storageBaseCfg.GRPC); err != nil {
 		return err
 	}
+	if err := checkTLSCfg(&storageBaseCfg.HTTP.TLS); err != nil {
+		return err
+	}
+	if len(storageBaseCfg.PeerNames) > 0 && (!storageBaseCfg.HTTP.TLS.Enable || !storageBaseCfg.HTTP.TLS.ClientAuth) {
+		return fmt.Errorf("storage peer-names requires client-auth of http tls")
+	}
 	defaultStorageCfg := NewDefaultStorageBase()
 	if storageBaseCfg.TTLTaskInterval <= 0 {
 		storageBaseCfg.TTLTaskInterval = defaultStorageCfg.TTLTaskInterval
 	}
+	if err := checkTierCfg(&storageBaseCfg.Tier); err != nil {
+		return err
+	}
 	return checkTSDBCfg(&storageBaseCfg.TSDB)
 }
+
+func checkTierCfg(tierCfg *Tier) error {
+	defaultStorageCfg := NewDefaultStorageBase()
+	if tierCfg.OffloadAfter <= 0 {
+		tierCfg.OffloadAfter = defaultStorageCfg.Tier.OffloadAfter
+	}
+	if tierCfg.Timeout <= 0 {
+		tierCfg.Timeout = defaultStorageCfg.Tier.Timeout
+	}
+	switch tierCfg.Type {
+	case "":
+	case "fs":
+		if tierCfg.Dir == "" {
+			return fmt.Errorf("tier dir cannot be empty")
+		}
+	case "s3":
+		if tierCfg.Endpoint == "" || tierCfg.Bucket == "" {
+			return fmt.Errorf("tier endpoint/bucket cannot be empty")
+		}
+	default:
+		return fmt.Errorf("tier type [%s] not support", tierCfg.Type)
+	}
+	return nil
+}
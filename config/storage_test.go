@@ -40,3 +40,17 @@ func TestWAL_GetDataSizeLimit(t *testing.T) {
 	wal = &WAL{DataSizeLimit: 128 * 1024 * 1024}
 	assert.Equal(t, int64(128*1024*1024), wal.GetDataSizeLimit())
 }
+
+func TestTier_Check(t *testing.T) {
+	tier := &Tier{}
+	assert.NoError(t, checkTierCfg(tier))
+	assert.False(t, tier.Enabled())
+	assert.Equal(t, NewDefaultStorageBase().Tier.OffloadAfter, tier.OffloadAfter)
+	assert.Equal(t, NewDefaultStorageBase().Tier.Timeout, tier.Timeout)
+	assert.Error(t, checkTierCfg(&Tier{Type: "fs"}))
+	assert.NoError(t, checkTierCfg(&Tier{Type: "fs", Dir: "/tmp/cold"}))
+	assert.Error(t, checkTierCfg(&Tier{Type: "s3", Endpoint: "http://localhost:9000"}))
+	assert.NoError(t, checkTierCfg(&Tier{Type: "s3", Endpoint: "http://localhost:9000", Bucket: "lindb"}))
+	assert.Error(t, checkTierCfg(&Tier{Type: "hdfs"}))
+	assert.True(t, (&Tier{Type: "fs"}).Enabled())
+}
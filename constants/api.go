@@ -24,10 +24,16 @@ const (
 	APIVersion1 = "/v1"
 	// APIVersion1CliPath represents api version 1 path for client.
 	APIVersion1CliPath = "/api/v1"
+	// MetricsPath represents internal metrics path for prometheus scraping.
+	MetricsPath = "/metrics"
 	// ContentTypeFlat represents flat buffer content type.
 	ContentTypeFlat = "application/flatbuffer"
 	// ContentTypeProto represents proto buffer content type.
 	ContentTypeProto = "application/protobuf"
 	// ContentTypeInflux represents influx content type.
 	ContentTypeInflux = "application/influx"
+	// ContentTypeNDJSON represents newline delimited json content type.
+	ContentTypeNDJSON = "application/x-ndjson"
+	// ContentTypeOpenMetrics represents OpenMetrics text content type.
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
 )
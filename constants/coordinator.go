@@ -64,6 +64,30 @@ const (
 	StorageStatePath = "/storage/state"
 	// BrokerConfigPath represents broker cluster's config.
 	BrokerConfigPath = "/broker/config"
+	// RecordingRulePath represents recording rule config path.
+	RecordingRulePath = "/rule/recording"
+	// RuleSchedulerPath represents rule scheduler elect path.
+	RuleSchedulerPath = "/rule/scheduler"
+	// AlertRulePath represents alert rule config path.
+	AlertRulePath = "/alert/rule"
+	// AlertStatePath represents alert rule's state path.
+	AlertStatePath = "/alert/state"
+	// UserPath represents user config path.
+	UserPath = "/auth/user"
+	// RolePath represents role config path.
+	RolePath = "/auth/role"
+	// TokenPath represents api token config path.
+	TokenPath = "/auth/token"
+	// SigningKeyPath represents the path of key which signs the token issued by login api.
+	SigningKeyPath = "/auth/signing-key"
+	// TenantPath represents tenant config path.
+	TenantPath = "/tenant/config"
+	// AuditLogPath represents audit log entry path.
+	AuditLogPath = "/audit/log"
+	// ReplicaMovePath represents replica move(shard rebalance) path.
+	ReplicaMovePath = "/rebalance/move"
+	// DecommissionPath represents storage node decommission path.
+	DecommissionPath = "/rebalance/decommission"
 )
 
 // GetBrokerClusterConfigPath returns path which storing config of broker cluster.
@@ -90,6 +114,56 @@ func GetDatabaseAssignPath(name string) string {
 	return fmt.Sprintf("%s/%s", ShardAssignmentPath, name)
 }
 
+// GetRecordingRulePath returns path which storing config of recording rule.
+func GetRecordingRulePath(name string) string {
+	return fmt.Sprintf("%s/%s", RecordingRulePath, name)
+}
+
+// GetAlertRulePath returns path which storing config of alert rule.
+func GetAlertRulePath(name string) string {
+	return fmt.Sprintf("%s/%s", AlertRulePath, name)
+}
+
+// GetAlertStatePath returns path which storing state of alert rule.
+func GetAlertStatePath(name string) string {
+	return fmt.Sprintf("%s/%s", AlertStatePath, name)
+}
+
+// GetUserPath returns path which storing config of user.
+func GetUserPath(name string) string {
+	return fmt.Sprintf("%s/%s", UserPath, name)
+}
+
+// GetRolePath returns path which storing config of role.
+func GetRolePath(name string) string {
+	return fmt.Sprintf("%s/%s", RolePath, name)
+}
+
+// GetTokenPath returns path which storing config of api token.
+func GetTokenPath(name string) string {
+	return fmt.Sprintf("%s/%s", TokenPath, name)
+}
+
+// GetTenantPath returns path which storing config of tenant.
+func GetTenantPath(name string) string {
+	return fmt.Sprintf("%s/%s", TenantPath, name)
+}
+
+// GetAuditLogPath returns path which storing audit log entry.
+func GetAuditLogPath(key string) string {
+	return fmt.Sprintf("%s/%s", AuditLogPath, key)
+}
+
+// GetReplicaMovePath returns path which storing replica move of storage cluster.
+func GetReplicaMovePath(storage string) string {
+	return fmt.Sprintf("%s/%s", ReplicaMovePath, storage)
+}
+
+// GetDecommissionPath returns path which storing node decommission of storage cluster.
+func GetDecommissionPath(storage string) string {
+	return fmt.Sprintf("%s/%s", DecommissionPath, storage)
+}
+
 // GetLiveNodePath returns live node register path.
 func GetLiveNodePath(node string) string {
 	return fmt.Sprintf("%s/%s", LiveNodesPath, node)
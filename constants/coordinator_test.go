@@ -46,3 +46,22 @@ func TestGetStorageStatePath(t *testing.T) {
 func TestGetBrokerClusterConfigPath(t *testing.T) {
 	assert.Equal(t, BrokerConfigPath+"/name", GetBrokerClusterConfigPath("name"))
 }
+
+func TestGetRecordingRulePath(t *testing.T) {
+	assert.Equal(t, RecordingRulePath+"/name", GetRecordingRulePath("name"))
+}
+
+func TestGetAlertPath(t *testing.T) {
+	assert.Equal(t, AlertRulePath+"/name", GetAlertRulePath("name"))
+	assert.Equal(t, AlertStatePath+"/name", GetAlertStatePath("name"))
+}
+
+func TestGetAuthPath(t *testing.T) {
+	assert.Equal(t, UserPath+"/name", GetUserPath("name"))
+	assert.Equal(t, RolePath+"/name", GetRolePath("name"))
+	assert.Equal(t, TokenPath+"/name", GetTokenPath("name"))
+	assert.Equal(t, TenantPath+"/name", GetTenantPath("name"))
+	assert.Equal(t, AuditLogPath+"/key", GetAuditLogPath("key"))
+	assert.Equal(t, ReplicaMovePath+"/storage", GetReplicaMovePath("storage"))
+	assert.Equal(t, DecommissionPath+"/storage", GetDecommissionPath("storage"))
+}
@@ -48,10 +48,24 @@ var (
 	ErrBadEnrichTagQueryFormat = errors.New("enrich_tag has the wrong format")
 	// ErrNoLiveReplica represents no live replica node for current shard.
 	ErrNoLiveReplica = errors.New("no live replica for shard")
+	// ErrNoInSyncReplica represents no quorum of replicas report their progress for current shard.
+	ErrNoInSyncReplica = errors.New("no in-sync replica quorum for shard")
+	// ErrNoCaughtUpReplica represents no replica has caught up the write ahead log of leader for leader transfer.
+	ErrNoCaughtUpReplica = errors.New("no caught-up replica for leader transfer")
+	// ErrShardFenced represents client writes of shard are rejected, because its leadership is transferring.
+	ErrShardFenced = errors.New("shard is fenced for leadership transfer")
+	// ErrReplicaFactorChanged represents replica factor of existing database is changed by create stmt.
+	ErrReplicaFactorChanged = errors.New("replica factor of existing database cannot be changed by create")
+	// ErrReplicaFactorTooLarge represents replica factor exceeds the number of available storage nodes.
+	ErrReplicaFactorTooLarge = errors.New("replica factor too large")
 	// ErrNoLiveNode represents no live node for current cluster.
 	ErrNoLiveNode = errors.New("no live node for cluster")
 	// ErrNameEmpty represents name is empty.
 	ErrNameEmpty = errors.New("name cannot be empty")
+	// ErrNotMaster represents current node isn't master.
+	ErrNotMaster = errors.New("current node isn't master")
+	// ErrStorageNodeNotFound represents storage node not found in storage cluster.
+	ErrStorageNodeNotFound = fmt.Errorf("storage node %w", ErrNotFound)
 	// ErrNoStorageCluster represents storage cluster not exist.
 	ErrNoStorageCluster = errors.New("storage cluster not exist")
 	// ErrStatefulNodeExist represents stateful node already register.
@@ -60,6 +74,32 @@ var (
 	ErrDatabaseNameRequired = errors.New("database name cannot be empty")
 	// ErrStorageNameRequired represents storage name not input.
 	ErrStorageNameRequired = errors.New("storage name cannot be empty")
+	// ErrRuleExist represents rule with same name already exists.
+	ErrRuleExist = errors.New("rule already exists")
+	// ErrRuleNotFound represents rule not found.
+	ErrRuleNotFound = fmt.Errorf("rule %w", ErrNotFound)
+	// ErrUserExist represents user with same name already exists.
+	ErrUserExist = errors.New("user already exists")
+	// ErrUserNotFound represents user not found.
+	ErrUserNotFound = fmt.Errorf("user %w", ErrNotFound)
+	// ErrRoleExist represents role with same name already exists.
+	ErrRoleExist = errors.New("role already exists")
+	// ErrRoleNotFound represents role not found.
+	ErrRoleNotFound = fmt.Errorf("role %w", ErrNotFound)
+	// ErrTokenExist represents api token with same name already exists.
+	ErrTokenExist = errors.New("token already exists")
+	// ErrTokenNotFound represents api token not found.
+	ErrTokenNotFound = fmt.Errorf("token %w", ErrNotFound)
+	// ErrTenantExist represents tenant with same name already exists.
+	ErrTenantExist = errors.New("tenant already exists")
+	// ErrTenantNotFound represents tenant not found.
+	ErrTenantNotFound = fmt.Errorf("tenant %w", ErrNotFound)
+	// ErrQuotaExceeded represents the resource usage of tenant exceeds the quota.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrUnauthorized represents the credential of request is missing or invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrPermissionDenied represents the user hasn't the privilege of operation.
+	ErrPermissionDenied = errors.New("permission denied")
 
 	// ErrEmptySelectList represents empty select list.
 	ErrEmptySelectList = errors.New("select item list is empty")
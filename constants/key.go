@@ -23,4 +23,10 @@ type ContextKey string
 const (
 	// ContextKeySQL represents sql key.
 	ContextKeySQL = ContextKey("lin_ql")
+	// ContextKeyCaller represents the client address of request.
+	ContextKeyCaller = ContextKey("caller")
+	// ContextKeyMaxReadLag represents the max replica lag of follower which can serve the query.
+	ContextKeyMaxReadLag = ContextKey("max_read_lag")
+	// ContextKeyQueryClass represents the workload class of metric query.
+	ContextKeyQueryClass = ContextKey("query_class")
 )
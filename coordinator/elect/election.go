@@ -59,6 +59,7 @@ type Election interface {
 // election implements election interface for master elect.
 type election struct {
 	repo     state.Repository
+	path     string
 	isMaster *atomic.Bool
 	master   atomic.Value
 	node     models.Node
@@ -74,10 +75,18 @@ type election struct {
 	logger *logger.Logger
 }
 
-// NewElection returns a new election
+// NewElection returns a new election for master.
 func NewElection(ctx context.Context, repo state.Repository, node models.Node, ttl int64, listener Listener) Election {
+	return NewElectionWithPath(ctx, repo, node, ttl, constants.MasterPath, listener)
+}
+
+// NewElectionWithPath returns a new election which elects leader under the given path,
+// so that other role(such as rule scheduler) can be elected independently of master.
+func NewElectionWithPath(ctx context.Context, repo state.Repository, node models.Node, ttl int64,
+	path string, listener Listener) Election {
 	c, cancel := context.WithCancel(ctx)
 	return &election{
+		path:     path,
 		node:     node,
 		ttl:      ttl,
 		isMaster: atomic.NewBool(false),
@@ -93,7 +102,7 @@ func NewElection(ctx context.Context, repo state.Repository, node models.Node, t
 // Initialize initializes election, such as master change watch
 func (e *election) Initialize() {
 	// watch master change event
-	watchEventChan := e.repo.Watch(e.ctx, constants.MasterPath, true)
+	watchEventChan := e.repo.Watch(e.ctx, e.path, true)
 
 	go func() {
 		e.handleMasterChange(watchEventChan)
@@ -136,7 +145,7 @@ func (e *election) elect() {
 
 		master := models.Master{Node: e.node.(*models.StatelessNode), ElectTime: timeutil.Now()}
 		masterBytes := encoding.JSONMarshal(master)
-		result, _, err := e.repo.Elect(e.ctx, constants.MasterPath, masterBytes, e.ttl)
+		result, _, err := e.repo.Elect(e.ctx, e.path, masterBytes, e.ttl)
 
 		if err != nil {
 			e.logger.Warn("got an error when master elect, sleep 500ms then retry",
@@ -170,7 +179,7 @@ func (e *election) Close() {
 func (e *election) resign() {
 	if e.isMaster.Load() {
 		e.logger.Info("do master resign because current node is master")
-		if err := e.repo.Delete(e.ctx, constants.MasterPath); err != nil {
+		if err := e.repo.Delete(e.ctx, e.path); err != nil {
 			e.logger.Error("delete master path failed", logger.Error(err))
 		}
 		e.isMaster.Store(false)
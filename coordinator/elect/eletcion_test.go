@@ -53,6 +53,23 @@ func TestElection_Initialize(t *testing.T) {
 	election.Close()
 }
 
+func TestElection_WithPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	listener1 := NewMockListener(ctrl)
+	node1 := models.StatelessNode{HostIP: "127.0.0.1", GRPCPort: 2080}
+	repo.EXPECT().Watch(gomock.Any(), "/rule/scheduler", true).Return(nil)
+	election1 := NewElectionWithPath(context.TODO(), repo, &node1, 1, "/rule/scheduler", listener1)
+	election1.Initialize()
+	e := election1.(*election)
+	e.isMaster.Store(true)
+	repo.EXPECT().Delete(gomock.Any(), "/rule/scheduler").Return(nil)
+	election1.Close()
+	assert.False(t, election1.IsMaster())
+}
+
 func TestElection_Elect(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
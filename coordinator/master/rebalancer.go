@@ -0,0 +1,1142 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	statepkg "github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./rebalancer.go -destination=./rebalancer_mock.go -package=master
+
+const (
+	// movePollInterval represents the interval of checking replica move's progress.
+	movePollInterval = 5 * time.Second
+	// maxPendingChecks represents max checks of waiting leader keeps write ahead log for learner.
+	maxPendingChecks = 60
+	// maxSnapshotAttempts represents max attempts of installing family snapshot on target node.
+	maxSnapshotAttempts = 3
+	// fenceLeaseChecks represents the lease of fence on source replica in number of move checks,
+	// fence expires if replica move is aborted, so that source replica cannot be unwritable forever.
+	fenceLeaseChecks = 3
+	// maxFinishedMoves represents max finished replica moves kept for each storage cluster.
+	maxFinishedMoves = 100
+)
+
+// Rebalancer represents the shard rebalancer of storage cluster, which plans replica moves
+// from overloaded/offline storage nodes to others, then drives each replica move:
+// 1) add target node as learner of shard, leader keeps write ahead log for it;
+// 2) install family snapshot of shard on target node, which copied from live replica;
+// 3) promote target node as replica, waits it catches up leader's write ahead log;
+// 4) fence client writes on source replica, transfer leadership away from it, then retire it
+// after new leader has its final write ahead log.
+// NOTICE: writes rejected by fenced source replica are not retried by broker.
+// Rebalancer also decommissions storage node, moves all replicas/leaders on it to other nodes,
+// then marks it removable.
+type Rebalancer interface {
+	// Start loads unfinished replica moves and starts the background rebalance check task.
+	Start()
+	// Trigger triggers rebalance check for storage cluster, when storage node join or leave.
+	Trigger(storage string)
+	// GetReplicaMoves returns the replica moves of storage cluster.
+	GetReplicaMoves(storage string) models.ReplicaMoves
+	// Decommission starts decommission of storage node, replicas on it will be moved to other nodes.
+	Decommission(storage string, nodeID models.NodeID) error
+	// Close stops the rebalancer.
+	Close()
+}
+
+// nodeLoad represents the load of storage node.
+type nodeLoad struct {
+	nodeID    models.NodeID
+	shards    int64
+	diskBytes int64
+	series    int64
+	score     float64
+	// hostedShards represents the shards(database/shard id) which node hosts(replica or learner).
+	hostedShards map[string]struct{}
+}
+
+// rebalancer implements Rebalancer interface.
+type rebalancer struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cfg      *config.Rebalance
+	repo     statepkg.Repository
+	stateMgr StateManager
+	adminCli client.StorageAdminCli
+	// pollInterval represents the interval of checking replica move's progress.
+	pollInterval time.Duration
+
+	moves         map[string]models.ReplicaMoves                        // storage => replica moves
+	offlineSince  map[string]map[models.NodeID]int64                    // storage => offline node => timestamp
+	decommissions map[string]map[models.NodeID]*models.NodeDecommission // storage => node => decommission
+	triggers      chan string
+	mutex         sync.Mutex // protects moves/offlineSince/decommissions
+	assignLock    sync.Mutex // serializes modification of shard assignment
+
+	statistics *metrics.RebalanceStatistics
+	logger     *logger.Logger
+}
+
+// NewRebalancer creates a shard rebalancer instance.
+func NewRebalancer(ctx context.Context, repo statepkg.Repository, stateMgr StateManager) Rebalancer {
+	c, cancel := context.WithCancel(ctx)
+	return &rebalancer{
+		ctx:           c,
+		cancel:        cancel,
+		cfg:           &config.GlobalBrokerConfig().Rebalance,
+		repo:          repo,
+		stateMgr:      stateMgr,
+		adminCli:      newStorageAdminCliFn(),
+		pollInterval:  movePollInterval,
+		moves:         make(map[string]models.ReplicaMoves),
+		offlineSince:  make(map[string]map[models.NodeID]int64),
+		decommissions: make(map[string]map[models.NodeID]*models.NodeDecommission),
+		triggers:      make(chan string, 32),
+		statistics:    metrics.NewRebalanceStatistics(),
+		logger:        logger.GetLogger("Master", "Rebalancer"),
+	}
+}
+
+// Start loads unfinished replica moves and starts the background rebalance check task.
+func (r *rebalancer) Start() {
+	kvs, err := r.repo.List(r.ctx, constants.ReplicaMovePath)
+	if err != nil {
+		r.logger.Error("load replica moves failure", logger.Error(err))
+	}
+	r.mutex.Lock()
+	for _, kv := range kvs {
+		var moves models.ReplicaMoves
+		if err0 := encoding.JSONUnmarshal(kv.Value, &moves); err0 != nil {
+			r.logger.Error("unmarshal replica moves failure, ignore it", logger.String("key", kv.Key), logger.Error(err0))
+			continue
+		}
+		storage := strings.TrimPrefix(kv.Key, constants.GetReplicaMovePath(""))
+		r.moves[storage] = moves
+		for _, move := range moves {
+			if !move.IsFinished() {
+				r.execute(move)
+			}
+		}
+	}
+	r.loadDecommissions()
+	r.mutex.Unlock()
+
+	// background task always runs, because node decommission is driven by it even if auto rebalance disabled.
+	go r.run()
+	r.logger.Info("start shard rebalancer successfully", logger.Any("enable", r.cfg.Enable))
+}
+
+// Trigger triggers rebalance check for storage cluster, when storage node join or leave.
+func (r *rebalancer) Trigger(storage string) {
+	select {
+	case r.triggers <- storage:
+	default:
+		// rebalance check will be done by background task
+	}
+}
+
+// GetReplicaMoves returns the replica moves of storage cluster.
+func (r *rebalancer) GetReplicaMoves(storage string) (rs models.ReplicaMoves) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, move := range r.moves[storage] {
+		m := *move
+		rs = append(rs, &m)
+	}
+	return
+}
+
+// Decommission starts decommission of storage node, replicas on it will be moved to other nodes.
+func (r *rebalancer) Decommission(storage string, nodeID models.NodeID) error {
+	state, ok := r.stateMgr.GetStorageState(storage)
+	if !ok {
+		return constants.ErrNoStorageCluster
+	}
+	if _, live := state.LiveNodes[nodeID]; !live && len(state.ReplicasOnNode(nodeID)) == 0 {
+		return fmt.Errorf("%w: %s", constants.ErrStorageNodeNotFound, nodeID)
+	}
+	available := false
+	for id := range state.LiveNodes {
+		if id != nodeID && !state.IsDecommissioned(id) {
+			available = true
+			break
+		}
+	}
+	if !available {
+		return constants.ErrNoLiveNode
+	}
+
+	r.mutex.Lock()
+	decommissions, ok := r.decommissions[storage]
+	if !ok {
+		decommissions = make(map[models.NodeID]*models.NodeDecommission)
+		r.decommissions[storage] = decommissions
+	}
+	if _, ok := decommissions[nodeID]; ok {
+		// decommission is in progress
+		r.mutex.Unlock()
+		return nil
+	}
+	now := timeutil.Now()
+	decommissions[nodeID] = &models.NodeDecommission{
+		NodeID:     nodeID,
+		Status:     models.NodeDecommissioning,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := r.saveDecommissions(storage); err != nil {
+		delete(decommissions, nodeID)
+		r.mutex.Unlock()
+		return err
+	}
+	r.mutex.Unlock()
+
+	r.logger.Info("start decommission storage node", logger.String("storage", storage), logger.Any("node", nodeID))
+	r.Trigger(storage)
+	return nil
+}
+
+// Close stops the rebalancer.
+func (r *rebalancer) Close() {
+	r.cancel()
+}
+
+// run checks the load of storage nodes periodically or when triggered.
+func (r *rebalancer) run() {
+	ticker := time.NewTicker(r.cfg.CheckInterval.Duration())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			r.logger.Info("shard rebalance check task is stopped")
+			return
+		case storage := <-r.triggers:
+			r.check(storage)
+		case <-ticker.C:
+			for _, storage := range r.stateMgr.GetStorages() {
+				r.check(storage.Config.Namespace)
+			}
+		}
+	}
+}
+
+// check plans replica moves for storage cluster, then executes them.
+func (r *rebalancer) check(storage string) {
+	state, ok := r.stateMgr.GetStorageState(storage)
+	if !ok {
+		return
+	}
+	usages := r.collectUsages(state)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := timeutil.Now()
+	r.updateDecommissions(state, now)
+	moves := r.plan(state, usages, now)
+	if len(moves) == 0 {
+		return
+	}
+	r.moves[storage] = append(r.moves[storage], moves...)
+	if err := r.saveMoves(storage); err != nil {
+		// rollback planned moves
+		r.moves[storage] = r.moves[storage][:len(r.moves[storage])-len(moves)]
+		return
+	}
+	for _, move := range moves {
+		r.statistics.Plans.WithTagValues(storage).Incr()
+		r.logger.Info("plan replica move", logger.Any("move", move))
+		r.execute(move)
+	}
+}
+
+// collectUsages collects the shard usages of each live node, returns nil if failure.
+func (r *rebalancer) collectUsages(state *models.StorageState) map[models.NodeID]map[string]models.ShardUsage {
+	usages := make(map[models.NodeID]map[string]models.ShardUsage)
+	for nodeID := range state.LiveNodes {
+		node := state.LiveNodes[nodeID]
+		rs, err := r.adminCli.GetDatabaseUsage(&node)
+		if err != nil {
+			r.logger.Warn("get database usage of storage node failure, skip balancing load",
+				logger.String("storage", state.Name),
+				logger.String("node", node.Indicator()),
+				logger.Error(err))
+			return nil
+		}
+		shards := make(map[string]models.ShardUsage)
+		for _, db := range rs {
+			for _, shard := range db.Shards {
+				shards[shardKey(db.Database, shard.ShardID)] = shard
+			}
+		}
+		usages[nodeID] = shards
+	}
+	return usages
+}
+
+// plan plans replica moves for replica factor changes, decommissioning nodes, offline nodes and overloaded nodes,
+// throttled by max concurrent moves.
+func (r *rebalancer) plan(
+	state *models.StorageState,
+	usages map[models.NodeID]map[string]models.ShardUsage,
+	now int64,
+) (moves models.ReplicaMoves) {
+	busy := make(map[string]struct{})
+	running := 0
+	for _, move := range r.moves[state.Name] {
+		if !move.IsFinished() {
+			busy[move.Key()] = struct{}{}
+			running++
+		}
+	}
+	offlineNodes := r.trackOfflineNodes(state, now)
+	slots := r.cfg.MaxConcurrentMoves - running
+	if slots <= 0 || len(state.LiveNodes) == 0 {
+		return nil
+	}
+	loads := buildNodeLoads(state, usages)
+	newMove := func(db string, shardID models.ShardID, source, target, snapshotFrom models.NodeID, reason string) {
+		move := &models.ReplicaMove{
+			Storage:      state.Name,
+			Database:     db,
+			ShardID:      shardID,
+			Source:       source,
+			Target:       target,
+			SnapshotFrom: snapshotFrom,
+			Reason:       reason,
+			Status:       models.ReplicaMovePending,
+			CreateTime:   now,
+			UpdateTime:   now,
+		}
+		moves = append(moves, move)
+		busy[move.Key()] = struct{}{}
+		slots--
+	}
+
+	// 1. add/drop replicas of database which replica factor changed
+	replicaFactors := make(map[string]int)
+	for _, db := range r.stateMgr.GetDatabases() {
+		if db.Storage == state.Name {
+			replicaFactors[db.Name] = db.ReplicaFactor
+		}
+	}
+	for _, db := range sortedDatabases(state) {
+		replicaFactor, ok := replicaFactors[db]
+		if !ok {
+			continue
+		}
+		assignment := state.ShardAssignments[db]
+		for _, shardID := range sortedShards(assignment) {
+			if slots <= 0 {
+				return moves
+			}
+			if _, ok := busy[shardKey(db, shardID)]; ok {
+				continue
+			}
+			replicas := len(assignment.Shards[shardID].Replicas)
+			reason := fmt.Sprintf("replica factor %d -> %d", replicas, replicaFactor)
+			switch {
+			case replicas < replicaFactor:
+				// new replica bootstraps from family snapshot, then catches up write ahead log of leader
+				snapshotFrom := liveReplica(state, db, shardID)
+				target := pickTarget(loads, db, shardID)
+				if snapshotFrom == models.NoLeader || target == nil {
+					r.logger.Warn("no available node for adding replica of shard",
+						logger.String("storage", state.Name), logger.String("database", db), logger.Any("shard", shardID))
+					continue
+				}
+				newMove(db, shardID, models.NoLeader, target.nodeID, snapshotFrom, reason)
+				target.hostedShards[shardKey(db, shardID)] = struct{}{}
+				target.shards++
+			case replicas > replicaFactor:
+				newMove(db, shardID, pickRetiredReplica(state, db, shardID), models.NoLeader, models.NoLeader, reason)
+			}
+		}
+	}
+
+	// 2. move replicas away from decommissioning node
+	for _, db := range sortedDatabases(state) {
+		assignment := state.ShardAssignments[db]
+		for _, shardID := range sortedShards(assignment) {
+			if slots <= 0 {
+				return moves
+			}
+			if _, ok := busy[shardKey(db, shardID)]; ok {
+				continue
+			}
+			replica := assignment.Shards[shardID]
+			for _, nodeID := range replica.Replicas {
+				if !state.IsDecommissioned(nodeID) {
+					continue
+				}
+				// copy snapshot from decommissioning node if it's alive
+				snapshotFrom := nodeID
+				if _, live := state.LiveNodes[nodeID]; !live {
+					snapshotFrom = liveReplica(state, db, shardID)
+				}
+				target := pickTarget(loads, db, shardID)
+				if snapshotFrom == models.NoLeader || target == nil {
+					continue
+				}
+				newMove(db, shardID, nodeID, target.nodeID, snapshotFrom,
+					fmt.Sprintf("node %s decommission", nodeID))
+				target.hostedShards[shardKey(db, shardID)] = struct{}{}
+				target.shards++
+				break
+			}
+		}
+	}
+	if !r.cfg.Enable {
+		// auto rebalance disabled
+		return moves
+	}
+
+	// 3. evacuate replicas on node which is offline too long
+	for _, db := range sortedDatabases(state) {
+		assignment := state.ShardAssignments[db]
+		for _, shardID := range sortedShards(assignment) {
+			if slots <= 0 {
+				return moves
+			}
+			if _, ok := busy[shardKey(db, shardID)]; ok {
+				continue
+			}
+			replica := assignment.Shards[shardID]
+			for _, nodeID := range replica.Replicas {
+				if _, offline := offlineNodes[nodeID]; !offline {
+					continue
+				}
+				// target node cannot host the shard, snapshot is merged into the database if target hosts it.
+				snapshotFrom := liveReplica(state, db, shardID)
+				target := pickTarget(loads, db, shardID)
+				if snapshotFrom == models.NoLeader || target == nil {
+					continue
+				}
+				newMove(db, shardID, nodeID, target.nodeID, snapshotFrom,
+					fmt.Sprintf("node %s offline", nodeID))
+				target.hostedShards[shardKey(db, shardID)] = struct{}{}
+				target.shards++
+				break
+			}
+		}
+	}
+
+	// 4. balance load from the most loaded node to the least loaded node
+	if usages == nil || len(loads) < 2 {
+		return moves
+	}
+	for slots > 0 {
+		calcScore(loads)
+		sort.SliceStable(loads, func(i, j int) bool { return loads[i].score < loads[j].score })
+		source, target := loads[len(loads)-1], loads[0]
+		var avg float64
+		for _, load := range loads {
+			avg += load.score
+		}
+		avg /= float64(len(loads))
+		if source.score <= avg*(1+r.cfg.Threshold) {
+			return moves
+		}
+		db, shardID, usage, ok := pickShard(state, usages, loads, source, target, busy)
+		if !ok {
+			return moves
+		}
+		newMove(db, shardID, source.nodeID, target.nodeID, source.nodeID,
+			fmt.Sprintf("node %s overloaded(%.2f > avg %.2f)", source.nodeID, source.score, avg))
+		target.hostedShards[shardKey(db, shardID)] = struct{}{}
+		target.shards++
+		target.diskBytes += usage.DiskBytes
+		target.series += usage.NumOfSeries
+		source.shards--
+		source.diskBytes -= usage.DiskBytes
+		source.series -= usage.NumOfSeries
+	}
+	return moves
+}
+
+// trackOfflineNodes tracks offline replica nodes, returns the nodes which offline time exceeds offline timeout.
+func (r *rebalancer) trackOfflineNodes(state *models.StorageState, now int64) map[models.NodeID]struct{} {
+	offlineSince, ok := r.offlineSince[state.Name]
+	if !ok {
+		offlineSince = make(map[models.NodeID]int64)
+		r.offlineSince[state.Name] = offlineSince
+	}
+	offlineNodes := make(map[models.NodeID]struct{})
+	for _, assignment := range state.ShardAssignments {
+		for _, replica := range assignment.Shards {
+			for _, nodeID := range replica.Replicas {
+				if _, live := state.LiveNodes[nodeID]; !live {
+					offlineNodes[nodeID] = struct{}{}
+				}
+			}
+		}
+	}
+	timeouts := make(map[models.NodeID]struct{})
+	for nodeID := range offlineSince {
+		if _, ok := offlineNodes[nodeID]; !ok {
+			delete(offlineSince, nodeID)
+		}
+	}
+	for nodeID := range offlineNodes {
+		since, ok := offlineSince[nodeID]
+		if !ok {
+			offlineSince[nodeID] = now
+			continue
+		}
+		if now-since >= r.cfg.OfflineTimeout.Duration().Milliseconds() {
+			timeouts[nodeID] = struct{}{}
+		}
+	}
+	return timeouts
+}
+
+// execute drives the replica move in background.
+func (r *rebalancer) execute(move *models.ReplicaMove) {
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				r.logger.Error("panic when execute replica move", logger.Any("err", err), logger.Stack())
+			}
+		}()
+		r.statistics.Running.WithTagValues(move.Storage).Incr()
+		defer r.statistics.Running.WithTagValues(move.Storage).Decr()
+
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+
+		attempts := 0
+		for {
+			m := r.getMove(move)
+			if m.IsFinished() || r.ctx.Err() != nil {
+				return
+			}
+			status, lag, err := r.step(&m)
+			switch {
+			case err != nil:
+				attempts++
+				r.logger.Warn("execute replica move failure",
+					logger.Any("move", m), logger.Any("attempts", attempts), logger.Error(err))
+				if attempts >= maxAttempts(m.Status) {
+					r.fail(move, err)
+					return
+				}
+			case status != m.Status:
+				attempts = 0
+				r.updateMove(move, func(m *models.ReplicaMove) {
+					m.Status = status
+					m.Lag = lag
+				})
+				if status == models.ReplicaMoveDone {
+					r.statistics.MoveDone.WithTagValues(move.Storage).Incr()
+					r.logger.Info("replica move completed", logger.Any("move", move.Key()))
+					r.Trigger(move.Storage)
+					return
+				}
+				continue
+			default:
+				attempts++
+				if m.Lag != lag {
+					r.updateMove(move, func(m *models.ReplicaMove) {
+						m.Lag = lag
+					})
+				}
+				if attempts >= maxAttempts(m.Status) {
+					r.fail(move, fmt.Errorf("timeout when replica move in status: %s", m.Status))
+					return
+				}
+			}
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// step does the action of replica move's current status, returns the next status.
+func (r *rebalancer) step(move *models.ReplicaMove) (status models.ReplicaMoveStatus, lag int64, err error) {
+	state, ok := r.stateMgr.GetStorageState(move.Storage)
+	if !ok {
+		return move.Status, move.Lag, constants.ErrNoStorageCluster
+	}
+	switch move.Status {
+	case models.ReplicaMovePending:
+		if move.Target == models.NoLeader {
+			// only drops replica, no new replica need to catch up
+			return models.ReplicaMoveCatchUp, 0, nil
+		}
+		// add target node as learner, leader will keep write ahead log for it
+		if err = r.updateAssignment(move.Storage, move.Database, func(assignment *models.ShardAssignment) {
+			assignment.AddLearner(move.ShardID, move.Target)
+		}); err != nil {
+			return move.Status, 0, err
+		}
+		ready, err := r.isLearnerReady(state, move)
+		if err != nil || !ready {
+			return move.Status, 0, err
+		}
+		return models.ReplicaMoveSnapshot, 0, nil
+	case models.ReplicaMoveSnapshot:
+		source, ok := state.LiveNodes[move.SnapshotFrom]
+		if !ok {
+			return move.Status, 0, fmt.Errorf("snapshot source node %s isn't alive", move.SnapshotFrom)
+		}
+		target, ok := state.LiveNodes[move.Target]
+		if !ok {
+			return move.Status, 0, fmt.Errorf("target node %s isn't alive", move.Target)
+		}
+		if err = r.adminCli.InstallSnapshot(&target, &models.SnapshotParam{
+			Database: move.Database,
+			ShardID:  move.ShardID,
+			Source:   source.StatelessNode,
+		}); err != nil {
+			return move.Status, 0, err
+		}
+		// promote target node as replica after snapshot installed, then leader replicates write ahead log to it
+		if err = r.updateAssignment(move.Storage, move.Database, func(assignment *models.ShardAssignment) {
+			assignment.PromoteLearner(move.ShardID, move.Target)
+		}); err != nil {
+			return move.Status, 0, err
+		}
+		return models.ReplicaMoveCatchUp, 0, nil
+	case models.ReplicaMoveCatchUp:
+		if move.Target != models.NoLeader {
+			lag, err = r.getLag(state, move)
+			if err != nil {
+				return move.Status, move.Lag, err
+			}
+			if lag < 0 || lag > r.cfg.CatchUpLag {
+				return move.Status, lag, nil
+			}
+		}
+		if move.Source == models.NoLeader {
+			// only adds replica, no source replica need to be retired
+			return models.ReplicaMoveDone, lag, nil
+		}
+		shardState := state.ShardStates[move.Database][move.ShardID]
+		if source, live := state.LiveNodes[move.Source]; live {
+			// fence client writes on source replica(renewed by each check until it is retired),
+			// so that its write ahead log is final, no write accepted by it is lost after it is retired.
+			sourceState, err := r.adminCli.FenceShard(&source, &models.FenceParam{
+				Database: move.Database,
+				ShardID:  move.ShardID,
+				Lease:    (r.pollInterval * fenceLeaseChecks).Milliseconds(),
+			})
+			if err != nil {
+				return move.Status, lag, err
+			}
+			if shardState.Leader == move.Source {
+				// transfer leadership from source replica to the replica which has caught up all write ahead log of it,
+				// wait next check if no replica caught up.
+				if _, err = r.stateMgr.TransferLeader(move.Storage, move.Database, move.ShardID, move.Source); err != nil &&
+					!errors.Is(err, constants.ErrNoCaughtUpReplica) {
+					return move.Status, lag, err
+				}
+				return move.Status, lag, nil
+			}
+			// re-check new leader has the final write ahead log of source replica before retiring it
+			caughtUp, err := r.isLeaderCaughtUp(state, move, getReplicaProgress(sourceState, move.ShardID, move.Source))
+			if err != nil || !caughtUp {
+				return move.Status, lag, err
+			}
+		}
+		if _, live := state.LiveNodes[shardState.Leader]; move.Target == models.NoLeader && !live {
+			// only drops replica after leadership is confirmed on other live replica
+			return move.Status, lag, nil
+		}
+		// retire source replica after target node caught up
+		if err = r.updateAssignment(move.Storage, move.Database, func(assignment *models.ShardAssignment) {
+			assignment.RemoveReplica(move.ShardID, move.Source)
+		}); err != nil {
+			return move.Status, lag, err
+		}
+		return models.ReplicaMoveDone, lag, nil
+	default:
+		return move.Status, move.Lag, nil
+	}
+}
+
+// isLearnerReady checks if leader keeps write ahead log for learner(target node) in all family logs of shard.
+func (r *rebalancer) isLearnerReady(state *models.StorageState, move *models.ReplicaMove) (bool, error) {
+	shardState, ok := state.ShardStates[move.Database][move.ShardID]
+	if !ok || !shardState.Replica.Contain(move.Target) {
+		// wait shard state synced
+		return false, nil
+	}
+	leader, ok := state.LiveNodes[shardState.Leader]
+	if !ok {
+		return false, nil
+	}
+	rs, err := r.adminCli.GetReplicaState(&leader, move.Database)
+	if err != nil {
+		return false, err
+	}
+	target := move.Target.String()
+	for _, familyState := range rs {
+		if familyState.ShardID != move.ShardID || familyState.Leader != leader.ID {
+			continue
+		}
+		found := false
+		for _, replicator := range familyState.Replicators {
+			if replicator.Replicator == target {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isLeaderCaughtUp checks if current leader of shard has replicated the final write ahead log of source replica.
+func (r *rebalancer) isLeaderCaughtUp(state *models.StorageState, move *models.ReplicaMove, sourceProgress int64) (bool, error) {
+	shardState := state.ShardStates[move.Database][move.ShardID]
+	leader, ok := state.LiveNodes[shardState.Leader]
+	if !ok {
+		return false, nil
+	}
+	rs, err := r.adminCli.GetReplicaState(&leader, move.Database)
+	if err != nil {
+		return false, err
+	}
+	return getReplicaProgress(rs, move.ShardID, move.Source) >= sourceProgress, nil
+}
+
+// getLag returns the pending write ahead log of target node, returns -1 if target isn't replicated by leader.
+func (r *rebalancer) getLag(state *models.StorageState, move *models.ReplicaMove) (int64, error) {
+	shardState, ok := state.ShardStates[move.Database][move.ShardID]
+	if !ok || !shardState.Replica.Contain(move.Target) {
+		return -1, nil
+	}
+	leader, ok := state.LiveNodes[shardState.Leader]
+	if !ok {
+		return -1, nil
+	}
+	rs, err := r.adminCli.GetReplicaState(&leader, move.Database)
+	if err != nil {
+		return -1, err
+	}
+	target := move.Target.String()
+	lag := int64(0)
+	for _, familyState := range rs {
+		if familyState.ShardID != move.ShardID || familyState.Leader != leader.ID {
+			continue
+		}
+		found := false
+		for _, replicator := range familyState.Replicators {
+			if replicator.Replicator == target {
+				found = true
+				lag += familyState.Append - replicator.ACK
+				break
+			}
+		}
+		if !found {
+			return -1, nil
+		}
+	}
+	return lag, nil
+}
+
+// updateAssignment modifies the shard assignment of database, then saves it into master/storage repo if changed.
+func (r *rebalancer) updateAssignment(storage, database string, fn func(assignment *models.ShardAssignment)) error {
+	r.assignLock.Lock()
+	defer r.assignLock.Unlock()
+
+	cluster := r.stateMgr.GetStorageCluster(storage)
+	if cluster == nil {
+		return constants.ErrNoStorageCluster
+	}
+	data, err := r.repo.Get(r.ctx, constants.GetDatabaseAssignPath(database))
+	if err != nil {
+		return err
+	}
+	assignment := &models.ShardAssignment{}
+	if err = encoding.JSONUnmarshal(data, assignment); err != nil {
+		return err
+	}
+	fn(assignment)
+	newData := encoding.JSONMarshal(assignment)
+	if bytes.Equal(data, newData) {
+		return nil
+	}
+	var databaseOption *option.DatabaseOption
+	for _, db := range r.stateMgr.GetDatabases() {
+		if db.Name == database {
+			databaseOption = db.Option
+			break
+		}
+	}
+	if err = r.repo.Put(r.ctx, constants.GetDatabaseAssignPath(database), newData); err != nil {
+		return err
+	}
+	return cluster.SaveDatabaseAssignment(assignment, databaseOption)
+}
+
+// getMove returns the copy of replica move.
+func (r *rebalancer) getMove(move *models.ReplicaMove) models.ReplicaMove {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return *move
+}
+
+// updateMove modifies replica move, then persists it.
+func (r *rebalancer) updateMove(move *models.ReplicaMove, fn func(m *models.ReplicaMove)) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	fn(move)
+	move.UpdateTime = timeutil.Now()
+	_ = r.saveMoves(move.Storage)
+}
+
+// fail marks replica move failure, removes the learner/promoted target if source isn't retired.
+func (r *rebalancer) fail(move *models.ReplicaMove, cause error) {
+	r.statistics.MoveFailure.WithTagValues(move.Storage).Incr()
+	if err := r.updateAssignment(move.Storage, move.Database, func(assignment *models.ShardAssignment) {
+		assignment.RemoveReplica(move.ShardID, move.Target)
+	}); err != nil {
+		r.logger.Warn("rollback shard assignment failure when replica move failure",
+			logger.String("move", move.Key()), logger.Error(err))
+	}
+	r.updateMove(move, func(m *models.ReplicaMove) {
+		m.Status = models.ReplicaMoveFailed
+		m.ErrMsg = cause.Error()
+	})
+	r.logger.Error("replica move failure", logger.String("storage", move.Storage),
+		logger.String("move", move.Key()), logger.Error(cause))
+}
+
+// saveMoves persists replica moves of storage cluster, only keeps latest finished moves.
+func (r *rebalancer) saveMoves(storage string) error {
+	moves := r.moves[storage]
+	finished := 0
+	for _, move := range moves {
+		if move.IsFinished() {
+			finished++
+		}
+	}
+	if finished > maxFinishedMoves {
+		var rs models.ReplicaMoves
+		for _, move := range moves {
+			if move.IsFinished() && finished > maxFinishedMoves {
+				finished--
+				continue
+			}
+			rs = append(rs, move)
+		}
+		moves = rs
+		r.moves[storage] = rs
+	}
+	if err := r.repo.Put(r.ctx, constants.GetReplicaMovePath(storage), encoding.JSONMarshal(moves)); err != nil {
+		r.logger.Error("save replica moves failure", logger.String("storage", storage), logger.Error(err))
+		return err
+	}
+	return nil
+}
+
+// loadDecommissions loads the decommission progress of storage nodes.
+func (r *rebalancer) loadDecommissions() {
+	kvs, err := r.repo.List(r.ctx, constants.DecommissionPath)
+	if err != nil {
+		r.logger.Error("load storage node decommissions failure", logger.Error(err))
+		return
+	}
+	for _, kv := range kvs {
+		decommissions := make(map[models.NodeID]*models.NodeDecommission)
+		if err0 := encoding.JSONUnmarshal(kv.Value, &decommissions); err0 != nil {
+			r.logger.Error("unmarshal storage node decommissions failure, ignore it",
+				logger.String("key", kv.Key), logger.Error(err0))
+			continue
+		}
+		r.decommissions[strings.TrimPrefix(kv.Key, constants.GetDecommissionPath(""))] = decommissions
+	}
+}
+
+// updateDecommissions updates the progress of decommissioning nodes, pushes it into storage state.
+func (r *rebalancer) updateDecommissions(state *models.StorageState, now int64) {
+	decommissions := r.decommissions[state.Name]
+	if len(decommissions) == 0 {
+		return
+	}
+	changed := false
+	for nodeID, decommission := range decommissions {
+		if _, live := state.LiveNodes[nodeID]; !live && decommission.IsRemovable() {
+			// removable node has been removed from storage cluster
+			delete(decommissions, nodeID)
+			changed = true
+			continue
+		}
+		replicas, leaders := 0, 0
+		for _, shards := range state.ReplicasOnNode(nodeID) {
+			replicas += len(shards)
+		}
+		for _, assignment := range state.ShardAssignments {
+			for _, learner := range assignment.Learners {
+				if learner.Contain(nodeID) {
+					replicas++
+				}
+			}
+		}
+		for _, shards := range state.LeadersOnNode(nodeID) {
+			leaders += len(shards)
+		}
+		status := models.NodeDecommissioning
+		if replicas == 0 && leaders == 0 {
+			status = models.NodeRemovable
+		}
+		if decommission.Replicas == replicas && decommission.Leaders == leaders && decommission.Status == status {
+			continue
+		}
+		decommission.Replicas = replicas
+		decommission.Leaders = leaders
+		decommission.Status = status
+		decommission.UpdateTime = now
+		changed = true
+		if status == models.NodeRemovable {
+			r.logger.Info("storage node is removable after decommission",
+				logger.String("storage", state.Name), logger.Any("node", nodeID))
+		}
+	}
+	if changed {
+		_ = r.saveDecommissions(state.Name)
+	}
+	if err := r.stateMgr.SetDecommissions(state.Name, decommissions); err != nil {
+		r.logger.Warn("sync storage node decommissions failure",
+			logger.String("storage", state.Name), logger.Error(err))
+	}
+	// node which is decommissioning cannot be assigned new replica
+	state.Decommissions = decommissions
+}
+
+// saveDecommissions persists the decommission progress of storage nodes.
+func (r *rebalancer) saveDecommissions(storage string) error {
+	if err := r.repo.Put(r.ctx, constants.GetDecommissionPath(storage),
+		encoding.JSONMarshal(r.decommissions[storage])); err != nil {
+		r.logger.Error("save storage node decommissions failure", logger.String("storage", storage), logger.Error(err))
+		return err
+	}
+	return nil
+}
+
+// maxAttempts returns max attempts(checks) of replica move's status, 0 means no limit.
+func maxAttempts(status models.ReplicaMoveStatus) int {
+	switch status {
+	case models.ReplicaMovePending:
+		return maxPendingChecks
+	case models.ReplicaMoveSnapshot:
+		return maxSnapshotAttempts
+	default:
+		return math.MaxInt32
+	}
+}
+
+// buildNodeLoads builds the load of live nodes(except decommissioning nodes) based on shard assignment and shard usages.
+func buildNodeLoads(state *models.StorageState, usages map[models.NodeID]map[string]models.ShardUsage) (loads []*nodeLoad) {
+	nodes := make(map[models.NodeID]*nodeLoad)
+	for nodeID := range state.LiveNodes {
+		if state.IsDecommissioned(nodeID) {
+			// decommissioning node is neither balance source nor target
+			continue
+		}
+		load := &nodeLoad{nodeID: nodeID, hostedShards: make(map[string]struct{})}
+		for _, usage := range usages[nodeID] {
+			load.diskBytes += usage.DiskBytes
+			load.series += usage.NumOfSeries
+		}
+		nodes[nodeID] = load
+		loads = append(loads, load)
+	}
+	for db, assignment := range state.ShardAssignments {
+		for shardID, replica := range assignment.Shards {
+			for _, nodeID := range replica.Replicas {
+				if load, ok := nodes[nodeID]; ok {
+					load.shards++
+					load.hostedShards[shardKey(db, shardID)] = struct{}{}
+				}
+			}
+		}
+		for shardID, learner := range assignment.Learners {
+			for _, nodeID := range learner.Replicas {
+				if load, ok := nodes[nodeID]; ok {
+					load.hostedShards[shardKey(db, shardID)] = struct{}{}
+				}
+			}
+		}
+	}
+	sort.Slice(loads, func(i, j int) bool { return loads[i].nodeID < loads[j].nodeID })
+	calcScore(loads)
+	return loads
+}
+
+// calcScore calculates the score of node load, which is the average of normalized shards/disk/series.
+func calcScore(loads []*nodeLoad) {
+	var shards, diskBytes, series int64
+	for _, load := range loads {
+		shards += load.shards
+		diskBytes += load.diskBytes
+		series += load.series
+	}
+	for _, load := range loads {
+		load.score = (ratio(load.shards, shards) + ratio(load.diskBytes, diskBytes) + ratio(load.series, series)) / 3
+	}
+}
+
+// pickTarget picks the least loaded node which doesn't host the shard(replica or learner),
+// the node may host other shards of the database, snapshot of shard is merged into the database.
+func pickTarget(loads []*nodeLoad, database string, shardID models.ShardID) (target *nodeLoad) {
+	key := shardKey(database, shardID)
+	for _, load := range loads {
+		if _, ok := load.hostedShards[key]; ok {
+			continue
+		}
+		if target == nil || load.score < target.score || (load.score == target.score && load.shards < target.shards) {
+			target = load
+		}
+	}
+	return
+}
+
+// pickShard picks the biggest shard on source node which moving to target node won't make target overloaded than source.
+func pickShard(
+	state *models.StorageState,
+	usages map[models.NodeID]map[string]models.ShardUsage,
+	loads []*nodeLoad,
+	source, target *nodeLoad,
+	busy map[string]struct{},
+) (database string, shardID models.ShardID, usage models.ShardUsage, ok bool) {
+	var shards, diskBytes, series int64
+	for _, load := range loads {
+		shards += load.shards
+		diskBytes += load.diskBytes
+		series += load.series
+	}
+	maxContribution := (source.score - target.score) / 2
+	best := 0.0
+	for _, db := range sortedDatabases(state) {
+		assignment := state.ShardAssignments[db]
+		for _, id := range sortedShards(assignment) {
+			key := shardKey(db, id)
+			if _, running := busy[key]; running || !assignment.Shards[id].Contain(source.nodeID) {
+				continue
+			}
+			if _, hosted := target.hostedShards[key]; hosted {
+				continue
+			}
+			u := usages[source.nodeID][key]
+			contribution := (ratio(1, shards) + ratio(u.DiskBytes, diskBytes) + ratio(u.NumOfSeries, series)) / 3
+			if contribution >= maxContribution || contribution <= best {
+				continue
+			}
+			best = contribution
+			database, shardID, usage, ok = db, id, u, true
+		}
+	}
+	return
+}
+
+// pickRetiredReplica picks the replica of shard which will be dropped when replica factor lowered,
+// prefers offline/decommissioning replica, then the last follower.
+func pickRetiredReplica(state *models.StorageState, database string, shardID models.ShardID) models.NodeID {
+	replicas := state.ShardAssignments[database].Shards[shardID].Replicas
+	for _, nodeID := range replicas {
+		if _, live := state.LiveNodes[nodeID]; !live || state.IsDecommissioned(nodeID) {
+			return nodeID
+		}
+	}
+	leader := state.ShardStates[database][shardID].Leader
+	for i := len(replicas) - 1; i >= 0; i-- {
+		if replicas[i] != leader {
+			return replicas[i]
+		}
+	}
+	return replicas[len(replicas)-1]
+}
+
+// liveReplica returns the live replica of shard, prefers current leader.
+func liveReplica(state *models.StorageState, database string, shardID models.ShardID) models.NodeID {
+	if shardState, ok := state.ShardStates[database][shardID]; ok {
+		if _, live := state.LiveNodes[shardState.Leader]; live {
+			return shardState.Leader
+		}
+	}
+	if replica, ok := state.ShardAssignments[database].Shards[shardID]; ok {
+		for _, nodeID := range replica.Replicas {
+			if _, live := state.LiveNodes[nodeID]; live {
+				return nodeID
+			}
+		}
+	}
+	return models.NoLeader
+}
+
+// sortedDatabases returns the sorted database names of storage cluster.
+func sortedDatabases(state *models.StorageState) (rs []string) {
+	for db := range state.ShardAssignments {
+		rs = append(rs, db)
+	}
+	sort.Strings(rs)
+	return
+}
+
+// sortedShards returns the sorted shard ids of shard assignment.
+func sortedShards(assignment *models.ShardAssignment) (rs []models.ShardID) {
+	for shardID := range assignment.Shards {
+		rs = append(rs, shardID)
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+	return
+}
+
+// shardKey returns the unique key of shard.
+func shardKey(database string, shardID models.ShardID) string {
+	return fmt.Sprintf("%s/%d", database, shardID)
+}
+
+// ratio returns value/total, returns 0 if total is 0.
+func ratio(value, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(value) / float64(total)
+}
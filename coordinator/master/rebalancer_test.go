@@ -0,0 +1,601 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func newTestRebalancer(ctrl *gomock.Controller) (*rebalancer, *state.MockRepository, *MockStateManager, *client.MockStorageAdminCli) {
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := NewMockStateManager(ctrl)
+	cli := client.NewMockStorageAdminCli(ctrl)
+	newStorageAdminCliFn = func() client.StorageAdminCli {
+		return cli
+	}
+	r := NewRebalancer(context.TODO(), repo, stateMgr).(*rebalancer)
+	r.cfg = config.NewDefaultRebalance()
+	r.cfg.Enable = true
+	return r, repo, stateMgr, cli
+}
+
+func newTestStorageState() *models.StorageState {
+	s := models.NewStorageState("test")
+	for i := 1; i <= 3; i++ {
+		s.NodeOnline(models.StatefulNode{ID: models.NodeID(i), StatelessNode: models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(9000 + i)}})
+	}
+	assignment := models.NewShardAssignment("db")
+	shardStates := make(map[models.ShardID]models.ShardState)
+	for i := 0; i < 4; i++ {
+		assignment.AddReplica(models.ShardID(i), 1)
+		assignment.AddReplica(models.ShardID(i), 2)
+		shardStates[models.ShardID(i)] = models.ShardState{
+			ID: models.ShardID(i), Leader: 1, State: models.OnlineShard,
+			Replica: models.Replica{Replicas: []models.NodeID{1, 2}},
+		}
+	}
+	s.ShardAssignments["db"] = assignment
+	s.ShardStates["db"] = shardStates
+	return s
+}
+
+func TestRebalancer_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		ctrl.Finish()
+	}()
+	r, repo, stateMgr, _ := newTestRebalancer(ctrl)
+	r.cfg.CheckInterval = ltoml.Duration(10 * time.Millisecond)
+	stateMgr.EXPECT().GetStorages().Return([]config.StorageCluster{{Config: &config.RepoState{Namespace: "test"}}}).AnyTimes()
+	stateMgr.EXPECT().GetStorageState(gomock.Any()).Return(nil, false).AnyTimes()
+	// case 1: load moves/decommissions failure
+	repo.EXPECT().List(gomock.Any(), constants.ReplicaMovePath).Return(nil, fmt.Errorf("err"))
+	repo.EXPECT().List(gomock.Any(), constants.DecommissionPath).Return(nil, fmt.Errorf("err"))
+	r.Start()
+	r.Trigger("test")
+	time.Sleep(50 * time.Millisecond)
+	r.Close()
+
+	// case 2: load moves, resume unfinished move
+	r, repo, stateMgr, _ = newTestRebalancer(ctrl)
+	r.cfg.Enable = false
+	stateMgr.EXPECT().GetStorageState(gomock.Any()).Return(nil, false).AnyTimes()
+	repo.EXPECT().List(gomock.Any(), constants.ReplicaMovePath).Return([]state.KeyValue{
+		{Key: constants.GetReplicaMovePath("test"), Value: encoding.JSONMarshal(models.ReplicaMoves{
+			{Storage: "test", Database: "db", Status: models.ReplicaMoveDone},
+			{Storage: "test", Database: "db", ShardID: 1, Status: models.ReplicaMoveCatchUp},
+		})},
+		{Key: constants.GetReplicaMovePath("test2"), Value: []byte("xx")},
+	}, nil)
+	repo.EXPECT().List(gomock.Any(), constants.DecommissionPath).Return([]state.KeyValue{
+		{Key: constants.GetDecommissionPath("test"), Value: encoding.JSONMarshal(map[models.NodeID]*models.NodeDecommission{
+			2: {NodeID: 2, Status: models.NodeDecommissioning},
+		})},
+		{Key: constants.GetDecommissionPath("test2"), Value: []byte("xx")},
+	}, nil)
+	r.Start()
+	assert.Len(t, r.GetReplicaMoves("test"), 2)
+	assert.Empty(t, r.GetReplicaMoves("test2"))
+	assert.Len(t, r.decommissions["test"], 1)
+	assert.Empty(t, r.decommissions["test2"])
+	r.Close()
+}
+
+func TestRebalancer_Plan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		ctrl.Finish()
+	}()
+	r, repo, stateMgr, cli := newTestRebalancer(ctrl)
+	r.cfg.MaxConcurrentMoves = 2
+	var databases []models.Database
+	stateMgr.EXPECT().GetDatabases().DoAndReturn(func() []models.Database { return databases }).AnyTimes()
+	// case 1: storage state not found
+	stateMgr.EXPECT().GetStorageState("test").Return(nil, false)
+	r.check("test")
+
+	usage := func(nodeID models.NodeID) []models.DatabaseUsage {
+		if nodeID == 3 {
+			return nil
+		}
+		var shards []models.ShardUsage
+		for i := 0; i < 4; i++ {
+			shards = append(shards, models.ShardUsage{ShardID: models.ShardID(i), DiskBytes: 100, NumOfSeries: 10})
+		}
+		return []models.DatabaseUsage{{Database: "db", Shards: shards}}
+	}
+	cli.EXPECT().GetDatabaseUsage(gomock.Any()).DoAndReturn(func(node models.Node) ([]models.DatabaseUsage, error) {
+		return usage(node.(*models.StatefulNode).ID), nil
+	}).AnyTimes()
+	// case 2: save moves failure
+	stateMgr.EXPECT().GetStorageState("test").Return(newTestStorageState(), true)
+	repo.EXPECT().Put(gomock.Any(), constants.GetReplicaMovePath("test"), gomock.Any()).Return(fmt.Errorf("err"))
+	r.check("test")
+	assert.Empty(t, r.GetReplicaMoves("test"))
+
+	// case 3: move shards from overloaded nodes to new node, new node can host many shards of database
+	s := newTestStorageState()
+	moves := r.plan(s, r.collectUsages(s), timeutil.Now())
+	assert.Len(t, moves, 2)
+	assert.Equal(t, models.NodeID(2), moves[0].Source)
+	assert.Equal(t, models.NodeID(3), moves[0].Target)
+	assert.Equal(t, models.NodeID(2), moves[0].SnapshotFrom)
+	assert.Equal(t, models.ShardID(0), moves[0].ShardID)
+	assert.Equal(t, models.ReplicaMovePending, moves[0].Status)
+	assert.Equal(t, models.NodeID(1), moves[1].Source)
+	assert.Equal(t, models.NodeID(3), moves[1].Target)
+	assert.Equal(t, models.ShardID(1), moves[1].ShardID)
+
+	// case 4: balanced
+	s.ShardAssignments["db"].Shards[0].Replicas = []models.NodeID{1, 3}
+	s.ShardAssignments["db"].Shards[1].Replicas = []models.NodeID{2, 3}
+	assert.Empty(t, r.plan(s, map[models.NodeID]map[string]models.ShardUsage{}, timeutil.Now()))
+
+	// case 5: no usage, skip balancing
+	s = newTestStorageState()
+	assert.Empty(t, r.plan(s, nil, timeutil.Now()))
+
+	// case 6: evacuate replicas on offline node after timeout
+	s = newTestStorageState()
+	s.NodeOffline(2)
+	now := timeutil.Now()
+	assert.Empty(t, r.plan(s, nil, now))
+	moves = r.plan(s, nil, now+r.cfg.OfflineTimeout.Duration().Milliseconds())
+	// throttled by max concurrent moves
+	assert.Len(t, moves, 2)
+	for idx, move := range moves {
+		assert.Equal(t, models.ShardID(idx), move.ShardID)
+		assert.Equal(t, models.NodeID(2), move.Source)
+		assert.Equal(t, models.NodeID(3), move.Target)
+		assert.Equal(t, models.NodeID(1), move.SnapshotFrom)
+	}
+	// case 7: node online again
+	s = newTestStorageState()
+	assert.Empty(t, r.plan(s, nil, now))
+	assert.Empty(t, r.offlineSince["test"])
+
+	// case 8: throttled by max concurrent moves
+	r.moves["test"] = models.ReplicaMoves{
+		{Database: "db", ShardID: 1, Status: models.ReplicaMoveSnapshot},
+		{Database: "db", ShardID: 2, Status: models.ReplicaMoveCatchUp},
+	}
+	assert.Empty(t, r.plan(s, r.collectUsages(s), now))
+
+	// case 9: plan and execute move
+	r.moves["test"] = nil
+	r.ctx, r.cancel = context.WithCancel(context.TODO())
+	r.cancel()
+	stateMgr.EXPECT().GetStorageState("test").Return(newTestStorageState(), true).AnyTimes()
+	repo.EXPECT().Put(gomock.Any(), constants.GetReplicaMovePath("test"), gomock.Any()).Return(nil).AnyTimes()
+	r.check("test")
+	assert.Len(t, r.GetReplicaMoves("test"), 2)
+
+	// case 10: replica factor raised, add replica on node which doesn't host the shard
+	r.moves["test"] = nil
+	databases = []models.Database{{Name: "db", Storage: "test", ReplicaFactor: 3}, {Name: "db2", Storage: "other"}}
+	s = newTestStorageState()
+	s.ShardAssignments["db"].Shards[0].Replicas = []models.NodeID{1}
+	moves = r.plan(s, nil, now)
+	assert.Len(t, moves, 2)
+	assert.Equal(t, models.NoLeader, moves[0].Source)
+	assert.Equal(t, models.NodeID(3), moves[0].Target)
+	assert.Equal(t, models.NodeID(1), moves[0].SnapshotFrom)
+	assert.Equal(t, "replica factor 1 -> 3", moves[0].Reason)
+	// node 3 hosts database already(shard 0 is learner)
+	assert.Equal(t, models.ShardID(1), moves[1].ShardID)
+	assert.Equal(t, models.NodeID(3), moves[1].Target)
+	assert.Equal(t, "replica factor 2 -> 3", moves[1].Reason)
+	// every node hosts database, add replica of shard on node which doesn't host it
+	databases = []models.Database{{Name: "db", Storage: "test", ReplicaFactor: 2}}
+	s = newTestStorageState()
+	for i := 0; i < 3; i++ {
+		replicas := []models.NodeID{models.NodeID(i + 1)}
+		s.ShardAssignments["db"].Shards[models.ShardID(i)].Replicas = replicas
+		s.ShardStates["db"][models.ShardID(i)] = models.ShardState{
+			ID: models.ShardID(i), Leader: models.NodeID(i + 1), State: models.OnlineShard,
+			Replica: models.Replica{Replicas: replicas},
+		}
+	}
+	delete(s.ShardAssignments["db"].Shards, 3)
+	moves = r.plan(s, nil, now)
+	assert.Len(t, moves, 2)
+	for _, move := range moves {
+		assert.NotEqual(t, move.SnapshotFrom, move.Target)
+		assert.Equal(t, "replica factor 1 -> 2", move.Reason)
+	}
+	// case 11: replica factor lowered, drop follower replica
+	databases = []models.Database{{Name: "db", Storage: "test", ReplicaFactor: 1}}
+	s = newTestStorageState()
+	moves = r.plan(s, nil, now)
+	assert.Len(t, moves, 2)
+	for _, move := range moves {
+		assert.Equal(t, models.NodeID(2), move.Source)
+		assert.Equal(t, models.NoLeader, move.Target)
+		assert.Equal(t, "replica factor 2 -> 1", move.Reason)
+	}
+	// prefer offline replica
+	s.NodeOffline(1)
+	assert.Equal(t, models.NodeID(1), pickRetiredReplica(s, "db", 0))
+	s = newTestStorageState()
+	s.ShardAssignments["db"].Shards[0].Replicas = []models.NodeID{2, 1}
+	assert.Equal(t, models.NodeID(2), pickRetiredReplica(s, "db", 0))
+	databases = nil
+
+	// case 12: get usage failure
+	cli2 := client.NewMockStorageAdminCli(ctrl)
+	r.adminCli = cli2
+	cli2.EXPECT().GetDatabaseUsage(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Nil(t, r.collectUsages(newTestStorageState()))
+}
+
+func TestRebalancer_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		ctrl.Finish()
+	}()
+	r, repo, stateMgr, cli := newTestRebalancer(ctrl)
+	r.pollInterval = 10 * time.Millisecond
+	cluster := NewMockStorageCluster(ctrl)
+	s := newTestStorageState()
+	assignment := s.ShardAssignments["db"]
+	stateMgr.EXPECT().GetStorageState("test").DoAndReturn(func(_ string) (*models.StorageState, bool) {
+		shardState := s.ShardStates["db"][0]
+		shardState.Replica = models.Replica{Replicas: []models.NodeID{1, 2, 3}}
+		s.ShardStates["db"][0] = shardState
+		return s, true
+	}).AnyTimes()
+	stateMgr.EXPECT().GetStorageCluster("test").Return(cluster).AnyTimes()
+	stateMgr.EXPECT().GetDatabases().Return([]models.Database{{Name: "db"}}).AnyTimes()
+	repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("db")).
+		Return(encoding.JSONMarshal(assignment), nil).AnyTimes()
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	cluster.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	cli.EXPECT().GetReplicaState(gomock.Any(), "db").Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "2", ACK: 100}, {Replicator: "3", ACK: 90}}},
+		{ShardID: 1, Leader: 1, Append: 100},
+	}, nil).AnyTimes()
+	cli.EXPECT().InstallSnapshot(gomock.Any(), gomock.Any()).Return(nil)
+	cli.EXPECT().FenceShard(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	move := &models.ReplicaMove{Storage: "test", Database: "db", ShardID: 0, Source: 2, Target: 3, SnapshotFrom: 2,
+		Status: models.ReplicaMovePending}
+	r.moves["test"] = models.ReplicaMoves{move}
+	r.execute(move)
+	assert.Eventually(t, func() bool {
+		return r.GetReplicaMoves("test")[0].Status == models.ReplicaMoveDone
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(10), r.GetReplicaMoves("test")[0].Lag)
+
+	// case 2: install snapshot failure, rollback learner
+	cli.EXPECT().InstallSnapshot(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).Times(maxSnapshotAttempts)
+	move = &models.ReplicaMove{Storage: "test", Database: "db", ShardID: 0, Source: 2, Target: 3, SnapshotFrom: 2,
+		Status: models.ReplicaMoveSnapshot}
+	r.moves["test"] = models.ReplicaMoves{move}
+	r.execute(move)
+	assert.Eventually(t, func() bool {
+		return r.GetReplicaMoves("test")[0].Status == models.ReplicaMoveFailed
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "err", r.GetReplicaMoves("test")[0].ErrMsg)
+	r.Close()
+}
+
+func TestRebalancer_Step(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		ctrl.Finish()
+	}()
+	r, repo, stateMgr, cli := newTestRebalancer(ctrl)
+	cluster := NewMockStorageCluster(ctrl)
+	s := newTestStorageState()
+	move := &models.ReplicaMove{Storage: "test", Database: "db", ShardID: 0, Source: 2, Target: 3, SnapshotFrom: 2}
+	// case 1: storage not found
+	stateMgr.EXPECT().GetStorageState("test").Return(nil, false)
+	_, _, err := r.step(move)
+	assert.Equal(t, constants.ErrNoStorageCluster, err)
+
+	stateMgr.EXPECT().GetStorageState("test").Return(s, true).AnyTimes()
+	// case 2: storage cluster not found
+	move.Status = models.ReplicaMovePending
+	stateMgr.EXPECT().GetStorageCluster("test").Return(nil)
+	_, _, err = r.step(move)
+	assert.Equal(t, constants.ErrNoStorageCluster, err)
+	stateMgr.EXPECT().GetStorageCluster("test").Return(cluster).AnyTimes()
+	// case 3: get assignment failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	// case 4: unmarshal assignment failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("xx"), nil)
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	// case 5: save assignment failure
+	stateMgr.EXPECT().GetDatabases().Return(nil).AnyTimes()
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(s.ShardAssignments["db"]), nil).AnyTimes()
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	cluster.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	// case 6: shard state not synced
+	status, _, err := r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMovePending, status)
+	// case 7: leader not alive
+	shardState := s.ShardStates["db"][0]
+	shardState.Replica.Replicas = []models.NodeID{1, 2, 3}
+	shardState.Leader = 5
+	s.ShardStates["db"][0] = shardState
+	status, _, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMovePending, status)
+	shardState.Leader = 1
+	s.ShardStates["db"][0] = shardState
+	// case 8: get replica state failure
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	// case 9: learner not replicated by leader
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "2", ACK: 100}}},
+	}, nil).Times(2)
+	status, _, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMovePending, status)
+	// case 10: snapshot source/target not alive
+	move.Status = models.ReplicaMoveSnapshot
+	move.SnapshotFrom = 5
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	move.SnapshotFrom = 2
+	move.Target = 5
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	move.Target = 3
+	// case 11: catch up, target not replicated
+	move.Status = models.ReplicaMoveCatchUp
+	status, lag, err := r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	assert.Equal(t, int64(-1), lag)
+	// case 12: catch up, get replica state failure
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	// case 13: lag too large
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 1000, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 100}}},
+	}, nil)
+	status, lag, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	assert.Equal(t, int64(900), lag)
+	// case 14: caught up, transfer leadership away from source replica
+	shardState.Leader = 2
+	s.ShardStates["db"][0] = shardState
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 2, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 100}}},
+	}, nil).Times(4)
+	// fence source replica failure
+	cli.EXPECT().FenceShard(gomock.Any(), &models.FenceParam{Database: "db", ShardID: 0, Lease: 3 * r.pollInterval.Milliseconds()}).
+		Return(nil, fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	cli.EXPECT().FenceShard(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 2, Append: 100},
+	}, nil).AnyTimes()
+	stateMgr.EXPECT().TransferLeader("test", "db", models.ShardID(0), models.NodeID(2)).Return(models.NoLeader, fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	// no replica caught up, wait next check
+	stateMgr.EXPECT().TransferLeader("test", "db", models.ShardID(0), models.NodeID(2)).
+		Return(models.NoLeader, constants.ErrNoCaughtUpReplica)
+	status, _, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	stateMgr.EXPECT().TransferLeader("test", "db", models.ShardID(0), models.NodeID(2)).Return(models.NodeID(3), nil)
+	status, lag, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	assert.Equal(t, int64(0), lag)
+	// case 15: leadership transferred, new leader doesn't have final write ahead log of source replica
+	shardState.Leader = 1
+	s.ShardStates["db"][0] = shardState
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 100}}},
+		{ShardID: 0, Leader: 2, Append: 99},
+	}, nil).Times(2)
+	status, _, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	// get replica state of new leader failure
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 100}}},
+	}, nil)
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, _, err = r.step(move)
+	assert.Error(t, err)
+	// caught up, retire source replica
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 100}}},
+		{ShardID: 0, Leader: 2, Append: 100},
+	}, nil).Times(2)
+	status, _, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveDone, status)
+	// case 16: add replica completed, no source replica retired
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 100}}},
+	}, nil)
+	addMove := *move
+	addMove.Source = models.NoLeader
+	status, _, err = r.step(&addMove)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveDone, status)
+	// case 17: drop replica, skip learner/snapshot
+	dropMove := &models.ReplicaMove{Storage: "test", Database: "db", ShardID: 0, Source: 2,
+		Target: models.NoLeader, SnapshotFrom: models.NoLeader, Status: models.ReplicaMovePending}
+	status, _, err = r.step(dropMove)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	// case 18: drop replica, wait leader elected on other live replica
+	dropMove.Status = models.ReplicaMoveCatchUp
+	shardState.Leader = models.NoLeader
+	s.ShardStates["db"][0] = shardState
+	status, _, err = r.step(dropMove)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveCatchUp, status)
+	shardState.Leader = 1
+	s.ShardStates["db"][0] = shardState
+	cli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return([]models.FamilyLogReplicaState{
+		{ShardID: 0, Leader: 2, Append: 100},
+	}, nil)
+	status, _, err = r.step(dropMove)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveDone, status)
+	// case 19: finished move
+	move.Status = models.ReplicaMoveDone
+	status, _, err = r.step(move)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReplicaMoveDone, status)
+}
+
+func TestRebalancer_Decommission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		ctrl.Finish()
+	}()
+	r, repo, stateMgr, _ := newTestRebalancer(ctrl)
+	r.cfg.Enable = false
+	stateMgr.EXPECT().GetDatabases().Return(nil).AnyTimes()
+	// case 1: storage not found
+	stateMgr.EXPECT().GetStorageState("test").Return(nil, false)
+	assert.Equal(t, constants.ErrNoStorageCluster, r.Decommission("test", 2))
+	s := newTestStorageState()
+	stateMgr.EXPECT().GetStorageState("test").Return(s, true).AnyTimes()
+	// case 2: node not found
+	assert.ErrorIs(t, r.Decommission("test", 5), constants.ErrStorageNodeNotFound)
+	// case 3: no available node
+	s.Decommissions = map[models.NodeID]*models.NodeDecommission{1: {NodeID: 1}, 3: {NodeID: 3}}
+	assert.Equal(t, constants.ErrNoLiveNode, r.Decommission("test", 2))
+	s.Decommissions = nil
+	// case 4: save decommission failure
+	repo.EXPECT().Put(gomock.Any(), constants.GetDecommissionPath("test"), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, r.Decommission("test", 2))
+	assert.Empty(t, r.decommissions["test"])
+	// case 5: start decommission
+	repo.EXPECT().Put(gomock.Any(), constants.GetDecommissionPath("test"), gomock.Any()).Return(nil).AnyTimes()
+	assert.NoError(t, r.Decommission("test", 2))
+	assert.NoError(t, r.Decommission("test", 2))
+	assert.Len(t, r.decommissions["test"], 1)
+	assert.Equal(t, "test", <-r.triggers)
+
+	// case 6: plan replica moves away from decommissioning node, auto rebalance disabled
+	var decommissions map[models.NodeID]*models.NodeDecommission
+	stateMgr.EXPECT().SetDecommissions("test", gomock.Any()).
+		DoAndReturn(func(_ string, rs map[models.NodeID]*models.NodeDecommission) error {
+			decommissions = rs
+			return nil
+		}).AnyTimes()
+	now := timeutil.Now()
+	s.NodeOffline(1)
+	r.updateDecommissions(s, now)
+	assert.Equal(t, 4, decommissions[2].Replicas)
+	assert.Equal(t, 0, decommissions[2].Leaders)
+	assert.Equal(t, models.NodeDecommissioning, decommissions[2].Status)
+	moves := r.plan(s, nil, now+r.cfg.OfflineTimeout.Duration().Milliseconds())
+	// offline node isn't evacuated, because auto rebalance disabled
+	assert.Len(t, moves, 1)
+	assert.Equal(t, models.NodeID(2), moves[0].Source)
+	assert.Equal(t, models.NodeID(3), moves[0].Target)
+	assert.Equal(t, models.NodeID(2), moves[0].SnapshotFrom)
+	assert.Equal(t, "node 2 decommission", moves[0].Reason)
+	// snapshot copied from live replica if decommissioning node is offline
+	s = newTestStorageState()
+	s.NodeOffline(2)
+	s.Decommissions = decommissions
+	moves = r.plan(s, nil, now)
+	assert.Len(t, moves, 1)
+	assert.Equal(t, models.NodeID(1), moves[0].SnapshotFrom)
+
+	// case 7: node is removable after all replicas/leaders moved away
+	s = newTestStorageState()
+	s.ShardAssignments["db"].Learners = map[models.ShardID]*models.Replica{0: {Replicas: []models.NodeID{2}}}
+	r.updateDecommissions(s, now)
+	assert.Equal(t, 5, decommissions[2].Replicas)
+	for shardID := range s.ShardAssignments["db"].Shards {
+		s.ShardAssignments["db"].RemoveReplica(shardID, 2)
+	}
+	shardState := s.ShardStates["db"][0]
+	shardState.Leader = 2
+	s.ShardStates["db"][0] = shardState
+	r.updateDecommissions(s, now)
+	assert.Equal(t, 0, decommissions[2].Replicas)
+	assert.Equal(t, 1, decommissions[2].Leaders)
+	shardState.Leader = 1
+	s.ShardStates["db"][0] = shardState
+	r.updateDecommissions(s, now)
+	assert.True(t, decommissions[2].IsRemovable())
+	assert.True(t, s.IsDecommissioned(2))
+	// removable node isn't assigned new replica
+	for _, load := range buildNodeLoads(s, nil) {
+		assert.NotEqual(t, models.NodeID(2), load.nodeID)
+	}
+	// case 8: removable node removed from storage cluster
+	s.NodeOffline(2)
+	r.updateDecommissions(s, now)
+	assert.Empty(t, decommissions)
+}
+
+func TestRebalancer_SaveMoves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+		ctrl.Finish()
+	}()
+	r, repo, _, _ := newTestRebalancer(ctrl)
+	var moves models.ReplicaMoves
+	for i := 0; i < maxFinishedMoves+10; i++ {
+		moves = append(moves, &models.ReplicaMove{ShardID: models.ShardID(i), Status: models.ReplicaMoveDone})
+	}
+	moves = append(moves, &models.ReplicaMove{ShardID: 1000, Status: models.ReplicaMovePending})
+	r.moves["test"] = moves
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	assert.NoError(t, r.saveMoves("test"))
+	assert.Len(t, r.moves["test"], maxFinishedMoves+1)
+	assert.Equal(t, models.ShardID(10), r.moves["test"][0].ShardID)
+}
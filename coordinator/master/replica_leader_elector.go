@@ -18,34 +18,121 @@
 package master
 
 import (
+	"context"
+	"time"
+
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
 )
 
 //go:generate mockgen -source=./replica_leader_elector.go -destination=./replica_leader_elector_mock.go -package=master
 
+// ReplicaStates represents the write ahead log replica state of database which is fetched from storage nodes.
+// key: node id, value: replica state of all families on the node.
+type ReplicaStates map[models.NodeID][]models.FamilyLogReplicaState
+
 // ReplicaLeaderElector represents replica leader elector for shard.
 type ReplicaLeaderElector interface {
-	// ElectLeader elects the replica's leader based on shard assignment.
+	// FetchReplicaStates fetches the write ahead log replica state of database from storage nodes in parallel,
+	// the node which fetches failure(or timeout) is not included in result.
+	// NOTICE: states are fetched by admin api instead of rpc(ReplicaService.GetReplicaAckIndex), because the rpc
+	// returns the ack index of one partition(family time/leader) which master doesn't know, and it creates
+	// the partition on storage node if not exist. Admin request uses election timeout as its own timeout,
+	// so the fetching goroutine exits along with the election waiting.
+	FetchReplicaStates(database string, nodes []models.StatefulNode) ReplicaStates
+	// ElectLeader elects the replica's leader based on shard assignment,
+	// prefers the live replica which has the most progress of write ahead log replicated from previous leader,
+	// progress is calculated by the replica states fetched before election(no remote call during election).
+	// If previous leader is NoLeader, elects leader based on replica order of shard assignment.
 	ElectLeader(shardAssignment *models.ShardAssignment,
 		liveNodes map[models.NodeID]models.StatefulNode,
 		shardID models.ShardID,
+		prevLeader models.NodeID,
+		replicaStates ReplicaStates,
 	) (leader models.NodeID, err error)
+	// TransferLeader picks the live replica which has caught up all write ahead log of current leader(lag is 0)
+	// as new leader, replica order of shard assignment is used if many replicas caught up.
+	TransferLeader(shardAssignment *models.ShardAssignment,
+		liveNodes map[models.NodeID]models.StatefulNode,
+		shardID models.ShardID,
+		leader models.NodeID,
+	) (newLeader models.NodeID, err error)
 }
 
 // replicaLeaderElector implements ReplicaLeaderElector interface.
 type replicaLeaderElector struct {
+	ctx      context.Context
+	cfg      *config.Election
+	adminCli client.StorageAdminCli
+
+	logger *logger.Logger
 }
 
 // newReplicaLeaderElector creates a ReplicaLeaderElector instance.
-func newReplicaLeaderElector() ReplicaLeaderElector {
-	return &replicaLeaderElector{}
+func newReplicaLeaderElector(ctx context.Context) ReplicaLeaderElector {
+	cfg := &config.GlobalBrokerConfig().Election
+	return &replicaLeaderElector{
+		ctx:      ctx,
+		cfg:      cfg,
+		adminCli: client.NewStorageAdminCliWithTimeout(cfg.Timeout.Duration()),
+		logger:   logger.GetLogger("Master", "ReplicaLeaderElector"),
+	}
+}
+
+// FetchReplicaStates fetches the write ahead log replica state of database from storage nodes in parallel,
+// the node which fetches failure(or timeout) is not included in result.
+func (r *replicaLeaderElector) FetchReplicaStates(database string, nodes []models.StatefulNode) ReplicaStates {
+	type result struct {
+		nodeID models.NodeID
+		state  []models.FamilyLogReplicaState
+		err    error
+	}
+	results := make(chan result, len(nodes))
+	for idx := range nodes {
+		node := nodes[idx]
+		go func() {
+			rs, err := r.adminCli.GetReplicaState(&node, database)
+			results <- result{nodeID: node.ID, state: rs, err: err}
+		}()
+	}
+	timer := time.NewTimer(r.cfg.Timeout.Duration())
+	defer timer.Stop()
+
+	replicaStates := make(ReplicaStates)
+	for range nodes {
+		select {
+		case rs := <-results:
+			if rs.err != nil {
+				r.logger.Warn("get replica state from storage node failure, ignore it",
+					logger.String("db", database),
+					logger.Any("node", rs.nodeID),
+					logger.Error(rs.err))
+				continue
+			}
+			replicaStates[rs.nodeID] = rs.state
+		case <-timer.C:
+			r.logger.Warn("get replica state from storage nodes timeout, ignore the nodes not responded",
+				logger.String("db", database),
+				logger.Int("nodes", len(nodes)),
+				logger.Int("responded", len(replicaStates)))
+			return replicaStates
+		}
+	}
+	return replicaStates
 }
 
-// ElectLeader elects the replica's leader based on shard assignment.
+// ElectLeader elects the replica's leader based on shard assignment,
+// prefers the live replica which has the most progress of write ahead log replicated from previous leader,
+// progress is calculated by the replica states fetched before election(no remote call during election).
+// If previous leader is NoLeader, elects leader based on replica order of shard assignment.
 func (r *replicaLeaderElector) ElectLeader(shardAssignment *models.ShardAssignment,
 	liveNodes map[models.NodeID]models.StatefulNode,
 	shardID models.ShardID,
+	prevLeader models.NodeID,
+	replicaStates ReplicaStates,
 ) (leader models.NodeID, err error) {
 	replicas, ok := shardAssignment.Shards[shardID]
 	if !ok {
@@ -65,7 +152,129 @@ func (r *replicaLeaderElector) ElectLeader(shardAssignment *models.ShardAssignme
 		err = constants.ErrNoLiveReplica
 		return
 	}
-	// elect leader from live replicas
 	leader = liveReplicaNodes.Replicas[0]
+	if prevLeader == models.NoLeader || (len(liveReplicaNodes.Replicas) == 1 && !r.cfg.WaitInSyncReplica) {
+		// no progress need to compare, elect leader from live replicas
+		return
+	}
+	progresses := make(map[models.NodeID]int64)
+	for _, nodeID := range liveReplicaNodes.Replicas {
+		if rs, ok := replicaStates[nodeID]; ok {
+			progresses[nodeID] = getReplicaProgress(rs, shardID, prevLeader)
+		}
+	}
+	if r.cfg.WaitInSyncReplica {
+		return r.electInSyncReplica(&liveReplicaNodes, len(replicas.Replicas), progresses, replicaStates, shardID, prevLeader)
+	}
+	// elect the most caught-up replica, replica order of shard assignment is used if progress is same
+	maxProgress := int64(-1)
+	for _, nodeID := range liveReplicaNodes.Replicas {
+		if progress, ok := progresses[nodeID]; ok && progress > maxProgress {
+			leader = nodeID
+			maxProgress = progress
+		}
+	}
 	return
 }
+
+// electInSyncReplica elects the replica which has caught up the last append index of previous leader,
+// if previous leader doesn't report its progress, uses the max progress of a quorum of replicas instead,
+// refuses election if no replica caught up.
+func (r *replicaLeaderElector) electInSyncReplica(liveReplicaNodes *models.Replica,
+	numOfReplicas int,
+	progresses map[models.NodeID]int64,
+	replicaStates ReplicaStates,
+	shardID models.ShardID,
+	prevLeader models.NodeID,
+) (models.NodeID, error) {
+	var target int64
+	if rs, ok := replicaStates[prevLeader]; ok {
+		// last append index of previous leader
+		target = getReplicaProgress(rs, shardID, prevLeader)
+	} else {
+		if len(progresses) < numOfReplicas/2+1 {
+			// refuse election until a quorum of replicas report their progress
+			return models.NoLeader, constants.ErrNoInSyncReplica
+		}
+		target = -1
+		for _, progress := range progresses {
+			if progress > target {
+				target = progress
+			}
+		}
+	}
+	for _, nodeID := range liveReplicaNodes.Replicas {
+		if progress, ok := progresses[nodeID]; ok && progress >= target {
+			return nodeID, nil
+		}
+	}
+	r.logger.Warn("no replica caught up previous leader, refuse election",
+		logger.Any("shard", shardID),
+		logger.Any("prevLeader", prevLeader),
+		logger.Int64("target", target),
+		logger.Any("progresses", progresses))
+	return models.NoLeader, constants.ErrNoInSyncReplica
+}
+
+// TransferLeader picks the live replica which has caught up all write ahead log of current leader(lag is 0)
+// as new leader, replica order of shard assignment is used if many replicas caught up.
+func (r *replicaLeaderElector) TransferLeader(shardAssignment *models.ShardAssignment,
+	liveNodes map[models.NodeID]models.StatefulNode,
+	shardID models.ShardID,
+	leader models.NodeID,
+) (newLeader models.NodeID, err error) {
+	replicas, ok := shardAssignment.Shards[shardID]
+	if !ok {
+		return models.NoLeader, constants.ErrShardNotFound
+	}
+	leaderNode, ok := liveNodes[leader]
+	if !ok {
+		return models.NoLeader, constants.ErrNoLiveReplica
+	}
+	// lag of replica is calculated by the write ahead log of current leader
+	rs, err := r.adminCli.GetReplicaState(&leaderNode, shardAssignment.Name)
+	if err != nil {
+		return models.NoLeader, err
+	}
+	caughtUp := func(replica string) bool {
+		for _, familyState := range rs {
+			if familyState.ShardID != shardID || familyState.Leader != leader {
+				continue
+			}
+			found := false
+			for _, replicator := range familyState.Replicators {
+				if replicator.Replicator == replica {
+					found = replicator.ACK == familyState.Append
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	for _, nodeID := range replicas.Replicas {
+		if _, live := liveNodes[nodeID]; !live || nodeID == leader {
+			continue
+		}
+		if caughtUp(nodeID.String()) {
+			return nodeID, nil
+		}
+	}
+	return models.NoLeader, constants.ErrNoCaughtUpReplica
+}
+
+// getReplicaProgress returns the progress(sum of append index of all families) of write ahead log
+// which replica replicated from previous leader.
+func getReplicaProgress(rs []models.FamilyLogReplicaState, shardID models.ShardID, prevLeader models.NodeID) int64 {
+	progress := int64(0)
+	for _, familyState := range rs {
+		if familyState.ShardID != shardID || familyState.Leader != prevLeader {
+			continue
+		}
+		// append index starts with -1(empty write ahead log)
+		progress += familyState.Append + 1
+	}
+	return progress
+}
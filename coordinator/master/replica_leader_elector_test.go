@@ -18,28 +18,275 @@
 package master
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/ltoml"
 )
 
 func TestReplicaLeaderElector_ElectLeader(t *testing.T) {
-	elect := newReplicaLeaderElector()
-	_, err := elect.ElectLeader(models.NewShardAssignment("test"), nil, models.ShardID(1))
+	elect := newReplicaLeaderElector(context.TODO())
+	_, err := elect.ElectLeader(models.NewShardAssignment("test"), nil, models.ShardID(1), models.NoLeader, nil)
 	assert.Equal(t, constants.ErrShardNotFound, err)
 
 	shardAssignment := models.NewShardAssignment("test")
 	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(1))
 	liveNodes := make(map[models.NodeID]models.StatefulNode)
 
-	_, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1))
+	_, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), models.NoLeader, nil)
 	assert.Equal(t, constants.ErrNoLiveReplica, err)
 	liveNodes[models.NodeID(1)] = models.StatefulNode{}
 
-	leader, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1))
+	leader, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), models.NoLeader, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, models.NodeID(1), leader)
+	// only one live replica, no progress need to compare
+	leader, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), models.NodeID(2), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NodeID(1), leader)
+}
+
+func TestReplicaLeaderElector_FetchReplicaStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adminCli := client.NewMockStorageAdminCli(ctrl)
+	elect := &replicaLeaderElector{
+		cfg:      &config.Election{Timeout: ltoml.Duration(time.Second)},
+		adminCli: adminCli,
+		logger:   logger.GetLogger("Master", "Test"),
+	}
+	nodes := []models.StatefulNode{{ID: 1}, {ID: 2}, {ID: 3}}
+	adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").DoAndReturn(
+		func(node models.Node, _ string) ([]models.FamilyLogReplicaState, error) {
+			switch node.(*models.StatefulNode).ID {
+			case 1:
+				return nil, fmt.Errorf("err")
+			case 2:
+				return []models.FamilyLogReplicaState{{ShardID: 1, Append: 10}}, nil
+			default:
+				// timeout
+				time.Sleep(200 * time.Millisecond)
+				return []models.FamilyLogReplicaState{{ShardID: 1, Append: 5}}, nil
+			}
+		}).Times(3)
+	elect.cfg.Timeout = ltoml.Duration(100 * time.Millisecond)
+	replicaStates := elect.FetchReplicaStates("test", nodes)
+	assert.Equal(t, ReplicaStates{2: {{ShardID: 1, Append: 10}}}, replicaStates)
+	time.Sleep(200 * time.Millisecond)
+}
+
+func TestReplicaLeaderElector_ElectMostCaughtUpReplica(t *testing.T) {
+	cfg := &config.Election{}
+	elect := &replicaLeaderElector{
+		ctx:    context.TODO(),
+		cfg:    cfg,
+		logger: logger.GetLogger("Master", "Test"),
+	}
+	shardAssignment := models.NewShardAssignment("test")
+	for _, nodeID := range []models.NodeID{1, 2, 3, 4} {
+		shardAssignment.AddReplica(models.ShardID(1), nodeID)
+	}
+	liveNodes := map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}, 3: {ID: 3}}
+	familyState := func(shardID models.ShardID, leader models.NodeID, append int64) models.FamilyLogReplicaState {
+		return models.FamilyLogReplicaState{ShardID: shardID, Leader: leader, Append: append}
+	}
+
+	cases := []struct {
+		name          string
+		wait          bool
+		liveNodes     map[models.NodeID]models.StatefulNode
+		replicaStates ReplicaStates
+		leader        models.NodeID
+		wantErr       error
+	}{
+		{
+			name:   "no replica state, elect leader based on replica order",
+			leader: 1,
+		},
+		{
+			name:          "no quorum replica state, refuse election if wait in-sync replica",
+			wait:          true,
+			replicaStates: ReplicaStates{3: {familyState(1, 4, 10)}},
+			wantErr:       constants.ErrNoInSyncReplica,
+		},
+		{
+			name: "elect the most caught-up replica",
+			replicaStates: ReplicaStates{
+				// node 1: no family of previous leader
+				1: {familyState(1, 3, 100)},
+				// node 2: families of other shard/leader are ignored
+				2: {familyState(1, 4, 10), familyState(2, 4, 100), familyState(1, 3, 100)},
+				// node 3: sum of all families
+				3: {familyState(1, 4, 6), familyState(1, 4, 6)},
+			},
+			leader: 3,
+		},
+		{
+			name:          "same progress, elect leader based on replica order",
+			replicaStates: ReplicaStates{2: {familyState(1, 4, 10)}, 3: {familyState(1, 4, 10)}},
+			leader:        2,
+		},
+		{
+			name: "wait in-sync replica, elect the max progress of quorum",
+			wait: true,
+			replicaStates: ReplicaStates{
+				1: {familyState(1, 4, 8)},
+				2: {familyState(1, 4, 10)},
+				3: {familyState(1, 4, 10)},
+			},
+			leader: 2,
+		},
+		{
+			name:      "wait in-sync replica, elect replica caught up previous leader",
+			wait:      true,
+			liveNodes: map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}, 4: {ID: 4}},
+			replicaStates: ReplicaStates{
+				1: {familyState(1, 4, 10)},
+				2: {familyState(1, 4, 8)},
+				4: {familyState(1, 4, 10)},
+			},
+			leader: 1,
+		},
+		{
+			name:      "wait in-sync replica, previous leader online again",
+			wait:      true,
+			liveNodes: map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}, 4: {ID: 4}},
+			replicaStates: ReplicaStates{
+				1: {familyState(1, 4, 8)},
+				2: {familyState(1, 4, 8)},
+				4: {familyState(1, 4, 10)},
+			},
+			leader: 4,
+		},
+		{
+			name: "wait in-sync replica, refuse election if replicas behind previous leader",
+			wait: true,
+			replicaStates: ReplicaStates{
+				1: {familyState(1, 4, 8)},
+				2: {familyState(1, 4, 8)},
+				4: {familyState(1, 4, 10)},
+			},
+			wantErr: constants.ErrNoInSyncReplica,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg.WaitInSyncReplica = tt.wait
+			nodes := liveNodes
+			if tt.liveNodes != nil {
+				nodes = tt.liveNodes
+			}
+			leader, err := elect.ElectLeader(shardAssignment, nodes, models.ShardID(1), models.NodeID(4), tt.replicaStates)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.leader, leader)
+		})
+	}
+}
+
+func TestReplicaLeaderElector_TransferLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adminCli := client.NewMockStorageAdminCli(ctrl)
+	elect := &replicaLeaderElector{
+		ctx:      context.TODO(),
+		cfg:      &config.Election{},
+		adminCli: adminCli,
+		logger:   logger.GetLogger("Master", "Test"),
+	}
+	shardAssignment := models.NewShardAssignment("test")
+	for i := 1; i <= 3; i++ {
+		shardAssignment.AddReplica(models.ShardID(1), models.NodeID(i))
+	}
+	liveNodes := map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}, 3: {ID: 3}}
+	cases := []struct {
+		name      string
+		shardID   models.ShardID
+		liveNodes map[models.NodeID]models.StatefulNode
+		prepare   func()
+		leader    models.NodeID
+		wantErr   error
+	}{
+		{
+			name:    "shard not found",
+			shardID: 2,
+			wantErr: constants.ErrShardNotFound,
+		},
+		{
+			name:      "leader not alive",
+			shardID:   1,
+			liveNodes: map[models.NodeID]models.StatefulNode{2: {ID: 2}},
+			wantErr:   constants.ErrNoLiveReplica,
+		},
+		{
+			name:    "get replica state failure",
+			shardID: 1,
+			prepare: func() {
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: fmt.Errorf("err"),
+		},
+		{
+			name:    "no replica caught up",
+			shardID: 1,
+			prepare: func() {
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+					{ShardID: 1, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{
+						{Replicator: "2", ACK: 99}, {Replicator: "3", ACK: 100},
+					}},
+					{ShardID: 1, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{{Replicator: "2", ACK: 100}}},
+				}, nil)
+			},
+			wantErr: constants.ErrNoCaughtUpReplica,
+		},
+		{
+			name:    "pick caught-up replica",
+			shardID: 1,
+			prepare: func() {
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+					{ShardID: 1, Leader: 1, Append: 100, Replicators: []models.ReplicaPeerState{
+						{Replicator: "2", ACK: 99}, {Replicator: "3", ACK: 100},
+					}},
+					{ShardID: 2, Leader: 1, Append: 100},
+					{ShardID: 1, Leader: 2, Append: 100},
+				}, nil)
+			},
+			leader: 3,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			nodes := liveNodes
+			if tt.liveNodes != nil {
+				nodes = tt.liveNodes
+			}
+			leader, err := elect.TransferLeader(shardAssignment, nodes, tt.shardID, 1)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.leader, leader)
+		})
+	}
 }
@@ -18,6 +18,7 @@
 package master
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"path/filepath"
@@ -59,6 +60,17 @@ type StateManager interface {
 	GetShardAssignments() []models.ShardAssignment
 	// GetStorageStates returns current storage state list.
 	GetStorageStates() []*models.StorageState
+	// GetStorageState returns the copy of storage state by name.
+	GetStorageState(name string) (*models.StorageState, bool)
+	// SetRebalancer sets shard rebalancer, which is triggered when storage node join or leave.
+	SetRebalancer(rebalancer Rebalancer)
+	// GetRebalancer returns shard rebalancer.
+	GetRebalancer() Rebalancer
+	// SetDecommissions sets the decommission progress of storage nodes, then syncs storage state if changed.
+	SetDecommissions(storageName string, decommissions map[models.NodeID]*models.NodeDecommission) error
+	// TransferLeader transfers the leadership of shard from current leader to the replica which has caught up
+	// write ahead log of current leader, returns the new leader.
+	TransferLeader(storageName, databaseName string, shardID models.ShardID, leader models.NodeID) (models.NodeID, error)
 }
 
 // stateManager implements StateManager.
@@ -68,6 +80,7 @@ type stateManager struct {
 
 	repoFactory     statepkg.RepositoryFactory
 	stateMachineFct *StateMachineFactory
+	rebalancer      Rebalancer
 
 	masterRepo statepkg.Repository
 	elector    ReplicaLeaderElector
@@ -105,7 +118,7 @@ func NewStateManager(
 		storages:              make(map[string]StorageCluster),
 		databases:             make(map[string]*models.Database),
 		shardAssignments:      make(map[string]*models.ShardAssignment),
-		elector:               newReplicaLeaderElector(),
+		elector:               newReplicaLeaderElector(c),
 		events:                make(chan *discovery.Event, 10),
 		running:               atomic.NewBool(true),
 		newStorageClusterFn:   newStorageCluster,
@@ -149,6 +162,9 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		}
 	}()
 
+	// fetch replica state for leader election before lock, because it needs remote call
+	replicaStates := m.fetchReplicaStates(event)
+
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -167,11 +183,11 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 	case discovery.DatabaseConfigDeletion:
 		err = m.onDatabaseCfgDelete(event.Key)
 	case discovery.ShardAssignmentChanged:
-		err = m.onShardAssignmentChange(event.Key, event.Value)
+		err = m.onShardAssignmentChange(event.Key, event.Value, replicaStates)
 	case discovery.NodeStartup:
-		err = m.onStorageNodeStartup(event.Attributes[storageNameKey], event.Key, event.Value)
+		err = m.onStorageNodeStartup(event.Attributes[storageNameKey], event.Key, event.Value, replicaStates)
 	case discovery.NodeFailure:
-		err = m.onStorageNodeFailure(event.Attributes[storageNameKey], event.Key)
+		err = m.onStorageNodeFailure(event.Attributes[storageNameKey], event.Key, replicaStates)
 	}
 	if err != nil {
 		m.statistics.HandleEventFailure.WithTagValues(eventType).Incr()
@@ -190,6 +206,101 @@ func (m *stateManager) GetStateMachineFactory() *StateMachineFactory {
 	return m.stateMachineFct
 }
 
+// SetRebalancer sets shard rebalancer, which is triggered when storage node join or leave.
+func (m *stateManager) SetRebalancer(rebalancer Rebalancer) {
+	m.rebalancer = rebalancer
+}
+
+// GetRebalancer returns shard rebalancer.
+func (m *stateManager) GetRebalancer() Rebalancer {
+	return m.rebalancer
+}
+
+// SetDecommissions sets the decommission progress of storage nodes, then syncs storage state if changed.
+func (m *stateManager) SetDecommissions(storageName string, decommissions map[models.NodeID]*models.NodeDecommission) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cluster, ok := m.storages[storageName]
+	if !ok {
+		return constants.ErrNoStorageCluster
+	}
+	var rs map[models.NodeID]*models.NodeDecommission
+	if len(decommissions) > 0 {
+		rs = make(map[models.NodeID]*models.NodeDecommission)
+		for nodeID, decommission := range decommissions {
+			d := *decommission
+			rs[nodeID] = &d
+		}
+	}
+	state := cluster.GetState()
+	if bytes.Equal(encoding.JSONMarshal(state.Decommissions), encoding.JSONMarshal(rs)) {
+		return nil
+	}
+	state.Decommissions = rs
+	return m.syncState(state)
+}
+
+// TransferLeader transfers the leadership of shard from current leader to the replica which has caught up
+// write ahead log of current leader, returns the new leader.
+func (m *stateManager) TransferLeader(storageName, databaseName string,
+	shardID models.ShardID, leader models.NodeID,
+) (models.NodeID, error) {
+	state, ok := m.GetStorageState(storageName)
+	if !ok {
+		return models.NoLeader, constants.ErrNoStorageCluster
+	}
+	shardAssignment, ok := state.ShardAssignments[databaseName]
+	if !ok {
+		return models.NoLeader, constants.ErrDatabaseNotFound
+	}
+	// decommissioning node cannot take over the leadership
+	liveNodes := make(map[models.NodeID]models.StatefulNode)
+	for nodeID, node := range state.LiveNodes {
+		if nodeID == leader || !state.IsDecommissioned(nodeID) {
+			liveNodes[nodeID] = node
+		}
+	}
+	// picks new leader without lock, because it needs to fetch the replica state of current leader
+	newLeader, err := m.elector.TransferLeader(shardAssignment, liveNodes, shardID, leader)
+	if err != nil {
+		return models.NoLeader, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cluster, ok := m.storages[storageName]
+	if !ok {
+		return models.NoLeader, constants.ErrNoStorageCluster
+	}
+	storageState := cluster.GetState()
+	shardState, ok := storageState.ShardStates[databaseName][shardID]
+	if !ok {
+		return models.NoLeader, constants.ErrShardNotFound
+	}
+	if shardState.Leader != leader {
+		// leadership has been changed by others
+		return shardState.Leader, nil
+	}
+	shardState.Leader = newLeader
+	storageState.ShardStates[databaseName][shardID] = shardState
+	m.shardLeaderStatistics.LeaderElections.Incr()
+	m.logger.Info("transfer leadership of shard",
+		logger.String("db", databaseName),
+		logger.Any("shard", shardID),
+		logger.Any("from", leader),
+		logger.Any("to", newLeader))
+	return newLeader, m.syncState(storageState)
+}
+
+// triggerRebalance triggers shard rebalance check for storage cluster if rebalancer set.
+func (m *stateManager) triggerRebalance(storageName string) {
+	if m.rebalancer != nil {
+		m.rebalancer.Trigger(storageName)
+	}
+}
+
 // onDatabaseCfgChange triggers when database create/modify.
 func (m *stateManager) onDatabaseCfgChange(key string, data []byte) error {
 	m.logger.Info("do shard assignment, because database config is changed",
@@ -204,6 +315,8 @@ func (m *stateManager) onDatabaseCfgChange(key string, data []byte) error {
 	}
 
 	m.shardAssignment(cfg)
+	// replica factor of database maybe changed
+	m.triggerRebalance(cfg.Storage)
 	return nil
 }
 
@@ -238,7 +351,7 @@ func (m *stateManager) onDatabaseCfgDelete(key string) error {
 }
 
 // onShardAssignmentChange triggers when shard assignment modify.
-func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
+func (m *stateManager) onShardAssignmentChange(key string, data []byte, replicaStates map[string]ReplicaStates) error {
 	m.logger.Info("database's shard assignment is changed",
 		logger.String("key", key),
 		logger.String("data", string(data)))
@@ -254,7 +367,7 @@ func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 
 	storage := m.storages[databaseCfg.Storage]
 
-	m.initializeShardState(storage, shardAssignment)
+	m.initializeShardState(storage, shardAssignment, replicaStates[shardAssignment.Name])
 	return m.syncState(storage.GetState())
 }
 
@@ -288,7 +401,9 @@ func (m *stateManager) onStorageConfigDelete(key string) {
 }
 
 // onStorageNodeStartup triggers when storage node online
-func (m *stateManager) onStorageNodeStartup(storageName, key string, data []byte) error {
+func (m *stateManager) onStorageNodeStartup(storageName, key string, data []byte,
+	replicaStates map[string]ReplicaStates,
+) error {
 	m.logger.Info("new storage node online in storage cluster",
 		logger.String("storage", storageName),
 		logger.String("key", key),
@@ -305,13 +420,17 @@ func (m *stateManager) onStorageNodeStartup(storageName, key string, data []byte
 
 	s.NodeOnline(node)
 
-	m.onNodeStartup(s, node)
+	m.onNodeStartup(s, node, replicaStates)
 
-	return m.syncState(s)
+	if err := m.syncState(s); err != nil {
+		return err
+	}
+	m.triggerRebalance(storageName)
+	return nil
 }
 
 // onStorageNodeFailure triggers when storage node offline.
-func (m *stateManager) onStorageNodeFailure(storageName, key string) error {
+func (m *stateManager) onStorageNodeFailure(storageName, key string, replicaStates map[string]ReplicaStates) error {
 	m.logger.Info("a storage node offline in storage cluster",
 		logger.String("storage", storageName),
 		logger.String("key", key))
@@ -329,9 +448,13 @@ func (m *stateManager) onStorageNodeFailure(storageName, key string) error {
 	nodeID := models.NodeID(id)
 	s.NodeOffline(nodeID)
 	// 2. do node offline state change
-	m.onNodeFailure(s, nodeID)
+	m.onNodeFailure(s, nodeID, replicaStates)
 
-	return m.syncState(s)
+	if err := m.syncState(s); err != nil {
+		return err
+	}
+	m.triggerRebalance(storageName)
+	return nil
 }
 
 // register starts storage state machine which watch storage state change.
@@ -363,7 +486,7 @@ func (m *stateManager) register(cfg *config.StorageCluster) error {
 			databaseCfg := m.databases[shardAssignment.Name]
 			if databaseCfg.Storage == name {
 				// if shard assigment belong current cluster, need to do initialize shard state
-				m.initializeShardState(cluster, shardAssignment)
+				m.initializeShardState(cluster, shardAssignment, nil)
 			}
 		}
 	}
@@ -402,6 +525,9 @@ func (m *stateManager) Close() {
 
 	if m.running.CAS(true, false) {
 		m.logger.Info("starting close state manager")
+		if m.rebalancer != nil {
+			m.rebalancer.Close()
+		}
 		for name := range m.storages {
 			m.unRegister(name)
 		}
@@ -468,7 +594,9 @@ func (m *stateManager) shardAssignment(databaseCfg *models.Database) {
 	}
 }
 
-func (m *stateManager) onNodeStartup(state *models.StorageState, node models.StatefulNode) {
+func (m *stateManager) onNodeStartup(state *models.StorageState, node models.StatefulNode,
+	replicaStates map[string]ReplicaStates,
+) {
 	// 1. do when a new node come up is send it the entire list of shards that it is supposed to host.
 	replicasOnOnlineNode := state.ReplicasOnNode(node.ID)
 	for db, shards := range replicasOnOnlineNode {
@@ -476,8 +604,19 @@ func (m *stateManager) onNodeStartup(state *models.StorageState, node models.Sta
 			for _, shardID := range shards {
 				shardState := shardStates[shardID]
 				if shardState.State != models.OnlineShard {
-					shardState.State = models.OnlineShard
-					shardState.Leader = node.ID
+					// elect leader for offline shard, leader of offline shard is the previous leader.
+					leader, err := m.elector.ElectLeader(state.ShardAssignments[db], state.LiveNodes,
+						shardID, shardState.Leader, replicaStates[db])
+					m.shardLeaderStatistics.LeaderElections.Incr()
+					if err != nil {
+						m.shardLeaderStatistics.LeaderElectFailures.Incr()
+						m.logger.Warn("elect shard leader err, when node startup",
+							logger.String("db", db),
+							logger.Any("shard", shardID), logger.Error(err))
+					} else {
+						shardState.State = models.OnlineShard
+						shardState.Leader = leader
+					}
 				}
 				shardStates[shardID] = shardState
 			}
@@ -485,7 +624,9 @@ func (m *stateManager) onNodeStartup(state *models.StorageState, node models.Sta
 	}
 }
 
-func (m *stateManager) onNodeFailure(state *models.StorageState, nodeID models.NodeID) {
+func (m *stateManager) onNodeFailure(state *models.StorageState, nodeID models.NodeID,
+	replicaStates map[string]ReplicaStates,
+) {
 	// 1. find all leaders on failure node, need do leader elect
 	leadersOnOfflineNode := state.LeadersOnNode(nodeID)
 	m.logger.Debug("leader node is offline need elect new leader for shard",
@@ -496,12 +637,12 @@ func (m *stateManager) onNodeFailure(state *models.StorageState, nodeID models.N
 		shardAssignment := state.ShardAssignments[db]
 		shardStates := state.ShardStates[db]
 		for _, shardID := range shards {
-			leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID)
+			leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID, nodeID, replicaStates[db])
 			shardState := shardStates[shardID]
 			m.shardLeaderStatistics.LeaderElections.Incr()
 			if err != nil {
+				// keep previous leader, elect leader again when replica node startup
 				shardState.State = models.OfflineShard
-				shardState.Leader = models.NoLeader
 				m.shardLeaderStatistics.LeaderElectFailures.Incr()
 				m.logger.Warn("elect shard leader err",
 					logger.String("db", shardAssignment.Name),
@@ -519,6 +660,110 @@ func (m *stateManager) onNodeFailure(state *models.StorageState, nodeID models.N
 	}
 }
 
+// fetchReplicaStates fetches the replica state of databases which need to elect shard leader when handling event,
+// it makes remote calls to storage nodes, so it's done outside the lock, election under lock uses the result.
+func (m *stateManager) fetchReplicaStates(event *discovery.Event) map[string]ReplicaStates {
+	var (
+		state *models.StorageState
+		dbs   []string
+	)
+	switch event.Type {
+	case discovery.NodeStartup:
+		node := models.StatefulNode{}
+		if err := json.Unmarshal(event.Value, &node); err != nil {
+			return nil
+		}
+		s, ok := m.GetStorageState(event.Attributes[storageNameKey])
+		if !ok {
+			return nil
+		}
+		state = s
+		state.NodeOnline(node)
+		for db, shards := range state.ReplicasOnNode(node.ID) {
+			for _, shardID := range shards {
+				shardState, ok := state.ShardStates[db][shardID]
+				if ok && shardState.State != models.OnlineShard && shardState.Leader != models.NoLeader {
+					dbs = append(dbs, db)
+					break
+				}
+			}
+		}
+	case discovery.NodeFailure:
+		_, nodeIDStr := filepath.Split(event.Key)
+		id, err := strconv.ParseInt(nodeIDStr, 10, 64)
+		if err != nil {
+			return nil
+		}
+		s, ok := m.GetStorageState(event.Attributes[storageNameKey])
+		if !ok {
+			return nil
+		}
+		state = s
+		state.NodeOffline(models.NodeID(id))
+		for db := range state.LeadersOnNode(models.NodeID(id)) {
+			dbs = append(dbs, db)
+		}
+	case discovery.ShardAssignmentChanged:
+		shardAssignment := &models.ShardAssignment{}
+		if err := encoding.JSONUnmarshal(event.Value, shardAssignment); err != nil {
+			return nil
+		}
+		m.mutex.RLock()
+		databaseCfg, ok := m.databases[shardAssignment.Name]
+		m.mutex.RUnlock()
+		if !ok {
+			return nil
+		}
+		s, ok := m.GetStorageState(databaseCfg.Storage)
+		if !ok {
+			return nil
+		}
+		state = s
+		for shardID, replicas := range shardAssignment.Shards {
+			// live leader keeps its leadership if it's still a replica(see initializeShardState)
+			prevShardState, ok := state.ShardStates[shardAssignment.Name][shardID]
+			if !ok || prevShardState.Leader == models.NoLeader {
+				continue
+			}
+			prevLeader := prevShardState.Leader
+			if _, live := state.LiveNodes[prevLeader]; !live || !replicas.Contain(prevLeader) {
+				dbs = append(dbs, shardAssignment.Name)
+				break
+			}
+		}
+		state.ShardAssignments[shardAssignment.Name] = shardAssignment
+	default:
+		return nil
+	}
+	if len(dbs) == 0 {
+		return nil
+	}
+	var (
+		result = make(map[string]ReplicaStates)
+		lock   sync.Mutex
+		wait   sync.WaitGroup
+	)
+	for _, db := range dbs {
+		var nodes []models.StatefulNode
+		for nodeID, node := range state.LiveNodes {
+			if len(state.ReplicasOnNode(nodeID)[db]) > 0 {
+				nodes = append(nodes, node)
+			}
+		}
+		db := db
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			replicaStates := m.elector.FetchReplicaStates(db, nodes)
+			lock.Lock()
+			result[db] = replicaStates
+			lock.Unlock()
+		}()
+	}
+	wait.Wait()
+	return result
+}
+
 // syncState syncs storage state into state repo.
 func (m *stateManager) syncState(state *models.StorageState) error {
 	// TODO add timeout
@@ -557,6 +802,10 @@ func (m *stateManager) createShardAssignment(
 	nodes := make(map[models.NodeID]*models.StatefulNode)
 	for idx := range liveNodes {
 		node := liveNodes[idx]
+		if cluster.GetState().IsDecommissioned(node.ID) {
+			// no new replica assigned to decommissioning node
+			continue
+		}
 		nodeIDs = append(nodeIDs, node.ID)
 		nodes[node.ID] = &node
 	}
@@ -604,6 +853,10 @@ func (m *stateManager) modifyShardAssignment(
 		var nodeIDs []models.NodeID
 		for idx := range liveNodes {
 			node := liveNodes[idx]
+			if cluster.GetState().IsDecommissioned(node.ID) {
+				// no new replica assigned to decommissioning node
+				continue
+			}
 			nodeIDs = append(nodeIDs, node.ID)
 			nodes[node.ID] = &node
 		}
@@ -698,18 +951,52 @@ func (m *stateManager) GetStorageStates() (rs []*models.StorageState) {
 	return
 }
 
+// GetStorageState returns the copy of storage state by name.
+func (m *stateManager) GetStorageState(name string) (*models.StorageState, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storage, ok := m.storages[name]
+	if !ok {
+		return nil, false
+	}
+	state := &models.StorageState{}
+	if err := encoding.JSONUnmarshal(encoding.JSONMarshal(storage.GetState()), state); err != nil {
+		return nil, false
+	}
+	return state, true
+}
+
 // initializeShardState initializes the shard state based on shard assignment for storage cluster.
-func (m *stateManager) initializeShardState(storage StorageCluster, shardAssignment *models.ShardAssignment) {
+func (m *stateManager) initializeShardState(storage StorageCluster, shardAssignment *models.ShardAssignment,
+	replicaStates ReplicaStates,
+) {
 	storageState := storage.GetState()
 	liveNodes := storageState.LiveNodes
+	prevShardStates := storageState.ShardStates[shardAssignment.Name]
 	shardStates := make(map[models.ShardID]models.ShardState)
 	for shardID, replicas := range shardAssignment.Shards {
-		leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID)
-		shardState := models.ShardState{ID: shardID, Replica: *replicas}
+		// learners are included in replica list of shard state, so leader keeps write ahead log for them.
+		replica := models.Replica{Replicas: append(append([]models.NodeID{}, replicas.Replicas...),
+			shardAssignment.GetLearners(shardID)...)}
+		shardState := models.ShardState{ID: shardID, Replica: replica}
+		// live leader keeps its leadership if it's still a replica, leadership is changed only by leader transfer,
+		// otherwise elects the most caught-up replica.
+		prevLeader := models.NoLeader
+		if prevShardState, ok := prevShardStates[shardID]; ok && prevShardState.Leader != models.NoLeader {
+			prevLeader = prevShardState.Leader
+			if _, live := liveNodes[prevLeader]; live && replicas.Contain(prevLeader) {
+				shardState.State = models.OnlineShard
+				shardState.Leader = prevLeader
+				shardStates[shardID] = shardState
+				continue
+			}
+		}
+		leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID, prevLeader, replicaStates)
 		m.shardLeaderStatistics.LeaderElections.Incr()
 		if err != nil {
 			shardState.State = models.OfflineShard
-			shardState.Leader = models.NoLeader
+			shardState.Leader = prevLeader
 			m.shardLeaderStatistics.LeaderElectFailures.Incr()
 			m.logger.Warn("elect shard leader err",
 				logger.String("db", shardAssignment.Name),
@@ -27,7 +27,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/option"
@@ -316,6 +318,7 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 	mgr1.databases["test"] = &models.Database{Storage: "test"}
 	mgr1.storages["test"] = storage
 	mgr1.mutex.Unlock()
+	elector.EXPECT().FetchReplicaStates("test", gomock.Any()).Return(nil).AnyTimes()
 	// case 1: unmarshal err
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -328,8 +331,9 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 		Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{2, 3}}, 2: {Replicas: []models.NodeID{2, 3}}},
 	})
 	storage.EXPECT().GetState().Return(models.NewStorageState("test")).AnyTimes()
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(models.NodeID(0), fmt.Errorf("err"))
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -337,8 +341,9 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 		Value: data,
 	})
 	// case 2: put state err
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(models.NodeID(0), fmt.Errorf("err"))
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -358,6 +363,9 @@ func TestStateManager_createShardAssign(t *testing.T) {
 	repo := state.NewMockRepository(ctrl)
 	storage := NewMockStorageCluster(ctrl)
 	storage.EXPECT().Close().AnyTimes()
+	storageState := models.NewStorageState("test")
+	storageState.Decommissions = map[models.NodeID]*models.NodeDecommission{3: {NodeID: 3}}
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
 	// case 1: get live nodes err
@@ -397,6 +405,10 @@ func TestStateManager_createShardAssign(t *testing.T) {
 		-1, -1)
 	assert.NoError(t, err)
 	assert.NotNil(t, shardAssign)
+	// decommissioning node cannot be assigned
+	for _, replica := range shardAssign.Shards {
+		assert.False(t, replica.Contain(3))
+	}
 }
 
 func TestStateManager_modifyShardAssign(t *testing.T) {
@@ -407,6 +419,7 @@ func TestStateManager_modifyShardAssign(t *testing.T) {
 	repo := state.NewMockRepository(ctrl)
 	storage := NewMockStorageCluster(ctrl)
 	storage.EXPECT().Close().AnyTimes()
+	storage.EXPECT().GetState().Return(models.NewStorageState("test")).AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
 	// case 1: no impl
@@ -464,8 +477,11 @@ func TestStateManager_StorageNodeStartup(t *testing.T) {
 	storage.EXPECT().Close().AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
+	adminCli := client.NewMockStorageAdminCli(ctrl)
+	adminCli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	mgr1.mutex.Lock()
 	mgr1.storages["test"] = storage
+	mgr1.elector.(*replicaLeaderElector).adminCli = adminCli
 	mgr1.mutex.Unlock()
 	// case 1: unmarshal err
 	mgr.EmitEvent(&discovery.Event{
@@ -476,7 +492,7 @@ func TestStateManager_StorageNodeStartup(t *testing.T) {
 	})
 	// case 2: sync err
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
-	storage.EXPECT().GetState().Return(models.NewStorageState("test"))
+	storage.EXPECT().GetState().Return(models.NewStorageState("test")).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeStartup,
 		Key:        "/test/1",
@@ -491,7 +507,7 @@ func TestStateManager_StorageNodeStartup(t *testing.T) {
 		ShardAssignments: map[string]*models.ShardAssignment{"test": {
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3, 4}}},
 		}},
-	})
+	}).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeStartup,
 		Key:        "/test/1",
@@ -506,7 +522,7 @@ func TestStateManager_StorageNodeStartup(t *testing.T) {
 		ShardAssignments: map[string]*models.ShardAssignment{"test": {
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3, 4}}},
 		}},
-	})
+	}).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeStartup,
 		Key:        "/test/1",
@@ -532,8 +548,11 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	storage.EXPECT().Close().AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
+	adminCli := client.NewMockStorageAdminCli(ctrl)
+	adminCli.EXPECT().GetReplicaState(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	mgr1.mutex.Lock()
 	mgr1.storages["test"] = storage
+	mgr1.elector.(*replicaLeaderElector).adminCli = adminCli
 	mgr1.mutex.Unlock()
 	// case 1: unmarshal node id err
 	mgr.EmitEvent(&discovery.Event{
@@ -543,7 +562,7 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	})
 	// case 2: sync err
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
-	storage.EXPECT().GetState().Return(models.NewStorageState("test"))
+	storage.EXPECT().GetState().Return(models.NewStorageState("test")).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeFailure,
 		Key:        "/test/1",
@@ -558,7 +577,7 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 		ShardAssignments: map[string]*models.ShardAssignment{"test": {
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3, 4}}},
 		}},
-	})
+	}).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeFailure,
 		Key:        "/test/1",
@@ -575,7 +594,7 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 		ShardAssignments: map[string]*models.ShardAssignment{"test": {
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3, 4}}},
 		}},
-	})
+	}).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeFailure,
 		Key:        "/test/1",
@@ -591,3 +610,350 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	mgr1.mutex.Unlock()
 	mgr.Close()
 }
+
+func TestStateManager_Rebalancer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	rebalancer := NewMockRebalancer(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr.SetRebalancer(rebalancer)
+	assert.Equal(t, rebalancer, mgr.GetRebalancer())
+	mgr1 := mgr.(*stateManager)
+	mgr1.mutex.Lock()
+	mgr1.storages["test"] = storage
+	mgr1.mutex.Unlock()
+
+	storageState := models.NewStorageState("test")
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
+	// case 1: node startup/failure triggers rebalance
+	rebalancer.EXPECT().Trigger("test").Times(2)
+	mgr.EmitEvent(&discovery.Event{
+		Type:       discovery.NodeStartup,
+		Key:        "/test/1",
+		Value:      []byte(`{"id":1}`),
+		Attributes: map[string]string{storageNameKey: "test"},
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type:       discovery.NodeFailure,
+		Key:        "/test/1",
+		Attributes: map[string]string{storageNameKey: "test"},
+	})
+	time.Sleep(100 * time.Millisecond)
+	// case 2: learners are included in replica list of shard state
+	mgr1.mutex.Lock()
+	storageState.NodeOnline(models.StatefulNode{ID: 1})
+	mgr1.initializeShardState(storage, &models.ShardAssignment{
+		Name:     "db",
+		Shards:   map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2}}},
+		Learners: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{3}}},
+	}, nil)
+	mgr1.mutex.Unlock()
+	// case 3: get copy of storage state
+	s, ok := mgr.GetStorageState("test")
+	assert.True(t, ok)
+	assert.Equal(t, []models.NodeID{1, 2, 3}, s.ShardStates["db"][1].Replica.Replicas)
+	assert.Equal(t, models.NodeID(1), s.ShardStates["db"][1].Leader)
+	assert.Equal(t, []models.NodeID{1, 2}, storageState.ShardAssignments["db"].Shards[1].Replicas)
+	// live leader keeps leadership when replica order changed
+	mgr1.mutex.Lock()
+	mgr1.initializeShardState(storage, &models.ShardAssignment{
+		Name:   "db",
+		Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{2, 1}}},
+	}, nil)
+	mgr1.mutex.Unlock()
+	assert.Equal(t, models.NodeID(1), storageState.ShardStates["db"][1].Leader)
+	s, ok = mgr.GetStorageState("not-exist")
+	assert.False(t, ok)
+	assert.Nil(t, s)
+	// case 4: set decommissions
+	assert.Equal(t, constants.ErrNoStorageCluster, mgr.SetDecommissions("not-exist", nil))
+	decommissions := map[models.NodeID]*models.NodeDecommission{2: {NodeID: 2, Status: models.NodeDecommissioning}}
+	assert.NoError(t, mgr.SetDecommissions("test", decommissions))
+	decommissions[2].Status = models.NodeRemovable
+	assert.Equal(t, models.NodeDecommissioning, storageState.Decommissions[2].Status)
+	assert.NoError(t, mgr.SetDecommissions("test", nil))
+	assert.Nil(t, storageState.Decommissions)
+	// case 5: close rebalancer with state manager
+	rebalancer.EXPECT().Close()
+	mgr.Close()
+}
+
+func TestStateManager_TransferLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	elector := NewMockReplicaLeaderElector(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	mgr1.mutex.Lock()
+	mgr1.elector = elector
+	mgr1.storages["test"] = storage
+	mgr1.mutex.Unlock()
+
+	storageState := models.NewStorageState("test")
+	for i := 1; i <= 3; i++ {
+		storageState.NodeOnline(models.StatefulNode{ID: models.NodeID(i)})
+	}
+	storageState.Decommissions = map[models.NodeID]*models.NodeDecommission{3: {NodeID: 3}}
+	assignment := models.NewShardAssignment("db")
+	assignment.AddReplica(1, 1)
+	assignment.AddReplica(1, 2)
+	assignment.AddReplica(1, 3)
+	storageState.ShardAssignments["db"] = assignment
+	storageState.ShardStates["db"] = map[models.ShardID]models.ShardState{1: {ID: 1, Leader: 1, State: models.OnlineShard}}
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
+
+	cases := []struct {
+		name     string
+		storage  string
+		database string
+		shardID  models.ShardID
+		prepare  func()
+		leader   models.NodeID
+		wantErr  bool
+	}{
+		{
+			name:     "storage not found",
+			storage:  "not-exist",
+			database: "db",
+			shardID:  1,
+			wantErr:  true,
+		},
+		{
+			name:     "database not found",
+			storage:  "test",
+			database: "not-exist",
+			shardID:  1,
+			wantErr:  true,
+		},
+		{
+			name:     "no caught-up replica",
+			storage:  "test",
+			database: "db",
+			shardID:  1,
+			prepare: func() {
+				elector.EXPECT().TransferLeader(gomock.Any(), gomock.Any(), models.ShardID(1), models.NodeID(1)).
+					Return(models.NoLeader, constants.ErrNoCaughtUpReplica)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "shard not found",
+			storage:  "test",
+			database: "db",
+			shardID:  2,
+			prepare: func() {
+				elector.EXPECT().TransferLeader(gomock.Any(), gomock.Any(), models.ShardID(2), models.NodeID(1)).
+					Return(models.NodeID(2), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "sync state failure",
+			storage:  "test",
+			database: "db",
+			shardID:  1,
+			prepare: func() {
+				elector.EXPECT().TransferLeader(gomock.Any(), gomock.Any(), models.ShardID(1), models.NodeID(1)).
+					DoAndReturn(func(_ *models.ShardAssignment, liveNodes map[models.NodeID]models.StatefulNode,
+						_ models.ShardID, _ models.NodeID,
+					) (models.NodeID, error) {
+						// decommissioning node cannot be leader
+						assert.Len(t, liveNodes, 2)
+						return models.NodeID(2), nil
+					})
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "leadership changed by others",
+			storage:  "test",
+			database: "db",
+			shardID:  1,
+			prepare: func() {
+				storageState.ShardStates["db"][1] = models.ShardState{ID: 1, Leader: 1, State: models.OnlineShard}
+				elector.EXPECT().TransferLeader(gomock.Any(), gomock.Any(), models.ShardID(1), models.NodeID(1)).
+					DoAndReturn(func(_ *models.ShardAssignment, _ map[models.NodeID]models.StatefulNode,
+						_ models.ShardID, _ models.NodeID,
+					) (models.NodeID, error) {
+						storageState.ShardStates["db"][1] = models.ShardState{ID: 1, Leader: 3, State: models.OnlineShard}
+						return models.NodeID(2), nil
+					})
+			},
+			leader: 3,
+		},
+		{
+			name:     "transfer leader successfully",
+			storage:  "test",
+			database: "db",
+			shardID:  1,
+			prepare: func() {
+				storageState.ShardStates["db"][1] = models.ShardState{ID: 1, Leader: 1, State: models.OnlineShard}
+				elector.EXPECT().TransferLeader(gomock.Any(), gomock.Any(), models.ShardID(1), models.NodeID(1)).
+					Return(models.NodeID(2), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			leader: 2,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			leader, err := mgr.TransferLeader(tt.storage, tt.database, tt.shardID, 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.leader, leader)
+			assert.Equal(t, tt.leader, storageState.ShardStates["db"][1].Leader)
+		})
+	}
+	mgr.Close()
+}
+
+func TestStateManager_fetchReplicaStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	elector := NewMockReplicaLeaderElector(ctrl)
+	mgr := NewStateManager(context.TODO(), nil, nil)
+	mgr1 := mgr.(*stateManager)
+	mgr1.elector = elector
+	mgr1.storages["test"] = storage
+	mgr1.databases["db"] = &models.Database{Name: "db", Storage: "test"}
+	defer mgr.Close()
+
+	storageState := &models.StorageState{
+		Name:      "test",
+		LiveNodes: map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}, 4: {ID: 4}},
+		ShardStates: map[string]map[models.ShardID]models.ShardState{
+			"db": {1: {Leader: 1, State: models.OnlineShard}, 2: {Leader: 3, State: models.OfflineShard}},
+		},
+		ShardAssignments: map[string]*models.ShardAssignment{"db": {
+			Name: "db",
+			Shards: map[models.ShardID]*models.Replica{
+				1: {Replicas: []models.NodeID{1, 2}},
+				2: {Replicas: []models.NodeID{2, 3}},
+			},
+		}},
+	}
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
+	replicaStates := ReplicaStates{2: {{ShardID: 1, Leader: 1, Append: 10}}}
+	cases := []struct {
+		name    string
+		event   *discovery.Event
+		prepare func()
+		result  map[string]ReplicaStates
+	}{
+		{
+			name:  "other event",
+			event: &discovery.Event{Type: discovery.DatabaseConfigChanged},
+		},
+		{
+			name:  "storage not found",
+			event: &discovery.Event{Type: discovery.NodeFailure, Key: "/test/1", Attributes: map[string]string{storageNameKey: "x"}},
+		},
+		{
+			name: "node startup, unmarshal failure",
+			event: &discovery.Event{Type: discovery.NodeStartup, Key: "/test/3", Value: []byte("abc"),
+				Attributes: map[string]string{storageNameKey: "test"}},
+		},
+		{
+			name: "node startup, shard of node is offline",
+			event: &discovery.Event{Type: discovery.NodeStartup, Key: "/test/3", Value: []byte(`{"id":3}`),
+				Attributes: map[string]string{storageNameKey: "test"}},
+			prepare: func() {
+				elector.EXPECT().FetchReplicaStates("db", gomock.Any()).
+					DoAndReturn(func(_ string, nodes []models.StatefulNode) ReplicaStates {
+						// live replicas include the startup node
+						assert.Len(t, nodes, 3)
+						return replicaStates
+					})
+			},
+			result: map[string]ReplicaStates{"db": replicaStates},
+		},
+		{
+			name: "node startup, no offline shard",
+			event: &discovery.Event{Type: discovery.NodeStartup, Key: "/test/1", Value: []byte(`{"id":1}`),
+				Attributes: map[string]string{storageNameKey: "test"}},
+		},
+		{
+			name:  "node failure, parse node id failure",
+			event: &discovery.Event{Type: discovery.NodeFailure, Key: "/test/a", Attributes: map[string]string{storageNameKey: "test"}},
+		},
+		{
+			name:  "node failure, leader on node",
+			event: &discovery.Event{Type: discovery.NodeFailure, Key: "/test/1", Attributes: map[string]string{storageNameKey: "test"}},
+			prepare: func() {
+				elector.EXPECT().FetchReplicaStates("db", gomock.Any()).
+					DoAndReturn(func(_ string, nodes []models.StatefulNode) ReplicaStates {
+						// failure node is excluded
+						assert.Equal(t, []models.StatefulNode{{ID: 2}}, nodes)
+						return replicaStates
+					})
+			},
+			result: map[string]ReplicaStates{"db": replicaStates},
+		},
+		{
+			name:  "node failure, no leader on node",
+			event: &discovery.Event{Type: discovery.NodeFailure, Key: "/test/4", Attributes: map[string]string{storageNameKey: "test"}},
+		},
+		{
+			name:  "shard assignment changed, unmarshal failure",
+			event: &discovery.Event{Type: discovery.ShardAssignmentChanged, Value: []byte("abc")},
+		},
+		{
+			name: "shard assignment changed, database not found",
+			event: &discovery.Event{Type: discovery.ShardAssignmentChanged,
+				Value: encoding.JSONMarshal(&models.ShardAssignment{Name: "not-exist"})},
+		},
+		{
+			name: "shard assignment changed, live leader keeps leadership",
+			event: &discovery.Event{Type: discovery.ShardAssignmentChanged,
+				Value: encoding.JSONMarshal(&models.ShardAssignment{Name: "db", Shards: map[models.ShardID]*models.Replica{
+					1: {Replicas: []models.NodeID{1, 2}},
+					3: {Replicas: []models.NodeID{1, 2}},
+				}})},
+		},
+		{
+			name: "shard assignment changed, leader removed from replicas",
+			event: &discovery.Event{Type: discovery.ShardAssignmentChanged,
+				Value: encoding.JSONMarshal(&models.ShardAssignment{Name: "db", Shards: map[models.ShardID]*models.Replica{
+					1: {Replicas: []models.NodeID{2, 4}},
+				}})},
+			prepare: func() {
+				elector.EXPECT().FetchReplicaStates("db", gomock.Any()).
+					DoAndReturn(func(_ string, nodes []models.StatefulNode) ReplicaStates {
+						// replicas of new shard assignment
+						assert.Len(t, nodes, 2)
+						return replicaStates
+					})
+			},
+			result: map[string]ReplicaStates{"db": replicaStates},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			assert.Equal(t, tt.result, mgr1.fetchReplicaStates(tt.event))
+		})
+	}
+}
@@ -20,10 +20,14 @@ package master
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
@@ -33,6 +37,11 @@ import (
 
 //go:generate mockgen -source=./storage_cluster.go -destination=./storage_cluster_mock.go -package=master
 
+// for testing
+var (
+	newStorageAdminCliFn = client.NewStorageAdminCli
+)
+
 // StorageCluster represents storage cluster controller,
 // 1) discovery active node list in storage cluster
 // 2) save shard assignment
@@ -48,6 +57,8 @@ type StorageCluster interface {
 	GetLiveNodes() ([]models.StatefulNode, error)
 	// FlushDatabase submits the coordinator task for flushing memory database by name
 	FlushDatabase(databaseName string) error
+	// CompactDatabase submits manual compaction/rollup job of database to all live nodes.
+	CompactDatabase(param *models.CompactParam) error
 	// SaveDatabaseAssignment saves database assignment in storage state repo.
 	SaveDatabaseAssignment(
 		shardAssign *models.ShardAssignment,
@@ -68,8 +79,9 @@ type storageCluster struct {
 	storageRepo state.Repository
 	stateMgr    StateManager
 
-	state *models.StorageState
-	sm    discovery.StateMachine
+	state    *models.StorageState
+	sm       discovery.StateMachine
+	adminCli client.StorageAdminCli
 
 	logger *logger.Logger
 }
@@ -92,6 +104,7 @@ func newStorageCluster(ctx context.Context,
 		storageRepo: storageRepo,
 		stateMgr:    stateMgr,
 		state:       models.NewStorageState(cfg.Config.Namespace),
+		adminCli:    newStorageAdminCliFn(),
 		logger:      log,
 	}
 
@@ -149,6 +162,41 @@ func (c *storageCluster) FlushDatabase(_ string) error {
 	panic("need impl")
 }
 
+// CompactDatabase submits manual compaction/rollup job of database to all live nodes.
+func (c *storageCluster) CompactDatabase(param *models.CompactParam) error {
+	nodes, err := c.GetLiveNodes()
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return constants.ErrNoLiveNode
+	}
+	errs := make([]error, len(nodes))
+	var wait sync.WaitGroup
+	wait.Add(len(nodes))
+	for idx := range nodes {
+		i := idx
+		go func() {
+			defer wait.Done()
+			errs[i] = c.adminCli.CompactDatabase(&nodes[i], param)
+		}()
+	}
+	wait.Wait()
+	var failures []string
+	for idx, err := range errs {
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", nodes[idx].Indicator(), err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("submit compaction job failure, %s", strings.Join(failures, "; "))
+	}
+	c.logger.Info("submit compaction job successfully",
+		logger.String("storage", c.cfg.Config.Namespace),
+		logger.Any("param", param))
+	return nil
+}
+
 // SaveDatabaseAssignment saves database assignment in storage state repo.
 func (c *storageCluster) SaveDatabaseAssignment(
 	shardAssign *models.ShardAssignment,
@@ -26,7 +26,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
@@ -176,6 +178,40 @@ func TestStorageCluster_listLiveNodes(t *testing.T) {
 	}
 }
 
+func TestStorageCluster_CompactDatabase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	cli := client.NewMockStorageAdminCli(ctrl)
+	sc := &storageCluster{
+		cfg:         &config.StorageCluster{Config: &config.RepoState{Namespace: "test"}},
+		storageRepo: repo,
+		adminCli:    cli,
+		logger:      logger.GetLogger("Master", "Test"),
+	}
+	param := &models.CompactParam{Type: models.CompactTypeCompact, Database: "db"}
+	nodes := []state.KeyValue{
+		{Value: encoding.JSONMarshal(&models.StatefulNode{ID: 1})},
+		{Value: encoding.JSONMarshal(&models.StatefulNode{ID: 2})},
+	}
+	// case 1: list live nodes failure
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, sc.CompactDatabase(param))
+	// case 2: no live node
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+	assert.Equal(t, constants.ErrNoLiveNode, sc.CompactDatabase(param))
+	// case 3: submit job failure
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nodes, nil)
+	cli.EXPECT().CompactDatabase(gomock.Any(), param).Return(nil)
+	cli.EXPECT().CompactDatabase(gomock.Any(), param).Return(fmt.Errorf("err"))
+	assert.Error(t, sc.CompactDatabase(param))
+	// case 4: submit job successfully
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nodes, nil)
+	cli.EXPECT().CompactDatabase(gomock.Any(), param).Return(nil).Times(2)
+	assert.NoError(t, sc.CompactDatabase(param))
+}
+
 func TestStorageCluster_close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
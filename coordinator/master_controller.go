@@ -42,6 +42,7 @@ var (
 	newRegistryFn        = discovery.NewRegistry
 	newStateMgrFn        = masterpkg.NewStateManager
 	newStateMachineFctFn = masterpkg.NewStateMachineFactory
+	newRebalancerFn      = masterpkg.NewRebalancer
 )
 
 var log = logger.GetLogger("Master", "MasterController")
@@ -73,6 +74,10 @@ type MasterController interface {
 	Stop()
 	// FlushDatabase submits the coordinator task for flushing memory database by cluster and database name
 	FlushDatabase(cluster string, databaseName string) error
+	// CompactDatabase submits manual compaction/rollup job of database by cluster name, only master can submit it.
+	CompactDatabase(cluster string, param *models.CompactParam) error
+	// DecommissionNode starts decommission of storage node by cluster name, only master can do it.
+	DecommissionNode(cluster string, nodeID models.NodeID) error
 	// GetStateManager returns master's state manager.
 	GetStateManager() masterpkg.StateManager
 	// WatchMasterElected adds callback after master finished election.
@@ -125,6 +130,8 @@ func (m *masterController) OnFailOver() error {
 	stateMachineFct := newStateMachineFctFn(m.ctx, m.cfg.DiscoveryFactory, stateMgr)
 	// first need set state machine factory in state manager
 	stateMgr.SetStateMachineFactory(stateMachineFct)
+	rebalancer := newRebalancerFn(m.ctx, m.cfg.Repo, stateMgr)
+	stateMgr.SetRebalancer(rebalancer)
 
 	defer func() {
 		if err != nil {
@@ -147,6 +154,8 @@ func (m *masterController) OnFailOver() error {
 		m.statistics.FailOverFailures.Incr()
 		return fmt.Errorf("register elected master node error:%s", err)
 	}
+	// start shard rebalancer after master state machine started
+	rebalancer.Start()
 	m.statistics.FailOvers.Incr()
 	return nil
 }
@@ -230,6 +239,37 @@ func (m *masterController) FlushDatabase(cluster, databaseName string) error {
 	return nil
 }
 
+// CompactDatabase submits manual compaction/rollup job of database by cluster name, only master can submit it.
+func (m *masterController) CompactDatabase(cluster string, param *models.CompactParam) error {
+	if !m.IsMaster() {
+		return constants.ErrNotMaster
+	}
+	m.mutex.Lock()
+	storage := m.stateMgr.GetStorageCluster(cluster)
+	m.mutex.Unlock()
+
+	if storage == nil {
+		return constants.ErrNoStorageCluster
+	}
+	// NOTICE: submits job to storage nodes without lock, because it calls remote http api.
+	return storage.CompactDatabase(param)
+}
+
+// DecommissionNode starts decommission of storage node by cluster name, only master can do it.
+func (m *masterController) DecommissionNode(cluster string, nodeID models.NodeID) error {
+	if !m.IsMaster() {
+		return constants.ErrNotMaster
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	rebalancer := m.stateMgr.GetRebalancer()
+	if rebalancer == nil {
+		return constants.ErrNotMaster
+	}
+	return rebalancer.Decommission(cluster, nodeID)
+}
+
 // WatchMasterElected adds callback after master finished election.
 func (m *masterController) WatchMasterElected(fn func(master *models.Master)) {
 	m.mutex.Lock()
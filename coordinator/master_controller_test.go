@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/coordinator/elect"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
@@ -52,9 +53,15 @@ func TestMasterController_OnFailOver(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
 		newStateMgrFn = masterpkg.NewStateManager
+		newRebalancerFn = masterpkg.NewRebalancer
 		ctrl.Finish()
 	}()
 
+	rebalancer := masterpkg.NewMockRebalancer(ctrl)
+	rebalancer.EXPECT().Start()
+	newRebalancerFn = func(ctx context.Context, repo state.Repository, stateMgr masterpkg.StateManager) masterpkg.Rebalancer {
+		return rebalancer
+	}
 	discoveryFactory := discovery.NewMockFactory(ctrl)
 	discovery1 := discovery.NewMockDiscovery(ctrl)
 	discovery1.EXPECT().Close().AnyTimes()
@@ -62,6 +69,7 @@ func TestMasterController_OnFailOver(t *testing.T) {
 	stateMgr := masterpkg.NewMockStateManager(ctrl)
 	stateMgr.EXPECT().Close().AnyTimes()
 	stateMgr.EXPECT().SetStateMachineFactory(gomock.Any()).AnyTimes()
+	stateMgr.EXPECT().SetRebalancer(gomock.Any()).AnyTimes()
 	newStateMgrFn = func(ctx context.Context, masterRepo state.Repository,
 		repoFactory state.RepositoryFactory) masterpkg.StateManager {
 		return stateMgr
@@ -263,3 +271,54 @@ func TestMasterController_FlushDatabase(t *testing.T) {
 		})
 	}
 }
+
+func TestMasterController_CompactDatabase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterElect := elect.NewMockElection(ctrl)
+	stateMgr := masterpkg.NewMockStateManager(ctrl)
+	param := &models.CompactParam{Type: models.CompactTypeCompact, Database: "db"}
+	mc := &masterController{
+		elect:    masterElect,
+		stateMgr: stateMgr,
+	}
+	// case 1: isn't master
+	masterElect.EXPECT().IsMaster().Return(false)
+	assert.Equal(t, constants.ErrNotMaster, mc.CompactDatabase("test", param))
+	// case 2: storage not found
+	masterElect.EXPECT().IsMaster().Return(true)
+	stateMgr.EXPECT().GetStorageCluster("test").Return(nil)
+	assert.Equal(t, constants.ErrNoStorageCluster, mc.CompactDatabase("test", param))
+	// case 3: submit job
+	masterElect.EXPECT().IsMaster().Return(true)
+	storage := masterpkg.NewMockStorageCluster(ctrl)
+	stateMgr.EXPECT().GetStorageCluster("test").Return(storage)
+	storage.EXPECT().CompactDatabase(param).Return(nil)
+	assert.NoError(t, mc.CompactDatabase("test", param))
+}
+
+func TestMasterController_DecommissionNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterElect := elect.NewMockElection(ctrl)
+	stateMgr := masterpkg.NewMockStateManager(ctrl)
+	mc := &masterController{
+		elect:    masterElect,
+		stateMgr: stateMgr,
+	}
+	// case 1: isn't master
+	masterElect.EXPECT().IsMaster().Return(false)
+	assert.Equal(t, constants.ErrNotMaster, mc.DecommissionNode("test", 1))
+	// case 2: rebalancer not set
+	masterElect.EXPECT().IsMaster().Return(true)
+	stateMgr.EXPECT().GetRebalancer().Return(nil)
+	assert.Equal(t, constants.ErrNotMaster, mc.DecommissionNode("test", 1))
+	// case 3: start decommission
+	masterElect.EXPECT().IsMaster().Return(true)
+	rebalancer := masterpkg.NewMockRebalancer(ctrl)
+	stateMgr.EXPECT().GetRebalancer().Return(rebalancer)
+	rebalancer.EXPECT().Decommission("test", models.NodeID(1)).Return(nil)
+	assert.NoError(t, mc.DecommissionNode("test", 1))
+}
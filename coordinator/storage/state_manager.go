@@ -175,6 +175,7 @@ func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 			shardIDs = append(shardIDs, shardID)
 		}
 	}
+	m.retireShards(param.ShardAssignment)
 	if len(shardIDs) == 0 {
 		return constants.ErrShardNotFound
 	}
@@ -192,6 +193,39 @@ func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 	return nil
 }
 
+// retireShards drops local shards which are no longer assigned to current node,
+// shard which current node is learner of keeps alive(maybe installed by snapshot).
+func (m *stateManager) retireShards(assignment *models.ShardAssignment) {
+	db, ok := m.engine.GetDatabase(assignment.Name)
+	if !ok {
+		return
+	}
+	var retired []models.ShardID
+	for _, shardID := range db.GetConfig().ShardIDs {
+		replica, assigned := assignment.Shards[shardID]
+		if assigned && replica.Contain(m.current.ID) {
+			continue
+		}
+		if learner, ok := assignment.Learners[shardID]; ok && learner.Contain(m.current.ID) {
+			continue
+		}
+		retired = append(retired, shardID)
+	}
+	if len(retired) == 0 {
+		return
+	}
+	if err := m.engine.DropShards(assignment.Name, retired...); err != nil {
+		m.logger.Error("drop retired shard err",
+			logger.String("db", assignment.Name),
+			logger.Any("shards", retired),
+			logger.Error(err))
+		return
+	}
+	m.logger.Info("drop retired shards successfully",
+		logger.String("db", assignment.Name),
+		logger.Any("shards", retired))
+}
+
 // onNodeStartup triggers when storage node online.
 func (m *stateManager) onNodeStartup(key string, data []byte) error {
 	m.logger.Info("new node online",
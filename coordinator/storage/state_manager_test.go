@@ -139,6 +139,7 @@ func TestStateManager_OnShardAssignment(t *testing.T) {
 
 	engine := tsdb.NewMockEngine(ctrl)
 	mgr := NewStateManager(context.TODO(), &models.StatefulNode{ID: 1}, engine)
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(nil, false).AnyTimes()
 	// case 1: create shard storage engine err
 	engine.EXPECT().CreateShards(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	mgr.EmitEvent(&discovery.Event{
@@ -184,3 +185,32 @@ func TestStateManager_OnShardAssignment(t *testing.T) {
 	assert.Len(t, mgr.GetDatabaseAssignments(), 1)
 	mgr.Close()
 }
+
+func TestStateManager_RetireShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	mgr := NewStateManager(context.TODO(), &models.StatefulNode{ID: 1}, engine)
+	engine.EXPECT().GetDatabase("test").Return(db, true).AnyTimes()
+	db.EXPECT().GetConfig().Return(&models.DatabaseConfig{ShardIDs: []models.ShardID{1, 2, 3}}).AnyTimes()
+	assignment := &models.ShardAssignment{
+		Name:     "test",
+		Shards:   map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2}}, 2: {Replicas: []models.NodeID{2, 3}}},
+		Learners: map[models.ShardID]*models.Replica{3: {Replicas: []models.NodeID{1}}},
+	}
+	// case 1: drop shard err
+	engine.EXPECT().DropShards("test", models.ShardID(2)).Return(fmt.Errorf("err"))
+	mgr.(*stateManager).retireShards(assignment)
+	// case 2: drop shard successfully
+	engine.EXPECT().DropShards("test", models.ShardID(2)).Return(nil)
+	mgr.(*stateManager).retireShards(assignment)
+	// case 3: nothing to retire
+	assignment.Shards[2] = &models.Replica{Replicas: []models.NodeID{1, 3}}
+	mgr.(*stateManager).retireShards(assignment)
+	// case 4: database not exist
+	engine.EXPECT().GetDatabase("test2").Return(nil, false)
+	mgr.(*stateManager).retireShards(&models.ShardAssignment{Name: "test2"})
+	mgr.Close()
+}
@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build integration
+// +build integration
+
+package standalone
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-http-utils/headers"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// TestBackup_Restore backups database, then drops and re-creates it, restores it from backup,
+// all operations are done by lin query language(the database exists when restoring, same as live cluster).
+func TestBackup_Restore(t *testing.T) {
+	cli := client.NewExecuteCli("http://localhost:9000" + constants.APIVersion1CliPath)
+	exec := func(sql string) error {
+		return cli.Execute(models.ExecuteParam{SQL: sql}, nil)
+	}
+	databaseName := "backup_e2e"
+	cfg := config.NewDefaultStandalone()
+	database := encoding.JSONMarshal(&models.Database{
+		Name:          databaseName,
+		Storage:       cfg.Coordinator.Namespace,
+		NumOfShard:    1,
+		ReplicaFactor: 1,
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{{
+				Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+				Retention: timeutil.Interval(timeutil.OneMonth),
+			}},
+		},
+	})
+	createDatabase := func() {
+		assert.NoError(t, exec("create database "+string(database)))
+		// wait shard created on storage node
+		time.Sleep(5 * time.Second)
+	}
+	countSeries := func() int {
+		rs := &models.ResultSet{}
+		err := cli.Execute(models.ExecuteParam{
+			Database: databaseName,
+			SQL:      "select f1 from backup_data where time>now()-1h group by host",
+		}, rs)
+		if err != nil {
+			return 0
+		}
+		return len(rs.Series)
+	}
+
+	createDatabase()
+	writeBackupData(t, databaseName)
+	assert.Equal(t, 10, countSeries())
+
+	assert.NoError(t, exec(fmt.Sprintf("backup database %s to 'e2e'", databaseName)))
+	// database has data, cannot restore
+	assert.Error(t, exec(fmt.Sprintf("restore database %s from 'e2e'", databaseName)))
+
+	assert.NoError(t, exec(fmt.Sprintf("drop database '%s'", databaseName)))
+	// wait storage node drops the resource of database
+	time.Sleep(8 * time.Second)
+	createDatabase()
+	assert.Zero(t, countSeries())
+
+	assert.NoError(t, exec(fmt.Sprintf("restore database %s from 'e2e'", databaseName)))
+	assert.Equal(t, 10, countSeries())
+}
+
+// writeBackupData writes the metric data into database, then waits data flushed.
+func writeBackupData(t *testing.T, databaseName string) {
+	timestamp := timeutil.Now()
+	var buf bytes.Buffer
+	converter := metric.NewProtoConverter()
+	for i := 0; i < 10; i++ {
+		var brokerRow metric.BrokerRow
+		err := converter.ConvertTo(&protoMetricsV1.Metric{
+			Name:      "backup_data",
+			Timestamp: timestamp,
+			Tags:      []*protoMetricsV1.KeyValue{{Key: "host", Value: fmt.Sprintf("host%d", i)}},
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1},
+			},
+		}, &brokerRow)
+		assert.NoError(t, err)
+		_, _ = brokerRow.WriteTo(&buf)
+	}
+	r := resty.New().R()
+	r.Header.Set(headers.ContentType, constants.ContentTypeFlat)
+	_, err := r.SetBody(buf.Bytes()).Put("http://127.0.0.1:9000/api/v1/write?db=" + databaseName)
+	assert.NoError(t, err)
+	// wait data written and flushed, backup only includes flushed data
+	time.Sleep(5 * time.Second)
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/metric"
 )
@@ -51,6 +52,9 @@ func TestMain(m *testing.M) {
 		_ = fileutil.RemoveDir("data")
 	}()
 	cfg := config.NewDefaultStandalone()
+	// drop the resource of dropped database quickly(backup/restore test re-creates dropped database)
+	cfg.StorageBase.TTLTaskInterval = ltoml.Duration(5 * time.Second)
+	config.SetGlobalStorageConfig(&cfg.StorageBase)
 	if err := logger.InitLogger(cfg.Logging, "standalone.log"); err != nil {
 		panic(fmt.Errorf("init logging err: %s", err))
 	}
@@ -22,6 +22,8 @@ package tsdb
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -38,15 +40,24 @@ import (
 	"github.com/lindb/lindb/tsdb"
 )
 
-func TestDatabase_Write_And_Rollup(t *testing.T) {
-	dir := t.TempDir()
+// TestMain initializes storage path, because kv store manager is a singleton, all tests share same path.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "tsdb_e2e")
+	if err != nil {
+		panic(err)
+	}
 	config.SetGlobalStorageConfig(&config.StorageBase{
 		TSDB: config.TSDB{Dir: dir},
 	})
 	kv.Options.Store(&kv.StoreOptions{
 		Dir: config.GlobalStorageConfig().TSDB.Dir,
 	})
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
 
+func TestDatabase_Write_And_Rollup(t *testing.T) {
 	engine, err := tsdb.NewEngine()
 	assert.NoError(t, err)
 	assert.NotNil(t, engine)
@@ -109,6 +120,107 @@ func TestDatabase_Write_And_Rollup(t *testing.T) {
 	time.Sleep(200 * time.Millisecond)
 }
 
+func TestDatabase_Backup_Restore(t *testing.T) {
+	backupDir := filepath.Join(t.TempDir(), "backup")
+
+	engine, err := tsdb.NewEngine()
+	assert.NoError(t, err)
+	interval := timeutil.Interval(10 * 1000)
+	opt := &option.DatabaseOption{
+		Intervals:    option.Intervals{{Interval: interval, Retention: timeutil.Interval(timeutil.OneDay)}},
+		AutoCreateNS: true,
+	}
+	err = engine.CreateShards("backup-db", opt, models.ShardID(1))
+	assert.NoError(t, err)
+	shard, ok := engine.GetShard("backup-db", models.ShardID(1))
+	assert.True(t, ok)
+
+	now := timeutil.Now()
+	f, err := shard.GetOrCrateDataFamily(interval.Calculator().CalcFamilyTime(now))
+	assert.NoError(t, err)
+	rows := mockBatchRows(&protoMetricsV1.Metric{
+		Name:      "test",
+		Timestamp: now,
+		SimpleFields: []*protoMetricsV1.SimpleField{{
+			Name:  "f1",
+			Value: 1.0,
+			Type:  protoMetricsV1.SimpleFieldType_DELTA_SUM,
+		}},
+	})
+	assert.NoError(t, shard.LookupRowMetricMeta(rows))
+	assert.NoError(t, f.WriteRows(rows))
+	assert.NoError(t, f.Flush())
+
+	manifest, err := engine.BackupDatabase("backup-db", backupDir)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, manifest.Files)
+	// drop database, then restore it from backup
+	engine.DropDatabases(nil)
+	_, ok = engine.GetDatabase("backup-db")
+	assert.False(t, ok)
+
+	assert.NoError(t, engine.RestoreDatabase("backup-db", backupDir))
+	defer engine.Close()
+	db, ok := engine.GetDatabase("backup-db")
+	assert.True(t, ok)
+	metricID, err := db.Metadata().MetadataDatabase().GetMetricID("default-ns", "test")
+	assert.NoError(t, err)
+	assert.NotZero(t, metricID)
+	shard, ok = db.GetShard(models.ShardID(1))
+	assert.True(t, ok)
+	families := shard.GetDataFamilies(interval.Type(), timeutil.TimeRange{Start: now, End: now})
+	assert.Len(t, families, 1)
+}
+
+func TestDatabase_Import(t *testing.T) {
+	engine, err := tsdb.NewEngine()
+	assert.NoError(t, err)
+	defer engine.Close()
+	interval := timeutil.Interval(10 * 1000)
+	opt := &option.DatabaseOption{
+		Intervals:    option.Intervals{{Interval: interval, Retention: timeutil.Interval(100 * 365 * timeutil.OneDay)}},
+		AutoCreateNS: true,
+	}
+	err = engine.CreateShards("import-db", opt, models.ShardID(1))
+	assert.NoError(t, err)
+	db, ok := engine.GetDatabase("import-db")
+	assert.True(t, ok)
+	shard, ok := db.GetShard(models.ShardID(1))
+	assert.True(t, ok)
+
+	// history data out of writable time range
+	now, _ := timeutil.ParseTimestamp("20190702 19:10:00", "20060102 15:04:05")
+	var rows []metric.StorageRow
+	for _, timestamp := range []int64{now, now + timeutil.OneHour, now + timeutil.OneDay} {
+		rows = append(rows, mockBatchRows(&protoMetricsV1.Metric{
+			Name:      "import",
+			Timestamp: timestamp,
+			Tags:      []*protoMetricsV1.KeyValue{{Key: "host", Value: "host-1"}},
+			SimpleFields: []*protoMetricsV1.SimpleField{{
+				Name:  "f1",
+				Value: 1.0,
+				Type:  protoMetricsV1.SimpleFieldType_LAST,
+			}},
+		})...)
+	}
+	written, err := shard.Import(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, len(rows), written)
+
+	metricID, err := db.Metadata().MetadataDatabase().GetMetricID("default-ns", "import")
+	assert.NoError(t, err)
+	assert.NotZero(t, metricID)
+	families := shard.GetDataFamilies(interval.Type(), timeutil.TimeRange{Start: now, End: now + timeutil.OneDay})
+	assert.Len(t, families, 3)
+	for _, family := range families {
+		snapshot := family.Family().GetSnapshot()
+		// data file registered by version edit, memory database not used
+		assert.Len(t, snapshot.GetCurrent().GetAllFiles(), 1)
+		assert.Zero(t, family.MemDBSize())
+		snapshot.Close()
+	}
+}
+
 func mockBatchRows(m *protoMetricsV1.Metric) []metric.StorageRow {
 	var ml = protoMetricsV1.MetricList{Metrics: []*protoMetricsV1.Metric{m}}
 	var buf bytes.Buffer
@@ -44,6 +44,11 @@ type TaskContext struct {
 	Ctx    context.Context
 	Cancel context.CancelFunc
 
+	// MemoryTracker tracks the memory used by current task.
+	MemoryTracker *MemoryTracker
+	// ScanStats records the data scanned by current task.
+	ScanStats ScanStats
+
 	Start time.Time
 }
 
@@ -51,15 +56,43 @@ type TaskContext struct {
 func NewTaskContextWithTimeout(ctx context.Context, timeout time.Duration) *TaskContext {
 	c, cancel := context.WithTimeout(ctx, timeout)
 	return &TaskContext{
-		Ctx:    c,
-		Cancel: cancel,
-		Start:  time.Now(),
+		Ctx:           c,
+		Cancel:        cancel,
+		MemoryTracker: NewQueryMemoryTracker(),
+		Start:         time.Now(),
 	}
 }
 
 // Release releases context's resource after query.
 func (ctx *TaskContext) Release() {
 	ctx.Cancel()
+	ctx.MemoryTracker.ReleaseAll()
+}
+
+// ScanStats represents the data scanned by query task.
+// All methods are safe for nil stats, which means no scan tracking.
+type ScanStats struct {
+	Series   atomic.Int64
+	Families atomic.Int64
+	Bytes    atomic.Int64
+}
+
+// Add adds the number of scanned series/families/bytes.
+func (s *ScanStats) Add(series, families, bytes int64) {
+	if s == nil {
+		return
+	}
+	s.Series.Add(series)
+	s.Families.Add(families)
+	s.Bytes.Add(bytes)
+}
+
+// Load returns the number of scanned series/families/bytes.
+func (s *ScanStats) Load() (series, families, bytes int64) {
+	if s == nil {
+		return 0, 0, 0
+	}
+	return s.Series.Load(), s.Families.Load(), s.Bytes.Load()
 }
 
 // StorageExecuteContext represents storage level query execute context.
@@ -94,6 +127,22 @@ type StorageExecuteContext struct {
 	mutex sync.Mutex
 }
 
+// MemoryTracker returns the memory tracker of query task, returns nil if not tracking.
+func (ctx *StorageExecuteContext) MemoryTracker() *MemoryTracker {
+	if ctx.TaskCtx == nil {
+		return nil
+	}
+	return ctx.TaskCtx.MemoryTracker
+}
+
+// ScanStats returns the scan stats of query task, returns nil if not tracking.
+func (ctx *StorageExecuteContext) ScanStats() *ScanStats {
+	if ctx.TaskCtx == nil {
+		return nil
+	}
+	return &ctx.TaskCtx.ScanStats
+}
+
 // CollectTagValues collects tag value with lock.
 func (ctx *StorageExecuteContext) CollectTagValues(fn func()) {
 	ctx.mutex.Lock()
@@ -262,16 +311,15 @@ type GroupingSeriesAgg struct {
 }
 
 // reduce aggregator's result set.
-func (agg *GroupingSeriesAgg) reduce(reduceFn func(it series.GroupedIterator)) {
+func (agg *GroupingSeriesAgg) reduce(reduceFn func(it series.GroupedIterator) error) error {
 	if agg.Aggregator != nil {
-		reduceFn(aggregation.FieldAggregates{agg.Aggregator}.ResultSet(agg.Key))
 		// reset aggregate context
-		agg.Aggregator.Reset()
-	} else {
-		reduceFn(agg.Aggregators.ResultSet(agg.Key))
-		// reset aggregate context
-		agg.Aggregators.Reset()
+		defer agg.Aggregator.Reset()
+		return reduceFn(aggregation.FieldAggregates{agg.Aggregator}.ResultSet(agg.Key))
 	}
+	// reset aggregate context
+	defer agg.Aggregators.Reset()
+	return reduceFn(agg.Aggregators.ResultSet(agg.Key))
 }
 
 // DataLoadContext represents data load level query execute context.
@@ -292,6 +340,9 @@ type DataLoadContext struct {
 	GroupingSeriesAgg        []*GroupingSeriesAgg
 	groupingSeriesAggRefIdx  uint16
 
+	// memory(bytes) allocated for grouping, but not consumed from memory tracker
+	untrackedMemory int64
+
 	Decoder      *encoding.TSDDecoder
 	DownSampling func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter)
 
@@ -303,6 +354,7 @@ func (ctx *DataLoadContext) PrepareAggregatorWithoutGrouping() {
 	ctx.WithoutGroupingSeriesAgg = &GroupingSeriesAgg{
 		Key: "",
 	}
+	ctx.untrackedMemory += ctx.seriesAggregatorMemSize()
 	if ctx.IsMultiField {
 		ctx.WithoutGroupingSeriesAgg.Aggregators = ctx.newSeriesAggregators()
 	} else {
@@ -331,6 +383,8 @@ func (ctx *DataLoadContext) NewSeriesAggregator(groupingKey string) uint16 {
 	} else {
 		groupingSeriesAgg.Aggregator = ctx.newSeriesAggregator(0)
 	}
+	// grouping key(map key and aggregator key) + aggregator
+	ctx.untrackedMemory += int64(2*len(groupingKey)) + ctx.seriesAggregatorMemSize()
 	ctx.GroupingSeriesAgg = append(ctx.GroupingSeriesAgg, groupingSeriesAgg)
 	ctx.groupingSeriesAggRefIdx++
 	return rs
@@ -358,6 +412,24 @@ func (ctx *DataLoadContext) newSeriesAggregator(fieldIdx int) aggregation.Series
 		ctx.ShardExecuteCtx.StorageExecuteCtx.DownSamplingSpecs[fieldIdx])
 }
 
+// seriesAggregatorMemSize returns the estimated memory size of series aggregator(s) for a group.
+func (ctx *DataLoadContext) seriesAggregatorMemSize() int64 {
+	storageExecuteCtx := ctx.ShardExecuteCtx.StorageExecuteCtx
+	specs := storageExecuteCtx.DownSamplingSpecs
+	if !ctx.IsMultiField && len(specs) > 0 {
+		specs = specs[:1]
+	}
+	return specs.MemSize(storageExecuteCtx.Query.PointCount())
+}
+
+// TrackMemory consumes the memory allocated for grouping from memory tracker of query,
+// returns ErrMemoryLimitExceeded if memory used exceeds the limit.
+func (ctx *DataLoadContext) TrackMemory() error {
+	size := ctx.untrackedMemory
+	ctx.untrackedMemory = 0
+	return ctx.ShardExecuteCtx.StorageExecuteCtx.MemoryTracker().Consume(size)
+}
+
 // HasGroupingData returns if it is grouping data.
 func (ctx *DataLoadContext) HasGroupingData() bool {
 	if ctx.IsGrouping {
@@ -388,8 +460,10 @@ func (ctx *DataLoadContext) Grouping() {
 	ctx.MaxSeriesID = ctx.LowSeriesIDsContainer.Maximum()
 	lengthOfSeriesIDs := int(ctx.MaxSeriesID-ctx.MinSeriesID) + 1
 	ctx.LowSeriesIDs = make([]uint16, lengthOfSeriesIDs)
+	ctx.untrackedMemory += int64(2 * lengthOfSeriesIDs)
 	if ctx.IsGrouping {
 		ctx.GroupingSeriesAggRefs = make([]uint16, lengthOfSeriesIDs)
+		ctx.untrackedMemory += int64(2 * lengthOfSeriesIDs)
 	}
 	it := ctx.LowSeriesIDsContainer.PeekableIterator()
 	for it.HasNext() {
@@ -426,15 +500,17 @@ func (ctx *DataLoadContext) IterateLowSeriesIDs(lowSeriesIDsFromStorage roaring.
 	}
 }
 
-// Reduce reduces down sampling result.
-func (ctx *DataLoadContext) Reduce(reduceFn func(it series.GroupedIterator)) {
+// Reduce reduces down sampling result, stops reducing if reduce func returns error.
+func (ctx *DataLoadContext) Reduce(reduceFn func(it series.GroupedIterator) error) error {
 	if ctx.IsGrouping {
 		for _, groupAgg := range ctx.GroupingSeriesAgg {
-			groupAgg.reduce(reduceFn)
+			if err := groupAgg.reduce(reduceFn); err != nil {
+				return err
+			}
 		}
-	} else {
-		ctx.WithoutGroupingSeriesAgg.reduce(reduceFn)
+		return nil
 	}
+	return ctx.WithoutGroupingSeriesAgg.reduce(reduceFn)
 }
 
 // TimeSegmentContexts represents the time segment slice in query time range.
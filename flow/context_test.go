@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -59,6 +60,23 @@ func TestStorageExecuteContext(t *testing.T) {
 	assert.False(t, (&StorageExecuteContext{Query: &stmt.Query{}}).HasWhereCondition())
 }
 
+func TestStorageExecuteContext_ScanStats(t *testing.T) {
+	ctx := &StorageExecuteContext{}
+	assert.Nil(t, ctx.ScanStats())
+	ctx.ScanStats().Add(1, 1, 1)
+	series, families, bytes := ctx.ScanStats().Load()
+	assert.Zero(t, series+families+bytes)
+
+	ctx.TaskCtx = NewTaskContextWithTimeout(context.TODO(), time.Second)
+	defer ctx.TaskCtx.Release()
+	ctx.ScanStats().Add(10, 1, 80)
+	ctx.ScanStats().Add(5, 1, 40)
+	series, families, bytes = ctx.ScanStats().Load()
+	assert.Equal(t, int64(15), series)
+	assert.Equal(t, int64(2), families)
+	assert.Equal(t, int64(120), bytes)
+}
+
 func TestStorageExecuteContext_CalcSlotRange(t *testing.T) {
 	t1, _ := timeutil.ParseTimestamp("20190101 00:00:00", "20060102 15:04:05")
 	t2, _ := timeutil.ParseTimestamp("20190101 03:10:00", "20060102 15:04:05")
@@ -164,17 +182,19 @@ func TestGroupingSeriesAgg_reduce(t *testing.T) {
 	agg := &GroupingSeriesAgg{Aggregator: seriesAgg}
 	c := 0
 	seriesAgg.EXPECT().Reset()
-	agg.reduce(func(_ series.GroupedIterator) {
+	assert.NoError(t, agg.reduce(func(_ series.GroupedIterator) error {
 		c++
-	})
+		return nil
+	}))
 	assert.Equal(t, 1, c)
 
 	c = 0
 	seriesAgg.EXPECT().Reset()
 	agg = &GroupingSeriesAgg{Aggregators: aggregation.FieldAggregates{seriesAgg}}
-	agg.reduce(func(_ series.GroupedIterator) {
+	assert.NoError(t, agg.reduce(func(_ series.GroupedIterator) error {
 		c++
-	})
+		return nil
+	}))
 	assert.Equal(t, 1, c)
 }
 
@@ -250,6 +270,58 @@ func TestDataLoadContext_NewSeriesAggregator(t *testing.T) {
 	assert.NotNil(t, ctx.GroupingSeriesAgg[0].Aggregators)
 }
 
+func TestDataLoadContext_TrackMemory(t *testing.T) {
+	spec := aggregation.NewAggregatorSpec("f", field.SumField)
+	spec.AddFunctionType(function.Sum)
+	newCtx := func(limit int64) *DataLoadContext {
+		return &DataLoadContext{
+			ShardExecuteCtx: &ShardExecuteContext{
+				StorageExecuteCtx: &StorageExecuteContext{
+					TaskCtx: &TaskContext{MemoryTracker: NewMemoryTracker("query", limit, nil)},
+					Fields:  field.Metas{{ID: 1}},
+					Query: &stmt.Query{
+						Interval:  timeutil.Interval(timeutil.OneMinute),
+						TimeRange: timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+					},
+					DownSamplingSpecs:   aggregation.AggregatorSpecs{spec},
+					GroupByTagKeyIDs:    []tag.KeyID{1},
+					GroupingTagValueIDs: make([]*roaring.Bitmap, 1),
+				},
+			},
+		}
+	}
+	ctx := newCtx(0)
+	ctx.NewSeriesAggregator(string([]byte{1, 0, 0, 0}))
+	assert.NoError(t, ctx.TrackMemory())
+	// 61 points(8 bytes) + 8 marks + grouping keys
+	assert.Equal(t, int64(61*8+8+8), ctx.ShardExecuteCtx.StorageExecuteCtx.MemoryTracker().Used())
+	// no more memory need to track
+	assert.NoError(t, ctx.TrackMemory())
+	assert.Equal(t, int64(61*8+8+8), ctx.ShardExecuteCtx.StorageExecuteCtx.MemoryTracker().Used())
+
+	ctx = newCtx(100)
+	ctx.NewSeriesAggregator(string([]byte{1, 0, 0, 0}))
+	assert.ErrorIs(t, ctx.TrackMemory(), ErrMemoryLimitExceeded)
+}
+
+func TestDataLoadContext_Reduce_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	agg := aggregation.NewMockSeriesAggregator(ctrl)
+	agg.EXPECT().Reset().AnyTimes()
+	ctx := &DataLoadContext{
+		GroupingSeriesAgg: []*GroupingSeriesAgg{{Aggregator: agg}, {Aggregator: agg}},
+		IsGrouping:        true,
+	}
+	c := 0
+	assert.ErrorIs(t, ctx.Reduce(func(it series.GroupedIterator) error {
+		c++
+		return ErrMemoryLimitExceeded
+	}), ErrMemoryLimitExceeded)
+	assert.Equal(t, 1, c)
+}
+
 func TestDataLoadContext_HasGroupingData(t *testing.T) {
 	ctx := &DataLoadContext{
 		ShardExecuteCtx: &ShardExecuteContext{
@@ -313,7 +385,7 @@ func TestDataLoadContext_GetSeriesAggregator(t *testing.T) {
 	}
 	aggregator := ctx.GetSeriesAggregator(0, 0)
 	assert.NotNil(t, aggregator)
-	ctx.Reduce(func(it series.GroupedIterator) {})
+	assert.NoError(t, ctx.Reduce(func(it series.GroupedIterator) error { return nil }))
 
 	ctx = &DataLoadContext{
 		ShardExecuteCtx: &ShardExecuteContext{
@@ -328,7 +400,7 @@ func TestDataLoadContext_GetSeriesAggregator(t *testing.T) {
 	}
 	aggregator = ctx.GetSeriesAggregator(0, 1)
 	assert.NotNil(t, aggregator)
-	ctx.Reduce(func(it series.GroupedIterator) {})
+	assert.NoError(t, ctx.Reduce(func(it series.GroupedIterator) error { return nil }))
 }
 
 func TestTimeSegmentContexts(t *testing.T) {
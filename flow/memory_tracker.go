@@ -0,0 +1,132 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+// ErrMemoryLimitExceeded represents the memory used by query exceeds the limit.
+var ErrMemoryLimitExceeded = errors.New("query memory limit exceeded")
+
+var (
+	// globalMemoryTracker tracks the memory used by all running queries of current node.
+	globalMemoryTracker = NewMemoryTracker("all queries", 0, nil)
+	// maxMemoryPerQuery represents the memory limit of a single query.
+	maxMemoryPerQuery atomic.Int64
+)
+
+// SetMemoryLimit sets the memory limit of a single query and all running queries, 0 means no limit.
+func SetMemoryLimit(perQuery, total int64) {
+	maxMemoryPerQuery.Store(perQuery)
+	globalMemoryTracker.limit.Store(total)
+}
+
+// GlobalMemoryTracker returns the memory tracker of all running queries.
+func GlobalMemoryTracker() *MemoryTracker {
+	return globalMemoryTracker
+}
+
+// NewQueryMemoryTracker creates a memory tracker for a query, which consumes global memory quota also.
+func NewQueryMemoryTracker() *MemoryTracker {
+	return NewMemoryTracker("query", maxMemoryPerQuery.Load(), globalMemoryTracker)
+}
+
+// MemoryTracker tracks the memory(bytes) used by query, returns error if memory used exceeds the limit.
+// All methods are safe for nil tracker, which means no memory tracking.
+type MemoryTracker struct {
+	label  string
+	limit  atomic.Int64
+	used   atomic.Int64
+	peak   atomic.Int64
+	closed atomic.Bool
+	parent *MemoryTracker
+}
+
+// NewMemoryTracker creates a memory tracker with limit(0 means no limit) and parent tracker.
+func NewMemoryTracker(label string, limit int64, parent *MemoryTracker) *MemoryTracker {
+	t := &MemoryTracker{
+		label:  label,
+		parent: parent,
+	}
+	t.limit.Store(limit)
+	return t
+}
+
+// Consume consumes memory bytes, if memory used exceeds the limit of tracker or its parent,
+// rollbacks the consumption and returns ErrMemoryLimitExceeded.
+func (t *MemoryTracker) Consume(bytes int64) error {
+	if t == nil || bytes <= 0 || t.closed.Load() {
+		return nil
+	}
+	used := t.used.Add(bytes)
+	if limit := t.limit.Load(); limit > 0 && used > limit {
+		t.used.Sub(bytes)
+		return fmt.Errorf("%w: memory used by %s reaches %s, exceeds the limit %s",
+			ErrMemoryLimitExceeded, t.label, ltoml.Size(used), ltoml.Size(limit))
+	}
+	if err := t.parent.Consume(bytes); err != nil {
+		t.used.Sub(bytes)
+		return err
+	}
+	for {
+		peak := t.peak.Load()
+		if used <= peak || t.peak.CAS(peak, used) {
+			return nil
+		}
+	}
+}
+
+// Release releases memory bytes which consumed before.
+func (t *MemoryTracker) Release(bytes int64) {
+	if t == nil || bytes <= 0 || t.closed.Load() {
+		return
+	}
+	t.used.Sub(bytes)
+	t.parent.Release(bytes)
+}
+
+// ReleaseAll releases all memory consumed by this tracker after task completed,
+// then stops tracking because late consumption of the completed task cannot be released.
+func (t *MemoryTracker) ReleaseAll() {
+	if t == nil || !t.closed.CAS(false, true) {
+		return
+	}
+	t.parent.Release(t.used.Swap(0))
+}
+
+// Used returns the memory bytes used currently.
+func (t *MemoryTracker) Used() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.used.Load()
+}
+
+// Peak returns the peak memory bytes used.
+func (t *MemoryTracker) Peak() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.peak.Load()
+}
@@ -0,0 +1,117 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryTracker(t *testing.T) {
+	parent := NewMemoryTracker("parent", 100, nil)
+	tracker := NewMemoryTracker("query", 60, parent)
+
+	assert.NoError(t, tracker.Consume(0))
+	assert.NoError(t, tracker.Consume(50))
+	assert.Equal(t, int64(50), tracker.Used())
+	assert.Equal(t, int64(50), parent.Used())
+	// exceeds self limit
+	err := tracker.Consume(20)
+	assert.ErrorIs(t, err, ErrMemoryLimitExceeded)
+	assert.Contains(t, err.Error(), "query")
+	assert.Equal(t, int64(50), tracker.Used())
+	assert.Equal(t, int64(50), parent.Used())
+
+	// exceeds parent limit
+	other := NewMemoryTracker("other", 0, parent)
+	assert.NoError(t, other.Consume(45))
+	err = tracker.Consume(10)
+	assert.ErrorIs(t, err, ErrMemoryLimitExceeded)
+	assert.Contains(t, err.Error(), "parent")
+	assert.Equal(t, int64(50), tracker.Used())
+	assert.Equal(t, int64(95), parent.Used())
+
+	tracker.Release(30)
+	assert.Equal(t, int64(20), tracker.Used())
+	assert.Equal(t, int64(65), parent.Used())
+	assert.Equal(t, int64(50), tracker.Peak())
+	assert.Equal(t, int64(95), parent.Peak())
+
+	tracker.ReleaseAll()
+	assert.Equal(t, int64(0), tracker.Used())
+	assert.Equal(t, int64(45), parent.Used())
+	// completed tracker stops tracking
+	assert.NoError(t, tracker.Consume(1000))
+	tracker.Release(10)
+	tracker.ReleaseAll()
+	assert.Equal(t, int64(0), tracker.Used())
+	assert.Equal(t, int64(45), parent.Used())
+}
+
+func TestMemoryTracker_Nil(t *testing.T) {
+	var tracker *MemoryTracker
+	assert.NoError(t, tracker.Consume(100))
+	tracker.Release(100)
+	tracker.ReleaseAll()
+	assert.Zero(t, tracker.Used())
+	assert.Zero(t, tracker.Peak())
+}
+
+func TestMemoryTracker_Concurrent(t *testing.T) {
+	tracker := NewMemoryTracker("query", 0, nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				assert.NoError(t, tracker.Consume(10))
+				tracker.Release(10)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Zero(t, tracker.Used())
+	assert.True(t, tracker.Peak() >= 10)
+}
+
+func TestSetMemoryLimit(t *testing.T) {
+	defer SetMemoryLimit(0, 0)
+
+	SetMemoryLimit(100, 150)
+	q1 := NewQueryMemoryTracker()
+	q2 := NewQueryMemoryTracker()
+	assert.ErrorIs(t, q1.Consume(120), ErrMemoryLimitExceeded)
+	assert.NoError(t, q1.Consume(100))
+	assert.NoError(t, q2.Consume(50))
+	assert.Equal(t, int64(150), GlobalMemoryTracker().Used())
+	assert.ErrorIs(t, q2.Consume(1), ErrMemoryLimitExceeded)
+
+	taskCtx := NewTaskContextWithTimeout(context.TODO(), time.Second)
+	assert.NotNil(t, taskCtx.MemoryTracker)
+	q1.ReleaseAll()
+	q2.ReleaseAll()
+	assert.Zero(t, GlobalMemoryTracker().Used())
+	assert.NoError(t, taskCtx.MemoryTracker.Consume(10))
+	taskCtx.Release()
+	assert.Zero(t, GlobalMemoryTracker().Used())
+}
@@ -0,0 +1,190 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+//go:generate mockgen -source=./recorder.go -destination=./recorder_mock.go -package=audit
+
+// for testing
+var (
+	openFileFn = os.OpenFile
+)
+
+const (
+	// defaultLimit represents the default number of audit entries returned.
+	defaultLimit = 100
+	// trimInterval represents how many entries recorded between removing the oldest entries.
+	trimInterval = 100
+)
+
+// Recorder records the audit entries of state-mutating operations into coordinator(append-only),
+// exports them to file sink if configured.
+type Recorder interface {
+	// Record records an audit entry, failure is logged only and doesn't affect the operation.
+	Record(ctx context.Context, entry *models.AuditEntry)
+	// List returns the latest audit entries(newest first).
+	List(ctx context.Context, limit int) (models.AuditEntries, error)
+	// Close closes the file sink.
+	Close() error
+}
+
+// recorder implements Recorder interface.
+type recorder struct {
+	cfg  *config.Audit
+	node string
+	repo state.Repository
+	file *os.File
+
+	recorded int
+	lock     sync.Mutex
+
+	logger *logger.Logger
+}
+
+// NewRecorder creates an audit recorder which stores entries in the repo of cluster,
+// node represents the indicator of current node.
+func NewRecorder(cfg *config.Audit, node string, repo state.Repository) (Recorder, error) {
+	r := &recorder{
+		cfg:    cfg,
+		node:   node,
+		repo:   repo,
+		logger: logger.GetLogger("Audit", "Recorder"),
+	}
+	if cfg.Enable && cfg.File != "" {
+		f, err := openFileFn(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		r.file = f
+	}
+	return r, nil
+}
+
+// Record records an audit entry, failure is logged only and doesn't affect the operation.
+func (r *recorder) Record(ctx context.Context, entry *models.AuditEntry) {
+	if !r.cfg.Enable {
+		return
+	}
+	now := time.Now()
+	if entry.Time == 0 {
+		entry.Time = now.UnixMilli()
+	}
+	entry.Node = r.node
+	data := encoding.JSONMarshal(entry)
+	// key is ordered by time, node suffix avoids conflict between nodes
+	key := constants.GetAuditLogPath(fmt.Sprintf("%019d-%s", now.UnixNano(), r.node))
+	if err := r.repo.Put(ctx, key, data); err != nil {
+		r.logger.Warn("store audit entry failure",
+			logger.String("statement", entry.Statement), logger.Error(err))
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file != nil {
+		if _, err := r.file.Write(append(data, '\n')); err != nil {
+			r.logger.Warn("write audit entry to file failure",
+				logger.String("file", r.cfg.File), logger.Error(err))
+		}
+	}
+	r.recorded++
+	if r.recorded%trimInterval == 0 {
+		r.trim(ctx)
+	}
+}
+
+// List returns the latest audit entries(newest first).
+func (r *recorder) List(ctx context.Context, limit int) (models.AuditEntries, error) {
+	if !r.cfg.Enable {
+		return nil, fmt.Errorf("audit log is disabled")
+	}
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	kvs, err := r.listEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entries models.AuditEntries
+	for idx := len(kvs) - 1; idx >= 0 && len(entries) < limit; idx-- {
+		entry := &models.AuditEntry{}
+		if err := encoding.JSONUnmarshal(kvs[idx].Value, entry); err != nil {
+			r.logger.Warn("unmarshal audit entry failure",
+				logger.String("key", kvs[idx].Key), logger.Error(err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Close closes the file sink.
+func (r *recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// trim removes the oldest entries which exceed max entries.
+func (r *recorder) trim(ctx context.Context) {
+	kvs, err := r.listEntries(ctx)
+	if err != nil {
+		r.logger.Warn("list audit entries failure when trim", logger.Error(err))
+		return
+	}
+	for idx := 0; idx < len(kvs)-r.cfg.MaxEntries; idx++ {
+		if err := r.repo.Delete(ctx, kvs[idx].Key); err != nil {
+			r.logger.Warn("remove audit entry failure",
+				logger.String("key", kvs[idx].Key), logger.Error(err))
+			return
+		}
+	}
+}
+
+// listEntries returns all audit entries ordered by key(time).
+func (r *recorder) listEntries(ctx context.Context) ([]state.KeyValue, error) {
+	kvs, err := r.repo.List(ctx, constants.AuditLogPath)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].Key < kvs[j].Key
+	})
+	return kvs, nil
+}
@@ -0,0 +1,151 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestNewRecorder(t *testing.T) {
+	defer func() {
+		openFileFn = os.OpenFile
+	}()
+	openFileFn = func(name string, flag int, perm os.FileMode) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	r, err := NewRecorder(&config.Audit{Enable: true, File: "audit.log"}, "node", nil)
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	// file sink not used if disabled
+	r, err = NewRecorder(&config.Audit{File: "audit.log"}, "node", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+}
+
+func TestRecorder_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	file := filepath.Join(t.TempDir(), "audit.log")
+	r, err := NewRecorder(&config.Audit{Enable: true, MaxEntries: 1, File: file}, "node", repo)
+	assert.NoError(t, err)
+
+	// store failure, still write file
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	r.Record(context.TODO(), &models.AuditEntry{Statement: "drop database db"})
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	r.Record(context.TODO(), &models.AuditEntry{Statement: "create tenant t1"})
+	assert.NoError(t, r.Close())
+	assert.NoError(t, r.Close())
+
+	f, err := os.Open(file)
+	assert.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var entries []*models.AuditEntry
+	for scanner.Scan() {
+		entry := &models.AuditEntry{}
+		assert.NoError(t, encoding.JSONUnmarshal(scanner.Bytes(), entry))
+		entries = append(entries, entry)
+	}
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "node", entries[0].Node)
+	assert.NotZero(t, entries[0].Time)
+	assert.Equal(t, "create tenant t1", entries[1].Statement)
+
+	// disabled
+	r, err = NewRecorder(&config.Audit{}, "node", repo)
+	assert.NoError(t, err)
+	r.Record(context.TODO(), &models.AuditEntry{Statement: "drop database db"})
+}
+
+func TestRecorder_Trim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	r, err := NewRecorder(&config.Audit{Enable: true, MaxEntries: 1}, "node", repo)
+	assert.NoError(t, err)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	record := func() {
+		for i := 0; i < trimInterval; i++ {
+			r.Record(context.TODO(), &models.AuditEntry{})
+		}
+	}
+	// list failure
+	repo.EXPECT().List(gomock.Any(), constants.AuditLogPath).Return(nil, fmt.Errorf("err"))
+	record()
+	// remove the oldest entries
+	kvs := []state.KeyValue{{Key: "3"}, {Key: "1"}, {Key: "2"}}
+	repo.EXPECT().List(gomock.Any(), constants.AuditLogPath).Return(kvs, nil)
+	repo.EXPECT().Delete(gomock.Any(), "1").Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "2").Return(nil)
+	record()
+	// delete failure
+	kvs = []state.KeyValue{{Key: "3"}, {Key: "1"}, {Key: "2"}}
+	repo.EXPECT().List(gomock.Any(), constants.AuditLogPath).Return(kvs, nil)
+	repo.EXPECT().Delete(gomock.Any(), "1").Return(fmt.Errorf("err"))
+	record()
+}
+
+func TestRecorder_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	r, err := NewRecorder(&config.Audit{}, "node", repo)
+	assert.NoError(t, err)
+	entries, err := r.List(context.TODO(), 0)
+	assert.Error(t, err)
+	assert.Nil(t, entries)
+
+	r, err = NewRecorder(&config.Audit{Enable: true}, "node", repo)
+	assert.NoError(t, err)
+	repo.EXPECT().List(gomock.Any(), constants.AuditLogPath).Return(nil, fmt.Errorf("err"))
+	entries, err = r.List(context.TODO(), 0)
+	assert.Error(t, err)
+	assert.Nil(t, entries)
+
+	kvs := []state.KeyValue{
+		{Key: "1", Value: encoding.JSONMarshal(&models.AuditEntry{Statement: "s1"})},
+		{Key: "3", Value: encoding.JSONMarshal(&models.AuditEntry{Statement: "s3"})},
+		{Key: "2", Value: encoding.JSONMarshal(&models.AuditEntry{Statement: "s2"})},
+		{Key: "4", Value: []byte("abc")},
+	}
+	repo.EXPECT().List(gomock.Any(), constants.AuditLogPath).Return(kvs, nil)
+	entries, err = r.List(context.TODO(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "s3", entries[0].Statement)
+	assert.Equal(t, "s2", entries[1].Statement)
+}
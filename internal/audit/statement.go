@@ -0,0 +1,211 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// passwordRegex represents the pattern of password in statement, like: PASSWORD '<password>'.
+	passwordRegex = regexp.MustCompile(`(?i)(password\s+)('[^']*'|"[^"]*"|\S+)`)
+	// jsonPasswordRegex represents the pattern of password in json value of statement, like: "password":"<password>".
+	jsonPasswordRegex = regexp.MustCompile(`(?i)("password"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// Mutating returns if the statement changes the state of cluster, which should be audited.
+func Mutating(stmt stmtpkg.Statement) bool {
+	switch s := stmt.(type) {
+	case *stmtpkg.Schema:
+		return s.Type == stmtpkg.CreateDatabaseSchemaType || s.Type == stmtpkg.DropDatabaseSchemaType ||
+			s.Type == stmtpkg.AlterDatabaseReplicaSchemaType
+	case *stmtpkg.Storage:
+		return s.Type == stmtpkg.StorageOpCreate || s.Type == stmtpkg.StorageOpDelete ||
+			s.Type == stmtpkg.StorageOpRecover || s.Type == stmtpkg.StorageOpDecommission
+	case *stmtpkg.Broker:
+		return s.Type == stmtpkg.BrokerOpCreate || s.Type == stmtpkg.BrokerOpDelete
+	case *stmtpkg.Backup:
+		return true
+	case *stmtpkg.Compact:
+		return s.Type == stmtpkg.CompactOpCompact || s.Type == stmtpkg.CompactOpRollup
+	case *stmtpkg.RecordingRule:
+		return s.Type == stmtpkg.RecordingRuleOpCreate || s.Type == stmtpkg.RecordingRuleOpDrop
+	case *stmtpkg.Alert:
+		return s.Type == stmtpkg.AlertOpCreate || s.Type == stmtpkg.AlertOpDrop
+	case *stmtpkg.User:
+		return s.Type == stmtpkg.UserOpCreate || s.Type == stmtpkg.UserOpAlter || s.Type == stmtpkg.UserOpDrop ||
+			s.Type == stmtpkg.UserOpRevokeTokens
+	case *stmtpkg.Role:
+		return s.Type == stmtpkg.RoleOpCreate || s.Type == stmtpkg.RoleOpDrop
+	case *stmtpkg.Grant:
+		return true
+	case *stmtpkg.Token:
+		return s.Type == stmtpkg.TokenOpCreate || s.Type == stmtpkg.TokenOpDrop
+	case *stmtpkg.Tenant:
+		return s.Type == stmtpkg.TenantOpCreate || s.Type == stmtpkg.TenantOpAlter || s.Type == stmtpkg.TenantOpDrop
+	}
+	return false
+}
+
+// Execute executes the statement via fn, if the statement changes the state of cluster,
+// records the audit entry with result and the config of target resource before/after executed.
+func Execute(ctx context.Context, recorder Recorder, repo state.Repository,
+	entry *models.AuditEntry, stmt stmtpkg.Statement, fn func() (interface{}, error),
+) (interface{}, error) {
+	if recorder == nil || !Mutating(stmt) {
+		return fn()
+	}
+	path := resourcePath(stmt)
+	entry.Statement = Redact(entry.Statement)
+	entry.Before = snapshot(ctx, repo, path)
+	result, err := fn()
+	entry.After = snapshot(ctx, repo, path)
+	entry.Success = err == nil
+	if err != nil {
+		entry.Result = err.Error()
+	} else {
+		entry.Result = resultOf(stmt, result)
+	}
+	recorder.Record(ctx, entry)
+	return result, err
+}
+
+// Redact replaces the password in statement(include json value of storage/broker config) with '***'.
+func Redact(sql string) string {
+	sql = jsonPasswordRegex.ReplaceAllString(sql, `${1}"***"`)
+	return passwordRegex.ReplaceAllString(sql, "${1}'***'")
+}
+
+// resourcePath returns the config path of resource changed by statement, returns empty if unknown.
+func resourcePath(stmt stmtpkg.Statement) string {
+	switch s := stmt.(type) {
+	case *stmtpkg.Schema:
+		if s.Type == stmtpkg.DropDatabaseSchemaType || s.Type == stmtpkg.AlterDatabaseReplicaSchemaType {
+			return constants.GetDatabaseConfigPath(s.Value)
+		}
+		database := &models.Database{}
+		if err := encoding.JSONUnmarshal([]byte(s.Value), database); err == nil && database.Name != "" {
+			return constants.GetDatabaseConfigPath(database.Name)
+		}
+	case *stmtpkg.Storage:
+		if s.Type == stmtpkg.StorageOpCreate {
+			if name := clusterName(s.Value); name != "" {
+				return constants.GetStorageClusterConfigPath(name)
+			}
+		}
+	case *stmtpkg.Broker:
+		if s.Type == stmtpkg.BrokerOpCreate {
+			if name := clusterName(s.Value); name != "" {
+				return constants.GetBrokerClusterConfigPath(name)
+			}
+		}
+	case *stmtpkg.RecordingRule:
+		return constants.GetRecordingRulePath(strings.TrimSpace(s.Name))
+	case *stmtpkg.Alert:
+		return constants.GetAlertRulePath(s.Name)
+	case *stmtpkg.User:
+		return constants.GetUserPath(s.Name)
+	case *stmtpkg.Role:
+		return constants.GetRolePath(s.Name)
+	case *stmtpkg.Grant:
+		if s.ToRole {
+			return constants.GetRolePath(s.Grantee)
+		}
+		return constants.GetUserPath(s.Grantee)
+	case *stmtpkg.Token:
+		return constants.GetTokenPath(s.Name)
+	case *stmtpkg.Tenant:
+		return constants.GetTenantPath(s.Name)
+	}
+	return ""
+}
+
+// clusterName returns the namespace of storage/broker cluster config.
+func clusterName(value string) string {
+	cluster := struct {
+		Config struct {
+			Namespace string `json:"namespace"`
+		} `json:"config"`
+	}{}
+	if err := encoding.JSONUnmarshal([]byte(value), &cluster); err != nil {
+		return ""
+	}
+	return cluster.Config.Namespace
+}
+
+// snapshot returns the config of resource, the secret(password/token hash/password of repo) is removed.
+func snapshot(ctx context.Context, repo state.Repository, path string) string {
+	if path == "" {
+		return ""
+	}
+	data, err := repo.Get(ctx, path)
+	if err != nil {
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(path, constants.UserPath+"/"):
+		user := &models.User{}
+		if err := encoding.JSONUnmarshal(data, user); err != nil {
+			return ""
+		}
+		user.Password = ""
+		data = encoding.JSONMarshal(user)
+	case strings.HasPrefix(path, constants.TokenPath+"/"):
+		token := &models.APIToken{}
+		if err := encoding.JSONUnmarshal(data, token); err != nil {
+			return ""
+		}
+		token.Hash = ""
+		data = encoding.JSONMarshal(token)
+	case strings.HasPrefix(path, constants.StorageConfigPath+"/"), strings.HasPrefix(path, constants.BrokerConfigPath+"/"):
+		cluster := &config.StorageCluster{}
+		if err := encoding.JSONUnmarshal(data, cluster); err != nil {
+			return ""
+		}
+		if cluster.Config != nil {
+			cluster.Config.Password = ""
+		}
+		data = encoding.JSONMarshal(cluster)
+	}
+	return string(data)
+}
+
+// resultOf returns the message of statement result, plain token isn't recorded.
+func resultOf(stmt stmtpkg.Statement, result interface{}) string {
+	if s, ok := stmt.(*stmtpkg.Token); ok && s.Type == stmtpkg.TokenOpCreate {
+		return "Create token ok"
+	}
+	switch rs := result.(type) {
+	case *string:
+		if rs != nil {
+			return *rs
+		}
+	case string:
+		return rs
+	}
+	return "ok"
+}
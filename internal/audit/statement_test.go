@@ -0,0 +1,225 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestMutating(t *testing.T) {
+	for _, stmt := range []stmtpkg.Statement{
+		&stmtpkg.Schema{Type: stmtpkg.CreateDatabaseSchemaType},
+		&stmtpkg.Schema{Type: stmtpkg.DropDatabaseSchemaType},
+		&stmtpkg.Schema{Type: stmtpkg.AlterDatabaseReplicaSchemaType},
+		&stmtpkg.Storage{Type: stmtpkg.StorageOpCreate},
+		&stmtpkg.Storage{Type: stmtpkg.StorageOpRecover},
+		&stmtpkg.Storage{Type: stmtpkg.StorageOpDecommission, NodeID: 1},
+		&stmtpkg.Broker{Type: stmtpkg.BrokerOpCreate},
+		&stmtpkg.Backup{Type: stmtpkg.BackupOpRestore},
+		&stmtpkg.Compact{Type: stmtpkg.CompactOpRollup},
+		&stmtpkg.RecordingRule{Type: stmtpkg.RecordingRuleOpDrop},
+		&stmtpkg.Alert{Type: stmtpkg.AlertOpCreate},
+		&stmtpkg.User{Type: stmtpkg.UserOpAlter},
+		&stmtpkg.User{Type: stmtpkg.UserOpRevokeTokens},
+		&stmtpkg.Role{Type: stmtpkg.RoleOpCreate},
+		&stmtpkg.Grant{Revoke: true},
+		&stmtpkg.Token{Type: stmtpkg.TokenOpDrop},
+		&stmtpkg.Tenant{Type: stmtpkg.TenantOpAlter},
+	} {
+		assert.True(t, Mutating(stmt), stmt)
+	}
+	for _, stmt := range []stmtpkg.Statement{
+		&stmtpkg.Schema{Type: stmtpkg.DatabaseNameSchemaType},
+		&stmtpkg.Storage{Type: stmtpkg.StorageOpShow},
+		&stmtpkg.Broker{Type: stmtpkg.BrokerOpShow},
+		&stmtpkg.Compact{Type: stmtpkg.CompactOpShowState},
+		&stmtpkg.RecordingRule{Type: stmtpkg.RecordingRuleOpShow},
+		&stmtpkg.Alert{Type: stmtpkg.AlertOpShow},
+		&stmtpkg.User{Type: stmtpkg.UserOpShow},
+		&stmtpkg.Role{Type: stmtpkg.RoleOpShow},
+		&stmtpkg.Token{Type: stmtpkg.TokenOpShow},
+		&stmtpkg.Tenant{Type: stmtpkg.TenantOpShowUsage},
+		&stmtpkg.Query{},
+		&stmtpkg.Audit{},
+	} {
+		assert.False(t, Mutating(stmt), stmt)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t, "create user u1 password '***'", Redact("create user u1 password 'abc'"))
+	assert.Equal(t, "ALTER USER u1 PASSWORD '***';", Redact(`ALTER USER u1 PASSWORD "a b";`))
+	assert.Equal(t, "drop database db", Redact("drop database db"))
+	assert.Equal(t, `create storage {"config":{"namespace":"s1","password":"***","username":"u"}}`,
+		Redact(`create storage {"config":{"namespace":"s1","password":"a\"b","username":"u"}}`))
+	assert.Equal(t, `create broker {"config":{"Password" : "***"}}`, Redact(`create broker {"config":{"Password" : "abc"}}`))
+}
+
+func TestResourcePath(t *testing.T) {
+	cases := []struct {
+		stmt stmtpkg.Statement
+		path string
+	}{
+		{&stmtpkg.Schema{Type: stmtpkg.DropDatabaseSchemaType, Value: "db"}, constants.GetDatabaseConfigPath("db")},
+		{&stmtpkg.Schema{Type: stmtpkg.AlterDatabaseReplicaSchemaType, Value: "db"}, constants.GetDatabaseConfigPath("db")},
+		{&stmtpkg.Schema{Type: stmtpkg.CreateDatabaseSchemaType, Value: `{"name":"db"}`}, constants.GetDatabaseConfigPath("db")},
+		{&stmtpkg.Schema{Type: stmtpkg.CreateDatabaseSchemaType, Value: `abc`}, ""},
+		{&stmtpkg.Storage{Type: stmtpkg.StorageOpCreate, Value: `{"config":{"namespace":"s1"}}`},
+			constants.GetStorageClusterConfigPath("s1")},
+		{&stmtpkg.Storage{Type: stmtpkg.StorageOpCreate, Value: `abc`}, ""},
+		{&stmtpkg.Storage{Type: stmtpkg.StorageOpRecover, Value: "s1"}, ""},
+		{&stmtpkg.Broker{Type: stmtpkg.BrokerOpCreate, Value: `{"config":{"namespace":"b1"}}`},
+			constants.GetBrokerClusterConfigPath("b1")},
+		{&stmtpkg.RecordingRule{Name: "r1"}, constants.GetRecordingRulePath("r1")},
+		{&stmtpkg.Alert{Name: "a1"}, constants.GetAlertRulePath("a1")},
+		{&stmtpkg.User{Name: "u1"}, constants.GetUserPath("u1")},
+		{&stmtpkg.Role{Name: "r1"}, constants.GetRolePath("r1")},
+		{&stmtpkg.Grant{Grantee: "u1"}, constants.GetUserPath("u1")},
+		{&stmtpkg.Grant{Grantee: "r1", ToRole: true}, constants.GetRolePath("r1")},
+		{&stmtpkg.Token{Name: "t1"}, constants.GetTokenPath("t1")},
+		{&stmtpkg.Tenant{Name: "t1"}, constants.GetTenantPath("t1")},
+		{&stmtpkg.Backup{Database: "db"}, ""},
+	}
+	for _, tt := range cases {
+		assert.Equal(t, tt.path, resourcePath(tt.stmt), tt.stmt)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	recorder := NewMockRecorder(ctrl)
+	ok := "Create tenant ok"
+	execFn := func() (interface{}, error) {
+		return &ok, nil
+	}
+	// not audited
+	rs, err := Execute(context.TODO(), nil, repo, &models.AuditEntry{}, &stmtpkg.Tenant{Type: stmtpkg.TenantOpCreate}, execFn)
+	assert.NoError(t, err)
+	assert.Equal(t, &ok, rs)
+	rs, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{}, &stmtpkg.Tenant{Type: stmtpkg.TenantOpShow}, execFn)
+	assert.NoError(t, err)
+	assert.Equal(t, &ok, rs)
+
+	// create tenant
+	path := constants.GetTenantPath("t1")
+	gomock.InOrder(
+		repo.EXPECT().Get(gomock.Any(), path).Return(nil, state.ErrNotExist),
+		repo.EXPECT().Get(gomock.Any(), path).Return([]byte(`{"name":"t1"}`), nil),
+	)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Equal(t, "admin", entry.User)
+		assert.Empty(t, entry.Before)
+		assert.Equal(t, `{"name":"t1"}`, entry.After)
+		assert.True(t, entry.Success)
+		assert.Equal(t, ok, entry.Result)
+	})
+	rs, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{User: "admin", Statement: "create tenant t1"},
+		&stmtpkg.Tenant{Type: stmtpkg.TenantOpCreate, Name: "t1"}, execFn)
+	assert.NoError(t, err)
+	assert.Equal(t, &ok, rs)
+
+	// failure
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.False(t, entry.Success)
+		assert.Equal(t, "err", entry.Result)
+	})
+	_, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{}, &stmtpkg.Backup{}, func() (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	})
+	assert.Error(t, err)
+
+	// secret removed
+	repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).
+		Return(encoding.JSONMarshal(&models.User{Name: "u1", Password: "hash"}), nil).Times(2)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Equal(t, "alter user u1 password '***'", entry.Statement)
+		assert.NotContains(t, entry.Before, "hash")
+		assert.Contains(t, entry.After, "u1")
+	})
+	_, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{Statement: "alter user u1 password 'abc'"},
+		&stmtpkg.User{Type: stmtpkg.UserOpAlter, Name: "u1"}, execFn)
+	assert.NoError(t, err)
+	repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).
+		Return(encoding.JSONMarshal(&models.APIToken{Name: "t1", Hash: "hash"}), nil).Times(2)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.NotContains(t, entry.After, "hash")
+		assert.Equal(t, "Create token ok", entry.Result)
+	})
+	_, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{},
+		&stmtpkg.Token{Type: stmtpkg.TokenOpCreate, Name: "t1"}, func() (interface{}, error) {
+			return "plain-token", nil
+		})
+	assert.NoError(t, err)
+	for _, path := range []string{constants.GetStorageClusterConfigPath("s1"), constants.GetBrokerClusterConfigPath("s1")} {
+		repo.EXPECT().Get(gomock.Any(), path).
+			Return([]byte(`{"config":{"namespace":"s1","password":"secret"}}`), nil).Times(2)
+		recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+			assert.NotContains(t, entry.Statement, "secret")
+			assert.NotContains(t, entry.Before, "secret")
+			assert.NotContains(t, entry.After, "secret")
+			assert.Contains(t, entry.After, "s1")
+		})
+	}
+	_, err = Execute(context.TODO(), recorder, repo,
+		&models.AuditEntry{Statement: `create storage {"config":{"namespace":"s1","password":"secret"}}`},
+		&stmtpkg.Storage{Type: stmtpkg.StorageOpCreate, Value: `{"config":{"namespace":"s1","password":"secret"}}`}, execFn)
+	assert.NoError(t, err)
+	_, err = Execute(context.TODO(), recorder, repo,
+		&models.AuditEntry{Statement: `create broker {"config":{"namespace":"s1","password":"secret"}}`},
+		&stmtpkg.Broker{Type: stmtpkg.BrokerOpCreate, Value: `{"config":{"namespace":"s1","password":"secret"}}`}, execFn)
+	assert.NoError(t, err)
+	// invalid config
+	repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("u1")).Return([]byte("abc"), nil).Times(2)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Empty(t, entry.Before)
+	})
+	_, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{}, &stmtpkg.User{Type: stmtpkg.UserOpDrop, Name: "u1"}, execFn)
+	assert.NoError(t, err)
+	repo.EXPECT().Get(gomock.Any(), constants.GetTokenPath("t1")).Return([]byte("abc"), nil).Times(2)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any())
+	_, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{}, &stmtpkg.Token{Type: stmtpkg.TokenOpDrop, Name: "t1"}, execFn)
+	assert.NoError(t, err)
+	repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("s1")).Return([]byte("abc"), nil).Times(2)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry *models.AuditEntry) {
+		assert.Empty(t, entry.Before)
+	})
+	_, err = Execute(context.TODO(), recorder, repo, &models.AuditEntry{},
+		&stmtpkg.Storage{Type: stmtpkg.StorageOpCreate, Value: `{"config":{"namespace":"s1"}}`}, execFn)
+	assert.NoError(t, err)
+}
+
+func TestResultOf(t *testing.T) {
+	var nilStr *string
+	assert.Equal(t, "ok", resultOf(&stmtpkg.Backup{}, nilStr))
+	assert.Equal(t, "ok", resultOf(&stmtpkg.Storage{}, &[]string{"db"}))
+	assert.Equal(t, "done", resultOf(&stmtpkg.Storage{}, "done"))
+}
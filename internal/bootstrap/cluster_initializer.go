@@ -41,18 +41,28 @@ type ClusterInitializer interface {
 // clusterInitializer implements ClusterInitializer interface.
 type clusterInitializer struct {
 	endpoint string
+	user     config.User
 }
 
-// NewClusterInitializer creates a initializer
-func NewClusterInitializer(endpoint string) ClusterInitializer {
+// NewClusterInitializer creates a initializer, user is used for authentication if not empty.
+func NewClusterInitializer(endpoint string, user config.User) ClusterInitializer {
 	u, _ := url.Parse(endpoint)
 	u.Path = path.Join(u.Path, constants.APIVersion1CliPath)
-	return &clusterInitializer{endpoint: u.String()}
+	return &clusterInitializer{endpoint: u.String(), user: user}
+}
+
+// newExecuteCli creates the execute client with authentication.
+func (i *clusterInitializer) newExecuteCli() client.ExecuteCli {
+	cli := client.NewExecuteCli(i.endpoint)
+	if i.user.UserName != "" {
+		cli.SetBasicAuth(i.user.UserName, i.user.Password)
+	}
+	return cli
 }
 
 // InitStorageCluster initializes the storage cluster
 func (i *clusterInitializer) InitStorageCluster(storageCfg config.StorageCluster) error {
-	cli := client.NewExecuteCli(i.endpoint)
+	cli := i.newExecuteCli()
 	if err := cli.Execute(models.ExecuteParam{
 		SQL: "create storage " + string(encoding.JSONMarshal(&storageCfg)),
 	}, nil); err != nil {
@@ -63,7 +73,7 @@ func (i *clusterInitializer) InitStorageCluster(storageCfg config.StorageCluster
 
 // InitInternalDatabase initializes internal database
 func (i *clusterInitializer) InitInternalDatabase(database models.Database) error {
-	cli := client.NewExecuteCli(i.endpoint)
+	cli := i.newExecuteCli()
 	if err := cli.Execute(models.ExecuteParam{
 		SQL: "create database " + string(encoding.JSONMarshal(&database)),
 	}, nil); err != nil {
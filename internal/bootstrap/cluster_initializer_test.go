@@ -55,7 +55,7 @@ func TestClusterInitializer_InitDatabase(t *testing.T) {
 				}))
 			defer ts.Close()
 
-			init := NewClusterInitializer(ts.URL)
+			init := NewClusterInitializer(ts.URL, config.User{})
 
 			err := init.InitInternalDatabase(models.Database{})
 			if (err != nil) != tt.wantErr {
@@ -94,7 +94,7 @@ func TestClusterInitializer_InitStorageCluster(t *testing.T) {
 				}))
 			defer ts.Close()
 
-			init := NewClusterInitializer(ts.URL)
+			init := NewClusterInitializer(ts.URL, config.User{UserName: "admin", Password: "admin123"})
 
 			err := init.InitStorageCluster(config.StorageCluster{})
 			if (err != nil) != tt.wantErr {
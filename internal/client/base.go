@@ -17,9 +17,50 @@
 
 package client
 
-import resty "github.com/go-resty/resty/v2"
+import (
+	"net/http"
+	"sync/atomic"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/tlsutil"
+)
+
+// defaultPeerAuth holds the built-in admin user of broker for accessing internal api of other nodes.
+var defaultPeerAuth atomic.Value
+
+// SetDefaultPeerAuth sets the credential of http client for accessing internal api of other nodes,
+// if broker authentication enabled.
+func SetDefaultPeerAuth(user config.User) {
+	defaultPeerAuth.Store(user)
+}
 
 // Base represents base client.
 type Base struct {
 	cli *resty.Client
 }
+
+// NewRestyClient creates a resty client, using https client config/peer credential for accessing other nodes if set.
+func NewRestyClient() *resty.Client {
+	cli := resty.New()
+	if tlsCfg := tlsutil.DefaultClientConfig(); tlsCfg != nil {
+		cli.SetTLSClientConfig(tlsCfg)
+	}
+	if user, ok := defaultPeerAuth.Load().(config.User); ok && user.UserName != "" {
+		cli.SetBasicAuth(user.UserName, user.Password)
+	}
+	return cli
+}
+
+// NewTransport creates a http transport, using https client config for accessing other nodes if set.
+func NewTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsutil.DefaultClientConfig()
+	return transport
+}
+
+// SetBasicAuth sets the basic authentication(user name/password) for all requests.
+func (b *Base) SetBasicAuth(userName, password string) {
+	b.cli.SetBasicAuth(userName, password)
+}
@@ -0,0 +1,49 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/tlsutil"
+)
+
+func TestNewRestyClient(t *testing.T) {
+	assert.NotNil(t, NewRestyClient())
+	assert.Nil(t, NewTransport().TLSClientConfig)
+
+	defer tlsutil.SetDefaultClientConfig(nil)
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	tlsutil.SetDefaultClientConfig(tlsCfg)
+	assert.NotNil(t, NewRestyClient())
+	assert.Equal(t, tlsCfg, NewTransport().TLSClientConfig)
+}
+
+func TestNewRestyClient_PeerAuth(t *testing.T) {
+	assert.Empty(t, NewRestyClient().UserInfo)
+
+	defer SetDefaultPeerAuth(config.User{})
+	SetDefaultPeerAuth(config.User{UserName: "admin", Password: "admin123"})
+	cli := NewRestyClient()
+	assert.Equal(t, "admin", cli.UserInfo.Username)
+	assert.Equal(t, "admin123", cli.UserInfo.Password)
+}
@@ -18,13 +18,15 @@
 package client
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
-	resty "github.com/go-resty/resty/v2"
-
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/ltoml"
@@ -38,6 +40,13 @@ type ExecuteCli interface {
 	Execute(param models.ExecuteParam, rs interface{}) error
 	// ExecuteAsResult executes lin query language, then returns terminal result.
 	ExecuteAsResult(param models.ExecuteParam, rs interface{}) (string, error)
+	// ExecuteChunked executes metric query in chunked mode, invokes fn for each result chunk.
+	ExecuteChunked(param models.ExecuteParam, fn func(chunk *models.ResultChunk) error) error
+	// ExecuteExport executes metric query with export format, writes the exported data into writer,
+	// returns the number of bytes written.
+	ExecuteExport(param models.ExecuteParam, w io.Writer) (int64, error)
+	// SetBasicAuth sets the user name/password for all requests.
+	SetBasicAuth(userName, password string)
 }
 
 // executeCli implements ExecuteCli interface.
@@ -47,7 +56,7 @@ type executeCli struct {
 
 // NewExecuteCli creates a lin query language execute client instance.
 func NewExecuteCli(endpoint string) ExecuteCli {
-	cli := resty.New()
+	cli := NewRestyClient()
 	cli.SetBaseURL(endpoint)
 	return &executeCli{
 		Base{
@@ -78,6 +87,75 @@ func (cli *executeCli) Execute(param models.ExecuteParam, rs interface{}) error
 	return errors.New(string(resp.Body()))
 }
 
+// ExecuteChunked executes metric query in chunked mode, invokes fn for each result chunk.
+// returns error if query failure or response is broken before end chunk received.
+func (cli *executeCli) ExecuteChunked(param models.ExecuteParam, fn func(chunk *models.ResultChunk) error) error {
+	param.Chunked = true
+	resp, err := cli.cli.R().
+		SetBody(&param).
+		SetHeader("Accept", constants.ContentTypeNDJSON).
+		SetDoNotParseResponse(true).
+		Put("/exec")
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer func() {
+		_ = body.Close()
+	}()
+	if resp.StatusCode() != http.StatusOK {
+		data, _ := io.ReadAll(body)
+		return errors.New(string(data))
+	}
+	reader := bufio.NewReader(body)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			chunk := &models.ResultChunk{}
+			if err0 := encoding.JSONUnmarshal(line, chunk); err0 != nil {
+				return err0
+			}
+			switch chunk.Type {
+			case models.ErrorChunk:
+				return errors.New(chunk.Error)
+			case models.EndChunk:
+				return fn(chunk)
+			default:
+				if err0 := fn(chunk); err0 != nil {
+					return err0
+				}
+			}
+		}
+		if err == io.EOF {
+			return errors.New("unexpected end of chunked query result")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ExecuteExport executes metric query with export format, writes the exported data into writer,
+// returns the number of bytes written.
+func (cli *executeCli) ExecuteExport(param models.ExecuteParam, w io.Writer) (int64, error) {
+	resp, err := cli.cli.R().
+		SetBody(&param).
+		SetDoNotParseResponse(true).
+		Put("/exec")
+	if err != nil {
+		return 0, err
+	}
+	body := resp.RawBody()
+	defer func() {
+		_ = body.Close()
+	}()
+	if resp.StatusCode() != http.StatusOK {
+		data, _ := io.ReadAll(body)
+		return 0, errors.New(string(data))
+	}
+	return io.Copy(w, body)
+}
+
 // ExecuteAsResult executes lin query language, then returns terminal result.
 func (cli *executeCli) ExecuteAsResult(param models.ExecuteParam, rs interface{}) (string, error) {
 	n := time.Now()
@@ -18,7 +18,9 @@
 package client
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -175,3 +177,136 @@ func TestExecuteCli_ExecuteAsResult(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteCli_ExecuteChunked(t *testing.T) {
+	cases := []struct {
+		name    string
+		url     string
+		prepare func(rw http.ResponseWriter)
+		fn      func(chunk *models.ResultChunk) error
+		chunks  int
+		wantErr bool
+	}{
+		{
+			name:    "wrong url",
+			url:     "http://localhost:30001",
+			wantErr: true,
+		},
+		{
+			name: "http status no ok",
+			prepare: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusInternalServerError)
+				_, _ = rw.Write([]byte("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unmarshal chunk failure",
+			prepare: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte("err\n"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "error chunk",
+			prepare: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte(`{"type":"series","series":{}}` + "\n" + `{"type":"error","error":"err"}` + "\n"))
+			},
+			chunks:  1,
+			wantErr: true,
+		},
+		{
+			name: "missing end chunk",
+			prepare: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte(`{"type":"series","series":{}}` + "\n"))
+			},
+			chunks:  1,
+			wantErr: true,
+		},
+		{
+			name: "handle chunk failure",
+			prepare: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte(`{"type":"series","series":{}}` + "\n"))
+			},
+			fn: func(_ *models.ResultChunk) error {
+				return fmt.Errorf("err")
+			},
+			wantErr: true,
+		},
+		{
+			name: "query in chunks successfully",
+			prepare: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte(`{"type":"series","series":{}}` + "\n"))
+				_, _ = rw.Write([]byte(`{"type":"series","series":{}}` + "\n"))
+				_, _ = rw.Write([]byte(`{"type":"end","meta":{"metricName":"cpu"}}`))
+			},
+			chunks: 3,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				param := models.ExecuteParam{}
+				_ = encoding.JSONUnmarshal(readBody(req), &param)
+				assert.True(t, param.Chunked)
+				if tt.prepare != nil {
+					tt.prepare(rw)
+				}
+			}))
+			defer server.Close()
+
+			cli := NewExecuteCli(server.URL)
+			if len(tt.url) > 0 {
+				cli = NewExecuteCli(tt.url)
+			}
+			chunks := 0
+			fn := tt.fn
+			if fn == nil {
+				fn = func(_ *models.ResultChunk) error {
+					chunks++
+					return nil
+				}
+			}
+			err := cli.ExecuteChunked(models.ExecuteParam{SQL: "select f from cpu"}, fn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExecuteChunked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.chunks, chunks)
+		})
+	}
+}
+
+func TestExecuteCli_ExecuteExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		param := models.ExecuteParam{}
+		_ = encoding.JSONUnmarshal(readBody(req), &param)
+		if param.Format != "csv" {
+			rw.WriteHeader(http.StatusInternalServerError)
+			_, _ = rw.Write([]byte("unknown export format"))
+			return
+		}
+		_, _ = rw.Write([]byte("timestamp,f\n10,1\n"))
+	}))
+	defer server.Close()
+
+	cli := NewExecuteCli(server.URL)
+	var buf bytes.Buffer
+	n, err := cli.ExecuteExport(models.ExecuteParam{SQL: "select f from cpu", Format: "csv"}, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t, "timestamp,f\n10,1\n", buf.String())
+
+	n, err = cli.ExecuteExport(models.ExecuteParam{SQL: "select f from cpu", Format: "xml"}, &buf)
+	assert.EqualError(t, err, "unknown export format")
+	assert.Zero(t, n)
+
+	_, err = NewExecuteCli("http://localhost:30001").ExecuteExport(models.ExecuteParam{}, &buf)
+	assert.Error(t, err)
+}
+
+func readBody(req *http.Request) []byte {
+	data, _ := io.ReadAll(req.Body)
+	return data
+}
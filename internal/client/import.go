@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+//go:generate mockgen -source=./import.go -destination=./import_mock.go -package=client
+
+// ImportCli represents bulk import client of broker.
+type ImportCli interface {
+	// Import imports the metric data(flat/proto/influx protocol by content type) into database,
+	// params are the extra query params of request(such as ns/precision etc.).
+	Import(database, contentType string, params map[string]string, data []byte) (*models.ImportResult, error)
+}
+
+// importCli implements ImportCli interface.
+type importCli struct {
+	Base
+}
+
+// NewImportCli creates a bulk import client instance.
+func NewImportCli(endpoint string) ImportCli {
+	cli := NewRestyClient()
+	cli.SetBaseURL(endpoint)
+	return &importCli{
+		Base{
+			cli: cli,
+		}}
+}
+
+// Import imports the metric data(flat/proto/influx protocol by content type) into database.
+func (cli *importCli) Import(database, contentType string, params map[string]string,
+	data []byte) (*models.ImportResult, error) {
+	resp, err := cli.cli.R().
+		SetQueryParams(params).
+		SetQueryParam("db", database).
+		SetHeader("Content-Type", contentType).
+		SetHeader("Accept", "application/json").
+		SetBody(data).
+		Post("/import")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(string(resp.Body()))
+	}
+	rs := &models.ImportResult{}
+	if err := encoding.JSONUnmarshal(resp.Body(), rs); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
@@ -0,0 +1,85 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+func TestImportCli_Import(t *testing.T) {
+	cases := []struct {
+		name    string
+		handle  func(rw http.ResponseWriter)
+		wantErr bool
+	}{
+		{
+			name: "import failure",
+			handle: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusInternalServerError)
+				_, _ = rw.Write([]byte("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unmarshal result failure",
+			handle: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte("abc"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "import successfully",
+			handle: func(rw http.ResponseWriter) {
+				_, _ = rw.Write([]byte(`{"rows":2,"shards":1}`))
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/import", req.URL.Path)
+				assert.Equal(t, "db", req.URL.Query().Get("db"))
+				assert.Equal(t, "ms", req.URL.Query().Get("precision"))
+				assert.Equal(t, constants.ContentTypeInflux, req.Header.Get("Content-Type"))
+				data, _ := io.ReadAll(req.Body)
+				assert.Equal(t, "cpu usage=1 1\n", string(data))
+				tt.handle(rw)
+			}))
+			defer server.Close()
+			cli := NewImportCli(server.URL)
+			rs, err := cli.Import("db", constants.ContentTypeInflux, map[string]string{"precision": "ms"},
+				[]byte("cpu usage=1 1\n"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, &models.ImportResult{Rows: 2, Shards: 1}, rs)
+			}
+		})
+	}
+}
@@ -21,8 +21,6 @@ import (
 	"encoding/json"
 	"sync"
 
-	resty "github.com/go-resty/resty/v2"
-
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -54,7 +52,7 @@ func NewStateMachineCli() StateMachineCli {
 func (cli *stateMachineCli) FetchStateByNode(params map[string]string, node models.Node) (interface{}, error) {
 	address := node.HTTPAddress()
 	var r json.RawMessage
-	_, err := resty.New().R().
+	_, err := NewRestyClient().R().
 		SetQueryParams(params).
 		SetHeader("Accept", "application/json").
 		SetResult(&r).
@@ -0,0 +1,174 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./storage_admin.go -destination=./storage_admin_mock.go -package=client
+
+// for testing
+var (
+	// adminTimeout represents the timeout of admin request which only touches the state of storage node.
+	adminTimeout = 30 * time.Second
+	// fetchSnapshotTimeout represents the timeout of transferring shard's snapshot archive.
+	fetchSnapshotTimeout = 30 * time.Minute
+	// installSnapshotTimeout represents the timeout of installing snapshot, target node fetches the archive before installing.
+	installSnapshotTimeout = fetchSnapshotTimeout + adminTimeout
+)
+
+// StorageAdminCli represents the admin client of storage node.
+type StorageAdminCli interface {
+	// CompactDatabase submits manual compaction/rollup job of database to target node.
+	CompactDatabase(node models.Node, param *models.CompactParam) error
+	// GetReplicaState returns the write ahead log replica state of database from target node.
+	GetReplicaState(node models.Node, database string) ([]models.FamilyLogReplicaState, error)
+	// GetDatabaseUsage returns the resource usage of all databases from target node.
+	GetDatabaseUsage(node models.Node) ([]models.DatabaseUsage, error)
+	// FenceShard fences client writes of shard on target node, returns the write ahead log replica state of database.
+	FenceShard(node models.Node, param *models.FenceParam) ([]models.FamilyLogReplicaState, error)
+	// InstallSnapshot lets target node install shard's family snapshot which copied from source node.
+	InstallSnapshot(node models.Node, param *models.SnapshotParam) error
+	// FetchSnapshot fetches shard's family snapshot(tar archive) from target node, then invokes fn with the archive.
+	FetchSnapshot(node models.Node, database string, shardID models.ShardID, fn func(archive io.Reader) error) error
+}
+
+// storageAdminCli implements StorageAdminCli interface.
+type storageAdminCli struct {
+	timeout time.Duration // timeout of admin request(not include snapshot transfer)
+}
+
+// NewStorageAdminCli creates a storage node admin client instance.
+func NewStorageAdminCli() StorageAdminCli {
+	return NewStorageAdminCliWithTimeout(adminTimeout)
+}
+
+// NewStorageAdminCliWithTimeout creates a storage node admin client instance with the timeout of admin request.
+func NewStorageAdminCliWithTimeout(timeout time.Duration) StorageAdminCli {
+	return &storageAdminCli{timeout: timeout}
+}
+
+// CompactDatabase submits manual compaction/rollup job of database to target node.
+func (cli *storageAdminCli) CompactDatabase(node models.Node, param *models.CompactParam) error {
+	resp, err := NewRestyClient().SetTimeout(cli.timeout).R().
+		SetHeader("Accept", "application/json").
+		SetBody(param).
+		Put(node.HTTPAddress() + constants.APIVersion1CliPath + "/database/compact")
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("submit compaction job to storage node[%s] failure: %s", node.Indicator(), resp.String())
+	}
+	return nil
+}
+
+// GetReplicaState returns the write ahead log replica state of database from target node.
+func (cli *storageAdminCli) GetReplicaState(node models.Node, database string) ([]models.FamilyLogReplicaState, error) {
+	var state []models.FamilyLogReplicaState
+	resp, err := NewRestyClient().SetTimeout(cli.timeout).R().
+		SetHeader("Accept", "application/json").
+		SetQueryParam("db", database).
+		SetResult(&state).
+		Get(node.HTTPAddress() + constants.APIVersion1CliPath + "/state/replica")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get replica state from storage node[%s] failure: %s", node.Indicator(), resp.String())
+	}
+	return state, nil
+}
+
+// GetDatabaseUsage returns the resource usage of all databases from target node.
+func (cli *storageAdminCli) GetDatabaseUsage(node models.Node) ([]models.DatabaseUsage, error) {
+	var usage []models.DatabaseUsage
+	resp, err := NewRestyClient().SetTimeout(cli.timeout).R().
+		SetHeader("Accept", "application/json").
+		SetResult(&usage).
+		Get(node.HTTPAddress() + constants.APIVersion1CliPath + "/state/tsdb/usage")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get database usage from storage node[%s] failure: %s", node.Indicator(), resp.String())
+	}
+	return usage, nil
+}
+
+// FenceShard fences client writes of shard on target node, returns the write ahead log replica state of database.
+func (cli *storageAdminCli) FenceShard(node models.Node, param *models.FenceParam) ([]models.FamilyLogReplicaState, error) {
+	var state []models.FamilyLogReplicaState
+	resp, err := NewRestyClient().SetTimeout(cli.timeout).R().
+		SetHeader("Accept", "application/json").
+		SetBody(param).
+		SetResult(&state).
+		Put(node.HTTPAddress() + constants.APIVersion1CliPath + "/database/shard/fence")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("fence shard on storage node[%s] failure: %s", node.Indicator(), resp.String())
+	}
+	return state, nil
+}
+
+// InstallSnapshot lets target node install shard's family snapshot which copied from source node.
+func (cli *storageAdminCli) InstallSnapshot(node models.Node, param *models.SnapshotParam) error {
+	resp, err := NewRestyClient().SetTimeout(installSnapshotTimeout).R().
+		SetHeader("Accept", "application/json").
+		SetBody(param).
+		Put(node.HTTPAddress() + constants.APIVersion1CliPath + "/database/snapshot/install")
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("install snapshot on storage node[%s] failure: %s", node.Indicator(), resp.String())
+	}
+	return nil
+}
+
+// FetchSnapshot fetches shard's family snapshot(tar archive) from target node, then invokes fn with the archive.
+func (cli *storageAdminCli) FetchSnapshot(node models.Node, database string, shardID models.ShardID,
+	fn func(archive io.Reader) error,
+) error {
+	resp, err := NewRestyClient().SetTimeout(fetchSnapshotTimeout).R().
+		SetQueryParam("database", database).
+		SetQueryParam("shardID", strconv.Itoa(shardID.Int())).
+		SetDoNotParseResponse(true).
+		Get(node.HTTPAddress() + constants.APIVersion1CliPath + "/database/snapshot")
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer func() {
+		_ = body.Close()
+	}()
+	if resp.IsError() {
+		data, _ := io.ReadAll(body)
+		return fmt.Errorf("fetch snapshot from storage node[%s] failure: %s", node.Indicator(), string(data))
+	}
+	return fn(body)
+}
@@ -0,0 +1,244 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestStorageAdminCli_CompactDatabase(t *testing.T) {
+	cases := []struct {
+		name    string
+		port    int
+		prepare func(rw http.ResponseWriter)
+		wantErr bool
+	}{
+		{
+			name: "submit failure",
+			prepare: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "submit successfully",
+			prepare: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusOK)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "url wrong",
+			port:    30001,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, http.MethodPut, req.Method)
+				assert.Equal(t, "/api/v1/database/compact", req.URL.Path)
+				if tt.prepare != nil {
+					tt.prepare(rw)
+				}
+			}))
+			defer server.Close()
+			port := strings.Split(server.URL, ":")[2]
+			cli := NewStorageAdminCli()
+			p, _ := strconv.Atoi(port)
+			if tt.port > 0 {
+				p = tt.port
+			}
+			err := cli.CompactDatabase(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(p)},
+				&models.CompactParam{Type: models.CompactTypeCompact, Database: "db"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompactDatabase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStorageAdminCli_GetReplicaState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/v1/state/replica", req.URL.Path)
+		if req.URL.Query().Get("db") == "err" {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`[{"shardId":1,"append":10}]`))
+	}))
+	defer server.Close()
+	node := newTestNode(t, server.URL)
+	cli := NewStorageAdminCli()
+	state, err := cli.GetReplicaState(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.FamilyLogReplicaState{{ShardID: 1, Append: 10}}, state)
+	state, err = cli.GetReplicaState(node, "err")
+	assert.Error(t, err)
+	assert.Nil(t, state)
+	state, err = cli.GetReplicaState(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 30001}, "db")
+	assert.Error(t, err)
+	assert.Nil(t, state)
+}
+
+func TestStorageAdminCli_GetDatabaseUsage(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/usage", req.URL.Path)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		_, _ = rw.Write([]byte(`[{"database":"db","shards":[{"shardId":1,"numOfSeries":10,"diskBytes":100}]}]`))
+	}))
+	defer server.Close()
+	node := newTestNode(t, server.URL)
+	cli := NewStorageAdminCli()
+	usage, err := cli.GetDatabaseUsage(node)
+	assert.NoError(t, err)
+	assert.Len(t, usage, 1)
+	assert.Equal(t, int64(100), usage[0].Shards[0].DiskBytes)
+	status = http.StatusInternalServerError
+	usage, err = cli.GetDatabaseUsage(node)
+	assert.Error(t, err)
+	assert.Nil(t, usage)
+	usage, err = cli.GetDatabaseUsage(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 30001})
+	assert.Error(t, err)
+	assert.Nil(t, usage)
+}
+
+func TestStorageAdminCli_FenceShard(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/api/v1/database/shard/fence", req.URL.Path)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		_, _ = rw.Write([]byte(`[{"shardId":1,"append":10}]`))
+	}))
+	defer server.Close()
+	node := newTestNode(t, server.URL)
+	cli := NewStorageAdminCli()
+	param := &models.FenceParam{Database: "db", ShardID: 1, Lease: 1000}
+	state, err := cli.FenceShard(node, param)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.FamilyLogReplicaState{{ShardID: 1, Append: 10}}, state)
+	status = http.StatusInternalServerError
+	state, err = cli.FenceShard(node, param)
+	assert.Error(t, err)
+	assert.Nil(t, state)
+	state, err = cli.FenceShard(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 30001}, param)
+	assert.Error(t, err)
+	assert.Nil(t, state)
+}
+
+func TestStorageAdminCli_InstallSnapshot(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/api/v1/database/snapshot/install", req.URL.Path)
+		rw.WriteHeader(status)
+	}))
+	defer server.Close()
+	node := newTestNode(t, server.URL)
+	cli := NewStorageAdminCli()
+	param := &models.SnapshotParam{Database: "db", ShardID: 1}
+	assert.NoError(t, cli.InstallSnapshot(node, param))
+	status = http.StatusInternalServerError
+	assert.Error(t, cli.InstallSnapshot(node, param))
+	assert.Error(t, cli.InstallSnapshot(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 30001}, param))
+}
+
+func TestStorageAdminCli_FetchSnapshot(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/v1/database/snapshot", req.URL.Path)
+		assert.Equal(t, "db", req.URL.Query().Get("database"))
+		assert.Equal(t, "1", req.URL.Query().Get("shardID"))
+		rw.WriteHeader(status)
+		_, _ = rw.Write([]byte("archive"))
+	}))
+	defer server.Close()
+	node := newTestNode(t, server.URL)
+	cli := NewStorageAdminCli()
+	var archive []byte
+	assert.NoError(t, cli.FetchSnapshot(node, "db", 1, func(r io.Reader) (err error) {
+		archive, err = io.ReadAll(r)
+		return err
+	}))
+	assert.Equal(t, "archive", string(archive))
+	status = http.StatusInternalServerError
+	assert.Error(t, cli.FetchSnapshot(node, "db", 1, func(r io.Reader) error {
+		return nil
+	}))
+	assert.Error(t, cli.FetchSnapshot(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 30001}, "db", 1,
+		func(r io.Reader) error {
+			return nil
+		}))
+}
+
+func newTestNode(t *testing.T, url string) models.Node {
+	port, err := strconv.Atoi(strings.Split(url, ":")[2])
+	assert.NoError(t, err)
+	return &models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(port)}
+}
+
+func TestStorageAdminCli_Timeout(t *testing.T) {
+	defer func() {
+		adminTimeout = 30 * time.Second
+		fetchSnapshotTimeout = 30 * time.Minute
+		installSnapshotTimeout = fetchSnapshotTimeout + adminTimeout
+	}()
+	adminTimeout = 10 * time.Millisecond
+	fetchSnapshotTimeout = 10 * time.Millisecond
+	installSnapshotTimeout = 10 * time.Millisecond
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-done
+	}))
+	defer func() {
+		close(done)
+		server.Close()
+	}()
+	node := newTestNode(t, server.URL)
+	_, err := NewStorageAdminCliWithTimeout(10*time.Millisecond).GetReplicaState(node, "db")
+	assert.Error(t, err)
+	cli := NewStorageAdminCli()
+	assert.Error(t, cli.CompactDatabase(node, &models.CompactParam{Type: models.CompactTypeCompact, Database: "db"}))
+	_, err = cli.GetReplicaState(node, "db")
+	assert.Error(t, err)
+	_, err = cli.GetDatabaseUsage(node)
+	assert.Error(t, err)
+	_, err = cli.FenceShard(node, &models.FenceParam{Database: "db", ShardID: 1})
+	assert.Error(t, err)
+	assert.Error(t, cli.InstallSnapshot(node, &models.SnapshotParam{Database: "db", ShardID: 1}))
+	assert.Error(t, cli.FetchSnapshot(node, "db", 1, func(r io.Reader) error {
+		return nil
+	}))
+}
@@ -28,6 +28,7 @@ import (
 // Get will resets the underlying delta value
 type BoundCounter struct {
 	delta     atomic.Float64
+	total     atomic.Float64 // cumulative value, never be reset by gather
 	fieldName string
 }
 
@@ -40,21 +41,25 @@ func newCounter(fieldName string) *BoundCounter {
 // Incr increments c.
 func (c *BoundCounter) Incr() {
 	c.delta.Add(1)
+	c.total.Add(1)
 }
 
 // Decr decrements g.
 func (c *BoundCounter) Decr() {
 	c.delta.Sub(1)
+	c.total.Sub(1)
 }
 
 // Add adds v to c.
 func (c *BoundCounter) Add(v float64) {
 	c.delta.Add(v)
+	c.total.Add(v)
 }
 
 // Sub subs v to c.
 func (c *BoundCounter) Sub(v float64) {
 	c.delta.Sub(v)
+	c.total.Sub(v)
 }
 
 // Get returns the current delta counter value
@@ -62,6 +67,11 @@ func (c *BoundCounter) Get() float64 {
 	return c.delta.Load()
 }
 
+// Total returns the cumulative counter value which isn't reset by gather.
+func (c *BoundCounter) Total() float64 {
+	return c.total.Load()
+}
+
 // gather returns the current cumulative counter value
 // and resets the delta value by spin lock.
 func (c *BoundCounter) gather() float64 {
@@ -44,4 +44,8 @@ func Test_Counter(t *testing.T) {
 	assert.Equal(t, float64(100), c1.gather())
 	// reset
 	assert.Equal(t, float64(0), c1.Get())
+	// total isn't reset by gather
+	assert.Equal(t, float64(100), c1.Total())
+	c1.Incr()
+	assert.Equal(t, float64(101), c1.Total())
 }
@@ -100,6 +100,14 @@ func (h *BoundHistogram) Update(f func()) {
 	h.UpdateSince(start)
 }
 
+// snapshot returns the upper bounds, cumulative count of each bucket, total count and sum.
+func (h *BoundHistogram) snapshot() (upperBounds, values []float64, count, sum float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return cloneFloat64Slice(h.bkts.upperBounds), cloneFloat64Slice(h.bkts.values), h.bkts.totalCount, h.bkts.totalSum
+}
+
 func (h *BoundHistogram) marshalToCompoundField(builder *commonseries.RowBuilder) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -0,0 +1,207 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linmetric
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+
+	"github.com/lindb/lindb/series/tag"
+)
+
+const (
+	openMetricsCounter   = "counter"
+	openMetricsGauge     = "gauge"
+	openMetricsHistogram = "histogram"
+)
+
+// openMetricsFamily represents a metric family of OpenMetrics, which has same name and type.
+type openMetricsFamily struct {
+	metricType string
+	samples    []string
+}
+
+// WriteOpenMetrics writes all metrics of registry in OpenMetrics text format.
+//  1. counter is exported as cumulative value with _total suffix;
+//  2. gauge/min/max are exported as gauge;
+//  3. histogram is exported as cumulative buckets(in milliseconds) with _count and _sum.
+func (r *Registry) WriteOpenMetrics(w io.Writer) error {
+	var buffer []*taggedSeries
+	r.mu.RLock()
+	for _, s := range r.series {
+		buffer = append(buffer, s)
+	}
+	r.mu.RUnlock()
+	// keep series in order for stable output
+	sort.Slice(buffer, func(i, j int) bool {
+		return buffer[i].seriesID < buffer[j].seriesID
+	})
+
+	families := make(map[string]*openMetricsFamily)
+	for _, s := range buffer {
+		s.collectOpenMetrics(families)
+	}
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		family := families[name]
+		buf.WriteString("# TYPE ")
+		buf.WriteString(name)
+		buf.WriteByte(' ')
+		buf.WriteString(family.metricType)
+		buf.WriteByte('\n')
+		// histogram's samples(bucket/count/sum) of same series must keep in order
+		if family.metricType != openMetricsHistogram {
+			sort.Strings(family.samples)
+		}
+		for _, sample := range family.samples {
+			buf.WriteString(sample)
+		}
+	}
+	buf.WriteString("# EOF\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// collectOpenMetrics collects the samples of series into metric families.
+func (s *taggedSeries) collectOpenMetrics(families map[string]*openMetricsFamily) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.payload == nil {
+		return
+	}
+	labels := formatOpenMetricsLabels(s.tags)
+	for _, sf := range s.payload.simpleFields {
+		name := sanitizeOpenMetricsName(s.metricName + "_" + sf.name())
+		metricType := openMetricsGauge
+		suffix := ""
+		value := sf.Get()
+		if sf.flatType() == flatMetricsV1.SimpleFieldTypeDeltaSum {
+			metricType = openMetricsCounter
+			name = strings.TrimSuffix(name, "_total")
+			suffix = "_total"
+			if c, ok := sf.(*BoundCounter); ok {
+				value = c.Total()
+			}
+		}
+		family := getOpenMetricsFamily(families, name, metricType)
+		if family == nil {
+			continue
+		}
+		family.samples = append(family.samples, formatOpenMetricsSample(name+suffix, labels, value))
+	}
+	if s.payload.histogramDelta != nil {
+		name := sanitizeOpenMetricsName(s.metricName)
+		family := getOpenMetricsFamily(families, name, openMetricsHistogram)
+		if family == nil {
+			return
+		}
+		upperBounds, values, count, sum := s.payload.histogramDelta.snapshot()
+		cumulative := 0.0
+		for idx, upper := range upperBounds {
+			cumulative += values[idx]
+			le := formatOpenMetricsLabels(s.tags, "le", strconv.FormatFloat(upper, 'g', -1, 64))
+			family.samples = append(family.samples, formatOpenMetricsSample(name+"_bucket", le, cumulative))
+		}
+		family.samples = append(family.samples,
+			formatOpenMetricsSample(name+"_count", labels, count),
+			formatOpenMetricsSample(name+"_sum", labels, sum),
+		)
+	}
+}
+
+// getOpenMetricsFamily returns the metric family by name, creates it if not exist,
+// returns nil if the family exists with another metric type.
+func getOpenMetricsFamily(families map[string]*openMetricsFamily, name, metricType string) *openMetricsFamily {
+	family, ok := families[name]
+	if !ok {
+		family = &openMetricsFamily{metricType: metricType}
+		families[name] = family
+	}
+	if family.metricType != metricType {
+		return nil
+	}
+	return family
+}
+
+// formatOpenMetricsSample formats a sample line.
+func formatOpenMetricsSample(name, labels string, value float64) string {
+	return name + labels + " " + strconv.FormatFloat(value, 'g', -1, 64) + "\n"
+}
+
+// formatOpenMetricsLabels formats the tags(in order by key, with extra label pairs) as label set.
+func formatOpenMetricsLabels(tags tag.Tags, extra ...string) string {
+	if len(tags) == 0 && len(extra) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	writeLabel := func(key, value string) {
+		if sb.Len() > 1 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(sanitizeOpenMetricsName(key))
+		sb.WriteString(`="`)
+		sb.WriteString(escapeOpenMetricsLabelValue(value))
+		sb.WriteByte('"')
+	}
+	// keep labels in order by key
+	sorted := make(tag.Tags, len(tags))
+	copy(sorted, tags)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Key, sorted[j].Key) < 0
+	})
+	for _, t := range sorted {
+		writeLabel(string(t.Key), string(t.Value))
+	}
+	for i := 0; i+1 < len(extra); i += 2 {
+		writeLabel(extra[i], extra[i+1])
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// sanitizeOpenMetricsName replaces the invalid characters of metric/label name with '_'.
+func sanitizeOpenMetricsName(name string) string {
+	b := []byte(name)
+	for idx, c := range b {
+		valid := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9' && idx > 0)
+		if !valid {
+			b[idx] = '_'
+		}
+	}
+	return string(b)
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeOpenMetricsLabelValue escapes backslash, double-quote and line feed of label value.
+func escapeOpenMetricsLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linmetric
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockWriter struct{}
+
+func (w *mockWriter) Write(_ []byte) (int, error) {
+	return 0, fmt.Errorf("err")
+}
+
+func TestRegistry_WriteOpenMetrics(t *testing.T) {
+	r := &Registry{
+		series: make(map[uint64]*taggedSeries),
+	}
+	// empty
+	var buf bytes.Buffer
+	assert.NoError(t, r.WriteOpenMetrics(&buf))
+	assert.Equal(t, "# EOF\n", buf.String())
+
+	scope := r.NewScope("lindb.test", "node", `a"b\c`)
+	c := scope.NewCounter("writes")
+	c.Add(10)
+	c.gather() // pushed by native pusher
+	c.Incr()
+	scope.NewCounter("failure_total").Incr()
+	scope.NewGauge("active-readers").Update(3)
+	scope.NewMax("max").Update(5)
+	scope.NewMin("min").Update(1)
+	scope.NewCounterVec("vec", "type").WithTagValues("flush").Add(2)
+	h := scope.Scope("duration").NewHistogram().WithLinearBuckets(time.Millisecond, 3*time.Millisecond, 4)
+	h.UpdateDuration(time.Millisecond)
+	h.UpdateDuration(2 * time.Millisecond)
+	h.UpdateDuration(time.Second)
+	// conflict type with counter, ignore
+	r.NewScope("lindb.test", "node", "other").NewGauge("writes").Update(1)
+	r.NewScope("lindb.empty")
+
+	buf.Reset()
+	assert.NoError(t, r.WriteOpenMetrics(&buf))
+	rs := buf.String()
+	labels := `{node="a\"b\\c"}`
+	for _, line := range []string{
+		"# TYPE lindb_test_writes counter\n",
+		"lindb_test_writes_total" + labels + " 11\n",
+		"# TYPE lindb_test_failure counter\n",
+		"lindb_test_failure_total" + labels + " 1\n",
+		"# TYPE lindb_test_active_readers gauge\n",
+		"lindb_test_active_readers" + labels + " 3\n",
+		"lindb_test_max" + labels + " 5\n",
+		"lindb_test_min" + labels + " 1\n",
+		`lindb_test_vec_total{node="a\"b\\c",type="flush"} 2` + "\n",
+		"# TYPE lindb_test_duration histogram\n",
+		`lindb_test_duration_bucket{node="a\"b\\c",le="1"} 1` + "\n",
+		`lindb_test_duration_bucket{node="a\"b\\c",le="2"} 2` + "\n",
+		`lindb_test_duration_bucket{node="a\"b\\c",le="+Inf"} 3` + "\n",
+		"lindb_test_duration_count" + labels + " 3\n",
+		"lindb_test_duration_sum" + labels + " 1003\n",
+	} {
+		assert.Contains(t, rs, line)
+	}
+	assert.NotContains(t, rs, `node="other"`)
+	assert.NotContains(t, rs, "lindb_empty")
+	assert.True(t, strings.HasSuffix(rs, "# EOF\n"))
+
+	assert.Error(t, r.WriteOpenMetrics(&mockWriter{}))
+}
+
+func Test_sanitizeOpenMetricsName(t *testing.T) {
+	assert.Equal(t, "lindb_kv_flush", sanitizeOpenMetricsName("lindb.kv.flush"))
+	assert.Equal(t, "_node", sanitizeOpenMetricsName("1node"))
+	assert.Equal(t, "a1_b", sanitizeOpenMetricsName("a1-b"))
+}
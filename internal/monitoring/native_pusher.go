@@ -27,6 +27,7 @@ import (
 	"github.com/klauspost/compress/gzip"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/series/tag"
@@ -51,6 +52,7 @@ type nativeProtoPusher struct {
 	cancel          context.CancelFunc
 	interval        time.Duration
 	endpoint        string // HTTP endpoint
+	token           string // api token for authentication
 	globalKeyValues tag.Tags
 	gather          linmetric.Gather
 	client          *http.Client
@@ -64,10 +66,11 @@ type nativeProtoPusher struct {
 	}
 }
 
-// NewNativeProtoPusher creates a new native pusher
+// NewNativeProtoPusher creates a new native pusher, token is used for authentication if not empty.
 func NewNativeProtoPusher(
 	ctx context.Context,
 	endpoint string,
+	token string,
 	interval time.Duration,
 	pushTimeout time.Duration,
 	r *linmetric.Registry,
@@ -78,13 +81,14 @@ func NewNativeProtoPusher(
 		ctx:             c,
 		cancel:          cancel,
 		endpoint:        endpoint,
+		token:           token,
 		interval:        interval,
 		globalKeyValues: globalKeyValues,
 		gather: r.NewGather(
 			linmetric.WithReadRuntimeOption(newRuntimeObserver(r)),
 			linmetric.WithGlobalKeyValueOption(globalKeyValues),
 		),
-		client: &http.Client{Timeout: pushTimeout},
+		client: &http.Client{Timeout: pushTimeout, Transport: client.NewTransport()},
 		buffer: &bytes.Buffer{},
 	}
 
@@ -137,6 +141,9 @@ func (np *nativeProtoPusher) push(r io.Reader) {
 	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPut, np.endpoint, r)
 	req.Header.Set("Content-Encoding", "gzip")
 	req.Header.Set("Content-Type", constants.ContentTypeFlat)
+	if np.token != "" {
+		req.Header.Set("Authorization", "Bearer "+np.token)
+	}
 
 	resp, err := np.client.Do(req)
 	defer func() {
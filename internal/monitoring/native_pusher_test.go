@@ -18,10 +18,15 @@
 package monitoring
 
 import (
+	"bytes"
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/lindb/lindb/internal/linmetric"
 )
 
@@ -29,6 +34,7 @@ func Test_NativeProtoPusher(t *testing.T) {
 	pusher := NewNativeProtoPusher(
 		context.Background(),
 		"http://localhost:12345",
+		"",
 		time.Millisecond*100,
 		time.Millisecond,
 		linmetric.BrokerRegistry,
@@ -40,3 +46,27 @@ func Test_NativeProtoPusher(t *testing.T) {
 
 	pusher.(*nativeProtoPusher).push(nil)
 }
+
+func Test_NativeProtoPusher_token(t *testing.T) {
+	authorization := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case authorization <- r.Header.Get("Authorization"):
+		default:
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	pusher := NewNativeProtoPusher(
+		context.Background(),
+		server.URL,
+		"lin_id_secret",
+		time.Millisecond*100,
+		time.Second,
+		linmetric.BrokerRegistry,
+		nil,
+	)
+	pusher.(*nativeProtoPusher).push(bytes.NewReader([]byte("data")))
+	assert.Equal(t, "Bearer lin_id_secret", <-authorization)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./compact_job.go -destination=./compact_job_mock.go -package kv
@@ -161,6 +162,10 @@ func (c *compactJob) doMerge() error {
 		// set previous merge key
 		previousKey = key
 	}
+	if err := it.Err(); err != nil {
+		// input file is broken, abort compaction
+		return err
+	}
 
 	// if has pending merge values after iterator, need do merge
 	if len(needMerge) > 0 {
@@ -289,7 +294,7 @@ func (cf *compactFlusher) StreamWriter() (table.StreamWriter, error) {
 	if err := cf.beforeAdd(); err != nil {
 		return nil, err
 	}
-	sw := cf.compactJob.state.builder.StreamWriter()
+	sw := newLimitedStreamWriter(cf.compactJob.state.builder.StreamWriter(), cf.compactJob.compactType)
 	// hooks stream writer with compaction processing checkers
 	cf.streamWriter = &compactFlusherStreamWriter{
 		compactFlusher: cf,
@@ -329,6 +334,7 @@ func (cf *compactFlusher) Add(key uint32, value []byte) error {
 	if err := cf.beforeAdd(); err != nil {
 		return err
 	}
+	getIOLimiter().Wait(cf.compactJob.compactType, len(value))
 	// add key/value into store builder
 	if err := cf.compactJob.state.builder.Add(key, value); err != nil {
 		return err
@@ -343,6 +349,14 @@ func (cf *compactFlusher) Sequence(_ int32, _ int64) {
 	// do nothing
 }
 
+func (cf *compactFlusher) Import(_ uint64) {
+	// do nothing
+}
+
+func (cf *compactFlusher) Rollup(_ ...timeutil.Interval) {
+	// do nothing
+}
+
 func (cf *compactFlusher) Commit() error {
 	panic("Commit is not allowed to call for CompactFlusher")
 }
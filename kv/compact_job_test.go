@@ -364,6 +364,7 @@ func generateMockFamily(ctrl *gomock.Controller, merger NewMerger) *MockFamily {
 
 func generateIterator(ctrl *gomock.Controller, values map[uint32][]byte) table.Iterator {
 	it1 := table.NewMockIterator(ctrl)
+	it1.EXPECT().Err().Return(nil).AnyTimes()
 	var keys []uint32
 	for key := range values {
 		keys = append(keys, key)
@@ -51,6 +51,12 @@ type Family interface {
 	GetSnapshot() version.Snapshot
 	// Compact compacts all files of level0.
 	Compact()
+	// ForceCompact compacts all files of level0 regardless of compact threshold,
+	// such as full compaction by manual after bulk backfill,
+	// the request is queued if compaction job is running, and runs after that job completed.
+	ForceCompact()
+	// GetState returns the compaction state of family.
+	GetState() FamilyState
 
 	getStore() Store
 	// familyInfo return family info
@@ -78,12 +84,27 @@ type Family interface {
 	rollup()
 	// doRollupWork does rollup job, merge source family data to target family.
 	doRollupWork(sourceFamily Family, rollup Rollup, sourceFiles []table.FileNumber) (err error)
+	// offload does offload job, uploads fully compacted files to cold tier storage.
+	offload()
+	// backup copies the files of current version into target path, returns the edit log of current version.
+	backup(targetPath string) (version.EditLog, error)
 	// deleteObsoleteFiles deletes obsolete files.
 	deleteObsoleteFiles()
 	// close family, need wait background job completed then releases resource.
 	close()
 }
 
+// FamilyState represents the compaction state of family.
+type FamilyState struct {
+	Name             string `json:"name"`
+	Compacting       bool   `json:"compacting"`
+	Rolluping        bool   `json:"rolluping"`
+	NumOfL0Files     int    `json:"numOfL0Files"`
+	NumOfFiles       int    `json:"numOfFiles"`
+	NumOfRollupFiles int    `json:"numOfRollupFiles"`
+	Size             int64  `json:"size"`
+}
+
 // family implements Family interface
 type family struct {
 	store         Store
@@ -93,6 +114,7 @@ type family struct {
 	merger        NewMerger
 	familyVersion version.FamilyVersion
 	maxFileSize   uint32
+	compression   table.CompressionType
 
 	pendingOutputs    sync.Map // keep all pending output files, includes flush/compact/rollup.
 	newCompactJobFunc func(family Family, state *compactionState, rollup Rollup) CompactJob
@@ -100,6 +122,8 @@ type family struct {
 	rolluping      atomic.Bool
 	lastRollupTime *atomic.Int64
 	compacting     atomic.Bool
+	forceCompact   atomic.Bool // force compaction request queued
+	offloading     atomic.Bool
 
 	condition sync.WaitGroup // compact/rollup job if it's doing
 }
@@ -123,6 +147,10 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 	if option.MaxFileSize > 0 {
 		maxFileSize = option.MaxFileSize
 	}
+	compression, err := table.ParseCompressionType(option.Compression)
+	if err != nil {
+		return nil, fmt.Errorf("compression of family option is invalid, error:%w", err)
+	}
 
 	f := &family{
 		familyPath:        familyPath,
@@ -131,6 +159,7 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 		option:            option,
 		merger:            merger,
 		maxFileSize:       maxFileSize,
+		compression:       compression,
 		newCompactJobFunc: newCompactJobFunc,
 		familyVersion:     store.createFamilyVersion(name, version.FamilyID(option.ID)),
 		lastRollupTime:    atomic.NewInt64(timeutil.Now()),
@@ -176,7 +205,7 @@ func (f *family) familyInfo() string {
 func (f *family) newTableBuilder() (table.Builder, error) {
 	fileNumber := f.store.nextFileNumber()
 	fileName := filepath.Join(f.familyPath, version.Table(fileNumber))
-	return table.NewStoreBuilder(fileNumber, fileName)
+	return table.NewCompressedStoreBuilder(fileNumber, fileName, f.compression)
 }
 
 // commitEditLog persists edit logs into manifest file.
@@ -232,17 +261,67 @@ func (f *family) needCompact() bool {
 	return false
 }
 
+// ForceCompact compacts all files of level0 regardless of compact threshold,
+// such as full compaction by manual after bulk backfill.
+// If compaction job is running, the request is queued, and runs after that job completed.
+func (f *family) ForceCompact() {
+	snapshot := f.GetSnapshot()
+	numberOfFiles := snapshot.GetCurrent().NumberOfFilesInLevel(0)
+	snapshot.Close()
+
+	if numberOfFiles > 0 {
+		// NOTICE: mark request before trying, running job checks the mark after it completed.
+		f.forceCompact.Store(true)
+		f.doCompact(1)
+	}
+}
+
+// GetState returns the compaction state of family.
+func (f *family) GetState() FamilyState {
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	files := current.GetAllFiles()
+	size := int64(0)
+	for _, file := range files {
+		size += int64(file.GetFileSize())
+	}
+	return FamilyState{
+		Name:             f.name,
+		Compacting:       f.compacting.Load(),
+		Rolluping:        f.rolluping.Load(),
+		NumOfL0Files:     current.NumberOfFilesInLevel(0),
+		NumOfFiles:       len(files),
+		NumOfRollupFiles: len(current.GetRollupFiles()),
+		Size:             size,
+	}
+}
+
 // compact does compact job if it hasn't compact job running.
 func (f *family) compact() {
+	f.doCompact(f.option.CompactThreshold)
+}
+
+// doCompact does compact job with level0 compact threshold if it hasn't compact job running,
+// compacts all files of level0 if force compaction request is queued.
+func (f *family) doCompact(compactThreshold int) {
 	if f.compacting.CAS(false, true) {
+		if f.forceCompact.CAS(true, false) {
+			compactThreshold = 1
+		}
 		f.condition.Add(1)
 		go func() {
 			defer func() {
-				f.condition.Done()
 				f.compacting.Store(false)
+				if f.forceCompact.Load() {
+					// run queued force compaction request
+					f.doCompact(1)
+				}
+				f.condition.Done()
 			}()
 
-			if err := f.backgroundCompactionJob(); err != nil {
+			if err := f.backgroundCompactionJob(compactThreshold); err != nil {
 				kvLogger.Error("do compact job error",
 					logger.String("family", f.familyInfo()), logger.Error(err), logger.Stack())
 			}
@@ -251,7 +330,7 @@ func (f *family) compact() {
 }
 
 // backgroundCompactionJob runs compact job in background goroutine
-func (f *family) backgroundCompactionJob() error {
+func (f *family) backgroundCompactionJob(compactThreshold int) error {
 	snapshot := f.GetSnapshot()
 	defer func() {
 		snapshot.Close()
@@ -259,7 +338,7 @@ func (f *family) backgroundCompactionJob() error {
 		f.deleteObsoleteFiles()
 	}()
 
-	compaction := snapshot.GetCurrent().PickL0Compaction(f.option.CompactThreshold)
+	compaction := snapshot.GetCurrent().PickL0Compaction(compactThreshold)
 	if compaction == nil {
 		// no compaction job need to do
 		return nil
@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// for testing
+var (
+	linkOrCopyFunc = fileutil.LinkOrCopy
+)
+
+// backup pins current version, then hard-links(copies if link fails) the files of version into target path,
+// offloaded files are fetched from cold tier storage. Files are immutable and the pinned version keeps them
+// alive, so it doesn't block flush/compaction job.
+func (f *family) backup(targetPath string) (version.EditLog, error) {
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+
+	if err := mkDirFunc(targetPath); err != nil {
+		return nil, fmt.Errorf("create family backup path error:%s", err)
+	}
+	current := snapshot.GetCurrent()
+	files := make(map[table.FileNumber]struct{})
+	for _, file := range current.GetAllFiles() {
+		files[file.GetFileNumber()] = struct{}{}
+	}
+	// rollup files maybe not alive in current version, but rollup job need read them.
+	for file := range current.GetRollupFiles() {
+		files[file] = struct{}{}
+	}
+	objectStore, _ := f.store.getTier()
+	for fileNumber := range files {
+		fileName := version.Table(fileNumber)
+		src := filepath.Join(f.familyPath, fileName)
+		dst := filepath.Join(targetPath, fileName)
+		if !fileutil.Exist(src) && objectStore != nil {
+			if err := objectStore.Download(f.store.tieredKey(f.name, fileName), dst); err != nil {
+				return nil, fmt.Errorf("fetch file[%s] from cold tier storage for backup error:%w", src, err)
+			}
+			continue
+		}
+		if err := linkOrCopyFunc(src, dst); err != nil {
+			return nil, fmt.Errorf("backup file[%s] error:%w", src, err)
+		}
+	}
+	kvLogger.Info("backup family successfully",
+		logger.String("family", f.familyInfo()), logger.String("target", targetPath),
+		logger.Int("files", len(files)))
+	return version.NewBackupEditLog(f.ID(), current), nil
+}
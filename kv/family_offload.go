@@ -0,0 +1,165 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/tier"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// for testing
+var (
+	statFunc = os.Stat
+	nowFunc  = time.Now
+)
+
+// offload does offload job if it hasn't offload job running, it doesn't block compaction job,
+// because offloaded files are fetched from cold tier storage lazily when compaction reads them.
+func (f *family) offload() {
+	objectStore, _ := f.store.getTier()
+	if objectStore == nil {
+		return
+	}
+	if f.offloading.CAS(false, true) {
+		f.condition.Add(1)
+		go func() {
+			defer func() {
+				f.condition.Done()
+				f.offloading.Store(false)
+			}()
+
+			if err := f.backgroundOffloadJob(objectStore); err != nil {
+				kvLogger.Error("do offload job error",
+					logger.String("family", f.familyInfo()), logger.Error(err))
+			}
+		}()
+	}
+}
+
+// backgroundOffloadJob uploads the files of fully compacted family which have not been modified for a long time,
+// then removes local files, file will be fetched lazily by table cache when read it.
+func (f *family) backgroundOffloadJob(objectStore tier.ObjectStore) error {
+	_, offloadAfter := f.store.getTier()
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	// level0 files or rollup source files will be changed/read by compact/rollup job soon, skip it.
+	if current.NumberOfFilesInLevel(0) > 0 || len(current.GetRollupFiles()) > 0 {
+		return nil
+	}
+	now := nowFunc()
+	editLog := version.NewEditLog(f.ID())
+	type offloadFile struct {
+		fileNumber table.FileNumber
+		path       string
+	}
+	var localFiles []offloadFile
+	numOfLevels := f.store.Option().Levels
+	for level := 1; level < numOfLevels; level++ {
+		for _, file := range current.GetFiles(level) {
+			fileNumber := file.GetFileNumber()
+			fileName := version.Table(fileNumber)
+			path := filepath.Join(f.familyPath, fileName)
+			stat, err := statFunc(path)
+			if err != nil {
+				// file already offloaded, not exist in local disk
+				continue
+			}
+			if now.Sub(stat.ModTime()) < offloadAfter {
+				continue
+			}
+			if !current.IsOffloadFile(fileNumber) {
+				if err := objectStore.Upload(f.store.tieredKey(f.name, fileName), path); err != nil {
+					metrics.TierStatistics.UploadFailures.Incr()
+					return fmt.Errorf("upload file[%s] to cold tier storage error:%w", path, err)
+				}
+				metrics.TierStatistics.Uploads.Incr()
+				editLog.Add(version.CreateOffloadFile(fileNumber))
+			}
+			localFiles = append(localFiles, offloadFile{fileNumber: fileNumber, path: path})
+		}
+	}
+	if !editLog.IsEmpty() && !f.commitEditLog(editLog) {
+		return fmt.Errorf("commit offload edit log failure")
+	}
+	// remove local files after offload marks persisted, readers which mapped the file can still read it.
+	// file compacted concurrently isn't marked as offloaded, it is removed by compaction job.
+	latest := f.GetSnapshot()
+	defer latest.Close()
+	for _, localFile := range localFiles {
+		if !latest.GetCurrent().IsOffloadFile(localFile.fileNumber) {
+			continue
+		}
+		path := localFile.path
+		if err := removeFunc(path); err != nil {
+			kvLogger.Warn("remove offloaded local file failure",
+				logger.String("family", f.familyInfo()), logger.String("file", path), logger.Error(err))
+			continue
+		}
+		kvLogger.Info("offload file to cold tier storage successfully",
+			logger.String("family", f.familyInfo()), logger.String("file", path))
+	}
+	f.deleteObsoleteObjects(objectStore)
+	return nil
+}
+
+// deleteObsoleteObjects deletes the objects of cold tier storage which are not alive in family,
+// for example, offloaded file was compacted with new level0 files.
+func (f *family) deleteObsoleteObjects(objectStore tier.ObjectStore) {
+	prefix := f.store.tieredKey(f.name, "")
+	keys, err := objectStore.List(prefix)
+	if err != nil {
+		kvLogger.Error("list cold tier files fail when delete obsolete objects",
+			logger.String("family", f.familyInfo()), logger.Error(err))
+		return
+	}
+	liveFiles := make(map[table.FileNumber]string)
+	allLiveSSTFiles := f.familyVersion.GetAllActiveFiles()
+	for idx := range allLiveSSTFiles {
+		liveFiles[allLiveSSTFiles[idx].GetFileNumber()] = dummy
+	}
+	for file := range f.familyVersion.GetLiveRollupFiles() {
+		liveFiles[file] = dummy
+	}
+	for _, key := range keys {
+		fileDesc := version.ParseFileName(strings.TrimPrefix(key, prefix))
+		if fileDesc == nil || fileDesc.FileType != version.TypeTable {
+			continue
+		}
+		if _, ok := liveFiles[fileDesc.FileNumber]; ok {
+			continue
+		}
+		if err := objectStore.Delete(key); err != nil {
+			metrics.TierStatistics.DeleteFailures.Incr()
+			kvLogger.Error("delete obsolete cold tier file fail",
+				logger.String("family", f.familyInfo()), logger.String("key", key), logger.Error(err))
+			continue
+		}
+		metrics.TierStatistics.Deletes.Incr()
+	}
+}
@@ -0,0 +1,214 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/tier"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestFamily_offload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f, store := mockFamily(t, ctrl)
+	// case 1: tier disabled
+	store.EXPECT().getTier().Return(nil, time.Hour)
+	f.offload()
+	// case 2: compact job doing
+	objectStore := tier.NewMockObjectStore(ctrl)
+	store.EXPECT().getTier().Return(objectStore, time.Hour).AnyTimes()
+	f.compacting.Store(true)
+	f.offload()
+	// case 3: offload job failure
+	f.compacting.Store(false)
+	fv := f.familyVersion.(*version.MockFamilyVersion)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close()
+	current := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(current)
+	fv.EXPECT().GetSnapshot().Return(snapshot)
+	current.EXPECT().NumberOfFilesInLevel(0).Return(1)
+	f.offload()
+	f.close()
+	assert.False(t, f.compacting.Load())
+}
+
+func TestFamily_backgroundOffloadJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		statFunc = os.Stat
+		nowFunc = time.Now
+		removeFunc = os.Remove
+		ctrl.Finish()
+	}()
+
+	f, store := mockFamily(t, ctrl)
+	assert.NoError(t, os.MkdirAll(f.familyPath, 0755))
+	fv := f.familyVersion.(*version.MockFamilyVersion)
+	objectStore := tier.NewMockObjectStore(ctrl)
+	store.EXPECT().getTier().Return(objectStore, time.Hour).AnyTimes()
+	store.EXPECT().tieredKey(gomock.Any(), gomock.Any()).DoAndReturn(func(family, fileName string) string {
+		return "store/" + family + "/" + fileName
+	}).AnyTimes()
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	current := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(current).AnyTimes()
+	fv.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	store.EXPECT().Option().Return(DefaultStoreOption()).AnyTimes()
+
+	// prepare table files
+	for _, fileNumber := range []table.FileNumber{10, 11, 12} {
+		assert.NoError(t, os.WriteFile(filepath.Join(f.familyPath, version.Table(fileNumber)), []byte("sst"), 0644))
+	}
+	files := []*version.FileMeta{
+		version.NewFileMeta(9, 1, 10, 3), // offloaded, not in local disk
+		version.NewFileMeta(10, 1, 10, 3),
+		version.NewFileMeta(11, 11, 20, 3),
+		version.NewFileMeta(12, 21, 30, 3),
+	}
+	nowFunc = func() time.Time {
+		return time.Now().Add(2 * time.Hour)
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "has level0 files",
+			prepare: func() {
+				current.EXPECT().NumberOfFilesInLevel(0).Return(1)
+			},
+		},
+		{
+			name: "has rollup files",
+			prepare: func() {
+				current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+				current.EXPECT().GetRollupFiles().Return(map[table.FileNumber][]timeutil.Interval{10: {10}})
+			},
+		},
+		{
+			name: "upload failure",
+			prepare: func() {
+				current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+				current.EXPECT().GetRollupFiles().Return(nil)
+				current.EXPECT().GetFiles(1).Return(files)
+				current.EXPECT().IsOffloadFile(gomock.Any()).Return(false)
+				objectStore.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "commit edit log failure",
+			prepare: func() {
+				current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+				current.EXPECT().GetRollupFiles().Return(nil)
+				current.EXPECT().GetFiles(1).Return(files)
+				current.EXPECT().IsOffloadFile(gomock.Any()).Return(false).Times(3)
+				objectStore.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+				store.EXPECT().commitFamilyEditLog(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "offload successfully, remove local file failure, list objects failure",
+			prepare: func() {
+				current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+				current.EXPECT().GetRollupFiles().Return(nil)
+				current.EXPECT().GetFiles(1).Return(files)
+				current.EXPECT().IsOffloadFile(table.FileNumber(10)).Return(true)
+				current.EXPECT().IsOffloadFile(gomock.Any()).Return(false).Times(2)
+				objectStore.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				store.EXPECT().commitFamilyEditLog(gomock.Any(), gomock.Any()).Return(nil)
+				// file compacted concurrently isn't removed
+				current.EXPECT().IsOffloadFile(gomock.Any()).Return(true).Times(2)
+				current.EXPECT().IsOffloadFile(gomock.Any()).Return(false)
+				removeFunc = func(name string) error {
+					return fmt.Errorf("err")
+				}
+				objectStore.EXPECT().List("store/"+f.name+"/").Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "offload successfully, delete obsolete objects",
+			prepare: func() {
+				removeFunc = os.Remove
+				current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+				current.EXPECT().GetRollupFiles().Return(nil)
+				current.EXPECT().GetFiles(1).Return(files)
+				current.EXPECT().IsOffloadFile(gomock.Any()).Return(true).Times(6)
+				objectStore.EXPECT().List(gomock.Any()).Return([]string{
+					"store/" + f.name + "/" + version.Table(8),
+					"store/" + f.name + "/" + version.Table(7),
+					"store/" + f.name + "/" + version.Table(10),
+					"store/" + f.name + "/abc",
+				}, nil)
+				fv.EXPECT().GetAllActiveFiles().Return(files)
+				fv.EXPECT().GetLiveRollupFiles().Return(nil)
+				objectStore.EXPECT().Delete("store/" + f.name + "/" + version.Table(8)).Return(fmt.Errorf("err"))
+				objectStore.EXPECT().Delete("store/" + f.name + "/" + version.Table(7)).Return(nil)
+			},
+		},
+		{
+			name: "local files offloaded",
+			prepare: func() {
+				current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+				current.EXPECT().GetRollupFiles().Return(nil)
+				current.EXPECT().GetFiles(1).Return(files)
+				objectStore.EXPECT().List(gomock.Any()).Return(nil, nil)
+				fv.EXPECT().GetAllActiveFiles().Return(files)
+				fv.EXPECT().GetLiveRollupFiles().Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			err := f.backgroundOffloadJob(objectStore)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("backgroundOffloadJob() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	// not modified for a long time
+	nowFunc = time.Now
+	assert.NoError(t, os.WriteFile(filepath.Join(f.familyPath, version.Table(10)), []byte("sst"), 0644))
+	current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+	current.EXPECT().GetRollupFiles().Return(nil)
+	current.EXPECT().GetFiles(1).Return(files)
+	objectStore.EXPECT().List(gomock.Any()).Return(nil, nil)
+	fv.EXPECT().GetAllActiveFiles().Return(files)
+	fv.EXPECT().GetLiveRollupFiles().Return(nil)
+	assert.NoError(t, f.backgroundOffloadJob(objectStore))
+	_, err := os.Stat(filepath.Join(f.familyPath, version.Table(10)))
+	assert.NoError(t, err)
+}
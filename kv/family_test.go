@@ -58,7 +58,11 @@ func TestFamily_New(t *testing.T) {
 	f, err = newFamily(store, FamilyOption{Merger: "mockMerger_not_exist"})
 	assert.Error(t, err)
 	assert.Nil(t, f)
-	// case 3: create family success
+	// case 3: create family err, compression not support
+	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", Name: "c", Compression: "lz4"})
+	assert.Error(t, err)
+	assert.Nil(t, f)
+	// case 4: create family success
 	vs := version.NewMockFamilyVersion(ctrl)
 	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(vs)
 	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", ID: 10, Name: "f", MaxFileSize: 10})
@@ -226,11 +230,11 @@ func TestFamily_compact_background(t *testing.T) {
 		return compactJob
 	}
 	compactJob.EXPECT().Run().Return(fmt.Errorf("err"))
-	err = f2.backgroundCompactionJob()
+	err = f2.backgroundCompactionJob(0)
 	assert.Error(t, err)
 	// case 3: compact job run success
 	compactJob.EXPECT().Run().Return(nil)
-	err = f2.backgroundCompactionJob()
+	err = f2.backgroundCompactionJob(0)
 	assert.NoError(t, err)
 }
 
@@ -329,3 +333,84 @@ func TestFamily_Comapct(t *testing.T) {
 	f.Compact()
 	time.Sleep(100 * time.Millisecond)
 }
+
+func TestFamily_ForceCompact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fv := version.NewMockFamilyVersion(ctrl)
+	f := &family{
+		familyVersion: fv,
+		option:        FamilyOption{CompactThreshold: 4},
+	}
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	fv.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	fv.EXPECT().GetAllActiveFiles().Return(nil).AnyTimes()
+	fv.EXPECT().GetLiveRollupFiles().Return(nil).AnyTimes()
+	current := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(current).AnyTimes()
+	// case 1: no level0 files
+	current.EXPECT().NumberOfFilesInLevel(0).Return(0)
+	f.ForceCompact()
+	// case 2: compact all level0 files regardless of compact threshold
+	current.EXPECT().NumberOfFilesInLevel(0).Return(1)
+	current.EXPECT().PickL0Compaction(1).Return(nil)
+	f.ForceCompact()
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, f.forceCompact.Load())
+	// case 3: compaction job running, request queued and runs after running job completed
+	running := make(chan struct{})
+	compactJob := NewMockCompactJob(ctrl)
+	f.newCompactJobFunc = func(family Family, state *compactionState, rollup Rollup) CompactJob {
+		return compactJob
+	}
+	compactJob.EXPECT().Run().DoAndReturn(func() error {
+		<-running
+		return nil
+	})
+	gomock.InOrder(
+		current.EXPECT().PickL0Compaction(4).Return(version.NewCompaction(1, 0, nil, nil)),
+		current.EXPECT().PickL0Compaction(1).Return(nil),
+	)
+	f.compact()
+	current.EXPECT().NumberOfFilesInLevel(0).Return(1)
+	f.ForceCompact()
+	assert.True(t, f.forceCompact.Load())
+	close(running)
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, f.forceCompact.Load())
+	f.condition.Wait()
+}
+
+func TestFamily_GetState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fv := version.NewMockFamilyVersion(ctrl)
+	f := &family{
+		name:          "f",
+		familyVersion: fv,
+	}
+	f.compacting.Store(true)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close()
+	fv.EXPECT().GetSnapshot().Return(snapshot)
+	current := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(current)
+	current.EXPECT().NumberOfFilesInLevel(0).Return(2)
+	current.EXPECT().GetAllFiles().Return([]*version.FileMeta{
+		version.NewFileMeta(1, 0, 0, 10),
+		version.NewFileMeta(2, 0, 0, 20),
+		version.NewFileMeta(3, 0, 0, 30),
+	})
+	current.EXPECT().GetRollupFiles().Return(map[table.FileNumber][]timeutil.Interval{1: {10}})
+	assert.Equal(t, FamilyState{
+		Name:             "f",
+		Compacting:       true,
+		NumOfL0Files:     2,
+		NumOfFiles:       3,
+		NumOfRollupFiles: 1,
+		Size:             60,
+	}, f.GetState())
+}
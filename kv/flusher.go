@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./flusher.go -destination=./flusher_mock.go -package kv
@@ -39,6 +40,12 @@ type Flusher interface {
 	Add(key uint32, value []byte) error
 	// Sequence sets write sequence number.
 	Sequence(leader int32, seq int64)
+	// Import marks the import request which is applied by this flush,
+	// it is committed in same edit log with the flushed file.
+	Import(importID uint64)
+	// Rollup sets the target intervals of rollup job for output files,
+	// which are the rollup intervals of store if not set, no rollup job if intervals is empty.
+	Rollup(intervals ...timeutil.Interval)
 	// Commit flushes data and commits metadata.
 	Commit() error
 	// Release releases the resource of flusher.
@@ -50,6 +57,8 @@ type Flusher interface {
 type storeFlusher struct {
 	family    Family
 	sequences map[int32]int64
+	imports   []uint64
+	rollup    []timeutil.Interval // nil means using the rollup intervals of store
 	builder   table.Builder
 	editLog   version.EditLog
 	outputs   []table.FileNumber
@@ -92,6 +101,7 @@ func (sf *storeFlusher) Add(key uint32, value []byte) error {
 		return err
 	}
 	// TODO add file size limit
+	getIOLimiter().Wait(ioTypeFlush, len(value))
 	return sf.builder.Add(key, value)
 }
 
@@ -100,12 +110,23 @@ func (sf *storeFlusher) Sequence(leader int32, seq int64) {
 	sf.sequences[leader] = seq
 }
 
+// Import marks the import request which is applied by this flush.
+func (sf *storeFlusher) Import(importID uint64) {
+	sf.imports = append(sf.imports, importID)
+}
+
+// Rollup sets the target intervals of rollup job for output files.
+func (sf *storeFlusher) Rollup(intervals ...timeutil.Interval) {
+	sf.rollup = make([]timeutil.Interval, len(intervals))
+	copy(sf.rollup, intervals)
+}
+
 func (sf *storeFlusher) StreamWriter() (table.StreamWriter, error) {
 	if err := sf.checkBuilder(); err != nil {
 		metrics.FlushStatistics.Failure.Incr()
 		return nil, err
 	}
-	return sf.builder.StreamWriter(), nil
+	return newLimitedStreamWriter(sf.builder.StreamWriter(), ioTypeFlush), nil
 }
 
 // Commit flushes data and commits metadata.
@@ -134,12 +155,17 @@ func (sf *storeFlusher) Commit() (err error) {
 		// add sequence for each leader
 		sf.editLog.Add(version.CreateSequence(leader, seq))
 	}
+	for _, importID := range sf.imports {
+		sf.editLog.Add(version.CreateImport(importID))
+	}
 
 	// check if it needs add rollup log to target store
 	if len(sf.outputs) > 0 {
-		store := sf.family.getStore()
-		rollupTargetStores := store.Option().Rollup
-		for _, interval := range rollupTargetStores {
+		rollupTargets := sf.rollup
+		if rollupTargets == nil {
+			rollupTargets = sf.family.getStore().Option().Rollup
+		}
+		for _, interval := range rollupTargets {
 			// add rollup files edit log in source version
 			for _, output := range sf.outputs {
 				sf.editLog.Add(version.CreateNewRollupFile(output, interval))
@@ -190,6 +216,10 @@ func (nf *NopFlusher) Add(_ uint32, value []byte) error {
 
 func (nf *NopFlusher) Sequence(_ int32, _ int64) {}
 
+func (nf *NopFlusher) Import(_ uint64) {}
+
+func (nf *NopFlusher) Rollup(_ ...timeutil.Interval) {}
+
 // Commit always return nil
 func (nf *NopFlusher) Commit() error {
 	nf.buffer.Reset()
@@ -89,12 +89,17 @@ func TestStoreFlusher_Commit(t *testing.T) {
 	family = NewMockFamily(ctrl)
 	gomock.InOrder(
 		family.EXPECT().ID().Return(version.FamilyID(10)),
-		family.EXPECT().commitEditLog(gomock.Any()).Return(true),
+		family.EXPECT().commitEditLog(gomock.Any()).DoAndReturn(func(editLog version.EditLog) bool {
+			// import id is committed with the flushed file
+			assert.Contains(t, editLog.GetLogs(), version.CreateImport(100))
+			return true
+		}),
 	)
 	flusher = newStoreFlusher(family, func() {})
 	defer flusher.Release()
 	flusher.Sequence(1, 10)
 	flusher.Sequence(2, 20)
+	flusher.Import(100)
 	err = flusher.Commit()
 	assert.NoError(t, err)
 
@@ -153,6 +158,22 @@ func TestStoreFlusher_Commit(t *testing.T) {
 	f.builder = builder
 	err = flusher.Commit()
 	assert.NoError(t, err)
+
+	// rollup intervals set by flusher, no need to get store option
+	gomock.InOrder(
+		family.EXPECT().ID().Return(version.FamilyID(10)),
+		family.EXPECT().commitEditLog(gomock.Any()).DoAndReturn(func(editLog version.EditLog) bool {
+			assert.Len(t, editLog.GetLogs(), 1)
+			return true
+		}),
+	)
+	flusher = newStoreFlusher(family, func() {})
+	defer flusher.Release()
+	flusher.Rollup(timeutil.Interval(20))
+	f = flusher.(*storeFlusher)
+	f.outputs = []table.FileNumber{1}
+	err = flusher.Commit()
+	assert.NoError(t, err)
 }
 
 func TestStoreFlusher_StreamWriter(t *testing.T) {
@@ -204,6 +225,8 @@ func TestStoreFlusher_StreamWriter(t *testing.T) {
 func Test_NopFlusher(t *testing.T) {
 	nf := NewNopFlusher()
 	nf.Sequence(1, 10)
+	nf.Import(1)
+	nf.Rollup()
 	assert.Nil(t, nf.Commit())
 	assert.Nil(t, nf.Add(1, nil))
 	assert.Nil(t, nf.Bytes())
@@ -0,0 +1,220 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+	"golang.org/x/time/rate"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/metrics"
+)
+
+//go:generate mockgen -source ./io_limiter.go -destination=./io_limiter_mock.go -package kv
+
+// for testing
+var (
+	sleepFn = time.Sleep
+	nowFn   = time.Now
+)
+
+const (
+	ioTypeFlush = "flush"
+	// defaultIOAdjustInterval is the interval for adapting io rate based on foreground load.
+	defaultIOAdjustInterval = time.Second
+	// maxFlushDebt is the max delay of tokens which flush owes, flush stops consuming tokens after reaching it,
+	// so that compaction/rollup aren't stalled by unbounded token debt under heavy flush.
+	maxFlushDebt = time.Second
+)
+
+// defaultIOLimiter is used when store options don't set io limiter, which doesn't limit io.
+var defaultIOLimiter = NewIOLimiter(IOLimiterOptions{})
+
+// IOLimiterOptions represents the options of io limiter.
+type IOLimiterOptions struct {
+	Rate           int64         // max background io bytes per second(unlimited if <= 0)
+	MinRate        int64         // min background io bytes per second under heavy foreground load
+	Burst          int64         // max bytes of io allowed at once(default same as rate)
+	BusyThreshold  int64         // foreground ops per second at which background io rate reaches min rate(disable if <= 0)
+	AdjustInterval time.Duration // interval for adapting io rate based on foreground load
+}
+
+// IOLimiter limits the background io(flush/compaction/rollup) of kv store based on token bucket,
+// the rate of token bucket adapts to foreground(write/query) load.
+type IOLimiter interface {
+	// Wait blocks until n bytes of background io can be written,
+	// flush never blocks, it only consumes the tokens(bounded by max flush debt) so that compaction/rollup yields to it.
+	Wait(ioType string, n int)
+	// MarkForeground records the number of foreground operations.
+	MarkForeground(n int)
+	// Rate returns current background io rate(bytes per second), returns 0 if unlimited.
+	Rate() int64
+}
+
+// ioLimiter implements IOLimiter interface.
+type ioLimiter struct {
+	options IOLimiterOptions
+	limiter *rate.Limiter
+
+	foreground *atomic.Int64
+	lastAdjust *atomic.Int64
+	rate       *atomic.Int64
+}
+
+// NewIOLimiter creates an io limiter instance.
+func NewIOLimiter(options IOLimiterOptions) IOLimiter {
+	l := &ioLimiter{
+		foreground: atomic.NewInt64(0),
+		lastAdjust: atomic.NewInt64(nowFn().UnixNano()),
+		rate:       atomic.NewInt64(0),
+	}
+	if options.Rate <= 0 {
+		l.limiter = rate.NewLimiter(rate.Inf, 0)
+		l.options = options
+		return l
+	}
+	if options.MinRate <= 0 || options.MinRate > options.Rate {
+		options.MinRate = options.Rate
+	}
+	if options.Burst <= 0 {
+		options.Burst = options.Rate
+	}
+	if options.AdjustInterval <= 0 {
+		options.AdjustInterval = defaultIOAdjustInterval
+	}
+	l.options = options
+	l.limiter = rate.NewLimiter(rate.Limit(options.Rate), int(options.Burst))
+	l.rate.Store(options.Rate)
+	metrics.IOLimiterStatistics.Rate.Update(float64(options.Rate))
+	return l
+}
+
+// Wait blocks until n bytes of background io can be written,
+// flush never blocks, it only consumes the tokens(bounded by max flush debt) so that compaction/rollup yields to it.
+func (l *ioLimiter) Wait(ioType string, n int) {
+	if n <= 0 {
+		return
+	}
+	metrics.IOLimiterStatistics.Bytes.WithTagValues(ioType).Add(float64(n))
+	if l.options.Rate <= 0 {
+		return
+	}
+	l.adjust()
+
+	var delay time.Duration
+	burst := int(l.options.Burst)
+	for n > 0 {
+		size := n
+		if size > burst {
+			size = burst
+		}
+		// reserve always succeeds because size <= burst and limit isn't zero,
+		// reservations are queued, so the delay of last reservation is total wait time.
+		now := nowFn()
+		r := l.limiter.ReserveN(now, size)
+		if ioType == ioTypeFlush && r.DelayFrom(now) > maxFlushDebt {
+			// give back the tokens, flush doesn't owe more than max debt
+			r.CancelAt(now)
+			break
+		}
+		delay = r.DelayFrom(now)
+		n -= size
+	}
+	if ioType == ioTypeFlush {
+		// memory database must be flushed in time, otherwise writes are blocked by memory limit
+		return
+	}
+	if delay > 0 {
+		metrics.IOLimiterStatistics.Throttles.WithTagValues(ioType).Incr()
+		metrics.IOLimiterStatistics.WaitDuration.WithTagValues(ioType).UpdateDuration(delay)
+		sleepFn(delay)
+	}
+}
+
+// MarkForeground records the number of foreground operations.
+func (l *ioLimiter) MarkForeground(n int) {
+	if n <= 0 || l.options.Rate <= 0 || l.options.BusyThreshold <= 0 {
+		return
+	}
+	l.foreground.Add(int64(n))
+}
+
+// Rate returns current background io rate(bytes per second), returns 0 if unlimited.
+func (l *ioLimiter) Rate() int64 {
+	return l.rate.Load()
+}
+
+// adjust adapts the io rate based on foreground load since last adjustment,
+// the rate decreases linearly from max rate to min rate when foreground load reaches busy threshold.
+func (l *ioLimiter) adjust() {
+	if l.options.BusyThreshold <= 0 {
+		return
+	}
+	now := nowFn()
+	last := l.lastAdjust.Load()
+	elapsed := now.UnixNano() - last
+	if elapsed < l.options.AdjustInterval.Nanoseconds() || !l.lastAdjust.CAS(last, now.UnixNano()) {
+		return
+	}
+	load := l.foreground.Swap(0) * int64(time.Second) / elapsed
+	newRate := l.options.MinRate
+	if load < l.options.BusyThreshold {
+		newRate = l.options.Rate - (l.options.Rate-l.options.MinRate)*load/l.options.BusyThreshold
+	}
+	l.limiter.SetLimitAt(now, rate.Limit(newRate))
+	l.rate.Store(newRate)
+
+	metrics.IOLimiterStatistics.ForegroundLoad.Update(float64(load))
+	metrics.IOLimiterStatistics.Rate.Update(float64(newRate))
+}
+
+// getIOLimiter returns the io limiter of global store options, if not set returns default unlimited limiter.
+func getIOLimiter() IOLimiter {
+	if options := getStoreOptions(); options != nil && options.IOLimiter != nil {
+		return options.IOLimiter
+	}
+	return defaultIOLimiter
+}
+
+// MarkForegroundLoad records the number of foreground(write/query) operations,
+// the background io rate will be reduced when foreground load is high.
+func MarkForegroundLoad(n int) {
+	getIOLimiter().MarkForeground(n)
+}
+
+// limitedStreamWriter wraps stream writer, limits the written bytes by io limiter.
+type limitedStreamWriter struct {
+	table.StreamWriter
+	ioType string
+}
+
+// newLimitedStreamWriter creates a stream writer limited by io limiter.
+func newLimitedStreamWriter(sw table.StreamWriter, ioType string) table.StreamWriter {
+	return &limitedStreamWriter{
+		StreamWriter: sw,
+		ioType:       ioType,
+	}
+}
+
+// Write waits io limiter, then writes buffer into the underlying writer.
+func (w *limitedStreamWriter) Write(data []byte) (int, error) {
+	getIOLimiter().Wait(w.ioType, len(data))
+	return w.StreamWriter.Write(data)
+}
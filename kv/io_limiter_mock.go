@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./io_limiter.go
+
+// Package kv is a generated GoMock package.
+package kv
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIOLimiter is a mock of IOLimiter interface.
+type MockIOLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOLimiterMockRecorder
+}
+
+// MockIOLimiterMockRecorder is the mock recorder for MockIOLimiter.
+type MockIOLimiterMockRecorder struct {
+	mock *MockIOLimiter
+}
+
+// NewMockIOLimiter creates a new mock instance.
+func NewMockIOLimiter(ctrl *gomock.Controller) *MockIOLimiter {
+	mock := &MockIOLimiter{ctrl: ctrl}
+	mock.recorder = &MockIOLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOLimiter) EXPECT() *MockIOLimiterMockRecorder {
+	return m.recorder
+}
+
+// MarkForeground mocks base method.
+func (m *MockIOLimiter) MarkForeground(n int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MarkForeground", n)
+}
+
+// MarkForeground indicates an expected call of MarkForeground.
+func (mr *MockIOLimiterMockRecorder) MarkForeground(n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkForeground", reflect.TypeOf((*MockIOLimiter)(nil).MarkForeground), n)
+}
+
+// Rate mocks base method.
+func (m *MockIOLimiter) Rate() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rate")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Rate indicates an expected call of Rate.
+func (mr *MockIOLimiterMockRecorder) Rate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rate", reflect.TypeOf((*MockIOLimiter)(nil).Rate))
+}
+
+// Wait mocks base method.
+func (m *MockIOLimiter) Wait(ioType string, n int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Wait", ioType, n)
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockIOLimiterMockRecorder) Wait(ioType, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockIOLimiter)(nil).Wait), ioType, n)
+}
@@ -0,0 +1,140 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+)
+
+func TestIOLimiter_Unlimited(t *testing.T) {
+	defer func() {
+		sleepFn = time.Sleep
+	}()
+	sleepFn = func(_ time.Duration) {
+		panic("cannot sleep when unlimited")
+	}
+	l := NewIOLimiter(IOLimiterOptions{})
+	l.Wait(ioTypeFlush, 0)
+	l.Wait(ioTypeFlush, 1024*1024*1024)
+	l.MarkForeground(100)
+	assert.Equal(t, int64(0), l.Rate())
+}
+
+func TestIOLimiter_Wait(t *testing.T) {
+	defer func() {
+		sleepFn = time.Sleep
+	}()
+	var delay time.Duration
+	sleepFn = func(d time.Duration) {
+		delay += d
+	}
+	l := NewIOLimiter(IOLimiterOptions{Rate: 1024})
+	assert.Equal(t, int64(1024), l.Rate())
+	// consume burst
+	l.Wait("merge", 1024)
+	assert.Zero(t, delay)
+	// flush never waits, but consumes tokens
+	l.Wait(ioTypeFlush, 1024)
+	assert.Zero(t, delay)
+	// n > burst, wait about 3s(include tokens consumed by flush)
+	l.Wait("merge", 2048)
+	assert.True(t, delay > 2*time.Second && delay <= 3*time.Second)
+
+	// flush debt is bounded
+	l = NewIOLimiter(IOLimiterOptions{Rate: 1024})
+	delay = 0
+	l.Wait(ioTypeFlush, 100*1024)
+	assert.Zero(t, delay)
+	l.Wait("merge", 1024)
+	assert.True(t, delay > time.Second && delay <= time.Second+maxFlushDebt)
+}
+
+func TestIOLimiter_Adjust(t *testing.T) {
+	now := time.Now()
+	defer func() {
+		nowFn = time.Now
+	}()
+	nowFn = func() time.Time {
+		return now
+	}
+	l := NewIOLimiter(IOLimiterOptions{
+		Rate:          1000,
+		MinRate:       200,
+		BusyThreshold: 100,
+	})
+	limiter := l.(*ioLimiter)
+	// not reach adjust interval
+	l.MarkForeground(100)
+	limiter.adjust()
+	assert.Equal(t, int64(1000), l.Rate())
+	// half load
+	now = now.Add(2 * time.Second)
+	l.MarkForeground(0)
+	limiter.adjust()
+	assert.Equal(t, int64(600), l.Rate())
+	// busy
+	now = now.Add(time.Second)
+	l.MarkForeground(500)
+	limiter.adjust()
+	assert.Equal(t, int64(200), l.Rate())
+	// idle
+	now = now.Add(time.Second)
+	limiter.adjust()
+	assert.Equal(t, int64(1000), l.Rate())
+
+	// min rate invalid
+	l = NewIOLimiter(IOLimiterOptions{Rate: 1000, MinRate: 2000})
+	assert.Equal(t, int64(1000), l.(*ioLimiter).options.MinRate)
+	l.MarkForeground(100)
+	assert.Zero(t, l.(*ioLimiter).foreground.Load())
+}
+
+func TestIOLimiter_getIOLimiter(t *testing.T) {
+	defer Options.Store(&StoreOptions{})
+
+	Options.Store(&StoreOptions{})
+	assert.Equal(t, defaultIOLimiter, getIOLimiter())
+	l := NewIOLimiter(IOLimiterOptions{Rate: 100, BusyThreshold: 10})
+	Options.Store(&StoreOptions{IOLimiter: l})
+	assert.Equal(t, l, getIOLimiter())
+	MarkForegroundLoad(10)
+	assert.Equal(t, int64(10), l.(*ioLimiter).foreground.Load())
+}
+
+func TestIOLimiter_StreamWriter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defer Options.Store(&StoreOptions{})
+
+	limiter := NewMockIOLimiter(ctrl)
+	Options.Store(&StoreOptions{IOLimiter: limiter})
+	limiter.EXPECT().Wait(ioTypeFlush, 3)
+	sw := table.NewMockStreamWriter(ctrl)
+	sw.EXPECT().Write(gomock.Any()).Return(3, nil)
+	w := newLimitedStreamWriter(sw, ioTypeFlush)
+	n, err := w.Write([]byte{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
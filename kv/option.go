@@ -20,6 +20,8 @@ package kv
 import (
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -32,6 +34,7 @@ type FamilyOption struct {
 	RollupThreshold  int    `toml:"rollupThreshold"`  // level 0 rollup threshold
 	Merger           string `toml:"merger"`           // merger which need implement Merger interface
 	MaxFileSize      uint32 `toml:"maxFileSize"`      // max file size
+	Compression      string `toml:"compression"`      // optional(value compression: none/snappy/zstd)
 }
 
 // StoreOption defines config item for store level
@@ -53,6 +56,8 @@ func DefaultStoreOption() StoreOption {
 
 // storeInfo represents store config option, include all family's option in this kv store
 type storeInfo struct {
+	// UUID is the unique id of store, isolates the objects of store in shared cold tier storage.
+	UUID        string                  `toml:"uuid"`
 	StoreOption StoreOption             `toml:"store"`
 	Families    map[string]FamilyOption `toml:"families"`
 }
@@ -60,6 +65,7 @@ type storeInfo struct {
 // newStoreInfo creates store info instance for saving configs
 func newStoreInfo(storeOption StoreOption) *storeInfo {
 	return &storeInfo{
+		UUID:        uuid.New().String(),
 		StoreOption: storeOption,
 		Families:    make(map[string]FamilyOption),
 	}
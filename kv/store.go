@@ -24,11 +24,15 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/tier"
 	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/lockers"
 	"github.com/lindb/lindb/pkg/logger"
@@ -67,6 +71,9 @@ type Store interface {
 	Option() StoreOption
 	// ForceRollup does rollup job manual.
 	ForceRollup()
+	// Backup creates a point-in-time copy of store into target path(hard-link or copy the immutable files),
+	// which can be opened as a store directly, it doesn't block flush/compaction job.
+	Backup(targetPath string) error
 
 	// compact the families under store.
 	compact()
@@ -81,6 +88,10 @@ type Store interface {
 	commitFamilyEditLog(name string, editLog version.EditLog) error
 	// evictFamilyFile evicts family file reader from cache
 	evictFamilyFile(fileNumber table.FileNumber)
+	// getTier returns cold tier storage and offload threshold, returns nil if tier is disabled.
+	getTier() (tier.ObjectStore, time.Duration)
+	// tieredKey returns the object key of family file in cold tier storage.
+	tieredKey(familyName, fileName string) string
 }
 
 // store implements Store interface
@@ -100,6 +111,9 @@ type store struct {
 	storeInfo *storeInfo
 	cache     table.Cache
 
+	tier         tier.ObjectStore // optional(cold tier storage)
+	offloadAfter time.Duration
+
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -161,7 +175,13 @@ func newStore(name, path string, option StoreOption) (s Store, err error) {
 	}()
 
 	// build store reader cache
-	store1.cache = table.NewCache(path, option.TTL.Duration())
+	if options := getStoreOptions(); options != nil && options.Tier != nil {
+		store1.tier = options.Tier
+		store1.offloadAfter = options.OffloadAfter
+		store1.cache = table.NewTieredCache(path, option.TTL.Duration(), store1.fetchFile)
+	} else {
+		store1.cache = table.NewCache(path, option.TTL.Duration())
+	}
 	// init version set
 	store1.versions = newVersionSetFunc(path, store1.cache, store1.option.Levels)
 
@@ -172,6 +192,13 @@ func newStore(name, path string, option StoreOption) (s Store, err error) {
 			return nil, err
 		}
 	} else {
+		if info.UUID == "" {
+			// store created by old version or restored from backup, assign a new uuid
+			info.UUID = uuid.New().String()
+			if err = store1.dumpStoreInfo(); err != nil {
+				return nil, err
+			}
+		}
 		// existed store calc family sequence
 		for familyName, familyOption := range info.Families {
 			if int(store1.familySeq.Load()) < familyOption.ID {
@@ -275,6 +302,42 @@ func (s *store) ForceRollup() {
 	}
 }
 
+// Backup creates a point-in-time copy of store into target path(hard-link or copy the immutable files),
+// which can be opened as a store directly, it doesn't block flush/compaction job.
+func (s *store) Backup(targetPath string) error {
+	if err := mkDirFunc(targetPath); err != nil {
+		return fmt.Errorf("create backup path error:%s", err)
+	}
+	// make sure all families of backup exist in store info.
+	s.rwMutex.RLock()
+	families := make([]Family, 0, len(s.families))
+	for _, family := range s.families {
+		families = append(families, family)
+	}
+	// backup doesn't share the objects of cold tier storage with this store, so assigns new uuid after restore.
+	info := *s.storeInfo
+	info.UUID = ""
+	err := encodeTomlFunc(filepath.Join(targetPath, version.Options), &info)
+	s.rwMutex.RUnlock()
+	if err != nil {
+		return fmt.Errorf("write store info to backup path[%s] error:%s", targetPath, err)
+	}
+	editLogs := make([]version.EditLog, 0, len(families))
+	for _, family := range families {
+		editLog, err := family.backup(filepath.Join(targetPath, family.Name()))
+		if err != nil {
+			return err
+		}
+		editLogs = append(editLogs, editLog)
+	}
+	if err := s.versions.Backup(targetPath, editLogs); err != nil {
+		return fmt.Errorf("write manifest to backup path[%s] error:%s", targetPath, err)
+	}
+	kvLogger.Info("backup store successfully",
+		logger.String("store", s.path), logger.String("target", targetPath))
+	return nil
+}
+
 // close the store, then release some resource
 func (s *store) close() error {
 	// close each family in kv store.
@@ -344,6 +407,10 @@ func (s *store) compact() {
 		if family.needRollup() {
 			family.rollup()
 		}
+		if s.tier != nil {
+			// try to offload fully compacted files to cold tier storage
+			family.offload()
+		}
 	}
 
 	// try to evict expired reader from cache.
@@ -390,3 +457,31 @@ func (s *store) getCurrentFamilies() (families []Family) {
 	}
 	return families
 }
+
+// getTier returns cold tier storage and offload threshold, returns nil if tier is disabled.
+func (s *store) getTier() (tier.ObjectStore, time.Duration) {
+	return s.tier, s.offloadAfter
+}
+
+// tieredKey returns the object key of family file in cold tier storage,
+// key is prefixed with the uuid of store, because file number is only unique in store.
+func (s *store) tieredKey(familyName, fileName string) string {
+	return tieredPrefix(s.storeInfo.UUID, s.name) + familyName + "/" + fileName
+}
+
+// tieredPrefix returns the prefix of all objects which belong to the store in cold tier storage.
+func tieredPrefix(storeUUID, storeName string) string {
+	return strings.Join([]string{storeUUID, storeName, ""}, "/")
+}
+
+// fetchFile downloads the family file from cold tier storage into local path.
+func (s *store) fetchFile(familyName, fileName, path string) error {
+	if err := s.tier.Download(s.tieredKey(familyName, fileName), path); err != nil {
+		metrics.TierStatistics.DownloadFailures.Incr()
+		return fmt.Errorf("fetch file[%s] from cold tier storage error:%w", path, err)
+	}
+	metrics.TierStatistics.Downloads.Incr()
+	kvLogger.Info("fetch file from cold tier storage successfully",
+		logger.String("store", s.path), logger.String("family", familyName), logger.String("file", fileName))
+	return nil
+}
@@ -20,8 +20,14 @@ package kv
 import (
 	"path/filepath"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/kv/tier"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
 )
 
 //go:generate mockgen -source ./store_manager.go -destination=./store_manager_mock.go -package kv
@@ -29,6 +35,20 @@ import (
 type StoreOptions struct {
 	Dir                  string // store root path
 	CompactCheckInterval int    // compact/rollup job check interval(number of seconds)
+
+	Tier         tier.ObjectStore // optional(cold tier storage for offloading fully compacted files)
+	OffloadAfter time.Duration    // offload files which have not been modified this long
+
+	IOLimiter IOLimiter // optional(shared background io limiter for flush/compaction/rollup)
+}
+
+// getStoreOptions returns the global store options if it's set.
+func getStoreOptions() *StoreOptions {
+	options, ok := Options.Load().(*StoreOptions)
+	if !ok {
+		return nil
+	}
+	return options
 }
 
 var (
@@ -69,6 +89,9 @@ type StoreManager interface {
 	GetStores() []Store
 	// CloseStore closes the Store, then remove from manager cache.
 	CloseStore(name string) error
+	// DropTieredFiles deletes all files of the Store which offloaded to cold tier storage,
+	// must be invoked before removing the store's dir.
+	DropTieredFiles(name string) error
 }
 
 // storeManager implements StoreManager interface.
@@ -140,3 +163,35 @@ func (s *storeManager) CloseStore(name string) error {
 	}
 	return nil
 }
+
+// DropTieredFiles deletes all files of the Store which offloaded to cold tier storage,
+// objects are keyed by the uuid of store, so it must be invoked before removing the store's dir.
+func (s *storeManager) DropTieredFiles(name string) error {
+	if s.options.Tier == nil {
+		return nil
+	}
+	optionsFile := filepath.Join(s.options.Dir, name, version.Options)
+	if !fileutil.Exist(optionsFile) {
+		return nil
+	}
+	info := &storeInfo{}
+	if err := decodeTomlFunc(optionsFile, info); err != nil {
+		return err
+	}
+	if info.UUID == "" {
+		// uuid is assigned when store opened, store never opened hasn't offloaded any files.
+		return nil
+	}
+	keys, err := s.options.Tier.List(tieredPrefix(info.UUID, name))
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := s.options.Tier.Delete(key); err != nil {
+			metrics.TierStatistics.DeleteFailures.Incr()
+			return err
+		}
+		metrics.TierStatistics.Deletes.Incr()
+	}
+	return nil
+}
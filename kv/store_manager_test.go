@@ -19,11 +19,18 @@ package kv
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/tier"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/ltoml"
 )
 
 func TestInitStoreManager(t *testing.T) {
@@ -219,3 +226,81 @@ func TestStoreManager_Get(t *testing.T) {
 	assert.Len(t, stores, 1)
 	assert.Equal(t, store, stores[0])
 }
+
+func TestStoreManager_DropTieredFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		decodeTomlFunc = ltoml.DecodeToml
+		ctrl.Finish()
+	}()
+
+	// case 1: tier disabled
+	storeMgr := newStoreManager(StoreOptions{})
+	assert.NoError(t, storeMgr.DropTieredFiles("test"))
+
+	dir := t.TempDir()
+	objectStore := tier.NewMockObjectStore(ctrl)
+	storeMgr = newStoreManager(StoreOptions{Dir: dir, Tier: objectStore})
+	// case 2: store not exist
+	assert.NoError(t, storeMgr.DropTieredFiles("test"))
+	// case 3: store without uuid
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(dir, "test")))
+	info := newStoreInfo(DefaultStoreOption())
+	info.UUID = ""
+	assert.NoError(t, ltoml.EncodeToml(filepath.Join(dir, "test", version.Options), info))
+	assert.NoError(t, storeMgr.DropTieredFiles("test"))
+	info.UUID = "uuid"
+	assert.NoError(t, ltoml.EncodeToml(filepath.Join(dir, "test", version.Options), info))
+	// case 4: list objects failure
+	objectStore.EXPECT().List("uuid/test/").Return(nil, fmt.Errorf("err"))
+	assert.Error(t, storeMgr.DropTieredFiles("test"))
+	// case 5: delete object failure
+	objectStore.EXPECT().List("uuid/test/").Return([]string{"uuid/test/f/000001.sst"}, nil)
+	objectStore.EXPECT().Delete("uuid/test/f/000001.sst").Return(fmt.Errorf("err"))
+	assert.Error(t, storeMgr.DropTieredFiles("test"))
+	// case 6: delete objects successfully
+	objectStore.EXPECT().List("uuid/test/").Return([]string{"uuid/test/f/000001.sst", "uuid/test/f/000002.sst"}, nil)
+	objectStore.EXPECT().Delete(gomock.Any()).Return(nil).Times(2)
+	assert.NoError(t, storeMgr.DropTieredFiles("test"))
+	// case 7: decode store info failure
+	decodeTomlFunc = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, storeMgr.DropTieredFiles("test"))
+}
+
+func TestStoreManager_DropTieredFiles_FileSystemTier(t *testing.T) {
+	defer Options.Store(&StoreOptions{})
+	dir := t.TempDir()
+	objectStore := tier.NewFileSystemStore(t.TempDir())
+	options := StoreOptions{Dir: dir, Tier: objectStore, OffloadAfter: time.Nanosecond}
+	Options.Store(&options)
+	storeMgr := newStoreManager(options)
+	kv, err := storeMgr.CreateStore("db/shard/1/segment/day/20221019", DefaultStoreOption())
+	assert.NoError(t, err)
+	f1, err := kv.CreateFamily("f", FamilyOption{
+		CompactThreshold: 2,
+		Merger:           mergerStr,
+	})
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		flusher := f1.NewFlusher()
+		_ = flusher.Add(1, []byte("test"))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+	}
+	// compact level0 files, then offload level1 files
+	kv.compact()
+	time.Sleep(time.Second)
+	kv.compact()
+	time.Sleep(time.Second)
+	keys, err := objectStore.List("")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	assert.NoError(t, storeMgr.CloseStore(kv.Name()))
+	assert.NoError(t, storeMgr.DropTieredFiles(kv.Name()))
+	keys, err = objectStore.List("")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
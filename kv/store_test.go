@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/tier"
 	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/lockers"
@@ -333,3 +334,149 @@ func TestStore_Close(t *testing.T) {
 	kv1.versions = vs
 	assert.NoError(t, kv.close())
 }
+
+func TestStore_Tier(t *testing.T) {
+	defer Options.Store(&StoreOptions{})
+	objectStore := tier.NewFileSystemStore(t.TempDir())
+	Options.Store(&StoreOptions{Tier: objectStore, OffloadAfter: time.Nanosecond})
+	path := filepath.Join(t.TempDir(), "tier_test")
+	kv, err := newStore("test_kv", path, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	objectStore0, offloadAfter := kv.getTier()
+	assert.Equal(t, objectStore, objectStore0)
+	assert.Equal(t, time.Nanosecond, offloadAfter)
+	storeUUID := kv.(*store).storeInfo.UUID
+	assert.NotEmpty(t, storeUUID)
+	assert.Equal(t, storeUUID+"/test_kv/f/000001.sst", kv.tieredKey("f", "000001.sst"))
+
+	f1, err := kv.CreateFamily("f", FamilyOption{
+		CompactThreshold: 2,
+		Merger:           mergerStr,
+		Compression:      "zstd",
+	})
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		flusher := f1.NewFlusher()
+		_ = flusher.Add(1, []byte("test"))
+		_ = flusher.Add(10, []byte("test10"))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+	}
+	// compact level0 files
+	kv.compact()
+	time.Sleep(time.Second)
+	// offload level1 files
+	kv.compact()
+	time.Sleep(time.Second)
+
+	snapshot := f1.GetSnapshot()
+	files := snapshot.GetCurrent().GetFiles(1)
+	assert.Len(t, files, 1)
+	fileName := version.Table(files[0].GetFileNumber())
+	assert.True(t, snapshot.GetCurrent().IsOffloadFile(files[0].GetFileNumber()))
+	assert.False(t, fileutil.Exist(filepath.Join(path, "f", fileName)))
+	keys, err := objectStore.List(storeUUID + "/test_kv/f/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{storeUUID + "/test_kv/f/" + fileName}, keys)
+	snapshot.Close()
+
+	// fetch file lazily after evicting cache
+	kv.evictFamilyFile(files[0].GetFileNumber())
+	snapshot = f1.GetSnapshot()
+	readers, err := snapshot.FindReaders(10)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, _ := readers[0].Get(10)
+	assert.Equal(t, []byte("test10test10"), value)
+	snapshot.Close()
+	assert.True(t, fileutil.Exist(filepath.Join(path, "f", fileName)))
+
+	// fetch file failure
+	assert.Error(t, kv.(*store).fetchFile("f", "000100.sst", filepath.Join(path, "f", "000100.sst")))
+
+	// backup fetches offloaded file from cold tier storage
+	assert.NoError(t, removeFunc(filepath.Join(path, "f", fileName)))
+	target := filepath.Join(t.TempDir(), "backup")
+	assert.NoError(t, kv.Backup(target))
+	assert.True(t, fileutil.Exist(filepath.Join(target, "f", fileName)))
+	// backup doesn't share the uuid of store
+	info := &storeInfo{}
+	assert.NoError(t, ltoml.DecodeToml(filepath.Join(target, version.Options), info))
+	assert.Empty(t, info.UUID)
+	// store without uuid assigns new uuid when open
+	restored, err := newStore("test_kv", target, DefaultStoreOption())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, restored.(*store).storeInfo.UUID)
+	assert.NotEqual(t, storeUUID, restored.(*store).storeInfo.UUID)
+	assert.NoError(t, restored.close())
+}
+
+func TestStore_Backup(t *testing.T) {
+	defer func() {
+		mkDirFunc = fileutil.MkDirIfNotExist
+		encodeTomlFunc = ltoml.EncodeToml
+		linkOrCopyFunc = fileutil.LinkOrCopy
+	}()
+	path := filepath.Join(t.TempDir(), "backup_test")
+	kv, err := newStore("test_kv", path, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	f1, err := kv.CreateFamily("f", FamilyOption{
+		CompactThreshold: 2,
+		Merger:           mergerStr,
+	})
+	assert.NoError(t, err)
+	flusher := f1.NewFlusher()
+	_ = flusher.Add(10, []byte("test10"))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+
+	target := filepath.Join(t.TempDir(), "backup")
+	// case 1: create backup path failure
+	mkDirFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, kv.Backup(target))
+	mkDirFunc = fileutil.MkDirIfNotExist
+	// case 2: write store info failure
+	encodeTomlFunc = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, kv.Backup(target))
+	encodeTomlFunc = ltoml.EncodeToml
+	// case 3: backup family file failure
+	linkOrCopyFunc = func(src, dst string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, kv.Backup(target))
+	linkOrCopyFunc = fileutil.LinkOrCopy
+	// case 4: backup successfully
+	assert.NoError(t, kv.Backup(target))
+
+	// write data after backup, not visible in backup store
+	flusher = f1.NewFlusher()
+	_ = flusher.Add(20, []byte("test20"))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+
+	backup, err := newStore("backup", target, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, backup.close())
+	}()
+	snapshot := backup.GetFamily("f").GetSnapshot()
+	defer snapshot.Close()
+	readers, err := snapshot.FindReaders(10)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, _ := readers[0].Get(10)
+	assert.Equal(t, []byte("test10"), value)
+	readers, err = snapshot.FindReaders(20)
+	assert.NoError(t, err)
+	assert.Empty(t, readers)
+}
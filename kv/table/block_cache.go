@@ -0,0 +1,122 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/lindb/lindb/metrics"
+)
+
+// blockKey represents the key of decompressed value in block cache.
+type blockKey struct {
+	fileName string
+	idx      int
+}
+
+// blockEntry represents the decompressed value in block cache.
+type blockEntry struct {
+	key   blockKey
+	value []byte
+}
+
+// blockCache caches the decompressed values of compressed sst files based on lru,
+// the total size of cached values is bounded by capacity.
+type blockCache struct {
+	capacity  int
+	size      int
+	items     map[blockKey]*list.Element
+	files     map[string]map[int]struct{} // file name => cached value indexes
+	evictList *list.List
+	mutex     sync.Mutex
+}
+
+// newBlockCache creates a block cache with max cached bytes.
+func newBlockCache(capacity int) *blockCache {
+	return &blockCache{
+		capacity:  capacity,
+		items:     make(map[blockKey]*list.Element),
+		files:     make(map[string]map[int]struct{}),
+		evictList: list.New(),
+	}
+}
+
+// get returns the decompressed value of file by index.
+func (c *blockCache) get(fileName string, idx int) ([]byte, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if ent, ok := c.items[blockKey{fileName: fileName, idx: idx}]; ok {
+		c.evictList.MoveToFront(ent)
+		metrics.TableCacheStatistics.BlockHit.Incr()
+		return ent.Value.(*blockEntry).value, true
+	}
+	metrics.TableCacheStatistics.BlockMiss.Incr()
+	return nil, false
+}
+
+// put puts the decompressed value of file into cache, evicts the oldest values if cache is full.
+func (c *blockCache) put(fileName string, idx int, value []byte) {
+	if len(value) > c.capacity {
+		// value too large, no cache
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := blockKey{fileName: fileName, idx: idx}
+	if _, ok := c.items[key]; ok {
+		return
+	}
+	for c.size+len(value) > c.capacity {
+		c.removeElement(c.evictList.Back())
+	}
+	c.items[key] = c.evictList.PushFront(&blockEntry{key: key, value: value})
+	if indexes, ok := c.files[fileName]; ok {
+		indexes[idx] = struct{}{}
+	} else {
+		c.files[fileName] = map[int]struct{}{idx: {}}
+	}
+	c.size += len(value)
+}
+
+// evictFile evicts all cached values of file, after file reader closed.
+func (c *blockCache) evictFile(fileName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for idx := range c.files[fileName] {
+		if ent, ok := c.items[blockKey{fileName: fileName, idx: idx}]; ok {
+			c.removeElement(ent)
+		}
+	}
+}
+
+// removeElement removes the given list element from the cache.
+func (c *blockCache) removeElement(e *list.Element) {
+	c.evictList.Remove(e)
+	entry := e.Value.(*blockEntry)
+	delete(c.items, entry.key)
+	indexes := c.files[entry.key.fileName]
+	delete(indexes, entry.key.idx)
+	if len(indexes) == 0 {
+		delete(c.files, entry.key.fileName)
+	}
+	c.size -= len(entry.value)
+}
@@ -0,0 +1,107 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCache_PutGet(t *testing.T) {
+	c := newBlockCache(10)
+	_, ok := c.get("1.sst", 0)
+	assert.False(t, ok)
+
+	c.put("1.sst", 0, []byte("12345"))
+	c.put("1.sst", 0, []byte("12345")) // put exist value
+	v, ok := c.get("1.sst", 0)
+	assert.True(t, ok)
+	assert.Equal(t, "12345", string(v))
+	assert.Equal(t, 5, c.size)
+
+	// value too large
+	c.put("1.sst", 1, []byte("12345678901"))
+	_, ok = c.get("1.sst", 1)
+	assert.False(t, ok)
+
+	// evict oldest value if cache full
+	c.put("2.sst", 0, []byte("abcd"))
+	_, _ = c.get("1.sst", 0)
+	c.put("2.sst", 1, []byte("efgh"))
+	_, ok = c.get("2.sst", 0)
+	assert.False(t, ok)
+	_, ok = c.get("1.sst", 0)
+	assert.True(t, ok)
+	_, ok = c.get("2.sst", 1)
+	assert.True(t, ok)
+	assert.Equal(t, 9, c.size)
+}
+
+func TestBlockCache_evictFile(t *testing.T) {
+	c := newBlockCache(100)
+	c.put("1.sst", 0, []byte("a"))
+	c.put("1.sst", 1, []byte("b"))
+	c.put("2.sst", 0, []byte("c"))
+	c.evictFile("1.sst")
+	c.evictFile("3.sst")
+	_, ok := c.get("1.sst", 0)
+	assert.False(t, ok)
+	_, ok = c.get("1.sst", 1)
+	assert.False(t, ok)
+	_, ok = c.get("2.sst", 0)
+	assert.True(t, ok)
+	assert.Equal(t, 1, c.size)
+	assert.Len(t, c.files, 1)
+}
+
+func TestStoreMMapReader_BlockCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "000010.sst")
+	builder, err := NewCompressedStoreBuilder(10, path, ZstdCompression)
+	assert.NoError(t, err)
+	value := bytes.Repeat([]byte("series"), 50)
+	assert.NoError(t, builder.Add(1, value))
+	assert.NoError(t, builder.Close())
+
+	r, err := newMMapStoreReader(path, "000010.sst")
+	assert.NoError(t, err)
+	blocks := newBlockCache(1024)
+	r.(*storeMMapReader).blocks = blocks
+	v, err := r.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, value, v)
+	cached, ok := blocks.get("000010.sst", 0)
+	assert.True(t, ok)
+	assert.Equal(t, value, cached)
+	// get value from cache
+	v, err = r.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, value, v)
+	// iterator doesn't fill cache
+	blocks.evictFile("000010.sst")
+	it := r.Iterator()
+	for it.HasNext() {
+		_ = it.Key()
+		assert.Equal(t, value, it.Value())
+	}
+	_, ok = blocks.get("000010.sst", 0)
+	assert.False(t, ok)
+	assert.NoError(t, r.Close())
+}
@@ -103,22 +103,31 @@ type storeBuilder struct {
 	minKey uint32
 	maxKey uint32
 
+	compression CompressionType
+	scratch     []byte // buffer for compressed value
+
 	first bool
 }
 
 // NewStoreBuilder creates store builder instance for building store file
 func NewStoreBuilder(fileNumber FileNumber, fileName string) (Builder, error) {
+	return NewCompressedStoreBuilder(fileNumber, fileName, NoCompression)
+}
+
+// NewCompressedStoreBuilder creates store builder instance which compresses each value with given compression type.
+func NewCompressedStoreBuilder(fileNumber FileNumber, fileName string, compression CompressionType) (Builder, error) {
 	writer, err := newBufioWriterFunc(fileName)
 	if err != nil {
 		return nil, fmt.Errorf("create file write for store builder error:%s", err)
 	}
 	return &storeBuilder{
-		fileNumber: fileNumber,
-		fileName:   fileName,
-		keys:       roaring.New(),
-		writer:     writer,
-		first:      true,
-		offset:     encoding.NewFixedOffsetEncoder(true),
+		fileNumber:  fileNumber,
+		fileName:    fileName,
+		keys:        roaring.New(),
+		writer:      writer,
+		first:       true,
+		compression: compression,
+		offset:      encoding.NewFixedOffsetEncoder(true),
 	}, nil
 }
 
@@ -162,8 +171,8 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 
 	// get write offset
 	offset := b.writer.Size()
-	if _, err := b.writer.Write(value); err != nil {
-		return fmt.Errorf("write data into store file error:%s", err)
+	if err := b.writeValue(value); err != nil {
+		return err
 	}
 	metrics.TableWriteStatistics.AddKeys.Incr()
 	metrics.TableWriteStatistics.WriteBytes.Add(float64(len(value)))
@@ -171,6 +180,22 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 	return nil
 }
 
+// writeValue writes value into store file, compresses it if builder enables compression.
+func (b *storeBuilder) writeValue(value []byte) (err error) {
+	if b.compression != NoCompression {
+		b.scratch, err = b.compression.compress(b.scratch[:0], value)
+		if err != nil {
+			return err
+		}
+		metrics.TableWriteStatistics.CompressedBytes.Add(float64(len(b.scratch)))
+		value = b.scratch
+	}
+	if _, err = b.writer.Write(value); err != nil {
+		return fmt.Errorf("write data into store file error:%s", err)
+	}
+	return nil
+}
+
 // MinKey returns min key in store
 func (b *storeBuilder) MinKey() uint32 {
 	return b.minKey
@@ -222,16 +247,25 @@ func (b *storeBuilder) Close() (err error) {
 		return err
 	}
 
-	// for file footer for offsets/keys index, length=1+4+4+8
-	var buf [17]byte
-	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
-	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
-	buf[8] = version0
-	binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
-	if _, err = b.writer.Write(buf[:]); err != nil {
+	if b.compression == NoCompression {
+		// for file footer for offsets/keys index, length=4+4+1+8
+		var buf [sstFileFooterSize]byte
+		binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
+		binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
+		buf[8] = version0
+		binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
+		_, err = b.writer.Write(buf[:])
 		return err
 	}
-	return nil
+	// for file footer for offsets/keys index with compression, length=4+4+1+1+8
+	var buf [sstFileFooterSizeV1]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
+	buf[8] = byte(b.compression)
+	buf[9] = version1
+	binary.LittleEndian.PutUint64(buf[10:], magicNumberOffsetFile)
+	_, err = b.writer.Write(buf[:])
+	return err
 }
 
 func (b *storeBuilder) StreamWriter() StreamWriter {
@@ -253,6 +287,7 @@ type streamWriter struct {
 	offset  int64
 	badKey  bool
 	crc32   hash.Hash32
+	buf     []byte // buffers value if builder enables compression
 }
 
 func (sw *streamWriter) Prepare(key uint32) {
@@ -260,6 +295,7 @@ func (sw *streamWriter) Prepare(key uint32) {
 	sw.offset = sw.builder.writer.Size()
 	sw.key = key
 	sw.size = 0
+	sw.buf = sw.buf[:0]
 	sw.crc32.Reset()
 }
 
@@ -267,6 +303,13 @@ func (sw *streamWriter) Write(data []byte) (int, error) {
 	if sw.badKey {
 		return 0, nil
 	}
+	if sw.builder.compression != NoCompression {
+		// compress whole value when commit
+		sw.buf = append(sw.buf, data...)
+		_, _ = sw.crc32.Write(data)
+		sw.size += uint32(len(data))
+		return len(data), nil
+	}
 	n, err := sw.builder.writer.Write(data)
 	_, _ = sw.crc32.Write(data)
 	if err == nil {
@@ -288,6 +331,13 @@ func (sw *streamWriter) Commit() error {
 	if sw.badKey {
 		return nil
 	}
+	if sw.builder.compression != NoCompression {
+		sw.offset = sw.builder.writer.Size()
+		if err := sw.builder.writeValue(sw.buf); err != nil {
+			return err
+		}
+		metrics.TableWriteStatistics.WriteBytes.Add(float64(len(sw.buf)))
+	}
 	sw.builder.afterWrite(sw.key, int(sw.offset))
 	// preventing committing twice
 	sw.badKey = true
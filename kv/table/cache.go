@@ -24,8 +24,10 @@ import (
 	"time"
 
 	"go.uber.org/atomic"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -35,8 +37,12 @@ import (
 // for test
 var (
 	newMMapStoreReaderFunc = newMMapStoreReader
+	existFunc              = fileutil.Exist
 )
 
+// FileFetcher fetches the file which not exist in local disk(offloaded to cold tier storage).
+type FileFetcher func(family, fileName, path string) error
+
 // Cache caches table readers.
 type Cache interface {
 	// GetReader returns store reader from cache, create new reader if not exist.
@@ -57,6 +63,9 @@ type storeCache struct {
 	storePath string
 	families  map[string]map[string]struct{} // family name => files
 	cache     *LRUCache
+	blocks    *blockCache // decompressed values of compressed files
+	fetcher   FileFetcher
+	fetching  singleflight.Group // fetches offloaded file once for concurrent readers
 	mutex     sync.Mutex
 }
 
@@ -67,6 +76,20 @@ func NewCache(storePath string, ttl time.Duration) Cache {
 		storePath: storePath,
 		families:  make(map[string]map[string]struct{}),
 		cache:     NewLRUCache(),
+		blocks:    newBlockCache(defaultBlockCacheSize),
+	}
+}
+
+// NewTieredCache creates cache for store readers,
+// fetches the file by fetcher lazily if it not exists in local disk.
+func NewTieredCache(storePath string, ttl time.Duration, fetcher FileFetcher) Cache {
+	return &storeCache{
+		ttl:       ttl,
+		storePath: storePath,
+		families:  make(map[string]map[string]struct{}),
+		cache:     NewLRUCache(),
+		blocks:    newBlockCache(defaultBlockCacheSize),
+		fetcher:   fetcher,
 	}
 }
 
@@ -95,24 +118,46 @@ func (c *storeCache) ReleaseReaders(readers []Reader) {
 
 // GetReader returns store reader from cache, create new reader if not exist.
 func (c *storeCache) GetReader(family, fileName string) (Reader, error) {
+	c.mutex.Lock()
+	reader, ok := c.getCachedReader(fileName)
+	c.mutex.Unlock()
+	if ok {
+		return reader, nil
+	}
+
+	path := filepath.Join(c.storePath, family, fileName)
+	if c.fetcher != nil && !existFunc(path) {
+		// file offloaded to cold tier storage, fetch it lazily without holding lock,
+		// concurrent readers of the same file wait the single fetching.
+		if _, err, _ := c.fetching.Do(path, func() (interface{}, error) {
+			if existFunc(path) {
+				return nil, nil
+			}
+			return nil, c.fetcher(family, fileName, path)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	// find from cache
-	if entry, ok := c.cache.Get(fileName); ok {
-		entry.retain()
-		metrics.TableCacheStatistics.Hit.Incr()
-		return entry.reader, nil
+	// find from cache again, reader maybe created by others when fetching file
+	if reader, ok := c.getCachedReader(fileName); ok {
+		return reader, nil
 	}
 
 	metrics.TableCacheStatistics.Miss.Incr()
 	metrics.TableCacheStatistics.ActiveReaders.Incr()
 	// create new reader
-	path := filepath.Join(c.storePath, family, fileName)
 	newReader, err := newMMapStoreReaderFunc(path, fileName)
 	if err != nil {
 		return nil, err
 	}
+	if r, ok := newReader.(*storeMMapReader); ok {
+		// decompressed values of file are cached until reader evicted
+		r.blocks = c.blocks
+	}
 	entry := &cacheEntry{
 		key:      fileName,
 		reader:   newReader,
@@ -131,6 +176,16 @@ func (c *storeCache) GetReader(family, fileName string) (Reader, error) {
 	return newReader, nil
 }
 
+// getCachedReader returns store reader from cache if exist, must hold lock.
+func (c *storeCache) getCachedReader(fileName string) (Reader, bool) {
+	if entry, ok := c.cache.Get(fileName); ok {
+		entry.retain()
+		metrics.TableCacheStatistics.Hit.Incr()
+		return entry.reader, true
+	}
+	return nil, false
+}
+
 // Cleanup cleans the expired reader from cache.
 func (c *storeCache) Cleanup() {
 	c.mutex.Lock()
@@ -154,6 +209,7 @@ func (c *storeCache) Close() error {
 
 	c.cache.Purge(func(entry *cacheEntry) {
 		c.closeReader(entry)
+		c.blocks.evictFile(entry.fileName)
 		metrics.TableCacheStatistics.Evict.Incr()
 	})
 	return nil
@@ -174,6 +230,7 @@ func (c *storeCache) closeReader(entry *cacheEntry) {
 
 func (c *storeCache) evict(entry *cacheEntry) {
 	c.closeReader(entry)
+	c.blocks.evictFile(entry.fileName)
 
 	files := c.families[entry.family]
 	delete(files, entry.fileName)
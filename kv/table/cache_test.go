@@ -19,11 +19,15 @@ package table
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 )
 
 func TestMapCache_GetReader(t *testing.T) {
@@ -106,3 +110,65 @@ func TestStoreCache_Cleanup(t *testing.T) {
 	err = cache.Close()
 	assert.NoError(t, err)
 }
+
+func TestStoreCache_Fetcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newMMapStoreReaderFunc = newMMapStoreReader
+		ctrl.Finish()
+	}()
+	dir := t.TempDir()
+	fetched := ""
+	fetching := make(chan struct{})
+	var fetches atomic.Int32
+	cache := NewTieredCache(dir, time.Hour, func(family, fileName, path string) error {
+		switch fileName {
+		case "200000.sst":
+			return fmt.Errorf("err")
+		case "300000.sst":
+			fetches.Inc()
+			<-fetching
+			return os.WriteFile(path, []byte("sst"), 0644)
+		}
+		fetched = path
+		return nil
+	})
+	mockReader := NewMockReader(ctrl)
+	newMMapStoreReaderFunc = func(path, fileName string) (reader Reader, err error) {
+		return mockReader, nil
+	}
+	// case 1: fetch file failure
+	r, err := cache.GetReader("f", "200000.sst")
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	// case 2: fetch file successfully
+	r, err = cache.GetReader("f", "100000.sst")
+	assert.NoError(t, err)
+	assert.Equal(t, mockReader, r)
+	assert.Equal(t, filepath.Join(dir, "f", "100000.sst"), fetched)
+	// case 3: fetch file without holding lock, concurrent readers of the same file fetch it once
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "f"), 0755))
+	var wait sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			r, err := cache.GetReader("f", "300000.sst")
+			assert.NoError(t, err)
+			assert.Equal(t, mockReader, r)
+		}()
+	}
+	assert.Eventually(t, func() bool {
+		return fetches.Load() == 1
+	}, time.Second, time.Millisecond)
+	// cached reader is returned when fetching other file
+	r, err = cache.GetReader("f", "100000.sst")
+	assert.NoError(t, err)
+	assert.Equal(t, mockReader, r)
+	close(fetching)
+	wait.Wait()
+	assert.Equal(t, int32(1), fetches.Load())
+
+	mockReader.EXPECT().Close().Return(nil).Times(2)
+	assert.NoError(t, cache.Close())
+}
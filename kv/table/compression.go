@@ -0,0 +1,100 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionType represents the block compression algorithm of sst file values.
+type CompressionType uint8
+
+const (
+	// NoCompression stores value raw.
+	NoCompression CompressionType = iota
+	// SnappyCompression compresses value by snappy.
+	SnappyCompression
+	// ZstdCompression compresses value by zstd.
+	ZstdCompression
+)
+
+// zstd encoder/decoder are safe for concurrent use by EncodeAll/DecodeAll.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompressionType parses compression type from family option's value.
+func ParseCompressionType(name string) (CompressionType, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return NoCompression, nil
+	case "snappy":
+		return SnappyCompression, nil
+	case "zstd":
+		return ZstdCompression, nil
+	default:
+		return NoCompression, fmt.Errorf("unknown compression type: %s", name)
+	}
+}
+
+// String returns the string value of compression type.
+func (c CompressionType) String() string {
+	switch c {
+	case NoCompression:
+		return "none"
+	case SnappyCompression:
+		return "snappy"
+	case ZstdCompression:
+		return "zstd"
+	default:
+		return "unknown"
+	}
+}
+
+// compress appends compressed value of src to dst, returns the new dst.
+func (c CompressionType) compress(dst, src []byte) ([]byte, error) {
+	switch c {
+	case NoCompression:
+		return append(dst, src...), nil
+	case SnappyCompression:
+		return snappy.Encode(dst[:cap(dst)], src), nil
+	case ZstdCompression:
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	default:
+		return nil, fmt.Errorf("compress value with unknown compression type: %d", c)
+	}
+}
+
+// decompress returns decompressed value of src.
+func (c CompressionType) decompress(src []byte) ([]byte, error) {
+	switch c {
+	case NoCompression:
+		return src, nil
+	case SnappyCompression:
+		return snappy.Decode(nil, src)
+	case ZstdCompression:
+		return zstdDecoder.DecodeAll(src, nil)
+	default:
+		return nil, fmt.Errorf("decompress value with unknown compression type: %d", c)
+	}
+}
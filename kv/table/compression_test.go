@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompressionType(t *testing.T) {
+	cases := []struct {
+		name        string
+		compression CompressionType
+		wantErr     bool
+	}{
+		{name: "", compression: NoCompression},
+		{name: "none", compression: NoCompression},
+		{name: "Snappy", compression: SnappyCompression},
+		{name: "zstd", compression: ZstdCompression},
+		{name: "lz4", wantErr: true},
+	}
+	for _, c := range cases {
+		compression, err := ParseCompressionType(c.name)
+		if c.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, c.compression, compression)
+	}
+	assert.Equal(t, "none", NoCompression.String())
+	assert.Equal(t, "snappy", SnappyCompression.String())
+	assert.Equal(t, "zstd", ZstdCompression.String())
+	assert.Equal(t, "unknown", CompressionType(100).String())
+}
+
+func TestCompressionType_compress(t *testing.T) {
+	value := bytes.Repeat([]byte("tag-value"), 100)
+	for _, c := range []CompressionType{NoCompression, SnappyCompression, ZstdCompression} {
+		compressed, err := c.compress(nil, value)
+		assert.NoError(t, err)
+		if c != NoCompression {
+			assert.True(t, len(compressed) < len(value))
+		}
+		decompressed, err := c.decompress(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, value, decompressed)
+	}
+	_, err := CompressionType(100).compress(nil, value)
+	assert.Error(t, err)
+	_, err = CompressionType(100).decompress(value)
+	assert.Error(t, err)
+	_, err = SnappyCompression.decompress([]byte{1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestStoreBuilder_Compression(t *testing.T) {
+	for _, c := range []CompressionType{SnappyCompression, ZstdCompression} {
+		path := filepath.Join(t.TempDir(), "000010.sst")
+		builder, err := NewCompressedStoreBuilder(10, path, c)
+		assert.NoError(t, err)
+		value := bytes.Repeat([]byte("series"), 50)
+		assert.NoError(t, builder.Add(1, value))
+		// stream writer
+		sw := builder.StreamWriter()
+		sw.Prepare(5)
+		_, _ = sw.Write([]byte("stream-"))
+		_, _ = sw.Write([]byte("value"))
+		assert.Equal(t, uint32(12), sw.Size())
+		assert.NoError(t, sw.Commit())
+		assert.NoError(t, builder.Add(10, nil))
+		assert.NoError(t, builder.Close())
+
+		r, err := newMMapStoreReader(path, "000010.sst")
+		assert.NoError(t, err)
+		assert.Equal(t, c, r.(*storeMMapReader).compression)
+		v, err := r.Get(1)
+		assert.NoError(t, err)
+		assert.Equal(t, value, v)
+		v, err = r.Get(5)
+		assert.NoError(t, err)
+		assert.Equal(t, "stream-value", string(v))
+		v, err = r.Get(10)
+		assert.NoError(t, err)
+		assert.Empty(t, v)
+
+		it := r.Iterator()
+		var keys []uint32
+		var values []string
+		for it.HasNext() {
+			keys = append(keys, it.Key())
+			values = append(values, string(it.Value()))
+		}
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []uint32{1, 5, 10}, keys)
+		assert.Equal(t, []string{string(value), "stream-value", ""}, values)
+		// decompress failure stops iteration
+		r.(*storeMMapReader).compression = CompressionType(100)
+		it = r.Iterator()
+		assert.True(t, it.HasNext())
+		_ = it.Key()
+		_ = it.Value()
+		assert.Error(t, it.Err())
+		assert.False(t, it.HasNext())
+		assert.NoError(t, r.Close())
+	}
+}
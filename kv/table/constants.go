@@ -30,14 +30,21 @@ var (
 const (
 	// magic-number in the footer of sst file
 	magicNumberOffsetFile uint64 = 0x69632d656d656c65
-	// current file layout version
+	// file layout version without value compression
 	version0 = 0
+	// file layout version with value compression, footer includes compression type
+	version1 = 1
 
 	sstFileFooterSize = 4 + // posOfOffset(4)
 		4 + // posOfKeys(4)
 		1 + // version(1)
 		8 // magicNumber(8)
+	sstFileFooterSizeV1 = sstFileFooterSize +
+		1 // compression(1)
 	magicNumberAtFooter = 9
+
+	// max bytes of decompressed values cached by block cache of store
+	defaultBlockCacheSize = 64 * 1024 * 1024
 )
 
 var tableLogger = logger.GetLogger("KV", "Table")
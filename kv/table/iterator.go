@@ -32,6 +32,8 @@ type Iterator interface {
 	Key() uint32
 	// Value returns the value of the current key/value pair
 	Value() []byte
+	// Err returns the first error encountered when reading value, iteration stops after error.
+	Err() error
 }
 
 /////////////
@@ -46,6 +48,7 @@ type mergedIterator struct {
 
 	curKey   uint32
 	curValue []byte
+	err      error
 }
 
 // NewMergedIterator create merged iterator for multi iterators
@@ -68,6 +71,10 @@ func (m *mergedIterator) initQueue() {
 				value: it.Value(),
 				index: i,
 			})
+			if err := it.Err(); err != nil {
+				m.err = err
+				return
+			}
 			i++
 		}
 	}
@@ -79,6 +86,9 @@ func (m *mergedIterator) initQueue() {
 // HasNext returns if the iteration has more element.
 // It returns false if the iterator is exhausted.
 func (m *mergedIterator) HasNext() bool {
+	if m.err != nil {
+		return false
+	}
 	result := len(m.pq) > 0
 	if result {
 		// pop item and get value
@@ -92,6 +102,10 @@ func (m *mergedIterator) HasNext() bool {
 		if it.HasNext() {
 			item.key = it.Key()
 			item.value = it.Value()
+			if err := it.Err(); err != nil {
+				m.err = err
+				return false
+			}
 			m.pq.Push(item)
 			m.pq.update(item)
 		}
@@ -110,6 +124,11 @@ func (m *mergedIterator) Value() []byte {
 	return m.curValue
 }
 
+// Err returns the first error encountered by underlying iterators.
+func (m *mergedIterator) Err() error {
+	return m.err
+}
+
 // item represents an item under priority queue, using key as priority.
 type item struct {
 	it Iterator
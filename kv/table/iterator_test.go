@@ -18,6 +18,7 @@
 package table
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 
@@ -153,8 +154,25 @@ func TestMergedIterator_complex(t *testing.T) {
 	assert.Equal(t, len(keys), i)
 }
 
+func TestMergedIterator_Err(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	it1 := NewMockIterator(ctrl)
+	it1.EXPECT().HasNext().Return(true)
+	it1.EXPECT().Key().Return(uint32(1))
+	it1.EXPECT().Value().Return(nil)
+	it1.EXPECT().Err().Return(fmt.Errorf("err"))
+	it := NewMergedIterator([]Iterator{it1})
+	assert.False(t, it.HasNext())
+	assert.Error(t, it.Err())
+	// iteration stops after error
+	assert.False(t, it.HasNext())
+}
+
 func generateIterator(ctrl *gomock.Controller, values map[uint32][]byte) *MockIterator {
 	it1 := NewMockIterator(ctrl)
+	it1.EXPECT().Err().Return(nil).AnyTimes()
 	var keys []uint32
 	for key := range values {
 		keys = append(keys, key)
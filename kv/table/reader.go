@@ -69,6 +69,8 @@ type storeMMapReader struct {
 	entriesBlock []byte                       // mmaped file content without footer
 	keys         *roaring.Bitmap              // bitmap of keys
 	offsets      *encoding.FixedOffsetDecoder // offset of values
+	compression  CompressionType              // compression type of values
+	blocks       *blockCache                  // cache of decompressed values, nil if reader isn't cached
 }
 
 // newMMapStoreReader creates mmap store file reader.
@@ -126,6 +128,18 @@ func (r *storeMMapReader) initialize() error {
 	if uint64Func(r.fullBlock[footerStart+magicNumberAtFooter:]) != magicNumberOffsetFile {
 		return fmt.Errorf("verify magic-number of sstfile:%s failure", r.path)
 	}
+	switch r.fullBlock[footerStart+8] {
+	case version0:
+	case version1:
+		// footer with compression type before version
+		footerStart = len(r.fullBlock) - sstFileFooterSizeV1
+		if footerStart < 0 {
+			return fmt.Errorf("length of sstfile:%s length is too short", r.path)
+		}
+		r.compression = CompressionType(r.fullBlock[footerStart+8])
+	default:
+		return fmt.Errorf("unknown layout version of sstfile:%s", r.path)
+	}
 	posOfOffset := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart : footerStart+4]))
 	posOfKeys := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart+4 : footerStart+8]))
 	if !intsAreSortedFunc([]int{
@@ -174,18 +188,39 @@ func (r *storeMMapReader) Get(key uint32) ([]byte, error) {
 	}
 	// bitmap data's index from 1, so idx= get index - 1
 	idx := r.keys.Rank(key)
-	return r.getBlock(int(idx) - 1)
+	return r.getBlock(int(idx)-1, true)
 }
 
-func (r *storeMMapReader) getBlock(idx int) ([]byte, error) {
+// getBlock returns the value by index, decompresses the value if file is compressed,
+// decompressed value is cached in block cache if cacheable.
+func (r *storeMMapReader) getBlock(idx int, cacheable bool) ([]byte, error) {
 	block, err := r.offsets.GetBlock(idx, r.entriesBlock)
-	if err == nil {
-		metrics.TableReadStatistics.Gets.Incr()
-		metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
-	} else {
-		metrics.TableReadStatistics.GetFailures.Get()
+	if err != nil {
+		metrics.TableReadStatistics.GetFailures.Incr()
+		return block, err
+	}
+	metrics.TableReadStatistics.Gets.Incr()
+	metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
+	if r.compression == NoCompression {
+		return block, nil
+	}
+	cacheable = cacheable && r.blocks != nil
+	if cacheable {
+		if value, ok := r.blocks.get(r.fileName, idx); ok {
+			return value, nil
+		}
 	}
-	return block, err
+	// value is compressed, decompress it into heap memory
+	value, err := r.compression.decompress(block)
+	if err != nil {
+		metrics.TableReadStatistics.DecompressFailures.Incr()
+		return nil, fmt.Errorf("decompress value from file[%s] error:%s", r.path, err)
+	}
+	metrics.TableReadStatistics.DecompressBytes.Add(float64(len(value)))
+	if cacheable {
+		r.blocks.put(r.fileName, idx, value)
+	}
+	return value, nil
 }
 
 // Iterator iterates over a store's key/value pairs in key order.
@@ -214,6 +249,7 @@ type storeMMapIterator struct {
 	keyIt  roaring.IntIterable
 
 	idx int
+	err error
 }
 
 // newMMapIterator creates store iterator using mmap store reader
@@ -227,7 +263,7 @@ func newMMapIterator(reader *storeMMapReader) Iterator {
 // HasNext returns if the iteration has more element.
 // It returns false if the iterator is exhausted.
 func (it *storeMMapIterator) HasNext() bool {
-	return it.keyIt.HasNext()
+	return it.err == nil && it.keyIt.HasNext()
 }
 
 // Key returns the key of the current key/value pair
@@ -238,7 +274,16 @@ func (it *storeMMapIterator) Key() uint32 {
 
 // Value returns the value of the current key/value pair
 func (it *storeMMapIterator) Value() []byte {
-	block, _ := it.reader.getBlock(it.idx)
+	// iterator reads all values of file(e.g. compaction), no cache for decompressed values
+	block, err := it.reader.getBlock(it.idx, false)
 	it.idx++
+	if err != nil && it.err == nil {
+		it.err = err
+	}
 	return block
 }
+
+// Err returns the first error encountered when reading value(e.g. decompress failure).
+func (it *storeMMapIterator) Err() error {
+	return it.err
+}
@@ -100,15 +100,15 @@ func TestStoreMMapReader_readBytes_Err(t *testing.T) {
 	assert.NotNil(t, r)
 	assert.Equal(t, "000010.sst", r.FileName())
 
-	block, err := r.(*storeMMapReader).getBlock(0)
+	block, err := r.(*storeMMapReader).getBlock(0, false)
 	assert.NoError(t, err)
 	assert.Equal(t, "test", string(block))
 
-	block, err = r.(*storeMMapReader).getBlock(1)
+	block, err = r.(*storeMMapReader).getBlock(1, false)
 	assert.NoError(t, err)
 	assert.Equal(t, "test10", string(block))
 
-	block, err = r.(*storeMMapReader).getBlock(2)
+	block, err = r.(*storeMMapReader).getBlock(2, false)
 	assert.Error(t, err)
 	assert.Len(t, block, 0)
 	assert.NoError(t, r.Close())
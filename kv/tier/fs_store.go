@@ -0,0 +1,98 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tier
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileSystemStore implements ObjectStore interface, stores objects under the cold path,
+// for example, cheap disk mounted on storage node.
+type fileSystemStore struct {
+	dir string
+}
+
+// NewFileSystemStore creates the cold tier storage based on local file system.
+func NewFileSystemStore(dir string) ObjectStore {
+	return &fileSystemStore{
+		dir: dir,
+	}
+}
+
+// Upload copies local file into cold path.
+func (s *fileSystemStore) Upload(key, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return writeFile(s.path(key), f)
+}
+
+// Download copies the file under cold path into local file.
+func (s *fileSystemStore) Download(key, localPath string) error {
+	f, err := os.Open(s.path(key))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return writeFile(localPath, f)
+}
+
+// Delete removes the file under cold path.
+func (s *fileSystemStore) Delete(key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// List returns the keys of files under cold path with given prefix.
+func (s *fileSystemStore) List(prefix string) ([]string, error) {
+	var keys []string
+	root := s.path(prefix)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(rel))
+		return nil
+	})
+	return keys, err
+}
+
+// path returns the file path of key under cold path.
+func (s *fileSystemStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tier
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSystemStore(t *testing.T) {
+	localPath := filepath.Join(t.TempDir(), "000001.sst")
+	assert.NoError(t, os.WriteFile(localPath, []byte("sst"), 0644))
+	s := NewFileSystemStore(t.TempDir())
+	// list not exist dir
+	keys, err := s.List("db/shard/1/")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+	// upload
+	assert.Error(t, s.Upload("db/shard/1/f/000001.sst", "not_exist.sst"))
+	assert.NoError(t, s.Upload("db/shard/1/f/000001.sst", localPath))
+	assert.NoError(t, s.Upload("db/shard/2/f/000001.sst", localPath))
+	keys, err = s.List("db/shard/1/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db/shard/1/f/000001.sst"}, keys)
+	// download
+	downloadPath := filepath.Join(t.TempDir(), "f", "000001.sst")
+	assert.Error(t, s.Download("db/shard/1/f/000002.sst", downloadPath))
+	assert.NoError(t, s.Download("db/shard/1/f/000001.sst", downloadPath))
+	data, err := os.ReadFile(downloadPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "sst", string(data))
+	// delete
+	assert.NoError(t, s.Delete("db/shard/1/f/000001.sst"))
+	assert.NoError(t, s.Delete("db/shard/1/f/000001.sst"))
+	keys, err = s.List("db/shard/1/")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -0,0 +1,292 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tier
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lindb/lindb/config"
+)
+
+const (
+	s3Service         = "s3"
+	s3DefaultRegion   = "us-east-1"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+	s3SignAlgorithm   = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// for testing
+var (
+	nowFunc = time.Now
+)
+
+// s3Store implements ObjectStore interface based on S3-compatible api(path-style),
+// requests are signed by AWS signature version 4.
+type s3Store struct {
+	endpoint        string
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+}
+
+// NewS3Store creates the cold tier storage based on S3-compatible object store.
+func NewS3Store(cfg *config.Tier) ObjectStore {
+	region := cfg.Region
+	if region == "" {
+		region = s3DefaultRegion
+	}
+	return &s3Store{
+		endpoint:        strings.TrimSuffix(cfg.Endpoint, "/"),
+		region:          region,
+		bucket:          cfg.Bucket,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		client:          &http.Client{Timeout: cfg.Timeout.Duration()},
+	}
+}
+
+// Upload puts local file as the object.
+func (s *s3Store) Upload(key, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	req, err := s.newRequest(http.MethodPut, key, nil, f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = stat.Size()
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// Download gets the object into local file.
+func (s *s3Store) Download(key, localPath string) error {
+	req, err := s.newRequest(http.MethodGet, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	return writeFile(localPath, resp.Body)
+}
+
+// Delete deletes the object.
+func (s *s3Store) Delete(key string) error {
+	req, err := s.newRequest(http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// listBucketResult represents the response of ListObjectsV2.
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List lists the keys of objects with given prefix by ListObjectsV2.
+func (s *s3Store) List(prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		req, err := s.newRequest(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req)
+		if err != nil {
+			return nil, err
+		}
+		result := &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// newRequest creates a signed request for the object key.
+func (s *s3Store) newRequest(method, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	path := "/" + s.bucket
+	if key != "" {
+		path += "/" + key
+	}
+	u, err := url.Parse(s.endpoint + escapePath(path))
+	if err != nil {
+		return nil, err
+	}
+	if query != nil {
+		u.RawQuery = canonicalQuery(query)
+	}
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req)
+	return req, nil
+}
+
+// do sends the request, returns error if response status is not successful.
+func (s *s3Store) do(req *http.Request) (*http.Response, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("s3 request %s %s failure, status: %d, message: %s",
+			req.Method, req.URL.Path, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return resp, nil
+}
+
+// sign signs the request by AWS signature version 4, payload is unsigned for streaming upload.
+func (s *s3Store) sign(req *http.Request) {
+	now := nowFunc().UTC()
+	amzDate := now.Format(s3TimeFormat)
+	date := now.Format(s3DateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+	if s.accessKeyID == "" {
+		// anonymous access
+		return
+	}
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n",
+		req.URL.Host, s3UnsignedPayload, amzDate)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+	scope := strings.Join([]string{date, s.region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3SignAlgorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SignAlgorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the query string sorted by key, encoded as signature v4 required.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			pairs = append(pairs, escape(k)+"="+escape(v))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath escapes each segment of path.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// escape escapes string as RFC 3986 which signature v4 required.
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,179 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tier
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+// mockS3Server mocks S3-compatible api(path-style) based on memory.
+type mockS3Server struct {
+	objects map[string][]byte
+	mutex   sync.Mutex
+}
+
+func (m *mockS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get("X-Amz-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/lindb/")
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		m.objects[key] = data
+	case http.MethodGet:
+		if r.URL.Query().Get("list-type") == "2" {
+			m.list(w, r)
+			return
+		}
+		data, ok := m.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("NoSuchKey"))
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(m.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (m *mockS3Server) list(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+	var keys []string
+	for key := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	// return one key per page
+	start := 0
+	if token := r.URL.Query().Get("continuation-token"); token != "" {
+		_, _ = fmt.Sscanf(token, "%d", &start)
+	}
+	result := &listBucketResult{}
+	if start < len(keys) {
+		result.Contents = append(result.Contents, struct {
+			Key string `xml:"Key"`
+		}{Key: keys[start]})
+	}
+	if start+1 < len(keys) {
+		result.IsTruncated = true
+		result.NextContinuationToken = fmt.Sprintf("%d", start+1)
+	}
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func TestS3Store(t *testing.T) {
+	server := httptest.NewServer(&mockS3Server{objects: make(map[string][]byte)})
+	defer server.Close()
+
+	localPath := filepath.Join(t.TempDir(), "000001.sst")
+	assert.NoError(t, os.WriteFile(localPath, []byte("sst"), 0644))
+	s := NewS3Store(&config.Tier{
+		Endpoint:        server.URL + "/",
+		Bucket:          "lindb",
+		AccessKeyID:     "ak",
+		SecretAccessKey: "sk",
+		Timeout:         ltoml.Duration(time.Minute),
+	})
+	assert.Equal(t, time.Minute, s.(*s3Store).client.Timeout)
+	// upload
+	assert.Error(t, s.Upload("db/shard/1/f/000001.sst", "not_exist.sst"))
+	assert.NoError(t, s.Upload("db/shard/1/f/000001.sst", localPath))
+	assert.NoError(t, s.Upload("db/shard/1/f/000002.sst", localPath))
+	assert.NoError(t, s.Upload("db/shard/2/f/000001.sst", localPath))
+	// list with pages
+	keys, err := s.List("db/shard/1/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db/shard/1/f/000001.sst", "db/shard/1/f/000002.sst"}, keys)
+	// download
+	downloadPath := filepath.Join(t.TempDir(), "f", "000001.sst")
+	assert.Error(t, s.Download("db/shard/1/f/000003.sst", downloadPath))
+	assert.NoError(t, s.Download("db/shard/1/f/000001.sst", downloadPath))
+	data, err := os.ReadFile(downloadPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "sst", string(data))
+	// delete
+	assert.NoError(t, s.Delete("db/shard/1/f/000001.sst"))
+	keys, err = s.List("db/shard/1/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db/shard/1/f/000002.sst"}, keys)
+
+	// server not available
+	server.Close()
+	assert.Error(t, s.Upload("db/shard/1/f/000001.sst", localPath))
+	assert.Error(t, s.Download("db/shard/1/f/000001.sst", downloadPath))
+	assert.Error(t, s.Delete("db/shard/1/f/000001.sst"))
+	_, err = s.List("db/shard/1/")
+	assert.Error(t, err)
+}
+
+func TestS3Store_sign(t *testing.T) {
+	defer func() {
+		nowFunc = time.Now
+	}()
+	nowFunc = func() time.Time {
+		return time.Date(2013, 5, 24, 0, 0, 0, 0, time.UTC)
+	}
+	s := NewS3Store(&config.Tier{
+		Endpoint:        "http://localhost:9000",
+		Bucket:          "lindb",
+		AccessKeyID:     "ak",
+		SecretAccessKey: "sk",
+	}).(*s3Store)
+	assert.Equal(t, s3DefaultRegion, s.region)
+	req, err := s.newRequest(http.MethodGet, "", map[string][]string{"prefix": {"a b"}, "list-type": {"2"}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "list-type=2&prefix=a%20b", req.URL.RawQuery)
+	assert.Equal(t, "20130524T000000Z", req.Header.Get("X-Amz-Date"))
+	assert.True(t, strings.HasPrefix(req.Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=ak/20130524/us-east-1/s3/aws4_request, "+
+			"SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="))
+	// anonymous access
+	s.accessKeyID = ""
+	req, err = s.newRequest(http.MethodGet, "a", nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, req.Header.Get("Authorization"))
+	// bad endpoint
+	s.endpoint = "://localhost"
+	_, err = s.newRequest(http.MethodGet, "a", nil, nil)
+	assert.Error(t, err)
+}
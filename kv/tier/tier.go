@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tier
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+//go:generate mockgen -source ./tier.go -destination=./tier_mock.go -package tier
+
+var tierLogger = logger.GetLogger("KV", "Tier")
+
+// for testing
+var (
+	mkDirFunc  = fileutil.MkDirIfNotExist
+	renameFunc = os.Rename
+	createFunc = os.Create
+)
+
+// ObjectStore represents the cold tier storage which stores immutable kv table files.
+type ObjectStore interface {
+	// Upload uploads local file as the object with given key.
+	Upload(key, localPath string) error
+	// Download downloads the object with given key into local file.
+	Download(key, localPath string) error
+	// Delete deletes the object with given key.
+	Delete(key string) error
+	// List returns the keys of objects under given prefix.
+	List(prefix string) ([]string, error)
+}
+
+// NewObjectStore creates the cold tier storage based on config, returns nil if tier is disabled.
+func NewObjectStore(cfg *config.Tier) (ObjectStore, error) {
+	switch cfg.Type {
+	case "":
+		return nil, nil
+	case "fs":
+		return NewFileSystemStore(cfg.Dir), nil
+	case "s3":
+		return NewS3Store(cfg), nil
+	default:
+		return nil, fmt.Errorf("tier type [%s] not support", cfg.Type)
+	}
+}
+
+// writeFile writes the content of reader into local file atomically,
+// writes temp file firstly, then renames it.
+func writeFile(localPath string, r io.Reader) (err error) {
+	if err = mkDirFunc(filepath.Dir(localPath)); err != nil {
+		return err
+	}
+	tmpPath := localPath + ".tmp"
+	f, err := createFunc(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return renameFunc(tmpPath, localPath)
+}
@@ -0,0 +1,79 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tier
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestNewObjectStore(t *testing.T) {
+	s, err := NewObjectStore(&config.Tier{})
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+	s, err = NewObjectStore(&config.Tier{Type: "fs", Dir: t.TempDir()})
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+	s, err = NewObjectStore(&config.Tier{Type: "s3", Endpoint: "http://localhost:9000", Bucket: "lindb"})
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+	s, err = NewObjectStore(&config.Tier{Type: "hdfs"})
+	assert.Error(t, err)
+	assert.Nil(t, s)
+}
+
+func TestWriteFile(t *testing.T) {
+	defer func() {
+		mkDirFunc = fileutil.MkDirIfNotExist
+		renameFunc = os.Rename
+		createFunc = os.Create
+	}()
+	path := filepath.Join(t.TempDir(), "a", "000001.sst")
+	// case 1: mkdir failure
+	mkDirFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, writeFile(path, strings.NewReader("sst")))
+	mkDirFunc = fileutil.MkDirIfNotExist
+	// case 2: create file failure
+	createFunc = func(name string) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, writeFile(path, strings.NewReader("sst")))
+	createFunc = os.Create
+	// case 3: rename failure
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, writeFile(path, strings.NewReader("sst")))
+	assert.False(t, fileutil.Exist(path+".tmp"))
+	renameFunc = os.Rename
+	// case 4: write file successfully
+	assert.NoError(t, writeFile(path, strings.NewReader("sst")))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "sst", string(data))
+}
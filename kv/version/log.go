@@ -42,6 +42,8 @@ const (
 	NewReferenceFileLog
 	DeleteReferenceFileLog
 	SequenceNumberLog
+	OffloadFileLog
+	ImportLog
 )
 
 func init() {
@@ -77,6 +79,14 @@ func init() {
 	RegisterLogType(SequenceNumberLog, func() Log {
 		return &sequence{}
 	})
+	// register offload file
+	RegisterLogType(OffloadFileLog, func() Log {
+		return &offloadFile{}
+	})
+	// register import
+	RegisterLogType(ImportLog, func() Log {
+		return &importLog{}
+	})
 }
 
 // NewLogFunc creates specific edit log instance
@@ -452,3 +462,76 @@ func (s *sequence) apply(version Version) {
 func (s *sequence) String() string {
 	return fmt.Sprintf("sequence:{leader:%d,seq:%d}", s.leader, s.seq)
 }
+
+// offloadFile represents the file which is uploaded to cold tier storage.
+type offloadFile struct {
+	fileNumber table.FileNumber
+}
+
+// CreateOffloadFile creates an offload file log.
+func CreateOffloadFile(fileNumber table.FileNumber) Log {
+	return &offloadFile{
+		fileNumber: fileNumber,
+	}
+}
+
+// Encode writes offload file data into binary.
+func (o *offloadFile) Encode() ([]byte, error) {
+	writer := stream.NewBufferWriter(nil)
+	writer.PutVarint64(o.fileNumber.Int64())
+	return writer.Bytes()
+}
+
+// Decode reads offload file data from binary.
+func (o *offloadFile) Decode(v []byte) error {
+	reader := stream.NewReader(v)
+	o.fileNumber = table.FileNumber(reader.ReadVarint64())
+	return reader.Error()
+}
+
+// apply applies offload file edit log to version.
+func (o *offloadFile) apply(version Version) {
+	version.AddOffloadFile(o.fileNumber)
+}
+
+// String returns string value of offload file log.
+func (o *offloadFile) String() string {
+	return fmt.Sprintf("offloadFile:{fileNumber:%d}", o.fileNumber)
+}
+
+// importLog represents the import request which is applied to family,
+// used for skipping the retried import request.
+type importLog struct {
+	importID uint64
+}
+
+// CreateImport creates an import log.
+func CreateImport(importID uint64) Log {
+	return &importLog{
+		importID: importID,
+	}
+}
+
+// Encode writes import data into binary.
+func (i *importLog) Encode() ([]byte, error) {
+	writer := stream.NewBufferWriter(nil)
+	writer.PutUint64(i.importID)
+	return writer.Bytes()
+}
+
+// Decode reads import data from binary.
+func (i *importLog) Decode(v []byte) error {
+	reader := stream.NewReader(v)
+	i.importID = reader.ReadUint64()
+	return reader.Error()
+}
+
+// apply applies import edit log to version.
+func (i *importLog) apply(version Version) {
+	version.AddImport(i.importID)
+}
+
+// String returns string value of import log.
+func (i *importLog) String() string {
+	return fmt.Sprintf("import:{importID:%d}", i.importID)
+}
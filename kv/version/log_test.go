@@ -191,3 +191,36 @@ func TestSequence(t *testing.T) {
 	version.EXPECT().Sequence(int32(1), int64(10))
 	seq2.apply(version)
 }
+
+func TestOffloadFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	offload := CreateOffloadFile(10)
+	bytes, err := offload.Encode()
+	assert.NoError(t, err)
+
+	offload2 := &offloadFile{}
+	err = offload2.Decode(bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, offload, offload2)
+	version := NewMockVersion(ctrl)
+	version.EXPECT().AddOffloadFile(table.FileNumber(10))
+	offload2.apply(version)
+}
+
+func TestImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log := CreateImport(100)
+	bytes, err := log.Encode()
+	assert.NoError(t, err)
+
+	importLog2 := &importLog{}
+	err = importLog2.Decode(bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, log, importLog2)
+	assert.Equal(t, "import:{importID:100}", importLog2.String())
+	version := NewMockVersion(ctrl)
+	version.EXPECT().AddImport(uint64(100))
+	importLog2.apply(version)
+}
@@ -78,6 +78,20 @@ type Version interface {
 	Sequence(leader int32, seq int64)
 	// GetSequences returns all sequence number.
 	GetSequences() map[int32]int64
+
+	// AddOffloadFile marks the file which is uploaded to cold tier storage.
+	AddOffloadFile(fileNumber table.FileNumber)
+	// IsOffloadFile returns if the file is uploaded to cold tier storage.
+	IsOffloadFile(fileNumber table.FileNumber) bool
+	// GetOffloadFiles returns all files which are uploaded to cold tier storage.
+	GetOffloadFiles() []table.FileNumber
+
+	// AddImport marks the import request which is applied to family.
+	AddImport(importID uint64)
+	// IsImported returns if the import request is applied to family.
+	IsImported(importID uint64) bool
+	// GetImports returns all import requests which are applied to family.
+	GetImports() []uint64
 }
 
 // version is snapshot for current storage metadata includes levels/sst files
@@ -88,6 +102,8 @@ type version struct {
 	ref         atomic.Int32 // current version ref count for using
 	rollup      *rollup
 	sequences   map[int32]atomic.Int64
+	offloads    map[table.FileNumber]struct{} // files uploaded to cold tier storage
+	imports     map[uint64]struct{}           // import requests applied to family
 
 	levels []*level // each level sst files exclude level0
 }
@@ -104,6 +120,8 @@ func newVersion(id int64, fv FamilyVersion) Version {
 		numOfLevels: numOfLevel,
 		rollup:      newRollup(),
 		sequences:   make(map[int32]atomic.Int64),
+		offloads:    make(map[table.FileNumber]struct{}),
+		imports:     make(map[uint64]struct{}),
 	}
 	v.levels = make([]*level, numOfLevel)
 	for i := 0; i < numOfLevel; i++ {
@@ -230,6 +248,12 @@ func (v *version) Clone() Version {
 	for k, v := range v.sequences {
 		nv.sequences[k] = v
 	}
+	for k := range v.offloads {
+		nv.offloads[k] = struct{}{}
+	}
+	for k := range v.imports {
+		nv.imports[k] = struct{}{}
+	}
 	for level, value := range v.levels {
 		for _, file := range value.files {
 			newVersion.AddFile(level, file)
@@ -271,6 +295,8 @@ func (v *version) AddFile(level int, file *FileMeta) {
 func (v *version) DeleteFile(level int, fileNumber table.FileNumber) {
 	if level >= 0 && level < v.numOfLevels {
 		v.levels[level].deleteFile(fileNumber)
+		// file removed, offload mark is useless
+		delete(v.offloads, fileNumber)
 	}
 }
 
@@ -335,3 +361,49 @@ func (v *version) getOverlappingInputs(level int, minKey, maxKey uint32) []*File
 	}
 	return results
 }
+
+// AddOffloadFile marks the file which is uploaded to cold tier storage.
+// File removed by compaction concurrently is ignored.
+func (v *version) AddOffloadFile(fileNumber table.FileNumber) {
+	for _, file := range v.GetAllFiles() {
+		if file.GetFileNumber() == fileNumber {
+			v.offloads[fileNumber] = struct{}{}
+			return
+		}
+	}
+}
+
+// IsOffloadFile returns if the file is uploaded to cold tier storage.
+func (v *version) IsOffloadFile(fileNumber table.FileNumber) bool {
+	_, ok := v.offloads[fileNumber]
+	return ok
+}
+
+// GetOffloadFiles returns all files which are uploaded to cold tier storage.
+func (v *version) GetOffloadFiles() []table.FileNumber {
+	var rs []table.FileNumber
+	for fileNumber := range v.offloads {
+		rs = append(rs, fileNumber)
+	}
+	return rs
+}
+
+// AddImport marks the import request which is applied to family.
+func (v *version) AddImport(importID uint64) {
+	v.imports[importID] = struct{}{}
+}
+
+// IsImported returns if the import request is applied to family.
+func (v *version) IsImported(importID uint64) bool {
+	_, ok := v.imports[importID]
+	return ok
+}
+
+// GetImports returns all import requests which are applied to family.
+func (v *version) GetImports() []uint64 {
+	var rs []uint64
+	for importID := range v.imports {
+		rs = append(rs, importID)
+	}
+	return rs
+}
@@ -60,6 +60,9 @@ type StoreVersionSet interface {
 	CreateFamilyVersion(family string, familyID FamilyID) FamilyVersion
 	// GetFamilyVersion returns family version if it existed, else return nil
 	GetFamilyVersion(family string) FamilyVersion
+	// Backup writes the manifest which includes given family edit logs into target store path,
+	// then the store under target path can be recovered as a point-in-time copy.
+	Backup(targetPath string, editLogs []EditLog) error
 
 	// newVersionID generates new version id
 	newVersionID() int64
@@ -199,6 +202,29 @@ func (vs *storeVersionSet) GetFamilyVersion(family string) FamilyVersion {
 	return nil
 }
 
+// Backup writes the manifest which includes given family edit logs into target store path,
+// then the store under target path can be recovered as a point-in-time copy.
+func (vs *storeVersionSet) Backup(targetPath string, editLogs []EditLog) (err error) {
+	vs.mutex.RLock()
+	manifestFileName := ManifestFileName(table.FileNumber(vs.manifestFileNumber.Load()))
+	// save next file number, all files of given edit logs are less than it.
+	editLogs = append(editLogs, vs.createStoreSnapshot())
+	vs.mutex.RUnlock()
+
+	writer, err := newBufferWriterFunc(filepath.Join(targetPath, manifestFileName))
+	if err != nil {
+		return err
+	}
+	if err = vs.persistEditLogs(writer, editLogs); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return setCurrentFile(targetPath, manifestFileName)
+}
+
 // Recover recovers version set if it exist, recover been invoked when kv store init.
 // Initialize if version file not exists, else recover old data then init journal writer.
 func (vs *storeVersionSet) Recover() error {
@@ -340,13 +366,18 @@ func (vs *storeVersionSet) newVersionID() int64 {
 
 // setCurrent writes manifest file name into CURRENT file
 func (vs *storeVersionSet) setCurrent(manifestFile string) error {
-	current := vs.getCurrentPath()
-	tmp := fmt.Sprintf("%s.%s", current, TmpSuffix)
+	return setCurrentFile(vs.storePath, manifestFile)
+}
+
+// setCurrentFile writes manifest file name into CURRENT file under store path.
+func setCurrentFile(storePath, manifestFile string) error {
+	currentPath := filepath.Join(storePath, current())
+	tmp := fmt.Sprintf("%s.%s", currentPath, TmpSuffix)
 	// write manifest file name into current file
 	if err := writeFileFunc(tmp, []byte(manifestFile), 0666); err != nil {
 		return fmt.Errorf("write manifest file name into current tmp file error:%s", err)
 	}
-	if err := renameFunc(tmp, current); err != nil {
+	if err := renameFunc(tmp, currentPath); err != nil {
 		return fmt.Errorf("rename current tmp file name to current error:%s", err)
 	}
 	return nil
@@ -378,12 +409,21 @@ func (vs *storeVersionSet) createSnapshot() (editLogs []EditLog) {
 // createFamilySnapshot creates snapshot of edit log for family level.
 // NOTICE: IMPORTANT!!!!!, need write edit logs for all data of version.
 func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion FamilyVersion) EditLog {
-	editLog := NewEditLog(familyID)
 	// save current version all active files
 	snapshot := familyVersion.GetSnapshot()
 	defer snapshot.Close()
-	current := snapshot.GetCurrent()
+	return newVersionEditLog(familyID, snapshot.GetCurrent(), true)
+}
 
+// NewBackupEditLog builds the edit log for all data of given version,
+// offload marks are excluded, because backup keeps offloaded files in local.
+func NewBackupEditLog(familyID FamilyID, current Version) EditLog {
+	return newVersionEditLog(familyID, current, false)
+}
+
+// newVersionEditLog builds the edit log for all data of given version.
+func newVersionEditLog(familyID FamilyID, current Version, includeOffload bool) EditLog {
+	editLog := NewEditLog(familyID)
 	// write log for current file list under this family.
 	levels := current.Levels()
 	for numOfLevel, level := range levels {
@@ -394,6 +434,16 @@ func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion
 			editLog.Add(newFile)
 		}
 	}
+	if includeOffload {
+		// write log if family has offload files, after new file logs.
+		for _, file := range current.GetOffloadFiles() {
+			editLog.Add(CreateOffloadFile(file))
+		}
+	}
+	// write log if family has applied import requests.
+	for _, importID := range current.GetImports() {
+		editLog.Add(CreateImport(importID))
+	}
 	// write log if family has replica sequences.
 	sequences := current.GetSequences()
 	for leader, seq := range sequences {
@@ -211,6 +211,7 @@ func TestCommitFamilyEditLog(t *testing.T) {
 	editLog.Add(CreateSequence(1, 10))
 	editLog.Add(CreateNewRollupFile(1, 10000))
 	editLog.Add(CreateNewReferenceFile(1, 10))
+	editLog.Add(CreateImport(100))
 	err = vs.CommitFamilyEditLog("f", editLog)
 	assert.Nil(t, err, "commit family edit log error")
 
@@ -232,6 +233,7 @@ func TestCommitFamilyEditLog(t *testing.T) {
 		assert.Equal(t, map[int32]int64{1: 10}, current.GetSequences())
 		assert.Equal(t, map[FamilyID][]table.FileNumber{1: {10}}, current.GetReferenceFiles())
 		assert.Equal(t, map[table.FileNumber][]timeutil.Interval{1: {10000}}, current.GetRollupFiles())
+		assert.True(t, current.IsImported(100))
 
 		snapshot.Close()
 
@@ -316,3 +318,49 @@ func destroyVersionTestData() {
 		fmt.Println("delete test path error")
 	}
 }
+
+func TestStoreVersionSet_Backup(t *testing.T) {
+	initVersionSetTestData()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+		destroyVersionTestData()
+		ctrl.Finish()
+	}()
+	cache := table.NewMockCache(ctrl)
+	cache.EXPECT().ReleaseReaders(gomock.Any()).AnyTimes()
+
+	vs := NewStoreVersionSet(vsTestPath, cache, 2)
+	familyID := FamilyID(1)
+	vs.CreateFamilyVersion("f", familyID)
+	assert.NoError(t, vs.Recover())
+	editLog := NewEditLog(familyID)
+	editLog.Add(CreateNewFile(1, NewFileMeta(12, 1, 100, 2014)))
+	editLog.Add(CreateOffloadFile(12))
+	assert.NoError(t, vs.CommitFamilyEditLog("f", editLog))
+
+	snapshot := vs.GetFamilyVersion("f").GetSnapshot()
+	backupEditLog := NewBackupEditLog(familyID, snapshot.GetCurrent())
+	snapshot.Close()
+	_ = vs.Destroy()
+
+	target := t.TempDir()
+	// case 1: create manifest writer failure
+	newBufferWriterFunc = func(_ string) (bufioutil.BufioWriter, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, vs.Backup(target, []EditLog{backupEditLog}))
+	newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+	// case 2: backup successfully
+	assert.NoError(t, vs.Backup(target, []EditLog{backupEditLog}))
+
+	// recover backup store, offload mark excluded
+	vs = NewStoreVersionSet(target, cache, 2)
+	vs.CreateFamilyVersion("f", familyID)
+	assert.NoError(t, vs.Recover())
+	snapshot = vs.GetFamilyVersion("f").GetSnapshot()
+	assert.Len(t, snapshot.GetCurrent().GetFiles(1), 1)
+	assert.False(t, snapshot.GetCurrent().IsOffloadFile(12))
+	snapshot.Close()
+	_ = vs.Destroy()
+}
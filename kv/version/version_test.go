@@ -241,6 +241,8 @@ func TestVersion_Clone(t *testing.T) {
 	v.Sequence(10, 100)
 	v.AddRollupFile(1, timeutil.Interval(10))
 	v.AddReferenceFile(10, 10)
+	v.AddOffloadFile(1)
+	v.AddImport(100)
 
 	newV := v.Clone()
 	v1 := v.(*version)
@@ -251,5 +253,46 @@ func TestVersion_Clone(t *testing.T) {
 	assert.Equal(t, v1.numOfLevels, newV1.numOfLevels)
 	assert.Equal(t, v1.sequences, newV1.sequences)
 	assert.Equal(t, v1.rollup, newV1.rollup)
+	assert.Equal(t, v1.offloads, newV1.offloads)
+	assert.Equal(t, v1.imports, newV1.imports)
 	assert.Equal(t, v1.fv, newV1.fv)
 }
+
+func TestVersion_OffloadFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fv := NewMockFamilyVersion(ctrl)
+	vs := NewMockStoreVersionSet(ctrl)
+	fv.EXPECT().GetVersionSet().Return(vs).AnyTimes()
+	vs.EXPECT().numberOfLevels().Return(2).AnyTimes()
+	v := newVersion(1, fv)
+	v.AddFile(1, NewFileMeta(10, 1, 100, 1024))
+	assert.False(t, v.IsOffloadFile(10))
+	v.AddOffloadFile(10)
+	assert.True(t, v.IsOffloadFile(10))
+	assert.Equal(t, []table.FileNumber{10}, v.GetOffloadFiles())
+	// delete file, remove offload mark
+	v.DeleteFile(1, 10)
+	assert.False(t, v.IsOffloadFile(10))
+	assert.Empty(t, v.GetOffloadFiles())
+	// file not exist, ignore offload mark
+	v.AddOffloadFile(10)
+	assert.False(t, v.IsOffloadFile(10))
+}
+
+func TestVersion_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fv := NewMockFamilyVersion(ctrl)
+	vs := NewMockStoreVersionSet(ctrl)
+	fv.EXPECT().GetVersionSet().Return(vs).AnyTimes()
+	vs.EXPECT().numberOfLevels().Return(2).AnyTimes()
+	v := newVersion(1, fv)
+	assert.False(t, v.IsImported(100))
+	assert.Empty(t, v.GetImports())
+	v.AddImport(100)
+	assert.True(t, v.IsImported(100))
+	assert.Equal(t, []uint64{100}, v.GetImports())
+}
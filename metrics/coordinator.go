@@ -46,6 +46,14 @@ type MasterStatistics struct {
 	ReassignFailures *linmetric.BoundCounter // master reassign failure
 }
 
+// RebalanceStatistics represents shard rebalance statistics of master.
+type RebalanceStatistics struct {
+	Plans       *linmetric.DeltaCounterVec // replica move planned count
+	MoveDone    *linmetric.DeltaCounterVec // replica move finished successfully
+	MoveFailure *linmetric.DeltaCounterVec // replica move failure
+	Running     *linmetric.GaugeVec        // running replica moves
+}
+
 // NewStateManagerStatistics creates a state manager statistics.
 func NewStateManagerStatistics(role string) *StateManagerStatistics {
 	var scope linmetric.Scope
@@ -80,3 +88,14 @@ func NewMasterStatistics() *MasterStatistics {
 		ReassignFailures: scope.NewCounter("reassign_failures"),
 	}
 }
+
+// NewRebalanceStatistics creates a shard rebalance statistics.
+func NewRebalanceStatistics() *RebalanceStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.master.rebalance")
+	return &RebalanceStatistics{
+		Plans:       scope.NewCounterVec("plans", "storage"),
+		MoveDone:    scope.NewCounterVec("move_done", "storage"),
+		MoveFailure: scope.NewCounterVec("move_failures", "storage"),
+		Running:     scope.NewGaugeVec("running_moves", "storage"),
+	}
+}
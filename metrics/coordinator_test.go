@@ -36,3 +36,7 @@ func TestNewShardLeaderStatistics(t *testing.T) {
 func TestNewMasterStatistics(t *testing.T) {
 	assert.NotNil(t, NewMasterStatistics())
 }
+
+func TestNewRebalanceStatistics(t *testing.T) {
+	assert.NotNil(t, NewRebalanceStatistics())
+}
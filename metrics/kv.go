@@ -31,6 +31,8 @@ var (
 		CloseFailures        *linmetric.BoundCounter // close reader failure
 		CreateReaderFailures *linmetric.BoundCounter // create read failure
 		ActiveReaders        *linmetric.BoundGauge   // number of active reader in cache
+		BlockHit             *linmetric.BoundCounter // get decompressed value hit cache
+		BlockMiss            *linmetric.BoundCounter // get decompressed value miss cache
 	}{
 		Evict:         tableCacheScope.NewCounter("evicts"),
 		Hit:           tableCacheScope.NewCounter("cache_hits"),
@@ -38,19 +40,23 @@ var (
 		Close:         tableCacheScope.NewCounter("closes"),
 		CloseFailures: tableCacheScope.NewCounter("close_failures"),
 		ActiveReaders: tableCacheScope.NewGauge("active_readers"),
+		BlockHit:      tableCacheScope.NewCounter("block_cache_hits"),
+		BlockMiss:     tableCacheScope.NewCounter("block_cache_misses"),
 	}
 
 	// table write
 	tableWriteScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.write")
 	// TableWriteStatistics represents table file write statistics.
 	TableWriteStatistics = struct {
-		AddBadKeys *linmetric.BoundCounter // add bad key count
-		AddKeys    *linmetric.BoundCounter // add key success
-		WriteBytes *linmetric.BoundCounter // write data bytes
+		AddBadKeys      *linmetric.BoundCounter // add bad key count
+		AddKeys         *linmetric.BoundCounter // add key success
+		WriteBytes      *linmetric.BoundCounter // write data bytes
+		CompressedBytes *linmetric.BoundCounter // write data bytes after compression
 	}{
-		AddBadKeys: tableWriteScope.NewCounter("bad_keys"),
-		AddKeys:    tableWriteScope.NewCounter("add_keys"),
-		WriteBytes: tableWriteScope.NewCounter("write_bytes"),
+		AddBadKeys:      tableWriteScope.NewCounter("bad_keys"),
+		AddKeys:         tableWriteScope.NewCounter("add_keys"),
+		WriteBytes:      tableWriteScope.NewCounter("write_bytes"),
+		CompressedBytes: tableWriteScope.NewCounter("compressed_bytes"),
 	}
 
 	// table read
@@ -64,6 +70,9 @@ var (
 		MMapFailures   *linmetric.BoundCounter // map file failure
 		UnMMaps        *linmetric.BoundCounter // unmap file success
 		UnMMapFailures *linmetric.BoundCounter // unmap file failures
+
+		DecompressBytes    *linmetric.BoundCounter // bytes of data after decompression
+		DecompressFailures *linmetric.BoundCounter // decompress data failure
 	}{
 		Gets:           tableReadScope.NewCounter("gets"),
 		GetFailures:    tableReadScope.NewCounter("get_failures"),
@@ -72,6 +81,9 @@ var (
 		MMapFailures:   tableReadScope.NewCounter("mmap_failures"),
 		UnMMaps:        tableReadScope.NewCounter("unmmaps"),
 		UnMMapFailures: tableReadScope.NewCounter("unmmap_failures"),
+
+		DecompressBytes:    tableReadScope.NewCounter("decompress_bytes"),
+		DecompressFailures: tableReadScope.NewCounter("decompress_failures"),
 	}
 
 	// compact job
@@ -87,6 +99,42 @@ var (
 		Duration:   compactScope.Scope("duration").NewHistogramVec("type"),
 	}
 
+	// background io limiter
+	ioLimiterScope = linmetric.StorageRegistry.NewScope("lindb.kv.io_limiter")
+	// IOLimiterStatistics represents background io(flush/compaction/rollup) limiter statistics.
+	IOLimiterStatistics = struct {
+		Rate           *linmetric.BoundGauge        // current background io rate limit(bytes per second)
+		ForegroundLoad *linmetric.BoundGauge        // foreground operations per second
+		Bytes          *linmetric.DeltaCounterVec   // background io bytes
+		Throttles      *linmetric.DeltaCounterVec   // number of throttled background io
+		WaitDuration   *linmetric.DeltaHistogramVec // wait duration of throttled background io
+	}{
+		Rate:           ioLimiterScope.NewGauge("rate"),
+		ForegroundLoad: ioLimiterScope.NewGauge("foreground_load"),
+		Bytes:          ioLimiterScope.NewCounterVec("bytes", "type"),
+		Throttles:      ioLimiterScope.NewCounterVec("throttles", "type"),
+		WaitDuration:   ioLimiterScope.Scope("wait_duration").NewHistogramVec("type"),
+	}
+
+	// tiered storage
+	tierScope = linmetric.StorageRegistry.NewScope("lindb.kv.tier")
+	// TierStatistics represents cold tier storage statistics.
+	TierStatistics = struct {
+		Uploads          *linmetric.BoundCounter // upload file to cold tier success
+		UploadFailures   *linmetric.BoundCounter // upload file to cold tier failure
+		Downloads        *linmetric.BoundCounter // download file from cold tier success
+		DownloadFailures *linmetric.BoundCounter // download file from cold tier failure
+		Deletes          *linmetric.BoundCounter // delete obsolete file of cold tier success
+		DeleteFailures   *linmetric.BoundCounter // delete obsolete file of cold tier failure
+	}{
+		Uploads:          tierScope.NewCounter("uploads"),
+		UploadFailures:   tierScope.NewCounter("upload_failures"),
+		Downloads:        tierScope.NewCounter("downloads"),
+		DownloadFailures: tierScope.NewCounter("download_failures"),
+		Deletes:          tierScope.NewCounter("deletes"),
+		DeleteFailures:   tierScope.NewCounter("delete_failures"),
+	}
+
 	// flush job
 	flushScope = linmetric.StorageRegistry.NewScope("lindb.kv.flush")
 	// FlushStatistics represents flush job statistics.
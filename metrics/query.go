@@ -41,6 +41,92 @@ type StorageQueryStatistics struct {
 	OmitRequest         *linmetric.BoundCounter // omit request(task no belong to current node, wrong stream etc.)
 }
 
+// QueryAdmissionStatistics represents query admission statistics of a workload class.
+type QueryAdmissionStatistics struct {
+	Running   *linmetric.BoundGauge     // number of running queries
+	Queued    *linmetric.BoundGauge     // number of queries waiting in queue
+	Admitted  *linmetric.BoundCounter   // number of admitted queries
+	Rejected  *linmetric.BoundCounter   // number of queries rejected because queue is full
+	Timeouts  *linmetric.BoundCounter   // number of queries timeout when waiting in queue
+	QueueWait *linmetric.BoundHistogram // duration of waiting in queue
+}
+
+// RecordingRuleStatistics represents recording rule evaluation statistics.
+type RecordingRuleStatistics struct {
+	Evaluations        *linmetric.BoundCounter   // number of evaluations
+	EvaluationFailures *linmetric.BoundCounter   // number of evaluation failures
+	WrittenRows        *linmetric.BoundCounter   // number of rows written back
+	Duration           *linmetric.BoundHistogram // duration of evaluation
+}
+
+// NewRecordingRuleStatistics creates a recording rule evaluation statistics.
+func NewRecordingRuleStatistics(rule string) *RecordingRuleStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.rule.recording", "rule", rule)
+	return &RecordingRuleStatistics{
+		Evaluations:        scope.NewCounter("evaluations"),
+		EvaluationFailures: scope.NewCounter("evaluation_failures"),
+		WrittenRows:        scope.NewCounter("written_rows"),
+		Duration:           scope.Scope("duration").NewHistogram(),
+	}
+}
+
+// AlertRuleStatistics represents alert rule evaluation statistics.
+type AlertRuleStatistics struct {
+	Evaluations          *linmetric.BoundCounter   // number of evaluations
+	EvaluationFailures   *linmetric.BoundCounter   // number of evaluation failures
+	Notifications        *linmetric.BoundCounter   // number of notifications sent
+	NotificationFailures *linmetric.BoundCounter   // number of notification failures
+	Duration             *linmetric.BoundHistogram // duration of evaluation
+}
+
+// NewAlertRuleStatistics creates an alert rule evaluation statistics.
+func NewAlertRuleStatistics(rule string) *AlertRuleStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.rule.alert", "rule", rule)
+	return &AlertRuleStatistics{
+		Evaluations:          scope.NewCounter("evaluations"),
+		EvaluationFailures:   scope.NewCounter("evaluation_failures"),
+		Notifications:        scope.NewCounter("notifications"),
+		NotificationFailures: scope.NewCounter("notification_failures"),
+		Duration:             scope.Scope("duration").NewHistogram(),
+	}
+}
+
+// NewQueryAdmissionStatistics creates a query admission statistics for workload class.
+func NewQueryAdmissionStatistics(class string) *QueryAdmissionStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.query.admission", "class", class)
+	return &QueryAdmissionStatistics{
+		Running:   scope.NewGauge("running"),
+		Queued:    scope.NewGauge("queued"),
+		Admitted:  scope.NewCounter("admitted"),
+		Rejected:  scope.NewCounter("rejected"),
+		Timeouts:  scope.NewCounter("timeouts"),
+		QueueWait: scope.Scope("queue_wait").NewHistogram(),
+	}
+}
+
+// QueryResultCacheStatistics represents broker query result cache statistics.
+type QueryResultCacheStatistics struct {
+	Hit     *linmetric.BoundCounter // query hits cached result
+	Miss    *linmetric.BoundCounter // query misses cached result
+	Evict   *linmetric.BoundCounter // evict result because cache is full
+	Expire  *linmetric.BoundCounter // expire result because of ttl
+	Entries *linmetric.BoundGauge   // number of cached results
+	Size    *linmetric.BoundGauge   // memory size of cached results
+}
+
+// NewQueryResultCacheStatistics creates a broker query result cache statistics.
+func NewQueryResultCacheStatistics() *QueryResultCacheStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.query.result_cache")
+	return &QueryResultCacheStatistics{
+		Hit:     scope.NewCounter("hits"),
+		Miss:    scope.NewCounter("misses"),
+		Evict:   scope.NewCounter("evicts"),
+		Expire:  scope.NewCounter("expires"),
+		Entries: scope.NewGauge("entries"),
+		Size:    scope.NewGauge("size"),
+	}
+}
+
 // NewBrokerQueryStatistics creates broker query statistics.
 func NewBrokerQueryStatistics() *BrokerQueryStatistics {
 	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.query")
@@ -26,4 +26,8 @@ import (
 func TestQueryStatistics(t *testing.T) {
 	assert.NotNil(t, NewBrokerQueryStatistics())
 	assert.NotNil(t, NewStorageQueryStatistics())
+	assert.NotNil(t, NewQueryAdmissionStatistics("ad-hoc"))
+	assert.NotNil(t, NewQueryResultCacheStatistics())
+	assert.NotNil(t, NewRecordingRuleStatistics("rule"))
+	assert.NotNil(t, NewAlertRuleStatistics("rule"))
 }
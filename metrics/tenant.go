@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import "github.com/lindb/lindb/internal/linmetric"
+
+// TenantStatistics represents tenant resource usage statistics, tagged by tenant name.
+type TenantStatistics struct {
+	WrittenPoints  *linmetric.DeltaCounterVec // number of points written by tenant
+	WriteRejected  *linmetric.DeltaCounterVec // number of write requests rejected by quota
+	QueryRejected  *linmetric.DeltaCounterVec // number of queries rejected by quota
+	Queries        *linmetric.GaugeVec        // number of running queries
+	NumOfSeries    *linmetric.GaugeVec        // number of series of all databases
+	DiskBytes      *linmetric.GaugeVec        // disk bytes of all databases
+	UsageCollected *linmetric.BoundCounter    // number of usage collecting
+	UsageFailures  *linmetric.BoundCounter    // number of usage collecting failure
+}
+
+// NewTenantStatistics creates a tenant resource usage statistics.
+func NewTenantStatistics() *TenantStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.tenant")
+	return &TenantStatistics{
+		WrittenPoints:  scope.NewCounterVec("written_points", "tenant"),
+		WriteRejected:  scope.NewCounterVec("write_rejected", "tenant"),
+		QueryRejected:  scope.NewCounterVec("query_rejected", "tenant"),
+		Queries:        scope.NewGaugeVec("queries", "tenant"),
+		NumOfSeries:    scope.NewGaugeVec("series", "tenant"),
+		DiskBytes:      scope.NewGaugeVec("disk_bytes", "tenant"),
+		UsageCollected: scope.NewCounter("usage_collected"),
+		UsageFailures:  scope.NewCounter("usage_failures"),
+	}
+}
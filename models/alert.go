@@ -0,0 +1,181 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// AlertStatus represents the status of alert.
+type AlertStatus string
+
+const (
+	// AlertPending represents the condition is matched, but not lasts for the duration of rule.
+	AlertPending AlertStatus = "pending"
+	// AlertFiring represents the condition lasts for the duration of rule, notification is sent.
+	AlertFiring AlertStatus = "firing"
+	// AlertResolved represents the firing alert is recovered.
+	AlertResolved AlertStatus = "resolved"
+)
+
+// NotifyFormat represents the payload format of alert notification.
+type NotifyFormat string
+
+const (
+	// NotifyWebhook represents generic webhook payload.
+	NotifyWebhook NotifyFormat = "webhook"
+	// NotifyAlertmanager represents Alertmanager compatible payload(api v2).
+	NotifyAlertmanager NotifyFormat = "alertmanager"
+)
+
+// AlertCondition represents the condition of alert rule, like HAVING clause,
+// compares the latest value of field with threshold.
+type AlertCondition struct {
+	// Field represents the field of query result, all fields are checked if empty.
+	Field     string  `json:"field,omitempty"`
+	Operator  string  `json:"operator" validate:"oneof=> >= < <= == !="`
+	Threshold float64 `json:"threshold"`
+}
+
+// Match checks if value matches the condition.
+func (c *AlertCondition) Match(value float64) bool {
+	switch c.Operator {
+	case ">":
+		return value > c.Threshold
+	case ">=":
+		return value >= c.Threshold
+	case "<":
+		return value < c.Threshold
+	case "<=":
+		return value <= c.Threshold
+	case "==":
+		return value == c.Threshold
+	case "!=":
+		return value != c.Threshold
+	default:
+		return false
+	}
+}
+
+// String returns the string value of condition.
+func (c *AlertCondition) String() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %v", c.Field, c.Operator, c.Threshold))
+}
+
+// AlertRule represents the alert rule which evaluates the query periodically,
+// alert is firing if the condition lasts for the duration.
+type AlertRule struct {
+	Name      string `json:"name" validate:"required"`
+	Database  string `json:"database" validate:"required"`
+	Namespace string `json:"namespace,omitempty"`
+	// SQL represents the query of rule, time range is set by scheduler.
+	SQL       string         `json:"sql" validate:"required"`
+	Condition AlertCondition `json:"condition"`
+	// Interval represents the evaluation interval(millisecond).
+	Interval int64 `json:"interval" validate:"gt=0"`
+	// For represents the duration(millisecond) which condition lasts before firing.
+	For    int64             `json:"for,omitempty" validate:"gte=0"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Webhook represents the url which notification is sent to.
+	Webhook string       `json:"webhook,omitempty"`
+	Format  NotifyFormat `json:"format,omitempty" validate:"omitempty,oneof=webhook alertmanager"`
+}
+
+// Alert represents the alert of a series which matches the condition of alert rule.
+type Alert struct {
+	Status AlertStatus       `json:"status"`
+	Labels map[string]string `json:"labels"`
+	Value  float64           `json:"value"`
+	// ActiveAt represents the time which condition matched first.
+	ActiveAt   int64 `json:"activeAt"`
+	FiredAt    int64 `json:"firedAt,omitempty"`
+	ResolvedAt int64 `json:"resolvedAt,omitempty"`
+	// Notified represents if the notification of current status(firing/resolved) has been delivered.
+	Notified bool `json:"notified,omitempty"`
+}
+
+// AlertRuleState represents the evaluation state of alert rule, which is persisted by scheduler.
+type AlertRuleState struct {
+	Rule           string            `json:"rule"`
+	Alerts         map[string]*Alert `json:"alerts,omitempty"` // key: labels of series
+	LastEvaluation int64             `json:"lastEvaluation,omitempty"`
+	LastError      string            `json:"lastError,omitempty"`
+}
+
+// AlertRuleStates represents the state list of alert rules.
+type AlertRuleStates []*AlertRuleState
+
+// ToTable returns alert list as table if it has value, else return empty string.
+func (states AlertRuleStates) ToTable() (rows int, tableStr string) {
+	if len(states) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Rule", "Status", "Labels", "Value", "Active At", "Last Evaluation", "Error"})
+	for _, state := range states {
+		lastEvaluation := ""
+		if state.LastEvaluation > 0 {
+			lastEvaluation = timeutil.FormatTimestamp(state.LastEvaluation, timeutil.DataTimeFormat2)
+		}
+		if len(state.Alerts) == 0 {
+			writer.AppendRow(table.Row{state.Rule, "inactive", "", "", "", lastEvaluation, state.LastError})
+			rows++
+			continue
+		}
+		keys := make([]string, 0, len(state.Alerts))
+		for key := range state.Alerts {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			alert := state.Alerts[key]
+			writer.AppendRow(table.Row{
+				state.Rule, alert.Status, key, alert.Value,
+				timeutil.FormatTimestamp(alert.ActiveAt, timeutil.DataTimeFormat2),
+				lastEvaluation, state.LastError,
+			})
+			rows++
+		}
+	}
+	return rows, writer.Render()
+}
+
+// AlertKey returns the key of alert by labels, format: k1=v1,k2=v2(order by key).
+func AlertKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for idx, key := range keys {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(key)
+		sb.WriteString("=")
+		sb.WriteString(labels[key])
+	}
+	return sb.String()
+}
@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/validate"
+)
+
+func TestAlertCondition_Match(t *testing.T) {
+	cases := []struct {
+		op      string
+		value   float64
+		matched bool
+	}{
+		{op: ">", value: 11, matched: true},
+		{op: ">", value: 10},
+		{op: ">=", value: 10, matched: true},
+		{op: "<", value: 9, matched: true},
+		{op: "<=", value: 11},
+		{op: "==", value: 10, matched: true},
+		{op: "!=", value: 10},
+		{op: "<>", value: 10},
+	}
+	for _, tt := range cases {
+		c := &AlertCondition{Operator: tt.op, Threshold: 10}
+		assert.Equal(t, tt.matched, c.Match(tt.value), tt.op)
+	}
+	assert.Equal(t, "f > 10", (&AlertCondition{Field: "f", Operator: ">", Threshold: 10}).String())
+	assert.Equal(t, "<= 1.5", (&AlertCondition{Operator: "<=", Threshold: 1.5}).String())
+}
+
+func TestAlertRule_Validate(t *testing.T) {
+	rule := &AlertRule{
+		Name:      "a1",
+		Database:  "db",
+		SQL:       "select f from cpu",
+		Condition: AlertCondition{Operator: ">=", Threshold: 1},
+		Interval:  timeutil.OneMinute,
+	}
+	assert.NoError(t, validate.Validator.Struct(rule))
+	rule.Format = NotifyAlertmanager
+	assert.NoError(t, validate.Validator.Struct(rule))
+	rule.Format = "abc"
+	assert.Error(t, validate.Validator.Struct(rule))
+	rule.Format = ""
+	rule.Condition.Operator = "=>"
+	assert.Error(t, validate.Validator.Struct(rule))
+}
+
+func TestAlertRuleStates_ToTable(t *testing.T) {
+	rows, rs := AlertRuleStates{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+
+	rows, rs = AlertRuleStates{
+		{Rule: "a1", LastEvaluation: timeutil.Now(), LastError: "query timeout"},
+		{Rule: "a2", Alerts: map[string]*Alert{
+			"host=1.1.1.1": {Status: AlertFiring, Value: 100, ActiveAt: timeutil.Now()},
+			"host=1.1.1.2": {Status: AlertPending, Value: 90, ActiveAt: timeutil.Now()},
+		}},
+	}.ToTable()
+	assert.Equal(t, 3, rows)
+	assert.Contains(t, rs, "inactive")
+	assert.Contains(t, rs, "query timeout")
+	assert.Contains(t, rs, "firing")
+	assert.Contains(t, rs, "host=1.1.1.2")
+}
+
+func TestAlertKey(t *testing.T) {
+	assert.Equal(t, "", AlertKey(nil))
+	assert.Equal(t, "a=1,b=2", AlertKey(map[string]string{"b": "2", "a": "1"}))
+}
@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// AuditEntry represents an audit entry of state-mutating(DDL/administrative) operation.
+type AuditEntry struct {
+	// Time represents the timestamp(millisecond) when operation executed.
+	Time int64 `json:"time"`
+	// Node represents the node which executed the operation.
+	Node     string `json:"node"`
+	User     string `json:"user"`
+	SourceIP string `json:"sourceIP"`
+	// Statement represents the executed statement, password is redacted.
+	Statement string `json:"statement"`
+	// Before/After represents the config of target resource before/after operation executed.
+	Before  string `json:"before,omitempty"`
+	After   string `json:"after,omitempty"`
+	Success bool   `json:"success"`
+	// Result represents the error message if operation failure, else result message.
+	Result string `json:"result"`
+}
+
+// AuditEntries represents the audit entry list.
+type AuditEntries []*AuditEntry
+
+// ToTable returns audit entry list as table if it has value, else return empty string.
+func (es AuditEntries) ToTable() (rows int, tableStr string) {
+	if len(es) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Time", "User", "Source IP", "Statement", "Success", "Result", "Before", "After"})
+	for _, e := range es {
+		writer.AppendRow(table.Row{
+			timeutil.FormatTimestamp(e.Time, timeutil.DataTimeFormat2),
+			e.User,
+			e.SourceIP,
+			e.Statement,
+			e.Success,
+			e.Result,
+			e.Before,
+			e.After,
+		})
+	}
+	return len(es), writer.Render()
+}
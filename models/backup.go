@@ -0,0 +1,34 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// BackupParam represents the param of database backup/restore.
+type BackupParam struct {
+	Database string `form:"database" json:"database" binding:"required"`
+	// Dir is relative to the backup root dir of storage node, path escapes root dir is rejected.
+	Dir string `form:"dir" json:"dir" binding:"required"`
+}
+
+// BackupManifest represents the manifest of database backup, which lists all files of backup.
+type BackupManifest struct {
+	Database  string    `toml:"database" json:"database"`
+	Timestamp int64     `toml:"timestamp" json:"timestamp"`
+	ShardIDs  []ShardID `toml:"shardIDs" json:"shardIDs"`
+	// relative path of backup files under database backup dir.
+	Files []string `toml:"files" json:"files"`
+}
@@ -0,0 +1,50 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// CompactType represents the type of manual compaction job.
+type CompactType string
+
+const (
+	// CompactTypeCompact represents full compaction of level0 files.
+	CompactTypeCompact CompactType = "compact"
+	// CompactTypeRollup represents rollup of source interval data.
+	CompactTypeRollup CompactType = "rollup"
+)
+
+// CompactParam represents the param of manual compaction/rollup job.
+type CompactParam struct {
+	Type     CompactType `json:"type" binding:"required"`
+	Database string      `json:"database" binding:"required"`
+	// ShardIDs represents the target shards, all shards if empty.
+	ShardIDs []ShardID `json:"shardIds,omitempty"`
+	// FamilyTime represents the target data family which contains this time, all families if 0.
+	FamilyTime int64 `json:"familyTime,omitempty"`
+}
+
+// FamilyCompactionState represents the compaction state of kv family.
+type FamilyCompactionState struct {
+	Store            string `json:"store"`
+	Family           string `json:"family"`
+	Compacting       bool   `json:"compacting"`
+	Rolluping        bool   `json:"rolluping"`
+	NumOfL0Files     int    `json:"numOfL0Files"`
+	NumOfFiles       int    `json:"numOfFiles"`
+	NumOfRollupFiles int    `json:"numOfRollupFiles"`
+	Size             int64  `json:"size"`
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/jedib0t/go-pretty/v6/table"
 
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 )
 
@@ -101,6 +102,10 @@ type Database struct {
 	ReplicaFactor int                    `json:"replicaFactor" validate:"gt=0"` // replica refactor
 	Option        *option.DatabaseOption `json:"option"`                        // time series database option
 	Desc          string                 `json:"desc,omitempty"`
+	Tenant        string                 `json:"tenant,omitempty"` // tenant which owns database
+	// MaxReadLag represents the max replica lag(duration, like 10s) of follower which can serve the query,
+	// 0 means only leader serves the query. Lag is the age of data which follower hasn't acknowledged.
+	MaxReadLag ltoml.Duration `json:"maxReadLag,omitempty" validate:"gte=0"`
 }
 
 // String returns the database's description.
@@ -108,6 +113,9 @@ func (db *Database) String() string {
 	result := "create database " + db.Name + " with "
 	result += "shard " + fmt.Sprintf("%d", db.NumOfShard) + ", replica " + fmt.Sprintf("%d", db.ReplicaFactor)
 	result += ", intervals " + db.Option.Intervals.String()
+	if db.Tenant != "" {
+		result += ", tenant " + db.Tenant
+	}
 	return result
 }
 
@@ -135,6 +143,9 @@ func (r Replica) Contain(nodeID NodeID) bool {
 type ShardAssignment struct {
 	Name   string               `json:"name"` // database's name
 	Shards map[ShardID]*Replica `json:"shards"`
+	// Learners represents the nodes which are joining replica list of shard(replica move in progress),
+	// leader keeps write ahead log for them, but they don't create shard until promoted.
+	Learners map[ShardID]*Replica `json:"learners,omitempty"`
 
 	replicaFactor int // for storage recover
 }
@@ -167,3 +178,69 @@ func (s *ShardAssignment) AddReplica(shardID ShardID, replicaID NodeID) {
 func (s *ShardAssignment) GetReplicaFactor() int {
 	return s.replicaFactor
 }
+
+// AddLearner adds learner node id to spec shard, ignore it if node is replica of shard.
+func (s *ShardAssignment) AddLearner(shardID ShardID, nodeID NodeID) {
+	if replica, ok := s.Shards[shardID]; ok && replica.Contain(nodeID) {
+		return
+	}
+	if s.Learners == nil {
+		s.Learners = make(map[ShardID]*Replica)
+	}
+	learner, ok := s.Learners[shardID]
+	if !ok {
+		learner = &Replica{}
+		s.Learners[shardID] = learner
+	}
+	if !learner.Contain(nodeID) {
+		learner.Replicas = append(learner.Replicas, nodeID)
+	}
+}
+
+// PromoteLearner moves learner node id into replica list of spec shard.
+func (s *ShardAssignment) PromoteLearner(shardID ShardID, nodeID NodeID) {
+	s.removeLearner(shardID, nodeID)
+	s.AddReplica(shardID, nodeID)
+}
+
+// RemoveReplica removes node id from replica(learner) list of spec shard.
+func (s *ShardAssignment) RemoveReplica(shardID ShardID, nodeID NodeID) {
+	s.removeLearner(shardID, nodeID)
+	if replica, ok := s.Shards[shardID]; ok {
+		replica.Replicas = removeNodeID(replica.Replicas, nodeID)
+	}
+}
+
+// GetLearners returns learner list of spec shard.
+func (s *ShardAssignment) GetLearners(shardID ShardID) []NodeID {
+	if learner, ok := s.Learners[shardID]; ok {
+		return learner.Replicas
+	}
+	return nil
+}
+
+// removeLearner removes learner node id of spec shard.
+func (s *ShardAssignment) removeLearner(shardID ShardID, nodeID NodeID) {
+	learner, ok := s.Learners[shardID]
+	if !ok {
+		return
+	}
+	learner.Replicas = removeNodeID(learner.Replicas, nodeID)
+	if len(learner.Replicas) == 0 {
+		delete(s.Learners, shardID)
+	}
+	if len(s.Learners) == 0 {
+		s.Learners = nil
+	}
+}
+
+// removeNodeID removes node id from node list.
+func removeNodeID(nodeIDs []NodeID, nodeID NodeID) []NodeID {
+	var rs []NodeID
+	for _, id := range nodeIDs {
+		if id != nodeID {
+			rs = append(rs, id)
+		}
+	}
+	return rs
+}
@@ -39,6 +39,30 @@ func TestNewShardAssignment(t *testing.T) {
 	assert.Equal(t, 3, shardAssign.GetReplicaFactor())
 }
 
+func TestShardAssignment_Learner(t *testing.T) {
+	shardAssign := NewShardAssignment("test")
+	shardAssign.AddReplica(1, 1)
+	shardAssign.AddReplica(1, 2)
+	// replica cannot be learner
+	shardAssign.AddLearner(1, 1)
+	assert.Nil(t, shardAssign.Learners)
+	shardAssign.AddLearner(1, 3)
+	shardAssign.AddLearner(1, 3)
+	assert.Equal(t, []NodeID{3}, shardAssign.GetLearners(1))
+	assert.Nil(t, shardAssign.GetLearners(2))
+
+	shardAssign.PromoteLearner(1, 3)
+	assert.Nil(t, shardAssign.Learners)
+	assert.Equal(t, []NodeID{1, 2, 3}, shardAssign.Shards[1].Replicas)
+
+	shardAssign.AddLearner(1, 4)
+	shardAssign.RemoveReplica(1, 1)
+	shardAssign.RemoveReplica(1, 4)
+	shardAssign.RemoveReplica(2, 1)
+	assert.Nil(t, shardAssign.Learners)
+	assert.Equal(t, []NodeID{2, 3}, shardAssign.Shards[1].Replicas)
+}
+
 func TestDatabase_String(t *testing.T) {
 	database := Database{
 		Name:          "test",
@@ -51,6 +75,8 @@ func TestDatabase_String(t *testing.T) {
 			}},
 	}
 	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M]", database.String())
+	database.Tenant = "t1"
+	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M], tenant t1", database.String())
 }
 
 func TestParseShardID(t *testing.T) {
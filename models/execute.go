@@ -17,8 +17,38 @@
 
 package models
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 // ExecuteParam represents lin query language executor's param.
 type ExecuteParam struct {
 	Database string `form:"db" json:"db"`
 	SQL      string `form:"sql" json:"sql" binding:"required"`
+	// Chunked writes query result in chunks(NDJSON, one series per line) as soon as each time window of query
+	// completed, series of each window are ordered by tag values(same as unchunked result), a series group
+	// appears once per window with the points in the window, order by/explain query is never split.
+	Chunked bool `form:"chunked" json:"chunked"`
+	// Class represents the workload class of query(dashboard/alerting/ad-hoc/internal), default ad-hoc,
+	// alerting/internal are reserved for system workload, which only can be claimed by admin.
+	Class string `form:"class" json:"class"`
+	// Format represents the export format of metric query result(csv/arrow/parquet), default json.
+	Format string `form:"format" json:"format"`
+	// MaxReadLag represents the max replica lag(duration, like 10s) of follower which can serve the query,
+	// empty/0 means using the setting of database, negative means only leader serves the query.
+	MaxReadLag string `form:"maxReadLag" json:"maxReadLag"`
+}
+
+// ParseMaxReadLag returns the max replica lag of follower which can serve the query, returns 0 if not set.
+func (p *ExecuteParam) ParseMaxReadLag() (time.Duration, error) {
+	if strings.TrimSpace(p.MaxReadLag) == "" {
+		return 0, nil
+	}
+	maxReadLag, err := time.ParseDuration(strings.TrimSpace(p.MaxReadLag))
+	if err != nil {
+		return 0, fmt.Errorf("invalid max read lag: %s", p.MaxReadLag)
+	}
+	return maxReadLag, nil
 }
@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteParam_ParseMaxReadLag(t *testing.T) {
+	cases := []struct {
+		maxReadLag string
+		expect     time.Duration
+		wantErr    bool
+	}{
+		{maxReadLag: "", expect: 0},
+		{maxReadLag: " 10s ", expect: 10 * time.Second},
+		{maxReadLag: "-1s", expect: -time.Second},
+		{maxReadLag: "10", wantErr: true},
+	}
+	for _, tt := range cases {
+		param := &ExecuteParam{MaxReadLag: tt.maxReadLag}
+		maxReadLag, err := param.ParseMaxReadLag()
+		if tt.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, maxReadLag)
+		}
+	}
+}
@@ -90,6 +90,7 @@ type ReplicaPeerState struct {
 	Consume        int64           `json:"consume"`
 	ACK            int64           `json:"ack"`
 	Pending        int64           `json:"pending"`
+	Staleness      int64           `json:"staleness"` // max. age(ms) of data not acknowledged, 0: caught up, -1: unknown
 	State          ReplicatorState `json:"state"`
 	StateErrMsg    string          `json:"stateErrMsg"`
 }
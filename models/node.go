@@ -83,6 +83,7 @@ type StatelessNode struct {
 	HostName string `json:"hostName"`
 	GRPCPort uint16 `json:"grpcPort"`
 	HTTPPort uint16 `json:"httpPort"`
+	HTTPS    bool   `json:"https,omitempty"`
 
 	Version    string `json:"version"`
 	OnlineTime int64  `json:"onlineTime"` // node online time(millisecond)
@@ -94,6 +95,9 @@ func (n *StatelessNode) Indicator() string {
 }
 
 func (n *StatelessNode) HTTPAddress() string {
+	if n.HTTPS {
+		return fmt.Sprintf("https://%s:%d", n.HostIP, n.HTTPPort)
+	}
 	return fmt.Sprintf("http://%s:%d", n.HostIP, n.HTTPPort)
 }
 
@@ -30,6 +30,7 @@ func TestNode_Indicator(t *testing.T) {
 	indicator := node.Indicator()
 	assert.Equal(t, "1.1.1.1:19000", indicator)
 	assert.Equal(t, "http://1.1.1.1:8080", (&StatelessNode{HostIP: "1.1.1.1", HTTPPort: 8080}).HTTPAddress())
+	assert.Equal(t, "https://1.1.1.1:8080", (&StatelessNode{HostIP: "1.1.1.1", HTTPPort: 8080, HTTPS: true}).HTTPAddress())
 	node2, err := ParseNode(indicator)
 	assert.NoError(t, err)
 	node3 := node2.(*StatelessNode)
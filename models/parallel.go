@@ -19,10 +19,12 @@ package models
 
 // PhysicalPlan represents the distribution query's physical plan
 type PhysicalPlan struct {
-	Database      string         `json:"database"`      // database name
-	Root          Root           `json:"root"`          // root node
-	Intermediates []Intermediate `json:"intermediates"` // intermediate node if it needs
-	Leaves        []*Leaf        `json:"leafs"`         // leaf nodes(storage nodes of query database)
+	Database      string         `json:"database"`        // database name
+	Root          Root           `json:"root"`            // root node
+	Intermediates []Intermediate `json:"intermediates"`   // intermediate node if it needs
+	Leaves        []*Leaf        `json:"leafs"`           // leaf nodes(storage nodes of query database)
+	SQL           string         `json:"sql,omitempty"`   // sql text of query(for slow query log)
+	Class         QueryClass     `json:"class,omitempty"` // workload class of query(storage executes it by pool of class)
 }
 
 // NewPhysicalPlan creates the physical plan with root node
@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// QueryClass represents the workload class of metric query, each class has its own admission quota in broker
+// and its own executor pool in storage.
+type QueryClass string
+
+const (
+	// DashboardQuery represents the query from dashboard.
+	DashboardQuery QueryClass = "dashboard"
+	// AlertingQuery represents the query from alerting/recording rule evaluation.
+	AlertingQuery QueryClass = "alerting"
+	// AdHocQuery represents the ad-hoc query from user explorer(default class).
+	AdHocQuery QueryClass = "ad-hoc"
+	// InternalQuery represents the query from internal system(monitoring etc.).
+	InternalQuery QueryClass = "internal"
+)
+
+// QueryClasses represents all workload classes of metric query.
+var QueryClasses = []QueryClass{DashboardQuery, AlertingQuery, AdHocQuery, InternalQuery}
+
+// ErrUnknownQueryClass represents the query class is not supported.
+var ErrUnknownQueryClass = errors.New("unknown query class")
+
+// ParseQueryClass parses query class from string value, returns AdHocQuery if value is empty.
+func ParseQueryClass(class string) (QueryClass, error) {
+	switch QueryClass(class) {
+	case "":
+		return AdHocQuery, nil
+	case DashboardQuery, AlertingQuery, AdHocQuery, InternalQuery:
+		return QueryClass(class), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownQueryClass, class)
+	}
+}
+
+// Privileged returns if the class is reserved for system workload(rule evaluation/internal monitoring),
+// which only can be claimed by admin.
+func (c QueryClass) Privileged() bool {
+	return c == AlertingQuery || c == InternalQuery
+}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQueryClass(t *testing.T) {
+	cases := []struct {
+		class   string
+		expect  QueryClass
+		wantErr bool
+	}{
+		{class: "", expect: AdHocQuery},
+		{class: "dashboard", expect: DashboardQuery},
+		{class: "alerting", expect: AlertingQuery},
+		{class: "ad-hoc", expect: AdHocQuery},
+		{class: "internal", expect: InternalQuery},
+		{class: "unknown", wantErr: true},
+	}
+	for _, tt := range cases {
+		class, err := ParseQueryClass(tt.class)
+		if tt.wantErr {
+			assert.True(t, errors.Is(err, ErrUnknownQueryClass))
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, class)
+		}
+	}
+}
+
+func TestQueryClass_Privileged(t *testing.T) {
+	assert.True(t, AlertingQuery.Privileged())
+	assert.True(t, InternalQuery.Privileged())
+	assert.False(t, DashboardQuery.Privileged())
+	assert.False(t, AdHocQuery.Privileged())
+}
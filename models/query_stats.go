@@ -57,13 +57,32 @@ type StageStats struct {
 	Children []*StageStats `json:"children"`
 }
 
+// ScanStats represents the data scanned by query.
+type ScanStats struct {
+	Series   int64
+	Families int64
+	Bytes    int64
+}
+
+// Add adds the data scanned by other query.
+func (s *ScanStats) Add(other ScanStats) {
+	s.Series += other.Series
+	s.Families += other.Families
+	s.Bytes += other.Bytes
+}
+
 // LeafNodeStats represents query stats in storage side
 type LeafNodeStats struct {
 	NetPayload int64         `json:"netPayload"`
 	TotalCost  int64         `json:"totalCost"`
+	PeakMemory int64         `json:"peakMemory"`
 	Start      int64         `json:"start"`
 	End        int64         `json:"end"`
 	Stages     []*StageStats `json:"stages,omitempty"`
+
+	ScannedSeries   int64 `json:"scannedSeries"`
+	ScannedFamilies int64 `json:"scannedFamilies"`
+	ScannedBytes    int64 `json:"scannedBytes"`
 }
 
 // Stats represents the time stats
@@ -75,12 +94,29 @@ type Stats struct {
 	Series    int   `json:"series,omitempty"`
 }
 
+// ResultCacheStats represents the stats of broker result cache for a query.
+type ResultCacheStats struct {
+	Hit            bool `json:"hit"`
+	CachedBuckets  int  `json:"cachedBuckets"`  // number of time buckets served from cache
+	QueriedBuckets int  `json:"queriedBuckets"` // number of time buckets queried from storage
+}
+
+// String returns the string value of result cache stats.
+func (s *ResultCacheStats) String() string {
+	if !s.Hit {
+		return fmt.Sprintf("miss(queried buckets:%d)", s.QueriedBuckets)
+	}
+	return fmt.Sprintf("hit(cached buckets:%d, queried buckets:%d)", s.CachedBuckets, s.QueriedBuckets)
+}
+
 // QueryStats represents the query stats when need explain query flow stat
 type QueryStats struct {
 	Root         string                    `json:"root"`
 	BrokerNodes  map[string]*QueryStats    `json:"brokerNodes,omitempty"`
 	LeafNodes    map[string]*LeafNodeStats `json:"leafNodes,omitempty"`
 	NetPayload   int64                     `json:"netPayload"`
+	PeakMemory   int64                     `json:"peakMemory"`            // peak memory used by merging results
+	ResultCache  *ResultCacheStats         `json:"resultCache,omitempty"` // stats of broker result cache
 	PlanCost     int64                     `json:"planCost,omitempty"`
 	PlanStart    int64                     `json:"planStart,omitempty"`
 	PlanEnd      int64                     `json:"planEnd,omitempty"`
@@ -103,6 +139,25 @@ func NewQueryStats() *QueryStats {
 	}
 }
 
+// ScanStats returns the total data scanned by all leaf nodes.
+func (s *QueryStats) ScanStats() (series, families, bytes int64) {
+	for _, leaf := range s.LeafNodes {
+		series += leaf.ScannedSeries
+		families += leaf.ScannedFamilies
+		bytes += leaf.ScannedBytes
+	}
+	for _, node := range s.BrokerNodes {
+		if node == s {
+			continue
+		}
+		nodeSeries, nodeFamilies, nodeBytes := node.ScanStats()
+		series += nodeSeries
+		families += nodeFamilies
+		bytes += nodeBytes
+	}
+	return
+}
+
 // MergeBrokerTaskStats merges intermediate task execution stats
 func (s *QueryStats) MergeBrokerTaskStats(nodeID string, stats *QueryStats) {
 	s.BrokerNodes[nodeID] = stats
@@ -125,19 +180,19 @@ func (s *QueryStats) ToTable() (rows int, tableStr string) {
 	treeprint.EdgeTypeMid = "^^"
 	treeprint.EdgeTypeEnd = "~~"
 	treeprint.IndentSize = 2
-	tree := treeprint.NewWithRoot(fmt.Sprintf("Root(%s): [Cost:%s, Plan:%s, Wait:%s, Express: %s], Net Payload:%s",
+	tree := treeprint.NewWithRoot(fmt.Sprintf("Root(%s): [Cost:%s, Plan:%s, Wait:%s, Express: %s], Net Payload:%s, Peak Memory:%s",
 		s.Root, time.Duration(s.TotalCost), time.Duration(s.PlanCost), time.Duration(s.WaitCost),
-		time.Duration(s.ExpressCost), ltoml.Size(s.NetPayload),
+		time.Duration(s.ExpressCost), ltoml.Size(s.NetPayload), ltoml.Size(s.PeakMemory),
 	))
+	if s.ResultCache != nil {
+		tree.AddNode(fmt.Sprintf("Result Cache: %s", s.ResultCache))
+	}
 
 	for node, leaf := range s.LeafNodes {
-		leafNode := tree.AddBranch(fmt.Sprintf("Leaf(%s): [Cost:%s], Net Payload:%s",
-			node, time.Duration(leaf.TotalCost), ltoml.Size(leaf.NetPayload)))
-		for _, stage := range leaf.Stages {
-			s.stageToTable(leafNode, stage)
-		}
-		leafNode = tree.AddBranch(fmt.Sprintf("Leaf(%s): [Cost:%s], Net Payload:%s",
-			node, time.Duration(leaf.TotalCost), ltoml.Size(leaf.NetPayload)))
+		leafNode := tree.AddBranch(fmt.Sprintf("Leaf(%s): [Cost:%s], Net Payload:%s, Peak Memory:%s, "+
+			"Scanned Series:%d, Scanned Families:%d, Scanned Bytes:%s",
+			node, time.Duration(leaf.TotalCost), ltoml.Size(leaf.NetPayload), ltoml.Size(leaf.PeakMemory),
+			leaf.ScannedSeries, leaf.ScannedFamilies, ltoml.Size(leaf.ScannedBytes)))
 		for _, stage := range leaf.Stages {
 			s.stageToTable(leafNode, stage)
 		}
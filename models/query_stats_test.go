@@ -31,3 +31,32 @@ func TestNewQueryStats(t *testing.T) {
 	assert.True(t, ok)
 	stats.MergeBrokerTaskStats("node-1", stats)
 }
+
+func TestQueryStats_ToTable(t *testing.T) {
+	stats := NewQueryStats()
+	_, str := stats.ToTable()
+	assert.NotContains(t, str, "Result Cache")
+
+	stats.ResultCache = &ResultCacheStats{QueriedBuckets: 10}
+	_, str = stats.ToTable()
+	assert.Contains(t, str, "Result Cache: miss(queried buckets:10)")
+
+	stats.ResultCache = &ResultCacheStats{Hit: true, CachedBuckets: 9, QueriedBuckets: 1}
+	_, str = stats.ToTable()
+	assert.Contains(t, str, "Result Cache: hit(cached buckets:9, queried buckets:1)")
+}
+
+func TestQueryStats_ScanStats(t *testing.T) {
+	stats := NewQueryStats()
+	stats.MergeLeafTaskStats("leaf-1", &LeafNodeStats{ScannedSeries: 10, ScannedFamilies: 1, ScannedBytes: 80})
+	brokerStats := NewQueryStats()
+	brokerStats.MergeLeafTaskStats("leaf-2", &LeafNodeStats{ScannedSeries: 5, ScannedFamilies: 2, ScannedBytes: 40})
+	stats.MergeBrokerTaskStats("node-1", brokerStats)
+	series, families, bytes := stats.ScanStats()
+	assert.Equal(t, int64(15), series)
+	assert.Equal(t, int64(3), families)
+	assert.Equal(t, int64(120), bytes)
+
+	_, str := stats.ToTable()
+	assert.Contains(t, str, "Scanned Series:10, Scanned Families:1")
+}
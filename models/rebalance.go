@@ -0,0 +1,148 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// ReplicaMoveStatus represents the status of replica move.
+type ReplicaMoveStatus string
+
+const (
+	// ReplicaMovePending represents move is planned, target node will be added as learner of shard.
+	ReplicaMovePending ReplicaMoveStatus = "Pending"
+	// ReplicaMoveSnapshot represents target node is learner(leader keeps write ahead log for it),
+	// family snapshot of shard is installing on target node.
+	ReplicaMoveSnapshot ReplicaMoveStatus = "Snapshot"
+	// ReplicaMoveCatchUp represents target node joined replica list, it is catching up write ahead log of leader.
+	ReplicaMoveCatchUp ReplicaMoveStatus = "CatchUp"
+	// ReplicaMoveDone represents source replica is retired, move is completed.
+	ReplicaMoveDone ReplicaMoveStatus = "Done"
+	// ReplicaMoveFailed represents move is failure.
+	ReplicaMoveFailed ReplicaMoveStatus = "Failed"
+)
+
+// ReplicaMove represents a replica move of shard from source node to target node.
+type ReplicaMove struct {
+	Storage  string  `json:"storage"`
+	Database string  `json:"database"`
+	ShardID  ShardID `json:"shardId"`
+	// Source represents the replica node which will be retired, NoLeader if only adds replica(replica factor raised).
+	Source NodeID `json:"source"`
+	// Target represents the node which will be new replica, NoLeader if only drops replica(replica factor lowered).
+	Target NodeID `json:"target"`
+	// SnapshotFrom represents the live replica node which family snapshot is copied from.
+	SnapshotFrom NodeID            `json:"snapshotFrom"`
+	Reason       string            `json:"reason"`
+	Status       ReplicaMoveStatus `json:"status"`
+	// Lag represents the pending write ahead log of target node when catching up.
+	Lag        int64  `json:"lag"`
+	ErrMsg     string `json:"errMsg,omitempty"`
+	CreateTime int64  `json:"createTime"`
+	UpdateTime int64  `json:"updateTime"`
+}
+
+// Key returns the unique key of replica move(one move per shard).
+func (m *ReplicaMove) Key() string {
+	return fmt.Sprintf("%s/%d", m.Database, m.ShardID)
+}
+
+// IsFinished returns if replica move is completed or failure.
+func (m *ReplicaMove) IsFinished() bool {
+	return m.Status == ReplicaMoveDone || m.Status == ReplicaMoveFailed
+}
+
+// ReplicaMoves represents the replica move list.
+type ReplicaMoves []*ReplicaMove
+
+// ToTable returns replica move list as table if it has value, else return empty string.
+func (ms ReplicaMoves) ToTable() (rows int, tableStr string) {
+	if len(ms) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Storage", "Database", "Shard", "Source", "Target", "Status", "Lag", "Reason", "Error", "Update Time"})
+	for _, m := range ms {
+		writer.AppendRow(table.Row{
+			m.Storage,
+			m.Database,
+			m.ShardID,
+			m.Source,
+			m.Target,
+			m.Status,
+			m.Lag,
+			m.Reason,
+			m.ErrMsg,
+			timeutil.FormatTimestamp(m.UpdateTime, timeutil.DataTimeFormat2),
+		})
+	}
+	return len(ms), writer.Render()
+}
+
+// NodeDecommissionStatus represents the status of storage node decommission.
+type NodeDecommissionStatus string
+
+const (
+	// NodeDecommissioning represents replicas on node are moving to other nodes.
+	NodeDecommissioning NodeDecommissionStatus = "Decommissioning"
+	// NodeRemovable represents no replica/leader on node, node can be removed safely.
+	NodeRemovable NodeDecommissionStatus = "Removable"
+)
+
+// NodeDecommission represents the decommission progress of storage node.
+type NodeDecommission struct {
+	NodeID NodeID                 `json:"nodeId"`
+	Status NodeDecommissionStatus `json:"status"`
+	// Replicas represents the number of replicas(include learners) remaining on node.
+	Replicas int `json:"replicas"`
+	// Leaders represents the number of shard leaders remaining on node.
+	Leaders    int   `json:"leaders"`
+	CreateTime int64 `json:"createTime"`
+	UpdateTime int64 `json:"updateTime"`
+}
+
+// IsRemovable returns if node can be removed safely.
+func (d *NodeDecommission) IsRemovable() bool {
+	return d.Status == NodeRemovable
+}
+
+// DecommissionParam represents the param of storage node decommission.
+type DecommissionParam struct {
+	Storage string `json:"storage" binding:"required"`
+	NodeID  NodeID `json:"nodeId"`
+}
+
+// FenceParam represents the param of fencing client writes of shard on its leader when transferring leadership.
+type FenceParam struct {
+	Database string  `json:"database" binding:"required"`
+	ShardID  ShardID `json:"shardId"`
+	// Lease represents how long(milliseconds) the fence keeps, renewed by next fence.
+	Lease int64 `json:"lease" binding:"required"`
+}
+
+// SnapshotParam represents the param of installing shard's family snapshot which copied from source node.
+type SnapshotParam struct {
+	Database string        `json:"database" binding:"required"`
+	ShardID  ShardID       `json:"shardId"`
+	Source   StatelessNode `json:"source"`
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicaMove(t *testing.T) {
+	move := &ReplicaMove{Database: "db", ShardID: 1, Status: ReplicaMoveCatchUp}
+	assert.Equal(t, "db/1", move.Key())
+	assert.False(t, move.IsFinished())
+	move.Status = ReplicaMoveDone
+	assert.True(t, move.IsFinished())
+	move.Status = ReplicaMoveFailed
+	assert.True(t, move.IsFinished())
+}
+
+func TestNodeDecommission(t *testing.T) {
+	d := &NodeDecommission{Status: NodeDecommissioning}
+	assert.False(t, d.IsRemovable())
+	d.Status = NodeRemovable
+	assert.True(t, d.IsRemovable())
+}
+
+func TestReplicaMoves_ToTable(t *testing.T) {
+	rows, rs := ReplicaMoves{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+
+	rows, rs = ReplicaMoves{
+		{Storage: "s", Database: "db", ShardID: 1, Source: 1, Target: 2, Status: ReplicaMoveSnapshot, Reason: "node join"},
+	}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, rs, "Snapshot")
+	assert.Contains(t, rs, "node join")
+}
@@ -17,6 +17,16 @@
 
 package models
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
 // Request represents lin query langage execute request.
 type Request struct {
 	Broker    string `json:"broker"`
@@ -24,4 +34,55 @@ type Request struct {
 	DB        string `json:"db"`
 	SQL       string `json:"sql"`
 	Start     int64  `json:"start"`
+	// Class represents the workload class of query(dashboard/alerting/ad-hoc/internal).
+	Class string `json:"class,omitempty"`
+	// Queued represents the request is waiting in admission queue.
+	Queued bool `json:"queued,omitempty"`
+	// QueueWait represents the time(ns) waiting in admission queue.
+	QueueWait int64 `json:"queueWait"`
+	// Caller represents the client address of request.
+	Caller string `json:"caller,omitempty"`
+}
+
+// SlowRequest represents the lin query request which cost exceeds slow query threshold.
+type SlowRequest struct {
+	Node      string `json:"node"`
+	Role      string `json:"role"` // broker/storage
+	RequestID string `json:"requestId"`
+	DB        string `json:"db"`
+	SQL       string `json:"sql"` // sql for broker, query statement(json) for storage
+	Caller    string `json:"caller"`
+	Start     int64  `json:"start"`
+	Cost      int64  `json:"cost"`
+	ErrMsg    string `json:"errMsg,omitempty"`
+
+	ScannedSeries   int64 `json:"scannedSeries"`
+	ScannedFamilies int64 `json:"scannedFamilies"`
+	ScannedBytes    int64 `json:"scannedBytes"`
+
+	Stats     *QueryStats    `json:"stats,omitempty"`     // execution stats of broker
+	LeafStats *LeafNodeStats `json:"leafStats,omitempty"` // execution stats of storage
+}
+
+// SlowRequests represents the slow request list.
+type SlowRequests []*SlowRequest
+
+// ToTable returns slow request list as table if it has value, else return empty string.
+func (rs SlowRequests) ToTable() (rows int, tableStr string) {
+	if len(rs) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Start", "Cost", "Node", "Role", "Database", "Caller",
+		"Scanned(Series/Families/Bytes)", "SQL", "Error"})
+	for _, r := range rs {
+		writer.AppendRow(table.Row{
+			timeutil.FormatTimestamp(time.Unix(0, r.Start).UnixMilli(), timeutil.DataTimeFormat2),
+			time.Duration(r.Cost).String(),
+			r.Node, r.Role, r.DB, r.Caller,
+			fmt.Sprintf("%d/%d/%s", r.ScannedSeries, r.ScannedFamilies, ltoml.Size(r.ScannedBytes)),
+			r.SQL, r.ErrMsg,
+		})
+	}
+	return len(rs), writer.Render()
 }
@@ -18,7 +18,6 @@
 package models
 
 import (
-	"fmt"
 	"path"
 	"sort"
 
@@ -42,6 +41,8 @@ type ResultSet struct {
 	Interval   int64       `json:"interval,omitempty"`
 	Series     []*Series   `json:"series,omitempty"`
 	Stats      *QueryStats `json:"stats,omitempty"`
+	// Scanned represents the data scanned by query, collected for each query(not only explain query).
+	Scanned ScanStats `json:"-"`
 }
 
 // NewResultSet creates a new result set
@@ -54,11 +55,88 @@ func (rs *ResultSet) AddSeries(series *Series) {
 	rs.Series = append(rs.Series, series)
 }
 
-// row represents a record in table.
-type row struct {
-	timestamp int64
-	values    map[string]float64
-	tags      map[string]string
+// ResultChunkType represents the chunk type of chunked query result.
+type ResultChunkType string
+
+const (
+	// SeriesChunk represents the chunk which includes one series group.
+	SeriesChunk ResultChunkType = "series"
+	// EndChunk represents the last chunk which includes the result set without series(fields/stats etc.).
+	EndChunk ResultChunkType = "end"
+	// ErrorChunk represents the chunk which includes the failure after writing chunks started.
+	ErrorChunk ResultChunkType = "error"
+)
+
+// ResultChunk represents a chunk(one line of NDJSON) of chunked query result.
+type ResultChunk struct {
+	Type   ResultChunkType `json:"type"`
+	Series *Series         `json:"series,omitempty"`
+	Meta   *ResultSet      `json:"meta,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// ResultRow represents a row of result set, which includes tag values(ordered by group by),
+// timestamp and field values(ordered by fields).
+type ResultRow struct {
+	TagValues []string
+	Timestamp int64
+	Values    []float64
+	Exists    []bool // if field has value at timestamp
+}
+
+// Rows returns the rows of result set(one row per tags and timestamp, one column per field),
+// ordered by tag values and timestamp.
+func (rs *ResultSet) Rows() []*ResultRow {
+	fieldIndexes := make(map[string]int, len(rs.Fields))
+	for idx, f := range rs.Fields {
+		fieldIndexes[f] = idx
+	}
+	type rowKey struct {
+		tags      string
+		timestamp int64
+	}
+	rows := make(map[rowKey]*ResultRow)
+	var keys []rowKey
+	for _, s := range rs.Series {
+		tagValues := make([]string, len(rs.GroupBy))
+		for idx, tagKey := range rs.GroupBy {
+			tagValues[idx] = s.Tags[tagKey]
+		}
+		tags := path.Join(tagValues...)
+		for n, f := range s.Fields {
+			fieldIdx, ok := fieldIndexes[n]
+			if !ok {
+				continue
+			}
+			for timestamp, v := range f {
+				k := rowKey{tags: tags, timestamp: timestamp}
+				r, ok := rows[k]
+				if !ok {
+					r = &ResultRow{
+						TagValues: tagValues,
+						Timestamp: timestamp,
+						Values:    make([]float64, len(rs.Fields)),
+						Exists:    make([]bool, len(rs.Fields)),
+					}
+					keys = append(keys, k)
+					rows[k] = r
+				}
+				r.Values[fieldIdx] = v
+				r.Exists[fieldIdx] = true
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].tags != keys[j].tags {
+			return keys[i].tags < keys[j].tags
+		}
+		return keys[i].timestamp < keys[j].timestamp
+	})
+	result := make([]*ResultRow, len(keys))
+	for idx, k := range keys {
+		result[idx] = rows[k]
+	}
+	return result
 }
 
 // ToTable returns the result of query as table if it has value, else return empty string.
@@ -79,41 +157,17 @@ func (rs *ResultSet) ToTable() (rows int, tableStr string) {
 	for _, f := range rs.Fields {
 		headers = append(headers, f)
 	}
-	// 2. build table rows
-	tableRows := make(map[string]*row)
-	var pks []string
-	for _, s := range rs.Series {
-		var values []string
-		for _, tagKey := range rs.GroupBy {
-			values = append(values, s.Tags[tagKey])
-		}
-		key := path.Join(values...)
-		for n, f := range s.Fields {
-			for timestamp, v := range f {
-				k := fmt.Sprintf("%s_%d", key, timestamp)
-				i, ok := tableRows[k]
-				if !ok {
-					i = &row{values: make(map[string]float64), tags: s.Tags, timestamp: timestamp}
-					pks = append(pks, k)
-					tableRows[k] = i
-				}
-				i.values[n] = v
-			}
-		}
-	}
-	// 3. format as table
+	// 2. format rows as table
 	result := NewTableFormatter()
 	result.AppendHeader(headers)
-	sort.Strings(pks)
-	for _, pk := range pks {
-		r := tableRows[pk]
+	for _, r := range rs.Rows() {
 		row := table.Row{}
-		for _, tagKey := range rs.GroupBy {
-			row = append(row, r.tags[tagKey])
+		for _, tagValue := range r.TagValues {
+			row = append(row, tagValue)
 		}
-		row = append(row, timeutil.FormatTimestamp(r.timestamp, timeutil.DataTimeFormat2))
-		for _, f := range rs.Fields {
-			row = append(row, r.values[f])
+		row = append(row, timeutil.FormatTimestamp(r.Timestamp, timeutil.DataTimeFormat2))
+		for _, v := range r.Values {
+			row = append(row, v)
 		}
 		result.AppendRow(row)
 	}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// RecordingRule represents the recording rule(continuous query) which runs the query periodically,
+// then writes the result back into database as new metric.
+type RecordingRule struct {
+	Name      string `json:"name" validate:"required"`
+	Database  string `json:"database" validate:"required"`
+	Namespace string `json:"namespace,omitempty"`
+	// SQL represents the query of rule, time range is set by scheduler.
+	SQL string `json:"sql" validate:"required"`
+	// Metric represents the target metric name which the result written into.
+	Metric string `json:"metric" validate:"required"`
+	// Interval represents the evaluation interval(millisecond).
+	Interval int64 `json:"interval" validate:"gt=0"`
+}
+
+// RecordingRules represents the recording rule list.
+type RecordingRules []*RecordingRule
+
+// ToTable returns recording rule list as table if it has value, else return empty string.
+func (rs RecordingRules) ToTable() (rows int, tableStr string) {
+	if len(rs) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Database", "Namespace", "Metric", "Interval", "SQL"})
+	for _, r := range rs {
+		writer.AppendRow(table.Row{
+			r.Name, r.Database, r.Namespace, r.Metric, timeutil.Interval(r.Interval).String(), r.SQL,
+		})
+	}
+	return len(rs), writer.Render()
+}
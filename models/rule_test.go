@@ -0,0 +1,43 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestRecordingRules_ToTable(t *testing.T) {
+	rows, rs := RecordingRules{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+
+	rows, rs = RecordingRules{{
+		Name:     "svc_requests",
+		Database: "test",
+		Metric:   "svc:requests:1m",
+		Interval: timeutil.OneMinute,
+		SQL:      "select sum(requests) from http group by service",
+	}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, rs, "svc:requests:1m")
+	assert.Contains(t, rs, "1m")
+}
@@ -216,6 +216,9 @@ type StorageState struct {
 	// TODO remove??
 	ShardAssignments map[string]*ShardAssignment       `json:"shardAssignments"` // database's name => shard assignment
 	ShardStates      map[string]map[ShardID]ShardState `json:"shardStates"`      // database's name => shard state
+
+	// Decommissions represents the decommission progress of storage nodes.
+	Decommissions map[NodeID]*NodeDecommission `json:"decommissions,omitempty"`
 }
 
 // NewStorageState creates storage cluster state
@@ -271,6 +274,12 @@ func (s *StorageState) NodeOffline(nodeID NodeID) {
 	delete(s.LiveNodes, nodeID)
 }
 
+// IsDecommissioned checks if node is decommissioning(or removable), no new replica can be assigned to it.
+func (s *StorageState) IsDecommissioned(nodeID NodeID) bool {
+	_, ok := s.Decommissions[nodeID]
+	return ok
+}
+
 // Stringer returns a human readable string
 func (s *StorageState) String() string {
 	return string(encoding.JSONMarshal(s))
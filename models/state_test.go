@@ -91,6 +91,10 @@ func TestStorageState(t *testing.T) {
 
 	assert.NotEmpty(t, storageState.String())
 
+	assert.False(t, storageState.IsDecommissioned(3))
+	storageState.Decommissions = map[NodeID]*NodeDecommission{3: {NodeID: 3, Status: NodeDecommissioning}}
+	assert.True(t, storageState.IsDecommissioned(3))
+
 	storageState.DropDatabase("test")
 	_, ok := storageState.ShardAssignments["test"]
 	assert.False(t, ok)
@@ -0,0 +1,159 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// TenantQuota represents the quotas of tenant, 0 means unlimited.
+// Ingest rate/concurrent queries are enforced by each broker locally with its share of quota, see PerBroker.
+type TenantQuota struct {
+	// IngestRate represents the max ingested points per second of cluster.
+	IngestRate int64 `json:"ingestRate"`
+	// MaxSeries represents the max number of series of all databases.
+	MaxSeries int64 `json:"maxSeries"`
+	// MaxQueries represents the max number of concurrent queries of cluster.
+	MaxQueries int64 `json:"maxQueries"`
+	// MaxDiskBytes represents the max disk bytes of all databases.
+	MaxDiskBytes int64 `json:"maxDiskBytes"`
+}
+
+// PerBroker returns the share of each broker for ingest rate/concurrent queries,
+// the quota is divided by the number of live brokers(rounded up), assuming requests are balanced between brokers.
+func (q TenantQuota) PerBroker(brokers int64) TenantQuota {
+	if brokers <= 1 {
+		return q
+	}
+	share := func(quota int64) int64 {
+		if quota <= 0 {
+			return quota
+		}
+		return (quota + brokers - 1) / brokers
+	}
+	q.IngestRate = share(q.IngestRate)
+	q.MaxQueries = share(q.MaxQueries)
+	return q
+}
+
+// Tenant represents the tenant which owns databases, the resource usage of databases is limited by quotas.
+type Tenant struct {
+	Name      string      `json:"name" validate:"required"`
+	Quota     TenantQuota `json:"quota"`
+	CreatedAt int64       `json:"createdAt"`
+}
+
+// Tenants represents the tenant list.
+type Tenants []*Tenant
+
+// ToTable returns tenant list as table if it has value, else return empty string.
+func (ts Tenants) ToTable() (rows int, tableStr string) {
+	if len(ts) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Ingest Rate", "Max Series", "Max Queries", "Max Disk", "Created At"})
+	for _, t := range ts {
+		writer.AppendRow(table.Row{
+			t.Name,
+			formatQuota(t.Quota.IngestRate),
+			formatQuota(t.Quota.MaxSeries),
+			formatQuota(t.Quota.MaxQueries),
+			formatSizeQuota(t.Quota.MaxDiskBytes),
+			timeutil.FormatTimestamp(t.CreatedAt, timeutil.DataTimeFormat2),
+		})
+	}
+	return len(ts), writer.Render()
+}
+
+// TenantUsage represents the resource usage of tenant.
+type TenantUsage struct {
+	Name      string      `json:"name"`
+	Databases []string    `json:"databases"`
+	Quota     TenantQuota `json:"quota"`
+	// Brokers represents the number of live brokers which share the ingest rate/concurrent queries quota.
+	Brokers int64 `json:"brokers"`
+	// IngestRate represents the ingested points per second of current broker.
+	IngestRate float64 `json:"ingestRate"`
+	// Queries represents the number of running queries of current broker.
+	Queries     int64 `json:"queries"`
+	NumOfSeries int64 `json:"numOfSeries"`
+	DiskBytes   int64 `json:"diskBytes"`
+}
+
+// TenantUsages represents the resource usage list of tenants.
+type TenantUsages []*TenantUsage
+
+// ToTable returns resource usage of tenants as table if it has value, else return empty string.
+// Ingest rate/queries are the usage and the quota share of current broker.
+func (us TenantUsages) ToTable() (rows int, tableStr string) {
+	if len(us) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Databases", "Ingest Rate(per broker)", "Series", "Queries(per broker)", "Disk"})
+	for _, u := range us {
+		share := u.Quota.PerBroker(u.Brokers)
+		writer.AppendRow(table.Row{
+			u.Name,
+			strings.Join(u.Databases, ", "),
+			strconv.FormatFloat(u.IngestRate, 'f', 2, 64) + "/" + formatQuota(share.IngestRate),
+			strconv.FormatInt(u.NumOfSeries, 10) + "/" + formatQuota(u.Quota.MaxSeries),
+			strconv.FormatInt(u.Queries, 10) + "/" + formatQuota(share.MaxQueries),
+			ltoml.Size(u.DiskBytes).String() + "/" + formatSizeQuota(u.Quota.MaxDiskBytes),
+		})
+	}
+	return len(us), writer.Render()
+}
+
+// DatabaseUsage represents the resource usage of database in storage node.
+type DatabaseUsage struct {
+	Database string `json:"database"`
+	// MetaBytes represents the disk bytes of metadata which shared by all shards.
+	MetaBytes int64        `json:"metaBytes"`
+	Shards    []ShardUsage `json:"shards"`
+}
+
+// ShardUsage represents the resource usage of shard.
+type ShardUsage struct {
+	ShardID     ShardID `json:"shardId"`
+	NumOfSeries int64   `json:"numOfSeries"`
+	DiskBytes   int64   `json:"diskBytes"`
+}
+
+// formatQuota returns the string of quota, unlimited if 0.
+func formatQuota(quota int64) string {
+	if quota <= 0 {
+		return "unlimited"
+	}
+	return strconv.FormatInt(quota, 10)
+}
+
+// formatSizeQuota returns the string of size quota, unlimited if 0.
+func formatSizeQuota(quota int64) string {
+	if quota <= 0 {
+		return "unlimited"
+	}
+	return ltoml.Size(quota).String()
+}
@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenants_ToTable(t *testing.T) {
+	rows, rs := Tenants{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+
+	rows, rs = Tenants{
+		{Name: "t1"},
+		{Name: "t2", Quota: TenantQuota{IngestRate: 100, MaxSeries: 1000, MaxQueries: 10, MaxDiskBytes: 1024}},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.Contains(t, rs, "unlimited")
+	assert.Contains(t, rs, "1.0 KiB")
+}
+
+func TestTenantUsages_ToTable(t *testing.T) {
+	rows, rs := TenantUsages{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+
+	rows, rs = TenantUsages{{
+		Name:        "t1",
+		Databases:   []string{"db1", "db2"},
+		Quota:       TenantQuota{MaxSeries: 1000},
+		IngestRate:  10.5,
+		NumOfSeries: 100,
+		DiskBytes:   2048,
+	}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, rs, "100/1000")
+	assert.Contains(t, rs, "db1, db2")
+	assert.Contains(t, rs, "per broker")
+
+	_, rs = TenantUsages{{
+		Name:       "t1",
+		Quota:      TenantQuota{IngestRate: 1000, MaxQueries: 5},
+		Brokers:    2,
+		IngestRate: 10.5,
+		Queries:    1,
+	}}.ToTable()
+	assert.Contains(t, rs, "10.50/500")
+	assert.Contains(t, rs, "1/3")
+}
+
+func TestTenantQuota_PerBroker(t *testing.T) {
+	quota := TenantQuota{IngestRate: 1000, MaxSeries: 100, MaxQueries: 5, MaxDiskBytes: 1024}
+	assert.Equal(t, quota, quota.PerBroker(0))
+	assert.Equal(t, quota, quota.PerBroker(1))
+	assert.Equal(t, TenantQuota{IngestRate: 334, MaxSeries: 100, MaxQueries: 2, MaxDiskBytes: 1024}, quota.PerBroker(3))
+	assert.Equal(t, TenantQuota{IngestRate: 1, MaxQueries: 1}, TenantQuota{IngestRate: 1, MaxQueries: 1}.PerBroker(3))
+	assert.Equal(t, TenantQuota{}, TenantQuota{}.PerBroker(3))
+}
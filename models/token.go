@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// APITokenParam represents the param of creating api token by admin api.
+type APITokenParam struct {
+	Name        string   `form:"name" json:"name" binding:"required"`
+	Database    string   `form:"database" json:"database" binding:"required"`
+	Namespace   string   `form:"namespace" json:"namespace"`
+	Permissions []string `form:"permissions" json:"permissions" binding:"required"`
+}
+
+// APIToken represents the long-lived token of non-interactive client(like collector),
+// which is scoped to database(namespace) and operations.
+type APIToken struct {
+	Name string `json:"name" validate:"required"`
+	// ID represents the identifier part of token, used to lookup token when authenticating.
+	ID string `json:"id"`
+	// Hash represents the hash of token secret, plain token is only returned when created.
+	Hash     string `json:"hash,omitempty"`
+	Database string `json:"database" validate:"required"`
+	// Namespace represents the namespace of database, applies on all namespaces if empty.
+	Namespace   string       `json:"namespace,omitempty"`
+	Permissions []Permission `json:"permissions" validate:"required,dive,oneof=read write"`
+	CreatedBy   string       `json:"createdBy,omitempty"`
+	CreatedAt   int64        `json:"createdAt"`
+}
+
+// Privileges returns the privileges granted by token.
+func (t *APIToken) Privileges() Privileges {
+	privileges := make(Privileges, 0, len(t.Permissions))
+	for _, permission := range t.Permissions {
+		privileges = append(privileges, Privilege{
+			Permission: permission,
+			Database:   t.Database,
+			Namespace:  t.Namespace,
+		})
+	}
+	return privileges
+}
+
+// APITokens represents the api token list.
+type APITokens []*APIToken
+
+// ToTable returns api token list as table if it has value, else return empty string.
+func (ts APITokens) ToTable() (rows int, tableStr string) {
+	if len(ts) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Database", "Namespace", "Permissions", "Created By", "Created At"})
+	for _, t := range ts {
+		permissions := make([]string, len(t.Permissions))
+		for idx, permission := range t.Permissions {
+			permissions[idx] = string(permission)
+		}
+		writer.AppendRow(table.Row{
+			t.Name,
+			t.Database,
+			t.Namespace,
+			strings.Join(permissions, ", "),
+			t.CreatedBy,
+			timeutil.FormatTimestamp(t.CreatedAt, timeutil.DataTimeFormat2),
+		})
+	}
+	return len(ts), writer.Render()
+}
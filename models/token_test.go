@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIToken_Privileges(t *testing.T) {
+	token := &APIToken{
+		Name:        "t1",
+		Database:    "db",
+		Namespace:   "ns",
+		Permissions: []Permission{PermissionRead, PermissionWrite},
+	}
+	privileges := token.Privileges()
+	assert.Equal(t, "read on db.ns, write on db.ns", privileges.String())
+	assert.True(t, privileges.Match(PermissionWrite, "db", "ns"))
+	assert.False(t, privileges.Match(PermissionWrite, "db", "ns2"))
+}
+
+func TestAPITokens_ToTable(t *testing.T) {
+	rows, rs := APITokens{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+
+	rows, rs = APITokens{{
+		Name:        "t1",
+		Database:    "db",
+		Permissions: []Permission{PermissionWrite},
+		CreatedBy:   "admin",
+	}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, rs)
+}
@@ -0,0 +1,173 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// Permission represents the operation permission on database.
+type Permission string
+
+const (
+	// PermissionRead represents query data/metadata of database.
+	PermissionRead Permission = "read"
+	// PermissionWrite represents write data into database.
+	PermissionWrite Permission = "write"
+)
+
+const (
+	// RoleAdmin represents built-in admin role, which has all privileges, includes cluster management.
+	RoleAdmin = "admin"
+	// AllDatabases represents the privilege applies on all databases.
+	AllDatabases = "*"
+)
+
+// Privilege represents the permission on database(namespace).
+type Privilege struct {
+	Permission Permission `json:"permission" validate:"oneof=read write"`
+	Database   string     `json:"database" validate:"required"`
+	// Namespace represents the namespace of database, applies on all namespaces if empty.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Match checks if privilege grants the permission on database/namespace.
+func (p *Privilege) Match(permission Permission, database, namespace string) bool {
+	if p.Permission != permission {
+		return false
+	}
+	if p.Database != AllDatabases && p.Database != database {
+		return false
+	}
+	return p.Namespace == "" || p.Namespace == namespace
+}
+
+// String returns the string value of privilege, format: permission on database[.namespace].
+func (p *Privilege) String() string {
+	if p.Namespace == "" {
+		return string(p.Permission) + " on " + p.Database
+	}
+	return string(p.Permission) + " on " + p.Database + "." + p.Namespace
+}
+
+// Privileges represents the privilege list.
+type Privileges []Privilege
+
+// Grant adds the privilege, returns false if privilege already granted.
+func (ps *Privileges) Grant(privilege Privilege) bool {
+	for _, p := range *ps {
+		if p == privilege {
+			return false
+		}
+	}
+	*ps = append(*ps, privilege)
+	return true
+}
+
+// Revoke removes the privilege, returns false if privilege not granted.
+func (ps *Privileges) Revoke(privilege Privilege) bool {
+	for idx, p := range *ps {
+		if p == privilege {
+			*ps = append((*ps)[:idx], (*ps)[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Match checks if any privilege grants the permission on database/namespace.
+func (ps Privileges) Match(permission Permission, database, namespace string) bool {
+	for idx := range ps {
+		if ps[idx].Match(permission, database, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the string value of privilege list.
+func (ps Privileges) String() string {
+	values := make([]string, len(ps))
+	for idx := range ps {
+		values[idx] = ps[idx].String()
+	}
+	sort.Strings(values)
+	return strings.Join(values, ", ")
+}
+
+// Role represents the named privilege set which can be granted to user.
+type Role struct {
+	Name       string     `json:"name" validate:"required"`
+	Privileges Privileges `json:"privileges,omitempty"`
+}
+
+// Roles represents the role list.
+type Roles []*Role
+
+// ToTable returns role list as table if it has value, else return empty string.
+func (rs Roles) ToTable() (rows int, tableStr string) {
+	if len(rs) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Privileges"})
+	for _, r := range rs {
+		writer.AppendRow(table.Row{r.Name, r.Privileges.String()})
+	}
+	return len(rs), writer.Render()
+}
+
+// User represents the user which accesses broker api.
+type User struct {
+	Name string `json:"name" validate:"required"`
+	// Password represents the hash of user password.
+	Password   string     `json:"password,omitempty"`
+	Roles      []string   `json:"roles,omitempty"`
+	Privileges Privileges `json:"privileges,omitempty"`
+	// Generation represents the token generation of user, tokens issued with other generation are invalid.
+	Generation int64 `json:"generation,omitempty"`
+}
+
+// HasRole checks if user has the role.
+func (u *User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Users represents the user list.
+type Users []*User
+
+// ToTable returns user list as table if it has value, else return empty string.
+func (us Users) ToTable() (rows int, tableStr string) {
+	if len(us) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Roles", "Privileges"})
+	for _, u := range us {
+		writer.AppendRow(table.Row{u.Name, strings.Join(u.Roles, ", "), u.Privileges.String()})
+	}
+	return len(us), writer.Render()
+}
@@ -0,0 +1,71 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrivilege_Match(t *testing.T) {
+	p := &Privilege{Permission: PermissionRead, Database: "db"}
+	assert.True(t, p.Match(PermissionRead, "db", "ns"))
+	assert.False(t, p.Match(PermissionWrite, "db", "ns"))
+	assert.False(t, p.Match(PermissionRead, "db2", "ns"))
+	assert.Equal(t, "read on db", p.String())
+
+	p = &Privilege{Permission: PermissionWrite, Database: AllDatabases, Namespace: "ns"}
+	assert.True(t, p.Match(PermissionWrite, "db", "ns"))
+	assert.False(t, p.Match(PermissionWrite, "db", "ns2"))
+	assert.Equal(t, "write on *.ns", p.String())
+}
+
+func TestPrivileges(t *testing.T) {
+	var ps Privileges
+	read := Privilege{Permission: PermissionRead, Database: "db"}
+	write := Privilege{Permission: PermissionWrite, Database: "db"}
+	assert.True(t, ps.Grant(read))
+	assert.False(t, ps.Grant(read))
+	assert.True(t, ps.Grant(write))
+	assert.Len(t, ps, 2)
+	assert.True(t, ps.Match(PermissionWrite, "db", "ns"))
+	assert.False(t, ps.Match(PermissionWrite, "db2", "ns"))
+	assert.Equal(t, "read on db, write on db", ps.String())
+	assert.True(t, ps.Revoke(read))
+	assert.False(t, ps.Revoke(read))
+	assert.Len(t, ps, 1)
+}
+
+func TestUser(t *testing.T) {
+	u := &User{Name: "u1", Roles: []string{"r1"}}
+	assert.True(t, u.HasRole("r1"))
+	assert.False(t, u.HasRole(RoleAdmin))
+
+	rows, _ := (Users{}).ToTable()
+	assert.Zero(t, rows)
+	rows, tableStr := (Users{u}).ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, tableStr)
+
+	rows, _ = (Roles{}).ToTable()
+	assert.Zero(t, rows)
+	rows, tableStr = (Roles{{Name: "r1", Privileges: Privileges{{Permission: PermissionRead, Database: "db"}}}}).ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, tableStr)
+}
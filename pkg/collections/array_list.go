@@ -45,6 +45,20 @@ func NewFloatArray(capacity int) *FloatArray {
 	}
 }
 
+// FloatArrayMemSize returns the memory size(bytes) of a float array with a certain capacity.
+func FloatArrayMemSize(capacity int) int {
+	markLen := capacity / blockSize
+	if capacity%blockSize > 0 {
+		markLen++
+	}
+	return capacity*8 + markLen
+}
+
+// MemSize returns the memory size(bytes) of float array's values and marks.
+func (f *FloatArray) MemSize() int {
+	return cap(f.values)*8 + cap(f.marks)
+}
+
 // HasValue returns if has value with pos
 func (f *FloatArray) HasValue(pos int) bool {
 	if !f.checkPos(pos) {
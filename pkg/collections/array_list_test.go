@@ -106,3 +106,10 @@ func BenchmarkFloatArray_HasValue_2(b *testing.B) {
 		_ = pos % blockSize
 	}
 }
+
+func TestFloatArray_MemSize(t *testing.T) {
+	assert.Equal(t, 0, FloatArrayMemSize(0))
+	assert.Equal(t, 64+1, FloatArrayMemSize(8))
+	assert.Equal(t, 80+2, FloatArrayMemSize(10))
+	assert.Equal(t, FloatArrayMemSize(10), NewFloatArray(10).MemSize())
+}
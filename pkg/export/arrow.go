@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"io"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/ipc"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+)
+
+// arrowTimestampType represents the arrow type of timestamp column.
+var arrowTimestampType = &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}
+
+// arrowWriter writes the table as arrow ipc stream(schema message, one record batch message per batch
+// and end-of-stream marker), timestamp column is written as timestamp[ms, tz=UTC], float column is nullable.
+type arrowWriter struct {
+	columns []*Column
+	schema  *arrow.Schema
+	writer  *ipc.Writer
+}
+
+// newArrowWriter creates arrow ipc stream writer.
+func newArrowWriter(w io.Writer, columns []*Column) Writer {
+	schema := newArrowSchema(columns)
+	return &arrowWriter{
+		columns: columns,
+		schema:  schema,
+		writer:  ipc.NewWriter(w, ipc.WithSchema(schema), ipc.WithAllocator(memory.DefaultAllocator)),
+	}
+}
+
+// Write writes the batch as a record batch message.
+func (w *arrowWriter) Write(batch *Table) error {
+	if err := checkColumns(w.columns, batch.Columns); err != nil {
+		return err
+	}
+	record := newArrowRecord(w.schema, batch)
+	defer record.Release()
+	return w.writer.Write(record)
+}
+
+// Close writes the schema message if no batch written and the end-of-stream marker.
+func (w *arrowWriter) Close() error {
+	return w.writer.Close()
+}
+
+// newArrowSchema creates the arrow schema of columns.
+func newArrowSchema(columns []*Column) *arrow.Schema {
+	fields := make([]arrow.Field, len(columns))
+	for idx, column := range columns {
+		field := arrow.Field{Name: column.Name}
+		switch column.Type {
+		case StringColumn:
+			field.Type = arrow.BinaryTypes.String
+		case TimestampColumn:
+			field.Type = arrowTimestampType
+		default:
+			field.Type = arrow.PrimitiveTypes.Float64
+			field.Nullable = true
+		}
+		fields[idx] = field
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// newArrowRecord creates the arrow record of table, the caller must release the record.
+func newArrowRecord(schema *arrow.Schema, table *Table) arrow.Record {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	builder.Reserve(table.NumRows)
+	for idx, column := range table.Columns {
+		switch b := builder.Field(idx).(type) {
+		case *array.StringBuilder:
+			b.AppendValues(column.Strings, nil)
+		case *array.TimestampBuilder:
+			for _, timestamp := range column.Timestamps {
+				b.Append(arrow.Timestamp(timestamp))
+			}
+		case *array.Float64Builder:
+			b.AppendValues(column.Floats, column.Valid)
+		}
+	}
+	return builder.NewRecord()
+}
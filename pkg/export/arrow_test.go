@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/ipc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteArrow(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, Arrow, newTestTable().Columns)
+	assert.NoError(t, err)
+	// write two batches
+	assert.NoError(t, writer.Write(newTestTable()))
+	assert.NoError(t, writer.Write(newTestTable()))
+	assert.NoError(t, writer.Close())
+
+	reader, err := ipc.NewReader(&buf)
+	assert.NoError(t, err)
+	defer reader.Release()
+
+	schema := reader.Schema()
+	assert.Len(t, schema.Fields(), 3)
+	assert.Equal(t, arrow.Field{Name: "host", Type: arrow.BinaryTypes.String}, schema.Field(0))
+	assert.Equal(t, arrow.Field{Name: "timestamp", Type: &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}},
+		schema.Field(1))
+	assert.Equal(t, arrow.Field{Name: "usage", Type: arrow.PrimitiveTypes.Float64, Nullable: true}, schema.Field(2))
+
+	batches := 0
+	for reader.Next() {
+		record := reader.Record()
+		assert.Equal(t, int64(3), record.NumRows())
+		hosts := record.Column(0).(*array.String)
+		assert.Equal(t, []string{"host-1", "host,2", ""}, []string{hosts.Value(0), hosts.Value(1), hosts.Value(2)})
+		timestamps := record.Column(1).(*array.Timestamp)
+		assert.Equal(t, []arrow.Timestamp{1659407840000, 1659407850000, 1659407860000}, timestamps.TimestampValues())
+		usage := record.Column(2).(*array.Float64)
+		assert.Equal(t, 1, usage.NullN())
+		assert.True(t, usage.IsNull(1))
+		assert.Equal(t, 1.5, usage.Value(0))
+		assert.Equal(t, -2.25, usage.Value(2))
+		batches++
+	}
+	assert.NoError(t, reader.Err())
+	assert.Equal(t, 2, batches)
+}
+
+func TestWriteArrow_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Write(&buf, Arrow, &Table{Columns: []*Column{NewColumn("timestamp", TimestampColumn)}}))
+
+	reader, err := ipc.NewReader(&buf)
+	assert.NoError(t, err)
+	defer reader.Release()
+	assert.Equal(t, "timestamp", reader.Schema().Field(0).Name)
+	for reader.Next() {
+		assert.Equal(t, int64(0), reader.Record().NumRows())
+	}
+	assert.NoError(t, reader.Err())
+}
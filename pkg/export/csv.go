@@ -0,0 +1,162 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// csvWriter writes the table as csv with header, timestamp is written as milliseconds since epoch,
+// null value is written as empty string.
+type csvWriter struct {
+	writer  *csv.Writer
+	columns []*Column
+	record  []string
+}
+
+// newCSVWriter creates csv writer, then writes the header.
+func newCSVWriter(w io.Writer, columns []*Column) (Writer, error) {
+	writer := &csvWriter{
+		writer:  csv.NewWriter(w),
+		columns: columns,
+		record:  make([]string, len(columns)),
+	}
+	for idx, column := range columns {
+		writer.record[idx] = column.Name
+	}
+	if err := writer.writer.Write(writer.record); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write writes the rows of batch, then flushes them into underlying writer.
+func (w *csvWriter) Write(batch *Table) error {
+	if err := checkColumns(w.columns, batch.Columns); err != nil {
+		return err
+	}
+	record := w.record
+	for row := 0; row < batch.NumRows; row++ {
+		for idx, column := range batch.Columns {
+			switch {
+			case column.isNull(row):
+				record[idx] = ""
+			case column.Type == StringColumn:
+				record[idx] = column.Strings[row]
+			case column.Type == TimestampColumn:
+				record[idx] = strconv.FormatInt(column.Timestamps[row], 10)
+			default:
+				record[idx] = strconv.FormatFloat(column.Floats[row], 'g', -1, 64)
+			}
+		}
+		if err := w.writer.Write(record); err != nil {
+			return err
+		}
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// Close flushes the buffered rows.
+func (w *csvWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// ReadCSV reads the table from csv with header(the layout written by csv writer),
+// the column named timestamp(case-insensitive) is timestamp column, which value is milliseconds since epoch or RFC3339,
+// the columns before timestamp column are string columns, the columns after timestamp column are float columns,
+// empty value of float column is read as null value.
+func ReadCSV(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("csv header not found")
+		}
+		return nil, err
+	}
+	timestampIdx := -1
+	table := &Table{}
+	for idx, name := range header {
+		columnType := StringColumn
+		switch {
+		case strings.EqualFold(name, "timestamp") && timestampIdx < 0:
+			timestampIdx = idx
+			columnType = TimestampColumn
+		case timestampIdx >= 0:
+			columnType = FloatColumn
+		}
+		table.Columns = append(table.Columns, NewColumn(name, columnType))
+	}
+	if timestampIdx < 0 {
+		return nil, fmt.Errorf("timestamp column not found in csv header")
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return table, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for idx, column := range table.Columns {
+			value := record[idx]
+			switch column.Type {
+			case StringColumn:
+				column.Strings = append(column.Strings, value)
+			case TimestampColumn:
+				timestamp, err := parseTimestamp(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", table.NumRows+2, err)
+				}
+				column.Timestamps = append(column.Timestamps, timestamp)
+			default:
+				if value == "" {
+					column.Floats = append(column.Floats, 0)
+					column.Valid = append(column.Valid, false)
+					continue
+				}
+				f, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid value of column '%s': %s", table.NumRows+2, column.Name, value)
+				}
+				column.Floats = append(column.Floats, f)
+				column.Valid = append(column.Valid, true)
+			}
+		}
+		table.NumRows++
+	}
+}
+
+// parseTimestamp parses timestamp as milliseconds since epoch or RFC3339.
+func parseTimestamp(value string) (int64, error) {
+	if timestamp, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return timestamp, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp: %s", value)
+	}
+	return t.UnixMilli(), nil
+}
@@ -0,0 +1,168 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format represents the export format of tabular data.
+type Format string
+
+const (
+	// CSV represents comma separated values format(RFC 4180).
+	CSV Format = "csv"
+	// Arrow represents Apache Arrow IPC streaming format.
+	Arrow Format = "arrow"
+	// Parquet represents Apache Parquet file format.
+	Parquet Format = "parquet"
+)
+
+// ParseFormat returns the export format by name(case-insensitive).
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(name))); f {
+	case CSV, Arrow, Parquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown export format '%s', supported formats: csv/arrow/parquet", name)
+	}
+}
+
+// ContentType returns the http content type of export format.
+func (f Format) ContentType() string {
+	switch f {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case Arrow:
+		return "application/vnd.apache.arrow.stream"
+	default:
+		return "application/vnd.apache.parquet"
+	}
+}
+
+// Writer writes tabular data in batches, so that large result can be exported without building whole table.
+type Writer interface {
+	// Write writes a batch of rows, the columns of batch must match the columns of writer.
+	Write(batch *Table) error
+	// Close flushes buffered data and writes the end of format(footer/end-of-stream marker),
+	// it doesn't close the underlying writer.
+	Close() error
+}
+
+// NewWriter creates a batch writer with given format and columns(only name/type used).
+func NewWriter(w io.Writer, format Format, columns []*Column) (Writer, error) {
+	switch format {
+	case CSV:
+		return newCSVWriter(w, columns)
+	case Arrow:
+		return newArrowWriter(w, columns), nil
+	case Parquet:
+		return newParquetWriter(w, columns)
+	default:
+		return nil, fmt.Errorf("unknown export format '%s'", format)
+	}
+}
+
+// Write writes the table into writer with given format.
+func Write(w io.Writer, format Format, table *Table) error {
+	writer, err := NewWriter(w, format, table.Columns)
+	if err != nil {
+		return err
+	}
+	if err := writer.Write(table); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// ColumnType represents the data type of column.
+type ColumnType int
+
+const (
+	// StringColumn represents utf8 string column.
+	StringColumn ColumnType = iota + 1
+	// TimestampColumn represents timestamp(milliseconds since epoch, UTC) column.
+	TimestampColumn
+	// FloatColumn represents float64 column, which values can be null.
+	FloatColumn
+)
+
+// Column represents a column of table, only the values of column's type are used.
+type Column struct {
+	Name string
+	Type ColumnType
+
+	Strings    []string
+	Timestamps []int64
+	Floats     []float64
+	Valid      []bool // for float column, false means null value
+}
+
+// NewColumn creates a column with name and type.
+func NewColumn(name string, columnType ColumnType) *Column {
+	return &Column{
+		Name: name,
+		Type: columnType,
+	}
+}
+
+// isNull checks if the value of row is null.
+func (c *Column) isNull(row int) bool {
+	return c.Type == FloatColumn && !c.Valid[row]
+}
+
+// Table represents the tabular data for exporting, stored in columnar layout.
+type Table struct {
+	Columns []*Column
+	NumRows int
+}
+
+// NewTable creates an empty table with the name/type of columns.
+func NewTable(columns []*Column) *Table {
+	table := &Table{}
+	for _, column := range columns {
+		table.Columns = append(table.Columns, NewColumn(column.Name, column.Type))
+	}
+	return table
+}
+
+// Reset clears the values of columns for reusing table as batch.
+func (t *Table) Reset() {
+	for _, column := range t.Columns {
+		column.Strings = column.Strings[:0]
+		column.Timestamps = column.Timestamps[:0]
+		column.Floats = column.Floats[:0]
+		column.Valid = column.Valid[:0]
+	}
+	t.NumRows = 0
+}
+
+// checkColumns checks if the name/type of columns match the columns of writer.
+func checkColumns(expect, columns []*Column) error {
+	if len(expect) != len(columns) {
+		return fmt.Errorf("num. of columns mismatch, expect: %d, actual: %d", len(expect), len(columns))
+	}
+	for idx, column := range columns {
+		if column.Name != expect[idx].Name || column.Type != expect[idx].Type {
+			return fmt.Errorf("column '%s' mismatch with writer's column '%s'", column.Name, expect[idx].Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestTable creates a table: host(string), timestamp, usage(float with null).
+func newTestTable() *Table {
+	host := NewColumn("host", StringColumn)
+	host.Strings = []string{"host-1", "host,2", ""}
+	timestamp := NewColumn("timestamp", TimestampColumn)
+	timestamp.Timestamps = []int64{1659407840000, 1659407850000, 1659407860000}
+	usage := NewColumn("usage", FloatColumn)
+	usage.Floats = []float64{1.5, 0, -2.25}
+	usage.Valid = []bool{true, false, true}
+	return &Table{Columns: []*Column{host, timestamp, usage}, NumRows: 3}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, expect := range map[string]Format{"csv": CSV, "CSV": CSV, " arrow ": Arrow, "Parquet": Parquet} {
+		f, err := ParseFormat(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expect, f)
+	}
+	f, err := ParseFormat("xml")
+	assert.Error(t, err)
+	assert.Empty(t, f)
+}
+
+func TestFormat_ContentType(t *testing.T) {
+	assert.Equal(t, "text/csv; charset=utf-8", CSV.ContentType())
+	assert.Equal(t, "application/vnd.apache.arrow.stream", Arrow.ContentType())
+	assert.Equal(t, "application/vnd.apache.parquet", Parquet.ContentType())
+}
+
+func TestWrite(t *testing.T) {
+	for _, format := range []Format{CSV, Arrow, Parquet} {
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, format, newTestTable()))
+		assert.NotEmpty(t, buf.Bytes())
+	}
+	assert.Error(t, Write(&bytes.Buffer{}, "xml", newTestTable()))
+}
+
+func TestNewWriter_ColumnsMismatch(t *testing.T) {
+	columns := newTestTable().Columns
+	for _, format := range []Format{CSV, Arrow, Parquet} {
+		writer, err := NewWriter(&bytes.Buffer{}, format, columns)
+		assert.NoError(t, err)
+		assert.Error(t, writer.Write(&Table{Columns: columns[:1]}))
+		assert.Error(t, writer.Write(&Table{Columns: []*Column{columns[1], columns[0], columns[2]}}))
+	}
+}
+
+func TestTable_Reset(t *testing.T) {
+	table := newTestTable()
+	table.Reset()
+	assert.Equal(t, 0, table.NumRows)
+	for _, column := range table.Columns {
+		assert.Empty(t, column.Strings)
+		assert.Empty(t, column.Timestamps)
+		assert.Empty(t, column.Floats)
+		assert.Empty(t, column.Valid)
+	}
+	assert.Equal(t, NewTable(newTestTable().Columns).Columns[0].Name, table.Columns[0].Name)
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Write(&buf, CSV, newTestTable()))
+	assert.Equal(t, "host,timestamp,usage\n"+
+		"host-1,1659407840000,1.5\n"+
+		"\"host,2\",1659407850000,\n"+
+		",1659407860000,-2.25\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, Write(&buf, CSV, &Table{Columns: []*Column{NewColumn("timestamp", TimestampColumn)}}))
+	assert.Equal(t, "timestamp\n", buf.String())
+}
+
+func TestReadCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Write(&buf, CSV, newTestTable()))
+	table, err := ReadCSV(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestTable(), table)
+
+	table, err = ReadCSV(bytes.NewBufferString("region,Timestamp,a,b\nsh,2022-08-02T02:37:40Z,1,\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, table.NumRows)
+	assert.Equal(t, []ColumnType{StringColumn, TimestampColumn, FloatColumn, FloatColumn},
+		[]ColumnType{table.Columns[0].Type, table.Columns[1].Type, table.Columns[2].Type, table.Columns[3].Type})
+	assert.Equal(t, []int64{1659407860000}, table.Columns[1].Timestamps)
+	assert.Equal(t, []bool{false}, table.Columns[3].Valid)
+
+	for _, data := range []string{
+		"",
+		"host,usage\n",
+		"timestamp,usage\nabc,1\n",
+		"timestamp,usage\n1,abc\n",
+		"timestamp,usage\n1,2,3\n",
+	} {
+		_, err = ReadCSV(bytes.NewBufferString(data))
+		assert.Error(t, err, data)
+	}
+}
@@ -0,0 +1,142 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/apache/arrow/go/v10/parquet"
+	"github.com/apache/arrow/go/v10/parquet/compress"
+	"github.com/apache/arrow/go/v10/parquet/pqarrow"
+)
+
+// parquetWriter writes the table as parquet file with one row group per batch(snappy compressed),
+// string column is written as required utf8, timestamp column is written as required timestamp(millis, utc),
+// float column is written as optional double.
+type parquetWriter struct {
+	columns []*Column
+	schema  *arrow.Schema
+	writer  *pqarrow.FileWriter
+}
+
+// newParquetWriter creates parquet file writer.
+func newParquetWriter(w io.Writer, columns []*Column) (Writer, error) {
+	schema := newArrowSchema(columns)
+	writer, err := pqarrow.NewFileWriter(schema, w,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return &parquetWriter{
+		columns: columns,
+		schema:  schema,
+		writer:  writer,
+	}, nil
+}
+
+// Write writes the batch as row group, empty batch is ignored.
+func (w *parquetWriter) Write(batch *Table) error {
+	if err := checkColumns(w.columns, batch.Columns); err != nil {
+		return err
+	}
+	if batch.NumRows == 0 {
+		return nil
+	}
+	record := newArrowRecord(w.schema, batch)
+	defer record.Release()
+	return w.writer.Write(record)
+}
+
+// Close writes the footer(file metadata).
+func (w *parquetWriter) Close() error {
+	return w.writer.Close()
+}
+
+// ReadParquet reads the table from parquet file with flat schema,
+// string column is read as string column(null value is read as empty string),
+// timestamp column is read as timestamp column(converted to milliseconds),
+// float/int column is read as float column.
+func ReadParquet(data []byte) (*Table, error) {
+	tbl, err := pqarrow.ReadTable(context.TODO(), bytes.NewReader(data),
+		parquet.NewReaderProperties(memory.DefaultAllocator), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Release()
+
+	table := &Table{NumRows: int(tbl.NumRows())}
+	for idx := 0; idx < int(tbl.NumCols()); idx++ {
+		column, err := newColumnFromArrow(tbl.Column(idx))
+		if err != nil {
+			return nil, err
+		}
+		table.Columns = append(table.Columns, column)
+	}
+	return table, nil
+}
+
+// newColumnFromArrow converts the values of arrow column into column.
+func newColumnFromArrow(arrowColumn *arrow.Column) (*Column, error) {
+	var column *Column
+	switch dataType := arrowColumn.DataType().(type) {
+	case *arrow.StringType:
+		column = NewColumn(arrowColumn.Name(), StringColumn)
+	case *arrow.TimestampType:
+		column = NewColumn(arrowColumn.Name(), TimestampColumn)
+	case *arrow.Float64Type, *arrow.Float32Type, *arrow.Int64Type, *arrow.Int32Type:
+		column = NewColumn(arrowColumn.Name(), FloatColumn)
+	default:
+		return nil, fmt.Errorf("unsupported type of parquet column '%s': %s", arrowColumn.Name(), dataType)
+	}
+	for _, chunk := range arrowColumn.Data().Chunks() {
+		for row := 0; row < chunk.Len(); row++ {
+			switch values := chunk.(type) {
+			case *array.String:
+				column.Strings = append(column.Strings, values.Value(row))
+			case *array.Timestamp:
+				if values.IsNull(row) {
+					return nil, fmt.Errorf("null value of timestamp column '%s'", arrowColumn.Name())
+				}
+				unit := values.DataType().(*arrow.TimestampType).Unit
+				column.Timestamps = append(column.Timestamps, values.Value(row).ToTime(unit).UnixMilli())
+			case *array.Float64:
+				column.appendFloat(values.Value(row), values.IsValid(row))
+			case *array.Float32:
+				column.appendFloat(float64(values.Value(row)), values.IsValid(row))
+			case *array.Int64:
+				column.appendFloat(float64(values.Value(row)), values.IsValid(row))
+			case *array.Int32:
+				column.appendFloat(float64(values.Value(row)), values.IsValid(row))
+			}
+		}
+	}
+	return column, nil
+}
+
+// appendFloat appends the value of float column.
+func (c *Column) appendFloat(value float64, valid bool) {
+	c.Floats = append(c.Floats, value)
+	c.Valid = append(c.Valid, valid)
+}
@@ -0,0 +1,148 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/apache/arrow/go/v10/parquet"
+	"github.com/apache/arrow/go/v10/parquet/file"
+	"github.com/apache/arrow/go/v10/parquet/pqarrow"
+	"github.com/apache/arrow/go/v10/parquet/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteParquet(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, Parquet, newTestTable().Columns)
+	assert.NoError(t, err)
+	// write two batches and an empty batch
+	assert.NoError(t, writer.Write(newTestTable()))
+	assert.NoError(t, writer.Write(newTestTable()))
+	assert.NoError(t, writer.Write(NewTable(newTestTable().Columns)))
+	assert.NoError(t, writer.Close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	defer func() {
+		_ = reader.Close()
+	}()
+	assert.Equal(t, int64(6), reader.NumRows())
+	assert.Equal(t, 2, reader.NumRowGroups())
+	fileSchema := reader.MetaData().Schema
+	assert.Equal(t, 3, fileSchema.NumColumns())
+	host := fileSchema.Column(0)
+	assert.Equal(t, "host", host.Name())
+	assert.Equal(t, parquet.Types.ByteArray, host.PhysicalType())
+	assert.Equal(t, schema.StringLogicalType{}, host.LogicalType())
+	assert.Equal(t, int16(0), host.MaxDefinitionLevel())
+	timestamp := fileSchema.Column(1)
+	assert.Equal(t, parquet.Types.Int64, timestamp.PhysicalType())
+	assert.Equal(t, schema.NewTimestampLogicalType(true, schema.TimeUnitMillis), timestamp.LogicalType())
+	usage := fileSchema.Column(2)
+	assert.Equal(t, parquet.Types.Double, usage.PhysicalType())
+	assert.Equal(t, int16(1), usage.MaxDefinitionLevel())
+
+	table, err := ReadParquet(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, 6, table.NumRows)
+	assert.Equal(t, append(newTestTable().Columns[0].Strings, newTestTable().Columns[0].Strings...), table.Columns[0].Strings)
+	assert.Equal(t, append(newTestTable().Columns[2].Valid, newTestTable().Columns[2].Valid...), table.Columns[2].Valid)
+}
+
+func TestReadParquet(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Write(&buf, Parquet, newTestTable()))
+	table, err := ReadParquet(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, newTestTable(), table)
+
+	buf.Reset()
+	assert.NoError(t, Write(&buf, Parquet, &Table{Columns: []*Column{NewColumn("timestamp", TimestampColumn)}}))
+	table, err = ReadParquet(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, table.NumRows)
+	assert.Len(t, table.Columns, 1)
+}
+
+func TestReadParquet_ConvertTypes(t *testing.T) {
+	// parquet file written by other tools, such as pandas(timestamp[us], int64/float32 columns)
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Microsecond}},
+		{Name: "count", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "load", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "cpus", Type: arrow.PrimitiveTypes.Int32},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.StringBuilder).AppendValues([]string{"sh", ""}, []bool{true, false})
+	builder.Field(1).(*array.TimestampBuilder).AppendValues([]arrow.Timestamp{1659407840000123, 1659407850000000}, nil)
+	builder.Field(2).(*array.Int64Builder).AppendValues([]int64{10, 0}, []bool{true, false})
+	builder.Field(3).(*array.Float32Builder).AppendValues([]float32{0.5, 1}, nil)
+	builder.Field(4).(*array.Int32Builder).AppendValues([]int32{4, 8}, nil)
+	record := builder.NewRecord()
+	defer record.Release()
+	tbl := array.NewTableFromRecords(arrowSchema, []arrow.Record{record})
+	defer tbl.Release()
+
+	var buf bytes.Buffer
+	assert.NoError(t, pqarrow.WriteTable(tbl, &buf, 1024, nil, pqarrow.DefaultWriterProps()))
+	table, err := ReadParquet(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, table.NumRows)
+	assert.Equal(t, []string{"sh", ""}, table.Columns[0].Strings)
+	assert.Equal(t, TimestampColumn, table.Columns[1].Type)
+	assert.Equal(t, []int64{1659407840000, 1659407850000}, table.Columns[1].Timestamps)
+	assert.Equal(t, []float64{10, 0}, table.Columns[2].Floats)
+	assert.Equal(t, []bool{true, false}, table.Columns[2].Valid)
+	assert.Equal(t, []float64{0.5, 1}, table.Columns[3].Floats)
+	assert.Equal(t, []float64{4, 8}, table.Columns[4].Floats)
+}
+
+func TestReadParquet_Error(t *testing.T) {
+	// unsupported column type
+	arrowSchema := arrow.NewSchema([]arrow.Field{{Name: "ok", Type: arrow.FixedWidthTypes.Boolean}}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.BooleanBuilder).Append(true)
+	record := builder.NewRecord()
+	defer record.Release()
+	tbl := array.NewTableFromRecords(arrowSchema, []arrow.Record{record})
+	defer tbl.Release()
+	var buf bytes.Buffer
+	assert.NoError(t, pqarrow.WriteTable(tbl, &buf, 1024, nil, pqarrow.DefaultWriterProps()))
+
+	var valid bytes.Buffer
+	assert.NoError(t, Write(&valid, Parquet, newTestTable()))
+	corrupted := valid.Bytes()
+	corrupted = append(append([]byte{}, corrupted[:len(corrupted)-8]...), 0xff, 0xff, 0xff, 0x7f, 'P', 'A', 'R', '1')
+
+	for _, data := range [][]byte{
+		buf.Bytes(),
+		[]byte("PAR1xxxxxxxxPAR2"),
+		corrupted,
+	} {
+		_, err := ReadParquet(data)
+		assert.Error(t, err)
+	}
+}
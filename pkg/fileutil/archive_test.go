@@ -0,0 +1,61 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTarDir_Untar(t *testing.T) {
+	tmpDir := t.TempDir()
+	src := filepath.Join(tmpDir, "src")
+	assert.NoError(t, MkDirIfNotExist(filepath.Join(src, "shard", "1")))
+	assert.NoError(t, os.WriteFile(filepath.Join(src, "OPTIONS"), []byte("options"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(src, "shard", "1", "000001.sst"), []byte("sst"), 0600))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, TarDir(src, buf))
+	dst := filepath.Join(tmpDir, "dst")
+	assert.NoError(t, Untar(buf, dst))
+	data, err := os.ReadFile(filepath.Join(dst, "shard", "1", "000001.sst"))
+	assert.NoError(t, err)
+	assert.Equal(t, "sst", string(data))
+	data, err = os.ReadFile(filepath.Join(dst, "OPTIONS"))
+	assert.NoError(t, err)
+	assert.Equal(t, "options", string(data))
+
+	// dir not exist
+	assert.Error(t, TarDir(filepath.Join(tmpDir, "not_exist"), &bytes.Buffer{}))
+	// archive invalid
+	assert.Error(t, Untar(bytes.NewBufferString("invalid archive"), dst))
+}
+
+func TestUntar_PathTraversal(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "../evil", Size: 1, Mode: 0600, Typeflag: tar.TypeReg}))
+	_, _ = tw.Write([]byte("a"))
+	assert.NoError(t, tw.Close())
+	assert.Error(t, Untar(buf, t.TempDir()))
+}
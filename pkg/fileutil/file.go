@@ -18,6 +18,7 @@
 package fileutil
 
 import (
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -27,6 +28,7 @@ var (
 	mkdirAllFunc  = os.MkdirAll
 	removeAllFunc = os.RemoveAll
 	removeFunc    = os.Remove
+	linkFunc      = os.Link
 )
 
 // MkDirIfNotExist creates given dir if it's not exist.
@@ -115,6 +117,38 @@ func GetExistPath(path string) string {
 	return GetExistPath(dir)
 }
 
+// LinkOrCopy creates dst as a hard link of src, copies the file if hard link not supported(cross device etc.).
+func LinkOrCopy(src, dst string) error {
+	if err := linkFunc(src, dst); err == nil {
+		return nil
+	}
+	return CopyFile(src, dst)
+}
+
+// CopyFile copies the content of src into dst, dst will be truncated if exist.
+func CopyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err0 := out.Close(); err == nil {
+			err = err0
+		}
+	}()
+	if _, err = io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
+
 // readDir lists all files/directories.
 func readDir(path string, fn func(f fs.DirEntry)) error {
 	files, err := os.ReadDir(path)
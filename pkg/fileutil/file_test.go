@@ -136,3 +136,29 @@ func TestRemoveFile(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, files, 1)
 }
+
+func TestLinkOrCopy(t *testing.T) {
+	dir := t.TempDir()
+	defer func() {
+		linkFunc = os.Link
+	}()
+	src := filepath.Join(dir, "src")
+	assert.NoError(t, os.WriteFile(src, []byte("data"), 0600))
+	// case 1: hard link
+	assert.NoError(t, LinkOrCopy(src, filepath.Join(dir, "link")))
+	data, err := os.ReadFile(filepath.Join(dir, "link"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	// case 2: link failure, copy file
+	linkFunc = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.NoError(t, LinkOrCopy(src, filepath.Join(dir, "copy")))
+	data, err = os.ReadFile(filepath.Join(dir, "copy"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	// case 3: src not exist
+	assert.Error(t, LinkOrCopy(filepath.Join(dir, "not_exist"), filepath.Join(dir, "copy2")))
+	// case 4: dst dir not exist
+	assert.Error(t, LinkOrCopy(src, filepath.Join(dir, "not_exist", "copy")))
+}
@@ -19,6 +19,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/fs"
 	"net/http"
@@ -39,6 +40,7 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 //go:generate mockgen -source ./http_server.go -destination=./http_server_mock.go -package=http
@@ -96,6 +98,9 @@ func (s *server) init() {
 	s.gin.Use(middleware.Recovery())
 	s.gin.Use(cors.Default())
 
+	// exposes internal metrics for prometheus scraping
+	s.gin.GET(constants.MetricsPath, s.metrics)
+
 	if config.Profile {
 		s.logger.Info("/debug/pprof is enabled")
 		pprof.Register(s.gin)
@@ -106,8 +111,12 @@ func (s *server) init() {
 	if config.Doc {
 		// swagger-ui: http://localhost:port/swagger/index.html
 		ip, _ := hostutil.GetHostIP()
+		scheme := "http"
+		if s.cfg.TLS.Enable {
+			scheme = "https"
+		}
 		s.gin.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler,
-			ginSwagger.URL(fmt.Sprintf("http://%s:%d/swagger/doc.json", ip, s.cfg.Port)),
+			ginSwagger.URL(fmt.Sprintf("%s://%s:%d/swagger/doc.json", scheme, ip, s.cfg.Port)),
 			ginSwagger.DefaultModelsExpandDepth(-1)))
 	}
 	if s.staticResource {
@@ -127,6 +136,15 @@ func (s *server) init() {
 	}
 }
 
+// metrics writes all internal metrics of current node in OpenMetrics text format.
+func (s *server) metrics(c *gin.Context) {
+	c.Header("Content-Type", constants.ContentTypeOpenMetrics)
+	c.Status(http.StatusOK)
+	if err := s.r.WriteOpenMetrics(c.Writer); err != nil {
+		s.logger.Warn("write internal metrics failure", logger.Error(err))
+	}
+}
+
 // GetAPIRouter returns api router.
 func (s *server) GetAPIRouter() *gin.RouterGroup {
 	return s.gin.Group(constants.APIRoot)
@@ -141,6 +159,16 @@ func (s *server) Run() error {
 	if err != nil {
 		return err
 	}
+	if s.cfg.TLS.Enable {
+		reloader := tlsutil.NewReloader(s.cfg.TLS)
+		// check tls files before serving, certificate is reloaded for each new connection
+		if err := reloader.Load(); err != nil {
+			_ = trackedListener.Close()
+			return err
+		}
+		s.logger.Info("https enabled", logger.String("cert", s.cfg.TLS.CertFile))
+		return s.server.Serve(tls.NewListener(trackedListener, reloader.ServerConfig("http/1.1")))
+	}
 	return s.server.Serve(trackedListener)
 }
 
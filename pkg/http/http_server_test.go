@@ -19,12 +19,15 @@ package http
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/linmetric"
 )
 
@@ -44,3 +47,25 @@ func TestNewHTTPServer(t *testing.T) {
 		_ = s.Close(context.TODO())
 	}()
 }
+
+func TestNewHTTPServer_TLS(t *testing.T) {
+	// tls files not exist
+	s := NewServer(config.HTTP{Port: 9997, TLS: config.TLS{
+		Enable:   true,
+		CertFile: "not-exist-cert.pem",
+		KeyFile:  "not-exist-key.pem",
+	}}, false, linmetric.BrokerRegistry)
+	assert.Error(t, s.Run())
+}
+
+func TestServer_Metrics(t *testing.T) {
+	r := linmetric.BrokerRegistry
+	r.NewScope("lindb.http.test").NewCounter("requests").Incr()
+	s := NewServer(config.HTTP{Port: 9998}, false, r)
+	req := httptest.NewRequest(http.MethodGet, constants.MetricsPath, http.NoBody)
+	resp := httptest.NewRecorder()
+	s.(*server).gin.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, constants.ContentTypeOpenMetrics, resp.Header().Get("Content-Type"))
+	assert.Contains(t, resp.Body.String(), "lindb_http_test_requests_total 1")
+}
@@ -18,39 +18,50 @@
 package middleware
 
 import (
-	"crypto/md5"
+	"errors"
 	"fmt"
+	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
-
-	"github.com/lindb/lindb/config"
 )
 
 // CustomClaims represents jwt custom claims param
-// need username and password and some standard claims
+// need username and some standard claims
 type CustomClaims struct {
 	jwt.StandardClaims
 	UserName string `json:"username"`
-	Password string `json:"password"`
+	// Generation represents the token generation of user when token issued.
+	Generation int64 `json:"generation,omitempty"`
 }
 
-// CreateToken returns token use jwt with custom claims
-func CreateToken(user config.User) (string, error) {
+// CreateToken returns token use jwt with custom claims, token is signed by secret key.
+func CreateToken(userName string, generation int64, secret []byte, ttl time.Duration) (string, error) {
 	claims := CustomClaims{
-		UserName: user.UserName,
-		Password: user.Password,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(ttl).Unix(),
+		},
+		UserName:   userName,
+		Generation: generation,
 	}
-	cid := Md5Encrypt(user)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
-	return token.SignedString([]byte(cid))
+	return token.SignedString(secret)
 }
 
-// Md5Encrypt returns secret key use Mk5 encryption with username and password
-func Md5Encrypt(user config.User) string {
-	/* #nosec */
-	md5Encrypt := md5.New()
-	key := fmt.Sprintf("%s/%s", user.UserName, user.Password)
-	_, _ = md5Encrypt.Write([]byte(key))
-	cipher := md5Encrypt.Sum(nil)
-	return string(cipher)
+// ParseToken returns jwt claims by token,
+// secret key is got by user name of claims, then jwt verifies token by secret key.
+func ParseToken(tokenString string, secretFn func(userName string) ([]byte, error)) (*CustomClaims, error) {
+	claims := &CustomClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return secretFn(claims.UserName)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("token invalid")
+	}
+	return claims, nil
 }
@@ -18,19 +18,52 @@
 package middleware
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
-
-	"github.com/lindb/lindb/config"
 )
 
-var tokenStr = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1c2VybmFtZSI6ImFkbWluIiwicGF" +
-	"zc3dvcmQiOiJhZG1pbjEyMyJ9.YbNGN0V-U5Y3xOIGNXcgbQkK2VV30UDDEZV19FN62hk"
+func Test_Token(t *testing.T) {
+	secret := []byte("secret")
+	token, err := CreateToken("admin", 10, secret, time.Minute)
+	assert.NoError(t, err)
+
+	claims, err := ParseToken(token, func(userName string) ([]byte, error) {
+		assert.Equal(t, "admin", userName)
+		return secret, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", claims.UserName)
+	assert.Equal(t, int64(10), claims.Generation)
 
-func Test_CreateToken(t *testing.T) {
-	user := config.User{UserName: "admin", Password: "admin123"}
-	token, err := CreateToken(user)
-	assert.Equal(t, true, err == nil)
-	assert.Equal(t, tokenStr, token)
+	// wrong secret
+	_, err = ParseToken(token, func(_ string) ([]byte, error) {
+		return []byte("abc"), nil
+	})
+	assert.Error(t, err)
+	// user not found
+	_, err = ParseToken(token, func(_ string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	})
+	assert.Error(t, err)
+	// expired
+	token, err = CreateToken("admin", 0, secret, -time.Minute)
+	assert.NoError(t, err)
+	_, err = ParseToken(token, func(_ string) ([]byte, error) {
+		return secret, nil
+	})
+	assert.Error(t, err)
+	// bad token
+	_, err = ParseToken("abc", func(_ string) ([]byte, error) {
+		return secret, nil
+	})
+	assert.Error(t, err)
+	// none signing method
+	_, err = ParseToken("eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJ1c2VybmFtZSI6ImFkbWluIn0.",
+		func(_ string) ([]byte, error) {
+			return secret, nil
+		})
+	assert.Error(t, err)
 }
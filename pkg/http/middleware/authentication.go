@@ -18,63 +18,122 @@
 package middleware
 
 import (
-	"errors"
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"fmt"
 	"net/http"
+	"strings"
 
-	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
 
 	"github.com/lindb/lindb/config"
-	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/constants"
 )
 
 //go:generate mockgen -source=./authentication.go -destination=./authentication_mock.go -package=middleware
 
-type Authentication interface {
-	// Validate validates the token
-	Validate(next http.Handler) http.Handler
+// Authenticator represents the authenticator which checks the credential of request.
+type Authenticator interface {
+	// Authenticate returns the context with authenticated principal of request, returns err if credential invalid.
+	Authenticate(r *http.Request) (context.Context, error)
+}
+
+// peerAuthenticator authenticates the request from other nodes of cluster, for internal api of storage node.
+type peerAuthenticator struct {
+	admin     config.User
+	peerNames []string
 }
 
-// userAuthentication represents user authentication using jwt
-type userAuthentication struct {
-	user config.User
+// NewPeerAuthenticator creates the authenticator which checks the built-in admin user of broker,
+// peer with verified client certificate(mutual tls) which matches the names of cluster nodes is allowed without credential,
+// all requests are allowed if admin user not set(broker authentication disabled).
+// NOTICE: client certificate is verified by the ca-file of server tls, so ca-file must be the dedicated cluster CA.
+func NewPeerAuthenticator(admin config.User, peerNames []string) Authenticator {
+	return &peerAuthenticator{
+		admin:     admin,
+		peerNames: peerNames,
+	}
 }
 
-// NewAuthentication creates authentication api instance
-func NewAuthentication(user config.User) Authentication {
-	return &userAuthentication{
-		user: user,
+// Authenticate checks the basic auth of request, returns err if not matched with admin user.
+func (a *peerAuthenticator) Authenticate(r *http.Request) (context.Context, error) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 &&
+		a.isPeer(r.TLS.VerifiedChains[0][0]) {
+		return r.Context(), nil
+	}
+	if a.admin.UserName == "" {
+		return r.Context(), nil
+	}
+	userName, password, ok := r.BasicAuth()
+	if !ok ||
+		subtle.ConstantTimeCompare([]byte(userName), []byte(a.admin.UserName)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(password), []byte(a.admin.Password)) != 1 {
+		return nil, fmt.Errorf("%w: credential of peer is invalid", constants.ErrUnauthorized)
 	}
+	return r.Context(), nil
 }
 
-// Validate creates middleware for user permissions validation by request header Authorization
-// if not authorization throw error
-// else perform the next action
-func (u *userAuthentication) Validate(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := r.Header.Get("Authorization")
-		if len(token) > 0 {
-			claims := parseToken(token, u.user)
-			if claims.UserName == u.user.UserName && claims.Password == u.user.Password {
-				next.ServeHTTP(w, r)
-				return
+// isPeer checks if the identity(DNS SAN or CN) of client certificate matches the names of cluster nodes.
+func (a *peerAuthenticator) isPeer(cert *x509.Certificate) bool {
+	if cert == nil {
+		return false
+	}
+	identities := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	for _, name := range a.peerNames {
+		for _, identity := range identities {
+			if matchPeerName(name, identity) {
+				return true
 			}
 		}
-		err := errors.New("authorization token invalid")
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(http.StatusUnauthorized)
-		b := encoding.JSONMarshal(err.Error())
-		_, _ = w.Write(b)
-	})
+	}
+	return false
+}
+
+// matchPeerName checks if identity matches the name, "*." prefix of name matches one label of identity.
+func matchPeerName(name, identity string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	identity = strings.ToLower(identity)
+	if name == "" || identity == "" {
+		return false
+	}
+	if strings.HasPrefix(name, "*.") {
+		idx := strings.Index(identity, ".")
+		return idx > 0 && identity[idx:] == name[1:]
+	}
+	return name == identity
+}
+
+// Authentication represents the authentication middleware.
+type Authentication interface {
+	// Validate validates the credential of request.
+	Validate() gin.HandlerFunc
+}
+
+// authentication implements Authentication interface.
+type authentication struct {
+	authenticator Authenticator
 }
 
-// ParseToken returns jwt claims by token
-// get secret key use Md5Encrypt method with username and password
-// then jwt parse token by secret key
-func parseToken(tokenString string, user config.User) *CustomClaims {
-	claims := CustomClaims{}
-	cid := Md5Encrypt(user)
-	_, _ = jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
-		return cid, nil
-	})
-	return &claims
+// NewAuthentication creates authentication middleware instance.
+func NewAuthentication(authenticator Authenticator) Authentication {
+	return &authentication{
+		authenticator: authenticator,
+	}
+}
+
+// Validate creates middleware for user validation by request header Authorization,
+// if not authorized aborts the request with 401, else performs the next action with authenticated context.
+func (a *authentication) Validate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, err := a.authenticator.Authenticate(c.Request)
+		if err != nil {
+			_ = c.Error(err)
+			c.Header("WWW-Authenticate", `Basic realm="LinDB"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
+			return
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
 }
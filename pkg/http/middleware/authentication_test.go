@@ -18,54 +18,91 @@
 package middleware
 
 import (
-	"bytes"
 	"context"
-	"io"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 )
 
-func Test_ParseToken(t *testing.T) {
-	user := config.User{UserName: "admin", Password: "admin123"}
-	claim := parseToken(tokenStr, user)
-	assert.Equal(t, user.UserName, claim.UserName)
-	assert.Equal(t, user.Password, claim.Password)
-}
-
-func TestUserAuthentication_Validate(t *testing.T) {
-	req, err := http.NewRequestWithContext(context.TODO(), "GET", "/health-check", bytes.NewReader([]byte("test")))
-	if err != nil {
-		t.Fatal(err)
-	}
-	req.Header.Set("Authorization", "Bearer abc123")
+type ctxKey struct{}
 
-	rr := httptest.NewRecorder()
-	user := config.User{UserName: "admin", Password: "admin123"}
-	auth := NewAuthentication(user)
+func TestAuthentication_Validate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Header().Set("Content-Type", "application/json")
-		_, _ = io.WriteString(w, "ok")
+	authenticator := NewMockAuthenticator(ctrl)
+	r := gin.New()
+	r.Use(NewAuthentication(authenticator).Validate())
+	r.GET("/test", func(c *gin.Context) {
+		assert.Equal(t, "admin", c.Request.Context().Value(ctxKey{}))
+		c.String(http.StatusOK, "ok")
 	})
-	authHandler := auth.Validate(handler)
 
-	authHandler.ServeHTTP(rr, req)
+	authenticator.EXPECT().Authenticate(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.NotEmpty(t, resp.Header().Get("WWW-Authenticate"))
 
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	authenticator.EXPECT().Authenticate(gomock.Any()).DoAndReturn(func(req *http.Request) (context.Context, error) {
+		return context.WithValue(req.Context(), ctxKey{}, "admin"), nil
+	})
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "ok", resp.Body.String())
+}
 
-	req, err = http.NewRequestWithContext(context.TODO(), "GET", "/health-check", bytes.NewReader([]byte("test")))
+func TestPeerAuthenticator_Authenticate(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/database/backup", nil)
+	// broker authentication disabled
+	_, err := NewPeerAuthenticator(config.User{}, nil).Authenticate(req)
 	assert.NoError(t, err)
-	req.Header.Set("Authorization", tokenStr)
-	rr = httptest.NewRecorder()
 
-	authHandler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
-	assert.Equal(t, "ok", rr.Body.String())
+	authenticator := NewPeerAuthenticator(config.User{UserName: "admin", Password: "admin123"},
+		[]string{"storage-1.lindb.local", "*.cluster.lindb.local"})
+	_, err = authenticator.Authenticate(req)
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	req.SetBasicAuth("admin", "admin")
+	_, err = authenticator.Authenticate(req)
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	req.SetBasicAuth("root", "admin123")
+	_, err = authenticator.Authenticate(req)
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	req.SetBasicAuth("admin", "admin123")
+	_, err = authenticator.Authenticate(req)
+	assert.NoError(t, err)
+	// mutual tls peer
+	peer := func(cert *x509.Certificate) *http.Request {
+		req := httptest.NewRequest(http.MethodPut, "/database/backup", nil)
+		req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		return req
+	}
+	_, err = authenticator.Authenticate(peer(&x509.Certificate{Subject: pkix.Name{CommonName: "storage-1.lindb.local"}}))
+	assert.NoError(t, err)
+	_, err = authenticator.Authenticate(peer(&x509.Certificate{DNSNames: []string{"other.com", "Node-2.Cluster.lindb.local"}}))
+	assert.NoError(t, err)
+	// certificate doesn't match names of cluster nodes
+	_, err = authenticator.Authenticate(peer(&x509.Certificate{}))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	_, err = authenticator.Authenticate(peer(&x509.Certificate{Subject: pkix.Name{CommonName: "storage-2.lindb.local"}}))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	_, err = authenticator.Authenticate(peer(&x509.Certificate{DNSNames: []string{"a.b.cluster.lindb.local", "cluster.lindb.local"}}))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
+	// no peer names, mutual tls peer isn't trusted
+	_, err = NewPeerAuthenticator(config.User{UserName: "admin", Password: "admin123"}, nil).
+		Authenticate(peer(&x509.Certificate{Subject: pkix.Name{CommonName: "storage-1.lindb.local"}}))
+	assert.ErrorIs(t, err, constants.ErrUnauthorized)
 }
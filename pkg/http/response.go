@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
 )
 
 // OK responses with content and set the http status code 200.
@@ -40,10 +42,20 @@ func NotFound(c *gin.Context) {
 	response(c, http.StatusNotFound, nil)
 }
 
-// Error responses error message and set the http status code 500.
+// Error responses error message and set the http status code 500,
+// 401 for unauthorized error, 403 for permission denied error.
 func Error(c *gin.Context, err error) {
 	_ = c.Error(err)
-	response(c, http.StatusInternalServerError, err.Error())
+	switch {
+	case errors.Is(err, constants.ErrUnauthorized):
+		response(c, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, constants.ErrPermissionDenied):
+		response(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, constants.ErrQuotaExceeded):
+		response(c, http.StatusTooManyRequests, err.Error())
+	default:
+		response(c, http.StatusInternalServerError, err.Error())
+	}
 }
 
 // response responses json body for http restful api
@@ -25,6 +25,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
 )
 
 func TestOK(t *testing.T) {
@@ -57,4 +59,19 @@ func TestError(t *testing.T) {
 	Error(c, fmt.Errorf("err"))
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	assert.Equal(t, `"err"`, resp.Body.String())
+
+	resp = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(resp)
+	Error(c, fmt.Errorf("%w: login", constants.ErrUnauthorized))
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(resp)
+	Error(c, fmt.Errorf("%w: write", constants.ErrPermissionDenied))
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+
+	resp = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(resp)
+	Error(c, fmt.Errorf("%w: ingest rate", constants.ErrQuotaExceeded))
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
 }
@@ -29,9 +29,16 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-const HTTPModule = "http"
+const (
+	HTTPModule      = "http"
+	SlowQueryModule = "slow"
+)
 
-var AccessLog = GetLogger(HTTPModule, "Access")
+var (
+	AccessLog = GetLogger(HTTPModule, "Access")
+	// SlowQueryLog writes the slow query with execution stats into dedicated slow log file.
+	SlowQueryLog = GetLogger(SlowQueryModule, "SlowQuery")
+)
 
 // SimpleTimeEncoder serializes a time.Time to a simplified format without timezone
 func SimpleTimeEncoder(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
@@ -97,6 +104,8 @@ func (l *Logger) getInitializedOrDefaultLogger() *zap.Logger {
 	switch l.module {
 	case HTTPModule:
 		accessLogger.Load()
+	case SlowQueryModule:
+		item = slowQueryLogger.Load()
 	default:
 		item = lindLogger.Load()
 	}
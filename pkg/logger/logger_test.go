@@ -56,6 +56,14 @@ func Test_Access_logger(t *testing.T) {
 	logger1.Info("access log")
 }
 
+func Test_SlowQuery_logger(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, InitLogger(config.Logging{Level: "info", Dir: dir}, "test.log"))
+	SlowQueryLog.Info("slow query", String("sql", "select f from cpu"))
+	assert.NotNil(t, slowQueryLogger.Load())
+	assert.Equal(t, slowQueryLogger.Load(), SlowQueryLog.GetLogger())
+}
+
 func Test_Level_String(t *testing.T) {
 	isTerminal = true
 	defer func() {
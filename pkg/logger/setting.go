@@ -37,6 +37,7 @@ var (
 	maxModuleNameLen uint32
 	lindLogger       atomic.Value
 	accessLogger     atomic.Value
+	slowQueryLogger  atomic.Value
 	// uninitialized logger for default usage
 	defaultLogger = newDefaultLogger()
 	// RunningAtomicLevel supports changing level on the fly
@@ -55,7 +56,8 @@ func init() {
 }
 
 const (
-	accessLogFileName = "access.log"
+	accessLogFileName    = "access.log"
+	slowQueryLogFileName = "slow.log"
 )
 
 func IsDebug() bool {
@@ -100,6 +102,9 @@ func InitLogger(cfg config.Logging, fileName string) error {
 	if err := initLogger(accessLogFileName, cfg); err != nil {
 		return err
 	}
+	if err := initLogger(slowQueryLogFileName, cfg); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -135,6 +140,8 @@ func initLogger(logFilename string, cfg config.Logging) error {
 	switch logFilename {
 	case accessLogFileName:
 		accessLogger.Store(zap.New(core))
+	case slowQueryLogFileName:
+		slowQueryLogger.Store(zap.New(core))
 	default:
 		lindLogger.Store(zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1)))
 	}
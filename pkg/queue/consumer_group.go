@@ -109,6 +109,10 @@ func NewConsumerGroup(parent, fanOutPath string, q FanOutQueue) (ConsumerGroup,
 		if ackSeq < ackOfQueue {
 			ackSeq = ackOfQueue
 		}
+	} else {
+		// new consumer group starts from queue ack, message before it may be already deleted(e.g. restore from snapshot)
+		consumedSeq = q.Queue().AcknowledgedSeq()
+		ackSeq = consumedSeq
 	}
 	// persist metadata
 	metaPage.PutUint64(uint64(consumedSeq), consumerGroupConsumedSeqOffset)
@@ -73,6 +73,7 @@ func TestFanOutQueue_New(t *testing.T) {
 	// case 2: create consumerGroup path err
 	queue := NewMockQueue(ctrl)
 	queue.EXPECT().Close().AnyTimes()
+	queue.EXPECT().AcknowledgedSeq().Return(int64(-1)).AnyTimes()
 
 	newQueueFunc = func(dirPath string, dataSizeLimit int64) (Queue, error) {
 		return queue, nil
@@ -321,6 +322,12 @@ func TestFanOutQueue_SetAppendedSeq(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []byte(fmt.Sprintf("msg-%d", 200)), fmsg)
 
+	// new consumer group starts from queue ack seq
+	f3, _ := fq.GetOrCreateConsumerGroup("f3")
+	assert.Equal(t, int64(200), f3.ConsumedSeq())
+	assert.Equal(t, int64(200), f3.AcknowledgedSeq())
+	assert.Equal(t, int64(201), f3.Consume())
+
 	fq.Close()
 }
 
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"sync/atomic"
+
+	"github.com/lindb/lindb/config"
+)
+
+// defaultClientConfig holds tls config of http client for accessing other nodes.
+var defaultClientConfig atomic.Value
+
+// NewClientConfig returns tls config of client which reloads certificate files when changed,
+// returns nil if tls disabled.
+func NewClientConfig(cfg config.TLS) (*tls.Config, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+	reloader := NewReloader(cfg)
+	if err := reloader.Load(); err != nil {
+		return nil, err
+	}
+	return reloader.ClientConfig(), nil
+}
+
+// SetDefaultClientConfig sets tls config of http client for accessing other nodes' https api.
+func SetDefaultClientConfig(cfg *tls.Config) {
+	defaultClientConfig.Store(cfg)
+}
+
+// DefaultClientConfig returns tls config of http client, returns nil if https not enabled.
+func DefaultClientConfig() *tls.Config {
+	if cfg, ok := defaultClientConfig.Load().(*tls.Config); ok {
+		return cfg
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// for testing
+var (
+	statFn     = os.Stat
+	readFileFn = os.ReadFile
+)
+
+// Reloader loads certificate/key/ca files of tls config,
+// reloads them on handshake when files are modified, so certificates can be rotated without restart.
+type Reloader struct {
+	cfg config.TLS
+
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+	mutex    sync.Mutex
+
+	logger *logger.Logger
+}
+
+// NewReloader creates a tls config reloader, files are loaded lazily, call Load to check them.
+func NewReloader(cfg config.TLS) *Reloader {
+	return &Reloader{
+		cfg:    cfg,
+		logger: logger.GetLogger("TLS", "Reloader"),
+	}
+}
+
+// Load loads certificate/key/ca files if they are modified since last loading.
+func (r *Reloader) Load() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, _, err := r.load()
+	return err
+}
+
+// ServerConfig returns tls config for server, certificate/ca are reloaded for each new connection.
+func (r *Reloader) ServerConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool, err := r.current()
+			if err != nil {
+				return nil, err
+			}
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*cert},
+			}
+			if r.cfg.ClientAuth {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = caPool
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns tls config for client, client certificate/ca are reloaded for each new connection.
+func (r *Reloader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: r.cfg.ServerName,
+		// server certificate is verified by verifyServer with reloaded ca pool.
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection:   r.verifyServer,
+		GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _, err := r.current()
+			return cert, err
+		},
+	}
+}
+
+// verifyServer verifies server certificate chain and host name with current ca pool.
+func (r *Reloader) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server certificate not found")
+	}
+	if cs.ServerName == "" {
+		// host name verification would be skipped if server name is empty
+		return errors.New("tls: server name is required for verifying server certificate")
+	}
+	_, caPool, err := r.current()
+	if err != nil {
+		return err
+	}
+	opts := x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         caPool, // using system root CAs if nil
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err = cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// current returns current certificate and ca pool, reloads them if files modified.
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	cert, caPool, err := r.load()
+	if err != nil {
+		if r.modTimes == nil {
+			return nil, nil, err
+		}
+		// keep using previous certificate if new files are invalid(maybe being written).
+		r.logger.Warn("reload tls files failure, using previous certificate", logger.Error(err))
+		return r.cert, r.caPool, nil
+	}
+	return cert, caPool, nil
+}
+
+// load (re)loads tls files if modified, must be called with lock held.
+func (r *Reloader) load() (*tls.Certificate, *x509.CertPool, error) {
+	modTimes, err := r.modifyTimes()
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.modTimes != nil && !r.modified(modTimes) {
+		return r.cert, r.caPool, nil
+	}
+	// client without certificate if cert/key files not set(only verifies server certificate)
+	cert := &tls.Certificate{}
+	if r.cfg.CertFile != "" || r.cfg.KeyFile != "" {
+		certPEM, err := readFileFn(r.cfg.CertFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		keyPEM, err := readFileFn(r.cfg.KeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, nil, err
+		}
+		cert = &keyPair
+	}
+	var caPool *x509.CertPool
+	if r.cfg.CAFile != "" {
+		caPEM, err := readFileFn(r.cfg.CAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return nil, nil, fmt.Errorf("tls: no valid ca certificate found in %s", r.cfg.CAFile)
+		}
+	}
+	if r.modTimes != nil {
+		r.logger.Info("tls files reloaded", logger.String("cert", r.cfg.CertFile))
+	}
+	r.cert = cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	return r.cert, r.caPool, nil
+}
+
+// modifyTimes returns last modify time of tls files.
+func (r *Reloader) modifyTimes() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, file := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.CAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := statFn(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+// modified checks if any tls file modified since last loading.
+func (r *Reloader) modified(modTimes map[string]time.Time) bool {
+	for file, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "lindb-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (ca *testCA) issue(t *testing.T) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeTLSFiles writes cert/key/ca files, and sets modify time for triggering reload.
+func writeTLSFiles(t *testing.T, dir, prefix string, ca *testCA, modTime time.Time) config.TLS {
+	certPEM, keyPEM := ca.issue(t)
+	cfg := config.TLS{
+		Enable:   true,
+		CertFile: filepath.Join(dir, prefix+"-cert.pem"),
+		KeyFile:  filepath.Join(dir, prefix+"-key.pem"),
+		CAFile:   filepath.Join(dir, prefix+"-ca.pem"),
+	}
+	for file, data := range map[string][]byte{cfg.CertFile: certPEM, cfg.KeyFile: keyPEM, cfg.CAFile: ca.pem} {
+		assert.NoError(t, os.WriteFile(file, data, 0600))
+		assert.NoError(t, os.Chtimes(file, modTime, modTime))
+	}
+	return cfg
+}
+
+func handshake(serverCfg, clientCfg *tls.Config) error {
+	serverConn, clientConn := net.Pipe()
+	defer func() {
+		_ = serverConn.Close()
+		_ = clientConn.Close()
+	}()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- tls.Server(serverConn, serverCfg).Handshake()
+		_ = serverConn.Close()
+	}()
+	clientErr := tls.Client(clientConn, clientCfg).Handshake()
+	_ = clientConn.Close()
+	serverErr := <-errCh
+	if clientErr != nil {
+		return clientErr
+	}
+	return serverErr
+}
+
+func TestReloader_Load(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	cfg := writeTLSFiles(t, dir, "node", ca, time.Now())
+	assert.NoError(t, NewReloader(cfg).Load())
+
+	cases := []struct {
+		name    string
+		prepare func(cfg *config.TLS)
+	}{
+		{
+			name: "cert file not exist",
+			prepare: func(cfg *config.TLS) {
+				cfg.CertFile = filepath.Join(dir, "not-exist.pem")
+			},
+		},
+		{
+			name: "invalid key pair",
+			prepare: func(cfg *config.TLS) {
+				cfg.KeyFile = cfg.CAFile
+			},
+		},
+		{
+			name: "invalid ca file",
+			prepare: func(cfg *config.TLS) {
+				cfg.CAFile = cfg.KeyFile
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg0 := cfg
+			tt.prepare(&cfg0)
+			assert.Error(t, NewReloader(cfg0).Load())
+		})
+	}
+}
+
+func TestReloader_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	serverCfg := writeTLSFiles(t, dir, "server", ca, time.Now())
+	serverCfg.ClientAuth = true
+	clientCfg := writeTLSFiles(t, dir, "client", ca, time.Now())
+	clientCfg.ServerName = "localhost"
+
+	server := NewReloader(serverCfg)
+	client := NewReloader(clientCfg)
+	assert.NoError(t, handshake(server.ServerConfig("h2"), client.ClientConfig()))
+
+	// server name not match
+	clientCfg.ServerName = "lindb.io"
+	assert.Error(t, handshake(server.ServerConfig(), NewReloader(clientCfg).ClientConfig()))
+	// server name is required
+	clientCfg.ServerName = ""
+	assert.Error(t, handshake(server.ServerConfig(), NewReloader(clientCfg).ClientConfig()))
+	// client without certificate
+	clientCfg.ServerName = "localhost"
+	clientCfg.CertFile = ""
+	clientCfg.KeyFile = ""
+	assert.Error(t, handshake(server.ServerConfig(), NewReloader(clientCfg).ClientConfig()))
+	// server without client auth
+	serverCfg.ClientAuth = false
+	assert.NoError(t, handshake(NewReloader(serverCfg).ServerConfig(), NewReloader(clientCfg).ClientConfig()))
+}
+
+func TestReloader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	ca := newTestCA(t)
+	serverCfg := writeTLSFiles(t, dir, "server", ca, now)
+	clientCfg := writeTLSFiles(t, dir, "client", ca, now)
+	clientCfg.ServerName = "localhost"
+	server := NewReloader(serverCfg)
+	client := NewReloader(clientCfg)
+	serverTLS := server.ServerConfig()
+	clientTLS := client.ClientConfig()
+	assert.NoError(t, handshake(serverTLS, clientTLS))
+
+	// rotate server certificate with new ca, client doesn't trust it
+	newCA := newTestCA(t)
+	_ = writeTLSFiles(t, dir, "server", newCA, now.Add(time.Minute))
+	assert.Error(t, handshake(serverTLS, clientTLS))
+	// rotate client ca, handshake ok without restart
+	_ = writeTLSFiles(t, dir, "client", newCA, now.Add(time.Minute))
+	assert.NoError(t, handshake(serverTLS, clientTLS))
+
+	// invalid files, keep using previous certificate
+	assert.NoError(t, os.WriteFile(serverCfg.KeyFile, []byte("invalid"), 0600))
+	assert.NoError(t, os.Chtimes(serverCfg.KeyFile, now.Add(time.Hour), now.Add(time.Hour)))
+	assert.NoError(t, handshake(serverTLS, clientTLS))
+	assert.Error(t, server.Load())
+}
+
+func TestReloader_current_failure(t *testing.T) {
+	defer func() {
+		statFn = os.Stat
+	}()
+	statFn = func(name string) (os.FileInfo, error) {
+		return nil, fmt.Errorf("err")
+	}
+	r := NewReloader(config.TLS{Enable: true, CertFile: "cert.pem", KeyFile: "key.pem"})
+	assert.Error(t, handshake(r.ServerConfig(), &tls.Config{InsecureSkipVerify: true})) //nolint:gosec
+	_, _, err := r.current()
+	assert.Error(t, err)
+}
+
+func TestNewClientConfig(t *testing.T) {
+	cfg, err := NewClientConfig(config.TLS{})
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+	cfg, err = NewClientConfig(config.TLS{Enable: true, CertFile: "cert.pem", KeyFile: "key.pem"})
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+	tlsCfg := writeTLSFiles(t, t.TempDir(), "client", newTestCA(t), time.Now())
+	cfg, err = NewClientConfig(tlsCfg)
+	assert.NoError(t, err)
+	assert.NotNil(t, cfg)
+}
+
+func TestDefaultClientConfig(t *testing.T) {
+	assert.Nil(t, DefaultClientConfig())
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	SetDefaultClientConfig(cfg)
+	assert.Equal(t, cfg, DefaultClientConfig())
+	SetDefaultClientConfig(nil)
+	assert.Nil(t, DefaultClientConfig())
+}
@@ -19,7 +19,9 @@ package unique
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"os"
 
 	"github.com/cockroachdb/pebble"
 
@@ -47,8 +49,22 @@ type IDStore interface {
 	Delete(key []byte) error
 	// IterKeys iterates the key list by given prefix, returns the key list.
 	IterKeys(prefix []byte, limit int) (rs [][]byte, err error)
+	// CountKeys counts the keys with given length.
+	CountKeys(keyLen int) (int64, error)
 	// Flush flushes the memory table data under pebble db.
 	Flush() error
+	// Checkpoint creates a consistent snapshot of store into given dir(must not exist),
+	// files are hard-linked when possible.
+	Checkpoint(dir string) error
+	// NewSnapshot returns a point-in-time view of store, it must be closed after used.
+	NewSnapshot() Snapshot
+}
+
+// Snapshot represents a point-in-time view of IDStore, writing store doesn't change it.
+type Snapshot interface {
+	io.Closer
+	// Checkpoint writes the data of snapshot into a new store under given dir(must not exist).
+	Checkpoint(dir string) error
 }
 
 // idStore implements IDStore interface.
@@ -146,13 +162,96 @@ func (s *idStore) IterKeys(prefix []byte, limit int) (rs [][]byte, err error) {
 	return rs, nil
 }
 
+// CountKeys counts the keys with given length.
+func (s *idStore) CountKeys(keyLen int) (int64, error) {
+	it := s.db.NewIter(nil)
+	defer func() {
+		if err0 := it.Close(); err0 != nil {
+			s.logger.Warn("close kv iterator resource err",
+				logger.String("path", s.path),
+				logger.Error(err0))
+		}
+	}()
+
+	var count int64
+	for it.First(); it.Valid(); it.Next() {
+		if len(it.Key()) == keyLen {
+			count++
+		}
+	}
+	if err := it.Error(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // Flush flushes the memory table data under pebble db.
 func (s *idStore) Flush() error {
 	return s.db.Flush()
 }
 
+// Checkpoint creates a consistent snapshot of store into given dir(must not exist),
+// files are hard-linked when possible.
+// NOTICE: wal is disabled, so need flush memory table first.
+func (s *idStore) Checkpoint(dir string) error {
+	if err := s.db.Flush(); err != nil {
+		return err
+	}
+	return s.db.Checkpoint(dir)
+}
+
+// NewSnapshot returns a point-in-time view of store, it must be closed after used.
+func (s *idStore) NewSnapshot() Snapshot {
+	return &idSnapshot{
+		snapshot: s.db.NewSnapshot(),
+	}
+}
+
 // Close closes backend pebble db.
 // NOTICE: need flush first
 func (s *idStore) Close() error {
 	return s.db.Close()
 }
+
+// idSnapshot implements Snapshot interface.
+type idSnapshot struct {
+	snapshot *pebble.Snapshot
+}
+
+// Checkpoint writes the data of snapshot into a new store under given dir(must not exist),
+// copies key/value pairs instead of hard-linking files, because files may contain data after snapshot.
+func (s *idSnapshot) Checkpoint(dir string) (err error) {
+	if _, err = os.Stat(dir); err == nil {
+		return fmt.Errorf("checkpoint dir: %s already exists", dir)
+	}
+	db, err := pebbleOpenFn(dir, DefaultOptions())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err0 := db.Close(); err == nil {
+			err = err0
+		}
+	}()
+	it := s.snapshot.NewIter(nil)
+	batch := db.NewBatch()
+	for it.First(); it.Valid(); it.Next() {
+		if err = batch.Set(it.Key(), it.Value(), nil); err != nil {
+			_ = it.Close()
+			return err
+		}
+	}
+	if err = it.Close(); err != nil {
+		return err
+	}
+	if err = batch.Commit(pebble.NoSync); err != nil {
+		return err
+	}
+	// NOTICE: wal is disabled, so need flush memory table.
+	return db.Flush()
+}
+
+// Close releases the snapshot.
+func (s *idSnapshot) Close() error {
+	return s.snapshot.Close()
+}
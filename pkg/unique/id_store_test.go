@@ -19,6 +19,7 @@ package unique
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
@@ -215,6 +216,22 @@ func TestIdStore_IterIDKeys(t *testing.T) {
 	}
 }
 
+func TestIdStore_CountKeys(t *testing.T) {
+	store, err := NewIDStore(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = store.Close()
+	}()
+	mock(t, store)
+
+	count, err := store.CountKeys(len("ns-0"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), count)
+	count, err = store.CountKeys(len("ns-10"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
 func mock(t *testing.T, store IDStore) {
 	for i := 0; i < 10; i++ {
 		key := fmt.Sprintf("ns-%d", i)
@@ -241,3 +258,56 @@ func TestPebble_Reopen(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, db2)
 }
+
+func TestIDStore_Checkpoint(t *testing.T) {
+	p := t.TempDir()
+	store, err := NewIDStore(filepath.Join(p, "src"))
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put([]byte("k"), []byte("v")))
+	target := filepath.Join(p, "backup")
+	assert.NoError(t, store.Checkpoint(target))
+	// target exist
+	assert.Error(t, store.Checkpoint(target))
+	assert.NoError(t, store.Close())
+
+	backup, err := NewIDStore(target)
+	assert.NoError(t, err)
+	defer func() {
+		_ = backup.Close()
+	}()
+	val, ok, err := backup.Get([]byte("k"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("v"), val)
+}
+
+func TestIDStore_Snapshot(t *testing.T) {
+	p := t.TempDir()
+	store, err := NewIDStore(filepath.Join(p, "src"))
+	assert.NoError(t, err)
+	defer func() {
+		_ = store.Close()
+	}()
+	assert.NoError(t, store.Put([]byte("k"), []byte("v")))
+	snapshot := store.NewSnapshot()
+	// write after snapshot isn't visible
+	assert.NoError(t, store.Put([]byte("k2"), []byte("v2")))
+	target := filepath.Join(p, "backup")
+	assert.NoError(t, snapshot.Checkpoint(target))
+	// target exist
+	assert.Error(t, snapshot.Checkpoint(target))
+	assert.NoError(t, snapshot.Close())
+
+	backup, err := NewIDStore(target)
+	assert.NoError(t, err)
+	defer func() {
+		_ = backup.Close()
+	}()
+	val, ok, err := backup.Get([]byte("k"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("v"), val)
+	_, ok, err = backup.Get([]byte("k2"))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
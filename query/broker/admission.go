@@ -0,0 +1,147 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./admission.go -destination=./admission_mock.go -package=brokerquery
+
+// ErrQueryQueueFull represents the query is rejected because too many queries waiting in queue.
+var ErrQueryQueueFull = errors.New("too many queries waiting in queue")
+
+// AdmissionController represents the admission controller of metric query,
+// which limits concurrency and queue depth for each workload class.
+// NOTICE: class of query is also passed to storage nodes by physical plan, which executes it by executor pool of its class.
+type AdmissionController interface {
+	// Timeout returns the timeout(include queue wait) of query class.
+	Timeout(class models.QueryClass) time.Duration
+	// Admit waits until the query of class can be executed, returns the release func which must be invoked
+	// after query completed, returns ErrQueryQueueFull if queue is full, ErrTimeout if ctx done when waiting.
+	Admit(ctx context.Context, class models.QueryClass) (release func(), err error)
+}
+
+// admissionController implements AdmissionController interface.
+type admissionController struct {
+	queues map[models.QueryClass]*admissionQueue
+}
+
+// NewAdmissionController creates an admission controller based on query classes config.
+func NewAdmissionController(cfg *config.Query) AdmissionController {
+	return &admissionController{
+		queues: map[models.QueryClass]*admissionQueue{
+			models.DashboardQuery: newAdmissionQueue(models.DashboardQuery, &cfg.Dashboard),
+			models.AlertingQuery:  newAdmissionQueue(models.AlertingQuery, &cfg.Alerting),
+			models.AdHocQuery:     newAdmissionQueue(models.AdHocQuery, &cfg.AdHoc),
+			models.InternalQuery:  newAdmissionQueue(models.InternalQuery, &cfg.Internal),
+		},
+	}
+}
+
+// Timeout returns the timeout(include queue wait) of query class.
+func (ac *admissionController) Timeout(class models.QueryClass) time.Duration {
+	return ac.getQueue(class).timeout
+}
+
+// Admit waits until the query of class can be executed.
+func (ac *admissionController) Admit(ctx context.Context, class models.QueryClass) (release func(), err error) {
+	return ac.getQueue(class).admit(ctx)
+}
+
+// getQueue returns the queue of query class, returns ad-hoc queue if class not exist.
+func (ac *admissionController) getQueue(class models.QueryClass) *admissionQueue {
+	if q, ok := ac.queues[class]; ok {
+		return q
+	}
+	return ac.queues[models.AdHocQuery]
+}
+
+// admissionQueue represents the concurrency tokens and waiting queue of a query class.
+type admissionQueue struct {
+	class     models.QueryClass
+	tokens    chan struct{}
+	queueSize int32
+	queued    atomic.Int32
+	timeout   time.Duration
+
+	statistics *metrics.QueryAdmissionStatistics
+}
+
+// newAdmissionQueue creates an admission queue of query class.
+func newAdmissionQueue(class models.QueryClass, cfg *config.QueryClass) *admissionQueue {
+	return &admissionQueue{
+		class:      class,
+		tokens:     make(chan struct{}, cfg.Concurrency),
+		queueSize:  int32(cfg.QueueSize),
+		timeout:    cfg.Timeout.Duration(),
+		statistics: metrics.NewQueryAdmissionStatistics(string(class)),
+	}
+}
+
+// admit acquires a concurrency token, waits in queue if all tokens are taken.
+func (q *admissionQueue) admit(ctx context.Context) (release func(), err error) {
+	select {
+	case q.tokens <- struct{}{}:
+		return q.admitted(0), nil
+	default:
+		// tokens are taken, so waits one to be free
+	}
+	if q.queued.Inc() > q.queueSize {
+		q.queued.Dec()
+		q.statistics.Rejected.Incr()
+		return nil, fmt.Errorf("%w, class: %s", ErrQueryQueueFull, q.class)
+	}
+	q.statistics.Queued.Incr()
+	defer func() {
+		q.queued.Dec()
+		q.statistics.Queued.Decr()
+	}()
+
+	start := time.Now()
+	select {
+	case q.tokens <- struct{}{}:
+		return q.admitted(time.Since(start)), nil
+	case <-ctx.Done():
+		q.statistics.Timeouts.Incr()
+		return nil, fmt.Errorf("%w when waiting in queue, class: %s", ErrTimeout, q.class)
+	}
+}
+
+// admitted records the admission, returns the release func of token.
+func (q *admissionQueue) admitted(wait time.Duration) func() {
+	q.statistics.Admitted.Incr()
+	q.statistics.QueueWait.UpdateDuration(wait)
+	q.statistics.Running.Incr()
+	released := atomic.NewBool(false)
+	return func() {
+		if released.CAS(false, true) {
+			q.statistics.Running.Decr()
+			<-q.tokens
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+func TestAdmissionController_Timeout(t *testing.T) {
+	cfg := config.NewDefaultQuery()
+	cfg.Alerting.Timeout = ltoml.Duration(time.Minute)
+	ac := NewAdmissionController(cfg)
+	assert.Equal(t, time.Minute, ac.Timeout(models.AlertingQuery))
+	assert.Equal(t, cfg.AdHoc.Timeout.Duration(), ac.Timeout(models.AdHocQuery))
+	// unknown class uses ad-hoc queue
+	assert.Equal(t, cfg.AdHoc.Timeout.Duration(), ac.Timeout("unknown"))
+}
+
+func TestAdmissionController_Admit(t *testing.T) {
+	cfg := config.NewDefaultQuery()
+	cfg.Dashboard = config.QueryClass{Concurrency: 1, QueueSize: 1, Timeout: ltoml.Duration(time.Second)}
+	ac := NewAdmissionController(cfg)
+
+	release, err := ac.Admit(context.TODO(), models.DashboardQuery)
+	assert.NoError(t, err)
+	// other class not affected
+	release2, err := ac.Admit(context.TODO(), models.AlertingQuery)
+	assert.NoError(t, err)
+	release2()
+
+	// wait in queue, then timeout
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	_, err = ac.Admit(ctx, models.DashboardQuery)
+	cancel()
+	assert.True(t, errors.Is(err, ErrTimeout))
+
+	// wait in queue, then admitted after release
+	admitted := make(chan func())
+	go func() {
+		r, err0 := ac.Admit(context.TODO(), models.DashboardQuery)
+		assert.NoError(t, err0)
+		admitted <- r
+	}()
+	assert.Eventually(t, func() bool {
+		return ac.(*admissionController).queues[models.DashboardQuery].queued.Load() == 1
+	}, time.Second, time.Millisecond)
+	// queue full
+	_, err = ac.Admit(context.TODO(), models.DashboardQuery)
+	assert.True(t, errors.Is(err, ErrQueryQueueFull))
+
+	release()
+	// release twice is no-op
+	release()
+	release = <-admitted
+	release()
+
+	release, err = ac.Admit(context.TODO(), models.DashboardQuery)
+	assert.NoError(t, err)
+	release()
+}
@@ -26,17 +26,23 @@ import (
 )
 
 type queryFactory struct {
-	stateMgr    broker.StateManager
-	taskManager TaskManager
+	stateMgr        broker.StateManager
+	taskManager     TaskManager
+	resultCache     ResultCache // nil if result cache disabled
+	replicaSelector ReplicaSelector
 }
 
+// NewQueryFactory creates the query factory, metric query result isn't cached if result cache is nil.
 func NewQueryFactory(
 	stateMgr broker.StateManager,
 	taskManager TaskManager,
+	resultCache ResultCache,
 ) Factory {
 	return &queryFactory{
-		stateMgr:    stateMgr,
-		taskManager: taskManager,
+		stateMgr:        stateMgr,
+		taskManager:     taskManager,
+		resultCache:     resultCache,
+		replicaSelector: newReplicaSelector(stateMgr),
 	}
 }
 
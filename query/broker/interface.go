@@ -66,7 +66,11 @@ var (
 //    because of for the system availability.
 
 type MetricQuery interface {
+	// WaitResponse waits the query completed, then returns the whole result set.
 	WaitResponse() (*models.ResultSet, error)
+	// WaitChunked emits the series groups by fn as soon as each time window of query completed,
+	// then returns the result set without series.
+	WaitChunked(fn func(series *models.Series) error) (*models.ResultSet, error)
 }
 
 // MetadataExecutor represents the metadata query executor, includes:
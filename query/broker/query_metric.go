@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/series"
@@ -37,6 +38,13 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 )
 
+const (
+	// maxChunkedWindows represents the max number of time windows which chunked query is split into.
+	maxChunkedWindows = 8
+	// minChunkedWindowPoints represents the min number of points in each time window of chunked query.
+	minChunkedWindowPoints = 60
+)
+
 // for testing
 var (
 	newExpressionFn = aggregation.NewExpression
@@ -85,8 +93,19 @@ func (mq *metricQuery) makePlan() error {
 		return query.ErrDatabaseNotExist
 	}
 
-	// FIXME: need using storage's replica state ???
-	storageNodes, err := mq.queryFactory.stateMgr.GetQueryableReplicas(mq.database)
+	// follower whose replica lag within bound can serve the query,
+	// lag bound of query overrides the setting of database.
+	maxReadLag := databaseCfg.MaxReadLag.Duration()
+	if lag, ok := mq.ctx.Value(constants.ContextKeyMaxReadLag).(time.Duration); ok && lag != 0 {
+		maxReadLag = lag
+	}
+	var storageNodes map[string][]models.ShardID
+	var err error
+	if maxReadLag > 0 {
+		storageNodes, err = mq.queryFactory.replicaSelector.SelectReplicas(databaseCfg, maxReadLag)
+	} else {
+		storageNodes, err = mq.queryFactory.stateMgr.GetQueryableReplicas(mq.database)
+	}
 	if err != nil {
 		return err
 	}
@@ -108,15 +127,219 @@ func (mq *metricQuery) makePlan() error {
 
 	mq.startTime = startTime
 	mq.plan.physicalPlan.Database = mq.database
+	if req, ok := mq.ctx.Value(constants.ContextKeySQL).(*models.Request); ok {
+		mq.plan.physicalPlan.SQL = req.SQL
+	}
+	if class, ok := mq.ctx.Value(constants.ContextKeyQueryClass).(models.QueryClass); ok {
+		mq.plan.physicalPlan.Class = class
+	}
 	mq.stmtQuery = mq.plan.query
 	return nil
 }
 
-// WaitResponse builds the plan, the dispatch the task by task-manager
+// WaitResponse builds the plan, the dispatch the task by task-manager,
+// serves the immutable time buckets from result cache if query is cacheable.
 func (mq *metricQuery) WaitResponse() (*models.ResultSet, error) {
 	if err := mq.makePlan(); err != nil {
 		return nil, err
 	}
+	if mq.cacheable() {
+		return mq.waitResponseWithCache()
+	}
+	event, err := mq.submit()
+	if err != nil {
+		return nil, err
+	}
+	return mq.makeResultSet(event)
+}
+
+// cacheable checks if the result of query can be cached, the result of order by query cannot be spliced.
+func (mq *metricQuery) cacheable() bool {
+	return mq.queryFactory.resultCache != nil &&
+		len(mq.stmtQuery.OrderByItems) == 0 &&
+		mq.stmtQuery.Interval > 0
+}
+
+// cacheKey returns the key of result cache based on database and normalized query(without time range).
+func (mq *metricQuery) cacheKey() string {
+	q := *mq.stmtQuery
+	q.Explain = false
+	q.TimeRange = timeutil.TimeRange{}
+	return mq.database + ":" + string(encoding.JSONMarshal(&q))
+}
+
+// waitResponseWithCache serves the time buckets covered by result cache, only queries the uncovered tail
+// from storage, then splices them together and caches the immutable time buckets of result.
+func (mq *metricQuery) waitResponseWithCache() (*models.ResultSet, error) {
+	resultCache := mq.queryFactory.resultCache
+	queryStmt := mq.stmtQuery
+	interval := queryStmt.Interval.Int64()
+	start := queryStmt.TimeRange.Start
+	end := queryStmt.TimeRange.End + interval // exclusive
+	key := mq.cacheKey()
+
+	cacheStats := &models.ResultCacheStats{}
+	tailStart := start
+	cached, hit := resultCache.Get(key, start)
+	if hit {
+		tailStart = cached.End
+		if tailStart > end {
+			tailStart = end
+		}
+		cacheStats.Hit = true
+		cacheStats.CachedBuckets = int((tailStart - start) / interval)
+	}
+
+	var (
+		event *series.TimeSeriesEvent
+		err   error
+	)
+	if tailStart < end {
+		// only query the uncovered tail from storage
+		queryStmt.TimeRange.Start = tailStart
+		event, err = mq.submit()
+		if err != nil {
+			return nil, err
+		}
+		cacheStats.QueriedBuckets = int((end - tailStart) / interval)
+	} else {
+		// all time buckets covered by result cache
+		mq.endPlanTime = time.Now()
+		event = &series.TimeSeriesEvent{}
+		if queryStmt.Explain {
+			event.Stats = models.NewQueryStats()
+		}
+	}
+	rs, err := mq.makeResultSet(event)
+	queryStmt.TimeRange.Start = start
+	if err != nil {
+		return nil, err
+	}
+	rs.StartTime = start
+	if rs.Stats != nil {
+		rs.Stats.ResultCache = cacheStats
+	}
+	if hit {
+		cached.splice(rs, start, tailStart)
+		if len(rs.Series) > queryStmt.Limit {
+			rs.Series = rs.Series[:queryStmt.Limit]
+		}
+	}
+	if len(rs.Series) >= queryStmt.Limit {
+		// result maybe truncated by limit, cannot be spliced
+		return rs, nil
+	}
+	cacheEnd := resultCache.ImmutableEnd(timeutil.Now(), interval, mq.databaseBehind())
+	if cacheEnd > end {
+		cacheEnd = end
+	}
+	if cacheEnd > start {
+		result := newCachedResult(rs, start, cacheEnd)
+		if hit {
+			// spliced result is as stale as cached result
+			result.expireAt = cached.expireAt
+		}
+		resultCache.Put(key, result)
+	}
+	return rs, nil
+}
+
+// databaseBehind returns the allowed write behind of database.
+func (mq *metricQuery) databaseBehind() int64 {
+	if opt := mq.plan.databaseCfg.Option; opt != nil {
+		_, behind := opt.GetAcceptWritableRange()
+		return behind
+	}
+	return 0
+}
+
+// WaitChunked builds the plan, then dispatches the task by task-manager, emits the series groups by fn
+// as soon as each time window of query completed, returns the result set without series.
+// Query is split into time windows(aligned to interval) if the results of windows can be spliced,
+// series groups of each window are emitted in order by tag values(same order as WaitResponse),
+// so a series group is emitted once per window and only includes the points in the window.
+func (mq *metricQuery) WaitChunked(fn func(s *models.Series) error) (*models.ResultSet, error) {
+	if err := mq.makePlan(); err != nil {
+		return nil, err
+	}
+	queryStmt := mq.stmtQuery
+	timeRange := queryStmt.TimeRange
+	defer func() {
+		queryStmt.TimeRange = timeRange
+	}()
+
+	var (
+		rs      *models.ResultSet
+		scanned models.ScanStats
+	)
+	emitted := make(map[string]struct{})
+	fieldsMap := make(map[string]struct{})
+	for _, window := range mq.chunkedWindows() {
+		// only query the time window from storage
+		queryStmt.TimeRange = window
+		event, err := mq.submit()
+		if err != nil {
+			return nil, err
+		}
+		rs, err = mq.makeResultSet(event)
+		if err != nil {
+			return nil, err
+		}
+		scanned.Add(rs.Scanned)
+		for _, s := range rs.Series {
+			if _, ok := emitted[s.TagValues]; !ok {
+				if len(emitted) >= queryStmt.Limit {
+					// limit series groups of whole result
+					continue
+				}
+				emitted[s.TagValues] = struct{}{}
+			}
+			if err := fn(s); err != nil {
+				return nil, err
+			}
+		}
+		for _, fName := range rs.Fields {
+			fieldsMap[fName] = struct{}{}
+		}
+	}
+	rs.Series = nil
+	rs.Fields = nil
+	for fName := range fieldsMap {
+		rs.Fields = append(rs.Fields, fName)
+	}
+	rs.StartTime = timeRange.Start
+	rs.EndTime = timeRange.End
+	rs.Scanned = scanned
+	return rs, nil
+}
+
+// chunkedWindows returns the time windows of chunked query, returns the whole time range
+// if the results cannot be spliced(order by query needs whole result, explain query needs one stats tree).
+func (mq *metricQuery) chunkedWindows() []timeutil.TimeRange {
+	queryStmt := mq.stmtQuery
+	timeRange := queryStmt.TimeRange
+	interval := queryStmt.Interval.Int64()
+	if len(queryStmt.OrderByItems) > 0 || queryStmt.Explain || interval <= 0 {
+		return []timeutil.TimeRange{timeRange}
+	}
+	points := (timeRange.End-timeRange.Start)/interval + 1
+	windowPoints := (points + maxChunkedWindows - 1) / maxChunkedWindows
+	if windowPoints < minChunkedWindowPoints {
+		windowPoints = minChunkedWindowPoints
+	}
+	var windows []timeutil.TimeRange
+	for start := timeRange.Start; start <= timeRange.End; start += windowPoints * interval {
+		end := start + (windowPoints-1)*interval
+		if end > timeRange.End {
+			end = timeRange.End
+		}
+		windows = append(windows, timeutil.TimeRange{Start: start, End: end})
+	}
+	return windows
+}
+
+// submit dispatches the tasks of plan, then waits the merged time series event of all tasks.
+func (mq *metricQuery) submit() (*series.TimeSeriesEvent, error) {
 	mq.endPlanTime = time.Now()
 
 	eventCh, err := mq.queryFactory.taskManager.SubmitMetricTask(
@@ -143,7 +366,7 @@ func (mq *metricQuery) WaitResponse() (*models.ResultSet, error) {
 	case <-mq.ctx.Done():
 		return nil, ErrTimeout
 	}
-	return mq.makeResultSet(event)
+	return event, nil
 }
 
 // buildOrderBy builds order by container.
@@ -185,8 +408,28 @@ func (mq *metricQuery) buildOrderBy(event *series.TimeSeriesEvent) (aggregation.
 }
 
 // makeResultSet makes final result set from time series event(GroupedIterators).
-// TODO: can opt use stream, leaf node need return grouping if completed.
 func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *models.ResultSet, err error) {
+	var seriesList []*models.Series
+	resultSet, err = mq.makeResult(event, func(s *models.Series) error {
+		seriesList = append(seriesList, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(seriesList, func(i, j int) bool {
+		return seriesList[i].TagValues < seriesList[j].TagValues
+	})
+	resultSet.Series = seriesList
+	return resultSet, nil
+}
+
+// makeResult makes the series of final result from time series event(GroupedIterators),
+// emits each series by fn, then returns the result set without series.
+func (mq *metricQuery) makeResult(
+	event *series.TimeSeriesEvent,
+	fn func(s *models.Series) error,
+) (resultSet *models.ResultSet, err error) {
 	makeResultStartTime := time.Now()
 
 	orderBy, err := mq.buildOrderBy(event)
@@ -196,8 +439,6 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 
 	resultSet = new(models.ResultSet)
 	// TODO: merge stats for cross idc query?
-	groupByKeys := mq.stmtQuery.GroupBy
-	groupByKeysLength := len(groupByKeys)
 	fieldsMap := make(map[string]struct{})
 
 	queryStmt := mq.stmtQuery
@@ -217,46 +458,11 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 
 	rows := orderBy.ResultSet()
 	for _, row := range rows {
-		var tags map[string]string
-		tagValues, fields := row.ResultSet()
-		if groupByKeysLength > 0 {
-			tagValues := tag.SplitTagValues(tagValues)
-			if groupByKeysLength != len(tagValues) {
-				// if tag values not match group by tag keys, ignore this time series
-				continue
-			}
-			// build group by tags for final result
-			tags = make(map[string]string)
-			for idx, tagKey := range groupByKeys {
-				tags[tagKey] = tagValues[idx]
-			}
-		}
-		timeSeries := models.NewSeries(tags, tagValues)
-		resultSet.AddSeries(timeSeries)
-		for fieldName, values := range fields {
-			if values == nil {
-				continue
-			}
-
-			points := models.NewPoints()
-			it := values.NewIterator()
-			for it.HasNext() {
-				slot, val := it.Next()
-				if math.IsNaN(val) {
-					// TODO: need check
-					continue
-				}
-				points.AddPoint(timeutil.CalcTimestamp(mq.stmtQuery.TimeRange.Start, slot, mq.stmtQuery.Interval), val)
-			}
-			timeSeries.AddField(fieldName, points)
-			fieldsMap[fieldName] = struct{}{}
+		if err := mq.emitSeries(row, fieldsMap, fn); err != nil {
+			return nil, err
 		}
 	}
 
-	sort.Slice(resultSet.Series, func(i, j int) bool {
-		return resultSet.Series[i].TagValues < resultSet.Series[j].TagValues
-	})
-
 	resultSet.MetricName = mq.stmtQuery.MetricName
 	resultSet.GroupBy = mq.stmtQuery.GroupBy
 	for fName := range fieldsMap {
@@ -266,8 +472,13 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 	resultSet.EndTime = mq.stmtQuery.TimeRange.End
 	resultSet.Interval = mq.stmtQuery.Interval.Int64()
 
-	resultSet.Stats = event.Stats
-	if resultSet.Stats != nil {
+	if event.Stats == nil {
+		return resultSet, nil
+	}
+	// scan stats are collected for each query, full execution stats are only returned for explain query
+	resultSet.Scanned.Series, resultSet.Scanned.Families, resultSet.Scanned.Bytes = event.Stats.ScanStats()
+	if mq.stmtQuery.Explain {
+		resultSet.Stats = event.Stats
 		now := time.Now()
 		resultSet.Stats.Root = mq.root.Indicator()
 		resultSet.Stats.PlanCost = mq.endPlanTime.Sub(mq.startTime).Nanoseconds()
@@ -285,3 +496,48 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 	}
 	return resultSet, nil
 }
+
+// emitSeries builds the series of result from row, then emits it by fn.
+func (mq *metricQuery) emitSeries(
+	row aggregation.Row,
+	fieldsMap map[string]struct{},
+	fn func(s *models.Series) error,
+) error {
+	groupByKeys := mq.stmtQuery.GroupBy
+	groupByKeysLength := len(groupByKeys)
+
+	var tags map[string]string
+	tagValues, fields := row.ResultSet()
+	if groupByKeysLength > 0 {
+		tagValues := tag.SplitTagValues(tagValues)
+		if groupByKeysLength != len(tagValues) {
+			// if tag values not match group by tag keys, ignore this time series
+			return nil
+		}
+		// build group by tags for final result
+		tags = make(map[string]string)
+		for idx, tagKey := range groupByKeys {
+			tags[tagKey] = tagValues[idx]
+		}
+	}
+	timeSeries := models.NewSeries(tags, tagValues)
+	for fieldName, values := range fields {
+		if values == nil {
+			continue
+		}
+
+		points := models.NewPoints()
+		it := values.NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			if math.IsNaN(val) {
+				// TODO: need check
+				continue
+			}
+			points.AddPoint(timeutil.CalcTimestamp(mq.stmtQuery.TimeRange.Start, slot, mq.stmtQuery.Interval), val)
+		}
+		timeSeries.AddField(fieldName, points)
+		fieldsMap[fieldName] = struct{}{}
+	}
+	return fn(timeSeries)
+}
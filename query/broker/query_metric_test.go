@@ -30,9 +30,12 @@ import (
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -54,9 +57,11 @@ func Test_MetricQuery(t *testing.T) {
 	stateMgr.EXPECT().GetCurrentNode().Return(currentNode).AnyTimes()
 	taskManager := NewMockTaskManager(ctrl)
 
+	replicaSelector := NewMockReplicaSelector(ctrl)
 	queryFactory := &queryFactory{
-		stateMgr:    stateMgr,
-		taskManager: taskManager,
+		stateMgr:        stateMgr,
+		taskManager:     taskManager,
+		replicaSelector: replicaSelector,
 	}
 	brokerNodes := []models.StatelessNode{
 		generateBrokerActiveNode("1.1.1.1", 8000),
@@ -110,6 +115,36 @@ func Test_MetricQuery(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "follower read, select replica failure",
+			prepare: func() context.Context {
+				stateMgr.EXPECT().GetDatabaseCfg("test_db").
+					Return(models.Database{Option: opt, MaxReadLag: ltoml.Duration(10 * time.Second)}, true)
+				replicaSelector.EXPECT().SelectReplicas(gomock.Any(), 10*time.Second).Return(nil, fmt.Errorf("err"))
+				return context.Background()
+			},
+			wantErr: true,
+		},
+		{
+			name: "follower read, lag bound of query overrides database",
+			prepare: func() context.Context {
+				stateMgr.EXPECT().GetDatabaseCfg("test_db").
+					Return(models.Database{Option: opt}, true)
+				replicaSelector.EXPECT().SelectReplicas(gomock.Any(), 20*time.Second).Return(nil, fmt.Errorf("err"))
+				return context.WithValue(context.Background(), constants.ContextKeyMaxReadLag, 20*time.Second)
+			},
+			wantErr: true,
+		},
+		{
+			name: "leader read, lag bound of query is negative",
+			prepare: func() context.Context {
+				stateMgr.EXPECT().GetDatabaseCfg("test_db").
+					Return(models.Database{Option: opt, MaxReadLag: ltoml.Duration(10 * time.Second)}, true)
+				stateMgr.EXPECT().GetQueryableReplicas("test_db").Return(nil, fmt.Errorf("err"))
+				return context.WithValue(context.Background(), constants.ContextKeyMaxReadLag, -time.Second)
+			},
+			wantErr: true,
+		},
 		{
 			name: "broker plan failure",
 			prepare: func() context.Context {
@@ -139,6 +174,24 @@ func Test_MetricQuery(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "pass sql and class of query by physical plan",
+			prepare: func() context.Context {
+				stateMgr.EXPECT().GetDatabaseCfg("test_db").
+					Return(models.Database{Option: opt}, true)
+				stateMgr.EXPECT().GetQueryableReplicas("test_db").
+					Return(storageNodes, nil)
+				taskManager.EXPECT().SubmitMetricTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, plan *models.PhysicalPlan, _ *stmt.Query) (<-chan *series.TimeSeriesEvent, error) {
+						assert.Equal(t, "select f from cpu", plan.SQL)
+						assert.Equal(t, models.DashboardQuery, plan.Class)
+						return nil, fmt.Errorf("err")
+					})
+				ctx := context.WithValue(context.Background(), constants.ContextKeySQL, &models.Request{SQL: "select f from cpu"})
+				return context.WithValue(ctx, constants.ContextKeyQueryClass, models.DashboardQuery)
+			},
+			wantErr: true,
+		},
 		{
 			name: "timeout",
 			prepare: func() context.Context {
@@ -372,3 +425,305 @@ func Test_MetricQuery_makeResultSet(t *testing.T) {
 		})
 	}
 }
+
+func Test_MetricQuery_makeResultSet_Stats(t *testing.T) {
+	qry := &metricQuery{
+		root:      &models.StatelessNode{},
+		stmtQuery: &stmt.Query{MetricName: "cpu", Limit: 10},
+	}
+	stats := models.NewQueryStats()
+	stats.MergeLeafTaskStats("1.1.1.1:9000", &models.LeafNodeStats{ScannedSeries: 10, ScannedFamilies: 2, ScannedBytes: 80})
+	// scan stats collected for each query
+	rs, err := qry.makeResultSet(&series.TimeSeriesEvent{Stats: stats})
+	assert.NoError(t, err)
+	assert.Nil(t, rs.Stats)
+	assert.Equal(t, models.ScanStats{Series: 10, Families: 2, Bytes: 80}, rs.Scanned)
+	// full stats for explain query
+	qry.stmtQuery.Explain = true
+	rs, err = qry.makeResultSet(&series.TimeSeriesEvent{Stats: stats})
+	assert.NoError(t, err)
+	assert.Equal(t, stats, rs.Stats)
+	assert.Equal(t, int64(10), rs.Scanned.Series)
+}
+
+func Test_MetricQuery_WaitChunked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg("test_db").Return(models.Database{}, false)
+	q, err := sql.Parse("select f from cpu")
+	assert.NoError(t, err)
+	qry := newMetricQuery(context.Background(), &models.StatelessNode{}, "test_db", q.(*stmt.Query),
+		&queryFactory{stateMgr: stateMgr})
+	rs, err := qry.WaitChunked(func(_ *models.Series) error { return nil })
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func Test_MetricQuery_WaitChunked_Windows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExpressionFn = aggregation.NewExpression
+		ctrl.Finish()
+	}()
+	expression := aggregation.NewMockExpression(ctrl)
+	newExpressionFn = func(_ timeutil.TimeRange, _ int64, _ []stmt.Expr) aggregation.Expression {
+		return expression
+	}
+	expression.EXPECT().Eval(gomock.Any()).AnyTimes()
+	expression.EXPECT().ResultSet().DoAndReturn(func() map[string]*collections.FloatArray {
+		values := collections.NewFloatArray(1)
+		values.SetValue(0, 1.0)
+		return map[string]*collections.FloatArray{"f": values}
+	}).AnyTimes()
+
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetCurrentNode().Return(currentNode).AnyTimes()
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{currentNode}).AnyTimes()
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}
+	stateMgr.EXPECT().GetDatabaseCfg("test_db").Return(models.Database{Option: opt}, true).AnyTimes()
+	stateMgr.EXPECT().GetQueryableReplicas("test_db").
+		Return(map[string][]models.ShardID{"1.1.1.1:9000": {1}}, nil).AnyTimes()
+	taskManager := NewMockTaskManager(ctrl)
+	var windows []timeutil.TimeRange
+	submitErr := false
+	taskManager.EXPECT().SubmitMetricTask(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *models.PhysicalPlan, q *stmt.Query) (<-chan *series.TimeSeriesEvent, error) {
+			if submitErr {
+				return nil, fmt.Errorf("err")
+			}
+			windows = append(windows, q.TimeRange)
+			var seriesList []series.GroupedIterator
+			// series of window are unordered
+			for _, tags := range []string{"c", "a", "b"} {
+				timeSeries := series.NewMockGroupedIterator(ctrl)
+				timeSeries.EXPECT().Tags().Return(tags).AnyTimes()
+				seriesList = append(seriesList, timeSeries)
+			}
+			ch := make(chan *series.TimeSeriesEvent, 1)
+			ch <- &series.TimeSeriesEvent{SeriesList: seriesList}
+			return ch, nil
+		}).AnyTimes()
+	interval := 10 * timeutil.OneSecond
+	start := timeutil.Truncate(timeutil.Now()-2*timeutil.OneHour, timeutil.OneHour)
+	newQuery := func(end int64, orderBy bool, limit int) *stmt.Query {
+		q := &stmt.Query{
+			MetricName:  "cpu",
+			SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}}},
+			TimeRange:   timeutil.TimeRange{Start: start, End: end},
+			Limit:       limit,
+		}
+		if orderBy {
+			q.OrderByItems = []stmt.Expr{&stmt.OrderByExpr{Expr: &stmt.CallExpr{
+				FuncType: function.Max,
+				Params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+			}}}
+		}
+		return q
+	}
+	waitChunked := func(q *stmt.Query) (tags []string, rs *models.ResultSet, err error) {
+		windows = nil
+		rs, err = newMetricQuery(context.Background(), &models.StatelessNode{}, "test_db", q, &queryFactory{
+			stateMgr:    stateMgr,
+			taskManager: taskManager,
+		}).WaitChunked(func(s *models.Series) error {
+			tags = append(tags, s.TagValues)
+			return nil
+		})
+		return
+	}
+
+	// case 1: split into time windows, series of each window ordered by tag values
+	end := start + 2*timeutil.OneHour - interval
+	q := newQuery(end, false, 10)
+	tags, rs, err := waitChunked(q)
+	assert.NoError(t, err)
+	assert.Len(t, windows, maxChunkedWindows)
+	assert.Equal(t, start, windows[0].Start)
+	assert.Equal(t, end, windows[maxChunkedWindows-1].End)
+	for i := 1; i < len(windows); i++ {
+		assert.Equal(t, windows[i-1].End+interval, windows[i].Start)
+	}
+	assert.Len(t, tags, 3*maxChunkedWindows)
+	assert.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, tags[:6])
+	assert.Equal(t, start, rs.StartTime)
+	assert.Equal(t, end, rs.EndTime)
+	assert.Equal(t, []string{"f"}, rs.Fields)
+	assert.Empty(t, rs.Series)
+	assert.Equal(t, timeutil.TimeRange{Start: start, End: end}, q.TimeRange)
+	// case 2: limit series groups of whole result
+	tags, _, err = waitChunked(newQuery(end, false, 2))
+	assert.NoError(t, err)
+	assert.Len(t, tags, 2*maxChunkedWindows)
+	// case 3: short time range, one window
+	tags, _, err = waitChunked(newQuery(start+5*timeutil.OneMinute, false, 10))
+	assert.NoError(t, err)
+	assert.Len(t, windows, 1)
+	assert.Equal(t, []string{"a", "b", "c"}, tags)
+	// case 4: order by query cannot be split
+	_, _, err = waitChunked(newQuery(end, true, 10))
+	assert.NoError(t, err)
+	assert.Len(t, windows, 1)
+	// case 5: emit series failure
+	_, err = newMetricQuery(context.Background(), &models.StatelessNode{}, "test_db", newQuery(end, false, 10),
+		&queryFactory{stateMgr: stateMgr, taskManager: taskManager}).
+		WaitChunked(func(_ *models.Series) error {
+			return fmt.Errorf("err")
+		})
+	assert.Error(t, err)
+	// case 6: submit failure
+	submitErr = true
+	_, _, err = waitChunked(newQuery(end, false, 10))
+	assert.Error(t, err)
+}
+
+func Test_MetricQuery_makeResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExpressionFn = aggregation.NewExpression
+		ctrl.Finish()
+	}()
+	expression := aggregation.NewMockExpression(ctrl)
+	newExpressionFn = func(_ timeutil.TimeRange, _ int64, _ []stmt.Expr) aggregation.Expression {
+		return expression
+	}
+	expression.EXPECT().Eval(gomock.Any()).AnyTimes()
+	values := collections.NewFloatArray(2)
+	values.SetValue(1, 1.0)
+	expression.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"f1": values}).AnyTimes()
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+	timeSeries.EXPECT().Tags().Return("node1").AnyTimes()
+
+	qry := &metricQuery{
+		root: &models.StatelessNode{},
+		stmtQuery: &stmt.Query{
+			MetricName: "cpu",
+			Interval:   timeutil.Interval(timeutil.OneMinute),
+			GroupBy:    []string{"node"},
+			Limit:      1,
+		},
+	}
+	event := &series.TimeSeriesEvent{SeriesList: []series.GroupedIterator{timeSeries, timeSeries}}
+	// emit series after limited by result limiter
+	var seriesList []*models.Series
+	rs, err := qry.makeResult(event, func(s *models.Series) error {
+		seriesList = append(seriesList, s)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, rs.Series)
+	assert.Equal(t, []string{"f1"}, rs.Fields)
+	assert.Len(t, seriesList, 1)
+	assert.Equal(t, map[string]string{"node": "node1"}, seriesList[0].Tags)
+	// emit failure
+	rs, err = qry.makeResult(event, func(_ *models.Series) error {
+		return fmt.Errorf("err")
+	})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// order by, emit failure
+	qry.stmtQuery.OrderByItems = []stmt.Expr{
+		&stmt.OrderByExpr{Expr: &stmt.CallExpr{
+			FuncType: function.Sum,
+			Params:   []stmt.Expr{&stmt.FieldExpr{Name: "f1"}},
+		}},
+	}
+	rs, err = qry.makeResult(event, func(_ *models.Series) error {
+		return fmt.Errorf("err")
+	})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func Test_MetricQuery_WaitResponseWithCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExpressionFn = aggregation.NewExpression
+		ctrl.Finish()
+	}()
+	expression := aggregation.NewMockExpression(ctrl)
+	pointCount := 0
+	newExpressionFn = func(timeRange timeutil.TimeRange, interval int64, _ []stmt.Expr) aggregation.Expression {
+		pointCount = timeutil.CalPointCount(timeRange.Start, timeRange.End, interval) + 1
+		return expression
+	}
+	expression.EXPECT().Eval(gomock.Any()).AnyTimes()
+	expression.EXPECT().ResultSet().DoAndReturn(func() map[string]*collections.FloatArray {
+		values := collections.NewFloatArray(pointCount)
+		for i := 0; i < pointCount; i++ {
+			values.SetValue(i, float64(i))
+		}
+		return map[string]*collections.FloatArray{"f": values}
+	}).AnyTimes()
+
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetCurrentNode().Return(currentNode).AnyTimes()
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{currentNode}).AnyTimes()
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}
+	stateMgr.EXPECT().GetDatabaseCfg("test_db").Return(models.Database{Option: opt}, true).AnyTimes()
+	stateMgr.EXPECT().GetQueryableReplicas("test_db").
+		Return(map[string][]models.ShardID{"1.1.1.1:9000": {1}}, nil).AnyTimes()
+	taskManager := NewMockTaskManager(ctrl)
+	cfg := config.NewDefaultQuery().ResultCache
+	queryFactory := &queryFactory{
+		stateMgr:    stateMgr,
+		taskManager: taskManager,
+		resultCache: NewResultCache(&cfg),
+	}
+	expectSubmit := func(tags string, start int64) {
+		taskManager.EXPECT().SubmitMetricTask(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *models.PhysicalPlan, q *stmt.Query) (<-chan *series.TimeSeriesEvent, error) {
+				assert.Equal(t, start, q.TimeRange.Start)
+				timeSeries := series.NewMockGroupedIterator(ctrl)
+				timeSeries.EXPECT().Tags().Return(tags).AnyTimes()
+				ch := make(chan *series.TimeSeriesEvent, 1)
+				event := &series.TimeSeriesEvent{SeriesList: []series.GroupedIterator{timeSeries}}
+				if q.Explain {
+					event.Stats = models.NewQueryStats()
+				}
+				ch <- event
+				return ch, nil
+			})
+	}
+	interval := 10 * timeutil.OneSecond
+	start := timeutil.Truncate(timeutil.Now()-2*timeutil.OneHour, timeutil.OneHour)
+	waitResponse := func(end int64, explain bool) *models.ResultSet {
+		q := &stmt.Query{
+			Explain:     explain,
+			MetricName:  "cpu",
+			SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}}},
+			TimeRange:   timeutil.TimeRange{Start: start, End: end},
+			Limit:       10,
+		}
+		rs, err := newMetricQuery(context.Background(), &models.StatelessNode{}, "test_db", q, queryFactory).WaitResponse()
+		assert.NoError(t, err)
+		return rs
+	}
+
+	// miss, query from storage
+	expectSubmit("a", start)
+	rs := waitResponse(start+10*timeutil.OneMinute, true)
+	assert.Equal(t, &models.ResultCacheStats{QueriedBuckets: 61}, rs.Stats.ResultCache)
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, 61, len(rs.Series[0].Fields["f"]))
+	// hit, all buckets cached
+	rs = waitResponse(start+10*timeutil.OneMinute, true)
+	assert.Equal(t, &models.ResultCacheStats{Hit: true, CachedBuckets: 61}, rs.Stats.ResultCache)
+	assert.Equal(t, start, rs.StartTime)
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, 61, len(rs.Series[0].Fields["f"]))
+	// hit, query uncovered tail
+	expectSubmit("b", start+10*timeutil.OneMinute+interval)
+	rs = waitResponse(start+20*timeutil.OneMinute, false)
+	assert.Nil(t, rs.Stats)
+	assert.Len(t, rs.Series, 2)
+	assert.Equal(t, 61, len(rs.Series[0].Fields["f"]))
+	assert.Equal(t, 60, len(rs.Series[1].Fields["f"]))
+	// spliced result cached
+	rs = waitResponse(start+20*timeutil.OneMinute, true)
+	assert.Equal(t, &models.ResultCacheStats{Hit: true, CachedBuckets: 121}, rs.Stats.ResultCache)
+	assert.Len(t, rs.Series, 2)
+}
@@ -0,0 +1,258 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+//go:generate mockgen -source=./replica_selector.go -destination=./replica_selector_mock.go -package=brokerquery
+
+// for testing
+var (
+	newStorageAdminCliFn = client.NewStorageAdminCli
+)
+
+const (
+	// replicaLagTTL represents the interval of refreshing cached replica lag of database.
+	replicaLagTTL = 5 * time.Second
+	// maxReplicaLagAge represents the max age of cached replica lag which can be used to select follower,
+	// only leader serves the query if replica lag isn't refreshed for a long time(leader unreachable).
+	maxReplicaLagAge = 3 * replicaLagTTL
+)
+
+// ReplicaSelector selects the replica which serves the query for each shard, the follower whose replica lag
+// is within the bound can serve the query, so that query load is spread across all replicas.
+// Replica lag is the time bound of data which follower hasn't acknowledged(age of the first write ahead log entry
+// not acknowledged, measured by leader), it is refreshed from leaders in background,
+// so that query never waits the replica state fetching, the age of cached replica lag is added to the lag.
+type ReplicaSelector interface {
+	// SelectReplicas returns the queryable replicas, chooses leader or follower which lag <= maxLag for each shard.
+	// returns storage node => shard id list
+	SelectReplicas(databaseCfg models.Database, maxLag time.Duration) (map[string][]models.ShardID, error)
+}
+
+// replicaLags represents the cached replica lag of database.
+type replicaLags struct {
+	lags      map[models.ShardID]map[models.NodeID]time.Duration // shard id => follower => lag
+	fetchedAt time.Time
+}
+
+// replicaSelector implements ReplicaSelector interface.
+type replicaSelector struct {
+	stateMgr broker.StateManager
+	adminCli client.StorageAdminCli
+	ttl      time.Duration
+	maxAge   time.Duration
+
+	lags       map[string]*replicaLags // database => replica lags
+	refreshing map[string]struct{}     // database => refreshing replica lags in background
+	next       atomic.Uint32
+	mutex      sync.Mutex
+
+	logger *logger.Logger
+}
+
+// newReplicaSelector creates a ReplicaSelector instance.
+func newReplicaSelector(stateMgr broker.StateManager) ReplicaSelector {
+	return &replicaSelector{
+		stateMgr:   stateMgr,
+		adminCli:   newStorageAdminCliFn(),
+		ttl:        replicaLagTTL,
+		maxAge:     maxReplicaLagAge,
+		lags:       make(map[string]*replicaLags),
+		refreshing: make(map[string]struct{}),
+		logger:     logger.GetLogger("Query", "ReplicaSelector"),
+	}
+}
+
+// SelectReplicas returns the queryable replicas, chooses leader or follower which lag <= maxLag for each shard.
+// returns storage node => shard id list
+func (s *replicaSelector) SelectReplicas(databaseCfg models.Database, maxLag time.Duration) (map[string][]models.ShardID, error) {
+	storage, ok := s.stateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	liveNodes := storage.LiveNodes
+	if len(liveNodes) == 0 {
+		return nil, constants.ErrNoLiveNode
+	}
+	shards := storage.ShardStates[databaseCfg.Name]
+	if len(shards) == 0 {
+		return nil, constants.ErrShardNotFound
+	}
+	lags, age := s.getLags(databaseCfg.Name, storage)
+	assignment := storage.ShardAssignments[databaseCfg.Name]
+
+	result := make(map[string][]models.ShardID)
+	for shardID, shardState := range shards {
+		if shardState.State != models.OnlineShard {
+			s.logger.Warn("shard is not online ignore it, maybe query data will be lost",
+				logger.String("storage", databaseCfg.Storage),
+				logger.String("database", databaseCfg.Name),
+				logger.Any("shard", shardID))
+			continue
+		}
+		candidates := []models.NodeID{shardState.Leader}
+		for _, nodeID := range shardState.Replica.Replicas {
+			if nodeID == shardState.Leader || isLearner(assignment, shardID, nodeID) {
+				// learner doesn't create shard until promoted
+				continue
+			}
+			if _, live := liveNodes[nodeID]; !live {
+				continue
+			}
+			// follower may fall behind since replica lag fetched, so age of cached lag is added
+			if lag, ok := lags[shardID][nodeID]; ok && lag+age <= maxLag {
+				candidates = append(candidates, nodeID)
+			}
+		}
+		node := liveNodes[candidates[int(s.next.Inc())%len(candidates)]]
+		result[node.Indicator()] = append(result[node.Indicator()], shardID)
+	}
+	return result, nil
+}
+
+// getLags returns the cached replica lag of followers for database and the age of cache,
+// refreshes it in background if cache expired,
+// returns nil(only leader serves the query) if replica lag isn't fetched or too old.
+func (s *replicaSelector) getLags(
+	database string,
+	storage *models.StorageState,
+) (lags map[models.ShardID]map[models.NodeID]time.Duration, age time.Duration) {
+	now := time.Now()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cached, ok := s.lags[database]
+	if !ok || now.Sub(cached.fetchedAt) >= s.ttl {
+		if _, refreshing := s.refreshing[database]; !refreshing {
+			s.refreshing[database] = struct{}{}
+			go s.refreshLags(database, storage)
+		}
+	}
+	if !ok || now.Sub(cached.fetchedAt) >= s.maxAge {
+		return nil, 0
+	}
+	return cached.lags, now.Sub(cached.fetchedAt)
+}
+
+// refreshLags fetches replica lag of database from leaders, then caches it.
+func (s *replicaSelector) refreshLags(database string, storage *models.StorageState) {
+	defer func() {
+		if err := recover(); err != nil {
+			s.logger.Error("panic when refresh replica lag", logger.Any("err", err), logger.Stack())
+		}
+		s.mutex.Lock()
+		delete(s.refreshing, database)
+		s.mutex.Unlock()
+	}()
+
+	lags := s.fetchLags(database, storage)
+
+	s.mutex.Lock()
+	s.lags[database] = &replicaLags{lags: lags, fetchedAt: time.Now()}
+	s.mutex.Unlock()
+}
+
+// fetchLags returns the replica lag of followers for database, fetches replica state from leaders,
+// replica lag of shard is the max. lag of all families.
+func (s *replicaSelector) fetchLags(database string, storage *models.StorageState) map[models.ShardID]map[models.NodeID]time.Duration {
+	leaders := make(map[models.NodeID]struct{})
+	for _, shardState := range storage.ShardStates[database] {
+		if shardState.State == models.OnlineShard {
+			leaders[shardState.Leader] = struct{}{}
+		}
+	}
+	lags := make(map[models.ShardID]map[models.NodeID]time.Duration)
+	families := make(map[models.ShardID]int)
+	replicated := make(map[models.ShardID]map[models.NodeID]int)
+	for leaderID := range leaders {
+		leader, live := storage.LiveNodes[leaderID]
+		if !live {
+			continue
+		}
+		rs, err := s.adminCli.GetReplicaState(&leader, database)
+		if err != nil {
+			// only leader serves query of shards which leader is this node
+			s.logger.Warn("get replica state from leader failure",
+				logger.String("database", database), logger.String("leader", leader.Indicator()), logger.Error(err))
+			continue
+		}
+		for _, familyState := range rs {
+			if familyState.Leader != leaderID {
+				continue
+			}
+			shardID := familyState.ShardID
+			if _, ok := lags[shardID]; !ok {
+				lags[shardID] = make(map[models.NodeID]time.Duration)
+				replicated[shardID] = make(map[models.NodeID]int)
+			}
+			families[shardID]++
+			for _, replicator := range familyState.Replicators {
+				id, err := strconv.ParseInt(replicator.Replicator, 10, 64)
+				if err != nil {
+					continue
+				}
+				if replicator.Staleness < 0 {
+					// staleness unknown, follower can't serve the query
+					continue
+				}
+				nodeID := models.NodeID(id)
+				lag := time.Duration(replicator.Staleness) * time.Millisecond
+				if current, ok := lags[shardID][nodeID]; !ok || lag > current {
+					lags[shardID][nodeID] = lag
+				}
+				replicated[shardID][nodeID]++
+			}
+		}
+	}
+	// follower must be replicated(staleness known) in all families of shard
+	for shardID, nodes := range replicated {
+		for nodeID, count := range nodes {
+			if count != families[shardID] {
+				delete(lags[shardID], nodeID)
+			}
+		}
+	}
+
+	return lags
+}
+
+// isLearner checks if node is learner of shard.
+func isLearner(assignment *models.ShardAssignment, shardID models.ShardID, nodeID models.NodeID) bool {
+	if assignment == nil {
+		return false
+	}
+	for _, learner := range assignment.GetLearners(shardID) {
+		if learner == nodeID {
+			return true
+		}
+	}
+	return false
+}
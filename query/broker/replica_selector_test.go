@@ -0,0 +1,270 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+func TestReplicaSelector_SelectReplicas(t *testing.T) {
+	defer func() {
+		newStorageAdminCliFn = client.NewStorageAdminCli
+	}()
+
+	databaseCfg := models.Database{Name: "test", Storage: "local"}
+	node := func(id models.NodeID) models.StatefulNode {
+		return models.StatefulNode{
+			StatelessNode: models.StatelessNode{HostIP: fmt.Sprintf("1.1.1.%d", id), GRPCPort: 9000},
+			ID:            id,
+		}
+	}
+	newState := func() *models.StorageState {
+		state := models.NewStorageState("local")
+		state.LiveNodes = map[models.NodeID]models.StatefulNode{1: node(1), 2: node(2), 3: node(3)}
+		state.ShardStates["test"] = map[models.ShardID]models.ShardState{
+			1: {ID: 1, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2, 3}}},
+		}
+		return state
+	}
+	replicaState := func(staleness ...int64) []models.FamilyLogReplicaState {
+		var replicators []models.ReplicaPeerState
+		for i, ms := range staleness {
+			replicators = append(replicators, models.ReplicaPeerState{Replicator: fmt.Sprintf("%d", i+2), Staleness: ms})
+		}
+		return []models.FamilyLogReplicaState{{ShardID: 1, Leader: 1, Append: 100, Replicators: replicators}}
+	}
+
+	cases := []struct {
+		name    string
+		maxLag  time.Duration
+		prepare func(stateMgr *broker.MockStateManager, adminCli *client.MockStorageAdminCli)
+		nodes   []string
+		wantErr bool
+	}{
+		{
+			name: "storage not found",
+			prepare: func(stateMgr *broker.MockStateManager, _ *client.MockStorageAdminCli) {
+				stateMgr.EXPECT().GetStorage("local").Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name: "no live node",
+			prepare: func(stateMgr *broker.MockStateManager, _ *client.MockStorageAdminCli) {
+				stateMgr.EXPECT().GetStorage("local").Return(models.NewStorageState("local"), true)
+			},
+			wantErr: true,
+		},
+		{
+			name: "shard not found",
+			prepare: func(stateMgr *broker.MockStateManager, _ *client.MockStorageAdminCli) {
+				state := newState()
+				state.ShardStates = map[string]map[models.ShardID]models.ShardState{}
+				stateMgr.EXPECT().GetStorage("local").Return(state, true)
+			},
+			wantErr: true,
+		},
+		{
+			name: "shard offline",
+			prepare: func(stateMgr *broker.MockStateManager, _ *client.MockStorageAdminCli) {
+				state := newState()
+				state.ShardStates["test"][1] = models.ShardState{ID: 1, State: models.OfflineShard}
+				stateMgr.EXPECT().GetStorage("local").Return(state, true).AnyTimes()
+			},
+		},
+		{
+			name:   "get replica state failure, only leader",
+			maxLag: 10 * time.Second,
+			prepare: func(stateMgr *broker.MockStateManager, adminCli *client.MockStorageAdminCli) {
+				stateMgr.EXPECT().GetStorage("local").Return(newState(), true).AnyTimes()
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(nil, fmt.Errorf("err"))
+			},
+			nodes: []string{"1.1.1.1:9000"},
+		},
+		{
+			name:   "followers lag too much, only leader",
+			maxLag: 10 * time.Second,
+			prepare: func(stateMgr *broker.MockStateManager, adminCli *client.MockStorageAdminCli) {
+				stateMgr.EXPECT().GetStorage("local").Return(newState(), true).AnyTimes()
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(replicaState(20000, 30000), nil)
+			},
+			nodes: []string{"1.1.1.1:9000"},
+		},
+		{
+			name:   "followers staleness unknown, only leader",
+			maxLag: 10 * time.Second,
+			prepare: func(stateMgr *broker.MockStateManager, adminCli *client.MockStorageAdminCli) {
+				stateMgr.EXPECT().GetStorage("local").Return(newState(), true).AnyTimes()
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(replicaState(-1, -1), nil)
+			},
+			nodes: []string{"1.1.1.1:9000"},
+		},
+		{
+			name:   "follower within staleness bound",
+			maxLag: 10 * time.Second,
+			prepare: func(stateMgr *broker.MockStateManager, adminCli *client.MockStorageAdminCli) {
+				stateMgr.EXPECT().GetStorage("local").Return(newState(), true).AnyTimes()
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(replicaState(1000, 20000), nil)
+			},
+			nodes: []string{"1.1.1.1:9000", "1.1.1.2:9000"},
+		},
+		{
+			name:   "learner/offline follower cannot serve query",
+			maxLag: 10 * time.Second,
+			prepare: func(stateMgr *broker.MockStateManager, adminCli *client.MockStorageAdminCli) {
+				state := newState()
+				delete(state.LiveNodes, 3)
+				state.ShardAssignments["test"] = &models.ShardAssignment{
+					Shards:   map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
+					Learners: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{2}}},
+				}
+				stateMgr.EXPECT().GetStorage("local").Return(state, true).AnyTimes()
+				adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(replicaState(0, 0), nil)
+			},
+			nodes: []string{"1.1.1.1:9000"},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stateMgr := broker.NewMockStateManager(ctrl)
+			adminCli := client.NewMockStorageAdminCli(ctrl)
+			newStorageAdminCliFn = func() client.StorageAdminCli {
+				return adminCli
+			}
+			selector := newReplicaSelector(stateMgr)
+			tt.prepare(stateMgr, adminCli)
+
+			// replica lag is refreshed in background, only leader serves the query before it is fetched
+			rs, err := selector.SelectReplicas(databaseCfg, tt.maxLag)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(rs), 1)
+			waitRefreshed(t, selector.(*replicaSelector))
+			// replica lag is cached, candidates are selected round-robin
+			nodes := make(map[string]struct{})
+			for i := 0; i < 4; i++ {
+				rs, err := selector.SelectReplicas(databaseCfg, tt.maxLag)
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				for n := range rs {
+					nodes[n] = struct{}{}
+				}
+			}
+			assert.Len(t, nodes, len(tt.nodes))
+			for _, n := range tt.nodes {
+				assert.Contains(t, nodes, n)
+			}
+		})
+	}
+}
+
+func TestReplicaSelector_getLags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adminCli := client.NewMockStorageAdminCli(ctrl)
+	selector := &replicaSelector{
+		adminCli:   adminCli,
+		ttl:        time.Minute,
+		maxAge:     time.Hour,
+		lags:       make(map[string]*replicaLags),
+		refreshing: make(map[string]struct{}),
+		logger:     logger.GetLogger("Query", "Test"),
+	}
+	state := models.NewStorageState("local")
+	state.LiveNodes[1] = models.StatefulNode{ID: 1}
+	state.ShardStates["test"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 1},
+		2: {ID: 2, State: models.OnlineShard, Leader: 1},
+		3: {ID: 3, State: models.OnlineShard, Leader: 4}, // leader offline
+	}
+	adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+		{ShardID: 1, Leader: 1, Append: 10, Replicators: []models.ReplicaPeerState{
+			{Replicator: "2", Staleness: 3000}, {Replicator: "3", Staleness: 0}, {Replicator: "4", Staleness: -1}},
+		},
+		{ShardID: 1, Leader: 1, Append: 20, Replicators: []models.ReplicaPeerState{
+			{Replicator: "2", Staleness: 1000}, {Replicator: "4", Staleness: 0}},
+		},
+		{ShardID: 2, Leader: 1, Append: 20, Replicators: []models.ReplicaPeerState{{Replicator: "abc", Staleness: 0}}},
+		{ShardID: 3, Leader: 2, Append: 20, Replicators: []models.ReplicaPeerState{{Replicator: "3", Staleness: 0}}},
+	}, nil).Times(2)
+	// not fetched
+	lags, _ := selector.getLags("test", state)
+	assert.Nil(t, lags)
+	waitRefreshed(t, selector)
+	lags, age := selector.getLags("test", state)
+	// lag of shard is the max. lag of all families, node 3 isn't replicated in all families of shard 1,
+	// staleness of node 4 is unknown in one family of shard 1.
+	assert.Equal(t, map[models.ShardID]map[models.NodeID]time.Duration{
+		1: {2: 3 * time.Second},
+		2: {},
+	}, lags)
+	assert.Less(t, age, time.Minute)
+	// hit cache
+	cached, _ := selector.getLags("test", state)
+	assert.Equal(t, lags, cached)
+	// cache expired, returns cached lag and refreshes it in background
+	expire := func(age time.Duration) {
+		selector.mutex.Lock()
+		selector.lags["test"].fetchedAt = time.Now().Add(-age)
+		selector.mutex.Unlock()
+	}
+	expire(2 * time.Minute)
+	cached, age = selector.getLags("test", state)
+	assert.Equal(t, lags, cached)
+	assert.GreaterOrEqual(t, age, 2*time.Minute)
+	waitRefreshed(t, selector)
+	// cached lag too old, leader unreachable
+	expire(2 * time.Hour)
+	adminCli.EXPECT().GetReplicaState(gomock.Any(), "test").Return(nil, fmt.Errorf("err"))
+	cached, _ = selector.getLags("test", state)
+	assert.Nil(t, cached)
+	waitRefreshed(t, selector)
+	cached, _ = selector.getLags("test", state)
+	assert.Equal(t, map[models.ShardID]map[models.NodeID]time.Duration{}, cached)
+}
+
+// waitRefreshed waits the background refreshing of replica lag completed.
+func waitRefreshed(t *testing.T, selector *replicaSelector) {
+	assert.Eventually(t, func() bool {
+		selector.mutex.Lock()
+		defer selector.mutex.Unlock()
+		return len(selector.refreshing) == 0
+	}, time.Second, time.Millisecond)
+}
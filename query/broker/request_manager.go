@@ -19,6 +19,7 @@ package brokerquery
 
 import (
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -36,6 +37,8 @@ type RequestManager interface {
 	NewRequest(req *models.Request) string
 	// CompleteRequest completes a request by given request id.
 	CompleteRequest(requestID string)
+	// AdmitRequest marks the request admitted from queue with the queue wait time.
+	AdmitRequest(requestID string, queueWait time.Duration)
 	// GetAliveRequests returns all alive request.
 	GetAliveRequests() []*models.Request
 }
@@ -85,13 +88,29 @@ func (r *requestManager) CompleteRequest(requestID string) {
 	delete(r.requests, requestID)
 }
 
-// GetAliveRequests returns all alive request.
+// AdmitRequest marks the request admitted from queue with the queue wait time.
+func (r *requestManager) AdmitRequest(requestID string, queueWait time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if req, ok := r.requests[requestID]; ok {
+		req.Queued = false
+		req.QueueWait = queueWait.Nanoseconds()
+	}
+}
+
+// GetAliveRequests returns the snapshot of all alive request, queue wait of queued request is up to now.
 func (r *requestManager) GetAliveRequests() (rs []*models.Request) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
+	now := time.Now().UnixNano()
 	for _, v := range r.requests {
-		rs = append(rs, v)
+		req := *v
+		if req.Queued {
+			req.QueueWait = now - req.Start
+		}
+		rs = append(rs, &req)
 	}
 	return
 }
@@ -19,6 +19,7 @@ package brokerquery
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -34,8 +35,18 @@ func TestRequestManager(t *testing.T) {
 	mgr := newRequestManager()
 	assert.Empty(t, mgr.GetAliveRequests())
 
-	req := mgr.NewRequest(&models.Request{})
-	assert.Len(t, mgr.GetAliveRequests(), 1)
+	req := mgr.NewRequest(&models.Request{Queued: true, Start: time.Now().Add(-time.Second).UnixNano()})
+	rs := mgr.GetAliveRequests()
+	assert.Len(t, rs, 1)
+	assert.True(t, rs[0].Queued)
+	assert.GreaterOrEqual(t, rs[0].QueueWait, time.Second.Nanoseconds())
+
+	mgr.AdmitRequest(req, time.Millisecond)
+	mgr.AdmitRequest("not-exist", time.Millisecond)
+	rs = mgr.GetAliveRequests()
+	assert.Len(t, rs, 1)
+	assert.False(t, rs[0].Queued)
+	assert.Equal(t, time.Millisecond.Nanoseconds(), rs[0].QueueWait)
 
 	mgr.CompleteRequest(req)
 	assert.Empty(t, mgr.GetAliveRequests())
@@ -0,0 +1,219 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./result_cache.go -destination=./result_cache_mock.go -package=brokerquery
+
+const (
+	// estimated memory size of cached series/field/point
+	cachedSeriesMemSize = 64
+	cachedFieldMemSize  = 48
+	cachedPointMemSize  = 32
+)
+
+// ResultCache represents the broker side cache of metric query result, which caches the immutable time buckets
+// of result keyed by normalized query(without time range), so identical query only needs to query the uncovered tail.
+type ResultCache interface {
+	// Get returns the cached result of query key which covers the start of query time range.
+	Get(key string, start int64) (*CachedResult, bool)
+	// Put caches the result of query key, replaces the old one.
+	Put(key string, result *CachedResult)
+	// ImmutableEnd returns the end(exclusive) of immutable time buckets,
+	// buckets after it may receive late writes, so cannot be cached.
+	ImmutableEnd(now, interval, behind int64) int64
+}
+
+// CachedResult represents the cached series of immutable time buckets [Start, End).
+type CachedResult struct {
+	Start  int64
+	End    int64
+	Fields []string
+	Series []*models.Series
+
+	key      string
+	size     int64
+	expireAt int64
+}
+
+// newCachedResult creates the cached result which copies the points in [start, end) from result set.
+func newCachedResult(rs *models.ResultSet, start, end int64) *CachedResult {
+	result := &CachedResult{
+		Start:  start,
+		End:    end,
+		Fields: append([]string(nil), rs.Fields...),
+	}
+	for _, s := range rs.Series {
+		cs := models.NewSeries(s.Tags, s.TagValues)
+		result.size += int64(cachedSeriesMemSize + len(s.TagValues))
+		for tagKey, tagValue := range s.Tags {
+			result.size += int64(len(tagKey) + len(tagValue))
+		}
+		for fieldName, points := range s.Fields {
+			cachedPoints := make(map[int64]float64)
+			for timestamp, value := range points {
+				if timestamp >= start && timestamp < end {
+					cachedPoints[timestamp] = value
+				}
+			}
+			cs.Fields[fieldName] = cachedPoints
+			result.size += int64(cachedFieldMemSize + len(fieldName) + cachedPointMemSize*len(cachedPoints))
+		}
+		result.Series = append(result.Series, cs)
+	}
+	return result
+}
+
+// splice merges the cached points in [start, end) into result set, then sorts the series by tag values.
+func (r *CachedResult) splice(rs *models.ResultSet, start, end int64) {
+	seriesMap := make(map[string]*models.Series, len(rs.Series))
+	for _, s := range rs.Series {
+		seriesMap[s.TagValues] = s
+	}
+	for _, cs := range r.Series {
+		s, ok := seriesMap[cs.TagValues]
+		if !ok {
+			s = models.NewSeries(cs.Tags, cs.TagValues)
+			seriesMap[cs.TagValues] = s
+			rs.Series = append(rs.Series, s)
+		}
+		for fieldName, points := range cs.Fields {
+			cachedPoints := models.NewPoints()
+			for timestamp, value := range points {
+				if timestamp >= start && timestamp < end {
+					cachedPoints.AddPoint(timestamp, value)
+				}
+			}
+			s.AddField(fieldName, cachedPoints)
+		}
+	}
+	fields := make(map[string]struct{}, len(rs.Fields))
+	for _, fieldName := range rs.Fields {
+		fields[fieldName] = struct{}{}
+	}
+	for _, fieldName := range r.Fields {
+		if _, ok := fields[fieldName]; !ok {
+			fields[fieldName] = struct{}{}
+			rs.Fields = append(rs.Fields, fieldName)
+		}
+	}
+	sort.Slice(rs.Series, func(i, j int) bool {
+		return rs.Series[i].TagValues < rs.Series[j].TagValues
+	})
+}
+
+// resultCache implements ResultCache interface based on lru cache, evicts the least recently used result
+// if total size exceeds max size.
+type resultCache struct {
+	cfg       *config.QueryResultCache
+	size      int64
+	items     map[string]*list.Element
+	evictList *list.List
+
+	statistics *metrics.QueryResultCacheStatistics
+
+	mutex sync.Mutex
+}
+
+// NewResultCache creates a metric query result cache.
+func NewResultCache(cfg *config.QueryResultCache) ResultCache {
+	return &resultCache{
+		cfg:        cfg,
+		items:      make(map[string]*list.Element),
+		evictList:  list.New(),
+		statistics: metrics.NewQueryResultCacheStatistics(),
+	}
+}
+
+// Get returns the cached result of query key which covers the start of query time range.
+func (c *resultCache) Get(key string, start int64) (*CachedResult, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		result := e.Value.(*CachedResult)
+		if result.expireAt <= timeutil.Now() {
+			c.statistics.Expire.Incr()
+			c.removeElement(e)
+		} else if result.Start <= start && start < result.End {
+			c.evictList.MoveToFront(e)
+			c.statistics.Hit.Incr()
+			return result, true
+		}
+	}
+	c.statistics.Miss.Incr()
+	return nil, false
+}
+
+// Put caches the result of query key, replaces the old one.
+// keeps the expire time if result is spliced from cached result, else expires after ttl.
+func (c *resultCache) Put(key string, result *CachedResult) {
+	maxSize := int64(c.cfg.MaxSize)
+	if result.size > maxSize {
+		return
+	}
+	result.key = key
+	if result.expireAt <= 0 {
+		result.expireAt = timeutil.Now() + c.cfg.TTL.Duration().Milliseconds()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		c.removeElement(e)
+	}
+	c.items[key] = c.evictList.PushFront(result)
+	c.size += result.size
+	for c.size > maxSize {
+		c.statistics.Evict.Incr()
+		c.removeElement(c.evictList.Back())
+	}
+	c.statistics.Entries.Update(float64(len(c.items)))
+	c.statistics.Size.Update(float64(c.size))
+}
+
+// ImmutableEnd returns the end(exclusive) of immutable time buckets,
+// buckets which end within late write window(or database's write behind) before now are mutable.
+func (c *resultCache) ImmutableEnd(now, interval, behind int64) int64 {
+	window := c.cfg.LateWriteWindow.Duration().Milliseconds()
+	if c.cfg.RespectDatabaseBehind && behind > window {
+		window = behind
+	}
+	return timeutil.Truncate(now-window, interval)
+}
+
+// removeElement removes the given list element from the cache.
+func (c *resultCache) removeElement(e *list.Element) {
+	c.evictList.Remove(e)
+	result := e.Value.(*CachedResult)
+	delete(c.items, result.key)
+	c.size -= result.size
+	c.statistics.Entries.Update(float64(len(c.items)))
+	c.statistics.Size.Update(float64(c.size))
+}
@@ -0,0 +1,133 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func newTestResultSet() *models.ResultSet {
+	s1 := models.NewSeries(map[string]string{"host": "a"}, "a")
+	s1.Fields["f"] = map[int64]float64{10: 1, 20: 2, 30: 3}
+	s2 := models.NewSeries(map[string]string{"host": "b"}, "b")
+	s2.Fields["f"] = map[int64]float64{30: 3}
+	return &models.ResultSet{Fields: []string{"f"}, Series: []*models.Series{s1, s2}}
+}
+
+func TestCachedResult_splice(t *testing.T) {
+	cached := newCachedResult(newTestResultSet(), 10, 30)
+	assert.Equal(t, map[int64]float64{10: 1, 20: 2}, cached.Series[0].Fields["f"])
+	assert.Empty(t, cached.Series[1].Fields["f"])
+	assert.True(t, cached.size > 0)
+
+	s := models.NewSeries(map[string]string{"host": "c"}, "c")
+	s.Fields["f2"] = map[int64]float64{30: 3}
+	rs := &models.ResultSet{Fields: []string{"f2"}, Series: []*models.Series{s}}
+	cached.splice(rs, 20, 30)
+	assert.Equal(t, []string{"f2", "f"}, rs.Fields)
+	assert.Len(t, rs.Series, 3)
+	assert.Equal(t, "a", rs.Series[0].TagValues)
+	assert.Equal(t, map[int64]float64{20: 2}, rs.Series[0].Fields["f"])
+	assert.Equal(t, "c", rs.Series[2].TagValues)
+	// cached result not changed
+	assert.Equal(t, map[int64]float64{10: 1, 20: 2}, cached.Series[0].Fields["f"])
+}
+
+func TestResultCache_GetPut(t *testing.T) {
+	cfg := config.NewDefaultQuery().ResultCache
+	cache := NewResultCache(&cfg)
+
+	_, ok := cache.Get("key", 10)
+	assert.False(t, ok)
+
+	cache.Put("key", newCachedResult(newTestResultSet(), 10, 30))
+	rs, ok := cache.Get("key", 10)
+	assert.True(t, ok)
+	assert.Equal(t, int64(30), rs.End)
+	_, ok = cache.Get("key", 20)
+	assert.True(t, ok)
+	// not cover start
+	_, ok = cache.Get("key", 5)
+	assert.False(t, ok)
+	_, ok = cache.Get("key", 30)
+	assert.False(t, ok)
+
+	// replace
+	cache.Put("key", newCachedResult(newTestResultSet(), 20, 40))
+	_, ok = cache.Get("key", 10)
+	assert.False(t, ok)
+	rs, ok = cache.Get("key", 30)
+	assert.True(t, ok)
+	assert.Equal(t, int64(40), rs.End)
+	assert.Equal(t, rs.size, cache.(*resultCache).size)
+
+	// expired
+	expired := newCachedResult(newTestResultSet(), 10, 30)
+	expired.expireAt = timeutil.Now() - 1
+	cache.Put("key", expired)
+	_, ok = cache.Get("key", 10)
+	assert.False(t, ok)
+	assert.Zero(t, cache.(*resultCache).size)
+}
+
+func TestResultCache_evict(t *testing.T) {
+	result := newCachedResult(newTestResultSet(), 10, 30)
+	cfg := config.NewDefaultQuery().ResultCache
+	cfg.MaxSize = ltoml.Size(result.size*2 + 1)
+	cache := NewResultCache(&cfg)
+
+	cache.Put("key1", newCachedResult(newTestResultSet(), 10, 30))
+	cache.Put("key2", newCachedResult(newTestResultSet(), 10, 30))
+	// key1 used recently
+	_, ok := cache.Get("key1", 10)
+	assert.True(t, ok)
+	cache.Put("key3", newCachedResult(newTestResultSet(), 10, 30))
+	_, ok = cache.Get("key2", 10)
+	assert.False(t, ok)
+	_, ok = cache.Get("key1", 10)
+	assert.True(t, ok)
+	_, ok = cache.Get("key3", 10)
+	assert.True(t, ok)
+
+	// too large
+	cfg.MaxSize = 1
+	cache.Put("key4", newCachedResult(newTestResultSet(), 10, 30))
+	_, ok = cache.Get("key4", 10)
+	assert.False(t, ok)
+}
+
+func TestResultCache_ImmutableEnd(t *testing.T) {
+	cfg := config.NewDefaultQuery().ResultCache
+	cfg.LateWriteWindow = ltoml.Duration(time.Minute)
+	cache := NewResultCache(&cfg)
+	now := 10 * timeutil.OneHour
+	interval := 10 * timeutil.OneSecond
+	// respect database's write behind by default
+	assert.Equal(t, now-timeutil.OneHour, cache.ImmutableEnd(now+5*timeutil.OneSecond, interval, timeutil.OneHour))
+	assert.Equal(t, now-timeutil.OneMinute, cache.ImmutableEnd(now+5*timeutil.OneSecond, interval, timeutil.OneSecond))
+	cfg.RespectDatabaseBehind = false
+	assert.Equal(t, now-timeutil.OneMinute, cache.ImmutableEnd(now+5*timeutil.OneSecond, interval, timeutil.OneHour))
+}
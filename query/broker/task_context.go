@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -192,6 +193,9 @@ type metricTaskContext struct {
 	// other error will be returned immediately
 	tolerantNotFounds int32
 	startTime         time.Time // task start time
+	// memoryTracker tracks the memory used by merging the responses of task
+	memoryTracker *flow.MemoryTracker
+	groupMemSize  int64 // memory size of each group in grouping aggregator
 }
 
 // metricTaskContext creates the task context based on params
@@ -221,6 +225,7 @@ func newMetricTaskContext(
 		eventCh:           eventCh,
 		tolerantNotFounds: expectResults,
 		startTime:         time.Now(),
+		memoryTracker:     flow.NewQueryMemoryTracker(),
 	}
 }
 
@@ -259,15 +264,21 @@ func (c *metricTaskContext) WriteResponse(resp *protoCommonV1.TaskResponse, from
 		if c.expectResults <= 0 {
 			close(c.eventCh)
 			c.closed = true
+			c.memoryTracker.ReleaseAll()
 		}
 	}()
 
 	if err := c.handleTaskResponse(resp, fromNode); err != nil {
+		if c.stats != nil {
+			c.stats.PeakMemory = c.memoryTracker.Peak()
+		}
 		select {
 		case c.eventCh <- &series.TimeSeriesEvent{Err: err, Stats: c.stats}:
 		default:
 			// reader gone
 		}
+		// query failure, no need to track memory used by remaining responses.
+		c.memoryTracker.ReleaseAll()
 		return
 	}
 	// not done yet
@@ -281,6 +292,7 @@ func (c *metricTaskContext) WriteResponse(resp *protoCommonV1.TaskResponse, from
 	}
 	if c.stats != nil {
 		c.stats.End = time.Now().UnixNano()
+		c.stats.PeakMemory = c.memoryTracker.Peak()
 	}
 	select {
 	case c.eventCh <- &series.TimeSeriesEvent{
@@ -337,6 +349,13 @@ func (c *metricTaskContext) handleTaskResponse(resp *protoCommonV1.TaskResponse,
 		}()
 	}
 
+	// track memory of decoded response, release it after merged.
+	payloadSize := int64(len(resp.Payload))
+	if err := c.memoryTracker.Consume(payloadSize); err != nil {
+		return err
+	}
+	defer c.memoryTracker.Release(payloadSize)
+
 	tsList := &protoCommonV1.TimeSeriesList{}
 	if err := tsList.Unmarshal(resp.Payload); err != nil {
 		return err
@@ -370,6 +389,7 @@ func (c *metricTaskContext) handleTaskResponse(resp *protoCommonV1.TaskResponse,
 			c.stmtQuery.TimeRange,
 			AggregatorSpecs,
 		)
+		c.groupMemSize = AggregatorSpecs.MemSize(c.stmtQuery.PointCount())
 	}
 
 	for _, ts := range tsList.TimeSeriesList {
@@ -381,7 +401,14 @@ func (c *metricTaskContext) handleTaskResponse(resp *protoCommonV1.TaskResponse,
 		for k, v := range ts.Fields {
 			fields[field.Name(k)] = v
 		}
+		groups := c.groupAgg.Size()
 		c.groupAgg.Aggregate(series.NewGroupedIterator(ts.Tags, fields))
+		if c.groupAgg.Size() > groups {
+			// new group created, track memory of grouping key and field aggregates.
+			if err := c.memoryTracker.Consume(int64(len(ts.Tags)) + c.groupMemSize); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
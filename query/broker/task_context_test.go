@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -210,9 +211,10 @@ func TestTaskContext_handleTaskResponse(t *testing.T) {
 	tsList2 := &protoCommonV1.TimeSeriesList{}
 	payload2, _ := tsList2.Marshal()
 	cases := []struct {
-		name    string
-		resp    *protoCommonV1.TaskResponse
-		wantErr bool
+		name        string
+		resp        *protoCommonV1.TaskResponse
+		memoryLimit int64
+		wantErr     bool
 	}{
 		{
 			name: "resp with err",
@@ -256,6 +258,22 @@ func TestTaskContext_handleTaskResponse(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "payload exceeds memory limit",
+			resp: &protoCommonV1.TaskResponse{
+				Payload: payloadWithField,
+			},
+			memoryLimit: 1,
+			wantErr:     true,
+		},
+		{
+			name: "grouping exceeds memory limit",
+			resp: &protoCommonV1.TaskResponse{
+				Payload: payloadWithField,
+			},
+			memoryLimit: int64(len(payloadWithField)) + 1,
+			wantErr:     true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -266,12 +284,16 @@ func TestTaskContext_handleTaskResponse(t *testing.T) {
 				tolerantNotFounds: 10,
 				aggregatorSpecs:   make(map[string]*protoCommonV1.AggregatorSpec),
 				stmtQuery:         &stmt.Query{Interval: timeutil.Interval(10 * timeutil.OneSecond)},
+				memoryTracker:     flow.NewMemoryTracker("query", tt.memoryLimit, nil),
 			}
 
 			err := ctx.handleTaskResponse(tt.resp, "leaf")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("handleTaskResponse() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.memoryLimit > 0 {
+				assert.ErrorIs(t, err, flow.ErrMemoryLimitExceeded)
+			}
 		})
 	}
 }
@@ -49,6 +49,8 @@ type LeafExecuteContext struct {
 
 	ServerFactory rpc.TaskServerFactory
 	Req           *protoCommonV1.TaskRequest
+	SQL           string            // sql text of query(for slow query log)
+	Class         models.QueryClass // workload class of query, selects executor pool of database
 
 	GroupingCtx *LeafGroupingContext
 	ReduceCtx   *LeafReduceContext
@@ -117,6 +119,8 @@ func (ctx *LeafExecuteContext) waitCollectGroupingTagsCompleted() (err error) {
 func (ctx *LeafExecuteContext) SendResponse(err error) {
 	if ctx.completed.CAS(false, true) {
 		defer ctx.StorageExecuteCtx.Release()
+		// return memory used by query after response sent
+		defer ctx.StorageExecuteCtx.MemoryTracker().ReleaseAll()
 
 		if err != nil {
 			// send error msg
@@ -142,12 +146,19 @@ func (ctx *LeafExecuteContext) SendResponse(err error) {
 func (ctx *LeafExecuteContext) sendResponse(resultData [][]byte, err error) {
 	var stats []byte
 	var errMsg string
-	if ctx.StorageExecuteCtx.Query.Explain {
-		stats = encoding.JSONMarshal(ctx.Tracker.GetStats())
+	if leafStats := ctx.Tracker.GetStats(); leafStats != nil {
+		if !ctx.StorageExecuteCtx.Query.Explain {
+			// only sends scan stats(cheap) for slow query log, stages for explain query
+			summary := *leafStats
+			summary.Stages = nil
+			leafStats = &summary
+		}
+		stats = encoding.JSONMarshal(leafStats)
 	}
 	if err != nil {
 		errMsg = err.Error()
 	}
+	defer ctx.recordSlowQuery(err)
 	// send result to upstream receivers
 	for idx, receiver := range ctx.LeafNode.Receivers {
 		stream := ctx.ServerFactory.GetStream(receiver.Indicator())
@@ -175,3 +186,33 @@ func (ctx *LeafExecuteContext) sendResponse(resultData [][]byte, err error) {
 		}
 	}
 }
+
+// recordSlowQuery records the query into slow query log if its cost exceeds the threshold.
+func (ctx *LeafExecuteContext) recordSlowQuery(err error) {
+	if trackerpkg.SlowQueryThreshold() <= 0 || ctx.TaskCtx == nil {
+		return
+	}
+	cost := time.Since(ctx.TaskCtx.Start)
+	if !trackerpkg.IsSlowQuery(cost) {
+		return
+	}
+	req := &models.SlowRequest{
+		Role:      "storage",
+		RequestID: ctx.Req.RequestID,
+		SQL:       ctx.SQL,
+		Start:     ctx.TaskCtx.Start.UnixNano(),
+		Cost:      cost.Nanoseconds(),
+		LeafStats: ctx.Tracker.GetStats(),
+	}
+	if ctx.Database != nil {
+		req.DB = ctx.Database.Name()
+	}
+	if len(ctx.LeafNode.Receivers) > 0 {
+		req.Caller = ctx.LeafNode.Receivers[0].Indicator()
+	}
+	if err != nil {
+		req.ErrMsg = err.Error()
+	}
+	req.ScannedSeries, req.ScannedFamilies, req.ScannedBytes = ctx.TaskCtx.ScanStats.Load()
+	trackerpkg.RecordSlowQuery(req)
+}
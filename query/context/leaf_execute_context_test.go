@@ -24,11 +24,13 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/rpc"
@@ -100,6 +102,46 @@ func TestLeafExecuteContext_SendResponse(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "send scan stats without stages if not explain",
+			in:   nil,
+			prepare: func(ctx *LeafExecuteContext) {
+				ctx.TaskCtx.ScanStats.Add(10, 1, 80)
+				ctx.Tracker.AddStage(&models.StageStats{Identifier: "stage"})
+				taskServerFct.EXPECT().GetStream(gomock.Any()).Return(stream)
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					stats := &models.LeafNodeStats{}
+					assert.NoError(t, encoding.JSONUnmarshal(resp.Stats, stats))
+					assert.Equal(t, int64(10), stats.ScannedSeries)
+					assert.Empty(t, stats.Stages)
+					return nil
+				})
+			},
+		},
+		{
+			name: "record slow query",
+			in:   fmt.Errorf("err"),
+			prepare: func(ctx *LeafExecuteContext) {
+				tracker.SetSlowQueryLog(time.Nanosecond, 10)
+				ctx.SQL = "select f from cpu"
+				ctx.TaskCtx.Start = time.Now()
+				ctx.TaskCtx.ScanStats.Add(10, 1, 80)
+				db.EXPECT().Name().Return("test_db")
+				taskServerFct.EXPECT().GetStream(gomock.Any()).Return(stream)
+				stream.EXPECT().Send(gomock.Any()).Return(nil)
+			},
+			assert: func() {
+				defer tracker.SetSlowQueryLog(0, 0)
+				slowQueries := tracker.GetSlowQueries()
+				assert.Len(t, slowQueries, 1)
+				assert.Equal(t, "storage", slowQueries[0].Role)
+				assert.Equal(t, "select f from cpu", slowQueries[0].SQL)
+				assert.Equal(t, "test_db", slowQueries[0].DB)
+				assert.Equal(t, "err", slowQueries[0].ErrMsg)
+				assert.Equal(t, int64(10), slowQueries[0].ScannedSeries)
+				assert.Equal(t, int64(80), slowQueries[0].ScannedBytes)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -46,18 +46,26 @@ func NewLeafReduceContext(storageExecuteCtx *flow.StorageExecuteContext, leafGro
 	}
 }
 
-// Reduce reduces the down sampling aggregator's result.
-func (ctx *LeafReduceContext) Reduce(it series.GroupedIterator) {
+// Reduce reduces the down sampling aggregator's result,
+// returns ErrMemoryLimitExceeded if memory used by new group exceeds the limit.
+func (ctx *LeafReduceContext) Reduce(it series.GroupedIterator) error {
 	ctx.lock.Lock()
 	defer ctx.lock.Unlock()
 
+	storageExecuteCtx := ctx.storageExecuteCtx
 	if ctx.reduceAgg == nil {
-		storageExecuteCtx := ctx.storageExecuteCtx
 		ctx.reduceAgg = aggregation.NewGroupingAggregator(storageExecuteCtx.Query.Interval,
 			storageExecuteCtx.Query.IntervalRatio, storageExecuteCtx.Query.TimeRange, storageExecuteCtx.AggregatorSpecs)
 	}
 
+	groups := ctx.reduceAgg.Size()
 	ctx.reduceAgg.Aggregate(it)
+	if ctx.reduceAgg.Size() > groups {
+		// new group created, track memory of grouping key and field aggregates.
+		size := int64(len(it.Tags())) + storageExecuteCtx.AggregatorSpecs.MemSize(storageExecuteCtx.Query.PointCount())
+		return storageExecuteCtx.MemoryTracker().Consume(size)
+	}
+	return nil
 }
 
 // BuildResultSet returns the result set from reduce aggregator based on receivers.
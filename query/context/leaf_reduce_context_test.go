@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -43,9 +44,35 @@ func TestLeafReduceContext_Reduce(t *testing.T) {
 	ctx := NewLeafReduceContext(storageCtx, &LeafGroupingContext{})
 
 	it := series.NewMockGroupedIterator(ctrl)
+	it.EXPECT().Tags().Return("").Times(2)
+	it.EXPECT().HasNext().Return(false)
+	assert.NoError(t, ctx.Reduce(it))
+	// same group
 	it.EXPECT().Tags().Return("")
 	it.EXPECT().HasNext().Return(false)
-	ctx.Reduce(it)
+	assert.NoError(t, ctx.Reduce(it))
+}
+
+func TestLeafReduceContext_Reduce_MemoryLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	spec := aggregation.NewAggregatorSpec("f", field.SumField)
+	spec.AddFunctionType(function.Sum)
+	storageCtx := &flow.StorageExecuteContext{
+		TaskCtx: &flow.TaskContext{MemoryTracker: flow.NewMemoryTracker("query", 100, nil)},
+		Query: &stmtpkg.Query{
+			Interval:  timeutil.Interval(timeutil.OneMinute),
+			TimeRange: timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+		},
+		AggregatorSpecs: aggregation.AggregatorSpecs{spec},
+	}
+	ctx := NewLeafReduceContext(storageCtx, &LeafGroupingContext{})
+
+	it := series.NewMockGroupedIterator(ctrl)
+	it.EXPECT().Tags().Return("host").Times(2)
+	it.EXPECT().HasNext().Return(false)
+	assert.ErrorIs(t, ctx.Reduce(it), flow.ErrMemoryLimitExceeded)
 }
 
 func TestLeafReduceContext_BuildResultSet(t *testing.T) {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -129,9 +130,11 @@ func (p *leafTaskProcessor) process(
 		return fmt.Errorf("%w: %s", ErrNoSendStream, curLeaf.Parent)
 	}
 
+	// query is foreground load, slow down background io(compaction etc.) when it's busy.
+	kv.MarkForegroundLoad(1)
 	switch req.RequestType {
 	case protoCommonV1.RequestType_Data:
-		if err := p.processDataSearch(ctx, db, req, curLeaf); err != nil {
+		if err := p.processDataSearch(ctx, db, req, curLeaf, &physicalPlan); err != nil {
 			p.statistics.MetricQueryFailures.Incr()
 			return err
 		}
@@ -196,6 +199,7 @@ func (p *leafTaskProcessor) processDataSearch(
 	db tsdb.Database,
 	req *protoCommonV1.TaskRequest,
 	leafNode *models.Leaf,
+	physicalPlan *models.PhysicalPlan,
 ) error {
 	stmtQuery := stmt.Query{}
 	if err := stmtQuery.UnmarshalJSON(req.Payload); err != nil {
@@ -205,6 +209,8 @@ func (p *leafTaskProcessor) processDataSearch(
 	// execute leaf pipeline
 	tracker := trackerpkg.NewStageTracker(ctx)
 	leafExecuteCtx := context.NewLeafExecuteContext(ctx, tracker, &stmtQuery, req, p.taskServerFactory, leafNode, db)
+	leafExecuteCtx.SQL = physicalPlan.SQL
+	leafExecuteCtx.Class = physicalPlan.Class
 
 	pipeline := newExecutePipelineFn(tracker, func(err error) {
 		// remove pipeline from cache after execute completed
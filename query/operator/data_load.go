@@ -37,6 +37,8 @@ type dataLoad struct {
 	rs         flow.FilterResultSet
 
 	foundSeries uint64
+	loadedBytes int64
+	err         error
 }
 
 // NewDataLoad creates a dataLoad instance.
@@ -71,9 +73,23 @@ func (op *dataLoad) Execute() error {
 	queryIntervalRatio := op.segmentRS.IntervalRatio
 	baseSlot := op.segmentRS.BaseTime
 
+	memoryTracker := op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.MemoryTracker()
+
 	// load field series data by series ids
 	op.executeCtx.Decoder = encoding.GetTSDDecoder()
 	op.executeCtx.DownSampling = func(slotRange timeutil.SlotRange, lowSeriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
+		if op.err != nil {
+			// memory limit exceeded, ignore the remaining data.
+			return
+		}
+		// track memory of decoded field block, release it after down sampling.
+		size := int64(slotRange.End-slotRange.Start+1) * 8
+		if op.err = memoryTracker.Consume(size); op.err != nil {
+			return
+		}
+		defer memoryTracker.Release(size)
+		op.loadedBytes += size
+
 		var agg aggregation.FieldAggregator
 		seriesAggregator := op.executeCtx.GetSeriesAggregator(lowSeriesIdx, fieldIdx)
 
@@ -95,7 +111,10 @@ func (op *dataLoad) Execute() error {
 	loader.Load(op.executeCtx)
 	// release tsd decoder back to pool for re-use.
 	encoding.ReleaseTSDDecoder(op.executeCtx.Decoder)
-	return nil
+	if op.foundSeries > 0 {
+		op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.ScanStats().Add(int64(op.foundSeries), 1, op.loadedBytes)
+	}
+	return op.err
 }
 
 // Identifier returns identifier value of data load operator.
@@ -82,8 +82,35 @@ func TestDataLoader_Execute(t *testing.T) {
 			ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter)
 			ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter)
 		})
+		taskCtx := &flow.TaskContext{}
+		ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx = taskCtx
+		defer func() {
+			ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx = nil
+		}()
 		op := NewDataLoad(ctx, segment, rs)
 		assert.NoError(t, op.Execute())
+		series, families, bytes := taskCtx.ScanStats.Load()
+		assert.Equal(t, int64(1), series)
+		assert.Equal(t, int64(1), families)
+		assert.Equal(t, int64(16), bytes)
+	})
+	t.Run("memory limit exceeded", func(t *testing.T) {
+		tracker := flow.NewMemoryTracker("query", 10, nil)
+		ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx = &flow.TaskContext{MemoryTracker: tracker}
+		defer func() {
+			ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx = nil
+		}()
+		loader := flow.NewMockDataLoader(ctrl)
+		rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2))
+		rs.EXPECT().Load(gomock.Any()).Return(loader)
+		getter := encoding.NewMockTSDValueGetter(ctrl)
+		loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+			ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 10}, 0, 0, getter)
+			ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter)
+		})
+		op := NewDataLoad(ctx, segment, rs)
+		assert.ErrorIs(t, op.Execute(), flow.ErrMemoryLimitExceeded)
+		assert.Zero(t, tracker.Used())
 	})
 }
 
@@ -40,7 +40,8 @@ func (op *groupingTagsLookup) Execute() error {
 	} else {
 		op.executeCtx.PrepareAggregatorWithoutGrouping()
 	}
-	return nil
+	// track the memory allocated for grouping aggregators
+	return op.executeCtx.TrackMemory()
 }
 
 // Identifier returns identifier string value of grouping tags lookup operator.
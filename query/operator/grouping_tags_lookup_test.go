@@ -59,6 +59,12 @@ func TestGroupingTagsLookup_Execute(t *testing.T) {
 		op := NewGroupingTagsLookup(dataLoadCtx)
 		assert.NoError(t, op.Execute())
 	})
+	t.Run("memory limit exceeded", func(t *testing.T) {
+		ctx.GroupingContext = nil
+		ctx.StorageExecuteCtx.TaskCtx = &flow.TaskContext{MemoryTracker: flow.NewMemoryTracker("query", 1, nil)}
+		op := NewGroupingTagsLookup(dataLoadCtx)
+		assert.ErrorIs(t, op.Execute(), flow.ErrMemoryLimitExceeded)
+	})
 }
 
 func TestGroupingTagsLookup_Identifier(t *testing.T) {
@@ -40,7 +40,7 @@ func NewLeafReduce(leafExecuteCtx *context.LeafExecuteContext, executeCtx *flow.
 func (op *leafReduce) Execute() error {
 	if op.executeCtx.PendingDataLoadTasks.Load() == 0 {
 		// after load, need to reduce the aggregator's result to query flow.
-		op.executeCtx.Reduce(op.leafExecuteCtx.ReduceCtx.Reduce)
+		return op.executeCtx.Reduce(op.leafExecuteCtx.ReduceCtx.Reduce)
 	}
 	return nil
 }
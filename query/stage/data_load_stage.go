@@ -41,7 +41,7 @@ func NewDataLoadStage(leafExecuteCtx *context.LeafExecuteContext,
 	return &dataLoadStage{
 		baseStage: baseStage{
 			ctx:       leafExecuteCtx.TaskCtx.Ctx,
-			execPool:  leafExecuteCtx.Database.ExecutorPool().Scanner,
+			execPool:  leafExecuteCtx.Database.ExecutorPool(leafExecuteCtx.Class).Scanner,
 			stageType: DataLoad,
 		},
 		leafExecuteCtx: leafExecuteCtx,
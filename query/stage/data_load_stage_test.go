@@ -36,7 +36,7 @@ func TestDataLoadStage_Plan(t *testing.T) {
 	defer ctrl.Finish()
 
 	db := tsdb.NewMockDatabase(ctrl)
-	db.EXPECT().ExecutorPool().Return(&tsdb.ExecutorPool{}).AnyTimes()
+	db.EXPECT().ExecutorPool(gomock.Any()).Return(&tsdb.ExecutorPool{}).AnyTimes()
 	rs := flow.NewMockFilterResultSet(ctrl)
 
 	now := timeutil.Now()
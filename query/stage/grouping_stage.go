@@ -40,7 +40,7 @@ func NewGroupingStage(leafExecuteCtx *context.LeafExecuteContext, executeCtx *fl
 	return &groupingStage{
 		baseStage: baseStage{
 			ctx:       leafExecuteCtx.TaskCtx.Ctx,
-			execPool:  leafExecuteCtx.Database.ExecutorPool().Grouping,
+			execPool:  leafExecuteCtx.Database.ExecutorPool(leafExecuteCtx.Class).Grouping,
 			stageType: Grouping,
 		},
 		leafExecuteCtx: leafExecuteCtx,
@@ -36,12 +36,13 @@ func TestGroupingStage(t *testing.T) {
 	defer ctrl.Finish()
 
 	db := tsdb.NewMockDatabase(ctrl)
-	db.EXPECT().ExecutorPool().Return(&tsdb.ExecutorPool{}).AnyTimes()
+	db.EXPECT().ExecutorPool(models.DashboardQuery).Return(&tsdb.ExecutorPool{}).AnyTimes()
 	dataLoadCtx := &flow.DataLoadContext{}
 	shard := tsdb.NewMockShard(ctrl)
 	stage := NewGroupingStage(&context.LeafExecuteContext{
 		TaskCtx:  &flow.TaskContext{},
 		Database: db,
+		Class:    models.DashboardQuery,
 		GroupingCtx: context.NewLeafGroupingContext(&context.LeafExecuteContext{
 			StorageExecuteCtx: &flow.StorageExecuteContext{Query: &stmtpkg.Query{}},
 			Database:          db,
@@ -57,7 +57,7 @@ func TestMetadataLookupStage(t *testing.T) {
 
 	shard := tsdb.NewMockShard(ctrl)
 	db.EXPECT().GetShard(gomock.Any()).Return(shard, true).MaxTimes(2)
-	db.EXPECT().ExecutorPool().Return(&tsdb.ExecutorPool{}).MaxTimes(2)
+	db.EXPECT().ExecutorPool(gomock.Any()).Return(&tsdb.ExecutorPool{}).MaxTimes(2)
 	assert.NotEmpty(t, s.NextStages())
 
 	assert.Equal(t, "Metadata Lookup", s.Identifier())
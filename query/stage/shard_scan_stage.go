@@ -46,7 +46,7 @@ func NewShardScanStage(leafExecuteCtx *context.LeafExecuteContext,
 	return &shardScanStage{
 		baseStage: baseStage{
 			ctx:       leafExecuteCtx.TaskCtx.Ctx,
-			execPool:  leafExecuteCtx.Database.ExecutorPool().Filtering,
+			execPool:  leafExecuteCtx.Database.ExecutorPool(leafExecuteCtx.Class).Filtering,
 			stageType: ShardScan,
 		},
 		leafExecuteCtx:  leafExecuteCtx,
@@ -60,7 +60,7 @@ func TestShardScanStage(t *testing.T) {
 	ctx.GroupingCtx = contextpkg.NewLeafGroupingContext(ctx)
 	shard := tsdb.NewMockShard(ctrl)
 	shardExecuteCtx := flow.NewShardExecuteContext(storageCtx)
-	db.EXPECT().ExecutorPool().Return(&tsdb.ExecutorPool{}).AnyTimes()
+	db.EXPECT().ExecutorPool(gomock.Any()).Return(&tsdb.ExecutorPool{}).AnyTimes()
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
 	s := NewShardScanStage(ctx, shardExecuteCtx, shard)
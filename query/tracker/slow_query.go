@@ -0,0 +1,178 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tracker
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	// slowQueryThreshold represents the cost threshold of slow query, 0 means disable slow query log.
+	slowQueryThreshold atomic.Duration
+	// statsSampleRate represents the ratio of queries which collect execution stats for slow query log.
+	statsSampleRate atomic.Float64
+	slowQueries     = newSlowQueryHistory(0)
+)
+
+// for testing
+var (
+	randFloat64Fn = rand.Float64
+)
+
+// SetSlowQueryLog sets the threshold of slow query and the max number of recent slow queries kept in memory.
+func SetSlowQueryLog(threshold time.Duration, historySize int) {
+	slowQueryThreshold.Store(threshold)
+	slowQueries.resize(historySize)
+}
+
+// SetSlowQueryStatsSampleRate sets the ratio of queries which collect execution stats for slow query log.
+func SetSlowQueryStatsSampleRate(rate float64) {
+	statsSampleRate.Store(rate)
+}
+
+// SampleSlowQueryStats returns if the query should collect execution stats for slow query log,
+// collecting stats costs every node of query, so only samples a part of queries.
+func SampleSlowQueryStats() bool {
+	if slowQueryThreshold.Load() <= 0 {
+		return false
+	}
+	rate := statsSampleRate.Load()
+	return rate > 0 && randFloat64Fn() < rate
+}
+
+// SlowQueryThreshold returns the cost threshold of slow query, 0 means slow query log disabled.
+func SlowQueryThreshold() time.Duration {
+	return slowQueryThreshold.Load()
+}
+
+// IsSlowQuery returns if query's cost exceeds the slow query threshold.
+func IsSlowQuery(cost time.Duration) bool {
+	threshold := slowQueryThreshold.Load()
+	return threshold > 0 && cost >= threshold
+}
+
+// RecordSlowQuery writes slow query into slow log file, and keeps it in recent slow query list.
+func RecordSlowQuery(req *models.SlowRequest) {
+	fields := []zap.Field{
+		logger.String("role", req.Role),
+		logger.String("requestId", req.RequestID),
+		logger.String("db", req.DB),
+		logger.String("sql", req.SQL),
+		logger.String("caller", req.Caller),
+		logger.String("cost", time.Duration(req.Cost).String()),
+		logger.Int64("scannedSeries", req.ScannedSeries),
+		logger.Int64("scannedFamilies", req.ScannedFamilies),
+		logger.Int64("scannedBytes", req.ScannedBytes),
+	}
+	if req.ErrMsg != "" {
+		fields = append(fields, logger.String("error", req.ErrMsg))
+	}
+	switch {
+	case req.Stats != nil:
+		fields = append(fields, logger.String("stats", string(encoding.JSONMarshal(req.Stats))))
+	case req.LeafStats != nil:
+		fields = append(fields, logger.String("stats", string(encoding.JSONMarshal(req.LeafStats))))
+	}
+	logger.SlowQueryLog.Warn("slow query", fields...)
+	slowQueries.add(req)
+}
+
+// GetSlowQueries returns recent slow queries of current node, the newest first.
+func GetSlowQueries() []*models.SlowRequest {
+	return slowQueries.list()
+}
+
+// slowQueryHistory keeps recent slow queries using ring buffer.
+type slowQueryHistory struct {
+	queries []*models.SlowRequest
+	next    int
+	count   int
+	mutex   sync.Mutex
+}
+
+// newSlowQueryHistory creates a slow query history with max size.
+func newSlowQueryHistory(size int) *slowQueryHistory {
+	return &slowQueryHistory{queries: make([]*models.SlowRequest, size)}
+}
+
+// resize resets the history with new max size, keeps the newest queries.
+func (h *slowQueryHistory) resize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	queries := h.listWithoutLock()
+	if len(queries) > size {
+		queries = queries[:size]
+	}
+	h.queries = make([]*models.SlowRequest, size)
+	h.next = 0
+	h.count = 0
+	// add from oldest to newest
+	for i := len(queries) - 1; i >= 0; i-- {
+		h.addWithoutLock(queries[i])
+	}
+}
+
+// add adds a slow query into history, overwrites the oldest one if full.
+func (h *slowQueryHistory) add(req *models.SlowRequest) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.addWithoutLock(req)
+}
+
+// list returns the slow queries in history, the newest first.
+func (h *slowQueryHistory) list() []*models.SlowRequest {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.listWithoutLock()
+}
+
+func (h *slowQueryHistory) addWithoutLock(req *models.SlowRequest) {
+	size := len(h.queries)
+	if size == 0 {
+		return
+	}
+	h.queries[h.next] = req
+	h.next = (h.next + 1) % size
+	if h.count < size {
+		h.count++
+	}
+}
+
+func (h *slowQueryHistory) listWithoutLock() []*models.SlowRequest {
+	size := len(h.queries)
+	rs := make([]*models.SlowRequest, 0, h.count)
+	for i := 1; i <= h.count; i++ {
+		rs = append(rs, h.queries[(h.next-i+size)%size])
+	}
+	return rs
+}
@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tracker
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestSlowQuery(t *testing.T) {
+	defer SetSlowQueryLog(0, 0)
+
+	SetSlowQueryLog(0, 2)
+	assert.Zero(t, SlowQueryThreshold())
+	assert.False(t, IsSlowQuery(time.Hour))
+
+	SetSlowQueryLog(time.Second, 2)
+	assert.Equal(t, time.Second, SlowQueryThreshold())
+	assert.False(t, IsSlowQuery(time.Millisecond))
+	assert.True(t, IsSlowQuery(time.Second))
+
+	RecordSlowQuery(&models.SlowRequest{RequestID: "1", Role: "broker", Stats: models.NewQueryStats()})
+	RecordSlowQuery(&models.SlowRequest{RequestID: "2", Role: "storage", LeafStats: &models.LeafNodeStats{}})
+	RecordSlowQuery(&models.SlowRequest{RequestID: "3", ErrMsg: "err"})
+	rs := GetSlowQueries()
+	assert.Len(t, rs, 2)
+	assert.Equal(t, "3", rs[0].RequestID)
+	assert.Equal(t, "2", rs[1].RequestID)
+
+	// shrink history, keep newest
+	SetSlowQueryLog(time.Second, 1)
+	rs = GetSlowQueries()
+	assert.Len(t, rs, 1)
+	assert.Equal(t, "3", rs[0].RequestID)
+
+	// disable history
+	SetSlowQueryLog(time.Second, -1)
+	RecordSlowQuery(&models.SlowRequest{RequestID: "4"})
+	assert.Empty(t, GetSlowQueries())
+}
+
+func TestSampleSlowQueryStats(t *testing.T) {
+	defer func() {
+		SetSlowQueryLog(0, 0)
+		SetSlowQueryStatsSampleRate(0)
+		randFloat64Fn = rand.Float64
+	}()
+	randFloat64Fn = func() float64 {
+		return 0.5
+	}
+	// slow query log disabled
+	SetSlowQueryStatsSampleRate(1)
+	assert.False(t, SampleSlowQueryStats())
+
+	SetSlowQueryLog(time.Second, 2)
+	assert.True(t, SampleSlowQueryStats())
+	SetSlowQueryStatsSampleRate(0.1)
+	assert.False(t, SampleSlowQueryStats())
+	SetSlowQueryStatsSampleRate(0)
+	assert.False(t, SampleSlowQueryStats())
+}
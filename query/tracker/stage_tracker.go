@@ -110,11 +110,16 @@ func (s *StageTracker) Complete() {
 	defer s.mutex.Unlock()
 
 	end := time.Now()
+	scannedSeries, scannedFamilies, scannedBytes := s.taskCtx.ScanStats.Load()
 	s.stats = &models.LeafNodeStats{
-		Start:     s.taskCtx.Start.UnixNano(),
-		End:       end.UnixNano(),
-		TotalCost: end.Sub(s.taskCtx.Start).Nanoseconds(),
-		Stages:    s.getStages(),
+		Start:           s.taskCtx.Start.UnixNano(),
+		End:             end.UnixNano(),
+		TotalCost:       end.Sub(s.taskCtx.Start).Nanoseconds(),
+		PeakMemory:      s.taskCtx.MemoryTracker.Peak(),
+		ScannedSeries:   scannedSeries,
+		ScannedFamilies: scannedFamilies,
+		ScannedBytes:    scannedBytes,
+		Stages:          s.getStages(),
 	}
 }
 
@@ -47,6 +47,14 @@ func TestStageTracker(t *testing.T) {
 	tracker.SetGroupingCollectStageValues(func(stage *models.StageStats) {
 		stage.Identifier = "test"
 	})
+	assert.NoError(t, taskCtx.MemoryTracker.Consume(100))
+	taskCtx.MemoryTracker.Release(100)
+	taskCtx.ScanStats.Add(10, 2, 800)
 	tracker.Complete()
 	assert.Len(t, tracker.GetStages(), 2)
+	assert.Equal(t, int64(100), tracker.GetStats().PeakMemory)
+	assert.Equal(t, int64(10), tracker.GetStats().ScannedSeries)
+	assert.Equal(t, int64(2), tracker.GetStats().ScannedFamilies)
+	assert.Equal(t, int64(800), tracker.GetStats().ScannedBytes)
+	taskCtx.Release()
 }
@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"sync"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+const (
+	// appendTimeSampleInterval represents the min. interval(ms) between two samples of append time.
+	appendTimeSampleInterval = timeutil.OneSecond
+	// maxAppendTimeSamples represents the max. samples kept, covers the writes of recent 10 minutes.
+	maxAppendTimeSamples = 600
+)
+
+// appendTimeSample represents the sequence of write ahead log appended at the time.
+type appendTimeSample struct {
+	seq       int64
+	timestamp int64
+}
+
+// appendTimes samples the append time of write ahead log, it is used for measuring the replica staleness of follower,
+// which is the age of first write ahead log entry that follower hasn't acknowledged.
+type appendTimes struct {
+	samples []appendTimeSample // ring buffer, ordered by sequence
+	head    int                // index of the oldest sample
+	size    int
+
+	mutex sync.Mutex
+}
+
+// newAppendTimes creates an append time sampler.
+func newAppendTimes() *appendTimes {
+	return &appendTimes{
+		samples: make([]appendTimeSample, maxAppendTimeSamples),
+	}
+}
+
+// append records the sequence appended at the time, skips it if last sample is recent.
+func (a *appendTimes) append(seq, timestamp int64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.size > 0 {
+		last := a.samples[(a.head+a.size-1)%len(a.samples)]
+		if seq <= last.seq || timestamp-last.timestamp < appendTimeSampleInterval {
+			return
+		}
+	}
+	if a.size == len(a.samples) {
+		// drop the oldest sample
+		a.head = (a.head + 1) % len(a.samples)
+		a.size--
+	}
+	a.samples[(a.head+a.size)%len(a.samples)] = appendTimeSample{seq: seq, timestamp: timestamp}
+	a.size++
+}
+
+// staleness returns the upper bound of staleness(ms) for the replica which acknowledged the sequence,
+// returns 0 if replica has acknowledged all appended sequence, returns -1 if unknown(append time isn't sampled).
+// The first sequence not acknowledged(ack+1) is appended at/after the latest sample which sequence <= ack+1.
+func (a *appendTimes) staleness(ack, appended, now int64) int64 {
+	if ack >= appended {
+		return 0
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for i := a.size - 1; i >= 0; i-- {
+		sample := a.samples[(a.head+i)%len(a.samples)]
+		if sample.seq <= ack+1 {
+			if now < sample.timestamp {
+				return 0
+			}
+			return now - sample.timestamp
+		}
+	}
+	return -1
+}
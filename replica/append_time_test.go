@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package replica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestAppendTimes_append(t *testing.T) {
+	a := newAppendTimes()
+	now := timeutil.Now()
+	a.append(10, now)
+	// sequence not increased
+	a.append(10, now+timeutil.OneMinute)
+	// sample too recent
+	a.append(11, now+10)
+	assert.Equal(t, 1, a.size)
+	// drop the oldest sample if full
+	for i := 1; i <= maxAppendTimeSamples; i++ {
+		a.append(int64(10+i), now+int64(i)*appendTimeSampleInterval)
+	}
+	assert.Equal(t, maxAppendTimeSamples, a.size)
+	assert.Equal(t, int64(11), a.samples[a.head].seq)
+}
+
+func TestAppendTimes_staleness(t *testing.T) {
+	a := newAppendTimes()
+	now := timeutil.Now()
+	// caught up
+	assert.Equal(t, int64(0), a.staleness(10, 10, now))
+	// not sampled
+	assert.Equal(t, int64(-1), a.staleness(5, 10, now))
+	a.append(10, now-10*timeutil.OneSecond)
+	a.append(20, now-5*timeutil.OneSecond)
+	a.append(30, now-timeutil.OneSecond)
+	// acknowledged sequence older than all samples
+	assert.Equal(t, int64(-1), a.staleness(5, 30, now))
+	// first sequence not acknowledged is sampled
+	assert.Equal(t, 10*timeutil.OneSecond, a.staleness(9, 30, now))
+	// first sequence not acknowledged is appended after the sample
+	assert.Equal(t, 10*timeutil.OneSecond, a.staleness(15, 30, now))
+	assert.Equal(t, 5*timeutil.OneSecond, a.staleness(25, 30, now))
+	// clock moves backward
+	assert.Equal(t, int64(0), a.staleness(25, 30, now-10*timeutil.OneMinute))
+}
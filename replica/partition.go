@@ -81,9 +81,10 @@ type partition struct {
 	shard         tsdb.Shard
 	family        tsdb.DataFamily
 
-	peers    map[models.NodeID]ReplicatorPeer
-	cliFct   rpc.ClientStreamFactory
-	stateMgr storage.StateManager
+	peers       map[models.NodeID]ReplicatorPeer
+	appendTimes *appendTimes // sampled append time of write ahead log, for measuring staleness of replica
+	cliFct      rpc.ClientStreamFactory
+	stateMgr    storage.StateManager
 
 	mutex sync.Mutex
 
@@ -115,6 +116,7 @@ func NewPartition(
 		cliFct:        cliFct,
 		stateMgr:      stateMgr,
 		peers:         make(map[models.NodeID]ReplicatorPeer),
+		appendTimes:   newAppendTimes(),
 		statistics:    metrics.NewStorageWriteAheadLogStatistics(shard.Database().Name(), shard.ShardID().String()),
 		logger:        logger.GetLogger("Replica", "Partition"),
 	}
@@ -206,6 +208,7 @@ func (p *partition) WriteLog(msg []byte) error {
 		p.statistics.WriteWALFailures.Incr()
 		return err
 	}
+	p.appendTimes.append(p.log.Queue().AppendedSeq(), timeutil.Now())
 	p.statistics.WriteWAL.Incr()
 	return nil
 }
@@ -277,6 +280,8 @@ func (p *partition) Stop() {
 // getReplicaState returns each family's log replica state.
 func (p *partition) getReplicaState() models.FamilyLogReplicaState {
 	replicators := p.log.ConsumerGroupNames()
+	appended := p.log.Queue().AppendedSeq()
+	now := timeutil.Now()
 	var stateOfReplicators []models.ReplicaPeerState
 	for _, name := range replicators {
 		fanout, err := p.log.GetOrCreateConsumerGroup(name)
@@ -284,11 +289,13 @@ func (p *partition) getReplicaState() models.FamilyLogReplicaState {
 			p.logger.Error("get fan out error when get replica state, ignore it")
 			continue
 		}
+		ack := fanout.AcknowledgedSeq()
 		peerState := models.ReplicaPeerState{
 			Replicator: name,
 			Consume:    fanout.ConsumedSeq(),
-			ACK:        fanout.AcknowledgedSeq(),
+			ACK:        ack,
 			Pending:    fanout.Pending(),
+			Staleness:  p.appendTimes.staleness(ack, appended, now),
 		}
 		nodeID := models.ParseNodeID(name)
 		if peer, ok := p.getReplicatorRunner(nodeID); ok {
@@ -303,7 +310,7 @@ func (p *partition) getReplicaState() models.FamilyLogReplicaState {
 	return models.FamilyLogReplicaState{
 		ShardID:     p.shardID,
 		FamilyTime:  timeutil.FormatTimestamp(p.family.FamilyTime(), timeutil.DataTimeFormat2),
-		Append:      p.log.Queue().AppendedSeq(),
+		Append:      appended,
 		Replicators: stateOfReplicators,
 	}
 }
@@ -141,6 +141,60 @@ func TestPartition_BuildReplicaForFollower(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPartition_BuildReplicaForFollower_SnapshotBootstrap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newReplicatorPeerFn = NewReplicatorPeer
+		ctrl.Finish()
+	}()
+	database := tsdb.NewMockDatabase(ctrl)
+	database.EXPECT().Name().Return("test").AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	shard.EXPECT().Database().Return(database).AnyTimes()
+	family := tsdb.NewMockDataFamily(ctrl)
+	family.EXPECT().FamilyTime().Return(timeutil.Now()).AnyTimes()
+	family.EXPECT().TimeRange().Return(timeutil.TimeRange{}).AnyTimes()
+	// family installed from snapshot inherits the sequence of leader which snapshot contains
+	family.EXPECT().AckSequence(int32(2), gomock.Any()).DoAndReturn(func(_ int32, fn func(seq int64)) {
+		fn(100)
+	})
+	family.EXPECT().Retain()
+	peer := NewMockReplicatorPeer(ctrl)
+	peer.EXPECT().Startup()
+	var replicator Replicator
+	newReplicatorPeerFn = func(r Replicator) ReplicatorPeer {
+		replicator = r
+		return peer
+	}
+
+	log, err := queue.NewFanOutQueue(t.TempDir(), 1024*1024)
+	assert.NoError(t, err)
+	defer log.Close()
+	p := NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
+	// new replica hasn't any write ahead log
+	assert.Equal(t, int64(-1), p.ReplicaAckIndex())
+	// leader resets append index of new replica based on its smallest ack index
+	p.ResetReplicaIndex(101)
+	assert.Equal(t, int64(100), p.ReplicaAckIndex())
+
+	assert.NoError(t, p.BuildReplicaForFollower(2, 1))
+	// local replicator starts from the sequence of snapshot, data in snapshot won't be written again
+	assert.Equal(t, int64(100), replicator.AckIndex())
+	assert.Equal(t, int64(101), replicator.ReplicaIndex())
+	assert.Equal(t, int64(0), replicator.Pending())
+	// rejects the write ahead log which isn't next append index
+	appendIdx, err := p.ReplicaLog(99, []byte("msg"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), appendIdx)
+	appendIdx, err = p.ReplicaLog(101, []byte("msg"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), appendIdx)
+	assert.Equal(t, int64(101), p.ReplicaAckIndex())
+	assert.Equal(t, int64(1), replicator.Pending())
+	assert.Equal(t, int64(101), replicator.Consume())
+}
+
 func TestPartition_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -206,8 +260,11 @@ func TestPartition_WriteLog(t *testing.T) {
 	err = p.WriteLog(nil)
 	assert.NoError(t, err)
 	q.EXPECT().Put(gomock.Any()).Return(nil)
+	q.EXPECT().AppendedSeq().Return(int64(1))
 	err = p.WriteLog([]byte{1})
 	assert.NoError(t, err)
+	// append time sampled
+	assert.Equal(t, 1, p.(*partition).appendTimes.size)
 }
 
 func TestPartition_ReplicaLog(t *testing.T) {
@@ -277,9 +334,11 @@ func TestPartition_getReplicaState(t *testing.T) {
 	fan.EXPECT().ConsumedSeq().Return(int64(1))
 	fan.EXPECT().AcknowledgedSeq().Return(int64(1))
 	fan.EXPECT().Pending().Return(int64(1))
-	q.EXPECT().AppendedSeq().Return(int64(1))
+	q.EXPECT().AppendedSeq().Return(int64(3))
+	p1.appendTimes.append(2, timeutil.Now()-5*timeutil.OneSecond)
 	state := p.getReplicaState()
-	assert.NotNil(t, state)
+	assert.Len(t, state.Replicators, 1)
+	assert.GreaterOrEqual(t, state.Replicators[0].Staleness, 5*timeutil.OneSecond)
 }
 
 func TestPartition_IsExpire(t *testing.T) {
@@ -24,8 +24,10 @@ import (
 	"path"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -45,6 +47,11 @@ type WriteAheadLog interface {
 	// GetOrCreatePartition returns a partition of write ahead log.
 	// if exist returns it, else create a new partition.
 	GetOrCreatePartition(shardID models.ShardID, familyTime int64, leader models.NodeID) (Partition, error)
+	// WriteLog writes msg of client write request into partition of shard, rejects it if shard is fenced.
+	WriteLog(shardID models.ShardID, partition Partition, msg []byte) error
+	// Fence rejects client writes of shard until lease expired(renewed by next fence), then waits in-flight
+	// client writes completed, so that append index of shard's family logs is final when transferring leadership.
+	Fence(shardID models.ShardID, lease time.Duration)
 	// Stop stops all replicator channels.
 	Stop()
 	// Drop drops write ahead log.
@@ -72,6 +79,9 @@ type writeAheadLog struct {
 	// family log = shard + family + leader
 	familyLogs map[partitionKey]Partition
 
+	fenceLock sync.RWMutex
+	fences    map[models.ShardID]int64 // shard => expire time of fence
+
 	logger *logger.Logger
 }
 
@@ -95,6 +105,7 @@ func NewWriteAheadLog(
 		cliFct:        cliFct,
 		stateMgr:      stateMgr,
 		familyLogs:    make(map[partitionKey]Partition),
+		fences:        make(map[models.ShardID]int64),
 		logger:        logger.GetLogger("Replica", "WriteAheadLog"),
 	}
 	return log
@@ -148,6 +159,27 @@ func (w *writeAheadLog) GetOrCreatePartition(
 	return p, nil
 }
 
+// WriteLog writes msg of client write request into partition of shard, rejects it if shard is fenced.
+func (w *writeAheadLog) WriteLog(shardID models.ShardID, partition Partition, msg []byte) error {
+	w.fenceLock.RLock()
+	defer w.fenceLock.RUnlock()
+
+	if expireAt, ok := w.fences[shardID]; ok && timeutil.Now() < expireAt {
+		return fmt.Errorf("%w, shard: %d", constants.ErrShardFenced, shardID.Int())
+	}
+	return partition.WriteLog(msg)
+}
+
+// Fence rejects client writes of shard until lease expired(renewed by next fence), then waits in-flight
+// client writes completed, so that append index of shard's family logs is final when transferring leadership.
+// NOTICE: fence expires automatically, so shard cannot be unwritable forever if leadership transfer is aborted.
+func (w *writeAheadLog) Fence(shardID models.ShardID, lease time.Duration) {
+	w.fenceLock.Lock()
+	defer w.fenceLock.Unlock()
+
+	w.fences[shardID] = timeutil.Now() + lease.Milliseconds()
+}
+
 // getReplicaState returns the state of replica.
 func (w *writeAheadLog) getReplicaState() (rs []models.FamilyLogReplicaState) {
 	w.mutex.Lock()
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
@@ -377,3 +378,24 @@ func TestWriteAheadLog_Drop(t *testing.T) {
 	}
 	assert.Error(t, wal.Drop())
 }
+
+func TestWriteAheadLog_Fence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wal := NewWriteAheadLog(context.TODO(), config.WAL{}, 1, "test", nil, nil, nil)
+	p := NewMockPartition(ctrl)
+	// case 1: shard not fenced
+	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	assert.NoError(t, wal.WriteLog(1, p, []byte("test")))
+	// case 2: shard fenced, other shard not fenced
+	wal.Fence(1, time.Minute)
+	err := wal.WriteLog(1, p, []byte("test"))
+	assert.ErrorIs(t, err, constants.ErrShardFenced)
+	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	assert.NoError(t, wal.WriteLog(2, p, []byte("test")))
+	// case 3: fence expired
+	wal.Fence(1, -time.Second)
+	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	assert.NoError(t, wal.WriteLog(1, p, []byte("test")))
+}
@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
@@ -51,10 +52,12 @@ var (
 func init() {
 	brokerClientConnFct = &clientConnFactory{
 		connMap:       make(map[string]*grpc.ClientConn),
+		creds:         insecure.NewCredentials(),
 		clientTracker: conntrack.NewGRPCClientTracker(linmetric.BrokerRegistry),
 	}
 	storageClientConnFct = &clientConnFactory{
 		connMap:       make(map[string]*grpc.ClientConn),
+		creds:         insecure.NewCredentials(),
 		clientTracker: conntrack.NewGRPCClientTracker(linmetric.StorageRegistry),
 	}
 }
@@ -67,13 +70,16 @@ type ClientConnFactory interface {
 	GetClientConn(target models.Node) (*grpc.ClientConn, error)
 	// CloseClientConn closes client connection for spec target node.
 	CloseClientConn(target models.Node) error
+	// SetTransportCredentials sets transport credentials(tls) for new connections, plaintext by default.
+	SetTransportCredentials(creds credentials.TransportCredentials)
 }
 
 // clientConnFactory implements ClientConnFactory.
 type clientConnFactory struct {
 	// target's indicator -> connection
 	connMap map[string]*grpc.ClientConn
-	// lock to protect connMap
+	creds   credentials.TransportCredentials
+	// lock to protect connMap/creds
 	mu            sync.RWMutex
 	clientTracker *conntrack.GRPCClientTracker
 }
@@ -108,7 +114,7 @@ func (fct *clientConnFactory) GetClientConn(target models.Node) (*grpc.ClientCon
 	}
 	conn, err := grpcDialFn(
 		target.Indicator(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(fct.creds),
 		grpc.WithStreamInterceptor(fct.clientTracker.StreamClientInterceptor()),
 		grpc.WithUnaryInterceptor(fct.clientTracker.UnaryClientInterceptor()),
 	)
@@ -120,6 +126,14 @@ func (fct *clientConnFactory) GetClientConn(target models.Node) (*grpc.ClientCon
 	return conn, nil
 }
 
+// SetTransportCredentials sets transport credentials(tls) for new connections, plaintext by default.
+func (fct *clientConnFactory) SetTransportCredentials(creds credentials.TransportCredentials) {
+	fct.mu.Lock()
+	defer fct.mu.Unlock()
+
+	fct.creds = creds
+}
+
 // CloseClientConn closes client connection for spec target node.
 func (fct *clientConnFactory) CloseClientConn(target models.Node) error {
 	indicator := target.Indicator()
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -77,6 +78,11 @@ func TestClientConnFactory(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, conn3)
 
+	// set tls credentials
+	creds, err := NewClientCredentials(config.TLS{})
+	assert.NoError(t, err)
+	fct.SetTransportCredentials(creds)
+
 	// test close
 	err = fct.CloseClientConn(&models.StatelessNode{
 		HostIP:   "127.0.0.1",
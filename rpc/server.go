@@ -25,6 +25,8 @@ import (
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/config"
@@ -32,6 +34,7 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 //go:generate mockgen -source ./server.go -destination=./server_mock.go -package=rpc
@@ -48,6 +51,7 @@ type GRPCServer interface {
 type grpcServer struct {
 	bindAddress string
 	gs          *grpc.Server
+	tls         *tlsutil.Reloader
 	statistics  *metrics.GRPCServerStatistics
 	logger      *logger.Logger
 }
@@ -64,28 +68,54 @@ func NewGRPCServer(cfg config.GRPC, r *linmetric.Registry) GRPCServer {
 			return status.Errorf(codes.Internal, "panic triggered: %v", p)
 		}),
 	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ConnectionTimeout(cfg.ConnectTimeout.Duration()),
+		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			grpcServerTracker.StreamServerInterceptor(),
+			grpcrecovery.StreamServerInterceptor(opts...),
+		)),
+		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			grpcServerTracker.UnaryServerInterceptor(),
+			grpcrecovery.UnaryServerInterceptor(opts...),
+		)),
+		grpc.MaxConcurrentStreams(uint32(cfg.MaxConcurrentStreams)),
+	}
+	var reloader *tlsutil.Reloader
+	if cfg.TLS.Enable {
+		// certificate is reloaded for each new connection
+		reloader = tlsutil.NewReloader(cfg.TLS)
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig("h2"))))
+	}
 	return &grpcServer{
 		logger:      log,
 		statistics:  statistics,
 		bindAddress: fmt.Sprintf(":%d", cfg.Port),
-		gs: grpc.NewServer(
-			grpc.ConnectionTimeout(cfg.ConnectTimeout.Duration()),
-			grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
-				grpcServerTracker.StreamServerInterceptor(),
-				grpcrecovery.StreamServerInterceptor(opts...),
-			)),
-			grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-				grpcServerTracker.UnaryServerInterceptor(),
-				grpcrecovery.UnaryServerInterceptor(opts...),
-			)),
-			grpc.MaxConcurrentStreams(uint32(cfg.MaxConcurrentStreams)),
-		),
+		tls:         reloader,
+		gs:          grpc.NewServer(serverOpts...),
+	}
+}
+
+// NewClientCredentials returns transport credentials of grpc client based on tls config, plaintext if tls disabled.
+func NewClientCredentials(cfg config.TLS) (credentials.TransportCredentials, error) {
+	if !cfg.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	tlsCfg, err := tlsutil.NewClientConfig(cfg)
+	if err != nil {
+		return nil, err
 	}
+	return credentials.NewTLS(tlsCfg), nil
 }
 
 // Start listens the bind address and serves grpc tcpServer,
 // block the caller, return fatal error or non-nil error if server is not stop gracefully.
 func (s *grpcServer) Start() error {
+	if s.tls != nil {
+		// check tls files before serving
+		if err := s.tls.Load(); err != nil {
+			return err
+		}
+	}
 	lis, err := net.Listen("tcp", s.bindAddress)
 	if err != nil {
 		return err
@@ -0,0 +1,64 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+func TestGRPCServer(t *testing.T) {
+	s := NewGRPCServer(config.GRPC{}, linmetric.BrokerRegistry)
+	assert.NotNil(t, s.GetServer())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.Start()
+	}()
+	time.Sleep(100 * time.Millisecond)
+	s.Stop()
+	assert.NoError(t, <-errCh)
+}
+
+func TestGRPCServer_TLS(t *testing.T) {
+	// tls files not exist
+	s := NewGRPCServer(config.GRPC{TLS: config.TLS{
+		Enable:   true,
+		CertFile: "not-exist-cert.pem",
+		KeyFile:  "not-exist-key.pem",
+	}}, linmetric.BrokerRegistry)
+	assert.Error(t, s.Start())
+}
+
+func TestNewClientCredentials(t *testing.T) {
+	creds, err := NewClientCredentials(config.TLS{})
+	assert.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+
+	creds, err = NewClientCredentials(config.TLS{
+		Enable:   true,
+		CertFile: "not-exist-cert.pem",
+		KeyFile:  "not-exist-key.pem",
+	})
+	assert.Error(t, err)
+	assert.Nil(t, creds)
+}
@@ -108,6 +108,22 @@ func (br *BrokerBatchRows) Swap(i, j int) { br.rows[i], br.rows[j] = br.rows[j],
 
 func (br *BrokerBatchRows) Rows() []BrokerRow { return br.rows[:br.rowCount] }
 
+// NumOfPoints returns the number of field points of rows in time range, compound field counts as one point.
+func (br *BrokerBatchRows) NumOfPoints() (points int) {
+	var compoundField flatMetricsV1.CompoundField
+	for idx := 0; idx < br.rowCount; idx++ {
+		row := &br.rows[idx]
+		if row.IsOutOfTimeRange {
+			continue
+		}
+		points += row.m.SimpleFieldsLength()
+		if row.m.CompoundField(&compoundField) != nil {
+			points++
+		}
+	}
+	return points
+}
+
 // EvictOutOfTimeRange evicts and marks out-of-range metrics invalid
 func (br *BrokerBatchRows) EvictOutOfTimeRange(behind, ahead int64) (evicted int) {
 	// check metric timestamp if in acceptable time range
@@ -126,6 +142,8 @@ func (br *BrokerBatchRows) TryAppend(appendFunc func(row *BrokerRow) error) erro
 	if len(br.rows) <= br.rowCount {
 		br.rows = append(br.rows, BrokerRow{})
 	}
+	// row may be reused from pool, which is marked by previous eviction
+	br.rows[br.rowCount].IsOutOfTimeRange = false
 	if err := appendFunc(&br.rows[br.rowCount]); err != nil {
 		return err
 	}
@@ -20,6 +20,7 @@ package metric
 import (
 	"bytes"
 	"io"
+	"math"
 	"strconv"
 	"testing"
 
@@ -102,6 +103,34 @@ func buildRow(row *BrokerRow, timestamp int64) {
 	row.FromBlock(data)
 }
 
+func Test_BrokerBatchRows_NumOfPoints(t *testing.T) {
+	batch := NewBrokerBatchRows()
+	defer batch.Release()
+
+	now := fasttime.UnixMilliseconds()
+	assert.Zero(t, batch.NumOfPoints())
+	assert.NoError(t, batch.TryAppend(func(row *BrokerRow) error {
+		buildRow(row, now)
+		return nil
+	}))
+	assert.NoError(t, batch.TryAppend(func(row *BrokerRow) error {
+		builder, releaseFunc := commonseries.NewRowBuilder()
+		defer releaseFunc(builder)
+		builder.AddMetricName([]byte("test"))
+		_ = builder.AddSimpleField([]byte("f1"), flatMetricsV1.SimpleFieldTypeDeltaSum, 1)
+		_ = builder.AddSimpleField([]byte("f2"), flatMetricsV1.SimpleFieldTypeLast, 1)
+		_ = builder.AddCompoundFieldData([]float64{1, 2, 3}, []float64{1, 2, math.Inf(1)})
+		builder.AddTimestamp(now)
+		data, err := builder.Build()
+		row.FromBlock(data)
+		return err
+	}))
+	// 1 simple field + 2 simple fields + 1 compound field
+	assert.Equal(t, 4, batch.NumOfPoints())
+	batch.Rows()[1].IsOutOfTimeRange = true
+	assert.Equal(t, 1, batch.NumOfPoints())
+}
+
 func Test_BrokerBatchRows_AppendError(t *testing.T) {
 	batch := NewBrokerBatchRows()
 	defer batch.Release()
@@ -0,0 +1,139 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// alertConditionRegex represents the pattern of alert condition, like: [field] <operator> <threshold>.
+var alertConditionRegex = regexp.MustCompile(`^\s*([A-Za-z_][\w.]*)?\s*(>=|<=|==|!=|>|<)\s*(\S+)\s*$`)
+
+// alertStmtParser represents alert rule statement parser.
+type alertStmtParser struct {
+	alert   *stmt.Alert
+	options optionSet
+
+	err error
+}
+
+// newAlertStmtParse creates an alert rule statement parser.
+func newAlertStmtParse(opType stmt.AlertOpType) *alertStmtParser {
+	return &alertStmtParser{
+		alert:   &stmt.Alert{Type: opType},
+		options: make(optionSet),
+	}
+}
+
+// visitName visits when production alert rule name is entered.
+func (a *alertStmtParser) visitName(ctx grammar.ITextValueContext) {
+	a.alert.Name = getTextValue(ctx)
+}
+
+// visitOption visits when production alert rule option(ON/WHEN/EVERY/FOR/NAMESPACE/LABELS/WEBHOOK/FORMAT/AS) is entered.
+func (a *alertStmtParser) visitOption(ctx *grammar.AlertOptionContext) {
+	if a.err != nil {
+		return
+	}
+	if a.err = a.options.visit(ctx); a.err != nil {
+		return
+	}
+	value := getTextValue(ctx.TextValue())
+	switch {
+	case ctx.T_ON() != nil:
+		a.alert.Database = value
+	case ctx.T_NAMESPACE() != nil:
+		a.alert.Namespace = value
+	case ctx.T_WEBHOOK() != nil:
+		a.alert.Webhook = value
+	case ctx.T_AS() != nil:
+		a.alert.SQL = value
+	case ctx.T_WHEN() != nil:
+		a.err = a.visitCondition(value)
+	case ctx.T_EVERY() != nil:
+		a.alert.Interval, a.err = getDurationValue(ctx.DurationValue())
+	case ctx.T_FOR() != nil:
+		a.alert.For, a.err = getDurationValue(ctx.DurationValue())
+	case ctx.T_LABELS() != nil:
+		a.err = a.visitLabels(value)
+	case ctx.T_FORMAT() != nil:
+		a.alert.Format = strings.ToLower(value)
+		switch a.alert.Format {
+		case "webhook", "alertmanager":
+		default:
+			a.err = fmt.Errorf("invalid format '%s' of alert rule, only support webhook/alertmanager", value)
+		}
+	}
+}
+
+// visitCondition parses the condition of alert rule, like: usage > 90.
+func (a *alertStmtParser) visitCondition(condition string) error {
+	matches := alertConditionRegex.FindStringSubmatch(condition)
+	if matches == nil {
+		return fmt.Errorf("invalid condition '%s' of alert rule, syntax: [field] <operator> <threshold>", condition)
+	}
+	threshold, err := strconv.ParseFloat(matches[3], 64)
+	if err != nil {
+		return fmt.Errorf("invalid threshold '%s' of alert rule", matches[3])
+	}
+	a.alert.Field, a.alert.Operator, a.alert.Threshold = matches[1], matches[2], threshold
+	return nil
+}
+
+// visitLabels parses the labels of alert rule, like: k1=v1,k2=v2.
+func (a *alertStmtParser) visitLabels(labels string) error {
+	a.alert.Labels = make(map[string]string)
+	for _, label := range strings.Split(labels, ",") {
+		kv := strings.SplitN(label, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return fmt.Errorf("invalid label '%s' of alert rule, syntax: k1=v1,k2=v2", label)
+		}
+		a.alert.Labels[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return nil
+}
+
+// build returns the alert rule statement.
+func (a *alertStmtParser) build() (stmt.Statement, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	if a.alert.Type != stmt.AlertOpCreate {
+		return a.alert, nil
+	}
+	switch {
+	case a.alert.Name == "":
+		return nil, fmt.Errorf("name of alert rule is required")
+	case a.alert.Database == "":
+		return nil, fmt.Errorf("'ON' of alert rule is required")
+	case a.alert.Operator == "":
+		return nil, fmt.Errorf("'WHEN' of alert rule is required")
+	case a.alert.Interval <= 0:
+		return nil, fmt.Errorf("'EVERY' of alert rule is required")
+	}
+	if err := checkRuleQuery(a.alert.SQL); err != nil {
+		return nil, err
+	}
+	return a.alert, nil
+}
@@ -0,0 +1,89 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestAlert(t *testing.T) {
+	q, err := Parse(`create alert high_cpu on test when 'usage > 90' every 1m for 5m ` +
+		`labels 'severity=critical, team=ops' webhook 'http://localhost:9093/api/v2/alerts' format Alertmanager ` +
+		`as 'select max(usage) from cpu group by host'`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Alert{
+		Type:      stmt.AlertOpCreate,
+		Name:      "high_cpu",
+		Database:  "test",
+		SQL:       "select max(usage) from cpu group by host",
+		Field:     "usage",
+		Operator:  ">",
+		Threshold: 90,
+		Interval:  timeutil.OneMinute,
+		For:       5 * timeutil.OneMinute,
+		Labels:    map[string]string{"severity": "critical", "team": "ops"},
+		Webhook:   "http://localhost:9093/api/v2/alerts",
+		Format:    "alertmanager",
+	}, q)
+
+	q, err = Parse(`CREATE ALERT a1 ON test NAMESPACE ns WHEN '<=-1.5' EVERY 30s AS "select f from cpu"`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Alert{
+		Type:      stmt.AlertOpCreate,
+		Name:      "a1",
+		Database:  "test",
+		Namespace: "ns",
+		SQL:       "select f from cpu",
+		Operator:  "<=",
+		Threshold: -1.5,
+		Interval:  30 * timeutil.OneSecond,
+	}, q)
+
+	q, err = Parse("show alerts")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Alert{Type: stmt.AlertOpShow}, q)
+
+	q, err = Parse("DROP ALERT a1;")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Alert{Type: stmt.AlertOpDrop, Name: "a1"}, q)
+
+	for _, sql := range []string{
+		"create alert",
+		"create alert a1 on test when '>1' every 1m",
+		"create alert a1 on test when '>1' every 1m as",
+		"create alert a1 on test when 'f => 1' every 1m as 'select f from cpu'",
+		"create alert a1 on test when 'f > abc' every 1m as 'select f from cpu'",
+		"create alert a1 on test when 'f > 1' every 1x as 'select f from cpu'",
+		"create alert a1 on test when 'f > 1' every 1m for 1x as 'select f from cpu'",
+		"create alert a1 on test when 'f > 1' every 1m labels 'a' as 'select f from cpu'",
+		"create alert a1 on test when 'f > 1' every 1m format json as 'select f from cpu'",
+		"create alert a1 on test when 'f > 1' every 1m as 'show databases'",
+		"create alert a1 on test when 'f > 1' every 1m as 'select from'",
+		"show alert",
+		"drop alert",
+	} {
+		q, err = Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
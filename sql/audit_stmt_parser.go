@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// auditStmtParser represents show audit log statement parser.
+type auditStmtParser struct {
+	audit *stmt.Audit
+
+	err error
+}
+
+// newAuditStmtParse creates a show audit log statement parser.
+func newAuditStmtParse() *auditStmtParser {
+	return &auditStmtParser{
+		audit: &stmt.Audit{},
+	}
+}
+
+// visitLimit visits when production limit expression is entered.
+func (a *auditStmtParser) visitLimit(ctx *grammar.LimitClauseContext) {
+	limit, err := strconv.Atoi(ctx.L_INT().GetText())
+	if err != nil || limit <= 0 {
+		a.err = fmt.Errorf("invalid limit '%s' of audit log", ctx.L_INT().GetText())
+		return
+	}
+	a.audit.Limit = limit
+}
+
+// build returns the show audit log statement.
+func (a *auditStmtParser) build() (stmt.Statement, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return a.audit, nil
+}
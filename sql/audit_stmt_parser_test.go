@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestAudit(t *testing.T) {
+	q, err := Parse("show audit log")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Audit{}, q)
+	q, err = Parse("SHOW AUDIT LOG LIMIT 10;")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Audit{Limit: 10}, q)
+	for _, sql := range []string{
+		"show audit",
+		"show audit logs",
+		"show audit log limit",
+		"show audit log limit abc",
+		"show audit log limit 0",
+		"show audit log top 10",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
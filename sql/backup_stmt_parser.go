@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// backupStmtParser represents backup/restore database statement parser.
+type backupStmtParser struct {
+	backup *stmt.Backup
+}
+
+// newBackupStmtParse creates a backup/restore database statement parser.
+func newBackupStmtParse(opType stmt.BackupOpType) *backupStmtParser {
+	return &backupStmtParser{
+		backup: &stmt.Backup{Type: opType},
+	}
+}
+
+// visitDatabase visits when production database name of backup/restore is entered.
+func (b *backupStmtParser) visitDatabase(ctx grammar.ITextValueContext) {
+	b.backup.Database = getTextValue(ctx)
+}
+
+// visitDir visits when production backup dir is entered.
+func (b *backupStmtParser) visitDir(ctx grammar.ITextValueContext) {
+	b.backup.Dir = getTextValue(ctx)
+}
+
+// build returns the backup/restore statement.
+func (b *backupStmtParser) build() (stmt.Statement, error) {
+	if b.backup.Database == "" || b.backup.Dir == "" {
+		return nil, errors.New("database name and backup dir are required")
+	}
+	return b.backup, nil
+}
@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestBackupDatabase(t *testing.T) {
+	q, err := Parse("backup database test to '/tmp/backup'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Backup{Type: stmt.BackupOpBackup, Database: "test", Dir: "/tmp/backup"}, q)
+
+	q, err = Parse(`BACKUP DATABASE "db 1" TO "/tmp/my backup";`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Backup{Type: stmt.BackupOpBackup, Database: "db 1", Dir: "/tmp/my backup"}, q)
+
+	q, err = Parse("backup database test from '/tmp/backup'")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+	q, err = Parse("backup database test to")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+	q, err = Parse("backup database test to '/tmp/backup")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+	q, err = Parse("backup database '' to '/tmp/backup'")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+}
+
+func TestRestoreDatabase(t *testing.T) {
+	q, err := Parse("restore database test from '/tmp/backup'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Backup{Type: stmt.BackupOpRestore, Database: "test", Dir: "/tmp/backup"}, q)
+
+	q, err = Parse("restore database test to '/tmp/backup'")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+}
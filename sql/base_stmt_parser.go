@@ -18,10 +18,15 @@
 package sql
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
 
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -184,3 +189,34 @@ func (b *baseStmtParser) setExprParam(param stmt.Expr) {
 	default:
 	}
 }
+
+// optionSet records the options of statement which can be specified only once, like: SHARD/FAMILY of compact.
+type optionSet map[string]struct{}
+
+// visit records the option keyword(first token of option), returns err if option is duplicate.
+func (s optionSet) visit(ctx antlr.ParserRuleContext) error {
+	keyword := strings.ToUpper(ctx.GetStart().GetText())
+	if _, ok := s[keyword]; ok {
+		return fmt.Errorf("duplicate option '%s'", keyword)
+	}
+	s[keyword] = struct{}{}
+	return nil
+}
+
+// getTextValue returns the value of text(identifier or quoted string), returns empty string if not exist.
+func getTextValue(ctx grammar.ITextValueContext) string {
+	if ctx == nil {
+		return ""
+	}
+	return strutil.GetStringValue(ctx.GetText())
+}
+
+// getDurationValue returns the interval of duration value, like: 1m or '30s'.
+func getDurationValue(ctx grammar.IDurationValueContext) (int64, error) {
+	value := strutil.GetStringValue(ctx.GetText())
+	var interval timeutil.Interval
+	if err := interval.ValueOf(value); err != nil || interval < 0 {
+		return 0, fmt.Errorf("invalid duration '%s'", value)
+	}
+	return interval.Int64(), nil
+}
@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// compactStmtParser represents compact/rollup database and show compaction statement parser.
+type compactStmtParser struct {
+	compact *stmt.Compact
+	options optionSet
+
+	err error
+}
+
+// newCompactStmtParse creates a compact/rollup database and show compaction statement parser.
+func newCompactStmtParse(opType stmt.CompactOpType) *compactStmtParser {
+	return &compactStmtParser{
+		compact: &stmt.Compact{Type: opType},
+		options: make(optionSet),
+	}
+}
+
+// visitDatabase visits when production database name of compaction is entered.
+func (c *compactStmtParser) visitDatabase(ctx grammar.ITextValueContext) {
+	c.compact.Database = getTextValue(ctx)
+}
+
+// visitOption visits when production compact option(SHARD/FAMILY) is entered.
+func (c *compactStmtParser) visitOption(ctx *grammar.CompactOptionContext) {
+	if c.err != nil {
+		return
+	}
+	if c.err = c.options.visit(ctx); c.err != nil {
+		return
+	}
+	if ctx.T_FAMILY() != nil {
+		family := getTextValue(ctx.TextValue())
+		familyTime, err := timeutil.ParseTimestamp(family)
+		if err != nil {
+			c.err = fmt.Errorf("invalid family time '%s', error: %s", family, err)
+			return
+		}
+		c.compact.FamilyTime = familyTime
+	}
+}
+
+// visitShardIDList visits when production shard id list is entered.
+func (c *compactStmtParser) visitShardIDList(ctx *grammar.ShardIDListContext) {
+	for _, shard := range ctx.AllL_INT() {
+		shardID, err := strconv.ParseInt(shard.GetText(), 10, 32)
+		if err != nil {
+			c.err = fmt.Errorf("invalid shard id '%s'", shard.GetText())
+			return
+		}
+		c.compact.ShardIDs = append(c.compact.ShardIDs, int32(shardID))
+	}
+}
+
+// build returns the compaction statement.
+func (c *compactStmtParser) build() (stmt.Statement, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.compact.Database == "" {
+		return nil, errors.New("database name is required")
+	}
+	return c.compact, nil
+}
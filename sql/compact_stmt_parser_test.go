@@ -0,0 +1,72 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestCompactDatabase(t *testing.T) {
+	q, err := Parse("compact database test")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Compact{Type: stmt.CompactOpCompact, Database: "test"}, q)
+
+	familyTime, _ := timeutil.ParseTimestamp("2022-10-12 10:00:00")
+	q, err = Parse("COMPACT DATABASE test FAMILY '2022-10-12 10:00:00' SHARD 1,2")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Compact{
+		Type:       stmt.CompactOpCompact,
+		Database:   "test",
+		ShardIDs:   []int32{1, 2},
+		FamilyTime: familyTime,
+	}, q)
+
+	q, err = Parse("rollup database test shard 3")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Compact{Type: stmt.CompactOpRollup, Database: "test", ShardIDs: []int32{3}}, q)
+
+	for _, sql := range []string{
+		"compact",
+		"compact table test",
+		"compact database test shard",
+		"compact database test shard a",
+		"compact database test shard -1",
+		"compact database test shard 1 shard 2",
+		"compact database test family 'abc'",
+		"compact database test limit 10",
+	} {
+		q, err = Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
+
+func TestShowCompaction(t *testing.T) {
+	q, err := Parse("show compaction database test")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Compact{Type: stmt.CompactOpShowState, Database: "test"}, q)
+
+	q, err = Parse("show compaction test")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+}
@@ -2,7 +2,7 @@
 // antlr4 SQL.g4 -Dlanguage=Go -package grammar
 grammar SQL;
 
-statement               : showStmt
+statement               : (showStmt
                         | createStorageStmt
                         | createBrokerStmt
                         | recoverStorageStmt
@@ -10,8 +10,29 @@ statement               : showStmt
                         | queryStmt
                         | createDatabaseStmt
                         | dropDatabaseStmt
+                        | alterDatabaseStmt
+                        | backupStmt
+                        | restoreStmt
+                        | compactStmt
+                        | createRecordingRuleStmt
+                        | dropRecordingRuleStmt
+                        | createAlertStmt
+                        | dropAlertStmt
+                        | createUserStmt
+                        | alterUserStmt
+                        | dropUserStmt
+                        | createRoleStmt
+                        | dropRoleStmt
+                        | grantStmt
+                        | revokeStmt
+                        | createTokenStmt
+                        | dropTokenStmt
+                        | createTenantStmt
+                        | alterTenantStmt
+                        | dropTenantStmt
+                        | decommissionStmt
                         | ident // just for suggest filtering.
-                        EOF ;
+                        ) T_SEMICOLON? EOF ;
 
 useStmt                 : T_USE ident ;
 
@@ -36,6 +57,17 @@ showStmt                : showMasterStmt
                         | showTagValuesStmt
 						| showRequestsStmt
 						| showRequestStmt
+                        | showSlowRequestsStmt
+                        | showCompactionStmt
+                        | showRecordingRulesStmt
+                        | showAlertsStmt
+                        | showUsersStmt
+                        | showRolesStmt
+                        | showTokensStmt
+                        | showTenantsStmt
+                        | showTenantUsageStmt
+                        | showAuditLogStmt
+                        | showRebalanceStmt
                         ;
 //meta data query statement
 showMasterStmt       : T_SHOW T_MASTER ;
@@ -64,6 +96,52 @@ showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQ
 showFieldsStmt       : T_SHOW T_FIELDS fromClause;
 showTagKeysStmt      : T_SHOW T_TAG T_KEYS fromClause;
 showTagValuesStmt    : T_SHOW T_TAG T_VALUES fromClause T_WITH T_KEY T_EQUAL withTagKey whereClause? limitClause?;
+//admin statement
+alterDatabaseStmt    : T_ALTER T_DATASBAE textValue T_REPLICA L_INT ;
+backupStmt           : T_BACKUP T_DATASBAE textValue T_TO textValue ;
+restoreStmt          : T_RESTORE T_DATASBAE textValue T_FROM textValue ;
+compactStmt          : (T_COMPACT | T_ROLLUP) T_DATASBAE textValue compactOption* ;
+compactOption        : T_SHARD shardIDList | T_FAMILY textValue ;
+shardIDList          : L_INT (T_COMMA L_INT)* ;
+showCompactionStmt   : T_SHOW T_COMPACTION T_DATASBAE textValue ;
+showSlowRequestsStmt : T_SHOW T_SLOW T_REQUESTS ;
+createRecordingRuleStmt : T_CREATE T_RECORDING T_RULE textValue recordingRuleOption* ;
+recordingRuleOption  : T_ON textValue | T_INTO textValue | T_EVERY durationValue | T_NAMESPACE textValue | T_AS textValue ;
+showRecordingRulesStmt  : T_SHOW T_RECORDING T_RULES ;
+dropRecordingRuleStmt   : T_DROP T_RECORDING T_RULE textValue ;
+createAlertStmt      : T_CREATE T_ALERT textValue alertOption* ;
+alertOption          : T_ON textValue | T_WHEN textValue | T_EVERY durationValue | T_FOR durationValue | T_NAMESPACE textValue
+                     | T_LABELS textValue | T_WEBHOOK textValue | T_FORMAT textValue | T_AS textValue ;
+showAlertsStmt       : T_SHOW T_ALERTS ;
+dropAlertStmt        : T_DROP T_ALERT textValue ;
+createUserStmt       : T_CREATE T_USER textValue T_PASSWORD textValue ;
+alterUserStmt        : T_ALTER T_USER textValue (T_PASSWORD textValue | T_REVOKE T_TOKENS) ;
+dropUserStmt         : T_DROP T_USER textValue ;
+showUsersStmt        : T_SHOW T_USERS ;
+createRoleStmt       : T_CREATE T_ROLE textValue ;
+dropRoleStmt         : T_DROP T_ROLE textValue ;
+showRolesStmt        : T_SHOW T_ROLES ;
+grantStmt            : T_GRANT (roleGrant T_TO | privilegeGrant T_TO T_ROLE?) textValue ;
+revokeStmt           : T_REVOKE (roleGrant T_FROM | privilegeGrant T_FROM T_ROLE?) textValue ;
+roleGrant            : T_ROLE textValue ;
+privilegeGrant       : privilegeList T_ON privilegeDatabase (T_NAMESPACE textValue)? ;
+privilegeDatabase    : textValue | T_MUL ;
+privilegeList        : privilege (T_COMMA privilege)* ;
+privilege            : T_READ | T_WRITE | T_ALL ;
+createTokenStmt      : T_CREATE T_TOKEN textValue T_ON textValue (T_NAMESPACE textValue)? T_FOR privilegeList ;
+dropTokenStmt        : T_DROP T_TOKEN textValue ;
+showTokensStmt       : T_SHOW T_TOKENS ;
+createTenantStmt     : T_CREATE T_TENANT textValue tenantQuota* ;
+alterTenantStmt      : T_ALTER T_TENANT textValue tenantQuota+ ;
+tenantQuota          : (T_INGEST_RATE | T_MAX_SERIES | T_MAX_QUERIES) L_INT | T_MAX_DISK (L_INT | textValue) ;
+dropTenantStmt       : T_DROP T_TENANT textValue ;
+showTenantsStmt      : T_SHOW T_TENANTS ;
+showTenantUsageStmt  : T_SHOW T_TENANT T_USAGE textValue? ;
+showAuditLogStmt     : T_SHOW T_AUDIT T_LOG limitClause? ;
+showRebalanceStmt    : T_SHOW T_REBALANCE textValue? ;
+decommissionStmt     : T_DECOMMISSION T_STORAGE T_NODE L_INT (T_ON textValue)? ;
+durationValue        : durationLit | textValue ;
+textValue            : ident | STRING ;
 prefix               : ident ;
 withTagKey           : ident ;
 namespace            : ident ;
@@ -309,6 +387,41 @@ nonReservedWords      :
                         | T_REQUESTS
                         | T_REQUEST
                         | T_ID
+                        | T_TO
+                        | T_COMPACTION
+                        | T_FAMILY
+                        | T_SLOW
+                        | T_RECORDING
+                        | T_RULE
+                        | T_RULES
+                        | T_INTO
+                        | T_EVERY
+                        | T_ALERT
+                        | T_ALERTS
+                        | T_WHEN
+                        | T_LABELS
+                        | T_WEBHOOK
+                        | T_FORMAT
+                        | T_USER
+                        | T_USERS
+                        | T_PASSWORD
+                        | T_TOKEN
+                        | T_TOKENS
+                        | T_ROLE
+                        | T_ROLES
+                        | T_READ
+                        | T_WRITE
+                        | T_ALL
+                        | T_TENANT
+                        | T_TENANTS
+                        | T_USAGE
+                        | T_INGEST_RATE
+                        | T_MAX_SERIES
+                        | T_MAX_QUERIES
+                        | T_MAX_DISK
+                        | T_AUDIT
+                        | T_REBALANCE
+                        | T_REPLICA
                         ;
 
 STRING
@@ -422,6 +535,50 @@ T_REQUESTS           : R E Q U E S T S                  ;
 T_REQUEST            : R E Q U E S T                    ;
 T_ID                 : I D                              ;
 
+T_ALTER              : A L T E R                        ;
+T_BACKUP             : B A C K U P                      ;
+T_RESTORE            : R E S T O R E                    ;
+T_TO                 : T O                              ;
+T_COMPACT            : C O M P A C T                    ;
+T_ROLLUP             : R O L L U P                      ;
+T_COMPACTION         : C O M P A C T I O N              ;
+T_FAMILY             : F A M I L Y                      ;
+T_SLOW               : S L O W                          ;
+T_RECORDING          : R E C O R D I N G                ;
+T_RULE               : R U L E                          ;
+T_RULES              : R U L E S                        ;
+T_INTO               : I N T O                          ;
+T_EVERY              : E V E R Y                        ;
+T_ALERT              : A L E R T                        ;
+T_ALERTS             : A L E R T S                      ;
+T_WHEN               : W H E N                          ;
+T_LABELS             : L A B E L S                      ;
+T_WEBHOOK            : W E B H O O K                    ;
+T_FORMAT             : F O R M A T                      ;
+T_USER               : U S E R                          ;
+T_USERS              : U S E R S                        ;
+T_PASSWORD           : P A S S W O R D                  ;
+T_TOKEN              : T O K E N                        ;
+T_TOKENS             : T O K E N S                      ;
+T_ROLE               : R O L E                          ;
+T_ROLES              : R O L E S                        ;
+T_GRANT              : G R A N T                        ;
+T_REVOKE             : R E V O K E                      ;
+T_READ               : R E A D                          ;
+T_WRITE              : W R I T E                        ;
+T_ALL                : A L L                            ;
+T_TENANT             : T E N A N T                      ;
+T_TENANTS            : T E N A N T S                    ;
+T_USAGE              : U S A G E                        ;
+T_INGEST_RATE        : I N G E S T T_UNDERLINE R A T E  ;
+T_MAX_SERIES         : M A X T_UNDERLINE S E R I E S    ;
+T_MAX_QUERIES        : M A X T_UNDERLINE Q U E R I E S  ;
+T_MAX_DISK           : M A X T_UNDERLINE D I S K        ;
+T_AUDIT              : A U D I T                        ;
+T_REBALANCE          : R E B A L A N C E                ;
+T_DECOMMISSION       : D E C O M M I S S I O N          ;
+T_REPLICA            : R E P L I C A                    ;
+
 T_SUM                : S U M                            ;
 T_MIN                : M I N                            ;
 T_MAX                : M A X                            ;
@@ -445,6 +602,7 @@ T_YEAR               : Y                                ;
 //
 T_DOT                :  '.'   ;
 T_COLON              :  ':'   ;
+T_SEMICOLON          :  ';'   ;
 T_EQUAL              :  '='   ;
 T_NOTEQUAL           :  '<>'  ;
 T_NOTEQUAL2          :  '!='  ;
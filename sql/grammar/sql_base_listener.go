@@ -211,6 +211,270 @@ func (s *BaseSQLListener) EnterShowTagValuesStmt(ctx *ShowTagValuesStmtContext)
 // ExitShowTagValuesStmt is called when production showTagValuesStmt is exited.
 func (s *BaseSQLListener) ExitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) {}
 
+// EnterAlterDatabaseStmt is called when production alterDatabaseStmt is entered.
+func (s *BaseSQLListener) EnterAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) {}
+
+// ExitAlterDatabaseStmt is called when production alterDatabaseStmt is exited.
+func (s *BaseSQLListener) ExitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) {}
+
+// EnterBackupStmt is called when production backupStmt is entered.
+func (s *BaseSQLListener) EnterBackupStmt(ctx *BackupStmtContext) {}
+
+// ExitBackupStmt is called when production backupStmt is exited.
+func (s *BaseSQLListener) ExitBackupStmt(ctx *BackupStmtContext) {}
+
+// EnterRestoreStmt is called when production restoreStmt is entered.
+func (s *BaseSQLListener) EnterRestoreStmt(ctx *RestoreStmtContext) {}
+
+// ExitRestoreStmt is called when production restoreStmt is exited.
+func (s *BaseSQLListener) ExitRestoreStmt(ctx *RestoreStmtContext) {}
+
+// EnterCompactStmt is called when production compactStmt is entered.
+func (s *BaseSQLListener) EnterCompactStmt(ctx *CompactStmtContext) {}
+
+// ExitCompactStmt is called when production compactStmt is exited.
+func (s *BaseSQLListener) ExitCompactStmt(ctx *CompactStmtContext) {}
+
+// EnterCompactOption is called when production compactOption is entered.
+func (s *BaseSQLListener) EnterCompactOption(ctx *CompactOptionContext) {}
+
+// ExitCompactOption is called when production compactOption is exited.
+func (s *BaseSQLListener) ExitCompactOption(ctx *CompactOptionContext) {}
+
+// EnterShardIDList is called when production shardIDList is entered.
+func (s *BaseSQLListener) EnterShardIDList(ctx *ShardIDListContext) {}
+
+// ExitShardIDList is called when production shardIDList is exited.
+func (s *BaseSQLListener) ExitShardIDList(ctx *ShardIDListContext) {}
+
+// EnterShowCompactionStmt is called when production showCompactionStmt is entered.
+func (s *BaseSQLListener) EnterShowCompactionStmt(ctx *ShowCompactionStmtContext) {}
+
+// ExitShowCompactionStmt is called when production showCompactionStmt is exited.
+func (s *BaseSQLListener) ExitShowCompactionStmt(ctx *ShowCompactionStmtContext) {}
+
+// EnterShowSlowRequestsStmt is called when production showSlowRequestsStmt is entered.
+func (s *BaseSQLListener) EnterShowSlowRequestsStmt(ctx *ShowSlowRequestsStmtContext) {}
+
+// ExitShowSlowRequestsStmt is called when production showSlowRequestsStmt is exited.
+func (s *BaseSQLListener) ExitShowSlowRequestsStmt(ctx *ShowSlowRequestsStmtContext) {}
+
+// EnterCreateRecordingRuleStmt is called when production createRecordingRuleStmt is entered.
+func (s *BaseSQLListener) EnterCreateRecordingRuleStmt(ctx *CreateRecordingRuleStmtContext) {}
+
+// ExitCreateRecordingRuleStmt is called when production createRecordingRuleStmt is exited.
+func (s *BaseSQLListener) ExitCreateRecordingRuleStmt(ctx *CreateRecordingRuleStmtContext) {}
+
+// EnterRecordingRuleOption is called when production recordingRuleOption is entered.
+func (s *BaseSQLListener) EnterRecordingRuleOption(ctx *RecordingRuleOptionContext) {}
+
+// ExitRecordingRuleOption is called when production recordingRuleOption is exited.
+func (s *BaseSQLListener) ExitRecordingRuleOption(ctx *RecordingRuleOptionContext) {}
+
+// EnterShowRecordingRulesStmt is called when production showRecordingRulesStmt is entered.
+func (s *BaseSQLListener) EnterShowRecordingRulesStmt(ctx *ShowRecordingRulesStmtContext) {}
+
+// ExitShowRecordingRulesStmt is called when production showRecordingRulesStmt is exited.
+func (s *BaseSQLListener) ExitShowRecordingRulesStmt(ctx *ShowRecordingRulesStmtContext) {}
+
+// EnterDropRecordingRuleStmt is called when production dropRecordingRuleStmt is entered.
+func (s *BaseSQLListener) EnterDropRecordingRuleStmt(ctx *DropRecordingRuleStmtContext) {}
+
+// ExitDropRecordingRuleStmt is called when production dropRecordingRuleStmt is exited.
+func (s *BaseSQLListener) ExitDropRecordingRuleStmt(ctx *DropRecordingRuleStmtContext) {}
+
+// EnterCreateAlertStmt is called when production createAlertStmt is entered.
+func (s *BaseSQLListener) EnterCreateAlertStmt(ctx *CreateAlertStmtContext) {}
+
+// ExitCreateAlertStmt is called when production createAlertStmt is exited.
+func (s *BaseSQLListener) ExitCreateAlertStmt(ctx *CreateAlertStmtContext) {}
+
+// EnterAlertOption is called when production alertOption is entered.
+func (s *BaseSQLListener) EnterAlertOption(ctx *AlertOptionContext) {}
+
+// ExitAlertOption is called when production alertOption is exited.
+func (s *BaseSQLListener) ExitAlertOption(ctx *AlertOptionContext) {}
+
+// EnterShowAlertsStmt is called when production showAlertsStmt is entered.
+func (s *BaseSQLListener) EnterShowAlertsStmt(ctx *ShowAlertsStmtContext) {}
+
+// ExitShowAlertsStmt is called when production showAlertsStmt is exited.
+func (s *BaseSQLListener) ExitShowAlertsStmt(ctx *ShowAlertsStmtContext) {}
+
+// EnterDropAlertStmt is called when production dropAlertStmt is entered.
+func (s *BaseSQLListener) EnterDropAlertStmt(ctx *DropAlertStmtContext) {}
+
+// ExitDropAlertStmt is called when production dropAlertStmt is exited.
+func (s *BaseSQLListener) ExitDropAlertStmt(ctx *DropAlertStmtContext) {}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (s *BaseSQLListener) EnterCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// ExitCreateUserStmt is called when production createUserStmt is exited.
+func (s *BaseSQLListener) ExitCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// EnterAlterUserStmt is called when production alterUserStmt is entered.
+func (s *BaseSQLListener) EnterAlterUserStmt(ctx *AlterUserStmtContext) {}
+
+// ExitAlterUserStmt is called when production alterUserStmt is exited.
+func (s *BaseSQLListener) ExitAlterUserStmt(ctx *AlterUserStmtContext) {}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (s *BaseSQLListener) EnterDropUserStmt(ctx *DropUserStmtContext) {}
+
+// ExitDropUserStmt is called when production dropUserStmt is exited.
+func (s *BaseSQLListener) ExitDropUserStmt(ctx *DropUserStmtContext) {}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (s *BaseSQLListener) EnterShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// ExitShowUsersStmt is called when production showUsersStmt is exited.
+func (s *BaseSQLListener) ExitShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// EnterCreateRoleStmt is called when production createRoleStmt is entered.
+func (s *BaseSQLListener) EnterCreateRoleStmt(ctx *CreateRoleStmtContext) {}
+
+// ExitCreateRoleStmt is called when production createRoleStmt is exited.
+func (s *BaseSQLListener) ExitCreateRoleStmt(ctx *CreateRoleStmtContext) {}
+
+// EnterDropRoleStmt is called when production dropRoleStmt is entered.
+func (s *BaseSQLListener) EnterDropRoleStmt(ctx *DropRoleStmtContext) {}
+
+// ExitDropRoleStmt is called when production dropRoleStmt is exited.
+func (s *BaseSQLListener) ExitDropRoleStmt(ctx *DropRoleStmtContext) {}
+
+// EnterShowRolesStmt is called when production showRolesStmt is entered.
+func (s *BaseSQLListener) EnterShowRolesStmt(ctx *ShowRolesStmtContext) {}
+
+// ExitShowRolesStmt is called when production showRolesStmt is exited.
+func (s *BaseSQLListener) ExitShowRolesStmt(ctx *ShowRolesStmtContext) {}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (s *BaseSQLListener) EnterGrantStmt(ctx *GrantStmtContext) {}
+
+// ExitGrantStmt is called when production grantStmt is exited.
+func (s *BaseSQLListener) ExitGrantStmt(ctx *GrantStmtContext) {}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (s *BaseSQLListener) EnterRevokeStmt(ctx *RevokeStmtContext) {}
+
+// ExitRevokeStmt is called when production revokeStmt is exited.
+func (s *BaseSQLListener) ExitRevokeStmt(ctx *RevokeStmtContext) {}
+
+// EnterRoleGrant is called when production roleGrant is entered.
+func (s *BaseSQLListener) EnterRoleGrant(ctx *RoleGrantContext) {}
+
+// ExitRoleGrant is called when production roleGrant is exited.
+func (s *BaseSQLListener) ExitRoleGrant(ctx *RoleGrantContext) {}
+
+// EnterPrivilegeGrant is called when production privilegeGrant is entered.
+func (s *BaseSQLListener) EnterPrivilegeGrant(ctx *PrivilegeGrantContext) {}
+
+// ExitPrivilegeGrant is called when production privilegeGrant is exited.
+func (s *BaseSQLListener) ExitPrivilegeGrant(ctx *PrivilegeGrantContext) {}
+
+// EnterPrivilegeDatabase is called when production privilegeDatabase is entered.
+func (s *BaseSQLListener) EnterPrivilegeDatabase(ctx *PrivilegeDatabaseContext) {}
+
+// ExitPrivilegeDatabase is called when production privilegeDatabase is exited.
+func (s *BaseSQLListener) ExitPrivilegeDatabase(ctx *PrivilegeDatabaseContext) {}
+
+// EnterPrivilegeList is called when production privilegeList is entered.
+func (s *BaseSQLListener) EnterPrivilegeList(ctx *PrivilegeListContext) {}
+
+// ExitPrivilegeList is called when production privilegeList is exited.
+func (s *BaseSQLListener) ExitPrivilegeList(ctx *PrivilegeListContext) {}
+
+// EnterPrivilege is called when production privilege is entered.
+func (s *BaseSQLListener) EnterPrivilege(ctx *PrivilegeContext) {}
+
+// ExitPrivilege is called when production privilege is exited.
+func (s *BaseSQLListener) ExitPrivilege(ctx *PrivilegeContext) {}
+
+// EnterCreateTokenStmt is called when production createTokenStmt is entered.
+func (s *BaseSQLListener) EnterCreateTokenStmt(ctx *CreateTokenStmtContext) {}
+
+// ExitCreateTokenStmt is called when production createTokenStmt is exited.
+func (s *BaseSQLListener) ExitCreateTokenStmt(ctx *CreateTokenStmtContext) {}
+
+// EnterDropTokenStmt is called when production dropTokenStmt is entered.
+func (s *BaseSQLListener) EnterDropTokenStmt(ctx *DropTokenStmtContext) {}
+
+// ExitDropTokenStmt is called when production dropTokenStmt is exited.
+func (s *BaseSQLListener) ExitDropTokenStmt(ctx *DropTokenStmtContext) {}
+
+// EnterShowTokensStmt is called when production showTokensStmt is entered.
+func (s *BaseSQLListener) EnterShowTokensStmt(ctx *ShowTokensStmtContext) {}
+
+// ExitShowTokensStmt is called when production showTokensStmt is exited.
+func (s *BaseSQLListener) ExitShowTokensStmt(ctx *ShowTokensStmtContext) {}
+
+// EnterCreateTenantStmt is called when production createTenantStmt is entered.
+func (s *BaseSQLListener) EnterCreateTenantStmt(ctx *CreateTenantStmtContext) {}
+
+// ExitCreateTenantStmt is called when production createTenantStmt is exited.
+func (s *BaseSQLListener) ExitCreateTenantStmt(ctx *CreateTenantStmtContext) {}
+
+// EnterAlterTenantStmt is called when production alterTenantStmt is entered.
+func (s *BaseSQLListener) EnterAlterTenantStmt(ctx *AlterTenantStmtContext) {}
+
+// ExitAlterTenantStmt is called when production alterTenantStmt is exited.
+func (s *BaseSQLListener) ExitAlterTenantStmt(ctx *AlterTenantStmtContext) {}
+
+// EnterTenantQuota is called when production tenantQuota is entered.
+func (s *BaseSQLListener) EnterTenantQuota(ctx *TenantQuotaContext) {}
+
+// ExitTenantQuota is called when production tenantQuota is exited.
+func (s *BaseSQLListener) ExitTenantQuota(ctx *TenantQuotaContext) {}
+
+// EnterDropTenantStmt is called when production dropTenantStmt is entered.
+func (s *BaseSQLListener) EnterDropTenantStmt(ctx *DropTenantStmtContext) {}
+
+// ExitDropTenantStmt is called when production dropTenantStmt is exited.
+func (s *BaseSQLListener) ExitDropTenantStmt(ctx *DropTenantStmtContext) {}
+
+// EnterShowTenantsStmt is called when production showTenantsStmt is entered.
+func (s *BaseSQLListener) EnterShowTenantsStmt(ctx *ShowTenantsStmtContext) {}
+
+// ExitShowTenantsStmt is called when production showTenantsStmt is exited.
+func (s *BaseSQLListener) ExitShowTenantsStmt(ctx *ShowTenantsStmtContext) {}
+
+// EnterShowTenantUsageStmt is called when production showTenantUsageStmt is entered.
+func (s *BaseSQLListener) EnterShowTenantUsageStmt(ctx *ShowTenantUsageStmtContext) {}
+
+// ExitShowTenantUsageStmt is called when production showTenantUsageStmt is exited.
+func (s *BaseSQLListener) ExitShowTenantUsageStmt(ctx *ShowTenantUsageStmtContext) {}
+
+// EnterShowAuditLogStmt is called when production showAuditLogStmt is entered.
+func (s *BaseSQLListener) EnterShowAuditLogStmt(ctx *ShowAuditLogStmtContext) {}
+
+// ExitShowAuditLogStmt is called when production showAuditLogStmt is exited.
+func (s *BaseSQLListener) ExitShowAuditLogStmt(ctx *ShowAuditLogStmtContext) {}
+
+// EnterShowRebalanceStmt is called when production showRebalanceStmt is entered.
+func (s *BaseSQLListener) EnterShowRebalanceStmt(ctx *ShowRebalanceStmtContext) {}
+
+// ExitShowRebalanceStmt is called when production showRebalanceStmt is exited.
+func (s *BaseSQLListener) ExitShowRebalanceStmt(ctx *ShowRebalanceStmtContext) {}
+
+// EnterDecommissionStmt is called when production decommissionStmt is entered.
+func (s *BaseSQLListener) EnterDecommissionStmt(ctx *DecommissionStmtContext) {}
+
+// ExitDecommissionStmt is called when production decommissionStmt is exited.
+func (s *BaseSQLListener) ExitDecommissionStmt(ctx *DecommissionStmtContext) {}
+
+// EnterDurationValue is called when production durationValue is entered.
+func (s *BaseSQLListener) EnterDurationValue(ctx *DurationValueContext) {}
+
+// ExitDurationValue is called when production durationValue is exited.
+func (s *BaseSQLListener) ExitDurationValue(ctx *DurationValueContext) {}
+
+// EnterTextValue is called when production textValue is entered.
+func (s *BaseSQLListener) EnterTextValue(ctx *TextValueContext) {}
+
+// ExitTextValue is called when production textValue is exited.
+func (s *BaseSQLListener) ExitTextValue(ctx *TextValueContext) {}
+
 // EnterPrefix is called when production prefix is entered.
 func (s *BaseSQLListener) EnterPrefix(ctx *PrefixContext) {}
 
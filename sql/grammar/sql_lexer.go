@@ -66,10 +66,13 @@ func sqllexerLexerInit() {
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'m'",
-		"", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='",
-		"'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('",
-		"')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "'m'", "", "", "", "'M'", "", "'.'",
+		"':'", "';'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'", "'<='", "'=~'",
+		"'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'", "'+'", "'-'",
+		"'/'", "'*'", "'%'", "'_'",
 	}
 	staticData.symbolicNames = []string{
 		"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
@@ -85,13 +88,21 @@ func sqllexerLexerInit() {
 		"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
 		"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME",
 		"T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID",
-		"T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG",
-		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR",
-		"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
-		"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS",
-		"T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B",
-		"T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB",
-		"T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
+		"T_ALTER", "T_BACKUP", "T_RESTORE", "T_TO", "T_COMPACT", "T_ROLLUP",
+		"T_COMPACTION", "T_FAMILY", "T_SLOW", "T_RECORDING", "T_RULE", "T_RULES",
+		"T_INTO", "T_EVERY", "T_ALERT", "T_ALERTS", "T_WHEN", "T_LABELS", "T_WEBHOOK",
+		"T_FORMAT", "T_USER", "T_USERS", "T_PASSWORD", "T_TOKEN", "T_TOKENS",
+		"T_ROLE", "T_ROLES", "T_GRANT", "T_REVOKE", "T_READ", "T_WRITE", "T_ALL",
+		"T_TENANT", "T_TENANTS", "T_USAGE", "T_INGEST_RATE", "T_MAX_SERIES",
+		"T_MAX_QUERIES", "T_MAX_DISK", "T_AUDIT", "T_REBALANCE", "T_DECOMMISSION",
+		"T_REPLICA", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST",
+		"T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE",
+		"T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON",
+		"T_SEMICOLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER",
+		"T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP",
+		"T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P",
+		"T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE",
+		"L_ID", "L_INT", "L_DEC",
 	}
 	staticData.ruleNames = []string{
 		"T__0", "T__1", "T__2", "STRING", "ESC", "UNICODE", "HEX", "SAFECODEPOINT",
@@ -108,20 +119,27 @@ func sqllexerLexerInit() {
 		"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
 		"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME",
 		"T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID",
-		"T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG",
-		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR",
-		"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
-		"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS",
-		"T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B",
-		"T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB",
-		"T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
-		"BLANK", "L_DIGIT", "L_ID_PART", "A", "B", "C", "D", "E", "F", "G",
-		"H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U",
-		"V", "W", "X", "Y", "Z",
+		"T_ALTER", "T_BACKUP", "T_RESTORE", "T_TO", "T_COMPACT", "T_ROLLUP",
+		"T_COMPACTION", "T_FAMILY", "T_SLOW", "T_RECORDING", "T_RULE", "T_RULES",
+		"T_INTO", "T_EVERY", "T_ALERT", "T_ALERTS", "T_WHEN", "T_LABELS", "T_WEBHOOK",
+		"T_FORMAT", "T_USER", "T_USERS", "T_PASSWORD", "T_TOKEN", "T_TOKENS",
+		"T_ROLE", "T_ROLES", "T_GRANT", "T_REVOKE", "T_READ", "T_WRITE", "T_ALL",
+		"T_TENANT", "T_TENANTS", "T_USAGE", "T_INGEST_RATE", "T_MAX_SERIES",
+		"T_MAX_QUERIES", "T_MAX_DISK", "T_AUDIT", "T_REBALANCE", "T_DECOMMISSION",
+		"T_REPLICA", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST",
+		"T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE",
+		"T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON",
+		"T_SEMICOLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER",
+		"T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP",
+		"T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P",
+		"T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE",
+		"L_ID", "L_INT", "L_DEC", "BLANK", "L_DIGIT", "L_ID_PART", "A", "B",
+		"C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P",
+		"Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 129, 1153, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		4, 0, 173, 1551, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
 		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
 		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
 		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
@@ -154,498 +172,691 @@ func sqllexerLexerInit() {
 		2, 149, 7, 149, 2, 150, 7, 150, 2, 151, 7, 151, 2, 152, 7, 152, 2, 153,
 		7, 153, 2, 154, 7, 154, 2, 155, 7, 155, 2, 156, 7, 156, 2, 157, 7, 157,
 		2, 158, 7, 158, 2, 159, 7, 159, 2, 160, 7, 160, 2, 161, 7, 161, 2, 162,
-		7, 162, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-		1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 5, 3, 347, 8, 3, 10,
-		3, 12, 3, 350, 9, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 3, 4, 357, 8, 4, 1,
-		5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 3,
-		8, 371, 8, 8, 1, 8, 1, 8, 1, 9, 4, 9, 376, 8, 9, 11, 9, 12, 9, 377, 1,
-		9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11,
-		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1,
-		13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14,
-		1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1,
-		16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
-		1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
-		18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20,
-		1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1,
-		22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23,
-		1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1,
-		25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27,
-		1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1,
-		28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29,
-		1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1,
-		30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31,
-		1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34,
-		1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1,
-		36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37,
-		1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1,
-		39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40,
-		1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
-		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
-		1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
-		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45,
-		1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1,
-		46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48,
-		1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1,
-		50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52,
-		1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1,
-		54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55,
-		1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
-		57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59,
-		1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1,
-		61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62,
-		1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1,
-		63, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65,
-		1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1,
-		68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
-		1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1,
-		71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73,
-		1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1,
-		75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77,
-		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1,
-		80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 82,
-		1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1,
-		84, 1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86,
-		1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1,
-		87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89,
-		1, 89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1,
-		92, 1, 92, 1, 92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94,
-		1, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 96, 1,
-		96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 98,
-		1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1,
-		99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 101, 1, 101, 1, 102, 1, 102, 1, 103,
-		1, 103, 1, 104, 1, 104, 1, 105, 1, 105, 1, 106, 1, 106, 1, 107, 1, 107,
-		1, 108, 1, 108, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111,
-		1, 111, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114, 1, 115,
-		1, 115, 1, 115, 1, 116, 1, 116, 1, 116, 1, 117, 1, 117, 1, 117, 1, 118,
-		1, 118, 1, 119, 1, 119, 1, 120, 1, 120, 1, 121, 1, 121, 1, 122, 1, 122,
-		1, 123, 1, 123, 1, 124, 1, 124, 1, 125, 1, 125, 1, 126, 1, 126, 1, 127,
-		1, 127, 1, 128, 1, 128, 1, 129, 1, 129, 1, 130, 1, 130, 1, 131, 1, 131,
-		1, 132, 4, 132, 1021, 8, 132, 11, 132, 12, 132, 1022, 1, 133, 4, 133, 1026,
-		8, 133, 11, 133, 12, 133, 1027, 1, 133, 1, 133, 1, 133, 5, 133, 1033, 8,
-		133, 10, 133, 12, 133, 1036, 9, 133, 1, 133, 1, 133, 4, 133, 1040, 8, 133,
-		11, 133, 12, 133, 1041, 3, 133, 1044, 8, 133, 1, 134, 1, 134, 1, 135, 1,
-		135, 1, 136, 1, 136, 1, 136, 1, 136, 5, 136, 1054, 8, 136, 10, 136, 12,
-		136, 1057, 9, 136, 1, 136, 1, 136, 1, 136, 5, 136, 1062, 8, 136, 10, 136,
-		12, 136, 1065, 9, 136, 1, 136, 1, 136, 1, 136, 1, 136, 1, 136, 4, 136,
-		1072, 8, 136, 11, 136, 12, 136, 1073, 1, 136, 1, 136, 5, 136, 1078, 8,
-		136, 10, 136, 12, 136, 1081, 9, 136, 1, 136, 1, 136, 1, 136, 5, 136, 1086,
-		8, 136, 10, 136, 12, 136, 1089, 9, 136, 1, 136, 1, 136, 1, 136, 5, 136,
-		1094, 8, 136, 10, 136, 12, 136, 1097, 9, 136, 1, 136, 3, 136, 1100, 8,
-		136, 1, 137, 1, 137, 1, 138, 1, 138, 1, 139, 1, 139, 1, 140, 1, 140, 1,
-		141, 1, 141, 1, 142, 1, 142, 1, 143, 1, 143, 1, 144, 1, 144, 1, 145, 1,
-		145, 1, 146, 1, 146, 1, 147, 1, 147, 1, 148, 1, 148, 1, 149, 1, 149, 1,
-		150, 1, 150, 1, 151, 1, 151, 1, 152, 1, 152, 1, 153, 1, 153, 1, 154, 1,
-		154, 1, 155, 1, 155, 1, 156, 1, 156, 1, 157, 1, 157, 1, 158, 1, 158, 1,
-		159, 1, 159, 1, 160, 1, 160, 1, 161, 1, 161, 1, 162, 1, 162, 4, 1063, 1079,
-		1087, 1095, 0, 163, 1, 1, 3, 2, 5, 3, 7, 4, 9, 0, 11, 0, 13, 0, 15, 0,
-		17, 0, 19, 5, 21, 6, 23, 7, 25, 8, 27, 9, 29, 10, 31, 11, 33, 12, 35, 13,
-		37, 14, 39, 15, 41, 16, 43, 17, 45, 18, 47, 19, 49, 20, 51, 21, 53, 22,
-		55, 23, 57, 24, 59, 25, 61, 26, 63, 27, 65, 28, 67, 29, 69, 30, 71, 31,
-		73, 32, 75, 33, 77, 34, 79, 35, 81, 36, 83, 37, 85, 38, 87, 39, 89, 40,
-		91, 41, 93, 42, 95, 43, 97, 44, 99, 45, 101, 46, 103, 47, 105, 48, 107,
-		49, 109, 50, 111, 51, 113, 52, 115, 53, 117, 54, 119, 55, 121, 56, 123,
-		57, 125, 58, 127, 59, 129, 60, 131, 61, 133, 62, 135, 63, 137, 64, 139,
-		65, 141, 66, 143, 67, 145, 68, 147, 69, 149, 70, 151, 71, 153, 72, 155,
-		73, 157, 74, 159, 75, 161, 76, 163, 77, 165, 78, 167, 79, 169, 80, 171,
-		81, 173, 82, 175, 83, 177, 84, 179, 85, 181, 86, 183, 87, 185, 88, 187,
-		89, 189, 90, 191, 91, 193, 92, 195, 93, 197, 94, 199, 95, 201, 96, 203,
-		97, 205, 98, 207, 99, 209, 100, 211, 101, 213, 102, 215, 103, 217, 104,
-		219, 105, 221, 106, 223, 107, 225, 108, 227, 109, 229, 110, 231, 111, 233,
-		112, 235, 113, 237, 114, 239, 115, 241, 116, 243, 117, 245, 118, 247, 119,
-		249, 120, 251, 121, 253, 122, 255, 123, 257, 124, 259, 125, 261, 126, 263,
-		127, 265, 128, 267, 129, 269, 0, 271, 0, 273, 0, 275, 0, 277, 0, 279, 0,
-		281, 0, 283, 0, 285, 0, 287, 0, 289, 0, 291, 0, 293, 0, 295, 0, 297, 0,
-		299, 0, 301, 0, 303, 0, 305, 0, 307, 0, 309, 0, 311, 0, 313, 0, 315, 0,
-		317, 0, 319, 0, 321, 0, 323, 0, 325, 0, 1, 0, 37, 8, 0, 34, 34, 47, 47,
-		92, 92, 98, 98, 102, 102, 110, 110, 114, 114, 116, 116, 3, 0, 48, 57, 65,
-		70, 97, 102, 3, 0, 0, 31, 34, 34, 92, 92, 2, 0, 69, 69, 101, 101, 2, 0,
-		43, 43, 45, 45, 3, 0, 9, 10, 13, 13, 32, 32, 1, 0, 46, 46, 1, 0, 48, 57,
-		2, 0, 65, 90, 97, 122, 2, 0, 46, 46, 95, 95, 3, 0, 35, 36, 64, 64, 95,
-		95, 4, 0, 35, 36, 58, 58, 64, 64, 95, 95, 2, 0, 65, 65, 97, 97, 2, 0, 66,
-		66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100, 100, 2, 0, 70, 70,
-		102, 102, 2, 0, 71, 71, 103, 103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73,
-		105, 105, 2, 0, 74, 74, 106, 106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76,
-		108, 108, 2, 0, 77, 77, 109, 109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79,
-		111, 111, 2, 0, 80, 80, 112, 112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82,
-		114, 114, 2, 0, 83, 83, 115, 115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85,
-		117, 117, 2, 0, 86, 86, 118, 118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88,
-		120, 120, 2, 0, 89, 89, 121, 121, 2, 0, 90, 90, 122, 122, 1143, 0, 1, 1,
-		0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 19, 1,
-		0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27,
-		1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0,
-		35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0,
-		0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0,
-		0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0,
-		0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1,
-		0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73,
-		1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0,
-		81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0,
-		0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0,
-		0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1,
-		0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0,
-		111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0,
-		0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125,
-		1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0,
-		0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1,
-		0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0,
-		147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0,
-		0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161,
-		1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0,
-		0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1,
-		0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0,
-		183, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0,
-		0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197,
-		1, 0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0,
-		0, 205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211, 1,
-		0, 0, 0, 0, 213, 1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0, 0,
-		219, 1, 0, 0, 0, 0, 221, 1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1, 0,
-		0, 0, 0, 227, 1, 0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 0, 233,
-		1, 0, 0, 0, 0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239, 1, 0, 0, 0,
-		0, 241, 1, 0, 0, 0, 0, 243, 1, 0, 0, 0, 0, 245, 1, 0, 0, 0, 0, 247, 1,
-		0, 0, 0, 0, 249, 1, 0, 0, 0, 0, 251, 1, 0, 0, 0, 0, 253, 1, 0, 0, 0, 0,
-		255, 1, 0, 0, 0, 0, 257, 1, 0, 0, 0, 0, 259, 1, 0, 0, 0, 0, 261, 1, 0,
-		0, 0, 0, 263, 1, 0, 0, 0, 0, 265, 1, 0, 0, 0, 0, 267, 1, 0, 0, 0, 1, 327,
-		1, 0, 0, 0, 3, 332, 1, 0, 0, 0, 5, 338, 1, 0, 0, 0, 7, 343, 1, 0, 0, 0,
-		9, 353, 1, 0, 0, 0, 11, 358, 1, 0, 0, 0, 13, 364, 1, 0, 0, 0, 15, 366,
-		1, 0, 0, 0, 17, 368, 1, 0, 0, 0, 19, 375, 1, 0, 0, 0, 21, 381, 1, 0, 0,
-		0, 23, 388, 1, 0, 0, 0, 25, 395, 1, 0, 0, 0, 27, 399, 1, 0, 0, 0, 29, 404,
-		1, 0, 0, 0, 31, 413, 1, 0, 0, 0, 33, 418, 1, 0, 0, 0, 35, 424, 1, 0, 0,
-		0, 37, 436, 1, 0, 0, 0, 39, 443, 1, 0, 0, 0, 41, 447, 1, 0, 0, 0, 43, 455,
-		1, 0, 0, 0, 45, 463, 1, 0, 0, 0, 47, 473, 1, 0, 0, 0, 49, 478, 1, 0, 0,
-		0, 51, 481, 1, 0, 0, 0, 53, 486, 1, 0, 0, 0, 55, 494, 1, 0, 0, 0, 57, 498,
-		1, 0, 0, 0, 59, 509, 1, 0, 0, 0, 61, 523, 1, 0, 0, 0, 63, 530, 1, 0, 0,
-		0, 65, 539, 1, 0, 0, 0, 67, 545, 1, 0, 0, 0, 69, 550, 1, 0, 0, 0, 71, 559,
-		1, 0, 0, 0, 73, 567, 1, 0, 0, 0, 75, 574, 1, 0, 0, 0, 77, 582, 1, 0, 0,
-		0, 79, 588, 1, 0, 0, 0, 81, 596, 1, 0, 0, 0, 83, 605, 1, 0, 0, 0, 85, 615,
-		1, 0, 0, 0, 87, 625, 1, 0, 0, 0, 89, 636, 1, 0, 0, 0, 91, 641, 1, 0, 0,
-		0, 93, 649, 1, 0, 0, 0, 95, 656, 1, 0, 0, 0, 97, 662, 1, 0, 0, 0, 99, 669,
-		1, 0, 0, 0, 101, 673, 1, 0, 0, 0, 103, 678, 1, 0, 0, 0, 105, 683, 1, 0,
-		0, 0, 107, 687, 1, 0, 0, 0, 109, 692, 1, 0, 0, 0, 111, 699, 1, 0, 0, 0,
-		113, 705, 1, 0, 0, 0, 115, 710, 1, 0, 0, 0, 117, 716, 1, 0, 0, 0, 119,
-		722, 1, 0, 0, 0, 121, 730, 1, 0, 0, 0, 123, 736, 1, 0, 0, 0, 125, 744,
-		1, 0, 0, 0, 127, 754, 1, 0, 0, 0, 129, 761, 1, 0, 0, 0, 131, 764, 1, 0,
-		0, 0, 133, 768, 1, 0, 0, 0, 135, 771, 1, 0, 0, 0, 137, 776, 1, 0, 0, 0,
-		139, 781, 1, 0, 0, 0, 141, 790, 1, 0, 0, 0, 143, 796, 1, 0, 0, 0, 145,
-		800, 1, 0, 0, 0, 147, 805, 1, 0, 0, 0, 149, 810, 1, 0, 0, 0, 151, 814,
-		1, 0, 0, 0, 153, 822, 1, 0, 0, 0, 155, 825, 1, 0, 0, 0, 157, 831, 1, 0,
-		0, 0, 159, 838, 1, 0, 0, 0, 161, 841, 1, 0, 0, 0, 163, 845, 1, 0, 0, 0,
-		165, 851, 1, 0, 0, 0, 167, 856, 1, 0, 0, 0, 169, 860, 1, 0, 0, 0, 171,
-		863, 1, 0, 0, 0, 173, 867, 1, 0, 0, 0, 175, 875, 1, 0, 0, 0, 177, 884,
-		1, 0, 0, 0, 179, 892, 1, 0, 0, 0, 181, 895, 1, 0, 0, 0, 183, 899, 1, 0,
-		0, 0, 185, 903, 1, 0, 0, 0, 187, 907, 1, 0, 0, 0, 189, 913, 1, 0, 0, 0,
-		191, 918, 1, 0, 0, 0, 193, 924, 1, 0, 0, 0, 195, 928, 1, 0, 0, 0, 197,
-		935, 1, 0, 0, 0, 199, 944, 1, 0, 0, 0, 201, 949, 1, 0, 0, 0, 203, 951,
-		1, 0, 0, 0, 205, 953, 1, 0, 0, 0, 207, 955, 1, 0, 0, 0, 209, 957, 1, 0,
-		0, 0, 211, 959, 1, 0, 0, 0, 213, 961, 1, 0, 0, 0, 215, 963, 1, 0, 0, 0,
-		217, 965, 1, 0, 0, 0, 219, 967, 1, 0, 0, 0, 221, 969, 1, 0, 0, 0, 223,
-		972, 1, 0, 0, 0, 225, 975, 1, 0, 0, 0, 227, 977, 1, 0, 0, 0, 229, 980,
-		1, 0, 0, 0, 231, 982, 1, 0, 0, 0, 233, 985, 1, 0, 0, 0, 235, 988, 1, 0,
-		0, 0, 237, 991, 1, 0, 0, 0, 239, 993, 1, 0, 0, 0, 241, 995, 1, 0, 0, 0,
-		243, 997, 1, 0, 0, 0, 245, 999, 1, 0, 0, 0, 247, 1001, 1, 0, 0, 0, 249,
-		1003, 1, 0, 0, 0, 251, 1005, 1, 0, 0, 0, 253, 1007, 1, 0, 0, 0, 255, 1009,
-		1, 0, 0, 0, 257, 1011, 1, 0, 0, 0, 259, 1013, 1, 0, 0, 0, 261, 1015, 1,
-		0, 0, 0, 263, 1017, 1, 0, 0, 0, 265, 1020, 1, 0, 0, 0, 267, 1043, 1, 0,
-		0, 0, 269, 1045, 1, 0, 0, 0, 271, 1047, 1, 0, 0, 0, 273, 1099, 1, 0, 0,
-		0, 275, 1101, 1, 0, 0, 0, 277, 1103, 1, 0, 0, 0, 279, 1105, 1, 0, 0, 0,
-		281, 1107, 1, 0, 0, 0, 283, 1109, 1, 0, 0, 0, 285, 1111, 1, 0, 0, 0, 287,
-		1113, 1, 0, 0, 0, 289, 1115, 1, 0, 0, 0, 291, 1117, 1, 0, 0, 0, 293, 1119,
-		1, 0, 0, 0, 295, 1121, 1, 0, 0, 0, 297, 1123, 1, 0, 0, 0, 299, 1125, 1,
-		0, 0, 0, 301, 1127, 1, 0, 0, 0, 303, 1129, 1, 0, 0, 0, 305, 1131, 1, 0,
-		0, 0, 307, 1133, 1, 0, 0, 0, 309, 1135, 1, 0, 0, 0, 311, 1137, 1, 0, 0,
-		0, 313, 1139, 1, 0, 0, 0, 315, 1141, 1, 0, 0, 0, 317, 1143, 1, 0, 0, 0,
-		319, 1145, 1, 0, 0, 0, 321, 1147, 1, 0, 0, 0, 323, 1149, 1, 0, 0, 0, 325,
-		1151, 1, 0, 0, 0, 327, 328, 5, 116, 0, 0, 328, 329, 5, 114, 0, 0, 329,
-		330, 5, 117, 0, 0, 330, 331, 5, 101, 0, 0, 331, 2, 1, 0, 0, 0, 332, 333,
-		5, 102, 0, 0, 333, 334, 5, 97, 0, 0, 334, 335, 5, 108, 0, 0, 335, 336,
-		5, 115, 0, 0, 336, 337, 5, 101, 0, 0, 337, 4, 1, 0, 0, 0, 338, 339, 5,
-		110, 0, 0, 339, 340, 5, 117, 0, 0, 340, 341, 5, 108, 0, 0, 341, 342, 5,
-		108, 0, 0, 342, 6, 1, 0, 0, 0, 343, 348, 5, 34, 0, 0, 344, 347, 3, 9, 4,
-		0, 345, 347, 3, 15, 7, 0, 346, 344, 1, 0, 0, 0, 346, 345, 1, 0, 0, 0, 347,
-		350, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 351,
-		1, 0, 0, 0, 350, 348, 1, 0, 0, 0, 351, 352, 5, 34, 0, 0, 352, 8, 1, 0,
-		0, 0, 353, 356, 5, 92, 0, 0, 354, 357, 7, 0, 0, 0, 355, 357, 3, 11, 5,
-		0, 356, 354, 1, 0, 0, 0, 356, 355, 1, 0, 0, 0, 357, 10, 1, 0, 0, 0, 358,
-		359, 5, 117, 0, 0, 359, 360, 3, 13, 6, 0, 360, 361, 3, 13, 6, 0, 361, 362,
-		3, 13, 6, 0, 362, 363, 3, 13, 6, 0, 363, 12, 1, 0, 0, 0, 364, 365, 7, 1,
-		0, 0, 365, 14, 1, 0, 0, 0, 366, 367, 8, 2, 0, 0, 367, 16, 1, 0, 0, 0, 368,
-		370, 7, 3, 0, 0, 369, 371, 7, 4, 0, 0, 370, 369, 1, 0, 0, 0, 370, 371,
-		1, 0, 0, 0, 371, 372, 1, 0, 0, 0, 372, 373, 3, 265, 132, 0, 373, 18, 1,
-		0, 0, 0, 374, 376, 7, 5, 0, 0, 375, 374, 1, 0, 0, 0, 376, 377, 1, 0, 0,
-		0, 377, 375, 1, 0, 0, 0, 377, 378, 1, 0, 0, 0, 378, 379, 1, 0, 0, 0, 379,
-		380, 6, 9, 0, 0, 380, 20, 1, 0, 0, 0, 381, 382, 3, 279, 139, 0, 382, 383,
-		3, 309, 154, 0, 383, 384, 3, 283, 141, 0, 384, 385, 3, 275, 137, 0, 385,
-		386, 3, 313, 156, 0, 386, 387, 3, 283, 141, 0, 387, 22, 1, 0, 0, 0, 388,
-		389, 3, 315, 157, 0, 389, 390, 3, 305, 152, 0, 390, 391, 3, 281, 140, 0,
-		391, 392, 3, 275, 137, 0, 392, 393, 3, 313, 156, 0, 393, 394, 3, 283, 141,
-		0, 394, 24, 1, 0, 0, 0, 395, 396, 3, 311, 155, 0, 396, 397, 3, 283, 141,
-		0, 397, 398, 3, 313, 156, 0, 398, 26, 1, 0, 0, 0, 399, 400, 3, 281, 140,
-		0, 400, 401, 3, 309, 154, 0, 401, 402, 3, 303, 151, 0, 402, 403, 3, 305,
-		152, 0, 403, 28, 1, 0, 0, 0, 404, 405, 3, 291, 145, 0, 405, 406, 3, 301,
-		150, 0, 406, 407, 3, 313, 156, 0, 407, 408, 3, 283, 141, 0, 408, 409, 3,
-		309, 154, 0, 409, 410, 3, 317, 158, 0, 410, 411, 3, 275, 137, 0, 411, 412,
-		3, 297, 148, 0, 412, 30, 1, 0, 0, 0, 413, 414, 3, 301, 150, 0, 414, 415,
-		3, 275, 137, 0, 415, 416, 3, 299, 149, 0, 416, 417, 3, 283, 141, 0, 417,
-		32, 1, 0, 0, 0, 418, 419, 3, 311, 155, 0, 419, 420, 3, 289, 144, 0, 420,
-		421, 3, 275, 137, 0, 421, 422, 3, 309, 154, 0, 422, 423, 3, 281, 140, 0,
-		423, 34, 1, 0, 0, 0, 424, 425, 3, 309, 154, 0, 425, 426, 3, 283, 141, 0,
-		426, 427, 3, 305, 152, 0, 427, 428, 3, 297, 148, 0, 428, 429, 3, 291, 145,
-		0, 429, 430, 3, 279, 139, 0, 430, 431, 3, 275, 137, 0, 431, 432, 3, 313,
-		156, 0, 432, 433, 3, 291, 145, 0, 433, 434, 3, 303, 151, 0, 434, 435, 3,
-		301, 150, 0, 435, 36, 1, 0, 0, 0, 436, 437, 3, 299, 149, 0, 437, 438, 3,
-		283, 141, 0, 438, 439, 3, 299, 149, 0, 439, 440, 3, 303, 151, 0, 440, 441,
-		3, 309, 154, 0, 441, 442, 3, 323, 161, 0, 442, 38, 1, 0, 0, 0, 443, 444,
-		3, 313, 156, 0, 444, 445, 3, 313, 156, 0, 445, 446, 3, 297, 148, 0, 446,
-		40, 1, 0, 0, 0, 447, 448, 3, 299, 149, 0, 448, 449, 3, 283, 141, 0, 449,
-		450, 3, 313, 156, 0, 450, 451, 3, 275, 137, 0, 451, 452, 3, 313, 156, 0,
-		452, 453, 3, 313, 156, 0, 453, 454, 3, 297, 148, 0, 454, 42, 1, 0, 0, 0,
-		455, 456, 3, 305, 152, 0, 456, 457, 3, 275, 137, 0, 457, 458, 3, 311, 155,
-		0, 458, 459, 3, 313, 156, 0, 459, 460, 3, 313, 156, 0, 460, 461, 3, 313,
-		156, 0, 461, 462, 3, 297, 148, 0, 462, 44, 1, 0, 0, 0, 463, 464, 3, 285,
-		142, 0, 464, 465, 3, 315, 157, 0, 465, 466, 3, 313, 156, 0, 466, 467, 3,
-		315, 157, 0, 467, 468, 3, 309, 154, 0, 468, 469, 3, 283, 141, 0, 469, 470,
-		3, 313, 156, 0, 470, 471, 3, 313, 156, 0, 471, 472, 3, 297, 148, 0, 472,
-		46, 1, 0, 0, 0, 473, 474, 3, 295, 147, 0, 474, 475, 3, 291, 145, 0, 475,
-		476, 3, 297, 148, 0, 476, 477, 3, 297, 148, 0, 477, 48, 1, 0, 0, 0, 478,
-		479, 3, 303, 151, 0, 479, 480, 3, 301, 150, 0, 480, 50, 1, 0, 0, 0, 481,
-		482, 3, 311, 155, 0, 482, 483, 3, 289, 144, 0, 483, 484, 3, 303, 151, 0,
-		484, 485, 3, 319, 159, 0, 485, 52, 1, 0, 0, 0, 486, 487, 3, 309, 154, 0,
-		487, 488, 3, 283, 141, 0, 488, 489, 3, 279, 139, 0, 489, 490, 3, 303, 151,
-		0, 490, 491, 3, 317, 158, 0, 491, 492, 3, 283, 141, 0, 492, 493, 3, 309,
-		154, 0, 493, 54, 1, 0, 0, 0, 494, 495, 3, 315, 157, 0, 495, 496, 3, 311,
-		155, 0, 496, 497, 3, 283, 141, 0, 497, 56, 1, 0, 0, 0, 498, 499, 3, 311,
-		155, 0, 499, 500, 3, 313, 156, 0, 500, 501, 3, 275, 137, 0, 501, 502, 3,
-		313, 156, 0, 502, 503, 3, 283, 141, 0, 503, 504, 3, 261, 130, 0, 504, 505,
-		3, 309, 154, 0, 505, 506, 3, 283, 141, 0, 506, 507, 3, 305, 152, 0, 507,
-		508, 3, 303, 151, 0, 508, 58, 1, 0, 0, 0, 509, 510, 3, 311, 155, 0, 510,
-		511, 3, 313, 156, 0, 511, 512, 3, 275, 137, 0, 512, 513, 3, 313, 156, 0,
-		513, 514, 3, 283, 141, 0, 514, 515, 3, 261, 130, 0, 515, 516, 3, 299, 149,
-		0, 516, 517, 3, 275, 137, 0, 517, 518, 3, 279, 139, 0, 518, 519, 3, 289,
-		144, 0, 519, 520, 3, 291, 145, 0, 520, 521, 3, 301, 150, 0, 521, 522, 3,
-		283, 141, 0, 522, 60, 1, 0, 0, 0, 523, 524, 3, 299, 149, 0, 524, 525, 3,
-		275, 137, 0, 525, 526, 3, 311, 155, 0, 526, 527, 3, 313, 156, 0, 527, 528,
-		3, 283, 141, 0, 528, 529, 3, 309, 154, 0, 529, 62, 1, 0, 0, 0, 530, 531,
-		3, 299, 149, 0, 531, 532, 3, 283, 141, 0, 532, 533, 3, 313, 156, 0, 533,
-		534, 3, 275, 137, 0, 534, 535, 3, 281, 140, 0, 535, 536, 3, 275, 137, 0,
-		536, 537, 3, 313, 156, 0, 537, 538, 3, 275, 137, 0, 538, 64, 1, 0, 0, 0,
-		539, 540, 3, 313, 156, 0, 540, 541, 3, 323, 161, 0, 541, 542, 3, 305, 152,
-		0, 542, 543, 3, 283, 141, 0, 543, 544, 3, 311, 155, 0, 544, 66, 1, 0, 0,
-		0, 545, 546, 3, 313, 156, 0, 546, 547, 3, 323, 161, 0, 547, 548, 3, 305,
-		152, 0, 548, 549, 3, 283, 141, 0, 549, 68, 1, 0, 0, 0, 550, 551, 3, 311,
-		155, 0, 551, 552, 3, 313, 156, 0, 552, 553, 3, 303, 151, 0, 553, 554, 3,
-		309, 154, 0, 554, 555, 3, 275, 137, 0, 555, 556, 3, 287, 143, 0, 556, 557,
-		3, 283, 141, 0, 557, 558, 3, 311, 155, 0, 558, 70, 1, 0, 0, 0, 559, 560,
-		3, 311, 155, 0, 560, 561, 3, 313, 156, 0, 561, 562, 3, 303, 151, 0, 562,
-		563, 3, 309, 154, 0, 563, 564, 3, 275, 137, 0, 564, 565, 3, 287, 143, 0,
-		565, 566, 3, 283, 141, 0, 566, 72, 1, 0, 0, 0, 567, 568, 3, 277, 138, 0,
-		568, 569, 3, 309, 154, 0, 569, 570, 3, 303, 151, 0, 570, 571, 3, 295, 147,
-		0, 571, 572, 3, 283, 141, 0, 572, 573, 3, 309, 154, 0, 573, 74, 1, 0, 0,
-		0, 574, 575, 3, 277, 138, 0, 575, 576, 3, 309, 154, 0, 576, 577, 3, 303,
-		151, 0, 577, 578, 3, 295, 147, 0, 578, 579, 3, 283, 141, 0, 579, 580, 3,
-		309, 154, 0, 580, 581, 3, 311, 155, 0, 581, 76, 1, 0, 0, 0, 582, 583, 3,
-		275, 137, 0, 583, 584, 3, 297, 148, 0, 584, 585, 3, 291, 145, 0, 585, 586,
-		3, 317, 158, 0, 586, 587, 3, 283, 141, 0, 587, 78, 1, 0, 0, 0, 588, 589,
-		3, 311, 155, 0, 589, 590, 3, 279, 139, 0, 590, 591, 3, 289, 144, 0, 591,
-		592, 3, 283, 141, 0, 592, 593, 3, 299, 149, 0, 593, 594, 3, 275, 137, 0,
-		594, 595, 3, 311, 155, 0, 595, 80, 1, 0, 0, 0, 596, 597, 3, 281, 140, 0,
-		597, 598, 3, 275, 137, 0, 598, 599, 3, 313, 156, 0, 599, 600, 3, 275, 137,
-		0, 600, 601, 3, 277, 138, 0, 601, 602, 3, 275, 137, 0, 602, 603, 3, 311,
-		155, 0, 603, 604, 3, 283, 141, 0, 604, 82, 1, 0, 0, 0, 605, 606, 3, 281,
-		140, 0, 606, 607, 3, 275, 137, 0, 607, 608, 3, 313, 156, 0, 608, 609, 3,
-		275, 137, 0, 609, 610, 3, 277, 138, 0, 610, 611, 3, 275, 137, 0, 611, 612,
-		3, 311, 155, 0, 612, 613, 3, 283, 141, 0, 613, 614, 3, 311, 155, 0, 614,
-		84, 1, 0, 0, 0, 615, 616, 3, 301, 150, 0, 616, 617, 3, 275, 137, 0, 617,
-		618, 3, 299, 149, 0, 618, 619, 3, 283, 141, 0, 619, 620, 3, 311, 155, 0,
-		620, 621, 3, 305, 152, 0, 621, 622, 3, 275, 137, 0, 622, 623, 3, 279, 139,
-		0, 623, 624, 3, 283, 141, 0, 624, 86, 1, 0, 0, 0, 625, 626, 3, 301, 150,
-		0, 626, 627, 3, 275, 137, 0, 627, 628, 3, 299, 149, 0, 628, 629, 3, 283,
-		141, 0, 629, 630, 3, 311, 155, 0, 630, 631, 3, 305, 152, 0, 631, 632, 3,
-		275, 137, 0, 632, 633, 3, 279, 139, 0, 633, 634, 3, 283, 141, 0, 634, 635,
-		3, 311, 155, 0, 635, 88, 1, 0, 0, 0, 636, 637, 3, 301, 150, 0, 637, 638,
-		3, 303, 151, 0, 638, 639, 3, 281, 140, 0, 639, 640, 3, 283, 141, 0, 640,
-		90, 1, 0, 0, 0, 641, 642, 3, 299, 149, 0, 642, 643, 3, 283, 141, 0, 643,
-		644, 3, 313, 156, 0, 644, 645, 3, 309, 154, 0, 645, 646, 3, 291, 145, 0,
-		646, 647, 3, 279, 139, 0, 647, 648, 3, 311, 155, 0, 648, 92, 1, 0, 0, 0,
-		649, 650, 3, 299, 149, 0, 650, 651, 3, 283, 141, 0, 651, 652, 3, 313, 156,
-		0, 652, 653, 3, 309, 154, 0, 653, 654, 3, 291, 145, 0, 654, 655, 3, 279,
-		139, 0, 655, 94, 1, 0, 0, 0, 656, 657, 3, 285, 142, 0, 657, 658, 3, 291,
-		145, 0, 658, 659, 3, 283, 141, 0, 659, 660, 3, 297, 148, 0, 660, 661, 3,
-		281, 140, 0, 661, 96, 1, 0, 0, 0, 662, 663, 3, 285, 142, 0, 663, 664, 3,
-		291, 145, 0, 664, 665, 3, 283, 141, 0, 665, 666, 3, 297, 148, 0, 666, 667,
-		3, 281, 140, 0, 667, 668, 3, 311, 155, 0, 668, 98, 1, 0, 0, 0, 669, 670,
-		3, 313, 156, 0, 670, 671, 3, 275, 137, 0, 671, 672, 3, 287, 143, 0, 672,
-		100, 1, 0, 0, 0, 673, 674, 3, 291, 145, 0, 674, 675, 3, 301, 150, 0, 675,
-		676, 3, 285, 142, 0, 676, 677, 3, 303, 151, 0, 677, 102, 1, 0, 0, 0, 678,
-		679, 3, 295, 147, 0, 679, 680, 3, 283, 141, 0, 680, 681, 3, 323, 161, 0,
-		681, 682, 3, 311, 155, 0, 682, 104, 1, 0, 0, 0, 683, 684, 3, 295, 147,
-		0, 684, 685, 3, 283, 141, 0, 685, 686, 3, 323, 161, 0, 686, 106, 1, 0,
-		0, 0, 687, 688, 3, 319, 159, 0, 688, 689, 3, 291, 145, 0, 689, 690, 3,
-		313, 156, 0, 690, 691, 3, 289, 144, 0, 691, 108, 1, 0, 0, 0, 692, 693,
-		3, 317, 158, 0, 693, 694, 3, 275, 137, 0, 694, 695, 3, 297, 148, 0, 695,
-		696, 3, 315, 157, 0, 696, 697, 3, 283, 141, 0, 697, 698, 3, 311, 155, 0,
-		698, 110, 1, 0, 0, 0, 699, 700, 3, 317, 158, 0, 700, 701, 3, 275, 137,
-		0, 701, 702, 3, 297, 148, 0, 702, 703, 3, 315, 157, 0, 703, 704, 3, 283,
-		141, 0, 704, 112, 1, 0, 0, 0, 705, 706, 3, 285, 142, 0, 706, 707, 3, 309,
-		154, 0, 707, 708, 3, 303, 151, 0, 708, 709, 3, 299, 149, 0, 709, 114, 1,
-		0, 0, 0, 710, 711, 3, 319, 159, 0, 711, 712, 3, 289, 144, 0, 712, 713,
-		3, 283, 141, 0, 713, 714, 3, 309, 154, 0, 714, 715, 3, 283, 141, 0, 715,
-		116, 1, 0, 0, 0, 716, 717, 3, 297, 148, 0, 717, 718, 3, 291, 145, 0, 718,
-		719, 3, 299, 149, 0, 719, 720, 3, 291, 145, 0, 720, 721, 3, 313, 156, 0,
-		721, 118, 1, 0, 0, 0, 722, 723, 3, 307, 153, 0, 723, 724, 3, 315, 157,
-		0, 724, 725, 3, 283, 141, 0, 725, 726, 3, 309, 154, 0, 726, 727, 3, 291,
-		145, 0, 727, 728, 3, 283, 141, 0, 728, 729, 3, 311, 155, 0, 729, 120, 1,
-		0, 0, 0, 730, 731, 3, 307, 153, 0, 731, 732, 3, 315, 157, 0, 732, 733,
-		3, 283, 141, 0, 733, 734, 3, 309, 154, 0, 734, 735, 3, 323, 161, 0, 735,
-		122, 1, 0, 0, 0, 736, 737, 3, 283, 141, 0, 737, 738, 3, 321, 160, 0, 738,
-		739, 3, 305, 152, 0, 739, 740, 3, 297, 148, 0, 740, 741, 3, 275, 137, 0,
-		741, 742, 3, 291, 145, 0, 742, 743, 3, 301, 150, 0, 743, 124, 1, 0, 0,
-		0, 744, 745, 3, 319, 159, 0, 745, 746, 3, 291, 145, 0, 746, 747, 3, 313,
-		156, 0, 747, 748, 3, 289, 144, 0, 748, 749, 3, 317, 158, 0, 749, 750, 3,
-		275, 137, 0, 750, 751, 3, 297, 148, 0, 751, 752, 3, 315, 157, 0, 752, 753,
-		3, 283, 141, 0, 753, 126, 1, 0, 0, 0, 754, 755, 3, 311, 155, 0, 755, 756,
-		3, 283, 141, 0, 756, 757, 3, 297, 148, 0, 757, 758, 3, 283, 141, 0, 758,
-		759, 3, 279, 139, 0, 759, 760, 3, 313, 156, 0, 760, 128, 1, 0, 0, 0, 761,
-		762, 3, 275, 137, 0, 762, 763, 3, 311, 155, 0, 763, 130, 1, 0, 0, 0, 764,
-		765, 3, 275, 137, 0, 765, 766, 3, 301, 150, 0, 766, 767, 3, 281, 140, 0,
-		767, 132, 1, 0, 0, 0, 768, 769, 3, 303, 151, 0, 769, 770, 3, 309, 154,
-		0, 770, 134, 1, 0, 0, 0, 771, 772, 3, 285, 142, 0, 772, 773, 3, 291, 145,
-		0, 773, 774, 3, 297, 148, 0, 774, 775, 3, 297, 148, 0, 775, 136, 1, 0,
-		0, 0, 776, 777, 3, 301, 150, 0, 777, 778, 3, 315, 157, 0, 778, 779, 3,
-		297, 148, 0, 779, 780, 3, 297, 148, 0, 780, 138, 1, 0, 0, 0, 781, 782,
-		3, 305, 152, 0, 782, 783, 3, 309, 154, 0, 783, 784, 3, 283, 141, 0, 784,
-		785, 3, 317, 158, 0, 785, 786, 3, 291, 145, 0, 786, 787, 3, 303, 151, 0,
-		787, 788, 3, 315, 157, 0, 788, 789, 3, 311, 155, 0, 789, 140, 1, 0, 0,
-		0, 790, 791, 3, 303, 151, 0, 791, 792, 3, 309, 154, 0, 792, 793, 3, 281,
-		140, 0, 793, 794, 3, 283, 141, 0, 794, 795, 3, 309, 154, 0, 795, 142, 1,
-		0, 0, 0, 796, 797, 3, 275, 137, 0, 797, 798, 3, 311, 155, 0, 798, 799,
-		3, 279, 139, 0, 799, 144, 1, 0, 0, 0, 800, 801, 3, 281, 140, 0, 801, 802,
-		3, 283, 141, 0, 802, 803, 3, 311, 155, 0, 803, 804, 3, 279, 139, 0, 804,
-		146, 1, 0, 0, 0, 805, 806, 3, 297, 148, 0, 806, 807, 3, 291, 145, 0, 807,
-		808, 3, 295, 147, 0, 808, 809, 3, 283, 141, 0, 809, 148, 1, 0, 0, 0, 810,
-		811, 3, 301, 150, 0, 811, 812, 3, 303, 151, 0, 812, 813, 3, 313, 156, 0,
-		813, 150, 1, 0, 0, 0, 814, 815, 3, 277, 138, 0, 815, 816, 3, 283, 141,
-		0, 816, 817, 3, 313, 156, 0, 817, 818, 3, 319, 159, 0, 818, 819, 3, 283,
-		141, 0, 819, 820, 3, 283, 141, 0, 820, 821, 3, 301, 150, 0, 821, 152, 1,
-		0, 0, 0, 822, 823, 3, 291, 145, 0, 823, 824, 3, 311, 155, 0, 824, 154,
-		1, 0, 0, 0, 825, 826, 3, 287, 143, 0, 826, 827, 3, 309, 154, 0, 827, 828,
-		3, 303, 151, 0, 828, 829, 3, 315, 157, 0, 829, 830, 3, 305, 152, 0, 830,
-		156, 1, 0, 0, 0, 831, 832, 3, 289, 144, 0, 832, 833, 3, 275, 137, 0, 833,
-		834, 3, 317, 158, 0, 834, 835, 3, 291, 145, 0, 835, 836, 3, 301, 150, 0,
-		836, 837, 3, 287, 143, 0, 837, 158, 1, 0, 0, 0, 838, 839, 3, 277, 138,
-		0, 839, 840, 3, 323, 161, 0, 840, 160, 1, 0, 0, 0, 841, 842, 3, 285, 142,
-		0, 842, 843, 3, 303, 151, 0, 843, 844, 3, 309, 154, 0, 844, 162, 1, 0,
-		0, 0, 845, 846, 3, 311, 155, 0, 846, 847, 3, 313, 156, 0, 847, 848, 3,
-		275, 137, 0, 848, 849, 3, 313, 156, 0, 849, 850, 3, 311, 155, 0, 850, 164,
-		1, 0, 0, 0, 851, 852, 3, 313, 156, 0, 852, 853, 3, 291, 145, 0, 853, 854,
-		3, 299, 149, 0, 854, 855, 3, 283, 141, 0, 855, 166, 1, 0, 0, 0, 856, 857,
-		3, 301, 150, 0, 857, 858, 3, 303, 151, 0, 858, 859, 3, 319, 159, 0, 859,
-		168, 1, 0, 0, 0, 860, 861, 3, 291, 145, 0, 861, 862, 3, 301, 150, 0, 862,
-		170, 1, 0, 0, 0, 863, 864, 3, 297, 148, 0, 864, 865, 3, 303, 151, 0, 865,
-		866, 3, 287, 143, 0, 866, 172, 1, 0, 0, 0, 867, 868, 3, 305, 152, 0, 868,
-		869, 3, 309, 154, 0, 869, 870, 3, 303, 151, 0, 870, 871, 3, 285, 142, 0,
-		871, 872, 3, 291, 145, 0, 872, 873, 3, 297, 148, 0, 873, 874, 3, 283, 141,
-		0, 874, 174, 1, 0, 0, 0, 875, 876, 3, 309, 154, 0, 876, 877, 3, 283, 141,
-		0, 877, 878, 3, 307, 153, 0, 878, 879, 3, 315, 157, 0, 879, 880, 3, 283,
-		141, 0, 880, 881, 3, 311, 155, 0, 881, 882, 3, 313, 156, 0, 882, 883, 3,
-		311, 155, 0, 883, 176, 1, 0, 0, 0, 884, 885, 3, 309, 154, 0, 885, 886,
-		3, 283, 141, 0, 886, 887, 3, 307, 153, 0, 887, 888, 3, 315, 157, 0, 888,
-		889, 3, 283, 141, 0, 889, 890, 3, 311, 155, 0, 890, 891, 3, 313, 156, 0,
-		891, 178, 1, 0, 0, 0, 892, 893, 3, 291, 145, 0, 893, 894, 3, 281, 140,
-		0, 894, 180, 1, 0, 0, 0, 895, 896, 3, 311, 155, 0, 896, 897, 3, 315, 157,
-		0, 897, 898, 3, 299, 149, 0, 898, 182, 1, 0, 0, 0, 899, 900, 3, 299, 149,
-		0, 900, 901, 3, 291, 145, 0, 901, 902, 3, 301, 150, 0, 902, 184, 1, 0,
-		0, 0, 903, 904, 3, 299, 149, 0, 904, 905, 3, 275, 137, 0, 905, 906, 3,
-		321, 160, 0, 906, 186, 1, 0, 0, 0, 907, 908, 3, 279, 139, 0, 908, 909,
-		3, 303, 151, 0, 909, 910, 3, 315, 157, 0, 910, 911, 3, 301, 150, 0, 911,
-		912, 3, 313, 156, 0, 912, 188, 1, 0, 0, 0, 913, 914, 3, 297, 148, 0, 914,
-		915, 3, 275, 137, 0, 915, 916, 3, 311, 155, 0, 916, 917, 3, 313, 156, 0,
-		917, 190, 1, 0, 0, 0, 918, 919, 3, 285, 142, 0, 919, 920, 3, 291, 145,
-		0, 920, 921, 3, 309, 154, 0, 921, 922, 3, 311, 155, 0, 922, 923, 3, 313,
-		156, 0, 923, 192, 1, 0, 0, 0, 924, 925, 3, 275, 137, 0, 925, 926, 3, 317,
-		158, 0, 926, 927, 3, 287, 143, 0, 927, 194, 1, 0, 0, 0, 928, 929, 3, 311,
-		155, 0, 929, 930, 3, 313, 156, 0, 930, 931, 3, 281, 140, 0, 931, 932, 3,
-		281, 140, 0, 932, 933, 3, 283, 141, 0, 933, 934, 3, 317, 158, 0, 934, 196,
-		1, 0, 0, 0, 935, 936, 3, 307, 153, 0, 936, 937, 3, 315, 157, 0, 937, 938,
-		3, 275, 137, 0, 938, 939, 3, 301, 150, 0, 939, 940, 3, 313, 156, 0, 940,
-		941, 3, 291, 145, 0, 941, 942, 3, 297, 148, 0, 942, 943, 3, 283, 141, 0,
-		943, 198, 1, 0, 0, 0, 944, 945, 3, 309, 154, 0, 945, 946, 3, 275, 137,
-		0, 946, 947, 3, 313, 156, 0, 947, 948, 3, 283, 141, 0, 948, 200, 1, 0,
-		0, 0, 949, 950, 3, 311, 155, 0, 950, 202, 1, 0, 0, 0, 951, 952, 5, 109,
-		0, 0, 952, 204, 1, 0, 0, 0, 953, 954, 3, 289, 144, 0, 954, 206, 1, 0, 0,
-		0, 955, 956, 3, 281, 140, 0, 956, 208, 1, 0, 0, 0, 957, 958, 3, 319, 159,
-		0, 958, 210, 1, 0, 0, 0, 959, 960, 5, 77, 0, 0, 960, 212, 1, 0, 0, 0, 961,
-		962, 3, 323, 161, 0, 962, 214, 1, 0, 0, 0, 963, 964, 5, 46, 0, 0, 964,
-		216, 1, 0, 0, 0, 965, 966, 5, 58, 0, 0, 966, 218, 1, 0, 0, 0, 967, 968,
-		5, 61, 0, 0, 968, 220, 1, 0, 0, 0, 969, 970, 5, 60, 0, 0, 970, 971, 5,
-		62, 0, 0, 971, 222, 1, 0, 0, 0, 972, 973, 5, 33, 0, 0, 973, 974, 5, 61,
-		0, 0, 974, 224, 1, 0, 0, 0, 975, 976, 5, 62, 0, 0, 976, 226, 1, 0, 0, 0,
-		977, 978, 5, 62, 0, 0, 978, 979, 5, 61, 0, 0, 979, 228, 1, 0, 0, 0, 980,
-		981, 5, 60, 0, 0, 981, 230, 1, 0, 0, 0, 982, 983, 5, 60, 0, 0, 983, 984,
-		5, 61, 0, 0, 984, 232, 1, 0, 0, 0, 985, 986, 5, 61, 0, 0, 986, 987, 5,
-		126, 0, 0, 987, 234, 1, 0, 0, 0, 988, 989, 5, 33, 0, 0, 989, 990, 5, 126,
-		0, 0, 990, 236, 1, 0, 0, 0, 991, 992, 5, 44, 0, 0, 992, 238, 1, 0, 0, 0,
-		993, 994, 5, 123, 0, 0, 994, 240, 1, 0, 0, 0, 995, 996, 5, 125, 0, 0, 996,
-		242, 1, 0, 0, 0, 997, 998, 5, 91, 0, 0, 998, 244, 1, 0, 0, 0, 999, 1000,
-		5, 93, 0, 0, 1000, 246, 1, 0, 0, 0, 1001, 1002, 5, 40, 0, 0, 1002, 248,
-		1, 0, 0, 0, 1003, 1004, 5, 41, 0, 0, 1004, 250, 1, 0, 0, 0, 1005, 1006,
-		5, 43, 0, 0, 1006, 252, 1, 0, 0, 0, 1007, 1008, 5, 45, 0, 0, 1008, 254,
-		1, 0, 0, 0, 1009, 1010, 5, 47, 0, 0, 1010, 256, 1, 0, 0, 0, 1011, 1012,
-		5, 42, 0, 0, 1012, 258, 1, 0, 0, 0, 1013, 1014, 5, 37, 0, 0, 1014, 260,
-		1, 0, 0, 0, 1015, 1016, 5, 95, 0, 0, 1016, 262, 1, 0, 0, 0, 1017, 1018,
-		3, 273, 136, 0, 1018, 264, 1, 0, 0, 0, 1019, 1021, 3, 271, 135, 0, 1020,
-		1019, 1, 0, 0, 0, 1021, 1022, 1, 0, 0, 0, 1022, 1020, 1, 0, 0, 0, 1022,
-		1023, 1, 0, 0, 0, 1023, 266, 1, 0, 0, 0, 1024, 1026, 3, 271, 135, 0, 1025,
-		1024, 1, 0, 0, 0, 1026, 1027, 1, 0, 0, 0, 1027, 1025, 1, 0, 0, 0, 1027,
-		1028, 1, 0, 0, 0, 1028, 1029, 1, 0, 0, 0, 1029, 1030, 5, 46, 0, 0, 1030,
-		1034, 8, 6, 0, 0, 1031, 1033, 3, 271, 135, 0, 1032, 1031, 1, 0, 0, 0, 1033,
-		1036, 1, 0, 0, 0, 1034, 1032, 1, 0, 0, 0, 1034, 1035, 1, 0, 0, 0, 1035,
-		1044, 1, 0, 0, 0, 1036, 1034, 1, 0, 0, 0, 1037, 1039, 5, 46, 0, 0, 1038,
-		1040, 3, 271, 135, 0, 1039, 1038, 1, 0, 0, 0, 1040, 1041, 1, 0, 0, 0, 1041,
-		1039, 1, 0, 0, 0, 1041, 1042, 1, 0, 0, 0, 1042, 1044, 1, 0, 0, 0, 1043,
-		1025, 1, 0, 0, 0, 1043, 1037, 1, 0, 0, 0, 1044, 268, 1, 0, 0, 0, 1045,
-		1046, 7, 5, 0, 0, 1046, 270, 1, 0, 0, 0, 1047, 1048, 7, 7, 0, 0, 1048,
-		272, 1, 0, 0, 0, 1049, 1055, 7, 8, 0, 0, 1050, 1054, 7, 8, 0, 0, 1051,
-		1054, 3, 271, 135, 0, 1052, 1054, 7, 9, 0, 0, 1053, 1050, 1, 0, 0, 0, 1053,
-		1051, 1, 0, 0, 0, 1053, 1052, 1, 0, 0, 0, 1054, 1057, 1, 0, 0, 0, 1055,
-		1053, 1, 0, 0, 0, 1055, 1056, 1, 0, 0, 0, 1056, 1100, 1, 0, 0, 0, 1057,
-		1055, 1, 0, 0, 0, 1058, 1059, 5, 36, 0, 0, 1059, 1063, 5, 123, 0, 0, 1060,
-		1062, 9, 0, 0, 0, 1061, 1060, 1, 0, 0, 0, 1062, 1065, 1, 0, 0, 0, 1063,
-		1064, 1, 0, 0, 0, 1063, 1061, 1, 0, 0, 0, 1064, 1066, 1, 0, 0, 0, 1065,
-		1063, 1, 0, 0, 0, 1066, 1100, 5, 125, 0, 0, 1067, 1071, 7, 10, 0, 0, 1068,
-		1072, 7, 8, 0, 0, 1069, 1072, 3, 271, 135, 0, 1070, 1072, 7, 11, 0, 0,
-		1071, 1068, 1, 0, 0, 0, 1071, 1069, 1, 0, 0, 0, 1071, 1070, 1, 0, 0, 0,
-		1072, 1073, 1, 0, 0, 0, 1073, 1071, 1, 0, 0, 0, 1073, 1074, 1, 0, 0, 0,
-		1074, 1100, 1, 0, 0, 0, 1075, 1079, 5, 34, 0, 0, 1076, 1078, 9, 0, 0, 0,
-		1077, 1076, 1, 0, 0, 0, 1078, 1081, 1, 0, 0, 0, 1079, 1080, 1, 0, 0, 0,
-		1079, 1077, 1, 0, 0, 0, 1080, 1082, 1, 0, 0, 0, 1081, 1079, 1, 0, 0, 0,
-		1082, 1100, 5, 34, 0, 0, 1083, 1087, 5, 96, 0, 0, 1084, 1086, 9, 0, 0,
-		0, 1085, 1084, 1, 0, 0, 0, 1086, 1089, 1, 0, 0, 0, 1087, 1088, 1, 0, 0,
-		0, 1087, 1085, 1, 0, 0, 0, 1088, 1090, 1, 0, 0, 0, 1089, 1087, 1, 0, 0,
-		0, 1090, 1100, 5, 96, 0, 0, 1091, 1095, 5, 39, 0, 0, 1092, 1094, 9, 0,
-		0, 0, 1093, 1092, 1, 0, 0, 0, 1094, 1097, 1, 0, 0, 0, 1095, 1096, 1, 0,
-		0, 0, 1095, 1093, 1, 0, 0, 0, 1096, 1098, 1, 0, 0, 0, 1097, 1095, 1, 0,
-		0, 0, 1098, 1100, 5, 39, 0, 0, 1099, 1049, 1, 0, 0, 0, 1099, 1058, 1, 0,
-		0, 0, 1099, 1067, 1, 0, 0, 0, 1099, 1075, 1, 0, 0, 0, 1099, 1083, 1, 0,
-		0, 0, 1099, 1091, 1, 0, 0, 0, 1100, 274, 1, 0, 0, 0, 1101, 1102, 7, 12,
-		0, 0, 1102, 276, 1, 0, 0, 0, 1103, 1104, 7, 13, 0, 0, 1104, 278, 1, 0,
-		0, 0, 1105, 1106, 7, 14, 0, 0, 1106, 280, 1, 0, 0, 0, 1107, 1108, 7, 15,
-		0, 0, 1108, 282, 1, 0, 0, 0, 1109, 1110, 7, 3, 0, 0, 1110, 284, 1, 0, 0,
-		0, 1111, 1112, 7, 16, 0, 0, 1112, 286, 1, 0, 0, 0, 1113, 1114, 7, 17, 0,
-		0, 1114, 288, 1, 0, 0, 0, 1115, 1116, 7, 18, 0, 0, 1116, 290, 1, 0, 0,
-		0, 1117, 1118, 7, 19, 0, 0, 1118, 292, 1, 0, 0, 0, 1119, 1120, 7, 20, 0,
-		0, 1120, 294, 1, 0, 0, 0, 1121, 1122, 7, 21, 0, 0, 1122, 296, 1, 0, 0,
-		0, 1123, 1124, 7, 22, 0, 0, 1124, 298, 1, 0, 0, 0, 1125, 1126, 7, 23, 0,
-		0, 1126, 300, 1, 0, 0, 0, 1127, 1128, 7, 24, 0, 0, 1128, 302, 1, 0, 0,
-		0, 1129, 1130, 7, 25, 0, 0, 1130, 304, 1, 0, 0, 0, 1131, 1132, 7, 26, 0,
-		0, 1132, 306, 1, 0, 0, 0, 1133, 1134, 7, 27, 0, 0, 1134, 308, 1, 0, 0,
-		0, 1135, 1136, 7, 28, 0, 0, 1136, 310, 1, 0, 0, 0, 1137, 1138, 7, 29, 0,
-		0, 1138, 312, 1, 0, 0, 0, 1139, 1140, 7, 30, 0, 0, 1140, 314, 1, 0, 0,
-		0, 1141, 1142, 7, 31, 0, 0, 1142, 316, 1, 0, 0, 0, 1143, 1144, 7, 32, 0,
-		0, 1144, 318, 1, 0, 0, 0, 1145, 1146, 7, 33, 0, 0, 1146, 320, 1, 0, 0,
-		0, 1147, 1148, 7, 34, 0, 0, 1148, 322, 1, 0, 0, 0, 1149, 1150, 7, 35, 0,
-		0, 1150, 324, 1, 0, 0, 0, 1151, 1152, 7, 36, 0, 0, 1152, 326, 1, 0, 0,
-		0, 20, 0, 346, 348, 356, 370, 377, 1022, 1027, 1034, 1041, 1043, 1053,
-		1055, 1063, 1071, 1073, 1079, 1087, 1095, 1099, 1, 6, 0, 0,
+		7, 162, 2, 163, 7, 163, 2, 164, 7, 164, 2, 165, 7, 165, 2, 166, 7, 166,
+		2, 167, 7, 167, 2, 168, 7, 168, 2, 169, 7, 169, 2, 170, 7, 170, 2, 171,
+		7, 171, 2, 172, 7, 172, 2, 173, 7, 173, 2, 174, 7, 174, 2, 175, 7, 175,
+		2, 176, 7, 176, 2, 177, 7, 177, 2, 178, 7, 178, 2, 179, 7, 179, 2, 180,
+		7, 180, 2, 181, 7, 181, 2, 182, 7, 182, 2, 183, 7, 183, 2, 184, 7, 184,
+		2, 185, 7, 185, 2, 186, 7, 186, 2, 187, 7, 187, 2, 188, 7, 188, 2, 189,
+		7, 189, 2, 190, 7, 190, 2, 191, 7, 191, 2, 192, 7, 192, 2, 193, 7, 193,
+		2, 194, 7, 194, 2, 195, 7, 195, 2, 196, 7, 196, 2, 197, 7, 197, 2, 198,
+		7, 198, 2, 199, 7, 199, 2, 200, 7, 200, 2, 201, 7, 201, 2, 202, 7, 202,
+		2, 203, 7, 203, 2, 204, 7, 204, 2, 205, 7, 205, 2, 206, 7, 206, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1,
+		2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 5, 3, 435, 8, 3, 10, 3, 12, 3, 438, 9,
+		3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 3, 4, 445, 8, 4, 1, 5, 1, 5, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 3, 8, 459, 8, 8, 1,
+		8, 1, 8, 1, 9, 4, 9, 464, 8, 9, 11, 9, 12, 9, 465, 1, 9, 1, 9, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
+		1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1,
+		19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1,
+		22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23,
+		1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1,
+		26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27,
+		1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29,
+		1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1,
+		30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32,
+		1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
+		34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35,
+		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37,
+		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1,
+		39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40,
+		1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
+		41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
+		1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1,
+		46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48,
+		1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1,
+		50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52,
+		1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1,
+		54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56,
+		1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1,
+		58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
+		1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1,
+		61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62,
+		1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66,
+		1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1,
+		69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70,
+		1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1,
+		72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74,
+		1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 76, 1,
+		76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1,
+		80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82,
+		1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85, 1,
+		85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87,
+		1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1,
+		88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90,
+		1, 90, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1,
+		91, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93,
+		1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 95, 1,
+		95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96,
+		1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 97, 1,
+		97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99,
+		1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100,
+		1, 100, 1, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 102,
+		1, 102, 1, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103,
+		1, 103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105,
+		1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106,
+		1, 106, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 108,
+		1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 109,
+		1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1, 110,
+		1, 110, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 112, 1, 112,
+		1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 113, 1, 113,
+		1, 113, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114,
+		1, 114, 1, 114, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 116, 1, 116,
+		1, 116, 1, 116, 1, 116, 1, 116, 1, 117, 1, 117, 1, 117, 1, 117, 1, 117,
+		1, 117, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 119,
+		1, 119, 1, 119, 1, 119, 1, 119, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120,
+		1, 120, 1, 121, 1, 121, 1, 121, 1, 121, 1, 122, 1, 122, 1, 122, 1, 122,
+		1, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1, 123, 1, 123, 1, 123, 1, 123,
+		1, 123, 1, 123, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 125,
+		1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125,
+		1, 125, 1, 125, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126,
+		1, 126, 1, 126, 1, 126, 1, 126, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127,
+		1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 128, 1, 128,
+		1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 129, 1, 129,
+		1, 129, 1, 129, 1, 129, 1, 129, 1, 130, 1, 130, 1, 130, 1, 130, 1, 130,
+		1, 130, 1, 130, 1, 130, 1, 130, 1, 130, 1, 131, 1, 131, 1, 131, 1, 131,
+		1, 131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131,
+		1, 132, 1, 132, 1, 132, 1, 132, 1, 132, 1, 132, 1, 132, 1, 132, 1, 133,
+		1, 133, 1, 133, 1, 133, 1, 134, 1, 134, 1, 134, 1, 134, 1, 135, 1, 135,
+		1, 135, 1, 135, 1, 136, 1, 136, 1, 136, 1, 136, 1, 136, 1, 136, 1, 137,
+		1, 137, 1, 137, 1, 137, 1, 137, 1, 138, 1, 138, 1, 138, 1, 138, 1, 138,
+		1, 138, 1, 139, 1, 139, 1, 139, 1, 139, 1, 140, 1, 140, 1, 140, 1, 140,
+		1, 140, 1, 140, 1, 140, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141,
+		1, 141, 1, 141, 1, 141, 1, 142, 1, 142, 1, 142, 1, 142, 1, 142, 1, 143,
+		1, 143, 1, 144, 1, 144, 1, 145, 1, 145, 1, 146, 1, 146, 1, 147, 1, 147,
+		1, 148, 1, 148, 1, 149, 1, 149, 1, 150, 1, 150, 1, 151, 1, 151, 1, 152,
+		1, 152, 1, 153, 1, 153, 1, 154, 1, 154, 1, 154, 1, 155, 1, 155, 1, 155,
+		1, 156, 1, 156, 1, 157, 1, 157, 1, 157, 1, 158, 1, 158, 1, 159, 1, 159,
+		1, 159, 1, 160, 1, 160, 1, 160, 1, 161, 1, 161, 1, 161, 1, 162, 1, 162,
+		1, 163, 1, 163, 1, 164, 1, 164, 1, 165, 1, 165, 1, 166, 1, 166, 1, 167,
+		1, 167, 1, 168, 1, 168, 1, 169, 1, 169, 1, 170, 1, 170, 1, 171, 1, 171,
+		1, 172, 1, 172, 1, 173, 1, 173, 1, 174, 1, 174, 1, 175, 1, 175, 1, 176,
+		4, 176, 1419, 8, 176, 11, 176, 12, 176, 1420, 1, 177, 4, 177, 1424, 8,
+		177, 11, 177, 12, 177, 1425, 1, 177, 1, 177, 1, 177, 5, 177, 1431, 8, 177,
+		10, 177, 12, 177, 1434, 9, 177, 1, 177, 1, 177, 4, 177, 1438, 8, 177, 11,
+		177, 12, 177, 1439, 3, 177, 1442, 8, 177, 1, 178, 1, 178, 1, 179, 1, 179,
+		1, 180, 1, 180, 1, 180, 1, 180, 5, 180, 1452, 8, 180, 10, 180, 12, 180,
+		1455, 9, 180, 1, 180, 1, 180, 1, 180, 5, 180, 1460, 8, 180, 10, 180, 12,
+		180, 1463, 9, 180, 1, 180, 1, 180, 1, 180, 1, 180, 1, 180, 4, 180, 1470,
+		8, 180, 11, 180, 12, 180, 1471, 1, 180, 1, 180, 5, 180, 1476, 8, 180, 10,
+		180, 12, 180, 1479, 9, 180, 1, 180, 1, 180, 1, 180, 5, 180, 1484, 8, 180,
+		10, 180, 12, 180, 1487, 9, 180, 1, 180, 1, 180, 1, 180, 5, 180, 1492, 8,
+		180, 10, 180, 12, 180, 1495, 9, 180, 1, 180, 3, 180, 1498, 8, 180, 1, 181,
+		1, 181, 1, 182, 1, 182, 1, 183, 1, 183, 1, 184, 1, 184, 1, 185, 1, 185,
+		1, 186, 1, 186, 1, 187, 1, 187, 1, 188, 1, 188, 1, 189, 1, 189, 1, 190,
+		1, 190, 1, 191, 1, 191, 1, 192, 1, 192, 1, 193, 1, 193, 1, 194, 1, 194,
+		1, 195, 1, 195, 1, 196, 1, 196, 1, 197, 1, 197, 1, 198, 1, 198, 1, 199,
+		1, 199, 1, 200, 1, 200, 1, 201, 1, 201, 1, 202, 1, 202, 1, 203, 1, 203,
+		1, 204, 1, 204, 1, 205, 1, 205, 1, 206, 1, 206, 4, 1461, 1477, 1485, 1493,
+		0, 207, 1, 1, 3, 2, 5, 3, 7, 4, 9, 0, 11, 0, 13, 0, 15, 0, 17, 0, 19, 5,
+		21, 6, 23, 7, 25, 8, 27, 9, 29, 10, 31, 11, 33, 12, 35, 13, 37, 14, 39,
+		15, 41, 16, 43, 17, 45, 18, 47, 19, 49, 20, 51, 21, 53, 22, 55, 23, 57,
+		24, 59, 25, 61, 26, 63, 27, 65, 28, 67, 29, 69, 30, 71, 31, 73, 32, 75,
+		33, 77, 34, 79, 35, 81, 36, 83, 37, 85, 38, 87, 39, 89, 40, 91, 41, 93,
+		42, 95, 43, 97, 44, 99, 45, 101, 46, 103, 47, 105, 48, 107, 49, 109, 50,
+		111, 51, 113, 52, 115, 53, 117, 54, 119, 55, 121, 56, 123, 57, 125, 58,
+		127, 59, 129, 60, 131, 61, 133, 62, 135, 63, 137, 64, 139, 65, 141, 66,
+		143, 67, 145, 68, 147, 69, 149, 70, 151, 71, 153, 72, 155, 73, 157, 74,
+		159, 75, 161, 76, 163, 77, 165, 78, 167, 79, 169, 80, 171, 81, 173, 82,
+		175, 83, 177, 84, 179, 85, 181, 86, 183, 87, 185, 88, 187, 89, 189, 90,
+		191, 91, 193, 92, 195, 93, 197, 94, 199, 95, 201, 96, 203, 97, 205, 98,
+		207, 99, 209, 100, 211, 101, 213, 102, 215, 103, 217, 104, 219, 105, 221,
+		106, 223, 107, 225, 108, 227, 109, 229, 110, 231, 111, 233, 112, 235, 113,
+		237, 114, 239, 115, 241, 116, 243, 117, 245, 118, 247, 119, 249, 120, 251,
+		121, 253, 122, 255, 123, 257, 124, 259, 125, 261, 126, 263, 127, 265, 128,
+		267, 129, 269, 130, 271, 131, 273, 132, 275, 133, 277, 134, 279, 135, 281,
+		136, 283, 137, 285, 138, 287, 139, 289, 140, 291, 141, 293, 142, 295, 143,
+		297, 144, 299, 145, 301, 146, 303, 147, 305, 148, 307, 149, 309, 150, 311,
+		151, 313, 152, 315, 153, 317, 154, 319, 155, 321, 156, 323, 157, 325, 158,
+		327, 159, 329, 160, 331, 161, 333, 162, 335, 163, 337, 164, 339, 165, 341,
+		166, 343, 167, 345, 168, 347, 169, 349, 170, 351, 171, 353, 172, 355, 173,
+		357, 0, 359, 0, 361, 0, 363, 0, 365, 0, 367, 0, 369, 0, 371, 0, 373, 0,
+		375, 0, 377, 0, 379, 0, 381, 0, 383, 0, 385, 0, 387, 0, 389, 0, 391, 0,
+		393, 0, 395, 0, 397, 0, 399, 0, 401, 0, 403, 0, 405, 0, 407, 0, 409, 0,
+		411, 0, 413, 0, 1, 0, 37, 8, 0, 34, 34, 47, 47, 92, 92, 98, 98, 102, 102,
+		110, 110, 114, 114, 116, 116, 3, 0, 48, 57, 65, 70, 97, 102, 3, 0, 0, 31,
+		34, 34, 92, 92, 2, 0, 69, 69, 101, 101, 2, 0, 43, 43, 45, 45, 3, 0, 9,
+		10, 13, 13, 32, 32, 1, 0, 46, 46, 1, 0, 48, 57, 2, 0, 65, 90, 97, 122,
+		2, 0, 46, 46, 95, 95, 3, 0, 35, 36, 64, 64, 95, 95, 4, 0, 35, 36, 58, 58,
+		64, 64, 95, 95, 2, 0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67,
+		99, 99, 2, 0, 68, 68, 100, 100, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103,
+		103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106,
+		106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109,
+		109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112,
+		112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115,
+		115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118,
+		118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121,
+		121, 2, 0, 90, 90, 122, 122, 1541, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0,
+		0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0,
+		0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0,
+		0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1,
+		0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45,
+		1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0,
+		53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0,
+		0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0,
+		0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0,
+		0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1,
+		0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91,
+		1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0,
+		99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0,
+		0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113,
+		1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0,
+		0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1,
+		0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0,
+		135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0,
+		0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149,
+		1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0,
+		0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1,
+		0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0,
+		171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0,
+		0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185,
+		1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0,
+		0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1,
+		0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1, 0, 0, 0, 0,
+		207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 0, 213, 1, 0,
+		0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0, 0, 219, 1, 0, 0, 0, 0, 221,
+		1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1, 0, 0, 0, 0, 227, 1, 0, 0, 0,
+		0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 0, 233, 1, 0, 0, 0, 0, 235, 1,
+		0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239, 1, 0, 0, 0, 0, 241, 1, 0, 0, 0, 0,
+		243, 1, 0, 0, 0, 0, 245, 1, 0, 0, 0, 0, 247, 1, 0, 0, 0, 0, 249, 1, 0,
+		0, 0, 0, 251, 1, 0, 0, 0, 0, 253, 1, 0, 0, 0, 0, 255, 1, 0, 0, 0, 0, 257,
+		1, 0, 0, 0, 0, 259, 1, 0, 0, 0, 0, 261, 1, 0, 0, 0, 0, 263, 1, 0, 0, 0,
+		0, 265, 1, 0, 0, 0, 0, 267, 1, 0, 0, 0, 0, 269, 1, 0, 0, 0, 0, 271, 1,
+		0, 0, 0, 0, 273, 1, 0, 0, 0, 0, 275, 1, 0, 0, 0, 0, 277, 1, 0, 0, 0, 0,
+		279, 1, 0, 0, 0, 0, 281, 1, 0, 0, 0, 0, 283, 1, 0, 0, 0, 0, 285, 1, 0,
+		0, 0, 0, 287, 1, 0, 0, 0, 0, 289, 1, 0, 0, 0, 0, 291, 1, 0, 0, 0, 0, 293,
+		1, 0, 0, 0, 0, 295, 1, 0, 0, 0, 0, 297, 1, 0, 0, 0, 0, 299, 1, 0, 0, 0,
+		0, 301, 1, 0, 0, 0, 0, 303, 1, 0, 0, 0, 0, 305, 1, 0, 0, 0, 0, 307, 1,
+		0, 0, 0, 0, 309, 1, 0, 0, 0, 0, 311, 1, 0, 0, 0, 0, 313, 1, 0, 0, 0, 0,
+		315, 1, 0, 0, 0, 0, 317, 1, 0, 0, 0, 0, 319, 1, 0, 0, 0, 0, 321, 1, 0,
+		0, 0, 0, 323, 1, 0, 0, 0, 0, 325, 1, 0, 0, 0, 0, 327, 1, 0, 0, 0, 0, 329,
+		1, 0, 0, 0, 0, 331, 1, 0, 0, 0, 0, 333, 1, 0, 0, 0, 0, 335, 1, 0, 0, 0,
+		0, 337, 1, 0, 0, 0, 0, 339, 1, 0, 0, 0, 0, 341, 1, 0, 0, 0, 0, 343, 1,
+		0, 0, 0, 0, 345, 1, 0, 0, 0, 0, 347, 1, 0, 0, 0, 0, 349, 1, 0, 0, 0, 0,
+		351, 1, 0, 0, 0, 0, 353, 1, 0, 0, 0, 0, 355, 1, 0, 0, 0, 1, 415, 1, 0,
+		0, 0, 3, 420, 1, 0, 0, 0, 5, 426, 1, 0, 0, 0, 7, 431, 1, 0, 0, 0, 9, 441,
+		1, 0, 0, 0, 11, 446, 1, 0, 0, 0, 13, 452, 1, 0, 0, 0, 15, 454, 1, 0, 0,
+		0, 17, 456, 1, 0, 0, 0, 19, 463, 1, 0, 0, 0, 21, 469, 1, 0, 0, 0, 23, 476,
+		1, 0, 0, 0, 25, 483, 1, 0, 0, 0, 27, 487, 1, 0, 0, 0, 29, 492, 1, 0, 0,
+		0, 31, 501, 1, 0, 0, 0, 33, 506, 1, 0, 0, 0, 35, 512, 1, 0, 0, 0, 37, 524,
+		1, 0, 0, 0, 39, 531, 1, 0, 0, 0, 41, 535, 1, 0, 0, 0, 43, 543, 1, 0, 0,
+		0, 45, 551, 1, 0, 0, 0, 47, 561, 1, 0, 0, 0, 49, 566, 1, 0, 0, 0, 51, 569,
+		1, 0, 0, 0, 53, 574, 1, 0, 0, 0, 55, 582, 1, 0, 0, 0, 57, 586, 1, 0, 0,
+		0, 59, 597, 1, 0, 0, 0, 61, 611, 1, 0, 0, 0, 63, 618, 1, 0, 0, 0, 65, 627,
+		1, 0, 0, 0, 67, 633, 1, 0, 0, 0, 69, 638, 1, 0, 0, 0, 71, 647, 1, 0, 0,
+		0, 73, 655, 1, 0, 0, 0, 75, 662, 1, 0, 0, 0, 77, 670, 1, 0, 0, 0, 79, 676,
+		1, 0, 0, 0, 81, 684, 1, 0, 0, 0, 83, 693, 1, 0, 0, 0, 85, 703, 1, 0, 0,
+		0, 87, 713, 1, 0, 0, 0, 89, 724, 1, 0, 0, 0, 91, 729, 1, 0, 0, 0, 93, 737,
+		1, 0, 0, 0, 95, 744, 1, 0, 0, 0, 97, 750, 1, 0, 0, 0, 99, 757, 1, 0, 0,
+		0, 101, 761, 1, 0, 0, 0, 103, 766, 1, 0, 0, 0, 105, 771, 1, 0, 0, 0, 107,
+		775, 1, 0, 0, 0, 109, 780, 1, 0, 0, 0, 111, 787, 1, 0, 0, 0, 113, 793,
+		1, 0, 0, 0, 115, 798, 1, 0, 0, 0, 117, 804, 1, 0, 0, 0, 119, 810, 1, 0,
+		0, 0, 121, 818, 1, 0, 0, 0, 123, 824, 1, 0, 0, 0, 125, 832, 1, 0, 0, 0,
+		127, 842, 1, 0, 0, 0, 129, 849, 1, 0, 0, 0, 131, 852, 1, 0, 0, 0, 133,
+		856, 1, 0, 0, 0, 135, 859, 1, 0, 0, 0, 137, 864, 1, 0, 0, 0, 139, 869,
+		1, 0, 0, 0, 141, 878, 1, 0, 0, 0, 143, 884, 1, 0, 0, 0, 145, 888, 1, 0,
+		0, 0, 147, 893, 1, 0, 0, 0, 149, 898, 1, 0, 0, 0, 151, 902, 1, 0, 0, 0,
+		153, 910, 1, 0, 0, 0, 155, 913, 1, 0, 0, 0, 157, 919, 1, 0, 0, 0, 159,
+		926, 1, 0, 0, 0, 161, 929, 1, 0, 0, 0, 163, 933, 1, 0, 0, 0, 165, 939,
+		1, 0, 0, 0, 167, 944, 1, 0, 0, 0, 169, 948, 1, 0, 0, 0, 171, 951, 1, 0,
+		0, 0, 173, 955, 1, 0, 0, 0, 175, 963, 1, 0, 0, 0, 177, 972, 1, 0, 0, 0,
+		179, 980, 1, 0, 0, 0, 181, 983, 1, 0, 0, 0, 183, 989, 1, 0, 0, 0, 185,
+		996, 1, 0, 0, 0, 187, 1004, 1, 0, 0, 0, 189, 1007, 1, 0, 0, 0, 191, 1015,
+		1, 0, 0, 0, 193, 1022, 1, 0, 0, 0, 195, 1033, 1, 0, 0, 0, 197, 1040, 1,
+		0, 0, 0, 199, 1045, 1, 0, 0, 0, 201, 1055, 1, 0, 0, 0, 203, 1060, 1, 0,
+		0, 0, 205, 1066, 1, 0, 0, 0, 207, 1071, 1, 0, 0, 0, 209, 1077, 1, 0, 0,
+		0, 211, 1083, 1, 0, 0, 0, 213, 1090, 1, 0, 0, 0, 215, 1095, 1, 0, 0, 0,
+		217, 1102, 1, 0, 0, 0, 219, 1110, 1, 0, 0, 0, 221, 1117, 1, 0, 0, 0, 223,
+		1122, 1, 0, 0, 0, 225, 1128, 1, 0, 0, 0, 227, 1137, 1, 0, 0, 0, 229, 1143,
+		1, 0, 0, 0, 231, 1150, 1, 0, 0, 0, 233, 1155, 1, 0, 0, 0, 235, 1161, 1,
+		0, 0, 0, 237, 1167, 1, 0, 0, 0, 239, 1174, 1, 0, 0, 0, 241, 1179, 1, 0,
+		0, 0, 243, 1185, 1, 0, 0, 0, 245, 1189, 1, 0, 0, 0, 247, 1196, 1, 0, 0,
+		0, 249, 1204, 1, 0, 0, 0, 251, 1210, 1, 0, 0, 0, 253, 1222, 1, 0, 0, 0,
+		255, 1233, 1, 0, 0, 0, 257, 1245, 1, 0, 0, 0, 259, 1254, 1, 0, 0, 0, 261,
+		1260, 1, 0, 0, 0, 263, 1270, 1, 0, 0, 0, 265, 1283, 1, 0, 0, 0, 267, 1291,
+		1, 0, 0, 0, 269, 1295, 1, 0, 0, 0, 271, 1299, 1, 0, 0, 0, 273, 1303, 1,
+		0, 0, 0, 275, 1309, 1, 0, 0, 0, 277, 1314, 1, 0, 0, 0, 279, 1320, 1, 0,
+		0, 0, 281, 1324, 1, 0, 0, 0, 283, 1331, 1, 0, 0, 0, 285, 1340, 1, 0, 0,
+		0, 287, 1345, 1, 0, 0, 0, 289, 1347, 1, 0, 0, 0, 291, 1349, 1, 0, 0, 0,
+		293, 1351, 1, 0, 0, 0, 295, 1353, 1, 0, 0, 0, 297, 1355, 1, 0, 0, 0, 299,
+		1357, 1, 0, 0, 0, 301, 1359, 1, 0, 0, 0, 303, 1361, 1, 0, 0, 0, 305, 1363,
+		1, 0, 0, 0, 307, 1365, 1, 0, 0, 0, 309, 1367, 1, 0, 0, 0, 311, 1370, 1,
+		0, 0, 0, 313, 1373, 1, 0, 0, 0, 315, 1375, 1, 0, 0, 0, 317, 1378, 1, 0,
+		0, 0, 319, 1380, 1, 0, 0, 0, 321, 1383, 1, 0, 0, 0, 323, 1386, 1, 0, 0,
+		0, 325, 1389, 1, 0, 0, 0, 327, 1391, 1, 0, 0, 0, 329, 1393, 1, 0, 0, 0,
+		331, 1395, 1, 0, 0, 0, 333, 1397, 1, 0, 0, 0, 335, 1399, 1, 0, 0, 0, 337,
+		1401, 1, 0, 0, 0, 339, 1403, 1, 0, 0, 0, 341, 1405, 1, 0, 0, 0, 343, 1407,
+		1, 0, 0, 0, 345, 1409, 1, 0, 0, 0, 347, 1411, 1, 0, 0, 0, 349, 1413, 1,
+		0, 0, 0, 351, 1415, 1, 0, 0, 0, 353, 1418, 1, 0, 0, 0, 355, 1441, 1, 0,
+		0, 0, 357, 1443, 1, 0, 0, 0, 359, 1445, 1, 0, 0, 0, 361, 1497, 1, 0, 0,
+		0, 363, 1499, 1, 0, 0, 0, 365, 1501, 1, 0, 0, 0, 367, 1503, 1, 0, 0, 0,
+		369, 1505, 1, 0, 0, 0, 371, 1507, 1, 0, 0, 0, 373, 1509, 1, 0, 0, 0, 375,
+		1511, 1, 0, 0, 0, 377, 1513, 1, 0, 0, 0, 379, 1515, 1, 0, 0, 0, 381, 1517,
+		1, 0, 0, 0, 383, 1519, 1, 0, 0, 0, 385, 1521, 1, 0, 0, 0, 387, 1523, 1,
+		0, 0, 0, 389, 1525, 1, 0, 0, 0, 391, 1527, 1, 0, 0, 0, 393, 1529, 1, 0,
+		0, 0, 395, 1531, 1, 0, 0, 0, 397, 1533, 1, 0, 0, 0, 399, 1535, 1, 0, 0,
+		0, 401, 1537, 1, 0, 0, 0, 403, 1539, 1, 0, 0, 0, 405, 1541, 1, 0, 0, 0,
+		407, 1543, 1, 0, 0, 0, 409, 1545, 1, 0, 0, 0, 411, 1547, 1, 0, 0, 0, 413,
+		1549, 1, 0, 0, 0, 415, 416, 5, 116, 0, 0, 416, 417, 5, 114, 0, 0, 417,
+		418, 5, 117, 0, 0, 418, 419, 5, 101, 0, 0, 419, 2, 1, 0, 0, 0, 420, 421,
+		5, 102, 0, 0, 421, 422, 5, 97, 0, 0, 422, 423, 5, 108, 0, 0, 423, 424,
+		5, 115, 0, 0, 424, 425, 5, 101, 0, 0, 425, 4, 1, 0, 0, 0, 426, 427, 5,
+		110, 0, 0, 427, 428, 5, 117, 0, 0, 428, 429, 5, 108, 0, 0, 429, 430, 5,
+		108, 0, 0, 430, 6, 1, 0, 0, 0, 431, 436, 5, 34, 0, 0, 432, 435, 3, 9, 4,
+		0, 433, 435, 3, 15, 7, 0, 434, 432, 1, 0, 0, 0, 434, 433, 1, 0, 0, 0, 435,
+		438, 1, 0, 0, 0, 436, 434, 1, 0, 0, 0, 436, 437, 1, 0, 0, 0, 437, 439,
+		1, 0, 0, 0, 438, 436, 1, 0, 0, 0, 439, 440, 5, 34, 0, 0, 440, 8, 1, 0,
+		0, 0, 441, 444, 5, 92, 0, 0, 442, 445, 7, 0, 0, 0, 443, 445, 3, 11, 5,
+		0, 444, 442, 1, 0, 0, 0, 444, 443, 1, 0, 0, 0, 445, 10, 1, 0, 0, 0, 446,
+		447, 5, 117, 0, 0, 447, 448, 3, 13, 6, 0, 448, 449, 3, 13, 6, 0, 449, 450,
+		3, 13, 6, 0, 450, 451, 3, 13, 6, 0, 451, 12, 1, 0, 0, 0, 452, 453, 7, 1,
+		0, 0, 453, 14, 1, 0, 0, 0, 454, 455, 8, 2, 0, 0, 455, 16, 1, 0, 0, 0, 456,
+		458, 7, 3, 0, 0, 457, 459, 7, 4, 0, 0, 458, 457, 1, 0, 0, 0, 458, 459,
+		1, 0, 0, 0, 459, 460, 1, 0, 0, 0, 460, 461, 3, 353, 176, 0, 461, 18, 1,
+		0, 0, 0, 462, 464, 7, 5, 0, 0, 463, 462, 1, 0, 0, 0, 464, 465, 1, 0, 0,
+		0, 465, 463, 1, 0, 0, 0, 465, 466, 1, 0, 0, 0, 466, 467, 1, 0, 0, 0, 467,
+		468, 6, 9, 0, 0, 468, 20, 1, 0, 0, 0, 469, 470, 3, 367, 183, 0, 470, 471,
+		3, 397, 198, 0, 471, 472, 3, 371, 185, 0, 472, 473, 3, 363, 181, 0, 473,
+		474, 3, 401, 200, 0, 474, 475, 3, 371, 185, 0, 475, 22, 1, 0, 0, 0, 476,
+		477, 3, 403, 201, 0, 477, 478, 3, 393, 196, 0, 478, 479, 3, 369, 184, 0,
+		479, 480, 3, 363, 181, 0, 480, 481, 3, 401, 200, 0, 481, 482, 3, 371, 185,
+		0, 482, 24, 1, 0, 0, 0, 483, 484, 3, 399, 199, 0, 484, 485, 3, 371, 185,
+		0, 485, 486, 3, 401, 200, 0, 486, 26, 1, 0, 0, 0, 487, 488, 3, 369, 184,
+		0, 488, 489, 3, 397, 198, 0, 489, 490, 3, 391, 195, 0, 490, 491, 3, 393,
+		196, 0, 491, 28, 1, 0, 0, 0, 492, 493, 3, 379, 189, 0, 493, 494, 3, 389,
+		194, 0, 494, 495, 3, 401, 200, 0, 495, 496, 3, 371, 185, 0, 496, 497, 3,
+		397, 198, 0, 497, 498, 3, 405, 202, 0, 498, 499, 3, 363, 181, 0, 499, 500,
+		3, 385, 192, 0, 500, 30, 1, 0, 0, 0, 501, 502, 3, 389, 194, 0, 502, 503,
+		3, 363, 181, 0, 503, 504, 3, 387, 193, 0, 504, 505, 3, 371, 185, 0, 505,
+		32, 1, 0, 0, 0, 506, 507, 3, 399, 199, 0, 507, 508, 3, 377, 188, 0, 508,
+		509, 3, 363, 181, 0, 509, 510, 3, 397, 198, 0, 510, 511, 3, 369, 184, 0,
+		511, 34, 1, 0, 0, 0, 512, 513, 3, 397, 198, 0, 513, 514, 3, 371, 185, 0,
+		514, 515, 3, 393, 196, 0, 515, 516, 3, 385, 192, 0, 516, 517, 3, 379, 189,
+		0, 517, 518, 3, 367, 183, 0, 518, 519, 3, 363, 181, 0, 519, 520, 3, 401,
+		200, 0, 520, 521, 3, 379, 189, 0, 521, 522, 3, 391, 195, 0, 522, 523, 3,
+		389, 194, 0, 523, 36, 1, 0, 0, 0, 524, 525, 3, 387, 193, 0, 525, 526, 3,
+		371, 185, 0, 526, 527, 3, 387, 193, 0, 527, 528, 3, 391, 195, 0, 528, 529,
+		3, 397, 198, 0, 529, 530, 3, 411, 205, 0, 530, 38, 1, 0, 0, 0, 531, 532,
+		3, 401, 200, 0, 532, 533, 3, 401, 200, 0, 533, 534, 3, 385, 192, 0, 534,
+		40, 1, 0, 0, 0, 535, 536, 3, 387, 193, 0, 536, 537, 3, 371, 185, 0, 537,
+		538, 3, 401, 200, 0, 538, 539, 3, 363, 181, 0, 539, 540, 3, 401, 200, 0,
+		540, 541, 3, 401, 200, 0, 541, 542, 3, 385, 192, 0, 542, 42, 1, 0, 0, 0,
+		543, 544, 3, 393, 196, 0, 544, 545, 3, 363, 181, 0, 545, 546, 3, 399, 199,
+		0, 546, 547, 3, 401, 200, 0, 547, 548, 3, 401, 200, 0, 548, 549, 3, 401,
+		200, 0, 549, 550, 3, 385, 192, 0, 550, 44, 1, 0, 0, 0, 551, 552, 3, 373,
+		186, 0, 552, 553, 3, 403, 201, 0, 553, 554, 3, 401, 200, 0, 554, 555, 3,
+		403, 201, 0, 555, 556, 3, 397, 198, 0, 556, 557, 3, 371, 185, 0, 557, 558,
+		3, 401, 200, 0, 558, 559, 3, 401, 200, 0, 559, 560, 3, 385, 192, 0, 560,
+		46, 1, 0, 0, 0, 561, 562, 3, 383, 191, 0, 562, 563, 3, 379, 189, 0, 563,
+		564, 3, 385, 192, 0, 564, 565, 3, 385, 192, 0, 565, 48, 1, 0, 0, 0, 566,
+		567, 3, 391, 195, 0, 567, 568, 3, 389, 194, 0, 568, 50, 1, 0, 0, 0, 569,
+		570, 3, 399, 199, 0, 570, 571, 3, 377, 188, 0, 571, 572, 3, 391, 195, 0,
+		572, 573, 3, 407, 203, 0, 573, 52, 1, 0, 0, 0, 574, 575, 3, 397, 198, 0,
+		575, 576, 3, 371, 185, 0, 576, 577, 3, 367, 183, 0, 577, 578, 3, 391, 195,
+		0, 578, 579, 3, 405, 202, 0, 579, 580, 3, 371, 185, 0, 580, 581, 3, 397,
+		198, 0, 581, 54, 1, 0, 0, 0, 582, 583, 3, 403, 201, 0, 583, 584, 3, 399,
+		199, 0, 584, 585, 3, 371, 185, 0, 585, 56, 1, 0, 0, 0, 586, 587, 3, 399,
+		199, 0, 587, 588, 3, 401, 200, 0, 588, 589, 3, 363, 181, 0, 589, 590, 3,
+		401, 200, 0, 590, 591, 3, 371, 185, 0, 591, 592, 3, 349, 174, 0, 592, 593,
+		3, 397, 198, 0, 593, 594, 3, 371, 185, 0, 594, 595, 3, 393, 196, 0, 595,
+		596, 3, 391, 195, 0, 596, 58, 1, 0, 0, 0, 597, 598, 3, 399, 199, 0, 598,
+		599, 3, 401, 200, 0, 599, 600, 3, 363, 181, 0, 600, 601, 3, 401, 200, 0,
+		601, 602, 3, 371, 185, 0, 602, 603, 3, 349, 174, 0, 603, 604, 3, 387, 193,
+		0, 604, 605, 3, 363, 181, 0, 605, 606, 3, 367, 183, 0, 606, 607, 3, 377,
+		188, 0, 607, 608, 3, 379, 189, 0, 608, 609, 3, 389, 194, 0, 609, 610, 3,
+		371, 185, 0, 610, 60, 1, 0, 0, 0, 611, 612, 3, 387, 193, 0, 612, 613, 3,
+		363, 181, 0, 613, 614, 3, 399, 199, 0, 614, 615, 3, 401, 200, 0, 615, 616,
+		3, 371, 185, 0, 616, 617, 3, 397, 198, 0, 617, 62, 1, 0, 0, 0, 618, 619,
+		3, 387, 193, 0, 619, 620, 3, 371, 185, 0, 620, 621, 3, 401, 200, 0, 621,
+		622, 3, 363, 181, 0, 622, 623, 3, 369, 184, 0, 623, 624, 3, 363, 181, 0,
+		624, 625, 3, 401, 200, 0, 625, 626, 3, 363, 181, 0, 626, 64, 1, 0, 0, 0,
+		627, 628, 3, 401, 200, 0, 628, 629, 3, 411, 205, 0, 629, 630, 3, 393, 196,
+		0, 630, 631, 3, 371, 185, 0, 631, 632, 3, 399, 199, 0, 632, 66, 1, 0, 0,
+		0, 633, 634, 3, 401, 200, 0, 634, 635, 3, 411, 205, 0, 635, 636, 3, 393,
+		196, 0, 636, 637, 3, 371, 185, 0, 637, 68, 1, 0, 0, 0, 638, 639, 3, 399,
+		199, 0, 639, 640, 3, 401, 200, 0, 640, 641, 3, 391, 195, 0, 641, 642, 3,
+		397, 198, 0, 642, 643, 3, 363, 181, 0, 643, 644, 3, 375, 187, 0, 644, 645,
+		3, 371, 185, 0, 645, 646, 3, 399, 199, 0, 646, 70, 1, 0, 0, 0, 647, 648,
+		3, 399, 199, 0, 648, 649, 3, 401, 200, 0, 649, 650, 3, 391, 195, 0, 650,
+		651, 3, 397, 198, 0, 651, 652, 3, 363, 181, 0, 652, 653, 3, 375, 187, 0,
+		653, 654, 3, 371, 185, 0, 654, 72, 1, 0, 0, 0, 655, 656, 3, 365, 182, 0,
+		656, 657, 3, 397, 198, 0, 657, 658, 3, 391, 195, 0, 658, 659, 3, 383, 191,
+		0, 659, 660, 3, 371, 185, 0, 660, 661, 3, 397, 198, 0, 661, 74, 1, 0, 0,
+		0, 662, 663, 3, 365, 182, 0, 663, 664, 3, 397, 198, 0, 664, 665, 3, 391,
+		195, 0, 665, 666, 3, 383, 191, 0, 666, 667, 3, 371, 185, 0, 667, 668, 3,
+		397, 198, 0, 668, 669, 3, 399, 199, 0, 669, 76, 1, 0, 0, 0, 670, 671, 3,
+		363, 181, 0, 671, 672, 3, 385, 192, 0, 672, 673, 3, 379, 189, 0, 673, 674,
+		3, 405, 202, 0, 674, 675, 3, 371, 185, 0, 675, 78, 1, 0, 0, 0, 676, 677,
+		3, 399, 199, 0, 677, 678, 3, 367, 183, 0, 678, 679, 3, 377, 188, 0, 679,
+		680, 3, 371, 185, 0, 680, 681, 3, 387, 193, 0, 681, 682, 3, 363, 181, 0,
+		682, 683, 3, 399, 199, 0, 683, 80, 1, 0, 0, 0, 684, 685, 3, 369, 184, 0,
+		685, 686, 3, 363, 181, 0, 686, 687, 3, 401, 200, 0, 687, 688, 3, 363, 181,
+		0, 688, 689, 3, 365, 182, 0, 689, 690, 3, 363, 181, 0, 690, 691, 3, 399,
+		199, 0, 691, 692, 3, 371, 185, 0, 692, 82, 1, 0, 0, 0, 693, 694, 3, 369,
+		184, 0, 694, 695, 3, 363, 181, 0, 695, 696, 3, 401, 200, 0, 696, 697, 3,
+		363, 181, 0, 697, 698, 3, 365, 182, 0, 698, 699, 3, 363, 181, 0, 699, 700,
+		3, 399, 199, 0, 700, 701, 3, 371, 185, 0, 701, 702, 3, 399, 199, 0, 702,
+		84, 1, 0, 0, 0, 703, 704, 3, 389, 194, 0, 704, 705, 3, 363, 181, 0, 705,
+		706, 3, 387, 193, 0, 706, 707, 3, 371, 185, 0, 707, 708, 3, 399, 199, 0,
+		708, 709, 3, 393, 196, 0, 709, 710, 3, 363, 181, 0, 710, 711, 3, 367, 183,
+		0, 711, 712, 3, 371, 185, 0, 712, 86, 1, 0, 0, 0, 713, 714, 3, 389, 194,
+		0, 714, 715, 3, 363, 181, 0, 715, 716, 3, 387, 193, 0, 716, 717, 3, 371,
+		185, 0, 717, 718, 3, 399, 199, 0, 718, 719, 3, 393, 196, 0, 719, 720, 3,
+		363, 181, 0, 720, 721, 3, 367, 183, 0, 721, 722, 3, 371, 185, 0, 722, 723,
+		3, 399, 199, 0, 723, 88, 1, 0, 0, 0, 724, 725, 3, 389, 194, 0, 725, 726,
+		3, 391, 195, 0, 726, 727, 3, 369, 184, 0, 727, 728, 3, 371, 185, 0, 728,
+		90, 1, 0, 0, 0, 729, 730, 3, 387, 193, 0, 730, 731, 3, 371, 185, 0, 731,
+		732, 3, 401, 200, 0, 732, 733, 3, 397, 198, 0, 733, 734, 3, 379, 189, 0,
+		734, 735, 3, 367, 183, 0, 735, 736, 3, 399, 199, 0, 736, 92, 1, 0, 0, 0,
+		737, 738, 3, 387, 193, 0, 738, 739, 3, 371, 185, 0, 739, 740, 3, 401, 200,
+		0, 740, 741, 3, 397, 198, 0, 741, 742, 3, 379, 189, 0, 742, 743, 3, 367,
+		183, 0, 743, 94, 1, 0, 0, 0, 744, 745, 3, 373, 186, 0, 745, 746, 3, 379,
+		189, 0, 746, 747, 3, 371, 185, 0, 747, 748, 3, 385, 192, 0, 748, 749, 3,
+		369, 184, 0, 749, 96, 1, 0, 0, 0, 750, 751, 3, 373, 186, 0, 751, 752, 3,
+		379, 189, 0, 752, 753, 3, 371, 185, 0, 753, 754, 3, 385, 192, 0, 754, 755,
+		3, 369, 184, 0, 755, 756, 3, 399, 199, 0, 756, 98, 1, 0, 0, 0, 757, 758,
+		3, 401, 200, 0, 758, 759, 3, 363, 181, 0, 759, 760, 3, 375, 187, 0, 760,
+		100, 1, 0, 0, 0, 761, 762, 3, 379, 189, 0, 762, 763, 3, 389, 194, 0, 763,
+		764, 3, 373, 186, 0, 764, 765, 3, 391, 195, 0, 765, 102, 1, 0, 0, 0, 766,
+		767, 3, 383, 191, 0, 767, 768, 3, 371, 185, 0, 768, 769, 3, 411, 205, 0,
+		769, 770, 3, 399, 199, 0, 770, 104, 1, 0, 0, 0, 771, 772, 3, 383, 191,
+		0, 772, 773, 3, 371, 185, 0, 773, 774, 3, 411, 205, 0, 774, 106, 1, 0,
+		0, 0, 775, 776, 3, 407, 203, 0, 776, 777, 3, 379, 189, 0, 777, 778, 3,
+		401, 200, 0, 778, 779, 3, 377, 188, 0, 779, 108, 1, 0, 0, 0, 780, 781,
+		3, 405, 202, 0, 781, 782, 3, 363, 181, 0, 782, 783, 3, 385, 192, 0, 783,
+		784, 3, 403, 201, 0, 784, 785, 3, 371, 185, 0, 785, 786, 3, 399, 199, 0,
+		786, 110, 1, 0, 0, 0, 787, 788, 3, 405, 202, 0, 788, 789, 3, 363, 181,
+		0, 789, 790, 3, 385, 192, 0, 790, 791, 3, 403, 201, 0, 791, 792, 3, 371,
+		185, 0, 792, 112, 1, 0, 0, 0, 793, 794, 3, 373, 186, 0, 794, 795, 3, 397,
+		198, 0, 795, 796, 3, 391, 195, 0, 796, 797, 3, 387, 193, 0, 797, 114, 1,
+		0, 0, 0, 798, 799, 3, 407, 203, 0, 799, 800, 3, 377, 188, 0, 800, 801,
+		3, 371, 185, 0, 801, 802, 3, 397, 198, 0, 802, 803, 3, 371, 185, 0, 803,
+		116, 1, 0, 0, 0, 804, 805, 3, 385, 192, 0, 805, 806, 3, 379, 189, 0, 806,
+		807, 3, 387, 193, 0, 807, 808, 3, 379, 189, 0, 808, 809, 3, 401, 200, 0,
+		809, 118, 1, 0, 0, 0, 810, 811, 3, 395, 197, 0, 811, 812, 3, 403, 201,
+		0, 812, 813, 3, 371, 185, 0, 813, 814, 3, 397, 198, 0, 814, 815, 3, 379,
+		189, 0, 815, 816, 3, 371, 185, 0, 816, 817, 3, 399, 199, 0, 817, 120, 1,
+		0, 0, 0, 818, 819, 3, 395, 197, 0, 819, 820, 3, 403, 201, 0, 820, 821,
+		3, 371, 185, 0, 821, 822, 3, 397, 198, 0, 822, 823, 3, 411, 205, 0, 823,
+		122, 1, 0, 0, 0, 824, 825, 3, 371, 185, 0, 825, 826, 3, 409, 204, 0, 826,
+		827, 3, 393, 196, 0, 827, 828, 3, 385, 192, 0, 828, 829, 3, 363, 181, 0,
+		829, 830, 3, 379, 189, 0, 830, 831, 3, 389, 194, 0, 831, 124, 1, 0, 0,
+		0, 832, 833, 3, 407, 203, 0, 833, 834, 3, 379, 189, 0, 834, 835, 3, 401,
+		200, 0, 835, 836, 3, 377, 188, 0, 836, 837, 3, 405, 202, 0, 837, 838, 3,
+		363, 181, 0, 838, 839, 3, 385, 192, 0, 839, 840, 3, 403, 201, 0, 840, 841,
+		3, 371, 185, 0, 841, 126, 1, 0, 0, 0, 842, 843, 3, 399, 199, 0, 843, 844,
+		3, 371, 185, 0, 844, 845, 3, 385, 192, 0, 845, 846, 3, 371, 185, 0, 846,
+		847, 3, 367, 183, 0, 847, 848, 3, 401, 200, 0, 848, 128, 1, 0, 0, 0, 849,
+		850, 3, 363, 181, 0, 850, 851, 3, 399, 199, 0, 851, 130, 1, 0, 0, 0, 852,
+		853, 3, 363, 181, 0, 853, 854, 3, 389, 194, 0, 854, 855, 3, 369, 184, 0,
+		855, 132, 1, 0, 0, 0, 856, 857, 3, 391, 195, 0, 857, 858, 3, 397, 198,
+		0, 858, 134, 1, 0, 0, 0, 859, 860, 3, 373, 186, 0, 860, 861, 3, 379, 189,
+		0, 861, 862, 3, 385, 192, 0, 862, 863, 3, 385, 192, 0, 863, 136, 1, 0,
+		0, 0, 864, 865, 3, 389, 194, 0, 865, 866, 3, 403, 201, 0, 866, 867, 3,
+		385, 192, 0, 867, 868, 3, 385, 192, 0, 868, 138, 1, 0, 0, 0, 869, 870,
+		3, 393, 196, 0, 870, 871, 3, 397, 198, 0, 871, 872, 3, 371, 185, 0, 872,
+		873, 3, 405, 202, 0, 873, 874, 3, 379, 189, 0, 874, 875, 3, 391, 195, 0,
+		875, 876, 3, 403, 201, 0, 876, 877, 3, 399, 199, 0, 877, 140, 1, 0, 0,
+		0, 878, 879, 3, 391, 195, 0, 879, 880, 3, 397, 198, 0, 880, 881, 3, 369,
+		184, 0, 881, 882, 3, 371, 185, 0, 882, 883, 3, 397, 198, 0, 883, 142, 1,
+		0, 0, 0, 884, 885, 3, 363, 181, 0, 885, 886, 3, 399, 199, 0, 886, 887,
+		3, 367, 183, 0, 887, 144, 1, 0, 0, 0, 888, 889, 3, 369, 184, 0, 889, 890,
+		3, 371, 185, 0, 890, 891, 3, 399, 199, 0, 891, 892, 3, 367, 183, 0, 892,
+		146, 1, 0, 0, 0, 893, 894, 3, 385, 192, 0, 894, 895, 3, 379, 189, 0, 895,
+		896, 3, 383, 191, 0, 896, 897, 3, 371, 185, 0, 897, 148, 1, 0, 0, 0, 898,
+		899, 3, 389, 194, 0, 899, 900, 3, 391, 195, 0, 900, 901, 3, 401, 200, 0,
+		901, 150, 1, 0, 0, 0, 902, 903, 3, 365, 182, 0, 903, 904, 3, 371, 185,
+		0, 904, 905, 3, 401, 200, 0, 905, 906, 3, 407, 203, 0, 906, 907, 3, 371,
+		185, 0, 907, 908, 3, 371, 185, 0, 908, 909, 3, 389, 194, 0, 909, 152, 1,
+		0, 0, 0, 910, 911, 3, 379, 189, 0, 911, 912, 3, 399, 199, 0, 912, 154,
+		1, 0, 0, 0, 913, 914, 3, 375, 187, 0, 914, 915, 3, 397, 198, 0, 915, 916,
+		3, 391, 195, 0, 916, 917, 3, 403, 201, 0, 917, 918, 3, 393, 196, 0, 918,
+		156, 1, 0, 0, 0, 919, 920, 3, 377, 188, 0, 920, 921, 3, 363, 181, 0, 921,
+		922, 3, 405, 202, 0, 922, 923, 3, 379, 189, 0, 923, 924, 3, 389, 194, 0,
+		924, 925, 3, 375, 187, 0, 925, 158, 1, 0, 0, 0, 926, 927, 3, 365, 182,
+		0, 927, 928, 3, 411, 205, 0, 928, 160, 1, 0, 0, 0, 929, 930, 3, 373, 186,
+		0, 930, 931, 3, 391, 195, 0, 931, 932, 3, 397, 198, 0, 932, 162, 1, 0,
+		0, 0, 933, 934, 3, 399, 199, 0, 934, 935, 3, 401, 200, 0, 935, 936, 3,
+		363, 181, 0, 936, 937, 3, 401, 200, 0, 937, 938, 3, 399, 199, 0, 938, 164,
+		1, 0, 0, 0, 939, 940, 3, 401, 200, 0, 940, 941, 3, 379, 189, 0, 941, 942,
+		3, 387, 193, 0, 942, 943, 3, 371, 185, 0, 943, 166, 1, 0, 0, 0, 944, 945,
+		3, 389, 194, 0, 945, 946, 3, 391, 195, 0, 946, 947, 3, 407, 203, 0, 947,
+		168, 1, 0, 0, 0, 948, 949, 3, 379, 189, 0, 949, 950, 3, 389, 194, 0, 950,
+		170, 1, 0, 0, 0, 951, 952, 3, 385, 192, 0, 952, 953, 3, 391, 195, 0, 953,
+		954, 3, 375, 187, 0, 954, 172, 1, 0, 0, 0, 955, 956, 3, 393, 196, 0, 956,
+		957, 3, 397, 198, 0, 957, 958, 3, 391, 195, 0, 958, 959, 3, 373, 186, 0,
+		959, 960, 3, 379, 189, 0, 960, 961, 3, 385, 192, 0, 961, 962, 3, 371, 185,
+		0, 962, 174, 1, 0, 0, 0, 963, 964, 3, 397, 198, 0, 964, 965, 3, 371, 185,
+		0, 965, 966, 3, 395, 197, 0, 966, 967, 3, 403, 201, 0, 967, 968, 3, 371,
+		185, 0, 968, 969, 3, 399, 199, 0, 969, 970, 3, 401, 200, 0, 970, 971, 3,
+		399, 199, 0, 971, 176, 1, 0, 0, 0, 972, 973, 3, 397, 198, 0, 973, 974,
+		3, 371, 185, 0, 974, 975, 3, 395, 197, 0, 975, 976, 3, 403, 201, 0, 976,
+		977, 3, 371, 185, 0, 977, 978, 3, 399, 199, 0, 978, 979, 3, 401, 200, 0,
+		979, 178, 1, 0, 0, 0, 980, 981, 3, 379, 189, 0, 981, 982, 3, 369, 184,
+		0, 982, 180, 1, 0, 0, 0, 983, 984, 3, 363, 181, 0, 984, 985, 3, 385, 192,
+		0, 985, 986, 3, 401, 200, 0, 986, 987, 3, 371, 185, 0, 987, 988, 3, 397,
+		198, 0, 988, 182, 1, 0, 0, 0, 989, 990, 3, 365, 182, 0, 990, 991, 3, 363,
+		181, 0, 991, 992, 3, 367, 183, 0, 992, 993, 3, 383, 191, 0, 993, 994, 3,
+		403, 201, 0, 994, 995, 3, 393, 196, 0, 995, 184, 1, 0, 0, 0, 996, 997,
+		3, 397, 198, 0, 997, 998, 3, 371, 185, 0, 998, 999, 3, 399, 199, 0, 999,
+		1000, 3, 401, 200, 0, 1000, 1001, 3, 391, 195, 0, 1001, 1002, 3, 397, 198,
+		0, 1002, 1003, 3, 371, 185, 0, 1003, 186, 1, 0, 0, 0, 1004, 1005, 3, 401,
+		200, 0, 1005, 1006, 3, 391, 195, 0, 1006, 188, 1, 0, 0, 0, 1007, 1008,
+		3, 367, 183, 0, 1008, 1009, 3, 391, 195, 0, 1009, 1010, 3, 387, 193, 0,
+		1010, 1011, 3, 393, 196, 0, 1011, 1012, 3, 363, 181, 0, 1012, 1013, 3,
+		367, 183, 0, 1013, 1014, 3, 401, 200, 0, 1014, 190, 1, 0, 0, 0, 1015, 1016,
+		3, 397, 198, 0, 1016, 1017, 3, 391, 195, 0, 1017, 1018, 3, 385, 192, 0,
+		1018, 1019, 3, 385, 192, 0, 1019, 1020, 3, 403, 201, 0, 1020, 1021, 3,
+		393, 196, 0, 1021, 192, 1, 0, 0, 0, 1022, 1023, 3, 367, 183, 0, 1023, 1024,
+		3, 391, 195, 0, 1024, 1025, 3, 387, 193, 0, 1025, 1026, 3, 393, 196, 0,
+		1026, 1027, 3, 363, 181, 0, 1027, 1028, 3, 367, 183, 0, 1028, 1029, 3,
+		401, 200, 0, 1029, 1030, 3, 379, 189, 0, 1030, 1031, 3, 391, 195, 0, 1031,
+		1032, 3, 389, 194, 0, 1032, 194, 1, 0, 0, 0, 1033, 1034, 3, 373, 186, 0,
+		1034, 1035, 3, 363, 181, 0, 1035, 1036, 3, 387, 193, 0, 1036, 1037, 3,
+		379, 189, 0, 1037, 1038, 3, 385, 192, 0, 1038, 1039, 3, 411, 205, 0, 1039,
+		196, 1, 0, 0, 0, 1040, 1041, 3, 399, 199, 0, 1041, 1042, 3, 385, 192, 0,
+		1042, 1043, 3, 391, 195, 0, 1043, 1044, 3, 407, 203, 0, 1044, 198, 1, 0,
+		0, 0, 1045, 1046, 3, 397, 198, 0, 1046, 1047, 3, 371, 185, 0, 1047, 1048,
+		3, 367, 183, 0, 1048, 1049, 3, 391, 195, 0, 1049, 1050, 3, 397, 198, 0,
+		1050, 1051, 3, 369, 184, 0, 1051, 1052, 3, 379, 189, 0, 1052, 1053, 3,
+		389, 194, 0, 1053, 1054, 3, 375, 187, 0, 1054, 200, 1, 0, 0, 0, 1055, 1056,
+		3, 397, 198, 0, 1056, 1057, 3, 403, 201, 0, 1057, 1058, 3, 385, 192, 0,
+		1058, 1059, 3, 371, 185, 0, 1059, 202, 1, 0, 0, 0, 1060, 1061, 3, 397,
+		198, 0, 1061, 1062, 3, 403, 201, 0, 1062, 1063, 3, 385, 192, 0, 1063, 1064,
+		3, 371, 185, 0, 1064, 1065, 3, 399, 199, 0, 1065, 204, 1, 0, 0, 0, 1066,
+		1067, 3, 379, 189, 0, 1067, 1068, 3, 389, 194, 0, 1068, 1069, 3, 401, 200,
+		0, 1069, 1070, 3, 391, 195, 0, 1070, 206, 1, 0, 0, 0, 1071, 1072, 3, 371,
+		185, 0, 1072, 1073, 3, 405, 202, 0, 1073, 1074, 3, 371, 185, 0, 1074, 1075,
+		3, 397, 198, 0, 1075, 1076, 3, 411, 205, 0, 1076, 208, 1, 0, 0, 0, 1077,
+		1078, 3, 363, 181, 0, 1078, 1079, 3, 385, 192, 0, 1079, 1080, 3, 371, 185,
+		0, 1080, 1081, 3, 397, 198, 0, 1081, 1082, 3, 401, 200, 0, 1082, 210, 1,
+		0, 0, 0, 1083, 1084, 3, 363, 181, 0, 1084, 1085, 3, 385, 192, 0, 1085,
+		1086, 3, 371, 185, 0, 1086, 1087, 3, 397, 198, 0, 1087, 1088, 3, 401, 200,
+		0, 1088, 1089, 3, 399, 199, 0, 1089, 212, 1, 0, 0, 0, 1090, 1091, 3, 407,
+		203, 0, 1091, 1092, 3, 377, 188, 0, 1092, 1093, 3, 371, 185, 0, 1093, 1094,
+		3, 389, 194, 0, 1094, 214, 1, 0, 0, 0, 1095, 1096, 3, 385, 192, 0, 1096,
+		1097, 3, 363, 181, 0, 1097, 1098, 3, 365, 182, 0, 1098, 1099, 3, 371, 185,
+		0, 1099, 1100, 3, 385, 192, 0, 1100, 1101, 3, 399, 199, 0, 1101, 216, 1,
+		0, 0, 0, 1102, 1103, 3, 407, 203, 0, 1103, 1104, 3, 371, 185, 0, 1104,
+		1105, 3, 365, 182, 0, 1105, 1106, 3, 377, 188, 0, 1106, 1107, 3, 391, 195,
+		0, 1107, 1108, 3, 391, 195, 0, 1108, 1109, 3, 383, 191, 0, 1109, 218, 1,
+		0, 0, 0, 1110, 1111, 3, 373, 186, 0, 1111, 1112, 3, 391, 195, 0, 1112,
+		1113, 3, 397, 198, 0, 1113, 1114, 3, 387, 193, 0, 1114, 1115, 3, 363, 181,
+		0, 1115, 1116, 3, 401, 200, 0, 1116, 220, 1, 0, 0, 0, 1117, 1118, 3, 403,
+		201, 0, 1118, 1119, 3, 399, 199, 0, 1119, 1120, 3, 371, 185, 0, 1120, 1121,
+		3, 397, 198, 0, 1121, 222, 1, 0, 0, 0, 1122, 1123, 3, 403, 201, 0, 1123,
+		1124, 3, 399, 199, 0, 1124, 1125, 3, 371, 185, 0, 1125, 1126, 3, 397, 198,
+		0, 1126, 1127, 3, 399, 199, 0, 1127, 224, 1, 0, 0, 0, 1128, 1129, 3, 393,
+		196, 0, 1129, 1130, 3, 363, 181, 0, 1130, 1131, 3, 399, 199, 0, 1131, 1132,
+		3, 399, 199, 0, 1132, 1133, 3, 407, 203, 0, 1133, 1134, 3, 391, 195, 0,
+		1134, 1135, 3, 397, 198, 0, 1135, 1136, 3, 369, 184, 0, 1136, 226, 1, 0,
+		0, 0, 1137, 1138, 3, 401, 200, 0, 1138, 1139, 3, 391, 195, 0, 1139, 1140,
+		3, 383, 191, 0, 1140, 1141, 3, 371, 185, 0, 1141, 1142, 3, 389, 194, 0,
+		1142, 228, 1, 0, 0, 0, 1143, 1144, 3, 401, 200, 0, 1144, 1145, 3, 391,
+		195, 0, 1145, 1146, 3, 383, 191, 0, 1146, 1147, 3, 371, 185, 0, 1147, 1148,
+		3, 389, 194, 0, 1148, 1149, 3, 399, 199, 0, 1149, 230, 1, 0, 0, 0, 1150,
+		1151, 3, 397, 198, 0, 1151, 1152, 3, 391, 195, 0, 1152, 1153, 3, 385, 192,
+		0, 1153, 1154, 3, 371, 185, 0, 1154, 232, 1, 0, 0, 0, 1155, 1156, 3, 397,
+		198, 0, 1156, 1157, 3, 391, 195, 0, 1157, 1158, 3, 385, 192, 0, 1158, 1159,
+		3, 371, 185, 0, 1159, 1160, 3, 399, 199, 0, 1160, 234, 1, 0, 0, 0, 1161,
+		1162, 3, 375, 187, 0, 1162, 1163, 3, 397, 198, 0, 1163, 1164, 3, 363, 181,
+		0, 1164, 1165, 3, 389, 194, 0, 1165, 1166, 3, 401, 200, 0, 1166, 236, 1,
+		0, 0, 0, 1167, 1168, 3, 397, 198, 0, 1168, 1169, 3, 371, 185, 0, 1169,
+		1170, 3, 405, 202, 0, 1170, 1171, 3, 391, 195, 0, 1171, 1172, 3, 383, 191,
+		0, 1172, 1173, 3, 371, 185, 0, 1173, 238, 1, 0, 0, 0, 1174, 1175, 3, 397,
+		198, 0, 1175, 1176, 3, 371, 185, 0, 1176, 1177, 3, 363, 181, 0, 1177, 1178,
+		3, 369, 184, 0, 1178, 240, 1, 0, 0, 0, 1179, 1180, 3, 407, 203, 0, 1180,
+		1181, 3, 397, 198, 0, 1181, 1182, 3, 379, 189, 0, 1182, 1183, 3, 401, 200,
+		0, 1183, 1184, 3, 371, 185, 0, 1184, 242, 1, 0, 0, 0, 1185, 1186, 3, 363,
+		181, 0, 1186, 1187, 3, 385, 192, 0, 1187, 1188, 3, 385, 192, 0, 1188, 244,
+		1, 0, 0, 0, 1189, 1190, 3, 401, 200, 0, 1190, 1191, 3, 371, 185, 0, 1191,
+		1192, 3, 389, 194, 0, 1192, 1193, 3, 363, 181, 0, 1193, 1194, 3, 389, 194,
+		0, 1194, 1195, 3, 401, 200, 0, 1195, 246, 1, 0, 0, 0, 1196, 1197, 3, 401,
+		200, 0, 1197, 1198, 3, 371, 185, 0, 1198, 1199, 3, 389, 194, 0, 1199, 1200,
+		3, 363, 181, 0, 1200, 1201, 3, 389, 194, 0, 1201, 1202, 3, 401, 200, 0,
+		1202, 1203, 3, 399, 199, 0, 1203, 248, 1, 0, 0, 0, 1204, 1205, 3, 403,
+		201, 0, 1205, 1206, 3, 399, 199, 0, 1206, 1207, 3, 363, 181, 0, 1207, 1208,
+		3, 375, 187, 0, 1208, 1209, 3, 371, 185, 0, 1209, 250, 1, 0, 0, 0, 1210,
+		1211, 3, 379, 189, 0, 1211, 1212, 3, 389, 194, 0, 1212, 1213, 3, 375, 187,
+		0, 1213, 1214, 3, 371, 185, 0, 1214, 1215, 3, 399, 199, 0, 1215, 1216,
+		3, 401, 200, 0, 1216, 1217, 3, 349, 174, 0, 1217, 1218, 3, 397, 198, 0,
+		1218, 1219, 3, 363, 181, 0, 1219, 1220, 3, 401, 200, 0, 1220, 1221, 3,
+		371, 185, 0, 1221, 252, 1, 0, 0, 0, 1222, 1223, 3, 387, 193, 0, 1223, 1224,
+		3, 363, 181, 0, 1224, 1225, 3, 409, 204, 0, 1225, 1226, 3, 349, 174, 0,
+		1226, 1227, 3, 399, 199, 0, 1227, 1228, 3, 371, 185, 0, 1228, 1229, 3,
+		397, 198, 0, 1229, 1230, 3, 379, 189, 0, 1230, 1231, 3, 371, 185, 0, 1231,
+		1232, 3, 399, 199, 0, 1232, 254, 1, 0, 0, 0, 1233, 1234, 3, 387, 193, 0,
+		1234, 1235, 3, 363, 181, 0, 1235, 1236, 3, 409, 204, 0, 1236, 1237, 3,
+		349, 174, 0, 1237, 1238, 3, 395, 197, 0, 1238, 1239, 3, 403, 201, 0, 1239,
+		1240, 3, 371, 185, 0, 1240, 1241, 3, 397, 198, 0, 1241, 1242, 3, 379, 189,
+		0, 1242, 1243, 3, 371, 185, 0, 1243, 1244, 3, 399, 199, 0, 1244, 256, 1,
+		0, 0, 0, 1245, 1246, 3, 387, 193, 0, 1246, 1247, 3, 363, 181, 0, 1247,
+		1248, 3, 409, 204, 0, 1248, 1249, 3, 349, 174, 0, 1249, 1250, 3, 369, 184,
+		0, 1250, 1251, 3, 379, 189, 0, 1251, 1252, 3, 399, 199, 0, 1252, 1253,
+		3, 383, 191, 0, 1253, 258, 1, 0, 0, 0, 1254, 1255, 3, 363, 181, 0, 1255,
+		1256, 3, 403, 201, 0, 1256, 1257, 3, 369, 184, 0, 1257, 1258, 3, 379, 189,
+		0, 1258, 1259, 3, 401, 200, 0, 1259, 260, 1, 0, 0, 0, 1260, 1261, 3, 397,
+		198, 0, 1261, 1262, 3, 371, 185, 0, 1262, 1263, 3, 365, 182, 0, 1263, 1264,
+		3, 363, 181, 0, 1264, 1265, 3, 385, 192, 0, 1265, 1266, 3, 363, 181, 0,
+		1266, 1267, 3, 389, 194, 0, 1267, 1268, 3, 367, 183, 0, 1268, 1269, 3,
+		371, 185, 0, 1269, 262, 1, 0, 0, 0, 1270, 1271, 3, 369, 184, 0, 1271, 1272,
+		3, 371, 185, 0, 1272, 1273, 3, 367, 183, 0, 1273, 1274, 3, 391, 195, 0,
+		1274, 1275, 3, 387, 193, 0, 1275, 1276, 3, 387, 193, 0, 1276, 1277, 3,
+		379, 189, 0, 1277, 1278, 3, 399, 199, 0, 1278, 1279, 3, 399, 199, 0, 1279,
+		1280, 3, 379, 189, 0, 1280, 1281, 3, 391, 195, 0, 1281, 1282, 3, 389, 194,
+		0, 1282, 264, 1, 0, 0, 0, 1283, 1284, 3, 397, 198, 0, 1284, 1285, 3, 371,
+		185, 0, 1285, 1286, 3, 393, 196, 0, 1286, 1287, 3, 385, 192, 0, 1287, 1288,
+		3, 379, 189, 0, 1288, 1289, 3, 367, 183, 0, 1289, 1290, 3, 363, 181, 0,
+		1290, 266, 1, 0, 0, 0, 1291, 1292, 3, 399, 199, 0, 1292, 1293, 3, 403,
+		201, 0, 1293, 1294, 3, 387, 193, 0, 1294, 268, 1, 0, 0, 0, 1295, 1296,
+		3, 387, 193, 0, 1296, 1297, 3, 379, 189, 0, 1297, 1298, 3, 389, 194, 0,
+		1298, 270, 1, 0, 0, 0, 1299, 1300, 3, 387, 193, 0, 1300, 1301, 3, 363,
+		181, 0, 1301, 1302, 3, 409, 204, 0, 1302, 272, 1, 0, 0, 0, 1303, 1304,
+		3, 367, 183, 0, 1304, 1305, 3, 391, 195, 0, 1305, 1306, 3, 403, 201, 0,
+		1306, 1307, 3, 389, 194, 0, 1307, 1308, 3, 401, 200, 0, 1308, 274, 1, 0,
+		0, 0, 1309, 1310, 3, 385, 192, 0, 1310, 1311, 3, 363, 181, 0, 1311, 1312,
+		3, 399, 199, 0, 1312, 1313, 3, 401, 200, 0, 1313, 276, 1, 0, 0, 0, 1314,
+		1315, 3, 373, 186, 0, 1315, 1316, 3, 379, 189, 0, 1316, 1317, 3, 397, 198,
+		0, 1317, 1318, 3, 399, 199, 0, 1318, 1319, 3, 401, 200, 0, 1319, 278, 1,
+		0, 0, 0, 1320, 1321, 3, 363, 181, 0, 1321, 1322, 3, 405, 202, 0, 1322,
+		1323, 3, 375, 187, 0, 1323, 280, 1, 0, 0, 0, 1324, 1325, 3, 399, 199, 0,
+		1325, 1326, 3, 401, 200, 0, 1326, 1327, 3, 369, 184, 0, 1327, 1328, 3,
+		369, 184, 0, 1328, 1329, 3, 371, 185, 0, 1329, 1330, 3, 405, 202, 0, 1330,
+		282, 1, 0, 0, 0, 1331, 1332, 3, 395, 197, 0, 1332, 1333, 3, 403, 201, 0,
+		1333, 1334, 3, 363, 181, 0, 1334, 1335, 3, 389, 194, 0, 1335, 1336, 3,
+		401, 200, 0, 1336, 1337, 3, 379, 189, 0, 1337, 1338, 3, 385, 192, 0, 1338,
+		1339, 3, 371, 185, 0, 1339, 284, 1, 0, 0, 0, 1340, 1341, 3, 397, 198, 0,
+		1341, 1342, 3, 363, 181, 0, 1342, 1343, 3, 401, 200, 0, 1343, 1344, 3,
+		371, 185, 0, 1344, 286, 1, 0, 0, 0, 1345, 1346, 3, 399, 199, 0, 1346, 288,
+		1, 0, 0, 0, 1347, 1348, 5, 109, 0, 0, 1348, 290, 1, 0, 0, 0, 1349, 1350,
+		3, 377, 188, 0, 1350, 292, 1, 0, 0, 0, 1351, 1352, 3, 369, 184, 0, 1352,
+		294, 1, 0, 0, 0, 1353, 1354, 3, 407, 203, 0, 1354, 296, 1, 0, 0, 0, 1355,
+		1356, 5, 77, 0, 0, 1356, 298, 1, 0, 0, 0, 1357, 1358, 3, 411, 205, 0, 1358,
+		300, 1, 0, 0, 0, 1359, 1360, 5, 46, 0, 0, 1360, 302, 1, 0, 0, 0, 1361,
+		1362, 5, 58, 0, 0, 1362, 304, 1, 0, 0, 0, 1363, 1364, 5, 59, 0, 0, 1364,
+		306, 1, 0, 0, 0, 1365, 1366, 5, 61, 0, 0, 1366, 308, 1, 0, 0, 0, 1367,
+		1368, 5, 60, 0, 0, 1368, 1369, 5, 62, 0, 0, 1369, 310, 1, 0, 0, 0, 1370,
+		1371, 5, 33, 0, 0, 1371, 1372, 5, 61, 0, 0, 1372, 312, 1, 0, 0, 0, 1373,
+		1374, 5, 62, 0, 0, 1374, 314, 1, 0, 0, 0, 1375, 1376, 5, 62, 0, 0, 1376,
+		1377, 5, 61, 0, 0, 1377, 316, 1, 0, 0, 0, 1378, 1379, 5, 60, 0, 0, 1379,
+		318, 1, 0, 0, 0, 1380, 1381, 5, 60, 0, 0, 1381, 1382, 5, 61, 0, 0, 1382,
+		320, 1, 0, 0, 0, 1383, 1384, 5, 61, 0, 0, 1384, 1385, 5, 126, 0, 0, 1385,
+		322, 1, 0, 0, 0, 1386, 1387, 5, 33, 0, 0, 1387, 1388, 5, 126, 0, 0, 1388,
+		324, 1, 0, 0, 0, 1389, 1390, 5, 44, 0, 0, 1390, 326, 1, 0, 0, 0, 1391,
+		1392, 5, 123, 0, 0, 1392, 328, 1, 0, 0, 0, 1393, 1394, 5, 125, 0, 0, 1394,
+		330, 1, 0, 0, 0, 1395, 1396, 5, 91, 0, 0, 1396, 332, 1, 0, 0, 0, 1397,
+		1398, 5, 93, 0, 0, 1398, 334, 1, 0, 0, 0, 1399, 1400, 5, 40, 0, 0, 1400,
+		336, 1, 0, 0, 0, 1401, 1402, 5, 41, 0, 0, 1402, 338, 1, 0, 0, 0, 1403,
+		1404, 5, 43, 0, 0, 1404, 340, 1, 0, 0, 0, 1405, 1406, 5, 45, 0, 0, 1406,
+		342, 1, 0, 0, 0, 1407, 1408, 5, 47, 0, 0, 1408, 344, 1, 0, 0, 0, 1409,
+		1410, 5, 42, 0, 0, 1410, 346, 1, 0, 0, 0, 1411, 1412, 5, 37, 0, 0, 1412,
+		348, 1, 0, 0, 0, 1413, 1414, 5, 95, 0, 0, 1414, 350, 1, 0, 0, 0, 1415,
+		1416, 3, 361, 180, 0, 1416, 352, 1, 0, 0, 0, 1417, 1419, 3, 359, 179, 0,
+		1418, 1417, 1, 0, 0, 0, 1419, 1420, 1, 0, 0, 0, 1420, 1418, 1, 0, 0, 0,
+		1420, 1421, 1, 0, 0, 0, 1421, 354, 1, 0, 0, 0, 1422, 1424, 3, 359, 179,
+		0, 1423, 1422, 1, 0, 0, 0, 1424, 1425, 1, 0, 0, 0, 1425, 1423, 1, 0, 0,
+		0, 1425, 1426, 1, 0, 0, 0, 1426, 1427, 1, 0, 0, 0, 1427, 1428, 5, 46, 0,
+		0, 1428, 1432, 8, 6, 0, 0, 1429, 1431, 3, 359, 179, 0, 1430, 1429, 1, 0,
+		0, 0, 1431, 1434, 1, 0, 0, 0, 1432, 1430, 1, 0, 0, 0, 1432, 1433, 1, 0,
+		0, 0, 1433, 1442, 1, 0, 0, 0, 1434, 1432, 1, 0, 0, 0, 1435, 1437, 5, 46,
+		0, 0, 1436, 1438, 3, 359, 179, 0, 1437, 1436, 1, 0, 0, 0, 1438, 1439, 1,
+		0, 0, 0, 1439, 1437, 1, 0, 0, 0, 1439, 1440, 1, 0, 0, 0, 1440, 1442, 1,
+		0, 0, 0, 1441, 1423, 1, 0, 0, 0, 1441, 1435, 1, 0, 0, 0, 1442, 356, 1,
+		0, 0, 0, 1443, 1444, 7, 5, 0, 0, 1444, 358, 1, 0, 0, 0, 1445, 1446, 7,
+		7, 0, 0, 1446, 360, 1, 0, 0, 0, 1447, 1453, 7, 8, 0, 0, 1448, 1452, 7,
+		8, 0, 0, 1449, 1452, 3, 359, 179, 0, 1450, 1452, 7, 9, 0, 0, 1451, 1448,
+		1, 0, 0, 0, 1451, 1449, 1, 0, 0, 0, 1451, 1450, 1, 0, 0, 0, 1452, 1455,
+		1, 0, 0, 0, 1453, 1451, 1, 0, 0, 0, 1453, 1454, 1, 0, 0, 0, 1454, 1498,
+		1, 0, 0, 0, 1455, 1453, 1, 0, 0, 0, 1456, 1457, 5, 36, 0, 0, 1457, 1461,
+		5, 123, 0, 0, 1458, 1460, 9, 0, 0, 0, 1459, 1458, 1, 0, 0, 0, 1460, 1463,
+		1, 0, 0, 0, 1461, 1462, 1, 0, 0, 0, 1461, 1459, 1, 0, 0, 0, 1462, 1464,
+		1, 0, 0, 0, 1463, 1461, 1, 0, 0, 0, 1464, 1498, 5, 125, 0, 0, 1465, 1469,
+		7, 10, 0, 0, 1466, 1470, 7, 8, 0, 0, 1467, 1470, 3, 359, 179, 0, 1468,
+		1470, 7, 11, 0, 0, 1469, 1466, 1, 0, 0, 0, 1469, 1467, 1, 0, 0, 0, 1469,
+		1468, 1, 0, 0, 0, 1470, 1471, 1, 0, 0, 0, 1471, 1469, 1, 0, 0, 0, 1471,
+		1472, 1, 0, 0, 0, 1472, 1498, 1, 0, 0, 0, 1473, 1477, 5, 34, 0, 0, 1474,
+		1476, 9, 0, 0, 0, 1475, 1474, 1, 0, 0, 0, 1476, 1479, 1, 0, 0, 0, 1477,
+		1478, 1, 0, 0, 0, 1477, 1475, 1, 0, 0, 0, 1478, 1480, 1, 0, 0, 0, 1479,
+		1477, 1, 0, 0, 0, 1480, 1498, 5, 34, 0, 0, 1481, 1485, 5, 96, 0, 0, 1482,
+		1484, 9, 0, 0, 0, 1483, 1482, 1, 0, 0, 0, 1484, 1487, 1, 0, 0, 0, 1485,
+		1486, 1, 0, 0, 0, 1485, 1483, 1, 0, 0, 0, 1486, 1488, 1, 0, 0, 0, 1487,
+		1485, 1, 0, 0, 0, 1488, 1498, 5, 96, 0, 0, 1489, 1493, 5, 39, 0, 0, 1490,
+		1492, 9, 0, 0, 0, 1491, 1490, 1, 0, 0, 0, 1492, 1495, 1, 0, 0, 0, 1493,
+		1494, 1, 0, 0, 0, 1493, 1491, 1, 0, 0, 0, 1494, 1496, 1, 0, 0, 0, 1495,
+		1493, 1, 0, 0, 0, 1496, 1498, 5, 39, 0, 0, 1497, 1447, 1, 0, 0, 0, 1497,
+		1456, 1, 0, 0, 0, 1497, 1465, 1, 0, 0, 0, 1497, 1473, 1, 0, 0, 0, 1497,
+		1481, 1, 0, 0, 0, 1497, 1489, 1, 0, 0, 0, 1498, 362, 1, 0, 0, 0, 1499,
+		1500, 7, 12, 0, 0, 1500, 364, 1, 0, 0, 0, 1501, 1502, 7, 13, 0, 0, 1502,
+		366, 1, 0, 0, 0, 1503, 1504, 7, 14, 0, 0, 1504, 368, 1, 0, 0, 0, 1505,
+		1506, 7, 15, 0, 0, 1506, 370, 1, 0, 0, 0, 1507, 1508, 7, 3, 0, 0, 1508,
+		372, 1, 0, 0, 0, 1509, 1510, 7, 16, 0, 0, 1510, 374, 1, 0, 0, 0, 1511,
+		1512, 7, 17, 0, 0, 1512, 376, 1, 0, 0, 0, 1513, 1514, 7, 18, 0, 0, 1514,
+		378, 1, 0, 0, 0, 1515, 1516, 7, 19, 0, 0, 1516, 380, 1, 0, 0, 0, 1517,
+		1518, 7, 20, 0, 0, 1518, 382, 1, 0, 0, 0, 1519, 1520, 7, 21, 0, 0, 1520,
+		384, 1, 0, 0, 0, 1521, 1522, 7, 22, 0, 0, 1522, 386, 1, 0, 0, 0, 1523,
+		1524, 7, 23, 0, 0, 1524, 388, 1, 0, 0, 0, 1525, 1526, 7, 24, 0, 0, 1526,
+		390, 1, 0, 0, 0, 1527, 1528, 7, 25, 0, 0, 1528, 392, 1, 0, 0, 0, 1529,
+		1530, 7, 26, 0, 0, 1530, 394, 1, 0, 0, 0, 1531, 1532, 7, 27, 0, 0, 1532,
+		396, 1, 0, 0, 0, 1533, 1534, 7, 28, 0, 0, 1534, 398, 1, 0, 0, 0, 1535,
+		1536, 7, 29, 0, 0, 1536, 400, 1, 0, 0, 0, 1537, 1538, 7, 30, 0, 0, 1538,
+		402, 1, 0, 0, 0, 1539, 1540, 7, 31, 0, 0, 1540, 404, 1, 0, 0, 0, 1541,
+		1542, 7, 32, 0, 0, 1542, 406, 1, 0, 0, 0, 1543, 1544, 7, 33, 0, 0, 1544,
+		408, 1, 0, 0, 0, 1545, 1546, 7, 34, 0, 0, 1546, 410, 1, 0, 0, 0, 1547,
+		1548, 7, 35, 0, 0, 1548, 412, 1, 0, 0, 0, 1549, 1550, 7, 36, 0, 0, 1550,
+		414, 1, 0, 0, 0, 20, 0, 434, 436, 444, 458, 465, 1420, 1425, 1432, 1439,
+		1441, 1451, 1453, 1461, 1469, 1471, 1477, 1485, 1493, 1497, 1, 6, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -771,48 +982,92 @@ const (
 	SQLLexerT_REQUESTS      = 83
 	SQLLexerT_REQUEST       = 84
 	SQLLexerT_ID            = 85
-	SQLLexerT_SUM           = 86
-	SQLLexerT_MIN           = 87
-	SQLLexerT_MAX           = 88
-	SQLLexerT_COUNT         = 89
-	SQLLexerT_LAST          = 90
-	SQLLexerT_FIRST         = 91
-	SQLLexerT_AVG           = 92
-	SQLLexerT_STDDEV        = 93
-	SQLLexerT_QUANTILE      = 94
-	SQLLexerT_RATE          = 95
-	SQLLexerT_SECOND        = 96
-	SQLLexerT_MINUTE        = 97
-	SQLLexerT_HOUR          = 98
-	SQLLexerT_DAY           = 99
-	SQLLexerT_WEEK          = 100
-	SQLLexerT_MONTH         = 101
-	SQLLexerT_YEAR          = 102
-	SQLLexerT_DOT           = 103
-	SQLLexerT_COLON         = 104
-	SQLLexerT_EQUAL         = 105
-	SQLLexerT_NOTEQUAL      = 106
-	SQLLexerT_NOTEQUAL2     = 107
-	SQLLexerT_GREATER       = 108
-	SQLLexerT_GREATEREQUAL  = 109
-	SQLLexerT_LESS          = 110
-	SQLLexerT_LESSEQUAL     = 111
-	SQLLexerT_REGEXP        = 112
-	SQLLexerT_NEQREGEXP     = 113
-	SQLLexerT_COMMA         = 114
-	SQLLexerT_OPEN_B        = 115
-	SQLLexerT_CLOSE_B       = 116
-	SQLLexerT_OPEN_SB       = 117
-	SQLLexerT_CLOSE_SB      = 118
-	SQLLexerT_OPEN_P        = 119
-	SQLLexerT_CLOSE_P       = 120
-	SQLLexerT_ADD           = 121
-	SQLLexerT_SUB           = 122
-	SQLLexerT_DIV           = 123
-	SQLLexerT_MUL           = 124
-	SQLLexerT_MOD           = 125
-	SQLLexerT_UNDERLINE     = 126
-	SQLLexerL_ID            = 127
-	SQLLexerL_INT           = 128
-	SQLLexerL_DEC           = 129
+	SQLLexerT_ALTER         = 86
+	SQLLexerT_BACKUP        = 87
+	SQLLexerT_RESTORE       = 88
+	SQLLexerT_TO            = 89
+	SQLLexerT_COMPACT       = 90
+	SQLLexerT_ROLLUP        = 91
+	SQLLexerT_COMPACTION    = 92
+	SQLLexerT_FAMILY        = 93
+	SQLLexerT_SLOW          = 94
+	SQLLexerT_RECORDING     = 95
+	SQLLexerT_RULE          = 96
+	SQLLexerT_RULES         = 97
+	SQLLexerT_INTO          = 98
+	SQLLexerT_EVERY         = 99
+	SQLLexerT_ALERT         = 100
+	SQLLexerT_ALERTS        = 101
+	SQLLexerT_WHEN          = 102
+	SQLLexerT_LABELS        = 103
+	SQLLexerT_WEBHOOK       = 104
+	SQLLexerT_FORMAT        = 105
+	SQLLexerT_USER          = 106
+	SQLLexerT_USERS         = 107
+	SQLLexerT_PASSWORD      = 108
+	SQLLexerT_TOKEN         = 109
+	SQLLexerT_TOKENS        = 110
+	SQLLexerT_ROLE          = 111
+	SQLLexerT_ROLES         = 112
+	SQLLexerT_GRANT         = 113
+	SQLLexerT_REVOKE        = 114
+	SQLLexerT_READ          = 115
+	SQLLexerT_WRITE         = 116
+	SQLLexerT_ALL           = 117
+	SQLLexerT_TENANT        = 118
+	SQLLexerT_TENANTS       = 119
+	SQLLexerT_USAGE         = 120
+	SQLLexerT_INGEST_RATE   = 121
+	SQLLexerT_MAX_SERIES    = 122
+	SQLLexerT_MAX_QUERIES   = 123
+	SQLLexerT_MAX_DISK      = 124
+	SQLLexerT_AUDIT         = 125
+	SQLLexerT_REBALANCE     = 126
+	SQLLexerT_DECOMMISSION  = 127
+	SQLLexerT_REPLICA       = 128
+	SQLLexerT_SUM           = 129
+	SQLLexerT_MIN           = 130
+	SQLLexerT_MAX           = 131
+	SQLLexerT_COUNT         = 132
+	SQLLexerT_LAST          = 133
+	SQLLexerT_FIRST         = 134
+	SQLLexerT_AVG           = 135
+	SQLLexerT_STDDEV        = 136
+	SQLLexerT_QUANTILE      = 137
+	SQLLexerT_RATE          = 138
+	SQLLexerT_SECOND        = 139
+	SQLLexerT_MINUTE        = 140
+	SQLLexerT_HOUR          = 141
+	SQLLexerT_DAY           = 142
+	SQLLexerT_WEEK          = 143
+	SQLLexerT_MONTH         = 144
+	SQLLexerT_YEAR          = 145
+	SQLLexerT_DOT           = 146
+	SQLLexerT_COLON         = 147
+	SQLLexerT_SEMICOLON     = 148
+	SQLLexerT_EQUAL         = 149
+	SQLLexerT_NOTEQUAL      = 150
+	SQLLexerT_NOTEQUAL2     = 151
+	SQLLexerT_GREATER       = 152
+	SQLLexerT_GREATEREQUAL  = 153
+	SQLLexerT_LESS          = 154
+	SQLLexerT_LESSEQUAL     = 155
+	SQLLexerT_REGEXP        = 156
+	SQLLexerT_NEQREGEXP     = 157
+	SQLLexerT_COMMA         = 158
+	SQLLexerT_OPEN_B        = 159
+	SQLLexerT_CLOSE_B       = 160
+	SQLLexerT_OPEN_SB       = 161
+	SQLLexerT_CLOSE_SB      = 162
+	SQLLexerT_OPEN_P        = 163
+	SQLLexerT_CLOSE_P       = 164
+	SQLLexerT_ADD           = 165
+	SQLLexerT_SUB           = 166
+	SQLLexerT_DIV           = 167
+	SQLLexerT_MUL           = 168
+	SQLLexerT_MOD           = 169
+	SQLLexerT_UNDERLINE     = 170
+	SQLLexerL_ID            = 171
+	SQLLexerL_INT           = 172
+	SQLLexerL_DEC           = 173
 )
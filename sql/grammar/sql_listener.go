@@ -111,6 +111,138 @@ type SQLListener interface {
 	// EnterShowTagValuesStmt is called when entering the showTagValuesStmt production.
 	EnterShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
+	// EnterAlterDatabaseStmt is called when entering the alterDatabaseStmt production.
+	EnterAlterDatabaseStmt(c *AlterDatabaseStmtContext)
+
+	// EnterBackupStmt is called when entering the backupStmt production.
+	EnterBackupStmt(c *BackupStmtContext)
+
+	// EnterRestoreStmt is called when entering the restoreStmt production.
+	EnterRestoreStmt(c *RestoreStmtContext)
+
+	// EnterCompactStmt is called when entering the compactStmt production.
+	EnterCompactStmt(c *CompactStmtContext)
+
+	// EnterCompactOption is called when entering the compactOption production.
+	EnterCompactOption(c *CompactOptionContext)
+
+	// EnterShardIDList is called when entering the shardIDList production.
+	EnterShardIDList(c *ShardIDListContext)
+
+	// EnterShowCompactionStmt is called when entering the showCompactionStmt production.
+	EnterShowCompactionStmt(c *ShowCompactionStmtContext)
+
+	// EnterShowSlowRequestsStmt is called when entering the showSlowRequestsStmt production.
+	EnterShowSlowRequestsStmt(c *ShowSlowRequestsStmtContext)
+
+	// EnterCreateRecordingRuleStmt is called when entering the createRecordingRuleStmt production.
+	EnterCreateRecordingRuleStmt(c *CreateRecordingRuleStmtContext)
+
+	// EnterRecordingRuleOption is called when entering the recordingRuleOption production.
+	EnterRecordingRuleOption(c *RecordingRuleOptionContext)
+
+	// EnterShowRecordingRulesStmt is called when entering the showRecordingRulesStmt production.
+	EnterShowRecordingRulesStmt(c *ShowRecordingRulesStmtContext)
+
+	// EnterDropRecordingRuleStmt is called when entering the dropRecordingRuleStmt production.
+	EnterDropRecordingRuleStmt(c *DropRecordingRuleStmtContext)
+
+	// EnterCreateAlertStmt is called when entering the createAlertStmt production.
+	EnterCreateAlertStmt(c *CreateAlertStmtContext)
+
+	// EnterAlertOption is called when entering the alertOption production.
+	EnterAlertOption(c *AlertOptionContext)
+
+	// EnterShowAlertsStmt is called when entering the showAlertsStmt production.
+	EnterShowAlertsStmt(c *ShowAlertsStmtContext)
+
+	// EnterDropAlertStmt is called when entering the dropAlertStmt production.
+	EnterDropAlertStmt(c *DropAlertStmtContext)
+
+	// EnterCreateUserStmt is called when entering the createUserStmt production.
+	EnterCreateUserStmt(c *CreateUserStmtContext)
+
+	// EnterAlterUserStmt is called when entering the alterUserStmt production.
+	EnterAlterUserStmt(c *AlterUserStmtContext)
+
+	// EnterDropUserStmt is called when entering the dropUserStmt production.
+	EnterDropUserStmt(c *DropUserStmtContext)
+
+	// EnterShowUsersStmt is called when entering the showUsersStmt production.
+	EnterShowUsersStmt(c *ShowUsersStmtContext)
+
+	// EnterCreateRoleStmt is called when entering the createRoleStmt production.
+	EnterCreateRoleStmt(c *CreateRoleStmtContext)
+
+	// EnterDropRoleStmt is called when entering the dropRoleStmt production.
+	EnterDropRoleStmt(c *DropRoleStmtContext)
+
+	// EnterShowRolesStmt is called when entering the showRolesStmt production.
+	EnterShowRolesStmt(c *ShowRolesStmtContext)
+
+	// EnterGrantStmt is called when entering the grantStmt production.
+	EnterGrantStmt(c *GrantStmtContext)
+
+	// EnterRevokeStmt is called when entering the revokeStmt production.
+	EnterRevokeStmt(c *RevokeStmtContext)
+
+	// EnterRoleGrant is called when entering the roleGrant production.
+	EnterRoleGrant(c *RoleGrantContext)
+
+	// EnterPrivilegeGrant is called when entering the privilegeGrant production.
+	EnterPrivilegeGrant(c *PrivilegeGrantContext)
+
+	// EnterPrivilegeDatabase is called when entering the privilegeDatabase production.
+	EnterPrivilegeDatabase(c *PrivilegeDatabaseContext)
+
+	// EnterPrivilegeList is called when entering the privilegeList production.
+	EnterPrivilegeList(c *PrivilegeListContext)
+
+	// EnterPrivilege is called when entering the privilege production.
+	EnterPrivilege(c *PrivilegeContext)
+
+	// EnterCreateTokenStmt is called when entering the createTokenStmt production.
+	EnterCreateTokenStmt(c *CreateTokenStmtContext)
+
+	// EnterDropTokenStmt is called when entering the dropTokenStmt production.
+	EnterDropTokenStmt(c *DropTokenStmtContext)
+
+	// EnterShowTokensStmt is called when entering the showTokensStmt production.
+	EnterShowTokensStmt(c *ShowTokensStmtContext)
+
+	// EnterCreateTenantStmt is called when entering the createTenantStmt production.
+	EnterCreateTenantStmt(c *CreateTenantStmtContext)
+
+	// EnterAlterTenantStmt is called when entering the alterTenantStmt production.
+	EnterAlterTenantStmt(c *AlterTenantStmtContext)
+
+	// EnterTenantQuota is called when entering the tenantQuota production.
+	EnterTenantQuota(c *TenantQuotaContext)
+
+	// EnterDropTenantStmt is called when entering the dropTenantStmt production.
+	EnterDropTenantStmt(c *DropTenantStmtContext)
+
+	// EnterShowTenantsStmt is called when entering the showTenantsStmt production.
+	EnterShowTenantsStmt(c *ShowTenantsStmtContext)
+
+	// EnterShowTenantUsageStmt is called when entering the showTenantUsageStmt production.
+	EnterShowTenantUsageStmt(c *ShowTenantUsageStmtContext)
+
+	// EnterShowAuditLogStmt is called when entering the showAuditLogStmt production.
+	EnterShowAuditLogStmt(c *ShowAuditLogStmtContext)
+
+	// EnterShowRebalanceStmt is called when entering the showRebalanceStmt production.
+	EnterShowRebalanceStmt(c *ShowRebalanceStmtContext)
+
+	// EnterDecommissionStmt is called when entering the decommissionStmt production.
+	EnterDecommissionStmt(c *DecommissionStmtContext)
+
+	// EnterDurationValue is called when entering the durationValue production.
+	EnterDurationValue(c *DurationValueContext)
+
+	// EnterTextValue is called when entering the textValue production.
+	EnterTextValue(c *TextValueContext)
+
 	// EnterPrefix is called when entering the prefix production.
 	EnterPrefix(c *PrefixContext)
 
@@ -384,6 +516,138 @@ type SQLListener interface {
 	// ExitShowTagValuesStmt is called when exiting the showTagValuesStmt production.
 	ExitShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
+	// ExitAlterDatabaseStmt is called when exiting the alterDatabaseStmt production.
+	ExitAlterDatabaseStmt(c *AlterDatabaseStmtContext)
+
+	// ExitBackupStmt is called when exiting the backupStmt production.
+	ExitBackupStmt(c *BackupStmtContext)
+
+	// ExitRestoreStmt is called when exiting the restoreStmt production.
+	ExitRestoreStmt(c *RestoreStmtContext)
+
+	// ExitCompactStmt is called when exiting the compactStmt production.
+	ExitCompactStmt(c *CompactStmtContext)
+
+	// ExitCompactOption is called when exiting the compactOption production.
+	ExitCompactOption(c *CompactOptionContext)
+
+	// ExitShardIDList is called when exiting the shardIDList production.
+	ExitShardIDList(c *ShardIDListContext)
+
+	// ExitShowCompactionStmt is called when exiting the showCompactionStmt production.
+	ExitShowCompactionStmt(c *ShowCompactionStmtContext)
+
+	// ExitShowSlowRequestsStmt is called when exiting the showSlowRequestsStmt production.
+	ExitShowSlowRequestsStmt(c *ShowSlowRequestsStmtContext)
+
+	// ExitCreateRecordingRuleStmt is called when exiting the createRecordingRuleStmt production.
+	ExitCreateRecordingRuleStmt(c *CreateRecordingRuleStmtContext)
+
+	// ExitRecordingRuleOption is called when exiting the recordingRuleOption production.
+	ExitRecordingRuleOption(c *RecordingRuleOptionContext)
+
+	// ExitShowRecordingRulesStmt is called when exiting the showRecordingRulesStmt production.
+	ExitShowRecordingRulesStmt(c *ShowRecordingRulesStmtContext)
+
+	// ExitDropRecordingRuleStmt is called when exiting the dropRecordingRuleStmt production.
+	ExitDropRecordingRuleStmt(c *DropRecordingRuleStmtContext)
+
+	// ExitCreateAlertStmt is called when exiting the createAlertStmt production.
+	ExitCreateAlertStmt(c *CreateAlertStmtContext)
+
+	// ExitAlertOption is called when exiting the alertOption production.
+	ExitAlertOption(c *AlertOptionContext)
+
+	// ExitShowAlertsStmt is called when exiting the showAlertsStmt production.
+	ExitShowAlertsStmt(c *ShowAlertsStmtContext)
+
+	// ExitDropAlertStmt is called when exiting the dropAlertStmt production.
+	ExitDropAlertStmt(c *DropAlertStmtContext)
+
+	// ExitCreateUserStmt is called when exiting the createUserStmt production.
+	ExitCreateUserStmt(c *CreateUserStmtContext)
+
+	// ExitAlterUserStmt is called when exiting the alterUserStmt production.
+	ExitAlterUserStmt(c *AlterUserStmtContext)
+
+	// ExitDropUserStmt is called when exiting the dropUserStmt production.
+	ExitDropUserStmt(c *DropUserStmtContext)
+
+	// ExitShowUsersStmt is called when exiting the showUsersStmt production.
+	ExitShowUsersStmt(c *ShowUsersStmtContext)
+
+	// ExitCreateRoleStmt is called when exiting the createRoleStmt production.
+	ExitCreateRoleStmt(c *CreateRoleStmtContext)
+
+	// ExitDropRoleStmt is called when exiting the dropRoleStmt production.
+	ExitDropRoleStmt(c *DropRoleStmtContext)
+
+	// ExitShowRolesStmt is called when exiting the showRolesStmt production.
+	ExitShowRolesStmt(c *ShowRolesStmtContext)
+
+	// ExitGrantStmt is called when exiting the grantStmt production.
+	ExitGrantStmt(c *GrantStmtContext)
+
+	// ExitRevokeStmt is called when exiting the revokeStmt production.
+	ExitRevokeStmt(c *RevokeStmtContext)
+
+	// ExitRoleGrant is called when exiting the roleGrant production.
+	ExitRoleGrant(c *RoleGrantContext)
+
+	// ExitPrivilegeGrant is called when exiting the privilegeGrant production.
+	ExitPrivilegeGrant(c *PrivilegeGrantContext)
+
+	// ExitPrivilegeDatabase is called when exiting the privilegeDatabase production.
+	ExitPrivilegeDatabase(c *PrivilegeDatabaseContext)
+
+	// ExitPrivilegeList is called when exiting the privilegeList production.
+	ExitPrivilegeList(c *PrivilegeListContext)
+
+	// ExitPrivilege is called when exiting the privilege production.
+	ExitPrivilege(c *PrivilegeContext)
+
+	// ExitCreateTokenStmt is called when exiting the createTokenStmt production.
+	ExitCreateTokenStmt(c *CreateTokenStmtContext)
+
+	// ExitDropTokenStmt is called when exiting the dropTokenStmt production.
+	ExitDropTokenStmt(c *DropTokenStmtContext)
+
+	// ExitShowTokensStmt is called when exiting the showTokensStmt production.
+	ExitShowTokensStmt(c *ShowTokensStmtContext)
+
+	// ExitCreateTenantStmt is called when exiting the createTenantStmt production.
+	ExitCreateTenantStmt(c *CreateTenantStmtContext)
+
+	// ExitAlterTenantStmt is called when exiting the alterTenantStmt production.
+	ExitAlterTenantStmt(c *AlterTenantStmtContext)
+
+	// ExitTenantQuota is called when exiting the tenantQuota production.
+	ExitTenantQuota(c *TenantQuotaContext)
+
+	// ExitDropTenantStmt is called when exiting the dropTenantStmt production.
+	ExitDropTenantStmt(c *DropTenantStmtContext)
+
+	// ExitShowTenantsStmt is called when exiting the showTenantsStmt production.
+	ExitShowTenantsStmt(c *ShowTenantsStmtContext)
+
+	// ExitShowTenantUsageStmt is called when exiting the showTenantUsageStmt production.
+	ExitShowTenantUsageStmt(c *ShowTenantUsageStmtContext)
+
+	// ExitShowAuditLogStmt is called when exiting the showAuditLogStmt production.
+	ExitShowAuditLogStmt(c *ShowAuditLogStmtContext)
+
+	// ExitShowRebalanceStmt is called when exiting the showRebalanceStmt production.
+	ExitShowRebalanceStmt(c *ShowRebalanceStmtContext)
+
+	// ExitDecommissionStmt is called when exiting the decommissionStmt production.
+	ExitDecommissionStmt(c *DecommissionStmtContext)
+
+	// ExitDurationValue is called when exiting the durationValue production.
+	ExitDurationValue(c *DurationValueContext)
+
+	// ExitTextValue is called when exiting the textValue production.
+	ExitTextValue(c *TextValueContext)
+
 	// ExitPrefix is called when exiting the prefix production.
 	ExitPrefix(c *PrefixContext)
 
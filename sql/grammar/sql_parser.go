@@ -54,10 +54,13 @@ func sqlParserInit() {
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'m'",
-		"", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='",
-		"'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('",
-		"')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "'m'", "", "", "", "'M'", "", "'.'",
+		"':'", "';'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'", "'<='", "'=~'",
+		"'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'", "'+'", "'-'",
+		"'/'", "'*'", "'%'", "'_'",
 	}
 	staticData.symbolicNames = []string{
 		"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
@@ -73,13 +76,21 @@ func sqlParserInit() {
 		"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
 		"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME",
 		"T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID",
-		"T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG",
-		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR",
-		"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
-		"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS",
-		"T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B",
-		"T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB",
-		"T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
+		"T_ALTER", "T_BACKUP", "T_RESTORE", "T_TO", "T_COMPACT", "T_ROLLUP",
+		"T_COMPACTION", "T_FAMILY", "T_SLOW", "T_RECORDING", "T_RULE", "T_RULES",
+		"T_INTO", "T_EVERY", "T_ALERT", "T_ALERTS", "T_WHEN", "T_LABELS", "T_WEBHOOK",
+		"T_FORMAT", "T_USER", "T_USERS", "T_PASSWORD", "T_TOKEN", "T_TOKENS",
+		"T_ROLE", "T_ROLES", "T_GRANT", "T_REVOKE", "T_READ", "T_WRITE", "T_ALL",
+		"T_TENANT", "T_TENANTS", "T_USAGE", "T_INGEST_RATE", "T_MAX_SERIES",
+		"T_MAX_QUERIES", "T_MAX_DISK", "T_AUDIT", "T_REBALANCE", "T_DECOMMISSION",
+		"T_REPLICA", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST",
+		"T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE",
+		"T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON",
+		"T_SEMICOLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER",
+		"T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP",
+		"T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P",
+		"T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE",
+		"L_ID", "L_INT", "L_DEC",
 	}
 	staticData.ruleNames = []string{
 		"statement", "useStmt", "showStmt", "showMasterStmt", "showRequestsStmt",
@@ -89,7 +100,18 @@ func sqlParserInit() {
 		"showStorageMetricStmt", "createStorageStmt", "createBrokerStmt", "recoverStorageStmt",
 		"showSchemasStmt", "createDatabaseStmt", "dropDatabaseStmt", "showDatabaseStmt",
 		"showNameSpacesStmt", "showMetricsStmt", "showFieldsStmt", "showTagKeysStmt",
-		"showTagValuesStmt", "prefix", "withTagKey", "namespace", "databaseName",
+		"showTagValuesStmt", "alterDatabaseStmt", "backupStmt", "restoreStmt",
+		"compactStmt", "compactOption", "shardIDList", "showCompactionStmt",
+		"showSlowRequestsStmt", "createRecordingRuleStmt", "recordingRuleOption",
+		"showRecordingRulesStmt", "dropRecordingRuleStmt", "createAlertStmt",
+		"alertOption", "showAlertsStmt", "dropAlertStmt", "createUserStmt",
+		"alterUserStmt", "dropUserStmt", "showUsersStmt", "createRoleStmt",
+		"dropRoleStmt", "showRolesStmt", "grantStmt", "revokeStmt", "roleGrant",
+		"privilegeGrant", "privilegeDatabase", "privilegeList", "privilege",
+		"createTokenStmt", "dropTokenStmt", "showTokensStmt", "createTenantStmt",
+		"alterTenantStmt", "tenantQuota", "dropTenantStmt", "showTenantsStmt",
+		"showTenantUsageStmt", "showAuditLogStmt", "showRebalanceStmt", "decommissionStmt",
+		"durationValue", "textValue", "prefix", "withTagKey", "namespace", "databaseName",
 		"storageName", "requestID", "source", "queryStmt", "sourceAndSelect",
 		"selectExpr", "fields", "field", "alias", "storageFilter", "databaseFilter",
 		"typeFilter", "fromClause", "whereClause", "conditionExpr", "tagFilterExpr",
@@ -104,363 +126,561 @@ func sqlParserInit() {
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 129, 807, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
-		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
-		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
-		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
-		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
-		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
-		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
-		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
-		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
-		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
-		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
-		7, 89, 2, 90, 7, 90, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-		0, 1, 0, 1, 0, 3, 0, 194, 8, 0, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1,
+		4, 1, 173, 1215, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
+		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
+		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
+		2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31,
+		7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7,
+		36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41,
+		2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2,
+		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
+		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
+		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73,
+		7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7,
+		78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83,
+		2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2,
+		89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94,
+		7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7,
+		99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2,
+		104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108, 7,
+		108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112, 2,
+		113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117, 7,
+		117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121, 2,
+		122, 7, 122, 2, 123, 7, 123, 2, 124, 7, 124, 2, 125, 7, 125, 2, 126, 7,
+		126, 2, 127, 7, 127, 2, 128, 7, 128, 2, 129, 7, 129, 2, 130, 7, 130, 2,
+		131, 7, 131, 2, 132, 7, 132, 2, 133, 7, 133, 2, 134, 7, 134, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 301, 8, 0, 1, 0, 3, 0, 304, 8, 0, 1, 0, 1,
+		0, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1,
 		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1,
-		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 220, 8, 2, 1, 3, 1, 3, 1, 3, 1,
-		4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1,
-		6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1,
-		9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1,
-		10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 269,
-		8, 11, 1, 11, 1, 11, 1, 11, 3, 11, 274, 8, 11, 1, 12, 1, 12, 1, 12, 1,
-		12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 285, 8, 13, 1, 13, 1, 13,
-		1, 13, 3, 13, 290, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 3,
-		14, 298, 8, 14, 1, 14, 1, 14, 1, 14, 3, 14, 303, 8, 14, 1, 15, 1, 15, 1,
-		15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16,
-		317, 8, 16, 1, 16, 1, 16, 1, 16, 3, 16, 322, 8, 16, 1, 17, 1, 17, 1, 17,
-		1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1,
-		20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23,
-		1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 356, 8,
-		24, 1, 24, 3, 24, 359, 8, 24, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 365, 8,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 371, 8, 25, 1, 25, 3, 25, 374, 8,
-		25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28,
-		1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 394, 8,
-		28, 1, 28, 3, 28, 397, 8, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31, 1, 31,
-		1, 32, 1, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1, 35, 1, 36, 3, 36, 414,
-		8, 36, 1, 36, 1, 36, 3, 36, 418, 8, 36, 1, 36, 3, 36, 421, 8, 36, 1, 36,
-		3, 36, 424, 8, 36, 1, 36, 3, 36, 427, 8, 36, 1, 36, 3, 36, 430, 8, 36,
-		1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 3, 37, 438, 8, 37, 1, 38, 1,
-		38, 1, 38, 1, 39, 1, 39, 1, 39, 5, 39, 446, 8, 39, 10, 39, 12, 39, 449,
-		9, 39, 1, 40, 1, 40, 3, 40, 453, 8, 40, 1, 41, 1, 41, 1, 41, 1, 42, 1,
-		42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44,
-		1, 45, 1, 45, 1, 45, 1, 45, 3, 45, 474, 8, 45, 1, 46, 1, 46, 1, 46, 1,
-		47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 3, 47, 487, 8, 47,
-		3, 47, 489, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
-		48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 505, 8, 48, 1, 48,
-		1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 513, 8, 48, 1, 48, 1, 48, 1,
-		48, 1, 48, 3, 48, 519, 8, 48, 1, 48, 1, 48, 1, 48, 5, 48, 524, 8, 48, 10,
-		48, 12, 48, 527, 9, 48, 1, 49, 1, 49, 1, 49, 5, 49, 532, 8, 49, 10, 49,
-		12, 49, 535, 9, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1,
-		51, 1, 51, 5, 51, 546, 8, 51, 10, 51, 12, 51, 549, 9, 51, 1, 52, 1, 52,
-		1, 52, 3, 52, 554, 8, 52, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 560, 8, 53,
-		1, 54, 1, 54, 3, 54, 564, 8, 54, 1, 55, 1, 55, 1, 55, 3, 55, 569, 8, 55,
-		1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 3,
-		56, 581, 8, 56, 1, 56, 3, 56, 584, 8, 56, 1, 57, 1, 57, 1, 57, 5, 57, 589,
-		8, 57, 10, 57, 12, 57, 592, 9, 57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1,
-		58, 3, 58, 600, 8, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61,
-		1, 61, 5, 61, 610, 8, 61, 10, 61, 12, 61, 613, 9, 61, 1, 62, 1, 62, 1,
-		62, 5, 62, 618, 8, 62, 10, 62, 12, 62, 621, 9, 62, 1, 63, 1, 63, 1, 63,
-		1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 3, 64, 632, 8, 64, 1, 64, 1,
-		64, 1, 64, 1, 64, 5, 64, 638, 8, 64, 10, 64, 12, 64, 641, 9, 64, 1, 65,
-		1, 65, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1,
-		68, 1, 68, 1, 68, 1, 68, 1, 68, 3, 68, 659, 8, 68, 1, 69, 1, 69, 1, 69,
-		1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 669, 8, 69, 1, 69, 1, 69, 1,
-		69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 5, 69,
-		683, 8, 69, 10, 69, 12, 69, 686, 9, 69, 1, 70, 1, 70, 1, 70, 1, 71, 1,
-		71, 1, 72, 1, 72, 1, 72, 3, 72, 696, 8, 72, 1, 72, 1, 72, 1, 73, 1, 73,
-		1, 74, 1, 74, 1, 74, 5, 74, 705, 8, 74, 10, 74, 12, 74, 708, 9, 74, 1,
-		75, 1, 75, 3, 75, 712, 8, 75, 1, 76, 1, 76, 3, 76, 716, 8, 76, 1, 76, 1,
-		76, 3, 76, 720, 8, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 79,
-		1, 79, 1, 79, 1, 79, 5, 79, 732, 8, 79, 10, 79, 12, 79, 735, 9, 79, 1,
-		79, 1, 79, 1, 79, 1, 79, 3, 79, 741, 8, 79, 1, 80, 1, 80, 1, 80, 1, 80,
-		1, 81, 1, 81, 1, 81, 1, 81, 5, 81, 751, 8, 81, 10, 81, 12, 81, 754, 9,
-		81, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81, 760, 8, 81, 1, 82, 1, 82, 1, 82,
-		1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 3, 82, 770, 8, 82, 1, 83, 3, 83, 773,
-		8, 83, 1, 83, 1, 83, 1, 84, 3, 84, 778, 8, 84, 1, 84, 1, 84, 1, 85, 1,
-		85, 1, 85, 1, 86, 1, 86, 1, 87, 1, 87, 1, 88, 1, 88, 1, 89, 1, 89, 3, 89,
-		793, 8, 89, 1, 89, 1, 89, 1, 89, 3, 89, 798, 8, 89, 5, 89, 800, 8, 89,
-		10, 89, 12, 89, 803, 9, 89, 1, 90, 1, 90, 1, 90, 0, 3, 96, 128, 138, 91,
-		0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36,
-		38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72,
-		74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106,
-		108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136,
-		138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166,
-		168, 170, 172, 174, 176, 178, 180, 0, 10, 1, 0, 31, 32, 1, 0, 24, 25, 1,
-		0, 61, 62, 2, 0, 64, 65, 128, 129, 1, 0, 67, 68, 2, 0, 69, 69, 112, 112,
-		1, 0, 96, 102, 1, 0, 86, 95, 1, 0, 121, 122, 2, 0, 6, 21, 23, 102, 831,
-		0, 193, 1, 0, 0, 0, 2, 195, 1, 0, 0, 0, 4, 219, 1, 0, 0, 0, 6, 221, 1,
-		0, 0, 0, 8, 224, 1, 0, 0, 0, 10, 227, 1, 0, 0, 0, 12, 234, 1, 0, 0, 0,
-		14, 237, 1, 0, 0, 0, 16, 240, 1, 0, 0, 0, 18, 244, 1, 0, 0, 0, 20, 252,
-		1, 0, 0, 0, 22, 260, 1, 0, 0, 0, 24, 275, 1, 0, 0, 0, 26, 279, 1, 0, 0,
-		0, 28, 291, 1, 0, 0, 0, 30, 304, 1, 0, 0, 0, 32, 310, 1, 0, 0, 0, 34, 323,
-		1, 0, 0, 0, 36, 327, 1, 0, 0, 0, 38, 331, 1, 0, 0, 0, 40, 335, 1, 0, 0,
-		0, 42, 338, 1, 0, 0, 0, 44, 342, 1, 0, 0, 0, 46, 346, 1, 0, 0, 0, 48, 349,
-		1, 0, 0, 0, 50, 360, 1, 0, 0, 0, 52, 375, 1, 0, 0, 0, 54, 379, 1, 0, 0,
-		0, 56, 384, 1, 0, 0, 0, 58, 398, 1, 0, 0, 0, 60, 400, 1, 0, 0, 0, 62, 402,
-		1, 0, 0, 0, 64, 404, 1, 0, 0, 0, 66, 406, 1, 0, 0, 0, 68, 408, 1, 0, 0,
-		0, 70, 410, 1, 0, 0, 0, 72, 413, 1, 0, 0, 0, 74, 437, 1, 0, 0, 0, 76, 439,
-		1, 0, 0, 0, 78, 442, 1, 0, 0, 0, 80, 450, 1, 0, 0, 0, 82, 454, 1, 0, 0,
-		0, 84, 457, 1, 0, 0, 0, 86, 461, 1, 0, 0, 0, 88, 465, 1, 0, 0, 0, 90, 469,
-		1, 0, 0, 0, 92, 475, 1, 0, 0, 0, 94, 488, 1, 0, 0, 0, 96, 518, 1, 0, 0,
-		0, 98, 528, 1, 0, 0, 0, 100, 536, 1, 0, 0, 0, 102, 542, 1, 0, 0, 0, 104,
-		550, 1, 0, 0, 0, 106, 555, 1, 0, 0, 0, 108, 561, 1, 0, 0, 0, 110, 565,
-		1, 0, 0, 0, 112, 572, 1, 0, 0, 0, 114, 585, 1, 0, 0, 0, 116, 599, 1, 0,
-		0, 0, 118, 601, 1, 0, 0, 0, 120, 603, 1, 0, 0, 0, 122, 607, 1, 0, 0, 0,
-		124, 614, 1, 0, 0, 0, 126, 622, 1, 0, 0, 0, 128, 631, 1, 0, 0, 0, 130,
-		642, 1, 0, 0, 0, 132, 644, 1, 0, 0, 0, 134, 646, 1, 0, 0, 0, 136, 658,
-		1, 0, 0, 0, 138, 668, 1, 0, 0, 0, 140, 687, 1, 0, 0, 0, 142, 690, 1, 0,
-		0, 0, 144, 692, 1, 0, 0, 0, 146, 699, 1, 0, 0, 0, 148, 701, 1, 0, 0, 0,
-		150, 711, 1, 0, 0, 0, 152, 719, 1, 0, 0, 0, 154, 721, 1, 0, 0, 0, 156,
-		725, 1, 0, 0, 0, 158, 740, 1, 0, 0, 0, 160, 742, 1, 0, 0, 0, 162, 759,
-		1, 0, 0, 0, 164, 769, 1, 0, 0, 0, 166, 772, 1, 0, 0, 0, 168, 777, 1, 0,
-		0, 0, 170, 781, 1, 0, 0, 0, 172, 784, 1, 0, 0, 0, 174, 786, 1, 0, 0, 0,
-		176, 788, 1, 0, 0, 0, 178, 792, 1, 0, 0, 0, 180, 804, 1, 0, 0, 0, 182,
-		194, 3, 4, 2, 0, 183, 194, 3, 34, 17, 0, 184, 194, 3, 36, 18, 0, 185, 194,
-		3, 38, 19, 0, 186, 194, 3, 2, 1, 0, 187, 194, 3, 72, 36, 0, 188, 194, 3,
-		42, 21, 0, 189, 194, 3, 44, 22, 0, 190, 191, 3, 178, 89, 0, 191, 192, 5,
-		0, 0, 1, 192, 194, 1, 0, 0, 0, 193, 182, 1, 0, 0, 0, 193, 183, 1, 0, 0,
-		0, 193, 184, 1, 0, 0, 0, 193, 185, 1, 0, 0, 0, 193, 186, 1, 0, 0, 0, 193,
-		187, 1, 0, 0, 0, 193, 188, 1, 0, 0, 0, 193, 189, 1, 0, 0, 0, 193, 190,
-		1, 0, 0, 0, 194, 1, 1, 0, 0, 0, 195, 196, 5, 23, 0, 0, 196, 197, 3, 178,
-		89, 0, 197, 3, 1, 0, 0, 0, 198, 220, 3, 6, 3, 0, 199, 220, 3, 16, 8, 0,
-		200, 220, 3, 18, 9, 0, 201, 220, 3, 20, 10, 0, 202, 220, 3, 22, 11, 0,
-		203, 220, 3, 12, 6, 0, 204, 220, 3, 14, 7, 0, 205, 220, 3, 24, 12, 0, 206,
-		220, 3, 30, 15, 0, 207, 220, 3, 32, 16, 0, 208, 220, 3, 26, 13, 0, 209,
-		220, 3, 28, 14, 0, 210, 220, 3, 40, 20, 0, 211, 220, 3, 46, 23, 0, 212,
-		220, 3, 48, 24, 0, 213, 220, 3, 50, 25, 0, 214, 220, 3, 52, 26, 0, 215,
-		220, 3, 54, 27, 0, 216, 220, 3, 56, 28, 0, 217, 220, 3, 8, 4, 0, 218, 220,
-		3, 10, 5, 0, 219, 198, 1, 0, 0, 0, 219, 199, 1, 0, 0, 0, 219, 200, 1, 0,
-		0, 0, 219, 201, 1, 0, 0, 0, 219, 202, 1, 0, 0, 0, 219, 203, 1, 0, 0, 0,
-		219, 204, 1, 0, 0, 0, 219, 205, 1, 0, 0, 0, 219, 206, 1, 0, 0, 0, 219,
-		207, 1, 0, 0, 0, 219, 208, 1, 0, 0, 0, 219, 209, 1, 0, 0, 0, 219, 210,
-		1, 0, 0, 0, 219, 211, 1, 0, 0, 0, 219, 212, 1, 0, 0, 0, 219, 213, 1, 0,
-		0, 0, 219, 214, 1, 0, 0, 0, 219, 215, 1, 0, 0, 0, 219, 216, 1, 0, 0, 0,
-		219, 217, 1, 0, 0, 0, 219, 218, 1, 0, 0, 0, 220, 5, 1, 0, 0, 0, 221, 222,
-		5, 21, 0, 0, 222, 223, 5, 26, 0, 0, 223, 7, 1, 0, 0, 0, 224, 225, 5, 21,
-		0, 0, 225, 226, 5, 83, 0, 0, 226, 9, 1, 0, 0, 0, 227, 228, 5, 21, 0, 0,
-		228, 229, 5, 84, 0, 0, 229, 230, 5, 53, 0, 0, 230, 231, 5, 85, 0, 0, 231,
-		232, 5, 105, 0, 0, 232, 233, 3, 68, 34, 0, 233, 11, 1, 0, 0, 0, 234, 235,
-		5, 21, 0, 0, 235, 236, 5, 30, 0, 0, 236, 13, 1, 0, 0, 0, 237, 238, 5, 21,
-		0, 0, 238, 239, 5, 33, 0, 0, 239, 15, 1, 0, 0, 0, 240, 241, 5, 21, 0, 0,
-		241, 242, 5, 27, 0, 0, 242, 243, 5, 28, 0, 0, 243, 17, 1, 0, 0, 0, 244,
-		245, 5, 21, 0, 0, 245, 246, 5, 32, 0, 0, 246, 247, 5, 27, 0, 0, 247, 248,
-		5, 52, 0, 0, 248, 249, 3, 70, 35, 0, 249, 250, 5, 53, 0, 0, 250, 251, 3,
-		88, 44, 0, 251, 19, 1, 0, 0, 0, 252, 253, 5, 21, 0, 0, 253, 254, 5, 26,
-		0, 0, 254, 255, 5, 27, 0, 0, 255, 256, 5, 52, 0, 0, 256, 257, 3, 70, 35,
-		0, 257, 258, 5, 53, 0, 0, 258, 259, 3, 88, 44, 0, 259, 21, 1, 0, 0, 0,
-		260, 261, 5, 21, 0, 0, 261, 262, 5, 31, 0, 0, 262, 263, 5, 27, 0, 0, 263,
-		264, 5, 52, 0, 0, 264, 265, 3, 70, 35, 0, 265, 268, 5, 53, 0, 0, 266, 269,
-		3, 84, 42, 0, 267, 269, 3, 88, 44, 0, 268, 266, 1, 0, 0, 0, 268, 267, 1,
-		0, 0, 0, 269, 270, 1, 0, 0, 0, 270, 273, 5, 61, 0, 0, 271, 274, 3, 84,
-		42, 0, 272, 274, 3, 88, 44, 0, 273, 271, 1, 0, 0, 0, 273, 272, 1, 0, 0,
-		0, 274, 23, 1, 0, 0, 0, 275, 276, 5, 21, 0, 0, 276, 277, 7, 0, 0, 0, 277,
-		278, 5, 34, 0, 0, 278, 25, 1, 0, 0, 0, 279, 280, 5, 21, 0, 0, 280, 281,
-		5, 13, 0, 0, 281, 284, 5, 53, 0, 0, 282, 285, 3, 84, 42, 0, 283, 285, 3,
-		86, 43, 0, 284, 282, 1, 0, 0, 0, 284, 283, 1, 0, 0, 0, 285, 286, 1, 0,
-		0, 0, 286, 289, 5, 61, 0, 0, 287, 290, 3, 84, 42, 0, 288, 290, 3, 86, 43,
-		0, 289, 287, 1, 0, 0, 0, 289, 288, 1, 0, 0, 0, 290, 27, 1, 0, 0, 0, 291,
-		292, 5, 21, 0, 0, 292, 293, 5, 14, 0, 0, 293, 294, 5, 36, 0, 0, 294, 297,
-		5, 53, 0, 0, 295, 298, 3, 84, 42, 0, 296, 298, 3, 86, 43, 0, 297, 295,
-		1, 0, 0, 0, 297, 296, 1, 0, 0, 0, 298, 299, 1, 0, 0, 0, 299, 302, 5, 61,
-		0, 0, 300, 303, 3, 84, 42, 0, 301, 303, 3, 86, 43, 0, 302, 300, 1, 0, 0,
-		0, 302, 301, 1, 0, 0, 0, 303, 29, 1, 0, 0, 0, 304, 305, 5, 21, 0, 0, 305,
-		306, 5, 32, 0, 0, 306, 307, 5, 42, 0, 0, 307, 308, 5, 53, 0, 0, 308, 309,
-		3, 100, 50, 0, 309, 31, 1, 0, 0, 0, 310, 311, 5, 21, 0, 0, 311, 312, 5,
-		31, 0, 0, 312, 313, 5, 42, 0, 0, 313, 316, 5, 53, 0, 0, 314, 317, 3, 84,
-		42, 0, 315, 317, 3, 100, 50, 0, 316, 314, 1, 0, 0, 0, 316, 315, 1, 0, 0,
-		0, 317, 318, 1, 0, 0, 0, 318, 321, 5, 61, 0, 0, 319, 322, 3, 84, 42, 0,
-		320, 322, 3, 100, 50, 0, 321, 319, 1, 0, 0, 0, 321, 320, 1, 0, 0, 0, 322,
-		33, 1, 0, 0, 0, 323, 324, 5, 6, 0, 0, 324, 325, 5, 31, 0, 0, 325, 326,
-		3, 156, 78, 0, 326, 35, 1, 0, 0, 0, 327, 328, 5, 6, 0, 0, 328, 329, 5,
-		32, 0, 0, 329, 330, 3, 156, 78, 0, 330, 37, 1, 0, 0, 0, 331, 332, 5, 22,
-		0, 0, 332, 333, 5, 31, 0, 0, 333, 334, 3, 66, 33, 0, 334, 39, 1, 0, 0,
-		0, 335, 336, 5, 21, 0, 0, 336, 337, 5, 35, 0, 0, 337, 41, 1, 0, 0, 0, 338,
-		339, 5, 6, 0, 0, 339, 340, 5, 36, 0, 0, 340, 341, 3, 156, 78, 0, 341, 43,
-		1, 0, 0, 0, 342, 343, 5, 9, 0, 0, 343, 344, 5, 36, 0, 0, 344, 345, 3, 64,
-		32, 0, 345, 45, 1, 0, 0, 0, 346, 347, 5, 21, 0, 0, 347, 348, 5, 37, 0,
-		0, 348, 47, 1, 0, 0, 0, 349, 350, 5, 21, 0, 0, 350, 355, 5, 39, 0, 0, 351,
-		352, 5, 53, 0, 0, 352, 353, 5, 38, 0, 0, 353, 354, 5, 105, 0, 0, 354, 356,
-		3, 58, 29, 0, 355, 351, 1, 0, 0, 0, 355, 356, 1, 0, 0, 0, 356, 358, 1,
-		0, 0, 0, 357, 359, 3, 170, 85, 0, 358, 357, 1, 0, 0, 0, 358, 359, 1, 0,
-		0, 0, 359, 49, 1, 0, 0, 0, 360, 361, 5, 21, 0, 0, 361, 364, 5, 41, 0, 0,
-		362, 363, 5, 20, 0, 0, 363, 365, 3, 62, 31, 0, 364, 362, 1, 0, 0, 0, 364,
-		365, 1, 0, 0, 0, 365, 370, 1, 0, 0, 0, 366, 367, 5, 53, 0, 0, 367, 368,
-		5, 42, 0, 0, 368, 369, 5, 105, 0, 0, 369, 371, 3, 58, 29, 0, 370, 366,
-		1, 0, 0, 0, 370, 371, 1, 0, 0, 0, 371, 373, 1, 0, 0, 0, 372, 374, 3, 170,
-		85, 0, 373, 372, 1, 0, 0, 0, 373, 374, 1, 0, 0, 0, 374, 51, 1, 0, 0, 0,
-		375, 376, 5, 21, 0, 0, 376, 377, 5, 44, 0, 0, 377, 378, 3, 90, 45, 0, 378,
-		53, 1, 0, 0, 0, 379, 380, 5, 21, 0, 0, 380, 381, 5, 45, 0, 0, 381, 382,
-		5, 47, 0, 0, 382, 383, 3, 90, 45, 0, 383, 55, 1, 0, 0, 0, 384, 385, 5,
-		21, 0, 0, 385, 386, 5, 45, 0, 0, 386, 387, 5, 50, 0, 0, 387, 388, 3, 90,
-		45, 0, 388, 389, 5, 49, 0, 0, 389, 390, 5, 48, 0, 0, 390, 391, 5, 105,
-		0, 0, 391, 393, 3, 60, 30, 0, 392, 394, 3, 92, 46, 0, 393, 392, 1, 0, 0,
-		0, 393, 394, 1, 0, 0, 0, 394, 396, 1, 0, 0, 0, 395, 397, 3, 170, 85, 0,
-		396, 395, 1, 0, 0, 0, 396, 397, 1, 0, 0, 0, 397, 57, 1, 0, 0, 0, 398, 399,
-		3, 178, 89, 0, 399, 59, 1, 0, 0, 0, 400, 401, 3, 178, 89, 0, 401, 61, 1,
-		0, 0, 0, 402, 403, 3, 178, 89, 0, 403, 63, 1, 0, 0, 0, 404, 405, 3, 178,
-		89, 0, 405, 65, 1, 0, 0, 0, 406, 407, 3, 178, 89, 0, 407, 67, 1, 0, 0,
-		0, 408, 409, 3, 178, 89, 0, 409, 69, 1, 0, 0, 0, 410, 411, 7, 1, 0, 0,
-		411, 71, 1, 0, 0, 0, 412, 414, 5, 57, 0, 0, 413, 412, 1, 0, 0, 0, 413,
-		414, 1, 0, 0, 0, 414, 415, 1, 0, 0, 0, 415, 417, 3, 74, 37, 0, 416, 418,
-		3, 92, 46, 0, 417, 416, 1, 0, 0, 0, 417, 418, 1, 0, 0, 0, 418, 420, 1,
-		0, 0, 0, 419, 421, 3, 112, 56, 0, 420, 419, 1, 0, 0, 0, 420, 421, 1, 0,
-		0, 0, 421, 423, 1, 0, 0, 0, 422, 424, 3, 120, 60, 0, 423, 422, 1, 0, 0,
-		0, 423, 424, 1, 0, 0, 0, 424, 426, 1, 0, 0, 0, 425, 427, 3, 170, 85, 0,
-		426, 425, 1, 0, 0, 0, 426, 427, 1, 0, 0, 0, 427, 429, 1, 0, 0, 0, 428,
-		430, 5, 58, 0, 0, 429, 428, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0, 430, 73,
-		1, 0, 0, 0, 431, 432, 3, 76, 38, 0, 432, 433, 3, 90, 45, 0, 433, 438, 1,
-		0, 0, 0, 434, 435, 3, 90, 45, 0, 435, 436, 3, 76, 38, 0, 436, 438, 1, 0,
-		0, 0, 437, 431, 1, 0, 0, 0, 437, 434, 1, 0, 0, 0, 438, 75, 1, 0, 0, 0,
-		439, 440, 5, 59, 0, 0, 440, 441, 3, 78, 39, 0, 441, 77, 1, 0, 0, 0, 442,
-		447, 3, 80, 40, 0, 443, 444, 5, 114, 0, 0, 444, 446, 3, 80, 40, 0, 445,
-		443, 1, 0, 0, 0, 446, 449, 1, 0, 0, 0, 447, 445, 1, 0, 0, 0, 447, 448,
-		1, 0, 0, 0, 448, 79, 1, 0, 0, 0, 449, 447, 1, 0, 0, 0, 450, 452, 3, 138,
-		69, 0, 451, 453, 3, 82, 41, 0, 452, 451, 1, 0, 0, 0, 452, 453, 1, 0, 0,
-		0, 453, 81, 1, 0, 0, 0, 454, 455, 5, 60, 0, 0, 455, 456, 3, 178, 89, 0,
-		456, 83, 1, 0, 0, 0, 457, 458, 5, 31, 0, 0, 458, 459, 5, 105, 0, 0, 459,
-		460, 3, 178, 89, 0, 460, 85, 1, 0, 0, 0, 461, 462, 5, 36, 0, 0, 462, 463,
-		5, 105, 0, 0, 463, 464, 3, 178, 89, 0, 464, 87, 1, 0, 0, 0, 465, 466, 5,
-		29, 0, 0, 466, 467, 5, 105, 0, 0, 467, 468, 3, 178, 89, 0, 468, 89, 1,
-		0, 0, 0, 469, 470, 5, 52, 0, 0, 470, 473, 3, 172, 86, 0, 471, 472, 5, 20,
-		0, 0, 472, 474, 3, 62, 31, 0, 473, 471, 1, 0, 0, 0, 473, 474, 1, 0, 0,
-		0, 474, 91, 1, 0, 0, 0, 475, 476, 5, 53, 0, 0, 476, 477, 3, 94, 47, 0,
-		477, 93, 1, 0, 0, 0, 478, 489, 3, 96, 48, 0, 479, 480, 3, 96, 48, 0, 480,
-		481, 5, 61, 0, 0, 481, 482, 3, 104, 52, 0, 482, 489, 1, 0, 0, 0, 483, 486,
-		3, 104, 52, 0, 484, 485, 5, 61, 0, 0, 485, 487, 3, 96, 48, 0, 486, 484,
-		1, 0, 0, 0, 486, 487, 1, 0, 0, 0, 487, 489, 1, 0, 0, 0, 488, 478, 1, 0,
-		0, 0, 488, 479, 1, 0, 0, 0, 488, 483, 1, 0, 0, 0, 489, 95, 1, 0, 0, 0,
-		490, 491, 6, 48, -1, 0, 491, 492, 5, 119, 0, 0, 492, 493, 3, 96, 48, 0,
-		493, 494, 5, 120, 0, 0, 494, 519, 1, 0, 0, 0, 495, 504, 3, 174, 87, 0,
-		496, 505, 5, 105, 0, 0, 497, 505, 5, 69, 0, 0, 498, 499, 5, 70, 0, 0, 499,
-		505, 5, 69, 0, 0, 500, 505, 5, 112, 0, 0, 501, 505, 5, 113, 0, 0, 502,
-		505, 5, 106, 0, 0, 503, 505, 5, 107, 0, 0, 504, 496, 1, 0, 0, 0, 504, 497,
-		1, 0, 0, 0, 504, 498, 1, 0, 0, 0, 504, 500, 1, 0, 0, 0, 504, 501, 1, 0,
-		0, 0, 504, 502, 1, 0, 0, 0, 504, 503, 1, 0, 0, 0, 505, 506, 1, 0, 0, 0,
-		506, 507, 3, 176, 88, 0, 507, 519, 1, 0, 0, 0, 508, 512, 3, 174, 87, 0,
-		509, 513, 5, 80, 0, 0, 510, 511, 5, 70, 0, 0, 511, 513, 5, 80, 0, 0, 512,
-		509, 1, 0, 0, 0, 512, 510, 1, 0, 0, 0, 513, 514, 1, 0, 0, 0, 514, 515,
-		5, 119, 0, 0, 515, 516, 3, 98, 49, 0, 516, 517, 5, 120, 0, 0, 517, 519,
-		1, 0, 0, 0, 518, 490, 1, 0, 0, 0, 518, 495, 1, 0, 0, 0, 518, 508, 1, 0,
-		0, 0, 519, 525, 1, 0, 0, 0, 520, 521, 10, 1, 0, 0, 521, 522, 7, 2, 0, 0,
-		522, 524, 3, 96, 48, 2, 523, 520, 1, 0, 0, 0, 524, 527, 1, 0, 0, 0, 525,
-		523, 1, 0, 0, 0, 525, 526, 1, 0, 0, 0, 526, 97, 1, 0, 0, 0, 527, 525, 1,
-		0, 0, 0, 528, 533, 3, 176, 88, 0, 529, 530, 5, 114, 0, 0, 530, 532, 3,
-		176, 88, 0, 531, 529, 1, 0, 0, 0, 532, 535, 1, 0, 0, 0, 533, 531, 1, 0,
-		0, 0, 533, 534, 1, 0, 0, 0, 534, 99, 1, 0, 0, 0, 535, 533, 1, 0, 0, 0,
-		536, 537, 5, 42, 0, 0, 537, 538, 5, 80, 0, 0, 538, 539, 5, 119, 0, 0, 539,
-		540, 3, 102, 51, 0, 540, 541, 5, 120, 0, 0, 541, 101, 1, 0, 0, 0, 542,
-		547, 3, 178, 89, 0, 543, 544, 5, 114, 0, 0, 544, 546, 3, 178, 89, 0, 545,
-		543, 1, 0, 0, 0, 546, 549, 1, 0, 0, 0, 547, 545, 1, 0, 0, 0, 547, 548,
-		1, 0, 0, 0, 548, 103, 1, 0, 0, 0, 549, 547, 1, 0, 0, 0, 550, 553, 3, 106,
-		53, 0, 551, 552, 5, 61, 0, 0, 552, 554, 3, 106, 53, 0, 553, 551, 1, 0,
-		0, 0, 553, 554, 1, 0, 0, 0, 554, 105, 1, 0, 0, 0, 555, 556, 5, 78, 0, 0,
-		556, 559, 3, 136, 68, 0, 557, 560, 3, 108, 54, 0, 558, 560, 3, 178, 89,
-		0, 559, 557, 1, 0, 0, 0, 559, 558, 1, 0, 0, 0, 560, 107, 1, 0, 0, 0, 561,
-		563, 3, 110, 55, 0, 562, 564, 3, 140, 70, 0, 563, 562, 1, 0, 0, 0, 563,
-		564, 1, 0, 0, 0, 564, 109, 1, 0, 0, 0, 565, 566, 5, 79, 0, 0, 566, 568,
-		5, 119, 0, 0, 567, 569, 3, 148, 74, 0, 568, 567, 1, 0, 0, 0, 568, 569,
-		1, 0, 0, 0, 569, 570, 1, 0, 0, 0, 570, 571, 5, 120, 0, 0, 571, 111, 1,
-		0, 0, 0, 572, 573, 5, 73, 0, 0, 573, 574, 5, 75, 0, 0, 574, 580, 3, 114,
-		57, 0, 575, 576, 5, 63, 0, 0, 576, 577, 5, 119, 0, 0, 577, 578, 3, 118,
-		59, 0, 578, 579, 5, 120, 0, 0, 579, 581, 1, 0, 0, 0, 580, 575, 1, 0, 0,
-		0, 580, 581, 1, 0, 0, 0, 581, 583, 1, 0, 0, 0, 582, 584, 3, 126, 63, 0,
-		583, 582, 1, 0, 0, 0, 583, 584, 1, 0, 0, 0, 584, 113, 1, 0, 0, 0, 585,
-		590, 3, 116, 58, 0, 586, 587, 5, 114, 0, 0, 587, 589, 3, 116, 58, 0, 588,
-		586, 1, 0, 0, 0, 589, 592, 1, 0, 0, 0, 590, 588, 1, 0, 0, 0, 590, 591,
-		1, 0, 0, 0, 591, 115, 1, 0, 0, 0, 592, 590, 1, 0, 0, 0, 593, 600, 3, 178,
-		89, 0, 594, 595, 5, 78, 0, 0, 595, 596, 5, 119, 0, 0, 596, 597, 3, 140,
-		70, 0, 597, 598, 5, 120, 0, 0, 598, 600, 1, 0, 0, 0, 599, 593, 1, 0, 0,
-		0, 599, 594, 1, 0, 0, 0, 600, 117, 1, 0, 0, 0, 601, 602, 7, 3, 0, 0, 602,
-		119, 1, 0, 0, 0, 603, 604, 5, 66, 0, 0, 604, 605, 5, 75, 0, 0, 605, 606,
-		3, 124, 62, 0, 606, 121, 1, 0, 0, 0, 607, 611, 3, 138, 69, 0, 608, 610,
-		7, 4, 0, 0, 609, 608, 1, 0, 0, 0, 610, 613, 1, 0, 0, 0, 611, 609, 1, 0,
-		0, 0, 611, 612, 1, 0, 0, 0, 612, 123, 1, 0, 0, 0, 613, 611, 1, 0, 0, 0,
-		614, 619, 3, 122, 61, 0, 615, 616, 5, 114, 0, 0, 616, 618, 3, 122, 61,
-		0, 617, 615, 1, 0, 0, 0, 618, 621, 1, 0, 0, 0, 619, 617, 1, 0, 0, 0, 619,
-		620, 1, 0, 0, 0, 620, 125, 1, 0, 0, 0, 621, 619, 1, 0, 0, 0, 622, 623,
-		5, 74, 0, 0, 623, 624, 3, 128, 64, 0, 624, 127, 1, 0, 0, 0, 625, 626, 6,
-		64, -1, 0, 626, 627, 5, 119, 0, 0, 627, 628, 3, 128, 64, 0, 628, 629, 5,
-		120, 0, 0, 629, 632, 1, 0, 0, 0, 630, 632, 3, 132, 66, 0, 631, 625, 1,
-		0, 0, 0, 631, 630, 1, 0, 0, 0, 632, 639, 1, 0, 0, 0, 633, 634, 10, 2, 0,
-		0, 634, 635, 3, 130, 65, 0, 635, 636, 3, 128, 64, 3, 636, 638, 1, 0, 0,
-		0, 637, 633, 1, 0, 0, 0, 638, 641, 1, 0, 0, 0, 639, 637, 1, 0, 0, 0, 639,
-		640, 1, 0, 0, 0, 640, 129, 1, 0, 0, 0, 641, 639, 1, 0, 0, 0, 642, 643,
-		7, 2, 0, 0, 643, 131, 1, 0, 0, 0, 644, 645, 3, 134, 67, 0, 645, 133, 1,
-		0, 0, 0, 646, 647, 3, 138, 69, 0, 647, 648, 3, 136, 68, 0, 648, 649, 3,
-		138, 69, 0, 649, 135, 1, 0, 0, 0, 650, 659, 5, 105, 0, 0, 651, 659, 5,
-		106, 0, 0, 652, 659, 5, 107, 0, 0, 653, 659, 5, 110, 0, 0, 654, 659, 5,
-		111, 0, 0, 655, 659, 5, 108, 0, 0, 656, 659, 5, 109, 0, 0, 657, 659, 7,
-		5, 0, 0, 658, 650, 1, 0, 0, 0, 658, 651, 1, 0, 0, 0, 658, 652, 1, 0, 0,
-		0, 658, 653, 1, 0, 0, 0, 658, 654, 1, 0, 0, 0, 658, 655, 1, 0, 0, 0, 658,
-		656, 1, 0, 0, 0, 658, 657, 1, 0, 0, 0, 659, 137, 1, 0, 0, 0, 660, 661,
-		6, 69, -1, 0, 661, 662, 5, 119, 0, 0, 662, 663, 3, 138, 69, 0, 663, 664,
-		5, 120, 0, 0, 664, 669, 1, 0, 0, 0, 665, 669, 3, 144, 72, 0, 666, 669,
-		3, 152, 76, 0, 667, 669, 3, 140, 70, 0, 668, 660, 1, 0, 0, 0, 668, 665,
-		1, 0, 0, 0, 668, 666, 1, 0, 0, 0, 668, 667, 1, 0, 0, 0, 669, 684, 1, 0,
-		0, 0, 670, 671, 10, 8, 0, 0, 671, 672, 5, 124, 0, 0, 672, 683, 3, 138,
-		69, 9, 673, 674, 10, 7, 0, 0, 674, 675, 5, 123, 0, 0, 675, 683, 3, 138,
-		69, 8, 676, 677, 10, 6, 0, 0, 677, 678, 5, 121, 0, 0, 678, 683, 3, 138,
-		69, 7, 679, 680, 10, 5, 0, 0, 680, 681, 5, 122, 0, 0, 681, 683, 3, 138,
-		69, 6, 682, 670, 1, 0, 0, 0, 682, 673, 1, 0, 0, 0, 682, 676, 1, 0, 0, 0,
-		682, 679, 1, 0, 0, 0, 683, 686, 1, 0, 0, 0, 684, 682, 1, 0, 0, 0, 684,
-		685, 1, 0, 0, 0, 685, 139, 1, 0, 0, 0, 686, 684, 1, 0, 0, 0, 687, 688,
-		3, 166, 83, 0, 688, 689, 3, 142, 71, 0, 689, 141, 1, 0, 0, 0, 690, 691,
-		7, 6, 0, 0, 691, 143, 1, 0, 0, 0, 692, 693, 3, 146, 73, 0, 693, 695, 5,
-		119, 0, 0, 694, 696, 3, 148, 74, 0, 695, 694, 1, 0, 0, 0, 695, 696, 1,
-		0, 0, 0, 696, 697, 1, 0, 0, 0, 697, 698, 5, 120, 0, 0, 698, 145, 1, 0,
-		0, 0, 699, 700, 7, 7, 0, 0, 700, 147, 1, 0, 0, 0, 701, 706, 3, 150, 75,
-		0, 702, 703, 5, 114, 0, 0, 703, 705, 3, 150, 75, 0, 704, 702, 1, 0, 0,
-		0, 705, 708, 1, 0, 0, 0, 706, 704, 1, 0, 0, 0, 706, 707, 1, 0, 0, 0, 707,
-		149, 1, 0, 0, 0, 708, 706, 1, 0, 0, 0, 709, 712, 3, 138, 69, 0, 710, 712,
-		3, 96, 48, 0, 711, 709, 1, 0, 0, 0, 711, 710, 1, 0, 0, 0, 712, 151, 1,
-		0, 0, 0, 713, 715, 3, 178, 89, 0, 714, 716, 3, 154, 77, 0, 715, 714, 1,
-		0, 0, 0, 715, 716, 1, 0, 0, 0, 716, 720, 1, 0, 0, 0, 717, 720, 3, 168,
-		84, 0, 718, 720, 3, 166, 83, 0, 719, 713, 1, 0, 0, 0, 719, 717, 1, 0, 0,
-		0, 719, 718, 1, 0, 0, 0, 720, 153, 1, 0, 0, 0, 721, 722, 5, 117, 0, 0,
-		722, 723, 3, 96, 48, 0, 723, 724, 5, 118, 0, 0, 724, 155, 1, 0, 0, 0, 725,
-		726, 3, 164, 82, 0, 726, 157, 1, 0, 0, 0, 727, 728, 5, 115, 0, 0, 728,
-		733, 3, 160, 80, 0, 729, 730, 5, 114, 0, 0, 730, 732, 3, 160, 80, 0, 731,
-		729, 1, 0, 0, 0, 732, 735, 1, 0, 0, 0, 733, 731, 1, 0, 0, 0, 733, 734,
-		1, 0, 0, 0, 734, 736, 1, 0, 0, 0, 735, 733, 1, 0, 0, 0, 736, 737, 5, 116,
-		0, 0, 737, 741, 1, 0, 0, 0, 738, 739, 5, 115, 0, 0, 739, 741, 5, 116, 0,
-		0, 740, 727, 1, 0, 0, 0, 740, 738, 1, 0, 0, 0, 741, 159, 1, 0, 0, 0, 742,
-		743, 5, 4, 0, 0, 743, 744, 5, 104, 0, 0, 744, 745, 3, 164, 82, 0, 745,
-		161, 1, 0, 0, 0, 746, 747, 5, 117, 0, 0, 747, 752, 3, 164, 82, 0, 748,
-		749, 5, 114, 0, 0, 749, 751, 3, 164, 82, 0, 750, 748, 1, 0, 0, 0, 751,
-		754, 1, 0, 0, 0, 752, 750, 1, 0, 0, 0, 752, 753, 1, 0, 0, 0, 753, 755,
-		1, 0, 0, 0, 754, 752, 1, 0, 0, 0, 755, 756, 5, 118, 0, 0, 756, 760, 1,
-		0, 0, 0, 757, 758, 5, 117, 0, 0, 758, 760, 5, 118, 0, 0, 759, 746, 1, 0,
-		0, 0, 759, 757, 1, 0, 0, 0, 760, 163, 1, 0, 0, 0, 761, 770, 5, 4, 0, 0,
-		762, 770, 3, 166, 83, 0, 763, 770, 3, 168, 84, 0, 764, 770, 3, 158, 79,
-		0, 765, 770, 3, 162, 81, 0, 766, 770, 5, 1, 0, 0, 767, 770, 5, 2, 0, 0,
-		768, 770, 5, 3, 0, 0, 769, 761, 1, 0, 0, 0, 769, 762, 1, 0, 0, 0, 769,
-		763, 1, 0, 0, 0, 769, 764, 1, 0, 0, 0, 769, 765, 1, 0, 0, 0, 769, 766,
-		1, 0, 0, 0, 769, 767, 1, 0, 0, 0, 769, 768, 1, 0, 0, 0, 770, 165, 1, 0,
-		0, 0, 771, 773, 7, 8, 0, 0, 772, 771, 1, 0, 0, 0, 772, 773, 1, 0, 0, 0,
-		773, 774, 1, 0, 0, 0, 774, 775, 5, 128, 0, 0, 775, 167, 1, 0, 0, 0, 776,
-		778, 7, 8, 0, 0, 777, 776, 1, 0, 0, 0, 777, 778, 1, 0, 0, 0, 778, 779,
-		1, 0, 0, 0, 779, 780, 5, 129, 0, 0, 780, 169, 1, 0, 0, 0, 781, 782, 5,
-		54, 0, 0, 782, 783, 5, 128, 0, 0, 783, 171, 1, 0, 0, 0, 784, 785, 3, 178,
-		89, 0, 785, 173, 1, 0, 0, 0, 786, 787, 3, 178, 89, 0, 787, 175, 1, 0, 0,
-		0, 788, 789, 3, 178, 89, 0, 789, 177, 1, 0, 0, 0, 790, 793, 5, 127, 0,
-		0, 791, 793, 3, 180, 90, 0, 792, 790, 1, 0, 0, 0, 792, 791, 1, 0, 0, 0,
-		793, 801, 1, 0, 0, 0, 794, 797, 5, 103, 0, 0, 795, 798, 5, 127, 0, 0, 796,
-		798, 3, 180, 90, 0, 797, 795, 1, 0, 0, 0, 797, 796, 1, 0, 0, 0, 798, 800,
-		1, 0, 0, 0, 799, 794, 1, 0, 0, 0, 800, 803, 1, 0, 0, 0, 801, 799, 1, 0,
-		0, 0, 801, 802, 1, 0, 0, 0, 802, 179, 1, 0, 0, 0, 803, 801, 1, 0, 0, 0,
-		804, 805, 7, 9, 0, 0, 805, 181, 1, 0, 0, 0, 66, 193, 219, 268, 273, 284,
-		289, 297, 302, 316, 321, 355, 358, 364, 370, 373, 393, 396, 413, 417, 420,
-		423, 426, 429, 437, 447, 452, 473, 486, 488, 504, 512, 518, 525, 533, 547,
-		553, 559, 563, 568, 580, 583, 590, 599, 611, 619, 631, 639, 658, 668, 682,
-		684, 695, 706, 711, 715, 719, 733, 740, 752, 759, 769, 772, 777, 792, 797,
-		801,
+		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3,
+		2, 343, 8, 2, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1,
+		8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10,
+		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1,
+		11, 1, 11, 1, 11, 1, 11, 3, 11, 392, 8, 11, 1, 11, 1, 11, 1, 11, 3, 11,
+		397, 8, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 3, 13, 408, 8, 13, 1, 13, 1, 13, 1, 13, 3, 13, 413, 8, 13, 1, 14, 1,
+		14, 1, 14, 1, 14, 1, 14, 1, 14, 3, 14, 421, 8, 14, 1, 14, 1, 14, 1, 14,
+		3, 14, 426, 8, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 440, 8, 16, 1, 16, 1, 16, 1, 16,
+		3, 16, 445, 8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1,
+		18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21,
+		1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1,
+		24, 1, 24, 1, 24, 1, 24, 3, 24, 479, 8, 24, 1, 24, 3, 24, 482, 8, 24, 1,
+		25, 1, 25, 1, 25, 1, 25, 3, 25, 488, 8, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		3, 25, 494, 8, 25, 1, 25, 3, 25, 497, 8, 25, 1, 26, 1, 26, 1, 26, 1, 26,
+		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 28, 1, 28, 1, 28, 3, 28, 517, 8, 28, 1, 28, 3, 28, 520, 8, 28, 1,
+		29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30,
+		1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1,
+		32, 5, 32, 544, 8, 32, 10, 32, 12, 32, 547, 9, 32, 1, 33, 1, 33, 1, 33,
+		1, 33, 3, 33, 553, 8, 33, 1, 34, 1, 34, 1, 34, 5, 34, 558, 8, 34, 10, 34,
+		12, 34, 561, 9, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1,
+		36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 5, 37, 577, 8, 37, 10, 37,
+		12, 37, 580, 9, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 38, 3, 38, 592, 8, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40,
+		1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 5, 41, 607, 8,
+		41, 10, 41, 12, 41, 610, 9, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
+		1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1,
+		42, 1, 42, 3, 42, 630, 8, 42, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44,
+		1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1,
+		46, 1, 46, 1, 46, 1, 46, 3, 46, 652, 8, 46, 1, 47, 1, 47, 1, 47, 1, 47,
+		1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1,
+		50, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
+		3, 52, 679, 8, 52, 3, 52, 681, 8, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53,
+		1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 692, 8, 53, 3, 53, 694, 8, 53, 1, 53,
+		1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 706,
+		8, 55, 1, 56, 1, 56, 3, 56, 710, 8, 56, 1, 57, 1, 57, 1, 57, 5, 57, 715,
+		8, 57, 10, 57, 12, 57, 718, 9, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1,
+		59, 1, 59, 1, 59, 1, 59, 3, 59, 729, 8, 59, 1, 59, 1, 59, 1, 59, 1, 60,
+		1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 5,
+		62, 745, 8, 62, 10, 62, 12, 62, 748, 9, 62, 1, 63, 1, 63, 1, 63, 1, 63,
+		4, 63, 754, 8, 63, 11, 63, 12, 63, 755, 1, 64, 1, 64, 1, 64, 1, 64, 1,
+		64, 3, 64, 763, 8, 64, 3, 64, 765, 8, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1,
+		66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 3, 67, 778, 8, 67, 1, 68,
+		1, 68, 1, 68, 1, 68, 3, 68, 784, 8, 68, 1, 69, 1, 69, 1, 69, 3, 69, 789,
+		8, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 3, 70, 797, 8, 70, 1,
+		71, 1, 71, 3, 71, 801, 8, 71, 1, 72, 1, 72, 3, 72, 805, 8, 72, 1, 73, 1,
+		73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 77, 1, 77, 1, 78, 1, 78,
+		1, 79, 1, 79, 1, 80, 3, 80, 822, 8, 80, 1, 80, 1, 80, 3, 80, 826, 8, 80,
+		1, 80, 3, 80, 829, 8, 80, 1, 80, 3, 80, 832, 8, 80, 1, 80, 3, 80, 835,
+		8, 80, 1, 80, 3, 80, 838, 8, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1,
+		81, 3, 81, 846, 8, 81, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 5, 83,
+		854, 8, 83, 10, 83, 12, 83, 857, 9, 83, 1, 84, 1, 84, 3, 84, 861, 8, 84,
+		1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1,
+		87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 3, 89, 882,
+		8, 89, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1,
+		91, 1, 91, 3, 91, 895, 8, 91, 3, 91, 897, 8, 91, 1, 92, 1, 92, 1, 92, 1,
+		92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92,
+		3, 92, 913, 8, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 3, 92, 921,
+		8, 92, 1, 92, 1, 92, 1, 92, 1, 92, 3, 92, 927, 8, 92, 1, 92, 1, 92, 1,
+		92, 5, 92, 932, 8, 92, 10, 92, 12, 92, 935, 9, 92, 1, 93, 1, 93, 1, 93,
+		5, 93, 940, 8, 93, 10, 93, 12, 93, 943, 9, 93, 1, 94, 1, 94, 1, 94, 1,
+		94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 5, 95, 954, 8, 95, 10, 95, 12, 95,
+		957, 9, 95, 1, 96, 1, 96, 1, 96, 3, 96, 962, 8, 96, 1, 97, 1, 97, 1, 97,
+		1, 97, 3, 97, 968, 8, 97, 1, 98, 1, 98, 3, 98, 972, 8, 98, 1, 99, 1, 99,
+		1, 99, 3, 99, 977, 8, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100,
+		1, 100, 1, 100, 1, 100, 1, 100, 3, 100, 989, 8, 100, 1, 100, 3, 100, 992,
+		8, 100, 1, 101, 1, 101, 1, 101, 5, 101, 997, 8, 101, 10, 101, 12, 101,
+		1000, 9, 101, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 3, 102, 1008,
+		8, 102, 1, 103, 1, 103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105,
+		5, 105, 1018, 8, 105, 10, 105, 12, 105, 1021, 9, 105, 1, 106, 1, 106, 1,
+		106, 5, 106, 1026, 8, 106, 10, 106, 12, 106, 1029, 9, 106, 1, 107, 1, 107,
+		1, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 3, 108, 1040, 8,
+		108, 1, 108, 1, 108, 1, 108, 1, 108, 5, 108, 1046, 8, 108, 10, 108, 12,
+		108, 1049, 9, 108, 1, 109, 1, 109, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111,
+		1, 111, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112,
+		3, 112, 1067, 8, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1,
+		113, 1, 113, 3, 113, 1077, 8, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113,
+		1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 5, 113, 1091, 8,
+		113, 10, 113, 12, 113, 1094, 9, 113, 1, 114, 1, 114, 1, 114, 1, 115, 1,
+		115, 1, 116, 1, 116, 1, 116, 3, 116, 1104, 8, 116, 1, 116, 1, 116, 1, 117,
+		1, 117, 1, 118, 1, 118, 1, 118, 5, 118, 1113, 8, 118, 10, 118, 12, 118,
+		1116, 9, 118, 1, 119, 1, 119, 3, 119, 1120, 8, 119, 1, 120, 1, 120, 3,
+		120, 1124, 8, 120, 1, 120, 1, 120, 3, 120, 1128, 8, 120, 1, 121, 1, 121,
+		1, 121, 1, 121, 1, 122, 1, 122, 1, 123, 1, 123, 1, 123, 1, 123, 5, 123,
+		1140, 8, 123, 10, 123, 12, 123, 1143, 9, 123, 1, 123, 1, 123, 1, 123, 1,
+		123, 3, 123, 1149, 8, 123, 1, 124, 1, 124, 1, 124, 1, 124, 1, 125, 1, 125,
+		1, 125, 1, 125, 5, 125, 1159, 8, 125, 10, 125, 12, 125, 1162, 9, 125, 1,
+		125, 1, 125, 1, 125, 1, 125, 3, 125, 1168, 8, 125, 1, 126, 1, 126, 1, 126,
+		1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 3, 126, 1178, 8, 126, 1, 127, 3,
+		127, 1181, 8, 127, 1, 127, 1, 127, 1, 128, 3, 128, 1186, 8, 128, 1, 128,
+		1, 128, 1, 129, 1, 129, 1, 129, 1, 130, 1, 130, 1, 131, 1, 131, 1, 132,
+		1, 132, 1, 133, 1, 133, 3, 133, 1201, 8, 133, 1, 133, 1, 133, 1, 133, 3,
+		133, 1206, 8, 133, 5, 133, 1208, 8, 133, 10, 133, 12, 133, 1211, 9, 133,
+		1, 134, 1, 134, 1, 134, 0, 3, 184, 216, 226, 135, 0, 2, 4, 6, 8, 10, 12,
+		14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48,
+		50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84,
+		86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116,
+		118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146,
+		148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176,
+		178, 180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206,
+		208, 210, 212, 214, 216, 218, 220, 222, 224, 226, 228, 230, 232, 234, 236,
+		238, 240, 242, 244, 246, 248, 250, 252, 254, 256, 258, 260, 262, 264, 266,
+		268, 0, 13, 1, 0, 31, 32, 1, 0, 90, 91, 1, 0, 115, 117, 1, 0, 121, 123,
+		1, 0, 24, 25, 1, 0, 61, 62, 2, 0, 64, 65, 172, 173, 1, 0, 67, 68, 2, 0,
+		69, 69, 156, 156, 1, 0, 139, 145, 1, 0, 129, 138, 1, 0, 165, 166, 6, 0,
+		6, 21, 23, 85, 89, 89, 92, 112, 115, 126, 128, 145, 1264, 0, 300, 1, 0,
+		0, 0, 2, 307, 1, 0, 0, 0, 4, 342, 1, 0, 0, 0, 6, 344, 1, 0, 0, 0, 8, 347,
+		1, 0, 0, 0, 10, 350, 1, 0, 0, 0, 12, 357, 1, 0, 0, 0, 14, 360, 1, 0, 0,
+		0, 16, 363, 1, 0, 0, 0, 18, 367, 1, 0, 0, 0, 20, 375, 1, 0, 0, 0, 22, 383,
+		1, 0, 0, 0, 24, 398, 1, 0, 0, 0, 26, 402, 1, 0, 0, 0, 28, 414, 1, 0, 0,
+		0, 30, 427, 1, 0, 0, 0, 32, 433, 1, 0, 0, 0, 34, 446, 1, 0, 0, 0, 36, 450,
+		1, 0, 0, 0, 38, 454, 1, 0, 0, 0, 40, 458, 1, 0, 0, 0, 42, 461, 1, 0, 0,
+		0, 44, 465, 1, 0, 0, 0, 46, 469, 1, 0, 0, 0, 48, 472, 1, 0, 0, 0, 50, 483,
+		1, 0, 0, 0, 52, 498, 1, 0, 0, 0, 54, 502, 1, 0, 0, 0, 56, 507, 1, 0, 0,
+		0, 58, 521, 1, 0, 0, 0, 60, 527, 1, 0, 0, 0, 62, 533, 1, 0, 0, 0, 64, 539,
+		1, 0, 0, 0, 66, 552, 1, 0, 0, 0, 68, 554, 1, 0, 0, 0, 70, 562, 1, 0, 0,
+		0, 72, 567, 1, 0, 0, 0, 74, 571, 1, 0, 0, 0, 76, 591, 1, 0, 0, 0, 78, 593,
+		1, 0, 0, 0, 80, 597, 1, 0, 0, 0, 82, 602, 1, 0, 0, 0, 84, 629, 1, 0, 0,
+		0, 86, 631, 1, 0, 0, 0, 88, 634, 1, 0, 0, 0, 90, 638, 1, 0, 0, 0, 92, 644,
+		1, 0, 0, 0, 94, 653, 1, 0, 0, 0, 96, 657, 1, 0, 0, 0, 98, 660, 1, 0, 0,
+		0, 100, 664, 1, 0, 0, 0, 102, 668, 1, 0, 0, 0, 104, 671, 1, 0, 0, 0, 106,
+		684, 1, 0, 0, 0, 108, 697, 1, 0, 0, 0, 110, 700, 1, 0, 0, 0, 112, 709,
+		1, 0, 0, 0, 114, 711, 1, 0, 0, 0, 116, 719, 1, 0, 0, 0, 118, 721, 1, 0,
+		0, 0, 120, 733, 1, 0, 0, 0, 122, 737, 1, 0, 0, 0, 124, 740, 1, 0, 0, 0,
+		126, 749, 1, 0, 0, 0, 128, 764, 1, 0, 0, 0, 130, 766, 1, 0, 0, 0, 132,
+		770, 1, 0, 0, 0, 134, 773, 1, 0, 0, 0, 136, 779, 1, 0, 0, 0, 138, 785,
+		1, 0, 0, 0, 140, 790, 1, 0, 0, 0, 142, 800, 1, 0, 0, 0, 144, 804, 1, 0,
+		0, 0, 146, 806, 1, 0, 0, 0, 148, 808, 1, 0, 0, 0, 150, 810, 1, 0, 0, 0,
+		152, 812, 1, 0, 0, 0, 154, 814, 1, 0, 0, 0, 156, 816, 1, 0, 0, 0, 158,
+		818, 1, 0, 0, 0, 160, 821, 1, 0, 0, 0, 162, 845, 1, 0, 0, 0, 164, 847,
+		1, 0, 0, 0, 166, 850, 1, 0, 0, 0, 168, 858, 1, 0, 0, 0, 170, 862, 1, 0,
+		0, 0, 172, 865, 1, 0, 0, 0, 174, 869, 1, 0, 0, 0, 176, 873, 1, 0, 0, 0,
+		178, 877, 1, 0, 0, 0, 180, 883, 1, 0, 0, 0, 182, 896, 1, 0, 0, 0, 184,
+		926, 1, 0, 0, 0, 186, 936, 1, 0, 0, 0, 188, 944, 1, 0, 0, 0, 190, 950,
+		1, 0, 0, 0, 192, 958, 1, 0, 0, 0, 194, 963, 1, 0, 0, 0, 196, 969, 1, 0,
+		0, 0, 198, 973, 1, 0, 0, 0, 200, 980, 1, 0, 0, 0, 202, 993, 1, 0, 0, 0,
+		204, 1007, 1, 0, 0, 0, 206, 1009, 1, 0, 0, 0, 208, 1011, 1, 0, 0, 0, 210,
+		1015, 1, 0, 0, 0, 212, 1022, 1, 0, 0, 0, 214, 1030, 1, 0, 0, 0, 216, 1039,
+		1, 0, 0, 0, 218, 1050, 1, 0, 0, 0, 220, 1052, 1, 0, 0, 0, 222, 1054, 1,
+		0, 0, 0, 224, 1066, 1, 0, 0, 0, 226, 1076, 1, 0, 0, 0, 228, 1095, 1, 0,
+		0, 0, 230, 1098, 1, 0, 0, 0, 232, 1100, 1, 0, 0, 0, 234, 1107, 1, 0, 0,
+		0, 236, 1109, 1, 0, 0, 0, 238, 1119, 1, 0, 0, 0, 240, 1127, 1, 0, 0, 0,
+		242, 1129, 1, 0, 0, 0, 244, 1133, 1, 0, 0, 0, 246, 1148, 1, 0, 0, 0, 248,
+		1150, 1, 0, 0, 0, 250, 1167, 1, 0, 0, 0, 252, 1177, 1, 0, 0, 0, 254, 1180,
+		1, 0, 0, 0, 256, 1185, 1, 0, 0, 0, 258, 1189, 1, 0, 0, 0, 260, 1192, 1,
+		0, 0, 0, 262, 1194, 1, 0, 0, 0, 264, 1196, 1, 0, 0, 0, 266, 1200, 1, 0,
+		0, 0, 268, 1212, 1, 0, 0, 0, 270, 301, 3, 4, 2, 0, 271, 301, 3, 34, 17,
+		0, 272, 301, 3, 36, 18, 0, 273, 301, 3, 38, 19, 0, 274, 301, 3, 2, 1, 0,
+		275, 301, 3, 160, 80, 0, 276, 301, 3, 42, 21, 0, 277, 301, 3, 44, 22, 0,
+		278, 301, 3, 58, 29, 0, 279, 301, 3, 60, 30, 0, 280, 301, 3, 62, 31, 0,
+		281, 301, 3, 64, 32, 0, 282, 301, 3, 74, 37, 0, 283, 301, 3, 80, 40, 0,
+		284, 301, 3, 82, 41, 0, 285, 301, 3, 88, 44, 0, 286, 301, 3, 90, 45, 0,
+		287, 301, 3, 92, 46, 0, 288, 301, 3, 94, 47, 0, 289, 301, 3, 98, 49, 0,
+		290, 301, 3, 100, 50, 0, 291, 301, 3, 104, 52, 0, 292, 301, 3, 106, 53,
+		0, 293, 301, 3, 118, 59, 0, 294, 301, 3, 120, 60, 0, 295, 301, 3, 124,
+		62, 0, 296, 301, 3, 126, 63, 0, 297, 301, 3, 130, 65, 0, 298, 301, 3, 140,
+		70, 0, 299, 301, 3, 266, 133, 0, 300, 270, 1, 0, 0, 0, 300, 271, 1, 0,
+		0, 0, 300, 272, 1, 0, 0, 0, 300, 273, 1, 0, 0, 0, 300, 274, 1, 0, 0, 0,
+		300, 275, 1, 0, 0, 0, 300, 276, 1, 0, 0, 0, 300, 277, 1, 0, 0, 0, 300,
+		278, 1, 0, 0, 0, 300, 279, 1, 0, 0, 0, 300, 280, 1, 0, 0, 0, 300, 281,
+		1, 0, 0, 0, 300, 282, 1, 0, 0, 0, 300, 283, 1, 0, 0, 0, 300, 284, 1, 0,
+		0, 0, 300, 285, 1, 0, 0, 0, 300, 286, 1, 0, 0, 0, 300, 287, 1, 0, 0, 0,
+		300, 288, 1, 0, 0, 0, 300, 289, 1, 0, 0, 0, 300, 290, 1, 0, 0, 0, 300,
+		291, 1, 0, 0, 0, 300, 292, 1, 0, 0, 0, 300, 293, 1, 0, 0, 0, 300, 294,
+		1, 0, 0, 0, 300, 295, 1, 0, 0, 0, 300, 296, 1, 0, 0, 0, 300, 297, 1, 0,
+		0, 0, 300, 298, 1, 0, 0, 0, 300, 299, 1, 0, 0, 0, 301, 303, 1, 0, 0, 0,
+		302, 304, 5, 148, 0, 0, 303, 302, 1, 0, 0, 0, 303, 304, 1, 0, 0, 0, 304,
+		305, 1, 0, 0, 0, 305, 306, 5, 0, 0, 1, 306, 1, 1, 0, 0, 0, 307, 308, 5,
+		23, 0, 0, 308, 309, 3, 266, 133, 0, 309, 3, 1, 0, 0, 0, 310, 343, 3, 6,
+		3, 0, 311, 343, 3, 16, 8, 0, 312, 343, 3, 18, 9, 0, 313, 343, 3, 20, 10,
+		0, 314, 343, 3, 22, 11, 0, 315, 343, 3, 12, 6, 0, 316, 343, 3, 14, 7, 0,
+		317, 343, 3, 24, 12, 0, 318, 343, 3, 30, 15, 0, 319, 343, 3, 32, 16, 0,
+		320, 343, 3, 26, 13, 0, 321, 343, 3, 28, 14, 0, 322, 343, 3, 40, 20, 0,
+		323, 343, 3, 46, 23, 0, 324, 343, 3, 48, 24, 0, 325, 343, 3, 50, 25, 0,
+		326, 343, 3, 52, 26, 0, 327, 343, 3, 54, 27, 0, 328, 343, 3, 56, 28, 0,
+		329, 343, 3, 8, 4, 0, 330, 343, 3, 10, 5, 0, 331, 343, 3, 72, 36, 0, 332,
+		343, 3, 70, 35, 0, 333, 343, 3, 78, 39, 0, 334, 343, 3, 86, 43, 0, 335,
+		343, 3, 96, 48, 0, 336, 343, 3, 102, 51, 0, 337, 343, 3, 122, 61, 0, 338,
+		343, 3, 132, 66, 0, 339, 343, 3, 134, 67, 0, 340, 343, 3, 136, 68, 0, 341,
+		343, 3, 138, 69, 0, 342, 310, 1, 0, 0, 0, 342, 311, 1, 0, 0, 0, 342, 312,
+		1, 0, 0, 0, 342, 313, 1, 0, 0, 0, 342, 314, 1, 0, 0, 0, 342, 315, 1, 0,
+		0, 0, 342, 316, 1, 0, 0, 0, 342, 317, 1, 0, 0, 0, 342, 318, 1, 0, 0, 0,
+		342, 319, 1, 0, 0, 0, 342, 320, 1, 0, 0, 0, 342, 321, 1, 0, 0, 0, 342,
+		322, 1, 0, 0, 0, 342, 323, 1, 0, 0, 0, 342, 324, 1, 0, 0, 0, 342, 325,
+		1, 0, 0, 0, 342, 326, 1, 0, 0, 0, 342, 327, 1, 0, 0, 0, 342, 328, 1, 0,
+		0, 0, 342, 329, 1, 0, 0, 0, 342, 330, 1, 0, 0, 0, 342, 331, 1, 0, 0, 0,
+		342, 332, 1, 0, 0, 0, 342, 333, 1, 0, 0, 0, 342, 334, 1, 0, 0, 0, 342,
+		335, 1, 0, 0, 0, 342, 336, 1, 0, 0, 0, 342, 337, 1, 0, 0, 0, 342, 338,
+		1, 0, 0, 0, 342, 339, 1, 0, 0, 0, 342, 340, 1, 0, 0, 0, 342, 341, 1, 0,
+		0, 0, 343, 5, 1, 0, 0, 0, 344, 345, 5, 21, 0, 0, 345, 346, 5, 26, 0, 0,
+		346, 7, 1, 0, 0, 0, 347, 348, 5, 21, 0, 0, 348, 349, 5, 83, 0, 0, 349,
+		9, 1, 0, 0, 0, 350, 351, 5, 21, 0, 0, 351, 352, 5, 84, 0, 0, 352, 353,
+		5, 53, 0, 0, 353, 354, 5, 85, 0, 0, 354, 355, 5, 149, 0, 0, 355, 356, 3,
+		156, 78, 0, 356, 11, 1, 0, 0, 0, 357, 358, 5, 21, 0, 0, 358, 359, 5, 30,
+		0, 0, 359, 13, 1, 0, 0, 0, 360, 361, 5, 21, 0, 0, 361, 362, 5, 33, 0, 0,
+		362, 15, 1, 0, 0, 0, 363, 364, 5, 21, 0, 0, 364, 365, 5, 27, 0, 0, 365,
+		366, 5, 28, 0, 0, 366, 17, 1, 0, 0, 0, 367, 368, 5, 21, 0, 0, 368, 369,
+		5, 32, 0, 0, 369, 370, 5, 27, 0, 0, 370, 371, 5, 52, 0, 0, 371, 372, 3,
+		158, 79, 0, 372, 373, 5, 53, 0, 0, 373, 374, 3, 176, 88, 0, 374, 19, 1,
+		0, 0, 0, 375, 376, 5, 21, 0, 0, 376, 377, 5, 26, 0, 0, 377, 378, 5, 27,
+		0, 0, 378, 379, 5, 52, 0, 0, 379, 380, 3, 158, 79, 0, 380, 381, 5, 53,
+		0, 0, 381, 382, 3, 176, 88, 0, 382, 21, 1, 0, 0, 0, 383, 384, 5, 21, 0,
+		0, 384, 385, 5, 31, 0, 0, 385, 386, 5, 27, 0, 0, 386, 387, 5, 52, 0, 0,
+		387, 388, 3, 158, 79, 0, 388, 391, 5, 53, 0, 0, 389, 392, 3, 172, 86, 0,
+		390, 392, 3, 176, 88, 0, 391, 389, 1, 0, 0, 0, 391, 390, 1, 0, 0, 0, 392,
+		393, 1, 0, 0, 0, 393, 396, 5, 61, 0, 0, 394, 397, 3, 172, 86, 0, 395, 397,
+		3, 176, 88, 0, 396, 394, 1, 0, 0, 0, 396, 395, 1, 0, 0, 0, 397, 23, 1,
+		0, 0, 0, 398, 399, 5, 21, 0, 0, 399, 400, 7, 0, 0, 0, 400, 401, 5, 34,
+		0, 0, 401, 25, 1, 0, 0, 0, 402, 403, 5, 21, 0, 0, 403, 404, 5, 13, 0, 0,
+		404, 407, 5, 53, 0, 0, 405, 408, 3, 172, 86, 0, 406, 408, 3, 174, 87, 0,
+		407, 405, 1, 0, 0, 0, 407, 406, 1, 0, 0, 0, 408, 409, 1, 0, 0, 0, 409,
+		412, 5, 61, 0, 0, 410, 413, 3, 172, 86, 0, 411, 413, 3, 174, 87, 0, 412,
+		410, 1, 0, 0, 0, 412, 411, 1, 0, 0, 0, 413, 27, 1, 0, 0, 0, 414, 415, 5,
+		21, 0, 0, 415, 416, 5, 14, 0, 0, 416, 417, 5, 36, 0, 0, 417, 420, 5, 53,
+		0, 0, 418, 421, 3, 172, 86, 0, 419, 421, 3, 174, 87, 0, 420, 418, 1, 0,
+		0, 0, 420, 419, 1, 0, 0, 0, 421, 422, 1, 0, 0, 0, 422, 425, 5, 61, 0, 0,
+		423, 426, 3, 172, 86, 0, 424, 426, 3, 174, 87, 0, 425, 423, 1, 0, 0, 0,
+		425, 424, 1, 0, 0, 0, 426, 29, 1, 0, 0, 0, 427, 428, 5, 21, 0, 0, 428,
+		429, 5, 32, 0, 0, 429, 430, 5, 42, 0, 0, 430, 431, 5, 53, 0, 0, 431, 432,
+		3, 188, 94, 0, 432, 31, 1, 0, 0, 0, 433, 434, 5, 21, 0, 0, 434, 435, 5,
+		31, 0, 0, 435, 436, 5, 42, 0, 0, 436, 439, 5, 53, 0, 0, 437, 440, 3, 172,
+		86, 0, 438, 440, 3, 188, 94, 0, 439, 437, 1, 0, 0, 0, 439, 438, 1, 0, 0,
+		0, 440, 441, 1, 0, 0, 0, 441, 444, 5, 61, 0, 0, 442, 445, 3, 172, 86, 0,
+		443, 445, 3, 188, 94, 0, 444, 442, 1, 0, 0, 0, 444, 443, 1, 0, 0, 0, 445,
+		33, 1, 0, 0, 0, 446, 447, 5, 6, 0, 0, 447, 448, 5, 31, 0, 0, 448, 449,
+		3, 244, 122, 0, 449, 35, 1, 0, 0, 0, 450, 451, 5, 6, 0, 0, 451, 452, 5,
+		32, 0, 0, 452, 453, 3, 244, 122, 0, 453, 37, 1, 0, 0, 0, 454, 455, 5, 22,
+		0, 0, 455, 456, 5, 31, 0, 0, 456, 457, 3, 154, 77, 0, 457, 39, 1, 0, 0,
+		0, 458, 459, 5, 21, 0, 0, 459, 460, 5, 35, 0, 0, 460, 41, 1, 0, 0, 0, 461,
+		462, 5, 6, 0, 0, 462, 463, 5, 36, 0, 0, 463, 464, 3, 244, 122, 0, 464,
+		43, 1, 0, 0, 0, 465, 466, 5, 9, 0, 0, 466, 467, 5, 36, 0, 0, 467, 468,
+		3, 152, 76, 0, 468, 45, 1, 0, 0, 0, 469, 470, 5, 21, 0, 0, 470, 471, 5,
+		37, 0, 0, 471, 47, 1, 0, 0, 0, 472, 473, 5, 21, 0, 0, 473, 478, 5, 39,
+		0, 0, 474, 475, 5, 53, 0, 0, 475, 476, 5, 38, 0, 0, 476, 477, 5, 149, 0,
+		0, 477, 479, 3, 146, 73, 0, 478, 474, 1, 0, 0, 0, 478, 479, 1, 0, 0, 0,
+		479, 481, 1, 0, 0, 0, 480, 482, 3, 258, 129, 0, 481, 480, 1, 0, 0, 0, 481,
+		482, 1, 0, 0, 0, 482, 49, 1, 0, 0, 0, 483, 484, 5, 21, 0, 0, 484, 487,
+		5, 41, 0, 0, 485, 486, 5, 20, 0, 0, 486, 488, 3, 150, 75, 0, 487, 485,
+		1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 493, 1, 0, 0, 0, 489, 490, 5, 53,
+		0, 0, 490, 491, 5, 42, 0, 0, 491, 492, 5, 149, 0, 0, 492, 494, 3, 146,
+		73, 0, 493, 489, 1, 0, 0, 0, 493, 494, 1, 0, 0, 0, 494, 496, 1, 0, 0, 0,
+		495, 497, 3, 258, 129, 0, 496, 495, 1, 0, 0, 0, 496, 497, 1, 0, 0, 0, 497,
+		51, 1, 0, 0, 0, 498, 499, 5, 21, 0, 0, 499, 500, 5, 44, 0, 0, 500, 501,
+		3, 178, 89, 0, 501, 53, 1, 0, 0, 0, 502, 503, 5, 21, 0, 0, 503, 504, 5,
+		45, 0, 0, 504, 505, 5, 47, 0, 0, 505, 506, 3, 178, 89, 0, 506, 55, 1, 0,
+		0, 0, 507, 508, 5, 21, 0, 0, 508, 509, 5, 45, 0, 0, 509, 510, 5, 50, 0,
+		0, 510, 511, 3, 178, 89, 0, 511, 512, 5, 49, 0, 0, 512, 513, 5, 48, 0,
+		0, 513, 514, 5, 149, 0, 0, 514, 516, 3, 148, 74, 0, 515, 517, 3, 180, 90,
+		0, 516, 515, 1, 0, 0, 0, 516, 517, 1, 0, 0, 0, 517, 519, 1, 0, 0, 0, 518,
+		520, 3, 258, 129, 0, 519, 518, 1, 0, 0, 0, 519, 520, 1, 0, 0, 0, 520, 57,
+		1, 0, 0, 0, 521, 522, 5, 86, 0, 0, 522, 523, 5, 36, 0, 0, 523, 524, 3,
+		144, 72, 0, 524, 525, 5, 128, 0, 0, 525, 526, 5, 172, 0, 0, 526, 59, 1,
+		0, 0, 0, 527, 528, 5, 87, 0, 0, 528, 529, 5, 36, 0, 0, 529, 530, 3, 144,
+		72, 0, 530, 531, 5, 89, 0, 0, 531, 532, 3, 144, 72, 0, 532, 61, 1, 0, 0,
+		0, 533, 534, 5, 88, 0, 0, 534, 535, 5, 36, 0, 0, 535, 536, 3, 144, 72,
+		0, 536, 537, 5, 52, 0, 0, 537, 538, 3, 144, 72, 0, 538, 63, 1, 0, 0, 0,
+		539, 540, 7, 1, 0, 0, 540, 541, 5, 36, 0, 0, 541, 545, 3, 144, 72, 0, 542,
+		544, 3, 66, 33, 0, 543, 542, 1, 0, 0, 0, 544, 547, 1, 0, 0, 0, 545, 543,
+		1, 0, 0, 0, 545, 546, 1, 0, 0, 0, 546, 65, 1, 0, 0, 0, 547, 545, 1, 0,
+		0, 0, 548, 549, 5, 12, 0, 0, 549, 553, 3, 68, 34, 0, 550, 551, 5, 93, 0,
+		0, 551, 553, 3, 144, 72, 0, 552, 548, 1, 0, 0, 0, 552, 550, 1, 0, 0, 0,
+		553, 67, 1, 0, 0, 0, 554, 559, 5, 172, 0, 0, 555, 556, 5, 158, 0, 0, 556,
+		558, 5, 172, 0, 0, 557, 555, 1, 0, 0, 0, 558, 561, 1, 0, 0, 0, 559, 557,
+		1, 0, 0, 0, 559, 560, 1, 0, 0, 0, 560, 69, 1, 0, 0, 0, 561, 559, 1, 0,
+		0, 0, 562, 563, 5, 21, 0, 0, 563, 564, 5, 92, 0, 0, 564, 565, 5, 36, 0,
+		0, 565, 566, 3, 144, 72, 0, 566, 71, 1, 0, 0, 0, 567, 568, 5, 21, 0, 0,
+		568, 569, 5, 94, 0, 0, 569, 570, 5, 83, 0, 0, 570, 73, 1, 0, 0, 0, 571,
+		572, 5, 6, 0, 0, 572, 573, 5, 95, 0, 0, 573, 574, 5, 96, 0, 0, 574, 578,
+		3, 144, 72, 0, 575, 577, 3, 76, 38, 0, 576, 575, 1, 0, 0, 0, 577, 580,
+		1, 0, 0, 0, 578, 576, 1, 0, 0, 0, 578, 579, 1, 0, 0, 0, 579, 75, 1, 0,
+		0, 0, 580, 578, 1, 0, 0, 0, 581, 582, 5, 20, 0, 0, 582, 592, 3, 144, 72,
+		0, 583, 584, 5, 98, 0, 0, 584, 592, 3, 144, 72, 0, 585, 586, 5, 99, 0,
+		0, 586, 592, 3, 142, 71, 0, 587, 588, 5, 38, 0, 0, 588, 592, 3, 144, 72,
+		0, 589, 590, 5, 60, 0, 0, 590, 592, 3, 144, 72, 0, 591, 581, 1, 0, 0, 0,
+		591, 583, 1, 0, 0, 0, 591, 585, 1, 0, 0, 0, 591, 587, 1, 0, 0, 0, 591,
+		589, 1, 0, 0, 0, 592, 77, 1, 0, 0, 0, 593, 594, 5, 21, 0, 0, 594, 595,
+		5, 95, 0, 0, 595, 596, 5, 97, 0, 0, 596, 79, 1, 0, 0, 0, 597, 598, 5, 9,
+		0, 0, 598, 599, 5, 95, 0, 0, 599, 600, 5, 96, 0, 0, 600, 601, 3, 144, 72,
+		0, 601, 81, 1, 0, 0, 0, 602, 603, 5, 6, 0, 0, 603, 604, 5, 100, 0, 0, 604,
+		608, 3, 144, 72, 0, 605, 607, 3, 84, 42, 0, 606, 605, 1, 0, 0, 0, 607,
+		610, 1, 0, 0, 0, 608, 606, 1, 0, 0, 0, 608, 609, 1, 0, 0, 0, 609, 83, 1,
+		0, 0, 0, 610, 608, 1, 0, 0, 0, 611, 612, 5, 20, 0, 0, 612, 630, 3, 144,
+		72, 0, 613, 614, 5, 102, 0, 0, 614, 630, 3, 144, 72, 0, 615, 616, 5, 99,
+		0, 0, 616, 630, 3, 142, 71, 0, 617, 618, 5, 76, 0, 0, 618, 630, 3, 142,
+		71, 0, 619, 620, 5, 38, 0, 0, 620, 630, 3, 144, 72, 0, 621, 622, 5, 103,
+		0, 0, 622, 630, 3, 144, 72, 0, 623, 624, 5, 104, 0, 0, 624, 630, 3, 144,
+		72, 0, 625, 626, 5, 105, 0, 0, 626, 630, 3, 144, 72, 0, 627, 628, 5, 60,
+		0, 0, 628, 630, 3, 144, 72, 0, 629, 611, 1, 0, 0, 0, 629, 613, 1, 0, 0,
+		0, 629, 615, 1, 0, 0, 0, 629, 617, 1, 0, 0, 0, 629, 619, 1, 0, 0, 0, 629,
+		621, 1, 0, 0, 0, 629, 623, 1, 0, 0, 0, 629, 625, 1, 0, 0, 0, 629, 627,
+		1, 0, 0, 0, 630, 85, 1, 0, 0, 0, 631, 632, 5, 21, 0, 0, 632, 633, 5, 101,
+		0, 0, 633, 87, 1, 0, 0, 0, 634, 635, 5, 9, 0, 0, 635, 636, 5, 100, 0, 0,
+		636, 637, 3, 144, 72, 0, 637, 89, 1, 0, 0, 0, 638, 639, 5, 6, 0, 0, 639,
+		640, 5, 106, 0, 0, 640, 641, 3, 144, 72, 0, 641, 642, 5, 108, 0, 0, 642,
+		643, 3, 144, 72, 0, 643, 91, 1, 0, 0, 0, 644, 645, 5, 86, 0, 0, 645, 646,
+		5, 106, 0, 0, 646, 651, 3, 144, 72, 0, 647, 648, 5, 108, 0, 0, 648, 652,
+		3, 144, 72, 0, 649, 650, 5, 114, 0, 0, 650, 652, 5, 110, 0, 0, 651, 647,
+		1, 0, 0, 0, 651, 649, 1, 0, 0, 0, 652, 93, 1, 0, 0, 0, 653, 654, 5, 9,
+		0, 0, 654, 655, 5, 106, 0, 0, 655, 656, 3, 144, 72, 0, 656, 95, 1, 0, 0,
+		0, 657, 658, 5, 21, 0, 0, 658, 659, 5, 107, 0, 0, 659, 97, 1, 0, 0, 0,
+		660, 661, 5, 6, 0, 0, 661, 662, 5, 111, 0, 0, 662, 663, 3, 144, 72, 0,
+		663, 99, 1, 0, 0, 0, 664, 665, 5, 9, 0, 0, 665, 666, 5, 111, 0, 0, 666,
+		667, 3, 144, 72, 0, 667, 101, 1, 0, 0, 0, 668, 669, 5, 21, 0, 0, 669, 670,
+		5, 112, 0, 0, 670, 103, 1, 0, 0, 0, 671, 680, 5, 113, 0, 0, 672, 673, 3,
+		108, 54, 0, 673, 674, 5, 89, 0, 0, 674, 681, 1, 0, 0, 0, 675, 676, 3, 110,
+		55, 0, 676, 678, 5, 89, 0, 0, 677, 679, 5, 111, 0, 0, 678, 677, 1, 0, 0,
+		0, 678, 679, 1, 0, 0, 0, 679, 681, 1, 0, 0, 0, 680, 672, 1, 0, 0, 0, 680,
+		675, 1, 0, 0, 0, 681, 682, 1, 0, 0, 0, 682, 683, 3, 144, 72, 0, 683, 105,
+		1, 0, 0, 0, 684, 693, 5, 114, 0, 0, 685, 686, 3, 108, 54, 0, 686, 687,
+		5, 52, 0, 0, 687, 694, 1, 0, 0, 0, 688, 689, 3, 110, 55, 0, 689, 691, 5,
+		52, 0, 0, 690, 692, 5, 111, 0, 0, 691, 690, 1, 0, 0, 0, 691, 692, 1, 0,
+		0, 0, 692, 694, 1, 0, 0, 0, 693, 685, 1, 0, 0, 0, 693, 688, 1, 0, 0, 0,
+		694, 695, 1, 0, 0, 0, 695, 696, 3, 144, 72, 0, 696, 107, 1, 0, 0, 0, 697,
+		698, 5, 111, 0, 0, 698, 699, 3, 144, 72, 0, 699, 109, 1, 0, 0, 0, 700,
+		701, 3, 114, 57, 0, 701, 702, 5, 20, 0, 0, 702, 705, 3, 112, 56, 0, 703,
+		704, 5, 38, 0, 0, 704, 706, 3, 144, 72, 0, 705, 703, 1, 0, 0, 0, 705, 706,
+		1, 0, 0, 0, 706, 111, 1, 0, 0, 0, 707, 710, 3, 144, 72, 0, 708, 710, 5,
+		168, 0, 0, 709, 707, 1, 0, 0, 0, 709, 708, 1, 0, 0, 0, 710, 113, 1, 0,
+		0, 0, 711, 716, 3, 116, 58, 0, 712, 713, 5, 158, 0, 0, 713, 715, 3, 116,
+		58, 0, 714, 712, 1, 0, 0, 0, 715, 718, 1, 0, 0, 0, 716, 714, 1, 0, 0, 0,
+		716, 717, 1, 0, 0, 0, 717, 115, 1, 0, 0, 0, 718, 716, 1, 0, 0, 0, 719,
+		720, 7, 2, 0, 0, 720, 117, 1, 0, 0, 0, 721, 722, 5, 6, 0, 0, 722, 723,
+		5, 109, 0, 0, 723, 724, 3, 144, 72, 0, 724, 725, 5, 20, 0, 0, 725, 728,
+		3, 144, 72, 0, 726, 727, 5, 38, 0, 0, 727, 729, 3, 144, 72, 0, 728, 726,
+		1, 0, 0, 0, 728, 729, 1, 0, 0, 0, 729, 730, 1, 0, 0, 0, 730, 731, 5, 76,
+		0, 0, 731, 732, 3, 114, 57, 0, 732, 119, 1, 0, 0, 0, 733, 734, 5, 9, 0,
+		0, 734, 735, 5, 109, 0, 0, 735, 736, 3, 144, 72, 0, 736, 121, 1, 0, 0,
+		0, 737, 738, 5, 21, 0, 0, 738, 739, 5, 110, 0, 0, 739, 123, 1, 0, 0, 0,
+		740, 741, 5, 6, 0, 0, 741, 742, 5, 118, 0, 0, 742, 746, 3, 144, 72, 0,
+		743, 745, 3, 128, 64, 0, 744, 743, 1, 0, 0, 0, 745, 748, 1, 0, 0, 0, 746,
+		744, 1, 0, 0, 0, 746, 747, 1, 0, 0, 0, 747, 125, 1, 0, 0, 0, 748, 746,
+		1, 0, 0, 0, 749, 750, 5, 86, 0, 0, 750, 751, 5, 118, 0, 0, 751, 753, 3,
+		144, 72, 0, 752, 754, 3, 128, 64, 0, 753, 752, 1, 0, 0, 0, 754, 755, 1,
+		0, 0, 0, 755, 753, 1, 0, 0, 0, 755, 756, 1, 0, 0, 0, 756, 127, 1, 0, 0,
+		0, 757, 758, 7, 3, 0, 0, 758, 765, 5, 172, 0, 0, 759, 762, 5, 124, 0, 0,
+		760, 763, 5, 172, 0, 0, 761, 763, 3, 144, 72, 0, 762, 760, 1, 0, 0, 0,
+		762, 761, 1, 0, 0, 0, 763, 765, 1, 0, 0, 0, 764, 757, 1, 0, 0, 0, 764,
+		759, 1, 0, 0, 0, 765, 129, 1, 0, 0, 0, 766, 767, 5, 9, 0, 0, 767, 768,
+		5, 118, 0, 0, 768, 769, 3, 144, 72, 0, 769, 131, 1, 0, 0, 0, 770, 771,
+		5, 21, 0, 0, 771, 772, 5, 119, 0, 0, 772, 133, 1, 0, 0, 0, 773, 774, 5,
+		21, 0, 0, 774, 775, 5, 118, 0, 0, 775, 777, 5, 120, 0, 0, 776, 778, 3,
+		144, 72, 0, 777, 776, 1, 0, 0, 0, 777, 778, 1, 0, 0, 0, 778, 135, 1, 0,
+		0, 0, 779, 780, 5, 21, 0, 0, 780, 781, 5, 125, 0, 0, 781, 783, 5, 81, 0,
+		0, 782, 784, 3, 258, 129, 0, 783, 782, 1, 0, 0, 0, 783, 784, 1, 0, 0, 0,
+		784, 137, 1, 0, 0, 0, 785, 786, 5, 21, 0, 0, 786, 788, 5, 126, 0, 0, 787,
+		789, 3, 144, 72, 0, 788, 787, 1, 0, 0, 0, 788, 789, 1, 0, 0, 0, 789, 139,
+		1, 0, 0, 0, 790, 791, 5, 127, 0, 0, 791, 792, 5, 31, 0, 0, 792, 793, 5,
+		40, 0, 0, 793, 796, 5, 172, 0, 0, 794, 795, 5, 20, 0, 0, 795, 797, 3, 144,
+		72, 0, 796, 794, 1, 0, 0, 0, 796, 797, 1, 0, 0, 0, 797, 141, 1, 0, 0, 0,
+		798, 801, 3, 228, 114, 0, 799, 801, 3, 144, 72, 0, 800, 798, 1, 0, 0, 0,
+		800, 799, 1, 0, 0, 0, 801, 143, 1, 0, 0, 0, 802, 805, 3, 266, 133, 0, 803,
+		805, 5, 4, 0, 0, 804, 802, 1, 0, 0, 0, 804, 803, 1, 0, 0, 0, 805, 145,
+		1, 0, 0, 0, 806, 807, 3, 266, 133, 0, 807, 147, 1, 0, 0, 0, 808, 809, 3,
+		266, 133, 0, 809, 149, 1, 0, 0, 0, 810, 811, 3, 266, 133, 0, 811, 151,
+		1, 0, 0, 0, 812, 813, 3, 266, 133, 0, 813, 153, 1, 0, 0, 0, 814, 815, 3,
+		266, 133, 0, 815, 155, 1, 0, 0, 0, 816, 817, 3, 266, 133, 0, 817, 157,
+		1, 0, 0, 0, 818, 819, 7, 4, 0, 0, 819, 159, 1, 0, 0, 0, 820, 822, 5, 57,
+		0, 0, 821, 820, 1, 0, 0, 0, 821, 822, 1, 0, 0, 0, 822, 823, 1, 0, 0, 0,
+		823, 825, 3, 162, 81, 0, 824, 826, 3, 180, 90, 0, 825, 824, 1, 0, 0, 0,
+		825, 826, 1, 0, 0, 0, 826, 828, 1, 0, 0, 0, 827, 829, 3, 200, 100, 0, 828,
+		827, 1, 0, 0, 0, 828, 829, 1, 0, 0, 0, 829, 831, 1, 0, 0, 0, 830, 832,
+		3, 208, 104, 0, 831, 830, 1, 0, 0, 0, 831, 832, 1, 0, 0, 0, 832, 834, 1,
+		0, 0, 0, 833, 835, 3, 258, 129, 0, 834, 833, 1, 0, 0, 0, 834, 835, 1, 0,
+		0, 0, 835, 837, 1, 0, 0, 0, 836, 838, 5, 58, 0, 0, 837, 836, 1, 0, 0, 0,
+		837, 838, 1, 0, 0, 0, 838, 161, 1, 0, 0, 0, 839, 840, 3, 164, 82, 0, 840,
+		841, 3, 178, 89, 0, 841, 846, 1, 0, 0, 0, 842, 843, 3, 178, 89, 0, 843,
+		844, 3, 164, 82, 0, 844, 846, 1, 0, 0, 0, 845, 839, 1, 0, 0, 0, 845, 842,
+		1, 0, 0, 0, 846, 163, 1, 0, 0, 0, 847, 848, 5, 59, 0, 0, 848, 849, 3, 166,
+		83, 0, 849, 165, 1, 0, 0, 0, 850, 855, 3, 168, 84, 0, 851, 852, 5, 158,
+		0, 0, 852, 854, 3, 168, 84, 0, 853, 851, 1, 0, 0, 0, 854, 857, 1, 0, 0,
+		0, 855, 853, 1, 0, 0, 0, 855, 856, 1, 0, 0, 0, 856, 167, 1, 0, 0, 0, 857,
+		855, 1, 0, 0, 0, 858, 860, 3, 226, 113, 0, 859, 861, 3, 170, 85, 0, 860,
+		859, 1, 0, 0, 0, 860, 861, 1, 0, 0, 0, 861, 169, 1, 0, 0, 0, 862, 863,
+		5, 60, 0, 0, 863, 864, 3, 266, 133, 0, 864, 171, 1, 0, 0, 0, 865, 866,
+		5, 31, 0, 0, 866, 867, 5, 149, 0, 0, 867, 868, 3, 266, 133, 0, 868, 173,
+		1, 0, 0, 0, 869, 870, 5, 36, 0, 0, 870, 871, 5, 149, 0, 0, 871, 872, 3,
+		266, 133, 0, 872, 175, 1, 0, 0, 0, 873, 874, 5, 29, 0, 0, 874, 875, 5,
+		149, 0, 0, 875, 876, 3, 266, 133, 0, 876, 177, 1, 0, 0, 0, 877, 878, 5,
+		52, 0, 0, 878, 881, 3, 260, 130, 0, 879, 880, 5, 20, 0, 0, 880, 882, 3,
+		150, 75, 0, 881, 879, 1, 0, 0, 0, 881, 882, 1, 0, 0, 0, 882, 179, 1, 0,
+		0, 0, 883, 884, 5, 53, 0, 0, 884, 885, 3, 182, 91, 0, 885, 181, 1, 0, 0,
+		0, 886, 897, 3, 184, 92, 0, 887, 888, 3, 184, 92, 0, 888, 889, 5, 61, 0,
+		0, 889, 890, 3, 192, 96, 0, 890, 897, 1, 0, 0, 0, 891, 894, 3, 192, 96,
+		0, 892, 893, 5, 61, 0, 0, 893, 895, 3, 184, 92, 0, 894, 892, 1, 0, 0, 0,
+		894, 895, 1, 0, 0, 0, 895, 897, 1, 0, 0, 0, 896, 886, 1, 0, 0, 0, 896,
+		887, 1, 0, 0, 0, 896, 891, 1, 0, 0, 0, 897, 183, 1, 0, 0, 0, 898, 899,
+		6, 92, -1, 0, 899, 900, 5, 163, 0, 0, 900, 901, 3, 184, 92, 0, 901, 902,
+		5, 164, 0, 0, 902, 927, 1, 0, 0, 0, 903, 912, 3, 262, 131, 0, 904, 913,
+		5, 149, 0, 0, 905, 913, 5, 69, 0, 0, 906, 907, 5, 70, 0, 0, 907, 913, 5,
+		69, 0, 0, 908, 913, 5, 156, 0, 0, 909, 913, 5, 157, 0, 0, 910, 913, 5,
+		150, 0, 0, 911, 913, 5, 151, 0, 0, 912, 904, 1, 0, 0, 0, 912, 905, 1, 0,
+		0, 0, 912, 906, 1, 0, 0, 0, 912, 908, 1, 0, 0, 0, 912, 909, 1, 0, 0, 0,
+		912, 910, 1, 0, 0, 0, 912, 911, 1, 0, 0, 0, 913, 914, 1, 0, 0, 0, 914,
+		915, 3, 264, 132, 0, 915, 927, 1, 0, 0, 0, 916, 920, 3, 262, 131, 0, 917,
+		921, 5, 80, 0, 0, 918, 919, 5, 70, 0, 0, 919, 921, 5, 80, 0, 0, 920, 917,
+		1, 0, 0, 0, 920, 918, 1, 0, 0, 0, 921, 922, 1, 0, 0, 0, 922, 923, 5, 163,
+		0, 0, 923, 924, 3, 186, 93, 0, 924, 925, 5, 164, 0, 0, 925, 927, 1, 0,
+		0, 0, 926, 898, 1, 0, 0, 0, 926, 903, 1, 0, 0, 0, 926, 916, 1, 0, 0, 0,
+		927, 933, 1, 0, 0, 0, 928, 929, 10, 1, 0, 0, 929, 930, 7, 5, 0, 0, 930,
+		932, 3, 184, 92, 2, 931, 928, 1, 0, 0, 0, 932, 935, 1, 0, 0, 0, 933, 931,
+		1, 0, 0, 0, 933, 934, 1, 0, 0, 0, 934, 185, 1, 0, 0, 0, 935, 933, 1, 0,
+		0, 0, 936, 941, 3, 264, 132, 0, 937, 938, 5, 158, 0, 0, 938, 940, 3, 264,
+		132, 0, 939, 937, 1, 0, 0, 0, 940, 943, 1, 0, 0, 0, 941, 939, 1, 0, 0,
+		0, 941, 942, 1, 0, 0, 0, 942, 187, 1, 0, 0, 0, 943, 941, 1, 0, 0, 0, 944,
+		945, 5, 42, 0, 0, 945, 946, 5, 80, 0, 0, 946, 947, 5, 163, 0, 0, 947, 948,
+		3, 190, 95, 0, 948, 949, 5, 164, 0, 0, 949, 189, 1, 0, 0, 0, 950, 955,
+		3, 266, 133, 0, 951, 952, 5, 158, 0, 0, 952, 954, 3, 266, 133, 0, 953,
+		951, 1, 0, 0, 0, 954, 957, 1, 0, 0, 0, 955, 953, 1, 0, 0, 0, 955, 956,
+		1, 0, 0, 0, 956, 191, 1, 0, 0, 0, 957, 955, 1, 0, 0, 0, 958, 961, 3, 194,
+		97, 0, 959, 960, 5, 61, 0, 0, 960, 962, 3, 194, 97, 0, 961, 959, 1, 0,
+		0, 0, 961, 962, 1, 0, 0, 0, 962, 193, 1, 0, 0, 0, 963, 964, 5, 78, 0, 0,
+		964, 967, 3, 224, 112, 0, 965, 968, 3, 196, 98, 0, 966, 968, 3, 266, 133,
+		0, 967, 965, 1, 0, 0, 0, 967, 966, 1, 0, 0, 0, 968, 195, 1, 0, 0, 0, 969,
+		971, 3, 198, 99, 0, 970, 972, 3, 228, 114, 0, 971, 970, 1, 0, 0, 0, 971,
+		972, 1, 0, 0, 0, 972, 197, 1, 0, 0, 0, 973, 974, 5, 79, 0, 0, 974, 976,
+		5, 163, 0, 0, 975, 977, 3, 236, 118, 0, 976, 975, 1, 0, 0, 0, 976, 977,
+		1, 0, 0, 0, 977, 978, 1, 0, 0, 0, 978, 979, 5, 164, 0, 0, 979, 199, 1,
+		0, 0, 0, 980, 981, 5, 73, 0, 0, 981, 982, 5, 75, 0, 0, 982, 988, 3, 202,
+		101, 0, 983, 984, 5, 63, 0, 0, 984, 985, 5, 163, 0, 0, 985, 986, 3, 206,
+		103, 0, 986, 987, 5, 164, 0, 0, 987, 989, 1, 0, 0, 0, 988, 983, 1, 0, 0,
+		0, 988, 989, 1, 0, 0, 0, 989, 991, 1, 0, 0, 0, 990, 992, 3, 214, 107, 0,
+		991, 990, 1, 0, 0, 0, 991, 992, 1, 0, 0, 0, 992, 201, 1, 0, 0, 0, 993,
+		998, 3, 204, 102, 0, 994, 995, 5, 158, 0, 0, 995, 997, 3, 204, 102, 0,
+		996, 994, 1, 0, 0, 0, 997, 1000, 1, 0, 0, 0, 998, 996, 1, 0, 0, 0, 998,
+		999, 1, 0, 0, 0, 999, 203, 1, 0, 0, 0, 1000, 998, 1, 0, 0, 0, 1001, 1008,
+		3, 266, 133, 0, 1002, 1003, 5, 78, 0, 0, 1003, 1004, 5, 163, 0, 0, 1004,
+		1005, 3, 228, 114, 0, 1005, 1006, 5, 164, 0, 0, 1006, 1008, 1, 0, 0, 0,
+		1007, 1001, 1, 0, 0, 0, 1007, 1002, 1, 0, 0, 0, 1008, 205, 1, 0, 0, 0,
+		1009, 1010, 7, 6, 0, 0, 1010, 207, 1, 0, 0, 0, 1011, 1012, 5, 66, 0, 0,
+		1012, 1013, 5, 75, 0, 0, 1013, 1014, 3, 212, 106, 0, 1014, 209, 1, 0, 0,
+		0, 1015, 1019, 3, 226, 113, 0, 1016, 1018, 7, 7, 0, 0, 1017, 1016, 1, 0,
+		0, 0, 1018, 1021, 1, 0, 0, 0, 1019, 1017, 1, 0, 0, 0, 1019, 1020, 1, 0,
+		0, 0, 1020, 211, 1, 0, 0, 0, 1021, 1019, 1, 0, 0, 0, 1022, 1027, 3, 210,
+		105, 0, 1023, 1024, 5, 158, 0, 0, 1024, 1026, 3, 210, 105, 0, 1025, 1023,
+		1, 0, 0, 0, 1026, 1029, 1, 0, 0, 0, 1027, 1025, 1, 0, 0, 0, 1027, 1028,
+		1, 0, 0, 0, 1028, 213, 1, 0, 0, 0, 1029, 1027, 1, 0, 0, 0, 1030, 1031,
+		5, 74, 0, 0, 1031, 1032, 3, 216, 108, 0, 1032, 215, 1, 0, 0, 0, 1033, 1034,
+		6, 108, -1, 0, 1034, 1035, 5, 163, 0, 0, 1035, 1036, 3, 216, 108, 0, 1036,
+		1037, 5, 164, 0, 0, 1037, 1040, 1, 0, 0, 0, 1038, 1040, 3, 220, 110, 0,
+		1039, 1033, 1, 0, 0, 0, 1039, 1038, 1, 0, 0, 0, 1040, 1047, 1, 0, 0, 0,
+		1041, 1042, 10, 2, 0, 0, 1042, 1043, 3, 218, 109, 0, 1043, 1044, 3, 216,
+		108, 3, 1044, 1046, 1, 0, 0, 0, 1045, 1041, 1, 0, 0, 0, 1046, 1049, 1,
+		0, 0, 0, 1047, 1045, 1, 0, 0, 0, 1047, 1048, 1, 0, 0, 0, 1048, 217, 1,
+		0, 0, 0, 1049, 1047, 1, 0, 0, 0, 1050, 1051, 7, 5, 0, 0, 1051, 219, 1,
+		0, 0, 0, 1052, 1053, 3, 222, 111, 0, 1053, 221, 1, 0, 0, 0, 1054, 1055,
+		3, 226, 113, 0, 1055, 1056, 3, 224, 112, 0, 1056, 1057, 3, 226, 113, 0,
+		1057, 223, 1, 0, 0, 0, 1058, 1067, 5, 149, 0, 0, 1059, 1067, 5, 150, 0,
+		0, 1060, 1067, 5, 151, 0, 0, 1061, 1067, 5, 154, 0, 0, 1062, 1067, 5, 155,
+		0, 0, 1063, 1067, 5, 152, 0, 0, 1064, 1067, 5, 153, 0, 0, 1065, 1067, 7,
+		8, 0, 0, 1066, 1058, 1, 0, 0, 0, 1066, 1059, 1, 0, 0, 0, 1066, 1060, 1,
+		0, 0, 0, 1066, 1061, 1, 0, 0, 0, 1066, 1062, 1, 0, 0, 0, 1066, 1063, 1,
+		0, 0, 0, 1066, 1064, 1, 0, 0, 0, 1066, 1065, 1, 0, 0, 0, 1067, 225, 1,
+		0, 0, 0, 1068, 1069, 6, 113, -1, 0, 1069, 1070, 5, 163, 0, 0, 1070, 1071,
+		3, 226, 113, 0, 1071, 1072, 5, 164, 0, 0, 1072, 1077, 1, 0, 0, 0, 1073,
+		1077, 3, 232, 116, 0, 1074, 1077, 3, 240, 120, 0, 1075, 1077, 3, 228, 114,
+		0, 1076, 1068, 1, 0, 0, 0, 1076, 1073, 1, 0, 0, 0, 1076, 1074, 1, 0, 0,
+		0, 1076, 1075, 1, 0, 0, 0, 1077, 1092, 1, 0, 0, 0, 1078, 1079, 10, 8, 0,
+		0, 1079, 1080, 5, 168, 0, 0, 1080, 1091, 3, 226, 113, 9, 1081, 1082, 10,
+		7, 0, 0, 1082, 1083, 5, 167, 0, 0, 1083, 1091, 3, 226, 113, 8, 1084, 1085,
+		10, 6, 0, 0, 1085, 1086, 5, 165, 0, 0, 1086, 1091, 3, 226, 113, 7, 1087,
+		1088, 10, 5, 0, 0, 1088, 1089, 5, 166, 0, 0, 1089, 1091, 3, 226, 113, 6,
+		1090, 1078, 1, 0, 0, 0, 1090, 1081, 1, 0, 0, 0, 1090, 1084, 1, 0, 0, 0,
+		1090, 1087, 1, 0, 0, 0, 1091, 1094, 1, 0, 0, 0, 1092, 1090, 1, 0, 0, 0,
+		1092, 1093, 1, 0, 0, 0, 1093, 227, 1, 0, 0, 0, 1094, 1092, 1, 0, 0, 0,
+		1095, 1096, 3, 254, 127, 0, 1096, 1097, 3, 230, 115, 0, 1097, 229, 1, 0,
+		0, 0, 1098, 1099, 7, 9, 0, 0, 1099, 231, 1, 0, 0, 0, 1100, 1101, 3, 234,
+		117, 0, 1101, 1103, 5, 163, 0, 0, 1102, 1104, 3, 236, 118, 0, 1103, 1102,
+		1, 0, 0, 0, 1103, 1104, 1, 0, 0, 0, 1104, 1105, 1, 0, 0, 0, 1105, 1106,
+		5, 164, 0, 0, 1106, 233, 1, 0, 0, 0, 1107, 1108, 7, 10, 0, 0, 1108, 235,
+		1, 0, 0, 0, 1109, 1114, 3, 238, 119, 0, 1110, 1111, 5, 158, 0, 0, 1111,
+		1113, 3, 238, 119, 0, 1112, 1110, 1, 0, 0, 0, 1113, 1116, 1, 0, 0, 0, 1114,
+		1112, 1, 0, 0, 0, 1114, 1115, 1, 0, 0, 0, 1115, 237, 1, 0, 0, 0, 1116,
+		1114, 1, 0, 0, 0, 1117, 1120, 3, 226, 113, 0, 1118, 1120, 3, 184, 92, 0,
+		1119, 1117, 1, 0, 0, 0, 1119, 1118, 1, 0, 0, 0, 1120, 239, 1, 0, 0, 0,
+		1121, 1123, 3, 266, 133, 0, 1122, 1124, 3, 242, 121, 0, 1123, 1122, 1,
+		0, 0, 0, 1123, 1124, 1, 0, 0, 0, 1124, 1128, 1, 0, 0, 0, 1125, 1128, 3,
+		256, 128, 0, 1126, 1128, 3, 254, 127, 0, 1127, 1121, 1, 0, 0, 0, 1127,
+		1125, 1, 0, 0, 0, 1127, 1126, 1, 0, 0, 0, 1128, 241, 1, 0, 0, 0, 1129,
+		1130, 5, 161, 0, 0, 1130, 1131, 3, 184, 92, 0, 1131, 1132, 5, 162, 0, 0,
+		1132, 243, 1, 0, 0, 0, 1133, 1134, 3, 252, 126, 0, 1134, 245, 1, 0, 0,
+		0, 1135, 1136, 5, 159, 0, 0, 1136, 1141, 3, 248, 124, 0, 1137, 1138, 5,
+		158, 0, 0, 1138, 1140, 3, 248, 124, 0, 1139, 1137, 1, 0, 0, 0, 1140, 1143,
+		1, 0, 0, 0, 1141, 1139, 1, 0, 0, 0, 1141, 1142, 1, 0, 0, 0, 1142, 1144,
+		1, 0, 0, 0, 1143, 1141, 1, 0, 0, 0, 1144, 1145, 5, 160, 0, 0, 1145, 1149,
+		1, 0, 0, 0, 1146, 1147, 5, 159, 0, 0, 1147, 1149, 5, 160, 0, 0, 1148, 1135,
+		1, 0, 0, 0, 1148, 1146, 1, 0, 0, 0, 1149, 247, 1, 0, 0, 0, 1150, 1151,
+		5, 4, 0, 0, 1151, 1152, 5, 147, 0, 0, 1152, 1153, 3, 252, 126, 0, 1153,
+		249, 1, 0, 0, 0, 1154, 1155, 5, 161, 0, 0, 1155, 1160, 3, 252, 126, 0,
+		1156, 1157, 5, 158, 0, 0, 1157, 1159, 3, 252, 126, 0, 1158, 1156, 1, 0,
+		0, 0, 1159, 1162, 1, 0, 0, 0, 1160, 1158, 1, 0, 0, 0, 1160, 1161, 1, 0,
+		0, 0, 1161, 1163, 1, 0, 0, 0, 1162, 1160, 1, 0, 0, 0, 1163, 1164, 5, 162,
+		0, 0, 1164, 1168, 1, 0, 0, 0, 1165, 1166, 5, 161, 0, 0, 1166, 1168, 5,
+		162, 0, 0, 1167, 1154, 1, 0, 0, 0, 1167, 1165, 1, 0, 0, 0, 1168, 251, 1,
+		0, 0, 0, 1169, 1178, 5, 4, 0, 0, 1170, 1178, 3, 254, 127, 0, 1171, 1178,
+		3, 256, 128, 0, 1172, 1178, 3, 246, 123, 0, 1173, 1178, 3, 250, 125, 0,
+		1174, 1178, 5, 1, 0, 0, 1175, 1178, 5, 2, 0, 0, 1176, 1178, 5, 3, 0, 0,
+		1177, 1169, 1, 0, 0, 0, 1177, 1170, 1, 0, 0, 0, 1177, 1171, 1, 0, 0, 0,
+		1177, 1172, 1, 0, 0, 0, 1177, 1173, 1, 0, 0, 0, 1177, 1174, 1, 0, 0, 0,
+		1177, 1175, 1, 0, 0, 0, 1177, 1176, 1, 0, 0, 0, 1178, 253, 1, 0, 0, 0,
+		1179, 1181, 7, 11, 0, 0, 1180, 1179, 1, 0, 0, 0, 1180, 1181, 1, 0, 0, 0,
+		1181, 1182, 1, 0, 0, 0, 1182, 1183, 5, 172, 0, 0, 1183, 255, 1, 0, 0, 0,
+		1184, 1186, 7, 11, 0, 0, 1185, 1184, 1, 0, 0, 0, 1185, 1186, 1, 0, 0, 0,
+		1186, 1187, 1, 0, 0, 0, 1187, 1188, 5, 173, 0, 0, 1188, 257, 1, 0, 0, 0,
+		1189, 1190, 5, 54, 0, 0, 1190, 1191, 5, 172, 0, 0, 1191, 259, 1, 0, 0,
+		0, 1192, 1193, 3, 266, 133, 0, 1193, 261, 1, 0, 0, 0, 1194, 1195, 3, 266,
+		133, 0, 1195, 263, 1, 0, 0, 0, 1196, 1197, 3, 266, 133, 0, 1197, 265, 1,
+		0, 0, 0, 1198, 1201, 5, 171, 0, 0, 1199, 1201, 3, 268, 134, 0, 1200, 1198,
+		1, 0, 0, 0, 1200, 1199, 1, 0, 0, 0, 1201, 1209, 1, 0, 0, 0, 1202, 1205,
+		5, 146, 0, 0, 1203, 1206, 5, 171, 0, 0, 1204, 1206, 3, 268, 134, 0, 1205,
+		1203, 1, 0, 0, 0, 1205, 1204, 1, 0, 0, 0, 1206, 1208, 1, 0, 0, 0, 1207,
+		1202, 1, 0, 0, 0, 1208, 1211, 1, 0, 0, 0, 1209, 1207, 1, 0, 0, 0, 1209,
+		1210, 1, 0, 0, 0, 1210, 267, 1, 0, 0, 0, 1211, 1209, 1, 0, 0, 0, 1212,
+		1213, 7, 12, 0, 0, 1213, 269, 1, 0, 0, 0, 93, 300, 303, 342, 391, 396,
+		407, 412, 420, 425, 439, 444, 478, 481, 487, 493, 496, 516, 519, 545, 552,
+		559, 578, 591, 608, 629, 651, 678, 680, 691, 693, 705, 709, 716, 728, 746,
+		755, 762, 764, 777, 783, 788, 796, 800, 804, 821, 825, 828, 831, 834, 837,
+		845, 855, 860, 881, 894, 896, 912, 920, 926, 933, 941, 955, 961, 967, 971,
+		976, 988, 991, 998, 1007, 1019, 1027, 1039, 1047, 1066, 1076, 1090, 1092,
+		1103, 1114, 1119, 1123, 1127, 1141, 1148, 1160, 1167, 1177, 1180, 1185,
+		1200, 1205, 1209,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -584,145 +804,233 @@ const (
 	SQLParserT_REQUESTS      = 83
 	SQLParserT_REQUEST       = 84
 	SQLParserT_ID            = 85
-	SQLParserT_SUM           = 86
-	SQLParserT_MIN           = 87
-	SQLParserT_MAX           = 88
-	SQLParserT_COUNT         = 89
-	SQLParserT_LAST          = 90
-	SQLParserT_FIRST         = 91
-	SQLParserT_AVG           = 92
-	SQLParserT_STDDEV        = 93
-	SQLParserT_QUANTILE      = 94
-	SQLParserT_RATE          = 95
-	SQLParserT_SECOND        = 96
-	SQLParserT_MINUTE        = 97
-	SQLParserT_HOUR          = 98
-	SQLParserT_DAY           = 99
-	SQLParserT_WEEK          = 100
-	SQLParserT_MONTH         = 101
-	SQLParserT_YEAR          = 102
-	SQLParserT_DOT           = 103
-	SQLParserT_COLON         = 104
-	SQLParserT_EQUAL         = 105
-	SQLParserT_NOTEQUAL      = 106
-	SQLParserT_NOTEQUAL2     = 107
-	SQLParserT_GREATER       = 108
-	SQLParserT_GREATEREQUAL  = 109
-	SQLParserT_LESS          = 110
-	SQLParserT_LESSEQUAL     = 111
-	SQLParserT_REGEXP        = 112
-	SQLParserT_NEQREGEXP     = 113
-	SQLParserT_COMMA         = 114
-	SQLParserT_OPEN_B        = 115
-	SQLParserT_CLOSE_B       = 116
-	SQLParserT_OPEN_SB       = 117
-	SQLParserT_CLOSE_SB      = 118
-	SQLParserT_OPEN_P        = 119
-	SQLParserT_CLOSE_P       = 120
-	SQLParserT_ADD           = 121
-	SQLParserT_SUB           = 122
-	SQLParserT_DIV           = 123
-	SQLParserT_MUL           = 124
-	SQLParserT_MOD           = 125
-	SQLParserT_UNDERLINE     = 126
-	SQLParserL_ID            = 127
-	SQLParserL_INT           = 128
-	SQLParserL_DEC           = 129
+	SQLParserT_ALTER         = 86
+	SQLParserT_BACKUP        = 87
+	SQLParserT_RESTORE       = 88
+	SQLParserT_TO            = 89
+	SQLParserT_COMPACT       = 90
+	SQLParserT_ROLLUP        = 91
+	SQLParserT_COMPACTION    = 92
+	SQLParserT_FAMILY        = 93
+	SQLParserT_SLOW          = 94
+	SQLParserT_RECORDING     = 95
+	SQLParserT_RULE          = 96
+	SQLParserT_RULES         = 97
+	SQLParserT_INTO          = 98
+	SQLParserT_EVERY         = 99
+	SQLParserT_ALERT         = 100
+	SQLParserT_ALERTS        = 101
+	SQLParserT_WHEN          = 102
+	SQLParserT_LABELS        = 103
+	SQLParserT_WEBHOOK       = 104
+	SQLParserT_FORMAT        = 105
+	SQLParserT_USER          = 106
+	SQLParserT_USERS         = 107
+	SQLParserT_PASSWORD      = 108
+	SQLParserT_TOKEN         = 109
+	SQLParserT_TOKENS        = 110
+	SQLParserT_ROLE          = 111
+	SQLParserT_ROLES         = 112
+	SQLParserT_GRANT         = 113
+	SQLParserT_REVOKE        = 114
+	SQLParserT_READ          = 115
+	SQLParserT_WRITE         = 116
+	SQLParserT_ALL           = 117
+	SQLParserT_TENANT        = 118
+	SQLParserT_TENANTS       = 119
+	SQLParserT_USAGE         = 120
+	SQLParserT_INGEST_RATE   = 121
+	SQLParserT_MAX_SERIES    = 122
+	SQLParserT_MAX_QUERIES   = 123
+	SQLParserT_MAX_DISK      = 124
+	SQLParserT_AUDIT         = 125
+	SQLParserT_REBALANCE     = 126
+	SQLParserT_DECOMMISSION  = 127
+	SQLParserT_REPLICA       = 128
+	SQLParserT_SUM           = 129
+	SQLParserT_MIN           = 130
+	SQLParserT_MAX           = 131
+	SQLParserT_COUNT         = 132
+	SQLParserT_LAST          = 133
+	SQLParserT_FIRST         = 134
+	SQLParserT_AVG           = 135
+	SQLParserT_STDDEV        = 136
+	SQLParserT_QUANTILE      = 137
+	SQLParserT_RATE          = 138
+	SQLParserT_SECOND        = 139
+	SQLParserT_MINUTE        = 140
+	SQLParserT_HOUR          = 141
+	SQLParserT_DAY           = 142
+	SQLParserT_WEEK          = 143
+	SQLParserT_MONTH         = 144
+	SQLParserT_YEAR          = 145
+	SQLParserT_DOT           = 146
+	SQLParserT_COLON         = 147
+	SQLParserT_SEMICOLON     = 148
+	SQLParserT_EQUAL         = 149
+	SQLParserT_NOTEQUAL      = 150
+	SQLParserT_NOTEQUAL2     = 151
+	SQLParserT_GREATER       = 152
+	SQLParserT_GREATEREQUAL  = 153
+	SQLParserT_LESS          = 154
+	SQLParserT_LESSEQUAL     = 155
+	SQLParserT_REGEXP        = 156
+	SQLParserT_NEQREGEXP     = 157
+	SQLParserT_COMMA         = 158
+	SQLParserT_OPEN_B        = 159
+	SQLParserT_CLOSE_B       = 160
+	SQLParserT_OPEN_SB       = 161
+	SQLParserT_CLOSE_SB      = 162
+	SQLParserT_OPEN_P        = 163
+	SQLParserT_CLOSE_P       = 164
+	SQLParserT_ADD           = 165
+	SQLParserT_SUB           = 166
+	SQLParserT_DIV           = 167
+	SQLParserT_MUL           = 168
+	SQLParserT_MOD           = 169
+	SQLParserT_UNDERLINE     = 170
+	SQLParserL_ID            = 171
+	SQLParserL_INT           = 172
+	SQLParserL_DEC           = 173
 )
 
 // SQLParser rules.
 const (
-	SQLParserRULE_statement              = 0
-	SQLParserRULE_useStmt                = 1
-	SQLParserRULE_showStmt               = 2
-	SQLParserRULE_showMasterStmt         = 3
-	SQLParserRULE_showRequestsStmt       = 4
-	SQLParserRULE_showRequestStmt        = 5
-	SQLParserRULE_showStoragesStmt       = 6
-	SQLParserRULE_showBrokersStmt        = 7
-	SQLParserRULE_showMetadataTypesStmt  = 8
-	SQLParserRULE_showBrokerMetaStmt     = 9
-	SQLParserRULE_showMasterMetaStmt     = 10
-	SQLParserRULE_showStorageMetaStmt    = 11
-	SQLParserRULE_showAliveStmt          = 12
-	SQLParserRULE_showReplicationStmt    = 13
-	SQLParserRULE_showMemoryDatabaseStmt = 14
-	SQLParserRULE_showBrokerMetricStmt   = 15
-	SQLParserRULE_showStorageMetricStmt  = 16
-	SQLParserRULE_createStorageStmt      = 17
-	SQLParserRULE_createBrokerStmt       = 18
-	SQLParserRULE_recoverStorageStmt     = 19
-	SQLParserRULE_showSchemasStmt        = 20
-	SQLParserRULE_createDatabaseStmt     = 21
-	SQLParserRULE_dropDatabaseStmt       = 22
-	SQLParserRULE_showDatabaseStmt       = 23
-	SQLParserRULE_showNameSpacesStmt     = 24
-	SQLParserRULE_showMetricsStmt        = 25
-	SQLParserRULE_showFieldsStmt         = 26
-	SQLParserRULE_showTagKeysStmt        = 27
-	SQLParserRULE_showTagValuesStmt      = 28
-	SQLParserRULE_prefix                 = 29
-	SQLParserRULE_withTagKey             = 30
-	SQLParserRULE_namespace              = 31
-	SQLParserRULE_databaseName           = 32
-	SQLParserRULE_storageName            = 33
-	SQLParserRULE_requestID              = 34
-	SQLParserRULE_source                 = 35
-	SQLParserRULE_queryStmt              = 36
-	SQLParserRULE_sourceAndSelect        = 37
-	SQLParserRULE_selectExpr             = 38
-	SQLParserRULE_fields                 = 39
-	SQLParserRULE_field                  = 40
-	SQLParserRULE_alias                  = 41
-	SQLParserRULE_storageFilter          = 42
-	SQLParserRULE_databaseFilter         = 43
-	SQLParserRULE_typeFilter             = 44
-	SQLParserRULE_fromClause             = 45
-	SQLParserRULE_whereClause            = 46
-	SQLParserRULE_conditionExpr          = 47
-	SQLParserRULE_tagFilterExpr          = 48
-	SQLParserRULE_tagValueList           = 49
-	SQLParserRULE_metricListFilter       = 50
-	SQLParserRULE_metricList             = 51
-	SQLParserRULE_timeRangeExpr          = 52
-	SQLParserRULE_timeExpr               = 53
-	SQLParserRULE_nowExpr                = 54
-	SQLParserRULE_nowFunc                = 55
-	SQLParserRULE_groupByClause          = 56
-	SQLParserRULE_groupByKeys            = 57
-	SQLParserRULE_groupByKey             = 58
-	SQLParserRULE_fillOption             = 59
-	SQLParserRULE_orderByClause          = 60
-	SQLParserRULE_sortField              = 61
-	SQLParserRULE_sortFields             = 62
-	SQLParserRULE_havingClause           = 63
-	SQLParserRULE_boolExpr               = 64
-	SQLParserRULE_boolExprLogicalOp      = 65
-	SQLParserRULE_boolExprAtom           = 66
-	SQLParserRULE_binaryExpr             = 67
-	SQLParserRULE_binaryOperator         = 68
-	SQLParserRULE_fieldExpr              = 69
-	SQLParserRULE_durationLit            = 70
-	SQLParserRULE_intervalItem           = 71
-	SQLParserRULE_exprFunc               = 72
-	SQLParserRULE_funcName               = 73
-	SQLParserRULE_exprFuncParams         = 74
-	SQLParserRULE_funcParam              = 75
-	SQLParserRULE_exprAtom               = 76
-	SQLParserRULE_identFilter            = 77
-	SQLParserRULE_json                   = 78
-	SQLParserRULE_obj                    = 79
-	SQLParserRULE_pair                   = 80
-	SQLParserRULE_arr                    = 81
-	SQLParserRULE_value                  = 82
-	SQLParserRULE_intNumber              = 83
-	SQLParserRULE_decNumber              = 84
-	SQLParserRULE_limitClause            = 85
-	SQLParserRULE_metricName             = 86
-	SQLParserRULE_tagKey                 = 87
-	SQLParserRULE_tagValue               = 88
-	SQLParserRULE_ident                  = 89
-	SQLParserRULE_nonReservedWords       = 90
+	SQLParserRULE_statement               = 0
+	SQLParserRULE_useStmt                 = 1
+	SQLParserRULE_showStmt                = 2
+	SQLParserRULE_showMasterStmt          = 3
+	SQLParserRULE_showRequestsStmt        = 4
+	SQLParserRULE_showRequestStmt         = 5
+	SQLParserRULE_showStoragesStmt        = 6
+	SQLParserRULE_showBrokersStmt         = 7
+	SQLParserRULE_showMetadataTypesStmt   = 8
+	SQLParserRULE_showBrokerMetaStmt      = 9
+	SQLParserRULE_showMasterMetaStmt      = 10
+	SQLParserRULE_showStorageMetaStmt     = 11
+	SQLParserRULE_showAliveStmt           = 12
+	SQLParserRULE_showReplicationStmt     = 13
+	SQLParserRULE_showMemoryDatabaseStmt  = 14
+	SQLParserRULE_showBrokerMetricStmt    = 15
+	SQLParserRULE_showStorageMetricStmt   = 16
+	SQLParserRULE_createStorageStmt       = 17
+	SQLParserRULE_createBrokerStmt        = 18
+	SQLParserRULE_recoverStorageStmt      = 19
+	SQLParserRULE_showSchemasStmt         = 20
+	SQLParserRULE_createDatabaseStmt      = 21
+	SQLParserRULE_dropDatabaseStmt        = 22
+	SQLParserRULE_showDatabaseStmt        = 23
+	SQLParserRULE_showNameSpacesStmt      = 24
+	SQLParserRULE_showMetricsStmt         = 25
+	SQLParserRULE_showFieldsStmt          = 26
+	SQLParserRULE_showTagKeysStmt         = 27
+	SQLParserRULE_showTagValuesStmt       = 28
+	SQLParserRULE_alterDatabaseStmt       = 29
+	SQLParserRULE_backupStmt              = 30
+	SQLParserRULE_restoreStmt             = 31
+	SQLParserRULE_compactStmt             = 32
+	SQLParserRULE_compactOption           = 33
+	SQLParserRULE_shardIDList             = 34
+	SQLParserRULE_showCompactionStmt      = 35
+	SQLParserRULE_showSlowRequestsStmt    = 36
+	SQLParserRULE_createRecordingRuleStmt = 37
+	SQLParserRULE_recordingRuleOption     = 38
+	SQLParserRULE_showRecordingRulesStmt  = 39
+	SQLParserRULE_dropRecordingRuleStmt   = 40
+	SQLParserRULE_createAlertStmt         = 41
+	SQLParserRULE_alertOption             = 42
+	SQLParserRULE_showAlertsStmt          = 43
+	SQLParserRULE_dropAlertStmt           = 44
+	SQLParserRULE_createUserStmt          = 45
+	SQLParserRULE_alterUserStmt           = 46
+	SQLParserRULE_dropUserStmt            = 47
+	SQLParserRULE_showUsersStmt           = 48
+	SQLParserRULE_createRoleStmt          = 49
+	SQLParserRULE_dropRoleStmt            = 50
+	SQLParserRULE_showRolesStmt           = 51
+	SQLParserRULE_grantStmt               = 52
+	SQLParserRULE_revokeStmt              = 53
+	SQLParserRULE_roleGrant               = 54
+	SQLParserRULE_privilegeGrant          = 55
+	SQLParserRULE_privilegeDatabase       = 56
+	SQLParserRULE_privilegeList           = 57
+	SQLParserRULE_privilege               = 58
+	SQLParserRULE_createTokenStmt         = 59
+	SQLParserRULE_dropTokenStmt           = 60
+	SQLParserRULE_showTokensStmt          = 61
+	SQLParserRULE_createTenantStmt        = 62
+	SQLParserRULE_alterTenantStmt         = 63
+	SQLParserRULE_tenantQuota             = 64
+	SQLParserRULE_dropTenantStmt          = 65
+	SQLParserRULE_showTenantsStmt         = 66
+	SQLParserRULE_showTenantUsageStmt     = 67
+	SQLParserRULE_showAuditLogStmt        = 68
+	SQLParserRULE_showRebalanceStmt       = 69
+	SQLParserRULE_decommissionStmt        = 70
+	SQLParserRULE_durationValue           = 71
+	SQLParserRULE_textValue               = 72
+	SQLParserRULE_prefix                  = 73
+	SQLParserRULE_withTagKey              = 74
+	SQLParserRULE_namespace               = 75
+	SQLParserRULE_databaseName            = 76
+	SQLParserRULE_storageName             = 77
+	SQLParserRULE_requestID               = 78
+	SQLParserRULE_source                  = 79
+	SQLParserRULE_queryStmt               = 80
+	SQLParserRULE_sourceAndSelect         = 81
+	SQLParserRULE_selectExpr              = 82
+	SQLParserRULE_fields                  = 83
+	SQLParserRULE_field                   = 84
+	SQLParserRULE_alias                   = 85
+	SQLParserRULE_storageFilter           = 86
+	SQLParserRULE_databaseFilter          = 87
+	SQLParserRULE_typeFilter              = 88
+	SQLParserRULE_fromClause              = 89
+	SQLParserRULE_whereClause             = 90
+	SQLParserRULE_conditionExpr           = 91
+	SQLParserRULE_tagFilterExpr           = 92
+	SQLParserRULE_tagValueList            = 93
+	SQLParserRULE_metricListFilter        = 94
+	SQLParserRULE_metricList              = 95
+	SQLParserRULE_timeRangeExpr           = 96
+	SQLParserRULE_timeExpr                = 97
+	SQLParserRULE_nowExpr                 = 98
+	SQLParserRULE_nowFunc                 = 99
+	SQLParserRULE_groupByClause           = 100
+	SQLParserRULE_groupByKeys             = 101
+	SQLParserRULE_groupByKey              = 102
+	SQLParserRULE_fillOption              = 103
+	SQLParserRULE_orderByClause           = 104
+	SQLParserRULE_sortField               = 105
+	SQLParserRULE_sortFields              = 106
+	SQLParserRULE_havingClause            = 107
+	SQLParserRULE_boolExpr                = 108
+	SQLParserRULE_boolExprLogicalOp       = 109
+	SQLParserRULE_boolExprAtom            = 110
+	SQLParserRULE_binaryExpr              = 111
+	SQLParserRULE_binaryOperator          = 112
+	SQLParserRULE_fieldExpr               = 113
+	SQLParserRULE_durationLit             = 114
+	SQLParserRULE_intervalItem            = 115
+	SQLParserRULE_exprFunc                = 116
+	SQLParserRULE_funcName                = 117
+	SQLParserRULE_exprFuncParams          = 118
+	SQLParserRULE_funcParam               = 119
+	SQLParserRULE_exprAtom                = 120
+	SQLParserRULE_identFilter             = 121
+	SQLParserRULE_json                    = 122
+	SQLParserRULE_obj                     = 123
+	SQLParserRULE_pair                    = 124
+	SQLParserRULE_arr                     = 125
+	SQLParserRULE_value                   = 126
+	SQLParserRULE_intNumber               = 127
+	SQLParserRULE_decNumber               = 128
+	SQLParserRULE_limitClause             = 129
+	SQLParserRULE_metricName              = 130
+	SQLParserRULE_tagKey                  = 131
+	SQLParserRULE_tagValue                = 132
+	SQLParserRULE_ident                   = 133
+	SQLParserRULE_nonReservedWords        = 134
 )
 
 // IStatementContext is an interface to support dynamic dispatch.
@@ -763,6 +1071,10 @@ func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, in
 
 func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *StatementContext) EOF() antlr.TerminalNode {
+	return s.GetToken(SQLParserEOF, 0)
+}
+
 func (s *StatementContext) ShowStmt() IShowStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -891,10 +1203,10 @@ func (s *StatementContext) DropDatabaseStmt() IDropDatabaseStmtContext {
 	return t.(IDropDatabaseStmtContext)
 }
 
-func (s *StatementContext) Ident() IIdentContext {
+func (s *StatementContext) AlterDatabaseStmt() IAlterDatabaseStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IAlterDatabaseStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -904,187 +1216,205 @@ func (s *StatementContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IAlterDatabaseStmtContext)
 }
 
-func (s *StatementContext) EOF() antlr.TerminalNode {
-	return s.GetToken(SQLParserEOF, 0)
-}
+func (s *StatementContext) BackupStmt() IBackupStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBackupStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *StatementContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return t.(IBackupStmtContext)
 }
 
-func (s *StatementContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterStatement(s)
+func (s *StatementContext) RestoreStmt() IRestoreStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRestoreStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *StatementContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitStatement(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(IRestoreStmtContext)
 }
 
-func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SQLVisitor:
-		return t.VisitStatement(s)
+func (s *StatementContext) CompactStmt() ICompactStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompactStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	default:
-		return t.VisitChildren(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(ICompactStmtContext)
 }
 
-func (p *SQLParser) Statement() (localctx IStatementContext) {
-	this := p
-	_ = this
+func (s *StatementContext) CreateRecordingRuleStmt() ICreateRecordingRuleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateRecordingRuleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 0, SQLParserRULE_statement)
+	if t == nil {
+		return nil
+	}
 
-	defer func() {
-		p.ExitRule()
-	}()
+	return t.(ICreateRecordingRuleStmtContext)
+}
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+func (s *StatementContext) DropRecordingRuleStmt() IDropRecordingRuleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropRecordingRuleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
-	}()
+	}
 
-	p.SetState(193)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(182)
-			p.ShowStmt()
-		}
+	if t == nil {
+		return nil
+	}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(183)
-			p.CreateStorageStmt()
-		}
+	return t.(IDropRecordingRuleStmtContext)
+}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(184)
-			p.CreateBrokerStmt()
+func (s *StatementContext) CreateAlertStmt() ICreateAlertStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateAlertStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(185)
-			p.RecoverStorageStmt()
-		}
+	if t == nil {
+		return nil
+	}
 
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(186)
-			p.UseStmt()
-		}
+	return t.(ICreateAlertStmtContext)
+}
 
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(187)
-			p.QueryStmt()
+func (s *StatementContext) DropAlertStmt() IDropAlertStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropAlertStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
 
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(188)
-			p.CreateDatabaseStmt()
-		}
+	if t == nil {
+		return nil
+	}
 
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(189)
-			p.DropDatabaseStmt()
-		}
+	return t.(IDropAlertStmtContext)
+}
 
-	case 9:
-		p.EnterOuterAlt(localctx, 9)
-		{
-			p.SetState(190)
-			p.Ident()
-		}
-		{
-			p.SetState(191)
-			p.Match(SQLParserEOF)
+func (s *StatementContext) CreateUserStmt() ICreateUserStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
 
+	if t == nil {
+		return nil
 	}
 
-	return localctx
+	return t.(ICreateUserStmtContext)
 }
 
-// IUseStmtContext is an interface to support dynamic dispatch.
-type IUseStmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+func (s *StatementContext) AlterUserStmt() IAlterUserStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlterUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	// IsUseStmtContext differentiates from other interfaces.
-	IsUseStmtContext()
-}
+	if t == nil {
+		return nil
+	}
 
-type UseStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+	return t.(IAlterUserStmtContext)
 }
 
-func NewEmptyUseStmtContext() *UseStmtContext {
-	var p = new(UseStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_useStmt
-	return p
-}
+func (s *StatementContext) DropUserStmt() IDropUserStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (*UseStmtContext) IsUseStmtContext() {}
+	if t == nil {
+		return nil
+	}
 
-func NewUseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UseStmtContext {
-	var p = new(UseStmtContext)
+	return t.(IDropUserStmtContext)
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *StatementContext) CreateRoleStmt() ICreateRoleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateRoleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_useStmt
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(ICreateRoleStmtContext)
 }
 
-func (s *UseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *StatementContext) DropRoleStmt() IDropRoleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropRoleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *UseStmtContext) T_USE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_USE, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropRoleStmtContext)
 }
 
-func (s *UseStmtContext) Ident() IIdentContext {
+func (s *StatementContext) GrantStmt() IGrantStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IGrantStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1094,117 +1424,29 @@ func (s *UseStmtContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
-}
-
-func (s *UseStmtContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IGrantStmtContext)
 }
 
-func (s *UseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *StatementContext) RevokeStmt() IRevokeStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRevokeStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *UseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterUseStmt(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(IRevokeStmtContext)
 }
 
-func (s *UseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitUseStmt(s)
-	}
-}
-
-func (s *UseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SQLVisitor:
-		return t.VisitUseStmt(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
-	this := p
-	_ = this
-
-	localctx = NewUseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, SQLParserRULE_useStmt)
-
-	defer func() {
-		p.ExitRule()
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(195)
-		p.Match(SQLParserT_USE)
-	}
-	{
-		p.SetState(196)
-		p.Ident()
-	}
-
-	return localctx
-}
-
-// IShowStmtContext is an interface to support dynamic dispatch.
-type IShowStmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsShowStmtContext differentiates from other interfaces.
-	IsShowStmtContext()
-}
-
-type ShowStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyShowStmtContext() *ShowStmtContext {
-	var p = new(ShowStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStmt
-	return p
-}
-
-func (*ShowStmtContext) IsShowStmtContext() {}
-
-func NewShowStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStmtContext {
-	var p = new(ShowStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStmt
-
-	return p
-}
-
-func (s *ShowStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowStmtContext) ShowMasterStmt() IShowMasterStmtContext {
+func (s *StatementContext) CreateTokenStmt() ICreateTokenStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMasterStmtContext); ok {
+		if _, ok := ctx.(ICreateTokenStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1214,13 +1456,13 @@ func (s *ShowStmtContext) ShowMasterStmt() IShowMasterStmtContext {
 		return nil
 	}
 
-	return t.(IShowMasterStmtContext)
+	return t.(ICreateTokenStmtContext)
 }
 
-func (s *ShowStmtContext) ShowMetadataTypesStmt() IShowMetadataTypesStmtContext {
+func (s *StatementContext) DropTokenStmt() IDropTokenStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMetadataTypesStmtContext); ok {
+		if _, ok := ctx.(IDropTokenStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1230,13 +1472,13 @@ func (s *ShowStmtContext) ShowMetadataTypesStmt() IShowMetadataTypesStmtContext
 		return nil
 	}
 
-	return t.(IShowMetadataTypesStmtContext)
+	return t.(IDropTokenStmtContext)
 }
 
-func (s *ShowStmtContext) ShowBrokerMetaStmt() IShowBrokerMetaStmtContext {
+func (s *StatementContext) CreateTenantStmt() ICreateTenantStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowBrokerMetaStmtContext); ok {
+		if _, ok := ctx.(ICreateTenantStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1246,13 +1488,13 @@ func (s *ShowStmtContext) ShowBrokerMetaStmt() IShowBrokerMetaStmtContext {
 		return nil
 	}
 
-	return t.(IShowBrokerMetaStmtContext)
+	return t.(ICreateTenantStmtContext)
 }
 
-func (s *ShowStmtContext) ShowMasterMetaStmt() IShowMasterMetaStmtContext {
+func (s *StatementContext) AlterTenantStmt() IAlterTenantStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMasterMetaStmtContext); ok {
+		if _, ok := ctx.(IAlterTenantStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1262,13 +1504,13 @@ func (s *ShowStmtContext) ShowMasterMetaStmt() IShowMasterMetaStmtContext {
 		return nil
 	}
 
-	return t.(IShowMasterMetaStmtContext)
+	return t.(IAlterTenantStmtContext)
 }
 
-func (s *ShowStmtContext) ShowStorageMetaStmt() IShowStorageMetaStmtContext {
+func (s *StatementContext) DropTenantStmt() IDropTenantStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowStorageMetaStmtContext); ok {
+		if _, ok := ctx.(IDropTenantStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1278,13 +1520,13 @@ func (s *ShowStmtContext) ShowStorageMetaStmt() IShowStorageMetaStmtContext {
 		return nil
 	}
 
-	return t.(IShowStorageMetaStmtContext)
+	return t.(IDropTenantStmtContext)
 }
 
-func (s *ShowStmtContext) ShowStoragesStmt() IShowStoragesStmtContext {
+func (s *StatementContext) DecommissionStmt() IDecommissionStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowStoragesStmtContext); ok {
+		if _, ok := ctx.(IDecommissionStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1294,13 +1536,13 @@ func (s *ShowStmtContext) ShowStoragesStmt() IShowStoragesStmtContext {
 		return nil
 	}
 
-	return t.(IShowStoragesStmtContext)
+	return t.(IDecommissionStmtContext)
 }
 
-func (s *ShowStmtContext) ShowBrokersStmt() IShowBrokersStmtContext {
+func (s *StatementContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowBrokersStmtContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1310,125 +1552,7585 @@ func (s *ShowStmtContext) ShowBrokersStmt() IShowBrokersStmtContext {
 		return nil
 	}
 
-	return t.(IShowBrokersStmtContext)
+	return t.(IIdentContext)
 }
 
-func (s *ShowStmtContext) ShowAliveStmt() IShowAliveStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowAliveStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *StatementContext) T_SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SEMICOLON, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IShowAliveStmtContext)
+func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStmtContext) ShowBrokerMetricStmt() IShowBrokerMetricStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowBrokerMetricStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *StatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterStatement(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *StatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitStatement(s)
 	}
-
-	return t.(IShowBrokerMetricStmtContext)
 }
 
-func (s *ShowStmtContext) ShowStorageMetricStmt() IShowStorageMetricStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowStorageMetricStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitStatement(s)
 
-	if t == nil {
-		return nil
+	default:
+		return t.VisitChildren(s)
 	}
-
-	return t.(IShowStorageMetricStmtContext)
 }
 
-func (s *ShowStmtContext) ShowReplicationStmt() IShowReplicationStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowReplicationStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (p *SQLParser) Statement() (localctx IStatementContext) {
+	this := p
+	_ = this
 
-	if t == nil {
-		return nil
-	}
+	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 0, SQLParserRULE_statement)
+	var _la int
 
-	return t.(IShowReplicationStmtContext)
-}
+	defer func() {
+		p.ExitRule()
+	}()
 
-func (s *ShowStmtContext) ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMemoryDatabaseStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
-	}
+	}()
 
-	if t == nil {
-		return nil
-	}
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(300)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(270)
+			p.ShowStmt()
+		}
 
-	return t.(IShowMemoryDatabaseStmtContext)
-}
+	case 2:
+		{
+			p.SetState(271)
+			p.CreateStorageStmt()
+		}
 
-func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowSchemasStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	case 3:
+		{
+			p.SetState(272)
+			p.CreateBrokerStmt()
 		}
-	}
 
-	if t == nil {
-		return nil
-	}
+	case 4:
+		{
+			p.SetState(273)
+			p.RecoverStorageStmt()
+		}
 
-	return t.(IShowSchemasStmtContext)
-}
+	case 5:
+		{
+			p.SetState(274)
+			p.UseStmt()
+		}
 
-func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowDatabaseStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	case 6:
+		{
+			p.SetState(275)
+			p.QueryStmt()
 		}
-	}
 
-	if t == nil {
-		return nil
+	case 7:
+		{
+			p.SetState(276)
+			p.CreateDatabaseStmt()
+		}
+
+	case 8:
+		{
+			p.SetState(277)
+			p.DropDatabaseStmt()
+		}
+
+	case 9:
+		{
+			p.SetState(278)
+			p.AlterDatabaseStmt()
+		}
+
+	case 10:
+		{
+			p.SetState(279)
+			p.BackupStmt()
+		}
+
+	case 11:
+		{
+			p.SetState(280)
+			p.RestoreStmt()
+		}
+
+	case 12:
+		{
+			p.SetState(281)
+			p.CompactStmt()
+		}
+
+	case 13:
+		{
+			p.SetState(282)
+			p.CreateRecordingRuleStmt()
+		}
+
+	case 14:
+		{
+			p.SetState(283)
+			p.DropRecordingRuleStmt()
+		}
+
+	case 15:
+		{
+			p.SetState(284)
+			p.CreateAlertStmt()
+		}
+
+	case 16:
+		{
+			p.SetState(285)
+			p.DropAlertStmt()
+		}
+
+	case 17:
+		{
+			p.SetState(286)
+			p.CreateUserStmt()
+		}
+
+	case 18:
+		{
+			p.SetState(287)
+			p.AlterUserStmt()
+		}
+
+	case 19:
+		{
+			p.SetState(288)
+			p.DropUserStmt()
+		}
+
+	case 20:
+		{
+			p.SetState(289)
+			p.CreateRoleStmt()
+		}
+
+	case 21:
+		{
+			p.SetState(290)
+			p.DropRoleStmt()
+		}
+
+	case 22:
+		{
+			p.SetState(291)
+			p.GrantStmt()
+		}
+
+	case 23:
+		{
+			p.SetState(292)
+			p.RevokeStmt()
+		}
+
+	case 24:
+		{
+			p.SetState(293)
+			p.CreateTokenStmt()
+		}
+
+	case 25:
+		{
+			p.SetState(294)
+			p.DropTokenStmt()
+		}
+
+	case 26:
+		{
+			p.SetState(295)
+			p.CreateTenantStmt()
+		}
+
+	case 27:
+		{
+			p.SetState(296)
+			p.AlterTenantStmt()
+		}
+
+	case 28:
+		{
+			p.SetState(297)
+			p.DropTenantStmt()
+		}
+
+	case 29:
+		{
+			p.SetState(298)
+			p.DecommissionStmt()
+		}
+
+	case 30:
+		{
+			p.SetState(299)
+			p.Ident()
+		}
+
+	}
+	p.SetState(303)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_SEMICOLON {
+		{
+			p.SetState(302)
+			p.Match(SQLParserT_SEMICOLON)
+		}
+
+	}
+	{
+		p.SetState(305)
+		p.Match(SQLParserEOF)
+	}
+
+	return localctx
+}
+
+// IUseStmtContext is an interface to support dynamic dispatch.
+type IUseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUseStmtContext differentiates from other interfaces.
+	IsUseStmtContext()
+}
+
+type UseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUseStmtContext() *UseStmtContext {
+	var p = new(UseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_useStmt
+	return p
+}
+
+func (*UseStmtContext) IsUseStmtContext() {}
+
+func NewUseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UseStmtContext {
+	var p = new(UseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_useStmt
+
+	return p
+}
+
+func (s *UseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UseStmtContext) T_USE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USE, 0)
+}
+
+func (s *UseStmtContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *UseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterUseStmt(s)
+	}
+}
+
+func (s *UseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitUseStmt(s)
+	}
+}
+
+func (s *UseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitUseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewUseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, SQLParserRULE_useStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(307)
+		p.Match(SQLParserT_USE)
+	}
+	{
+		p.SetState(308)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IShowStmtContext is an interface to support dynamic dispatch.
+type IShowStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowStmtContext differentiates from other interfaces.
+	IsShowStmtContext()
+}
+
+type ShowStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStmtContext() *ShowStmtContext {
+	var p = new(ShowStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStmt
+	return p
+}
+
+func (*ShowStmtContext) IsShowStmtContext() {}
+
+func NewShowStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStmtContext {
+	var p = new(ShowStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStmt
+
+	return p
+}
+
+func (s *ShowStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStmtContext) ShowMasterStmt() IShowMasterStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMasterStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMasterStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMetadataTypesStmt() IShowMetadataTypesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMetadataTypesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMetadataTypesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowBrokerMetaStmt() IShowBrokerMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowBrokerMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowBrokerMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMasterMetaStmt() IShowMasterMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMasterMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMasterMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowStorageMetaStmt() IShowStorageMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowStorageMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowStorageMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowStoragesStmt() IShowStoragesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowStoragesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowStoragesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowBrokersStmt() IShowBrokersStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowBrokersStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowBrokersStmtContext)
+}
+
+func (s *ShowStmtContext) ShowAliveStmt() IShowAliveStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowAliveStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowAliveStmtContext)
+}
+
+func (s *ShowStmtContext) ShowBrokerMetricStmt() IShowBrokerMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowBrokerMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowBrokerMetricStmtContext)
+}
+
+func (s *ShowStmtContext) ShowStorageMetricStmt() IShowStorageMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowStorageMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowStorageMetricStmtContext)
+}
+
+func (s *ShowStmtContext) ShowReplicationStmt() IShowReplicationStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowReplicationStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowReplicationStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMemoryDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMemoryDatabaseStmtContext)
+}
+
+func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowSchemasStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowSchemasStmtContext)
+}
+
+func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowDatabaseStmtContext)
+}
+
+func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowNameSpacesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowNameSpacesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMetricsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMetricsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowFieldsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowFieldsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTagKeysStmt() IShowTagKeysStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTagKeysStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagKeysStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTagValuesStmt() IShowTagValuesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTagValuesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagValuesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRequestsStmt() IShowRequestsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRequestsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRequestsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRequestStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRequestStmtContext)
+}
+
+func (s *ShowStmtContext) ShowSlowRequestsStmt() IShowSlowRequestsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowSlowRequestsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowSlowRequestsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowCompactionStmt() IShowCompactionStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowCompactionStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowCompactionStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRecordingRulesStmt() IShowRecordingRulesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRecordingRulesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRecordingRulesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowAlertsStmt() IShowAlertsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowAlertsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowAlertsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowUsersStmt() IShowUsersStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowUsersStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowUsersStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRolesStmt() IShowRolesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRolesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRolesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTokensStmt() IShowTokensStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTokensStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTokensStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTenantsStmt() IShowTenantsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTenantsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTenantsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTenantUsageStmt() IShowTenantUsageStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTenantUsageStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTenantUsageStmtContext)
+}
+
+func (s *ShowStmtContext) ShowAuditLogStmt() IShowAuditLogStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowAuditLogStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowAuditLogStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRebalanceStmt() IShowRebalanceStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRebalanceStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRebalanceStmtContext)
+}
+
+func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStmt(s)
+	}
+}
+
+func (s *ShowStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStmt(s)
+	}
+}
+
+func (s *ShowStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, SQLParserRULE_showStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(342)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(310)
+			p.ShowMasterStmt()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(311)
+			p.ShowMetadataTypesStmt()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(312)
+			p.ShowBrokerMetaStmt()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(313)
+			p.ShowMasterMetaStmt()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(314)
+			p.ShowStorageMetaStmt()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(315)
+			p.ShowStoragesStmt()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(316)
+			p.ShowBrokersStmt()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(317)
+			p.ShowAliveStmt()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(318)
+			p.ShowBrokerMetricStmt()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(319)
+			p.ShowStorageMetricStmt()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(320)
+			p.ShowReplicationStmt()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(321)
+			p.ShowMemoryDatabaseStmt()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(322)
+			p.ShowSchemasStmt()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(323)
+			p.ShowDatabaseStmt()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(324)
+			p.ShowNameSpacesStmt()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(325)
+			p.ShowMetricsStmt()
+		}
+
+	case 17:
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(326)
+			p.ShowFieldsStmt()
+		}
+
+	case 18:
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(327)
+			p.ShowTagKeysStmt()
+		}
+
+	case 19:
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(328)
+			p.ShowTagValuesStmt()
+		}
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(329)
+			p.ShowRequestsStmt()
+		}
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(330)
+			p.ShowRequestStmt()
+		}
+
+	case 22:
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(331)
+			p.ShowSlowRequestsStmt()
+		}
+
+	case 23:
+		p.EnterOuterAlt(localctx, 23)
+		{
+			p.SetState(332)
+			p.ShowCompactionStmt()
+		}
+
+	case 24:
+		p.EnterOuterAlt(localctx, 24)
+		{
+			p.SetState(333)
+			p.ShowRecordingRulesStmt()
+		}
+
+	case 25:
+		p.EnterOuterAlt(localctx, 25)
+		{
+			p.SetState(334)
+			p.ShowAlertsStmt()
+		}
+
+	case 26:
+		p.EnterOuterAlt(localctx, 26)
+		{
+			p.SetState(335)
+			p.ShowUsersStmt()
+		}
+
+	case 27:
+		p.EnterOuterAlt(localctx, 27)
+		{
+			p.SetState(336)
+			p.ShowRolesStmt()
+		}
+
+	case 28:
+		p.EnterOuterAlt(localctx, 28)
+		{
+			p.SetState(337)
+			p.ShowTokensStmt()
+		}
+
+	case 29:
+		p.EnterOuterAlt(localctx, 29)
+		{
+			p.SetState(338)
+			p.ShowTenantsStmt()
+		}
+
+	case 30:
+		p.EnterOuterAlt(localctx, 30)
+		{
+			p.SetState(339)
+			p.ShowTenantUsageStmt()
+		}
+
+	case 31:
+		p.EnterOuterAlt(localctx, 31)
+		{
+			p.SetState(340)
+			p.ShowAuditLogStmt()
+		}
+
+	case 32:
+		p.EnterOuterAlt(localctx, 32)
+		{
+			p.SetState(341)
+			p.ShowRebalanceStmt()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowMasterStmtContext is an interface to support dynamic dispatch.
+type IShowMasterStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMasterStmtContext differentiates from other interfaces.
+	IsShowMasterStmtContext()
+}
+
+type ShowMasterStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMasterStmtContext() *ShowMasterStmtContext {
+	var p = new(ShowMasterStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMasterStmt
+	return p
+}
+
+func (*ShowMasterStmtContext) IsShowMasterStmtContext() {}
+
+func NewShowMasterStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterStmtContext {
+	var p = new(ShowMasterStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMasterStmt
+
+	return p
+}
+
+func (s *ShowMasterStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMasterStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMasterStmtContext) T_MASTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MASTER, 0)
+}
+
+func (s *ShowMasterStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMasterStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMasterStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMasterStmt(s)
+	}
+}
+
+func (s *ShowMasterStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMasterStmt(s)
+	}
+}
+
+func (s *ShowMasterStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMasterStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMasterStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, SQLParserRULE_showMasterStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(344)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(345)
+		p.Match(SQLParserT_MASTER)
+	}
+
+	return localctx
+}
+
+// IShowRequestsStmtContext is an interface to support dynamic dispatch.
+type IShowRequestsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowRequestsStmtContext differentiates from other interfaces.
+	IsShowRequestsStmtContext()
+}
+
+type ShowRequestsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRequestsStmtContext() *ShowRequestsStmtContext {
+	var p = new(ShowRequestsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRequestsStmt
+	return p
+}
+
+func (*ShowRequestsStmtContext) IsShowRequestsStmtContext() {}
+
+func NewShowRequestsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestsStmtContext {
+	var p = new(ShowRequestsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRequestsStmt
+
+	return p
+}
+
+func (s *ShowRequestsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRequestsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRequestsStmtContext) T_REQUESTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REQUESTS, 0)
+}
+
+func (s *ShowRequestsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRequestsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRequestsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRequestsStmt(s)
+	}
+}
+
+func (s *ShowRequestsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRequestsStmt(s)
+	}
+}
+
+func (s *ShowRequestsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRequestsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowRequestsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, SQLParserRULE_showRequestsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(347)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(348)
+		p.Match(SQLParserT_REQUESTS)
+	}
+
+	return localctx
+}
+
+// IShowRequestStmtContext is an interface to support dynamic dispatch.
+type IShowRequestStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowRequestStmtContext differentiates from other interfaces.
+	IsShowRequestStmtContext()
+}
+
+type ShowRequestStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRequestStmtContext() *ShowRequestStmtContext {
+	var p = new(ShowRequestStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRequestStmt
+	return p
+}
+
+func (*ShowRequestStmtContext) IsShowRequestStmtContext() {}
+
+func NewShowRequestStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestStmtContext {
+	var p = new(ShowRequestStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRequestStmt
+
+	return p
+}
+
+func (s *ShowRequestStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRequestStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRequestStmtContext) T_REQUEST() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REQUEST, 0)
+}
+
+func (s *ShowRequestStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowRequestStmtContext) T_ID() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ID, 0)
+}
+
+func (s *ShowRequestStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowRequestStmtContext) RequestID() IRequestIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRequestIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRequestIDContext)
+}
+
+func (s *ShowRequestStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRequestStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRequestStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRequestStmt(s)
+	}
+}
+
+func (s *ShowRequestStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRequestStmt(s)
+	}
+}
+
+func (s *ShowRequestStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRequestStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowRequestStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, SQLParserRULE_showRequestStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(350)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(351)
+		p.Match(SQLParserT_REQUEST)
+	}
+	{
+		p.SetState(352)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(353)
+		p.Match(SQLParserT_ID)
+	}
+	{
+		p.SetState(354)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(355)
+		p.RequestID()
+	}
+
+	return localctx
+}
+
+// IShowStoragesStmtContext is an interface to support dynamic dispatch.
+type IShowStoragesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowStoragesStmtContext differentiates from other interfaces.
+	IsShowStoragesStmtContext()
+}
+
+type ShowStoragesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStoragesStmtContext() *ShowStoragesStmtContext {
+	var p = new(ShowStoragesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	return p
+}
+
+func (*ShowStoragesStmtContext) IsShowStoragesStmtContext() {}
+
+func NewShowStoragesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStoragesStmtContext {
+	var p = new(ShowStoragesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStoragesStmt
+
+	return p
+}
+
+func (s *ShowStoragesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStoragesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowStoragesStmtContext) T_STORAGES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGES, 0)
+}
+
+func (s *ShowStoragesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStoragesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStoragesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStoragesStmt(s)
+	}
+}
+
+func (s *ShowStoragesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStoragesStmt(s)
+	}
+}
+
+func (s *ShowStoragesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStoragesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStoragesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, SQLParserRULE_showStoragesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(357)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(358)
+		p.Match(SQLParserT_STORAGES)
+	}
+
+	return localctx
+}
+
+// IShowBrokersStmtContext is an interface to support dynamic dispatch.
+type IShowBrokersStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowBrokersStmtContext differentiates from other interfaces.
+	IsShowBrokersStmtContext()
+}
+
+type ShowBrokersStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowBrokersStmtContext() *ShowBrokersStmtContext {
+	var p = new(ShowBrokersStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokersStmt
+	return p
+}
+
+func (*ShowBrokersStmtContext) IsShowBrokersStmtContext() {}
+
+func NewShowBrokersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokersStmtContext {
+	var p = new(ShowBrokersStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokersStmt
+
+	return p
+}
+
+func (s *ShowBrokersStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokersStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowBrokersStmtContext) T_BROKERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKERS, 0)
+}
+
+func (s *ShowBrokersStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowBrokersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowBrokersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowBrokersStmt(s)
+	}
+}
+
+func (s *ShowBrokersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowBrokersStmt(s)
+	}
+}
+
+func (s *ShowBrokersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowBrokersStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowBrokersStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, SQLParserRULE_showBrokersStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(360)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(361)
+		p.Match(SQLParserT_BROKERS)
+	}
+
+	return localctx
+}
+
+// IShowMetadataTypesStmtContext is an interface to support dynamic dispatch.
+type IShowMetadataTypesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMetadataTypesStmtContext differentiates from other interfaces.
+	IsShowMetadataTypesStmtContext()
+}
+
+type ShowMetadataTypesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMetadataTypesStmtContext() *ShowMetadataTypesStmtContext {
+	var p = new(ShowMetadataTypesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+	return p
+}
+
+func (*ShowMetadataTypesStmtContext) IsShowMetadataTypesStmtContext() {}
+
+func NewShowMetadataTypesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetadataTypesStmtContext {
+	var p = new(ShowMetadataTypesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+
+	return p
+}
+
+func (s *ShowMetadataTypesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMetadataTypesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetadataTypesStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowMetadataTypesStmtContext) T_TYPES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TYPES, 0)
+}
+
+func (s *ShowMetadataTypesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMetadataTypesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMetadataTypesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMetadataTypesStmt(s)
+	}
+}
+
+func (s *ShowMetadataTypesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMetadataTypesStmt(s)
+	}
+}
+
+func (s *ShowMetadataTypesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMetadataTypesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMetadataTypesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, SQLParserRULE_showMetadataTypesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(363)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(364)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(365)
+		p.Match(SQLParserT_TYPES)
+	}
+
+	return localctx
+}
+
+// IShowBrokerMetaStmtContext is an interface to support dynamic dispatch.
+type IShowBrokerMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowBrokerMetaStmtContext differentiates from other interfaces.
+	IsShowBrokerMetaStmtContext()
+}
+
+type ShowBrokerMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowBrokerMetaStmtContext() *ShowBrokerMetaStmtContext {
+	var p = new(ShowBrokerMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+	return p
+}
+
+func (*ShowBrokerMetaStmtContext) IsShowBrokerMetaStmtContext() {}
+
+func NewShowBrokerMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetaStmtContext {
+	var p = new(ShowBrokerMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+
+	return p
+}
+
+func (s *ShowBrokerMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokerMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) TypeFilter() ITypeFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowBrokerMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowBrokerMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowBrokerMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowBrokerMetaStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowBrokerMetaStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowBrokerMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowBrokerMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, SQLParserRULE_showBrokerMetaStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(367)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(368)
+		p.Match(SQLParserT_BROKER)
+	}
+	{
+		p.SetState(369)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(370)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(371)
+		p.Source()
+	}
+	{
+		p.SetState(372)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(373)
+		p.TypeFilter()
+	}
+
+	return localctx
+}
+
+// IShowMasterMetaStmtContext is an interface to support dynamic dispatch.
+type IShowMasterMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMasterMetaStmtContext differentiates from other interfaces.
+	IsShowMasterMetaStmtContext()
+}
+
+type ShowMasterMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMasterMetaStmtContext() *ShowMasterMetaStmtContext {
+	var p = new(ShowMasterMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+	return p
+}
+
+func (*ShowMasterMetaStmtContext) IsShowMasterMetaStmtContext() {}
+
+func NewShowMasterMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterMetaStmtContext {
+	var p = new(ShowMasterMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+
+	return p
+}
+
+func (s *ShowMasterMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMasterMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) T_MASTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MASTER, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowMasterMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) TypeFilter() ITypeFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowMasterMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMasterMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMasterMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMasterMetaStmt(s)
+	}
+}
+
+func (s *ShowMasterMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMasterMetaStmt(s)
+	}
+}
+
+func (s *ShowMasterMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMasterMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMasterMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SQLParserRULE_showMasterMetaStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(375)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(376)
+		p.Match(SQLParserT_MASTER)
+	}
+	{
+		p.SetState(377)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(378)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(379)
+		p.Source()
+	}
+	{
+		p.SetState(380)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(381)
+		p.TypeFilter()
+	}
+
+	return localctx
+}
+
+// IShowStorageMetaStmtContext is an interface to support dynamic dispatch.
+type IShowStorageMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowStorageMetaStmtContext differentiates from other interfaces.
+	IsShowStorageMetaStmtContext()
+}
+
+type ShowStorageMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStorageMetaStmtContext() *ShowStorageMetaStmtContext {
+	var p = new(ShowStorageMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
+	return p
+}
+
+func (*ShowStorageMetaStmtContext) IsShowStorageMetaStmtContext() {}
+
+func NewShowStorageMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetaStmtContext {
+	var p = new(ShowStorageMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
+
+	return p
+}
+
+func (s *ShowStorageMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStorageMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowStorageMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetaStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowStorageMetaStmtContext) AllTypeFilter() []ITypeFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeFilterContext); ok {
+			tst[i] = t.(ITypeFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetaStmtContext) TypeFilter(i int) ITypeFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowStorageMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStorageMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStorageMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStorageMetaStmt(s)
+	}
+}
+
+func (s *ShowStorageMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStorageMetaStmt(s)
+	}
+}
+
+func (s *ShowStorageMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStorageMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStorageMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SQLParserRULE_showStorageMetaStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(383)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(384)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(385)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(386)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(387)
+		p.Source()
+	}
+	{
+		p.SetState(388)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(391)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(389)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_TYPE:
+		{
+			p.SetState(390)
+			p.TypeFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(393)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(396)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(394)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_TYPE:
+		{
+			p.SetState(395)
+			p.TypeFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowAliveStmtContext is an interface to support dynamic dispatch.
+type IShowAliveStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowAliveStmtContext differentiates from other interfaces.
+	IsShowAliveStmtContext()
+}
+
+type ShowAliveStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowAliveStmtContext() *ShowAliveStmtContext {
+	var p = new(ShowAliveStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showAliveStmt
+	return p
+}
+
+func (*ShowAliveStmtContext) IsShowAliveStmtContext() {}
+
+func NewShowAliveStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAliveStmtContext {
+	var p = new(ShowAliveStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showAliveStmt
+
+	return p
+}
+
+func (s *ShowAliveStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowAliveStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowAliveStmtContext) T_ALIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALIVE, 0)
+}
+
+func (s *ShowAliveStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *ShowAliveStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *ShowAliveStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowAliveStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowAliveStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowAliveStmt(s)
+	}
+}
+
+func (s *ShowAliveStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowAliveStmt(s)
+	}
+}
+
+func (s *ShowAliveStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowAliveStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowAliveStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SQLParserRULE_showAliveStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(398)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(399)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SQLParserT_STORAGE || _la == SQLParserT_BROKER) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(400)
+		p.Match(SQLParserT_ALIVE)
+	}
+
+	return localctx
+}
+
+// IShowReplicationStmtContext is an interface to support dynamic dispatch.
+type IShowReplicationStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowReplicationStmtContext differentiates from other interfaces.
+	IsShowReplicationStmtContext()
+}
+
+type ShowReplicationStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowReplicationStmtContext() *ShowReplicationStmtContext {
+	var p = new(ShowReplicationStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showReplicationStmt
+	return p
+}
+
+func (*ShowReplicationStmtContext) IsShowReplicationStmtContext() {}
+
+func NewShowReplicationStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReplicationStmtContext {
+	var p = new(ShowReplicationStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showReplicationStmt
+
+	return p
+}
+
+func (s *ShowReplicationStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowReplicationStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowReplicationStmtContext) T_REPLICATION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICATION, 0)
+}
+
+func (s *ShowReplicationStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowReplicationStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowReplicationStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowReplicationStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowReplicationStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDatabaseFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDatabaseFilterContext); ok {
+			tst[i] = t.(IDatabaseFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowReplicationStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseFilterContext)
+}
+
+func (s *ShowReplicationStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowReplicationStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowReplicationStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowReplicationStmt(s)
+	}
+}
+
+func (s *ShowReplicationStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowReplicationStmt(s)
+	}
+}
+
+func (s *ShowReplicationStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowReplicationStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowReplicationStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, SQLParserRULE_showReplicationStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(402)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(403)
+		p.Match(SQLParserT_REPLICATION)
+	}
+	{
+		p.SetState(404)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(407)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(405)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(406)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(409)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(412)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(410)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(411)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowMemoryDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowMemoryDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMemoryDatabaseStmtContext differentiates from other interfaces.
+	IsShowMemoryDatabaseStmtContext()
+}
+
+type ShowMemoryDatabaseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMemoryDatabaseStmtContext() *ShowMemoryDatabaseStmtContext {
+	var p = new(ShowMemoryDatabaseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+	return p
+}
+
+func (*ShowMemoryDatabaseStmtContext) IsShowMemoryDatabaseStmtContext() {}
+
+func NewShowMemoryDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMemoryDatabaseStmtContext {
+	var p = new(ShowMemoryDatabaseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+
+	return p
+}
+
+func (s *ShowMemoryDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMemoryDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_MEMORY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MEMORY, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowMemoryDatabaseStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDatabaseFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDatabaseFilterContext); ok {
+			tst[i] = t.(IDatabaseFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowMemoryDatabaseStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseFilterContext)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMemoryDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMemoryDatabaseStmt(s)
+	}
+}
+
+func (s *ShowMemoryDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMemoryDatabaseStmt(s)
+	}
+}
+
+func (s *ShowMemoryDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMemoryDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMemoryDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SQLParserRULE_showMemoryDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(414)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(415)
+		p.Match(SQLParserT_MEMORY)
+	}
+	{
+		p.SetState(416)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(417)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(420)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(418)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(419)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(422)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(425)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(423)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(424)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
+type IShowBrokerMetricStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
+	IsShowBrokerMetricStmtContext()
+}
+
+type ShowBrokerMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	return p
+}
+
+func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
+
+func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+
+	return p
+}
+
+func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricListFilterContext)
+}
+
+func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowBrokerMetricStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowBrokerMetricStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowBrokerMetricStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, SQLParserRULE_showBrokerMetricStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(427)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(428)
+		p.Match(SQLParserT_BROKER)
+	}
+	{
+		p.SetState(429)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(430)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(431)
+		p.MetricListFilter()
+	}
+
+	return localctx
+}
+
+// IShowStorageMetricStmtContext is an interface to support dynamic dispatch.
+type IShowStorageMetricStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowStorageMetricStmtContext differentiates from other interfaces.
+	IsShowStorageMetricStmtContext()
+}
+
+type ShowStorageMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStorageMetricStmtContext() *ShowStorageMetricStmtContext {
+	var p = new(ShowStorageMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	return p
+}
+
+func (*ShowStorageMetricStmtContext) IsShowStorageMetricStmtContext() {}
+
+func NewShowStorageMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetricStmtContext {
+	var p = new(ShowStorageMetricStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+
+	return p
+}
+
+func (s *ShowStorageMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStorageMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowStorageMetricStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *ShowStorageMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowStorageMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowStorageMetricStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMetricListFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricListFilterContext); ok {
+			tst[i] = t.(IMetricListFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricListFilterContext)
+}
+
+func (s *ShowStorageMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStorageMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStorageMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStorageMetricStmt(s)
+	}
+}
+
+func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStorageMetricStmt(s)
+	}
+}
+
+func (s *ShowStorageMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStorageMetricStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SQLParserRULE_showStorageMetricStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(433)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(434)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(435)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(436)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(439)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(437)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_METRIC:
+		{
+			p.SetState(438)
+			p.MetricListFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(441)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(444)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(442)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_METRIC:
+		{
+			p.SetState(443)
+			p.MetricListFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ICreateStorageStmtContext is an interface to support dynamic dispatch.
+type ICreateStorageStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateStorageStmtContext differentiates from other interfaces.
+	IsCreateStorageStmtContext()
+}
+
+type CreateStorageStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createStorageStmt
+	return p
+}
+
+func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
+
+func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createStorageStmt
+
+	return p
+}
+
+func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *CreateStorageStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateStorageStmt(s)
+	}
+}
+
+func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateStorageStmt(s)
+	}
+}
+
+func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCreateStorageStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SQLParserRULE_createStorageStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(446)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(447)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(448)
+		p.Json()
+	}
+
+	return localctx
+}
+
+// ICreateBrokerStmtContext is an interface to support dynamic dispatch.
+type ICreateBrokerStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateBrokerStmtContext differentiates from other interfaces.
+	IsCreateBrokerStmtContext()
+}
+
+type CreateBrokerStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateBrokerStmtContext() *CreateBrokerStmtContext {
+	var p = new(CreateBrokerStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	return p
+}
+
+func (*CreateBrokerStmtContext) IsCreateBrokerStmtContext() {}
+
+func NewCreateBrokerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateBrokerStmtContext {
+	var p = new(CreateBrokerStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createBrokerStmt
+
+	return p
+}
+
+func (s *CreateBrokerStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateBrokerStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateBrokerStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *CreateBrokerStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateBrokerStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateBrokerStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateBrokerStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateBrokerStmt(s)
+	}
+}
+
+func (s *CreateBrokerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateBrokerStmt(s)
+	}
+}
+
+func (s *CreateBrokerStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCreateBrokerStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCreateBrokerStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, SQLParserRULE_createBrokerStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(450)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(451)
+		p.Match(SQLParserT_BROKER)
+	}
+	{
+		p.SetState(452)
+		p.Json()
+	}
+
+	return localctx
+}
+
+// IRecoverStorageStmtContext is an interface to support dynamic dispatch.
+type IRecoverStorageStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRecoverStorageStmtContext differentiates from other interfaces.
+	IsRecoverStorageStmtContext()
+}
+
+type RecoverStorageStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRecoverStorageStmtContext() *RecoverStorageStmtContext {
+	var p = new(RecoverStorageStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	return p
+}
+
+func (*RecoverStorageStmtContext) IsRecoverStorageStmtContext() {}
+
+func NewRecoverStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecoverStorageStmtContext {
+	var p = new(RecoverStorageStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+
+	return p
+}
+
+func (s *RecoverStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RecoverStorageStmtContext) T_RECOVER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECOVER, 0)
+}
+
+func (s *RecoverStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageNameContext)
+}
+
+func (s *RecoverStorageStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RecoverStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RecoverStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRecoverStorageStmt(s)
+	}
+}
+
+func (s *RecoverStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRecoverStorageStmt(s)
+	}
+}
+
+func (s *RecoverStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRecoverStorageStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewRecoverStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SQLParserRULE_recoverStorageStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(454)
+		p.Match(SQLParserT_RECOVER)
+	}
+	{
+		p.SetState(455)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(456)
+		p.StorageName()
+	}
+
+	return localctx
+}
+
+// IShowSchemasStmtContext is an interface to support dynamic dispatch.
+type IShowSchemasStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowSchemasStmtContext differentiates from other interfaces.
+	IsShowSchemasStmtContext()
+}
+
+type ShowSchemasStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	return p
+}
+
+func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
+
+func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
+
+	return p
+}
+
+func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SCHEMAS, 0)
+}
+
+func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowSchemasStmt(s)
+	}
+}
+
+func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowSchemasStmt(s)
+	}
+}
+
+func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowSchemasStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SQLParserRULE_showSchemasStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(458)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(459)
+		p.Match(SQLParserT_SCHEMAS)
+	}
+
+	return localctx
+}
+
+// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
+type ICreateDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateDatabaseStmtContext differentiates from other interfaces.
+	IsCreateDatabaseStmtContext()
+}
+
+type CreateDatabaseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	return p
+}
+
+func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
+
+func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+
+	return p
+}
+
+func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateDatabaseStmt(s)
+	}
+}
+
+func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateDatabaseStmt(s)
+	}
+}
+
+func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCreateDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SQLParserRULE_createDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(461)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(462)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(463)
+		p.Json()
+	}
+
+	return localctx
+}
+
+// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
+type IDropDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDropDatabaseStmtContext differentiates from other interfaces.
+	IsDropDatabaseStmtContext()
+}
+
+type DropDatabaseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	return p
+}
+
+func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
+
+func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+
+	return p
+}
+
+func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDropDatabaseStmt(s)
+	}
+}
+
+func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDropDatabaseStmt(s)
+	}
+}
+
+func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDropDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SQLParserRULE_dropDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(465)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(466)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(467)
+		p.DatabaseName()
+	}
+
+	return localctx
+}
+
+// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowDatabaseStmtContext differentiates from other interfaces.
+	IsShowDatabaseStmtContext()
+}
+
+type ShowDatabaseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	return p
+}
+
+func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
+
+func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+
+	return p
+}
+
+func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAES, 0)
+}
+
+func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowDatabaseStmt(s)
+	}
+}
+
+func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowDatabaseStmt(s)
+	}
+}
+
+func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SQLParserRULE_showDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(469)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(470)
+		p.Match(SQLParserT_DATASBAES)
+	}
+
+	return localctx
+}
+
+// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
+type IShowNameSpacesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowNameSpacesStmtContext differentiates from other interfaces.
+	IsShowNameSpacesStmtContext()
+}
+
+type ShowNameSpacesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	return p
+}
+
+func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+
+func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+
+	return p
+}
+
+func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACES, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowNameSpacesStmt(s)
+	}
+}
+
+func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowNameSpacesStmt(s)
+	}
+}
+
+func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowNameSpacesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SQLParserRULE_showNameSpacesStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(472)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(473)
+		p.Match(SQLParserT_NAMESPACES)
+	}
+	p.SetState(478)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(474)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(475)
+			p.Match(SQLParserT_NAMESPACE)
+		}
+		{
+			p.SetState(476)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(477)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(481)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(480)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowMetricsStmtContext is an interface to support dynamic dispatch.
+type IShowMetricsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMetricsStmtContext differentiates from other interfaces.
+	IsShowMetricsStmtContext()
+}
+
+type ShowMetricsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	return p
+}
+
+func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+
+func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+
+	return p
+}
+
+func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRICS, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMetricsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SQLParserRULE_showMetricsStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(483)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(484)
+		p.Match(SQLParserT_METRICS)
+	}
+	p.SetState(487)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(485)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(486)
+			p.Namespace()
+		}
+
+	}
+	p.SetState(493)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(489)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(490)
+			p.Match(SQLParserT_METRIC)
+		}
+		{
+			p.SetState(491)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(492)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(496)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(495)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowFieldsStmtContext is an interface to support dynamic dispatch.
+type IShowFieldsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowFieldsStmtContext differentiates from other interfaces.
+	IsShowFieldsStmtContext()
+}
+
+type ShowFieldsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowFieldsStmtContext() *ShowFieldsStmtContext {
+	var p = new(ShowFieldsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	return p
+}
+
+func (*ShowFieldsStmtContext) IsShowFieldsStmtContext() {}
+
+func NewShowFieldsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldsStmtContext {
+	var p = new(ShowFieldsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showFieldsStmt
+
+	return p
+}
+
+func (s *ShowFieldsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowFieldsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowFieldsStmtContext) T_FIELDS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIELDS, 0)
+}
+
+func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowFieldsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowFieldsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowFieldsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowFieldsStmt(s)
+	}
+}
+
+func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowFieldsStmt(s)
+	}
+}
+
+func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowFieldsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SQLParserRULE_showFieldsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(498)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(499)
+		p.Match(SQLParserT_FIELDS)
+	}
+	{
+		p.SetState(500)
+		p.FromClause()
+	}
+
+	return localctx
+}
+
+// IShowTagKeysStmtContext is an interface to support dynamic dispatch.
+type IShowTagKeysStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowTagKeysStmtContext differentiates from other interfaces.
+	IsShowTagKeysStmtContext()
+}
+
+type ShowTagKeysStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowTagKeysStmtContext() *ShowTagKeysStmtContext {
+	var p = new(ShowTagKeysStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	return p
+}
+
+func (*ShowTagKeysStmtContext) IsShowTagKeysStmtContext() {}
+
+func NewShowTagKeysStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeysStmtContext {
+	var p = new(ShowTagKeysStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+
+	return p
+}
+
+func (s *ShowTagKeysStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowTagKeysStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagKeysStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagKeysStmtContext) T_KEYS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEYS, 0)
+}
+
+func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowTagKeysStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowTagKeysStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowTagKeysStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowTagKeysStmt(s)
+	}
+}
+
+func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowTagKeysStmt(s)
+	}
+}
+
+func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowTagKeysStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SQLParserRULE_showTagKeysStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(502)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(503)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(504)
+		p.Match(SQLParserT_KEYS)
+	}
+	{
+		p.SetState(505)
+		p.FromClause()
+	}
+
+	return localctx
+}
+
+// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
+type IShowTagValuesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowTagValuesStmtContext differentiates from other interfaces.
+	IsShowTagValuesStmtContext()
+}
+
+type ShowTagValuesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	return p
+}
+
+func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
+
+func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+
+	return p
+}
+
+func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VALUES, 0)
+}
+
+func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEY, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWithTagKeyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithTagKeyContext)
+}
+
+func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowTagValuesStmt(s)
+	}
+}
+
+func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowTagValuesStmt(s)
+	}
+}
+
+func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowTagValuesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SQLParserRULE_showTagValuesStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(507)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(508)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(509)
+		p.Match(SQLParserT_VALUES)
+	}
+	{
+		p.SetState(510)
+		p.FromClause()
+	}
+	{
+		p.SetState(511)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(512)
+		p.Match(SQLParserT_KEY)
+	}
+	{
+		p.SetState(513)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(514)
+		p.WithTagKey()
+	}
+	p.SetState(516)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(515)
+			p.WhereClause()
+		}
+
+	}
+	p.SetState(519)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(518)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// IAlterDatabaseStmtContext is an interface to support dynamic dispatch.
+type IAlterDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAlterDatabaseStmtContext differentiates from other interfaces.
+	IsAlterDatabaseStmtContext()
+}
+
+type AlterDatabaseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAlterDatabaseStmtContext() *AlterDatabaseStmtContext {
+	var p = new(AlterDatabaseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_alterDatabaseStmt
+	return p
+}
+
+func (*AlterDatabaseStmtContext) IsAlterDatabaseStmtContext() {}
+
+func NewAlterDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterDatabaseStmtContext {
+	var p = new(AlterDatabaseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alterDatabaseStmt
+
+	return p
+}
+
+func (s *AlterDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AlterDatabaseStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
+}
+
+func (s *AlterDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *AlterDatabaseStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *AlterDatabaseStmtContext) T_REPLICA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICA, 0)
+}
+
+func (s *AlterDatabaseStmtContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *AlterDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AlterDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AlterDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterAlterDatabaseStmt(s)
+	}
+}
+
+func (s *AlterDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitAlterDatabaseStmt(s)
+	}
+}
+
+func (s *AlterDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitAlterDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) AlterDatabaseStmt() (localctx IAlterDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewAlterDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SQLParserRULE_alterDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(521)
+		p.Match(SQLParserT_ALTER)
+	}
+	{
+		p.SetState(522)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(523)
+		p.TextValue()
+	}
+	{
+		p.SetState(524)
+		p.Match(SQLParserT_REPLICA)
+	}
+	{
+		p.SetState(525)
+		p.Match(SQLParserL_INT)
+	}
+
+	return localctx
+}
+
+// IBackupStmtContext is an interface to support dynamic dispatch.
+type IBackupStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBackupStmtContext differentiates from other interfaces.
+	IsBackupStmtContext()
+}
+
+type BackupStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBackupStmtContext() *BackupStmtContext {
+	var p = new(BackupStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_backupStmt
+	return p
+}
+
+func (*BackupStmtContext) IsBackupStmtContext() {}
+
+func NewBackupStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BackupStmtContext {
+	var p = new(BackupStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_backupStmt
+
+	return p
+}
+
+func (s *BackupStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BackupStmtContext) T_BACKUP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BACKUP, 0)
+}
+
+func (s *BackupStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *BackupStmtContext) AllTextValue() []ITextValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITextValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITextValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITextValueContext); ok {
+			tst[i] = t.(ITextValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BackupStmtContext) TextValue(i int) ITextValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *BackupStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *BackupStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BackupStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BackupStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterBackupStmt(s)
+	}
+}
+
+func (s *BackupStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitBackupStmt(s)
+	}
+}
+
+func (s *BackupStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitBackupStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) BackupStmt() (localctx IBackupStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewBackupStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SQLParserRULE_backupStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(527)
+		p.Match(SQLParserT_BACKUP)
+	}
+	{
+		p.SetState(528)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(529)
+		p.TextValue()
+	}
+	{
+		p.SetState(530)
+		p.Match(SQLParserT_TO)
+	}
+	{
+		p.SetState(531)
+		p.TextValue()
+	}
+
+	return localctx
+}
+
+// IRestoreStmtContext is an interface to support dynamic dispatch.
+type IRestoreStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRestoreStmtContext differentiates from other interfaces.
+	IsRestoreStmtContext()
+}
+
+type RestoreStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRestoreStmtContext() *RestoreStmtContext {
+	var p = new(RestoreStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_restoreStmt
+	return p
+}
+
+func (*RestoreStmtContext) IsRestoreStmtContext() {}
+
+func NewRestoreStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RestoreStmtContext {
+	var p = new(RestoreStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_restoreStmt
+
+	return p
+}
+
+func (s *RestoreStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RestoreStmtContext) T_RESTORE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RESTORE, 0)
+}
+
+func (s *RestoreStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *RestoreStmtContext) AllTextValue() []ITextValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITextValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITextValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITextValueContext); ok {
+			tst[i] = t.(ITextValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RestoreStmtContext) TextValue(i int) ITextValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *RestoreStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RestoreStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RestoreStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RestoreStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRestoreStmt(s)
+	}
+}
+
+func (s *RestoreStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRestoreStmt(s)
+	}
+}
+
+func (s *RestoreStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRestoreStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RestoreStmt() (localctx IRestoreStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewRestoreStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SQLParserRULE_restoreStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(533)
+		p.Match(SQLParserT_RESTORE)
+	}
+	{
+		p.SetState(534)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(535)
+		p.TextValue()
+	}
+	{
+		p.SetState(536)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(537)
+		p.TextValue()
+	}
+
+	return localctx
+}
+
+// ICompactStmtContext is an interface to support dynamic dispatch.
+type ICompactStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompactStmtContext differentiates from other interfaces.
+	IsCompactStmtContext()
+}
+
+type CompactStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompactStmtContext() *CompactStmtContext {
+	var p = new(CompactStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_compactStmt
+	return p
+}
+
+func (*CompactStmtContext) IsCompactStmtContext() {}
+
+func NewCompactStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompactStmtContext {
+	var p = new(CompactStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_compactStmt
+
+	return p
+}
+
+func (s *CompactStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompactStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *CompactStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *CompactStmtContext) T_COMPACT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMPACT, 0)
+}
+
+func (s *CompactStmtContext) T_ROLLUP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLLUP, 0)
+}
+
+func (s *CompactStmtContext) AllCompactOption() []ICompactOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICompactOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICompactOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICompactOptionContext); ok {
+			tst[i] = t.(ICompactOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CompactStmtContext) CompactOption(i int) ICompactOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompactOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompactOptionContext)
+}
+
+func (s *CompactStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompactStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompactStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCompactStmt(s)
+	}
+}
+
+func (s *CompactStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCompactStmt(s)
+	}
+}
+
+func (s *CompactStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCompactStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CompactStmt() (localctx ICompactStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompactStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SQLParserRULE_compactStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(539)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SQLParserT_COMPACT || _la == SQLParserT_ROLLUP) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(540)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(541)
+		p.TextValue()
+	}
+	p.SetState(545)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SQLParserT_SHARD || _la == SQLParserT_FAMILY {
+		{
+			p.SetState(542)
+			p.CompactOption()
+		}
+
+		p.SetState(547)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ICompactOptionContext is an interface to support dynamic dispatch.
+type ICompactOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompactOptionContext differentiates from other interfaces.
+	IsCompactOptionContext()
+}
+
+type CompactOptionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompactOptionContext() *CompactOptionContext {
+	var p = new(CompactOptionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_compactOption
+	return p
+}
+
+func (*CompactOptionContext) IsCompactOptionContext() {}
+
+func NewCompactOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompactOptionContext {
+	var p = new(CompactOptionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_compactOption
+
+	return p
+}
+
+func (s *CompactOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompactOptionContext) T_SHARD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHARD, 0)
+}
+
+func (s *CompactOptionContext) ShardIDList() IShardIDListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShardIDListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShardIDListContext)
+}
+
+func (s *CompactOptionContext) T_FAMILY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FAMILY, 0)
+}
+
+func (s *CompactOptionContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *CompactOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompactOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompactOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCompactOption(s)
+	}
+}
+
+func (s *CompactOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCompactOption(s)
+	}
+}
+
+func (s *CompactOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCompactOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CompactOption() (localctx ICompactOptionContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompactOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SQLParserRULE_compactOption)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(552)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_SHARD:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(548)
+			p.Match(SQLParserT_SHARD)
+		}
+		{
+			p.SetState(549)
+			p.ShardIDList()
+		}
+
+	case SQLParserT_FAMILY:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(550)
+			p.Match(SQLParserT_FAMILY)
+		}
+		{
+			p.SetState(551)
+			p.TextValue()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShardIDListContext is an interface to support dynamic dispatch.
+type IShardIDListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShardIDListContext differentiates from other interfaces.
+	IsShardIDListContext()
+}
+
+type ShardIDListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShardIDListContext() *ShardIDListContext {
+	var p = new(ShardIDListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_shardIDList
+	return p
+}
+
+func (*ShardIDListContext) IsShardIDListContext() {}
+
+func NewShardIDListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShardIDListContext {
+	var p = new(ShardIDListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_shardIDList
+
+	return p
+}
+
+func (s *ShardIDListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShardIDListContext) AllL_INT() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserL_INT)
+}
+
+func (s *ShardIDListContext) L_INT(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, i)
+}
+
+func (s *ShardIDListContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *ShardIDListContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *ShardIDListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShardIDListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShardIDListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShardIDList(s)
+	}
+}
+
+func (s *ShardIDListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShardIDList(s)
+	}
+}
+
+func (s *ShardIDListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShardIDList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShardIDList() (localctx IShardIDListContext) {
+	this := p
+	_ = this
+
+	localctx = NewShardIDListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SQLParserRULE_shardIDList)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(554)
+		p.Match(SQLParserL_INT)
+	}
+	p.SetState(559)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SQLParserT_COMMA {
+		{
+			p.SetState(555)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(556)
+			p.Match(SQLParserL_INT)
+		}
+
+		p.SetState(561)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IShowCompactionStmtContext is an interface to support dynamic dispatch.
+type IShowCompactionStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowCompactionStmtContext differentiates from other interfaces.
+	IsShowCompactionStmtContext()
+}
+
+type ShowCompactionStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowCompactionStmtContext() *ShowCompactionStmtContext {
+	var p = new(ShowCompactionStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showCompactionStmt
+	return p
+}
+
+func (*ShowCompactionStmtContext) IsShowCompactionStmtContext() {}
+
+func NewShowCompactionStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowCompactionStmtContext {
+	var p = new(ShowCompactionStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showCompactionStmt
+
+	return p
+}
+
+func (s *ShowCompactionStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowCompactionStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowCompactionStmtContext) T_COMPACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMPACTION, 0)
+}
+
+func (s *ShowCompactionStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *ShowCompactionStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *ShowCompactionStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowCompactionStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowCompactionStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowCompactionStmt(s)
+	}
+}
+
+func (s *ShowCompactionStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowCompactionStmt(s)
+	}
+}
+
+func (s *ShowCompactionStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowCompactionStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowCompactionStmt() (localctx IShowCompactionStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowCompactionStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SQLParserRULE_showCompactionStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(562)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(563)
+		p.Match(SQLParserT_COMPACTION)
+	}
+	{
+		p.SetState(564)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(565)
+		p.TextValue()
+	}
+
+	return localctx
+}
+
+// IShowSlowRequestsStmtContext is an interface to support dynamic dispatch.
+type IShowSlowRequestsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowSlowRequestsStmtContext differentiates from other interfaces.
+	IsShowSlowRequestsStmtContext()
+}
+
+type ShowSlowRequestsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowSlowRequestsStmtContext() *ShowSlowRequestsStmtContext {
+	var p = new(ShowSlowRequestsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showSlowRequestsStmt
+	return p
+}
+
+func (*ShowSlowRequestsStmtContext) IsShowSlowRequestsStmtContext() {}
+
+func NewShowSlowRequestsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSlowRequestsStmtContext {
+	var p = new(ShowSlowRequestsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showSlowRequestsStmt
+
+	return p
+}
+
+func (s *ShowSlowRequestsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowSlowRequestsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowSlowRequestsStmtContext) T_SLOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SLOW, 0)
+}
+
+func (s *ShowSlowRequestsStmtContext) T_REQUESTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REQUESTS, 0)
+}
+
+func (s *ShowSlowRequestsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowSlowRequestsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowSlowRequestsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowSlowRequestsStmt(s)
+	}
+}
+
+func (s *ShowSlowRequestsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowSlowRequestsStmt(s)
+	}
+}
+
+func (s *ShowSlowRequestsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowSlowRequestsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowSlowRequestsStmt() (localctx IShowSlowRequestsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowSlowRequestsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SQLParserRULE_showSlowRequestsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(567)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(568)
+		p.Match(SQLParserT_SLOW)
+	}
+	{
+		p.SetState(569)
+		p.Match(SQLParserT_REQUESTS)
+	}
+
+	return localctx
+}
+
+// ICreateRecordingRuleStmtContext is an interface to support dynamic dispatch.
+type ICreateRecordingRuleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateRecordingRuleStmtContext differentiates from other interfaces.
+	IsCreateRecordingRuleStmtContext()
+}
+
+type CreateRecordingRuleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateRecordingRuleStmtContext() *CreateRecordingRuleStmtContext {
+	var p = new(CreateRecordingRuleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createRecordingRuleStmt
+	return p
+}
+
+func (*CreateRecordingRuleStmtContext) IsCreateRecordingRuleStmtContext() {}
+
+func NewCreateRecordingRuleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateRecordingRuleStmtContext {
+	var p = new(CreateRecordingRuleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createRecordingRuleStmt
+
+	return p
+}
+
+func (s *CreateRecordingRuleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateRecordingRuleStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateRecordingRuleStmtContext) T_RECORDING() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECORDING, 0)
+}
+
+func (s *CreateRecordingRuleStmtContext) T_RULE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RULE, 0)
+}
+
+func (s *CreateRecordingRuleStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *CreateRecordingRuleStmtContext) AllRecordingRuleOption() []IRecordingRuleOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IRecordingRuleOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IRecordingRuleOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IRecordingRuleOptionContext); ok {
+			tst[i] = t.(IRecordingRuleOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateRecordingRuleStmtContext) RecordingRuleOption(i int) IRecordingRuleOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRecordingRuleOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRecordingRuleOptionContext)
+}
+
+func (s *CreateRecordingRuleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateRecordingRuleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateRecordingRuleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateRecordingRuleStmt(s)
+	}
+}
+
+func (s *CreateRecordingRuleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateRecordingRuleStmt(s)
+	}
+}
+
+func (s *CreateRecordingRuleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCreateRecordingRuleStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CreateRecordingRuleStmt() (localctx ICreateRecordingRuleStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCreateRecordingRuleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SQLParserRULE_createRecordingRuleStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(571)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(572)
+		p.Match(SQLParserT_RECORDING)
+	}
+	{
+		p.SetState(573)
+		p.Match(SQLParserT_RULE)
+	}
+	{
+		p.SetState(574)
+		p.TextValue()
+	}
+	p.SetState(578)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1152921779485802496) != 0 || _la == SQLParserT_INTO || _la == SQLParserT_EVERY {
+		{
+			p.SetState(575)
+			p.RecordingRuleOption()
+		}
+
+		p.SetState(580)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IRecordingRuleOptionContext is an interface to support dynamic dispatch.
+type IRecordingRuleOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRecordingRuleOptionContext differentiates from other interfaces.
+	IsRecordingRuleOptionContext()
+}
+
+type RecordingRuleOptionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRecordingRuleOptionContext() *RecordingRuleOptionContext {
+	var p = new(RecordingRuleOptionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_recordingRuleOption
+	return p
+}
+
+func (*RecordingRuleOptionContext) IsRecordingRuleOptionContext() {}
+
+func NewRecordingRuleOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecordingRuleOptionContext {
+	var p = new(RecordingRuleOptionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_recordingRuleOption
+
+	return p
+}
+
+func (s *RecordingRuleOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RecordingRuleOptionContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *RecordingRuleOptionContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *RecordingRuleOptionContext) T_INTO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTO, 0)
+}
+
+func (s *RecordingRuleOptionContext) T_EVERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EVERY, 0)
+}
+
+func (s *RecordingRuleOptionContext) DurationValue() IDurationValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationValueContext)
+}
+
+func (s *RecordingRuleOptionContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *RecordingRuleOptionContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *RecordingRuleOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RecordingRuleOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RecordingRuleOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRecordingRuleOption(s)
+	}
+}
+
+func (s *RecordingRuleOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRecordingRuleOption(s)
+	}
+}
+
+func (s *RecordingRuleOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRecordingRuleOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RecordingRuleOption() (localctx IRecordingRuleOptionContext) {
+	this := p
+	_ = this
+
+	localctx = NewRecordingRuleOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SQLParserRULE_recordingRuleOption)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(591)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_ON:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(581)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(582)
+			p.TextValue()
+		}
+
+	case SQLParserT_INTO:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(583)
+			p.Match(SQLParserT_INTO)
+		}
+		{
+			p.SetState(584)
+			p.TextValue()
+		}
+
+	case SQLParserT_EVERY:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(585)
+			p.Match(SQLParserT_EVERY)
+		}
+		{
+			p.SetState(586)
+			p.DurationValue()
+		}
+
+	case SQLParserT_NAMESPACE:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(587)
+			p.Match(SQLParserT_NAMESPACE)
+		}
+		{
+			p.SetState(588)
+			p.TextValue()
+		}
+
+	case SQLParserT_AS:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(589)
+			p.Match(SQLParserT_AS)
+		}
+		{
+			p.SetState(590)
+			p.TextValue()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowRecordingRulesStmtContext is an interface to support dynamic dispatch.
+type IShowRecordingRulesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowRecordingRulesStmtContext differentiates from other interfaces.
+	IsShowRecordingRulesStmtContext()
+}
+
+type ShowRecordingRulesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRecordingRulesStmtContext() *ShowRecordingRulesStmtContext {
+	var p = new(ShowRecordingRulesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRecordingRulesStmt
+	return p
+}
+
+func (*ShowRecordingRulesStmtContext) IsShowRecordingRulesStmtContext() {}
+
+func NewShowRecordingRulesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRecordingRulesStmtContext {
+	var p = new(ShowRecordingRulesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRecordingRulesStmt
+
+	return p
+}
+
+func (s *ShowRecordingRulesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRecordingRulesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRecordingRulesStmtContext) T_RECORDING() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECORDING, 0)
+}
+
+func (s *ShowRecordingRulesStmtContext) T_RULES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RULES, 0)
+}
+
+func (s *ShowRecordingRulesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRecordingRulesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRecordingRulesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRecordingRulesStmt(s)
+	}
+}
+
+func (s *ShowRecordingRulesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRecordingRulesStmt(s)
+	}
+}
+
+func (s *ShowRecordingRulesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRecordingRulesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowRecordingRulesStmt() (localctx IShowRecordingRulesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowRecordingRulesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SQLParserRULE_showRecordingRulesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(593)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(594)
+		p.Match(SQLParserT_RECORDING)
+	}
+	{
+		p.SetState(595)
+		p.Match(SQLParserT_RULES)
+	}
+
+	return localctx
+}
+
+// IDropRecordingRuleStmtContext is an interface to support dynamic dispatch.
+type IDropRecordingRuleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDropRecordingRuleStmtContext differentiates from other interfaces.
+	IsDropRecordingRuleStmtContext()
+}
+
+type DropRecordingRuleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDropRecordingRuleStmtContext() *DropRecordingRuleStmtContext {
+	var p = new(DropRecordingRuleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_dropRecordingRuleStmt
+	return p
+}
+
+func (*DropRecordingRuleStmtContext) IsDropRecordingRuleStmtContext() {}
+
+func NewDropRecordingRuleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropRecordingRuleStmtContext {
+	var p = new(DropRecordingRuleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_dropRecordingRuleStmt
+
+	return p
+}
+
+func (s *DropRecordingRuleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropRecordingRuleStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropRecordingRuleStmtContext) T_RECORDING() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECORDING, 0)
+}
+
+func (s *DropRecordingRuleStmtContext) T_RULE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RULE, 0)
+}
+
+func (s *DropRecordingRuleStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *DropRecordingRuleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropRecordingRuleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropRecordingRuleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDropRecordingRuleStmt(s)
+	}
+}
+
+func (s *DropRecordingRuleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDropRecordingRuleStmt(s)
+	}
+}
+
+func (s *DropRecordingRuleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDropRecordingRuleStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) DropRecordingRuleStmt() (localctx IDropRecordingRuleStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewDropRecordingRuleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SQLParserRULE_dropRecordingRuleStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(597)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(598)
+		p.Match(SQLParserT_RECORDING)
+	}
+	{
+		p.SetState(599)
+		p.Match(SQLParserT_RULE)
+	}
+	{
+		p.SetState(600)
+		p.TextValue()
+	}
+
+	return localctx
+}
+
+// ICreateAlertStmtContext is an interface to support dynamic dispatch.
+type ICreateAlertStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateAlertStmtContext differentiates from other interfaces.
+	IsCreateAlertStmtContext()
+}
+
+type CreateAlertStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateAlertStmtContext() *CreateAlertStmtContext {
+	var p = new(CreateAlertStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createAlertStmt
+	return p
+}
+
+func (*CreateAlertStmtContext) IsCreateAlertStmtContext() {}
+
+func NewCreateAlertStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateAlertStmtContext {
+	var p = new(CreateAlertStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createAlertStmt
+
+	return p
+}
+
+func (s *CreateAlertStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateAlertStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateAlertStmtContext) T_ALERT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERT, 0)
+}
+
+func (s *CreateAlertStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *CreateAlertStmtContext) AllAlertOption() []IAlertOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IAlertOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IAlertOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IAlertOptionContext); ok {
+			tst[i] = t.(IAlertOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateAlertStmtContext) AlertOption(i int) IAlertOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlertOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlertOptionContext)
+}
+
+func (s *CreateAlertStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateAlertStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateAlertStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateAlertStmt(s)
+	}
+}
+
+func (s *CreateAlertStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateAlertStmt(s)
+	}
+}
+
+func (s *CreateAlertStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCreateAlertStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) CreateAlertStmt() (localctx ICreateAlertStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCreateAlertStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SQLParserRULE_createAlertStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(602)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(603)
+		p.Match(SQLParserT_ALERT)
+	}
+	{
+		p.SetState(604)
+		p.TextValue()
+	}
+	p.SetState(608)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1152921779485802496) != 0 || (int64((_la-76)) & ^0x3f) == 0 && ((int64(1)<<(_la-76))&1015021569) != 0 {
+		{
+			p.SetState(605)
+			p.AlertOption()
+		}
+
+		p.SetState(610)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
-	return t.(IShowDatabaseStmtContext)
+	return localctx
+}
+
+// IAlertOptionContext is an interface to support dynamic dispatch.
+type IAlertOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAlertOptionContext differentiates from other interfaces.
+	IsAlertOptionContext()
+}
+
+type AlertOptionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAlertOptionContext() *AlertOptionContext {
+	var p = new(AlertOptionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_alertOption
+	return p
+}
+
+func (*AlertOptionContext) IsAlertOptionContext() {}
+
+func NewAlertOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlertOptionContext {
+	var p = new(AlertOptionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alertOption
+
+	return p
 }
 
-func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
+func (s *AlertOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AlertOptionContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *AlertOptionContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowNameSpacesStmtContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1438,13 +9140,21 @@ func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
 		return nil
 	}
 
-	return t.(IShowNameSpacesStmtContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
+func (s *AlertOptionContext) T_WHEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHEN, 0)
+}
+
+func (s *AlertOptionContext) T_EVERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EVERY, 0)
+}
+
+func (s *AlertOptionContext) DurationValue() IDurationValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMetricsStmtContext); ok {
+		if _, ok := ctx.(IDurationValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1454,77 +9164,358 @@ func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
 		return nil
 	}
 
-	return t.(IShowMetricsStmtContext)
+	return t.(IDurationValueContext)
 }
 
-func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowFieldsStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *AlertOptionContext) T_FOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FOR, 0)
+}
+
+func (s *AlertOptionContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *AlertOptionContext) T_LABELS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABELS, 0)
+}
+
+func (s *AlertOptionContext) T_WEBHOOK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WEBHOOK, 0)
+}
+
+func (s *AlertOptionContext) T_FORMAT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FORMAT, 0)
+}
+
+func (s *AlertOptionContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *AlertOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AlertOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AlertOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterAlertOption(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *AlertOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitAlertOption(s)
 	}
+}
 
-	return t.(IShowFieldsStmtContext)
+func (s *AlertOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitAlertOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *ShowStmtContext) ShowTagKeysStmt() IShowTagKeysStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowTagKeysStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (p *SQLParser) AlertOption() (localctx IAlertOptionContext) {
+	this := p
+	_ = this
+
+	localctx = NewAlertOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SQLParserRULE_alertOption)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(629)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_ON:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(611)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(612)
+			p.TextValue()
+		}
+
+	case SQLParserT_WHEN:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(613)
+			p.Match(SQLParserT_WHEN)
+		}
+		{
+			p.SetState(614)
+			p.TextValue()
+		}
+
+	case SQLParserT_EVERY:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(615)
+			p.Match(SQLParserT_EVERY)
+		}
+		{
+			p.SetState(616)
+			p.DurationValue()
+		}
+
+	case SQLParserT_FOR:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(617)
+			p.Match(SQLParserT_FOR)
+		}
+		{
+			p.SetState(618)
+			p.DurationValue()
+		}
+
+	case SQLParserT_NAMESPACE:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(619)
+			p.Match(SQLParserT_NAMESPACE)
+		}
+		{
+			p.SetState(620)
+			p.TextValue()
+		}
+
+	case SQLParserT_LABELS:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(621)
+			p.Match(SQLParserT_LABELS)
+		}
+		{
+			p.SetState(622)
+			p.TextValue()
+		}
+
+	case SQLParserT_WEBHOOK:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(623)
+			p.Match(SQLParserT_WEBHOOK)
+		}
+		{
+			p.SetState(624)
+			p.TextValue()
+		}
+
+	case SQLParserT_FORMAT:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(625)
+			p.Match(SQLParserT_FORMAT)
+		}
+		{
+			p.SetState(626)
+			p.TextValue()
+		}
+
+	case SQLParserT_AS:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(627)
+			p.Match(SQLParserT_AS)
+		}
+		{
+			p.SetState(628)
+			p.TextValue()
 		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
-	if t == nil {
-		return nil
+	return localctx
+}
+
+// IShowAlertsStmtContext is an interface to support dynamic dispatch.
+type IShowAlertsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowAlertsStmtContext differentiates from other interfaces.
+	IsShowAlertsStmtContext()
+}
+
+type ShowAlertsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowAlertsStmtContext() *ShowAlertsStmtContext {
+	var p = new(ShowAlertsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showAlertsStmt
+	return p
+}
+
+func (*ShowAlertsStmtContext) IsShowAlertsStmtContext() {}
+
+func NewShowAlertsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAlertsStmtContext {
+	var p = new(ShowAlertsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showAlertsStmt
+
+	return p
+}
+
+func (s *ShowAlertsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowAlertsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowAlertsStmtContext) T_ALERTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERTS, 0)
+}
+
+func (s *ShowAlertsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowAlertsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowAlertsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowAlertsStmt(s)
+	}
+}
+
+func (s *ShowAlertsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowAlertsStmt(s)
 	}
+}
 
-	return t.(IShowTagKeysStmtContext)
+func (s *ShowAlertsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowAlertsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowAlertsStmt() (localctx IShowAlertsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowAlertsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SQLParserRULE_showAlertsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(631)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(632)
+		p.Match(SQLParserT_ALERTS)
+	}
+
+	return localctx
+}
+
+// IDropAlertStmtContext is an interface to support dynamic dispatch.
+type IDropAlertStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDropAlertStmtContext differentiates from other interfaces.
+	IsDropAlertStmtContext()
+}
+
+type DropAlertStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDropAlertStmtContext() *DropAlertStmtContext {
+	var p = new(DropAlertStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_dropAlertStmt
+	return p
 }
 
-func (s *ShowStmtContext) ShowTagValuesStmt() IShowTagValuesStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowTagValuesStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (*DropAlertStmtContext) IsDropAlertStmtContext() {}
 
-	if t == nil {
-		return nil
-	}
+func NewDropAlertStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropAlertStmtContext {
+	var p = new(DropAlertStmtContext)
 
-	return t.(IShowTagValuesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_dropAlertStmt
+
+	return p
 }
 
-func (s *ShowStmtContext) ShowRequestsStmt() IShowRequestsStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowRequestsStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *DropAlertStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *DropAlertStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
 
-	return t.(IShowRequestsStmtContext)
+func (s *DropAlertStmtContext) T_ALERT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERT, 0)
 }
 
-func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
+func (s *DropAlertStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowRequestStmtContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1534,45 +9525,45 @@ func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
 		return nil
 	}
 
-	return t.(IShowRequestStmtContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropAlertStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropAlertStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropAlertStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStmt(s)
+		listenerT.EnterDropAlertStmt(s)
 	}
 }
 
-func (s *ShowStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropAlertStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStmt(s)
+		listenerT.ExitDropAlertStmt(s)
 	}
 }
 
-func (s *ShowStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropAlertStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStmt(s)
+		return t.VisitDropAlertStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
+func (p *SQLParser) DropAlertStmt() (localctx IDropAlertStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, SQLParserRULE_showStmt)
+	localctx = NewDropAlertStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SQLParserRULE_dropAlertStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -1590,243 +9581,150 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 		}
 	}()
 
-	p.SetState(219)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(198)
-			p.ShowMasterStmt()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(199)
-			p.ShowMetadataTypesStmt()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(200)
-			p.ShowBrokerMetaStmt()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(201)
-			p.ShowMasterMetaStmt()
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(202)
-			p.ShowStorageMetaStmt()
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(203)
-			p.ShowStoragesStmt()
-		}
-
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(204)
-			p.ShowBrokersStmt()
-		}
-
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(205)
-			p.ShowAliveStmt()
-		}
-
-	case 9:
-		p.EnterOuterAlt(localctx, 9)
-		{
-			p.SetState(206)
-			p.ShowBrokerMetricStmt()
-		}
-
-	case 10:
-		p.EnterOuterAlt(localctx, 10)
-		{
-			p.SetState(207)
-			p.ShowStorageMetricStmt()
-		}
-
-	case 11:
-		p.EnterOuterAlt(localctx, 11)
-		{
-			p.SetState(208)
-			p.ShowReplicationStmt()
-		}
-
-	case 12:
-		p.EnterOuterAlt(localctx, 12)
-		{
-			p.SetState(209)
-			p.ShowMemoryDatabaseStmt()
-		}
-
-	case 13:
-		p.EnterOuterAlt(localctx, 13)
-		{
-			p.SetState(210)
-			p.ShowSchemasStmt()
-		}
-
-	case 14:
-		p.EnterOuterAlt(localctx, 14)
-		{
-			p.SetState(211)
-			p.ShowDatabaseStmt()
-		}
-
-	case 15:
-		p.EnterOuterAlt(localctx, 15)
-		{
-			p.SetState(212)
-			p.ShowNameSpacesStmt()
-		}
-
-	case 16:
-		p.EnterOuterAlt(localctx, 16)
-		{
-			p.SetState(213)
-			p.ShowMetricsStmt()
-		}
-
-	case 17:
-		p.EnterOuterAlt(localctx, 17)
-		{
-			p.SetState(214)
-			p.ShowFieldsStmt()
-		}
-
-	case 18:
-		p.EnterOuterAlt(localctx, 18)
-		{
-			p.SetState(215)
-			p.ShowTagKeysStmt()
-		}
-
-	case 19:
-		p.EnterOuterAlt(localctx, 19)
-		{
-			p.SetState(216)
-			p.ShowTagValuesStmt()
-		}
-
-	case 20:
-		p.EnterOuterAlt(localctx, 20)
-		{
-			p.SetState(217)
-			p.ShowRequestsStmt()
-		}
-
-	case 21:
-		p.EnterOuterAlt(localctx, 21)
-		{
-			p.SetState(218)
-			p.ShowRequestStmt()
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(634)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(635)
+		p.Match(SQLParserT_ALERT)
+	}
+	{
+		p.SetState(636)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowMasterStmtContext is an interface to support dynamic dispatch.
-type IShowMasterStmtContext interface {
+// ICreateUserStmtContext is an interface to support dynamic dispatch.
+type ICreateUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMasterStmtContext differentiates from other interfaces.
-	IsShowMasterStmtContext()
+	// IsCreateUserStmtContext differentiates from other interfaces.
+	IsCreateUserStmtContext()
 }
 
-type ShowMasterStmtContext struct {
+type CreateUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMasterStmtContext() *ShowMasterStmtContext {
-	var p = new(ShowMasterStmtContext)
+func NewEmptyCreateUserStmtContext() *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMasterStmt
+	p.RuleIndex = SQLParserRULE_createUserStmt
 	return p
 }
 
-func (*ShowMasterStmtContext) IsShowMasterStmtContext() {}
+func (*CreateUserStmtContext) IsCreateUserStmtContext() {}
 
-func NewShowMasterStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterStmtContext {
-	var p = new(ShowMasterStmtContext)
+func NewCreateUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMasterStmt
+	p.RuleIndex = SQLParserRULE_createUserStmt
 
 	return p
 }
 
-func (s *ShowMasterStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMasterStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateUserStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowMasterStmtContext) T_MASTER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_MASTER, 0)
+func (s *CreateUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
 }
 
-func (s *ShowMasterStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateUserStmtContext) AllTextValue() []ITextValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITextValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITextValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITextValueContext); ok {
+			tst[i] = t.(ITextValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateUserStmtContext) TextValue(i int) ITextValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *CreateUserStmtContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *CreateUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMasterStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMasterStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMasterStmt(s)
+		listenerT.EnterCreateUserStmt(s)
 	}
 }
 
-func (s *ShowMasterStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMasterStmt(s)
+		listenerT.ExitCreateUserStmt(s)
 	}
 }
 
-func (s *ShowMasterStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMasterStmt(s)
+		return t.VisitCreateUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
+func (p *SQLParser) CreateUserStmt() (localctx ICreateUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMasterStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, SQLParserRULE_showMasterStmt)
+	localctx = NewCreateUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SQLParserRULE_createUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -1846,99 +9744,164 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(221)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(638)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(222)
-		p.Match(SQLParserT_MASTER)
+		p.SetState(639)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(640)
+		p.TextValue()
+	}
+	{
+		p.SetState(641)
+		p.Match(SQLParserT_PASSWORD)
+	}
+	{
+		p.SetState(642)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowRequestsStmtContext is an interface to support dynamic dispatch.
-type IShowRequestsStmtContext interface {
+// IAlterUserStmtContext is an interface to support dynamic dispatch.
+type IAlterUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowRequestsStmtContext differentiates from other interfaces.
-	IsShowRequestsStmtContext()
+	// IsAlterUserStmtContext differentiates from other interfaces.
+	IsAlterUserStmtContext()
 }
 
-type ShowRequestsStmtContext struct {
+type AlterUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRequestsStmtContext() *ShowRequestsStmtContext {
-	var p = new(ShowRequestsStmtContext)
+func NewEmptyAlterUserStmtContext() *AlterUserStmtContext {
+	var p = new(AlterUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRequestsStmt
+	p.RuleIndex = SQLParserRULE_alterUserStmt
 	return p
 }
 
-func (*ShowRequestsStmtContext) IsShowRequestsStmtContext() {}
+func (*AlterUserStmtContext) IsAlterUserStmtContext() {}
 
-func NewShowRequestsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestsStmtContext {
-	var p = new(ShowRequestsStmtContext)
+func NewAlterUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterUserStmtContext {
+	var p = new(AlterUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRequestsStmt
+	p.RuleIndex = SQLParserRULE_alterUserStmt
 
 	return p
 }
 
-func (s *ShowRequestsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowRequestsStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *AlterUserStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
 }
 
-func (s *ShowRequestsStmtContext) T_REQUESTS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REQUESTS, 0)
+func (s *AlterUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
 }
 
-func (s *ShowRequestsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterUserStmtContext) AllTextValue() []ITextValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITextValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITextValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITextValueContext); ok {
+			tst[i] = t.(ITextValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AlterUserStmtContext) TextValue(i int) ITextValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *AlterUserStmtContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *AlterUserStmtContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *AlterUserStmtContext) T_TOKENS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKENS, 0)
+}
+
+func (s *AlterUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowRequestsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowRequestsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRequestsStmt(s)
+		listenerT.EnterAlterUserStmt(s)
 	}
 }
 
-func (s *ShowRequestsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRequestsStmt(s)
+		listenerT.ExitAlterUserStmt(s)
 	}
 }
 
-func (s *ShowRequestsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowRequestsStmt(s)
+		return t.VisitAlterUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
+func (p *SQLParser) AlterUserStmt() (localctx IAlterUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowRequestsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, SQLParserRULE_showRequestsStmt)
+	localctx = NewAlterUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SQLParserRULE_alterUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -1958,79 +9921,98 @@ func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(224)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(644)
+		p.Match(SQLParserT_ALTER)
 	}
 	{
-		p.SetState(225)
-		p.Match(SQLParserT_REQUESTS)
+		p.SetState(645)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(646)
+		p.TextValue()
+	}
+	p.SetState(651)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_PASSWORD:
+		{
+			p.SetState(647)
+			p.Match(SQLParserT_PASSWORD)
+		}
+		{
+			p.SetState(648)
+			p.TextValue()
+		}
+
+	case SQLParserT_REVOKE:
+		{
+			p.SetState(649)
+			p.Match(SQLParserT_REVOKE)
+		}
+		{
+			p.SetState(650)
+			p.Match(SQLParserT_TOKENS)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IShowRequestStmtContext is an interface to support dynamic dispatch.
-type IShowRequestStmtContext interface {
+// IDropUserStmtContext is an interface to support dynamic dispatch.
+type IDropUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowRequestStmtContext differentiates from other interfaces.
-	IsShowRequestStmtContext()
+	// IsDropUserStmtContext differentiates from other interfaces.
+	IsDropUserStmtContext()
 }
 
-type ShowRequestStmtContext struct {
+type DropUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRequestStmtContext() *ShowRequestStmtContext {
-	var p = new(ShowRequestStmtContext)
+func NewEmptyDropUserStmtContext() *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRequestStmt
+	p.RuleIndex = SQLParserRULE_dropUserStmt
 	return p
 }
 
-func (*ShowRequestStmtContext) IsShowRequestStmtContext() {}
+func (*DropUserStmtContext) IsDropUserStmtContext() {}
 
-func NewShowRequestStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestStmtContext {
-	var p = new(ShowRequestStmtContext)
+func NewDropUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRequestStmt
+	p.RuleIndex = SQLParserRULE_dropUserStmt
 
 	return p
 }
 
-func (s *ShowRequestStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowRequestStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowRequestStmtContext) T_REQUEST() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REQUEST, 0)
-}
-
-func (s *ShowRequestStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
+func (s *DropUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowRequestStmtContext) T_ID() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ID, 0)
+func (s *DropUserStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *ShowRequestStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *DropUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
 }
 
-func (s *ShowRequestStmtContext) RequestID() IRequestIDContext {
+func (s *DropUserStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRequestIDContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2040,45 +10022,45 @@ func (s *ShowRequestStmtContext) RequestID() IRequestIDContext {
 		return nil
 	}
 
-	return t.(IRequestIDContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowRequestStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowRequestStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowRequestStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRequestStmt(s)
+		listenerT.EnterDropUserStmt(s)
 	}
 }
 
-func (s *ShowRequestStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRequestStmt(s)
+		listenerT.ExitDropUserStmt(s)
 	}
 }
 
-func (s *ShowRequestStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowRequestStmt(s)
+		return t.VisitDropUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
+func (p *SQLParser) DropUserStmt() (localctx IDropUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowRequestStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, SQLParserRULE_showRequestStmt)
+	localctx = NewDropUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SQLParserRULE_dropUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2098,115 +10080,103 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(227)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(228)
-		p.Match(SQLParserT_REQUEST)
-	}
-	{
-		p.SetState(229)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(230)
-		p.Match(SQLParserT_ID)
+		p.SetState(653)
+		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(231)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(654)
+		p.Match(SQLParserT_USER)
 	}
 	{
-		p.SetState(232)
-		p.RequestID()
+		p.SetState(655)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowStoragesStmtContext is an interface to support dynamic dispatch.
-type IShowStoragesStmtContext interface {
+// IShowUsersStmtContext is an interface to support dynamic dispatch.
+type IShowUsersStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowStoragesStmtContext differentiates from other interfaces.
-	IsShowStoragesStmtContext()
+	// IsShowUsersStmtContext differentiates from other interfaces.
+	IsShowUsersStmtContext()
 }
 
-type ShowStoragesStmtContext struct {
+type ShowUsersStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStoragesStmtContext() *ShowStoragesStmtContext {
-	var p = new(ShowStoragesStmtContext)
+func NewEmptyShowUsersStmtContext() *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	p.RuleIndex = SQLParserRULE_showUsersStmt
 	return p
 }
 
-func (*ShowStoragesStmtContext) IsShowStoragesStmtContext() {}
+func (*ShowUsersStmtContext) IsShowUsersStmtContext() {}
 
-func NewShowStoragesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStoragesStmtContext {
-	var p = new(ShowStoragesStmtContext)
+func NewShowUsersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	p.RuleIndex = SQLParserRULE_showUsersStmt
 
 	return p
 }
 
-func (s *ShowStoragesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowUsersStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStoragesStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowUsersStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowStoragesStmtContext) T_STORAGES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGES, 0)
+func (s *ShowUsersStmtContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
 }
 
-func (s *ShowStoragesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowUsersStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStoragesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowUsersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStoragesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowUsersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStoragesStmt(s)
+		listenerT.EnterShowUsersStmt(s)
 	}
 }
 
-func (s *ShowStoragesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowUsersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStoragesStmt(s)
+		listenerT.ExitShowUsersStmt(s)
 	}
 }
 
-func (s *ShowStoragesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowUsersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStoragesStmt(s)
+		return t.VisitShowUsersStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
+func (p *SQLParser) ShowUsersStmt() (localctx IShowUsersStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStoragesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, SQLParserRULE_showStoragesStmt)
+	localctx = NewShowUsersStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SQLParserRULE_showUsersStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2226,99 +10196,115 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(234)
+		p.SetState(657)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(235)
-		p.Match(SQLParserT_STORAGES)
+		p.SetState(658)
+		p.Match(SQLParserT_USERS)
 	}
 
 	return localctx
 }
 
-// IShowBrokersStmtContext is an interface to support dynamic dispatch.
-type IShowBrokersStmtContext interface {
+// ICreateRoleStmtContext is an interface to support dynamic dispatch.
+type ICreateRoleStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowBrokersStmtContext differentiates from other interfaces.
-	IsShowBrokersStmtContext()
+	// IsCreateRoleStmtContext differentiates from other interfaces.
+	IsCreateRoleStmtContext()
 }
 
-type ShowBrokersStmtContext struct {
+type CreateRoleStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokersStmtContext() *ShowBrokersStmtContext {
-	var p = new(ShowBrokersStmtContext)
+func NewEmptyCreateRoleStmtContext() *CreateRoleStmtContext {
+	var p = new(CreateRoleStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokersStmt
+	p.RuleIndex = SQLParserRULE_createRoleStmt
 	return p
 }
 
-func (*ShowBrokersStmtContext) IsShowBrokersStmtContext() {}
+func (*CreateRoleStmtContext) IsCreateRoleStmtContext() {}
 
-func NewShowBrokersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokersStmtContext {
-	var p = new(ShowBrokersStmtContext)
+func NewCreateRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateRoleStmtContext {
+	var p = new(CreateRoleStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokersStmt
+	p.RuleIndex = SQLParserRULE_createRoleStmt
 
 	return p
 }
 
-func (s *ShowBrokersStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateRoleStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokersStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateRoleStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowBrokersStmtContext) T_BROKERS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKERS, 0)
+func (s *CreateRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
 }
 
-func (s *ShowBrokersStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateRoleStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *CreateRoleStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokersStmt(s)
+		listenerT.EnterCreateRoleStmt(s)
 	}
 }
 
-func (s *ShowBrokersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokersStmt(s)
+		listenerT.ExitCreateRoleStmt(s)
 	}
 }
 
-func (s *ShowBrokersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateRoleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokersStmt(s)
+		return t.VisitCreateRoleStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
+func (p *SQLParser) CreateRoleStmt() (localctx ICreateRoleStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokersStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SQLParserRULE_showBrokersStmt)
+	localctx = NewCreateRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SQLParserRULE_createRoleStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2338,103 +10324,119 @@ func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(237)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(660)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(238)
-		p.Match(SQLParserT_BROKERS)
+		p.SetState(661)
+		p.Match(SQLParserT_ROLE)
+	}
+	{
+		p.SetState(662)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowMetadataTypesStmtContext is an interface to support dynamic dispatch.
-type IShowMetadataTypesStmtContext interface {
+// IDropRoleStmtContext is an interface to support dynamic dispatch.
+type IDropRoleStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMetadataTypesStmtContext differentiates from other interfaces.
-	IsShowMetadataTypesStmtContext()
+	// IsDropRoleStmtContext differentiates from other interfaces.
+	IsDropRoleStmtContext()
 }
 
-type ShowMetadataTypesStmtContext struct {
+type DropRoleStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetadataTypesStmtContext() *ShowMetadataTypesStmtContext {
-	var p = new(ShowMetadataTypesStmtContext)
+func NewEmptyDropRoleStmtContext() *DropRoleStmtContext {
+	var p = new(DropRoleStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+	p.RuleIndex = SQLParserRULE_dropRoleStmt
 	return p
 }
 
-func (*ShowMetadataTypesStmtContext) IsShowMetadataTypesStmtContext() {}
+func (*DropRoleStmtContext) IsDropRoleStmtContext() {}
 
-func NewShowMetadataTypesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetadataTypesStmtContext {
-	var p = new(ShowMetadataTypesStmtContext)
+func NewDropRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropRoleStmtContext {
+	var p = new(DropRoleStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+	p.RuleIndex = SQLParserRULE_dropRoleStmt
 
 	return p
 }
 
-func (s *ShowMetadataTypesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropRoleStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetadataTypesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *DropRoleStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *ShowMetadataTypesStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
+func (s *DropRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
 }
 
-func (s *ShowMetadataTypesStmtContext) T_TYPES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TYPES, 0)
+func (s *DropRoleStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
 }
 
-func (s *ShowMetadataTypesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropRoleStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetadataTypesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMetadataTypesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetadataTypesStmt(s)
+		listenerT.EnterDropRoleStmt(s)
 	}
 }
 
-func (s *ShowMetadataTypesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetadataTypesStmt(s)
+		listenerT.ExitDropRoleStmt(s)
 	}
 }
 
-func (s *ShowMetadataTypesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropRoleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetadataTypesStmt(s)
+		return t.VisitDropRoleStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtContext) {
+func (p *SQLParser) DropRoleStmt() (localctx IDropRoleStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMetadataTypesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SQLParserRULE_showMetadataTypesStmt)
+	localctx = NewDropRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SQLParserRULE_dropRoleStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2454,147 +10456,103 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(240)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(664)
+		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(241)
-		p.Match(SQLParserT_METADATA)
+		p.SetState(665)
+		p.Match(SQLParserT_ROLE)
 	}
 	{
-		p.SetState(242)
-		p.Match(SQLParserT_TYPES)
+		p.SetState(666)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowBrokerMetaStmtContext is an interface to support dynamic dispatch.
-type IShowBrokerMetaStmtContext interface {
+// IShowRolesStmtContext is an interface to support dynamic dispatch.
+type IShowRolesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowBrokerMetaStmtContext differentiates from other interfaces.
-	IsShowBrokerMetaStmtContext()
+	// IsShowRolesStmtContext differentiates from other interfaces.
+	IsShowRolesStmtContext()
 }
 
-type ShowBrokerMetaStmtContext struct {
+type ShowRolesStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokerMetaStmtContext() *ShowBrokerMetaStmtContext {
-	var p = new(ShowBrokerMetaStmtContext)
+func NewEmptyShowRolesStmtContext() *ShowRolesStmtContext {
+	var p = new(ShowRolesStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+	p.RuleIndex = SQLParserRULE_showRolesStmt
 	return p
 }
 
-func (*ShowBrokerMetaStmtContext) IsShowBrokerMetaStmtContext() {}
+func (*ShowRolesStmtContext) IsShowRolesStmtContext() {}
 
-func NewShowBrokerMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetaStmtContext {
-	var p = new(ShowBrokerMetaStmtContext)
+func NewShowRolesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRolesStmtContext {
+	var p = new(ShowRolesStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+	p.RuleIndex = SQLParserRULE_showRolesStmt
 
 	return p
 }
 
-func (s *ShowBrokerMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowRolesStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokerMetaStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowRolesStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowBrokerMetaStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) Source() ISourceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISourceContext)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) TypeFilter() ITypeFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeFilterContext)
+func (s *ShowRolesStmtContext) T_ROLES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLES, 0)
 }
 
-func (s *ShowBrokerMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowRolesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokerMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowRolesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokerMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowRolesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokerMetaStmt(s)
+		listenerT.EnterShowRolesStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowRolesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokerMetaStmt(s)
+		listenerT.ExitShowRolesStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowRolesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokerMetaStmt(s)
+		return t.VisitShowRolesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
+func (p *SQLParser) ShowRolesStmt() (localctx IShowRolesStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokerMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SQLParserRULE_showBrokerMetaStmt)
+	localctx = NewShowRolesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SQLParserRULE_showRolesStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2614,95 +10572,79 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(244)
+		p.SetState(668)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(245)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(246)
-		p.Match(SQLParserT_METADATA)
-	}
-	{
-		p.SetState(247)
-		p.Match(SQLParserT_FROM)
-	}
-	{
-		p.SetState(248)
-		p.Source()
-	}
-	{
-		p.SetState(249)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(250)
-		p.TypeFilter()
+		p.SetState(669)
+		p.Match(SQLParserT_ROLES)
 	}
 
 	return localctx
 }
 
-// IShowMasterMetaStmtContext is an interface to support dynamic dispatch.
-type IShowMasterMetaStmtContext interface {
+// IGrantStmtContext is an interface to support dynamic dispatch.
+type IGrantStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMasterMetaStmtContext differentiates from other interfaces.
-	IsShowMasterMetaStmtContext()
+	// IsGrantStmtContext differentiates from other interfaces.
+	IsGrantStmtContext()
 }
 
-type ShowMasterMetaStmtContext struct {
+type GrantStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMasterMetaStmtContext() *ShowMasterMetaStmtContext {
-	var p = new(ShowMasterMetaStmtContext)
+func NewEmptyGrantStmtContext() *GrantStmtContext {
+	var p = new(GrantStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+	p.RuleIndex = SQLParserRULE_grantStmt
 	return p
 }
 
-func (*ShowMasterMetaStmtContext) IsShowMasterMetaStmtContext() {}
+func (*GrantStmtContext) IsGrantStmtContext() {}
 
-func NewShowMasterMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterMetaStmtContext {
-	var p = new(ShowMasterMetaStmtContext)
+func NewGrantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantStmtContext {
+	var p = new(GrantStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+	p.RuleIndex = SQLParserRULE_grantStmt
 
 	return p
 }
 
-func (s *ShowMasterMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *GrantStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMasterMetaStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *GrantStmtContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
 }
 
-func (s *ShowMasterMetaStmtContext) T_MASTER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_MASTER, 0)
-}
+func (s *GrantStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ShowMasterMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *ShowMasterMetaStmtContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowMasterMetaStmtContext) Source() ISourceContext {
+func (s *GrantStmtContext) RoleGrant() IRoleGrantContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
+		if _, ok := ctx.(IRoleGrantContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2712,17 +10654,17 @@ func (s *ShowMasterMetaStmtContext) Source() ISourceContext {
 		return nil
 	}
 
-	return t.(ISourceContext)
+	return t.(IRoleGrantContext)
 }
 
-func (s *ShowMasterMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *GrantStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
 }
 
-func (s *ShowMasterMetaStmtContext) TypeFilter() ITypeFilterContext {
+func (s *GrantStmtContext) PrivilegeGrant() IPrivilegeGrantContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
+		if _, ok := ctx.(IPrivilegeGrantContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2732,45 +10674,49 @@ func (s *ShowMasterMetaStmtContext) TypeFilter() ITypeFilterContext {
 		return nil
 	}
 
-	return t.(ITypeFilterContext)
+	return t.(IPrivilegeGrantContext)
 }
 
-func (s *ShowMasterMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *GrantStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *GrantStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMasterMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GrantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMasterMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GrantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMasterMetaStmt(s)
+		listenerT.EnterGrantStmt(s)
 	}
 }
 
-func (s *ShowMasterMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GrantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMasterMetaStmt(s)
+		listenerT.ExitGrantStmt(s)
 	}
 }
 
-func (s *ShowMasterMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GrantStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMasterMetaStmt(s)
+		return t.VisitGrantStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
+func (p *SQLParser) GrantStmt() (localctx IGrantStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMasterMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SQLParserRULE_showMasterMetaStmt)
+	localctx = NewGrantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SQLParserRULE_grantStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2790,146 +10736,102 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(252)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(253)
-		p.Match(SQLParserT_MASTER)
-	}
-	{
-		p.SetState(254)
-		p.Match(SQLParserT_METADATA)
-	}
-	{
-		p.SetState(255)
-		p.Match(SQLParserT_FROM)
-	}
-	{
-		p.SetState(256)
-		p.Source()
+		p.SetState(671)
+		p.Match(SQLParserT_GRANT)
 	}
-	{
-		p.SetState(257)
-		p.Match(SQLParserT_WHERE)
+	p.SetState(680)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_ROLE:
+		{
+			p.SetState(672)
+			p.RoleGrant()
+		}
+		{
+			p.SetState(673)
+			p.Match(SQLParserT_TO)
+		}
+
+	case SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL:
+		{
+			p.SetState(675)
+			p.PrivilegeGrant()
+		}
+		{
+			p.SetState(676)
+			p.Match(SQLParserT_TO)
+		}
+		p.SetState(678)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(677)
+				p.Match(SQLParserT_ROLE)
+			}
+
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(258)
-		p.TypeFilter()
+		p.SetState(682)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowStorageMetaStmtContext is an interface to support dynamic dispatch.
-type IShowStorageMetaStmtContext interface {
+// IRevokeStmtContext is an interface to support dynamic dispatch.
+type IRevokeStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowStorageMetaStmtContext differentiates from other interfaces.
-	IsShowStorageMetaStmtContext()
+	// IsRevokeStmtContext differentiates from other interfaces.
+	IsRevokeStmtContext()
 }
 
-type ShowStorageMetaStmtContext struct {
+type RevokeStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStorageMetaStmtContext() *ShowStorageMetaStmtContext {
-	var p = new(ShowStorageMetaStmtContext)
+func NewEmptyRevokeStmtContext() *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
-	return p
-}
-
-func (*ShowStorageMetaStmtContext) IsShowStorageMetaStmtContext() {}
-
-func NewShowStorageMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetaStmtContext {
-	var p = new(ShowStorageMetaStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
-
+	p.RuleIndex = SQLParserRULE_revokeStmt
 	return p
 }
 
-func (s *ShowStorageMetaStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowStorageMetaStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowStorageMetaStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
-}
-
-func (s *ShowStorageMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
-}
-
-func (s *ShowStorageMetaStmtContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
-}
-
-func (s *ShowStorageMetaStmtContext) Source() ISourceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISourceContext)
-}
-
-func (s *ShowStorageMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
+func (*RevokeStmtContext) IsRevokeStmtContext() {}
 
-func (s *ShowStorageMetaStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
-}
+func NewRevokeStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
 
-func (s *ShowStorageMetaStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
-	}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
-	}
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_revokeStmt
 
-	return tst
+	return p
 }
 
-func (s *ShowStorageMetaStmtContext) StorageFilter(i int) IStorageFilterContext {
+func (s *RevokeStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RevokeStmtContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *RevokeStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -2937,40 +10839,35 @@ func (s *ShowStorageMetaStmtContext) StorageFilter(i int) IStorageFilterContext
 		return nil
 	}
 
-	return t.(IStorageFilterContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowStorageMetaStmtContext) AllTypeFilter() []ITypeFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ITypeFilterContext); ok {
-			len++
+func (s *RevokeStmtContext) RoleGrant() IRoleGrantContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRoleGrantContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]ITypeFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ITypeFilterContext); ok {
-			tst[i] = t.(ITypeFilterContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IRoleGrantContext)
 }
 
-func (s *ShowStorageMetaStmtContext) TypeFilter(i int) ITypeFilterContext {
+func (s *RevokeStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RevokeStmtContext) PrivilegeGrant() IPrivilegeGrantContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IPrivilegeGrantContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -2978,45 +10875,49 @@ func (s *ShowStorageMetaStmtContext) TypeFilter(i int) ITypeFilterContext {
 		return nil
 	}
 
-	return t.(ITypeFilterContext)
+	return t.(IPrivilegeGrantContext)
 }
 
-func (s *ShowStorageMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RevokeStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *RevokeStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStorageMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RevokeStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStorageMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RevokeStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStorageMetaStmt(s)
+		listenerT.EnterRevokeStmt(s)
 	}
 }
 
-func (s *ShowStorageMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RevokeStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStorageMetaStmt(s)
+		listenerT.ExitRevokeStmt(s)
 	}
 }
 
-func (s *ShowStorageMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RevokeStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStorageMetaStmt(s)
+		return t.VisitRevokeStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext) {
+func (p *SQLParser) RevokeStmt() (localctx IRevokeStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStorageMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SQLParserRULE_showStorageMetaStmt)
+	localctx = NewRevokeStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SQLParserRULE_revokeStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3036,166 +10937,148 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(260)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(261)
-		p.Match(SQLParserT_STORAGE)
-	}
-	{
-		p.SetState(262)
-		p.Match(SQLParserT_METADATA)
-	}
-	{
-		p.SetState(263)
-		p.Match(SQLParserT_FROM)
-	}
-	{
-		p.SetState(264)
-		p.Source()
-	}
-	{
-		p.SetState(265)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(684)
+		p.Match(SQLParserT_REVOKE)
 	}
-	p.SetState(268)
+	p.SetState(693)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	case SQLParserT_ROLE:
 		{
-			p.SetState(266)
-			p.StorageFilter()
+			p.SetState(685)
+			p.RoleGrant()
 		}
-
-	case SQLParserT_TYPE:
 		{
-			p.SetState(267)
-			p.TypeFilter()
+			p.SetState(686)
+			p.Match(SQLParserT_FROM)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	{
-		p.SetState(270)
-		p.Match(SQLParserT_AND)
-	}
-	p.SetState(273)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	case SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL:
 		{
-			p.SetState(271)
-			p.StorageFilter()
+			p.SetState(688)
+			p.PrivilegeGrant()
 		}
-
-	case SQLParserT_TYPE:
 		{
-			p.SetState(272)
-			p.TypeFilter()
+			p.SetState(689)
+			p.Match(SQLParserT_FROM)
+		}
+		p.SetState(691)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(690)
+				p.Match(SQLParserT_ROLE)
+			}
+
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
+	{
+		p.SetState(695)
+		p.TextValue()
+	}
 
 	return localctx
 }
 
-// IShowAliveStmtContext is an interface to support dynamic dispatch.
-type IShowAliveStmtContext interface {
+// IRoleGrantContext is an interface to support dynamic dispatch.
+type IRoleGrantContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowAliveStmtContext differentiates from other interfaces.
-	IsShowAliveStmtContext()
+	// IsRoleGrantContext differentiates from other interfaces.
+	IsRoleGrantContext()
 }
 
-type ShowAliveStmtContext struct {
+type RoleGrantContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowAliveStmtContext() *ShowAliveStmtContext {
-	var p = new(ShowAliveStmtContext)
+func NewEmptyRoleGrantContext() *RoleGrantContext {
+	var p = new(RoleGrantContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showAliveStmt
+	p.RuleIndex = SQLParserRULE_roleGrant
 	return p
 }
 
-func (*ShowAliveStmtContext) IsShowAliveStmtContext() {}
+func (*RoleGrantContext) IsRoleGrantContext() {}
 
-func NewShowAliveStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAliveStmtContext {
-	var p = new(ShowAliveStmtContext)
+func NewRoleGrantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoleGrantContext {
+	var p = new(RoleGrantContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showAliveStmt
+	p.RuleIndex = SQLParserRULE_roleGrant
 
 	return p
 }
 
-func (s *ShowAliveStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *RoleGrantContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowAliveStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *RoleGrantContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
 }
 
-func (s *ShowAliveStmtContext) T_ALIVE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ALIVE, 0)
-}
+func (s *RoleGrantContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ShowAliveStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *ShowAliveStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowAliveStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RoleGrantContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowAliveStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RoleGrantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowAliveStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RoleGrantContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowAliveStmt(s)
+		listenerT.EnterRoleGrant(s)
 	}
 }
 
-func (s *ShowAliveStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RoleGrantContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowAliveStmt(s)
+		listenerT.ExitRoleGrant(s)
 	}
 }
 
-func (s *ShowAliveStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RoleGrantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowAliveStmt(s)
+		return t.VisitRoleGrant(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
+func (p *SQLParser) RoleGrant() (localctx IRoleGrantContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowAliveStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SQLParserRULE_showAliveStmt)
-	var _la int
+	localctx = NewRoleGrantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SQLParserRULE_roleGrant)
 
 	defer func() {
 		p.ExitRule()
@@ -3215,113 +11098,61 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(275)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(276)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == SQLParserT_STORAGE || _la == SQLParserT_BROKER) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
+		p.SetState(697)
+		p.Match(SQLParserT_ROLE)
 	}
 	{
-		p.SetState(277)
-		p.Match(SQLParserT_ALIVE)
+		p.SetState(698)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowReplicationStmtContext is an interface to support dynamic dispatch.
-type IShowReplicationStmtContext interface {
+// IPrivilegeGrantContext is an interface to support dynamic dispatch.
+type IPrivilegeGrantContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowReplicationStmtContext differentiates from other interfaces.
-	IsShowReplicationStmtContext()
+	// IsPrivilegeGrantContext differentiates from other interfaces.
+	IsPrivilegeGrantContext()
 }
 
-type ShowReplicationStmtContext struct {
+type PrivilegeGrantContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowReplicationStmtContext() *ShowReplicationStmtContext {
-	var p = new(ShowReplicationStmtContext)
+func NewEmptyPrivilegeGrantContext() *PrivilegeGrantContext {
+	var p = new(PrivilegeGrantContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showReplicationStmt
+	p.RuleIndex = SQLParserRULE_privilegeGrant
 	return p
 }
 
-func (*ShowReplicationStmtContext) IsShowReplicationStmtContext() {}
+func (*PrivilegeGrantContext) IsPrivilegeGrantContext() {}
 
-func NewShowReplicationStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReplicationStmtContext {
-	var p = new(ShowReplicationStmtContext)
+func NewPrivilegeGrantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeGrantContext {
+	var p = new(PrivilegeGrantContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showReplicationStmt
+	p.RuleIndex = SQLParserRULE_privilegeGrant
 
 	return p
 }
 
-func (s *ShowReplicationStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowReplicationStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowReplicationStmtContext) T_REPLICATION() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REPLICATION, 0)
-}
-
-func (s *ShowReplicationStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowReplicationStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
-}
-
-func (s *ShowReplicationStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
-	}
-
-	return tst
-}
+func (s *PrivilegeGrantContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowReplicationStmtContext) StorageFilter(i int) IStorageFilterContext {
+func (s *PrivilegeGrantContext) PrivilegeList() IPrivilegeListContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IPrivilegeListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3329,40 +11160,39 @@ func (s *ShowReplicationStmtContext) StorageFilter(i int) IStorageFilterContext
 		return nil
 	}
 
-	return t.(IStorageFilterContext)
+	return t.(IPrivilegeListContext)
 }
 
-func (s *ShowReplicationStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
-			len++
-		}
-	}
+func (s *PrivilegeGrantContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
 
-	tst := make([]IDatabaseFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IDatabaseFilterContext); ok {
-			tst[i] = t.(IDatabaseFilterContext)
-			i++
+func (s *PrivilegeGrantContext) PrivilegeDatabase() IPrivilegeDatabaseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrivilegeDatabaseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrivilegeDatabaseContext)
 }
 
-func (s *ShowReplicationStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+func (s *PrivilegeGrantContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *PrivilegeGrantContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3370,45 +11200,46 @@ func (s *ShowReplicationStmtContext) DatabaseFilter(i int) IDatabaseFilterContex
 		return nil
 	}
 
-	return t.(IDatabaseFilterContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowReplicationStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *PrivilegeGrantContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowReplicationStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrivilegeGrantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowReplicationStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeGrantContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowReplicationStmt(s)
+		listenerT.EnterPrivilegeGrant(s)
 	}
 }
 
-func (s *ShowReplicationStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeGrantContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowReplicationStmt(s)
+		listenerT.ExitPrivilegeGrant(s)
 	}
 }
 
-func (s *ShowReplicationStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrivilegeGrantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowReplicationStmt(s)
+		return t.VisitPrivilegeGrant(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext) {
+func (p *SQLParser) PrivilegeGrant() (localctx IPrivilegeGrantContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowReplicationStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SQLParserRULE_showReplicationStmt)
+	localctx = NewPrivilegeGrantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SQLParserRULE_privilegeGrant)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3428,176 +11259,224 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(279)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(280)
-		p.Match(SQLParserT_REPLICATION)
+		p.SetState(700)
+		p.PrivilegeList()
 	}
 	{
-		p.SetState(281)
-		p.Match(SQLParserT_WHERE)
-	}
-	p.SetState(284)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(282)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_DATASBAE:
-		{
-			p.SetState(283)
-			p.DatabaseFilter()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(701)
+		p.Match(SQLParserT_ON)
 	}
 	{
-		p.SetState(286)
-		p.Match(SQLParserT_AND)
+		p.SetState(702)
+		p.PrivilegeDatabase()
 	}
-	p.SetState(289)
+	p.SetState(705)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	if _la == SQLParserT_NAMESPACE {
 		{
-			p.SetState(287)
-			p.StorageFilter()
+			p.SetState(703)
+			p.Match(SQLParserT_NAMESPACE)
 		}
-
-	case SQLParserT_DATASBAE:
 		{
-			p.SetState(288)
-			p.DatabaseFilter()
+			p.SetState(704)
+			p.TextValue()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IShowMemoryDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowMemoryDatabaseStmtContext interface {
+// IPrivilegeDatabaseContext is an interface to support dynamic dispatch.
+type IPrivilegeDatabaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMemoryDatabaseStmtContext differentiates from other interfaces.
-	IsShowMemoryDatabaseStmtContext()
+	// IsPrivilegeDatabaseContext differentiates from other interfaces.
+	IsPrivilegeDatabaseContext()
 }
 
-type ShowMemoryDatabaseStmtContext struct {
+type PrivilegeDatabaseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMemoryDatabaseStmtContext() *ShowMemoryDatabaseStmtContext {
-	var p = new(ShowMemoryDatabaseStmtContext)
+func NewEmptyPrivilegeDatabaseContext() *PrivilegeDatabaseContext {
+	var p = new(PrivilegeDatabaseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+	p.RuleIndex = SQLParserRULE_privilegeDatabase
 	return p
 }
 
-func (*ShowMemoryDatabaseStmtContext) IsShowMemoryDatabaseStmtContext() {}
+func (*PrivilegeDatabaseContext) IsPrivilegeDatabaseContext() {}
 
-func NewShowMemoryDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMemoryDatabaseStmtContext {
-	var p = new(ShowMemoryDatabaseStmtContext)
+func NewPrivilegeDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeDatabaseContext {
+	var p = new(PrivilegeDatabaseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+	p.RuleIndex = SQLParserRULE_privilegeDatabase
 
 	return p
 }
 
-func (s *ShowMemoryDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrivilegeDatabaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMemoryDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
+func (s *PrivilegeDatabaseContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ShowMemoryDatabaseStmtContext) T_MEMORY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_MEMORY, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *PrivilegeDatabaseContext) T_MUL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MUL, 0)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *PrivilegeDatabaseContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ShowMemoryDatabaseStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+func (s *PrivilegeDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
+func (s *PrivilegeDatabaseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterPrivilegeDatabase(s)
 	}
+}
 
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
+func (s *PrivilegeDatabaseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitPrivilegeDatabase(s)
 	}
+}
 
-	return tst
+func (s *PrivilegeDatabaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitPrivilegeDatabase(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *ShowMemoryDatabaseStmtContext) StorageFilter(i int) IStorageFilterContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
+func (p *SQLParser) PrivilegeDatabase() (localctx IPrivilegeDatabaseContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrivilegeDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SQLParserRULE_privilegeDatabase)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
 			}
-			j++
 		}
-	}
+	}()
 
-	if t == nil {
-		return nil
+	p.SetState(709)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserSTRING, SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_TO, SQLParserT_COMPACTION, SQLParserT_FAMILY, SQLParserT_SLOW, SQLParserT_RECORDING, SQLParserT_RULE, SQLParserT_RULES, SQLParserT_INTO, SQLParserT_EVERY, SQLParserT_ALERT, SQLParserT_ALERTS, SQLParserT_WHEN, SQLParserT_LABELS, SQLParserT_WEBHOOK, SQLParserT_FORMAT, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_TOKENS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL, SQLParserT_TENANT, SQLParserT_TENANTS, SQLParserT_USAGE, SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES, SQLParserT_MAX_DISK, SQLParserT_AUDIT, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(707)
+			p.TextValue()
+		}
+
+	case SQLParserT_MUL:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(708)
+			p.Match(SQLParserT_MUL)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
-	return t.(IStorageFilterContext)
+	return localctx
 }
 
-func (s *ShowMemoryDatabaseStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
+// IPrivilegeListContext is an interface to support dynamic dispatch.
+type IPrivilegeListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPrivilegeListContext differentiates from other interfaces.
+	IsPrivilegeListContext()
+}
+
+type PrivilegeListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrivilegeListContext() *PrivilegeListContext {
+	var p = new(PrivilegeListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_privilegeList
+	return p
+}
+
+func (*PrivilegeListContext) IsPrivilegeListContext() {}
+
+func NewPrivilegeListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeListContext {
+	var p = new(PrivilegeListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_privilegeList
+
+	return p
+}
+
+func (s *PrivilegeListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrivilegeListContext) AllPrivilege() []IPrivilegeContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
+		if _, ok := ctx.(IPrivilegeContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IDatabaseFilterContext, len)
+	tst := make([]IPrivilegeContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IDatabaseFilterContext); ok {
-			tst[i] = t.(IDatabaseFilterContext)
+		if t, ok := ctx.(IPrivilegeContext); ok {
+			tst[i] = t.(IPrivilegeContext)
 			i++
 		}
 	}
@@ -3605,11 +11484,11 @@ func (s *ShowMemoryDatabaseStmtContext) AllDatabaseFilter() []IDatabaseFilterCon
 	return tst
 }
 
-func (s *ShowMemoryDatabaseStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+func (s *PrivilegeListContext) Privilege(i int) IPrivilegeContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
+		if _, ok := ctx.(IPrivilegeContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -3622,45 +11501,54 @@ func (s *ShowMemoryDatabaseStmtContext) DatabaseFilter(i int) IDatabaseFilterCon
 		return nil
 	}
 
-	return t.(IDatabaseFilterContext)
+	return t.(IPrivilegeContext)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *PrivilegeListContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *PrivilegeListContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *PrivilegeListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMemoryDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrivilegeListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMemoryDatabaseStmt(s)
+		listenerT.EnterPrivilegeList(s)
 	}
 }
 
-func (s *ShowMemoryDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMemoryDatabaseStmt(s)
+		listenerT.ExitPrivilegeList(s)
 	}
 }
 
-func (s *ShowMemoryDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrivilegeListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMemoryDatabaseStmt(s)
+		return t.VisitPrivilegeList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtContext) {
+func (p *SQLParser) PrivilegeList() (localctx IPrivilegeListContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMemoryDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SQLParserRULE_showMemoryDatabaseStmt)
+	localctx = NewPrivilegeListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, SQLParserRULE_privilegeList)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3674,179 +11562,124 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 				p.GetErrorHandler().Recover(p, v)
 			} else {
 				panic(err)
-			}
-		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(291)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(292)
-		p.Match(SQLParserT_MEMORY)
-	}
-	{
-		p.SetState(293)
-		p.Match(SQLParserT_DATASBAE)
-	}
-	{
-		p.SetState(294)
-		p.Match(SQLParserT_WHERE)
-	}
-	p.SetState(297)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(295)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_DATASBAE:
-		{
-			p.SetState(296)
-			p.DatabaseFilter()
+			}
 		}
+	}()
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
+	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(299)
-		p.Match(SQLParserT_AND)
+		p.SetState(711)
+		p.Privilege()
 	}
-	p.SetState(302)
+	p.SetState(716)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(300)
-			p.StorageFilter()
+			p.SetState(712)
+			p.Match(SQLParserT_COMMA)
 		}
-
-	case SQLParserT_DATASBAE:
 		{
-			p.SetState(301)
-			p.DatabaseFilter()
+			p.SetState(713)
+			p.Privilege()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(718)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
-type IShowBrokerMetricStmtContext interface {
+// IPrivilegeContext is an interface to support dynamic dispatch.
+type IPrivilegeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
-	IsShowBrokerMetricStmtContext()
+	// IsPrivilegeContext differentiates from other interfaces.
+	IsPrivilegeContext()
 }
 
-type ShowBrokerMetricStmtContext struct {
+type PrivilegeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewEmptyPrivilegeContext() *PrivilegeContext {
+	var p = new(PrivilegeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_privilege
 	return p
 }
 
-func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
+func (*PrivilegeContext) IsPrivilegeContext() {}
 
-func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewPrivilegeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeContext {
+	var p = new(PrivilegeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_privilege
 
 	return p
 }
 
-func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
+func (s *PrivilegeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
+func (s *PrivilegeContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *PrivilegeContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMetricListFilterContext)
+func (s *PrivilegeContext) T_ALL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALL, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *PrivilegeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrivilegeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokerMetricStmt(s)
+		listenerT.EnterPrivilege(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokerMetricStmt(s)
+		listenerT.ExitPrivilege(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrivilegeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokerMetricStmt(s)
+		return t.VisitPrivilege(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
+func (p *SQLParser) Privilege() (localctx IPrivilegeContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SQLParserRULE_showBrokerMetricStmt)
+	localctx = NewPrivilegeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, SQLParserRULE_privilege)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3866,101 +11699,80 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(304)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(305)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(306)
-		p.Match(SQLParserT_METRIC)
-	}
-	{
-		p.SetState(307)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(308)
-		p.MetricListFilter()
+		p.SetState(719)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-115)) & ^0x3f) == 0 && ((int64(1)<<(_la-115))&7) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
 }
 
-// IShowStorageMetricStmtContext is an interface to support dynamic dispatch.
-type IShowStorageMetricStmtContext interface {
+// ICreateTokenStmtContext is an interface to support dynamic dispatch.
+type ICreateTokenStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowStorageMetricStmtContext differentiates from other interfaces.
-	IsShowStorageMetricStmtContext()
+	// IsCreateTokenStmtContext differentiates from other interfaces.
+	IsCreateTokenStmtContext()
 }
 
-type ShowStorageMetricStmtContext struct {
+type CreateTokenStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStorageMetricStmtContext() *ShowStorageMetricStmtContext {
-	var p = new(ShowStorageMetricStmtContext)
+func NewEmptyCreateTokenStmtContext() *CreateTokenStmtContext {
+	var p = new(CreateTokenStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	p.RuleIndex = SQLParserRULE_createTokenStmt
 	return p
 }
 
-func (*ShowStorageMetricStmtContext) IsShowStorageMetricStmtContext() {}
+func (*CreateTokenStmtContext) IsCreateTokenStmtContext() {}
 
-func NewShowStorageMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetricStmtContext {
-	var p = new(ShowStorageMetricStmtContext)
+func NewCreateTokenStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateTokenStmtContext {
+	var p = new(CreateTokenStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	p.RuleIndex = SQLParserRULE_createTokenStmt
 
 	return p
 }
 
-func (s *ShowStorageMetricStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowStorageMetricStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowStorageMetricStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
-}
-
-func (s *ShowStorageMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
+func (s *CreateTokenStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStorageMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *CreateTokenStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+func (s *CreateTokenStmtContext) T_TOKEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKEN, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContext {
+func (s *CreateTokenStmtContext) AllTextValue() []ITextValueContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IStorageFilterContext, len)
+	tst := make([]ITextValueContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
+		if t, ok := ctx.(ITextValueContext); ok {
+			tst[i] = t.(ITextValueContext)
 			i++
 		}
 	}
@@ -3968,11 +11780,11 @@ func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContex
 	return tst
 }
 
-func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContext {
+func (s *CreateTokenStmtContext) TextValue(i int) ITextValueContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -3985,40 +11797,23 @@ func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContex
 		return nil
 	}
 
-	return t.(IStorageFilterContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IMetricListFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IMetricListFilterContext); ok {
-			tst[i] = t.(IMetricListFilterContext)
-			i++
-		}
-	}
+func (s *CreateTokenStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
 
-	return tst
+func (s *CreateTokenStmtContext) T_FOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FOR, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilterContext {
+func (s *CreateTokenStmtContext) PrivilegeList() IPrivilegeListContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IPrivilegeListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4026,45 +11821,50 @@ func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilter
 		return nil
 	}
 
-	return t.(IMetricListFilterContext)
+	return t.(IPrivilegeListContext)
 }
 
-func (s *ShowStorageMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateTokenStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *CreateTokenStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStorageMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateTokenStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStorageMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateTokenStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStorageMetricStmt(s)
+		listenerT.EnterCreateTokenStmt(s)
 	}
 }
 
-func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateTokenStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStorageMetricStmt(s)
+		listenerT.ExitCreateTokenStmt(s)
 	}
 }
 
-func (s *ShowStorageMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateTokenStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStorageMetricStmt(s)
+		return t.VisitCreateTokenStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
+func (p *SQLParser) CreateTokenStmt() (localctx ICreateTokenStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SQLParserRULE_showStorageMetricStmt)
+	localctx = NewCreateTokenStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, SQLParserRULE_createTokenStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4084,117 +11884,102 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(310)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(721)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(311)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(722)
+		p.Match(SQLParserT_TOKEN)
 	}
 	{
-		p.SetState(312)
-		p.Match(SQLParserT_METRIC)
+		p.SetState(723)
+		p.TextValue()
 	}
 	{
-		p.SetState(313)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(724)
+		p.Match(SQLParserT_ON)
 	}
-	p.SetState(316)
+	{
+		p.SetState(725)
+		p.TextValue()
+	}
+	p.SetState(728)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	if _la == SQLParserT_NAMESPACE {
 		{
-			p.SetState(314)
-			p.StorageFilter()
+			p.SetState(726)
+			p.Match(SQLParserT_NAMESPACE)
 		}
-
-	case SQLParserT_METRIC:
 		{
-			p.SetState(315)
-			p.MetricListFilter()
+			p.SetState(727)
+			p.TextValue()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(318)
-		p.Match(SQLParserT_AND)
+		p.SetState(730)
+		p.Match(SQLParserT_FOR)
 	}
-	p.SetState(321)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(319)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_METRIC:
-		{
-			p.SetState(320)
-			p.MetricListFilter()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	{
+		p.SetState(731)
+		p.PrivilegeList()
 	}
 
 	return localctx
 }
 
-// ICreateStorageStmtContext is an interface to support dynamic dispatch.
-type ICreateStorageStmtContext interface {
+// IDropTokenStmtContext is an interface to support dynamic dispatch.
+type IDropTokenStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateStorageStmtContext differentiates from other interfaces.
-	IsCreateStorageStmtContext()
+	// IsDropTokenStmtContext differentiates from other interfaces.
+	IsDropTokenStmtContext()
 }
 
-type CreateStorageStmtContext struct {
+type DropTokenStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewEmptyDropTokenStmtContext() *DropTokenStmtContext {
+	var p = new(DropTokenStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_dropTokenStmt
 	return p
 }
 
-func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
+func (*DropTokenStmtContext) IsDropTokenStmtContext() {}
 
-func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewDropTokenStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropTokenStmtContext {
+	var p = new(DropTokenStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_dropTokenStmt
 
 	return p
 }
 
-func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropTokenStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *DropTokenStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *DropTokenStmtContext) T_TOKEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKEN, 0)
 }
 
-func (s *CreateStorageStmtContext) Json() IJsonContext {
+func (s *DropTokenStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4204,45 +11989,45 @@ func (s *CreateStorageStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(ITextValueContext)
 }
 
-func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropTokenStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropTokenStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropTokenStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateStorageStmt(s)
+		listenerT.EnterDropTokenStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropTokenStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateStorageStmt(s)
+		listenerT.ExitDropTokenStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropTokenStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateStorageStmt(s)
+		return t.VisitDropTokenStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
+func (p *SQLParser) DropTokenStmt() (localctx IDropTokenStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SQLParserRULE_createStorageStmt)
+	localctx = NewDropTokenStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, SQLParserRULE_dropTokenStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4262,119 +12047,103 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(323)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(733)
+		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(324)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(734)
+		p.Match(SQLParserT_TOKEN)
 	}
 	{
-		p.SetState(325)
-		p.Json()
+		p.SetState(735)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// ICreateBrokerStmtContext is an interface to support dynamic dispatch.
-type ICreateBrokerStmtContext interface {
+// IShowTokensStmtContext is an interface to support dynamic dispatch.
+type IShowTokensStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateBrokerStmtContext differentiates from other interfaces.
-	IsCreateBrokerStmtContext()
+	// IsShowTokensStmtContext differentiates from other interfaces.
+	IsShowTokensStmtContext()
 }
 
-type CreateBrokerStmtContext struct {
+type ShowTokensStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateBrokerStmtContext() *CreateBrokerStmtContext {
-	var p = new(CreateBrokerStmtContext)
+func NewEmptyShowTokensStmtContext() *ShowTokensStmtContext {
+	var p = new(ShowTokensStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	p.RuleIndex = SQLParserRULE_showTokensStmt
 	return p
 }
 
-func (*CreateBrokerStmtContext) IsCreateBrokerStmtContext() {}
+func (*ShowTokensStmtContext) IsShowTokensStmtContext() {}
 
-func NewCreateBrokerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateBrokerStmtContext {
-	var p = new(CreateBrokerStmtContext)
+func NewShowTokensStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTokensStmtContext {
+	var p = new(ShowTokensStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	p.RuleIndex = SQLParserRULE_showTokensStmt
 
 	return p
 }
 
-func (s *CreateBrokerStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *CreateBrokerStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
-}
+func (s *ShowTokensStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateBrokerStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
+func (s *ShowTokensStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateBrokerStmtContext) Json() IJsonContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IJsonContext)
+func (s *ShowTokensStmtContext) T_TOKENS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKENS, 0)
 }
 
-func (s *CreateBrokerStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTokensStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateBrokerStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTokensStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateBrokerStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTokensStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateBrokerStmt(s)
+		listenerT.EnterShowTokensStmt(s)
 	}
 }
 
-func (s *CreateBrokerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTokensStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateBrokerStmt(s)
+		listenerT.ExitShowTokensStmt(s)
 	}
 }
 
-func (s *CreateBrokerStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTokensStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateBrokerStmt(s)
+		return t.VisitShowTokensStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
+func (p *SQLParser) ShowTokensStmt() (localctx IShowTokensStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateBrokerStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SQLParserRULE_createBrokerStmt)
+	localctx = NewShowTokensStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, SQLParserRULE_showTokensStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4394,71 +12163,67 @@ func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(327)
-		p.Match(SQLParserT_CREATE)
-	}
-	{
-		p.SetState(328)
-		p.Match(SQLParserT_BROKER)
+		p.SetState(737)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(329)
-		p.Json()
+		p.SetState(738)
+		p.Match(SQLParserT_TOKENS)
 	}
 
 	return localctx
 }
 
-// IRecoverStorageStmtContext is an interface to support dynamic dispatch.
-type IRecoverStorageStmtContext interface {
+// ICreateTenantStmtContext is an interface to support dynamic dispatch.
+type ICreateTenantStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRecoverStorageStmtContext differentiates from other interfaces.
-	IsRecoverStorageStmtContext()
+	// IsCreateTenantStmtContext differentiates from other interfaces.
+	IsCreateTenantStmtContext()
 }
 
-type RecoverStorageStmtContext struct {
+type CreateTenantStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRecoverStorageStmtContext() *RecoverStorageStmtContext {
-	var p = new(RecoverStorageStmtContext)
+func NewEmptyCreateTenantStmtContext() *CreateTenantStmtContext {
+	var p = new(CreateTenantStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	p.RuleIndex = SQLParserRULE_createTenantStmt
 	return p
 }
 
-func (*RecoverStorageStmtContext) IsRecoverStorageStmtContext() {}
+func (*CreateTenantStmtContext) IsCreateTenantStmtContext() {}
 
-func NewRecoverStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecoverStorageStmtContext {
-	var p = new(RecoverStorageStmtContext)
+func NewCreateTenantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateTenantStmtContext {
+	var p = new(CreateTenantStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	p.RuleIndex = SQLParserRULE_createTenantStmt
 
 	return p
 }
 
-func (s *RecoverStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateTenantStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RecoverStorageStmtContext) T_RECOVER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_RECOVER, 0)
+func (s *CreateTenantStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *RecoverStorageStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *CreateTenantStmtContext) T_TENANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANT, 0)
 }
 
-func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
+func (s *CreateTenantStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageNameContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4468,45 +12233,87 @@ func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
 		return nil
 	}
 
-	return t.(IStorageNameContext)
+	return t.(ITextValueContext)
 }
 
-func (s *RecoverStorageStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateTenantStmtContext) AllTenantQuota() []ITenantQuotaContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITenantQuotaContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITenantQuotaContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITenantQuotaContext); ok {
+			tst[i] = t.(ITenantQuotaContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateTenantStmtContext) TenantQuota(i int) ITenantQuotaContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITenantQuotaContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITenantQuotaContext)
+}
+
+func (s *CreateTenantStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RecoverStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateTenantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RecoverStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateTenantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRecoverStorageStmt(s)
+		listenerT.EnterCreateTenantStmt(s)
 	}
 }
 
-func (s *RecoverStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateTenantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRecoverStorageStmt(s)
+		listenerT.ExitCreateTenantStmt(s)
 	}
 }
 
-func (s *RecoverStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateTenantStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRecoverStorageStmt(s)
+		return t.VisitCreateTenantStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
+func (p *SQLParser) CreateTenantStmt() (localctx ICreateTenantStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewRecoverStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SQLParserRULE_recoverStorageStmt)
+	localctx = NewCreateTenantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, SQLParserRULE_createTenantStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4526,103 +12333,175 @@ func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(331)
-		p.Match(SQLParserT_RECOVER)
+		p.SetState(740)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(332)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(741)
+		p.Match(SQLParserT_TENANT)
 	}
 	{
-		p.SetState(333)
-		p.StorageName()
+		p.SetState(742)
+		p.TextValue()
+	}
+	p.SetState(746)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64((_la-121)) & ^0x3f) == 0 && ((int64(1)<<(_la-121))&15) != 0 {
+		{
+			p.SetState(743)
+			p.TenantQuota()
+		}
+
+		p.SetState(748)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IShowSchemasStmtContext is an interface to support dynamic dispatch.
-type IShowSchemasStmtContext interface {
+// IAlterTenantStmtContext is an interface to support dynamic dispatch.
+type IAlterTenantStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowSchemasStmtContext differentiates from other interfaces.
-	IsShowSchemasStmtContext()
+	// IsAlterTenantStmtContext differentiates from other interfaces.
+	IsAlterTenantStmtContext()
 }
 
-type ShowSchemasStmtContext struct {
+type AlterTenantStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewEmptyAlterTenantStmtContext() *AlterTenantStmtContext {
+	var p = new(AlterTenantStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_alterTenantStmt
 	return p
 }
 
-func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
+func (*AlterTenantStmtContext) IsAlterTenantStmtContext() {}
 
-func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewAlterTenantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterTenantStmtContext {
+	var p = new(AlterTenantStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_alterTenantStmt
 
 	return p
 }
 
-func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterTenantStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *AlterTenantStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
 }
 
-func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SCHEMAS, 0)
+func (s *AlterTenantStmtContext) T_TENANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANT, 0)
 }
 
-func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterTenantStmtContext) TextValue() ITextValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITextValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITextValueContext)
+}
+
+func (s *AlterTenantStmtContext) AllTenantQuota() []ITenantQuotaContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITenantQuotaContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITenantQuotaContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITenantQuotaContext); ok {
+			tst[i] = t.(ITenantQuotaContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AlterTenantStmtContext) TenantQuota(i int) ITenantQuotaContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITenantQuotaContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITenantQuotaContext)
+}
+
+func (s *AlterTenantStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterTenantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterTenantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowSchemasStmt(s)
+		listenerT.EnterAlterTenantStmt(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterTenantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowSchemasStmt(s)
+		listenerT.ExitAlterTenantStmt(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterTenantStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowSchemasStmt(s)
+		return t.VisitAlterTenantStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
+func (p *SQLParser) AlterTenantStmt() (localctx IAlterTenantStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SQLParserRULE_showSchemasStmt)
+	localctx = NewAlterTenantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, SQLParserRULE_alterTenantStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4642,67 +12521,97 @@ func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(335)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(749)
+		p.Match(SQLParserT_ALTER)
 	}
 	{
-		p.SetState(336)
-		p.Match(SQLParserT_SCHEMAS)
+		p.SetState(750)
+		p.Match(SQLParserT_TENANT)
+	}
+	{
+		p.SetState(751)
+		p.TextValue()
+	}
+	p.SetState(753)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = (int64((_la-121)) & ^0x3f) == 0 && ((int64(1)<<(_la-121))&15) != 0 {
+		{
+			p.SetState(752)
+			p.TenantQuota()
+		}
+
+		p.SetState(755)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
-type ICreateDatabaseStmtContext interface {
+// ITenantQuotaContext is an interface to support dynamic dispatch.
+type ITenantQuotaContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateDatabaseStmtContext differentiates from other interfaces.
-	IsCreateDatabaseStmtContext()
+	// IsTenantQuotaContext differentiates from other interfaces.
+	IsTenantQuotaContext()
 }
 
-type CreateDatabaseStmtContext struct {
+type TenantQuotaContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewEmptyTenantQuotaContext() *TenantQuotaContext {
+	var p = new(TenantQuotaContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_tenantQuota
 	return p
 }
 
-func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
+func (*TenantQuotaContext) IsTenantQuotaContext() {}
 
-func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewTenantQuotaContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TenantQuotaContext {
+	var p = new(TenantQuotaContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_tenantQuota
 
 	return p
 }
 
-func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *TenantQuotaContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *TenantQuotaContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
 }
 
-func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *TenantQuotaContext) T_INGEST_RATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INGEST_RATE, 0)
 }
 
-func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+func (s *TenantQuotaContext) T_MAX_SERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MAX_SERIES, 0)
+}
+
+func (s *TenantQuotaContext) T_MAX_QUERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MAX_QUERIES, 0)
+}
+
+func (s *TenantQuotaContext) T_MAX_DISK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MAX_DISK, 0)
+}
+
+func (s *TenantQuotaContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4712,45 +12621,46 @@ func (s *CreateDatabaseStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(ITextValueContext)
 }
 
-func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TenantQuotaContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TenantQuotaContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TenantQuotaContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateDatabaseStmt(s)
+		listenerT.EnterTenantQuota(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TenantQuotaContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateDatabaseStmt(s)
+		listenerT.ExitTenantQuota(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TenantQuotaContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateDatabaseStmt(s)
+		return t.VisitTenantQuota(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
+func (p *SQLParser) TenantQuota() (localctx ITenantQuotaContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SQLParserRULE_createDatabaseStmt)
+	localctx = NewTenantQuotaContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, SQLParserRULE_tenantQuota)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4768,73 +12678,111 @@ func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(338)
-		p.Match(SQLParserT_CREATE)
-	}
-	{
-		p.SetState(339)
-		p.Match(SQLParserT_DATASBAE)
-	}
-	{
-		p.SetState(340)
-		p.Json()
+	p.SetState(764)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(757)
+			_la = p.GetTokenStream().LA(1)
+
+			if !((int64((_la-121)) & ^0x3f) == 0 && ((int64(1)<<(_la-121))&7) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(758)
+			p.Match(SQLParserL_INT)
+		}
+
+	case SQLParserT_MAX_DISK:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(759)
+			p.Match(SQLParserT_MAX_DISK)
+		}
+		p.SetState(762)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SQLParserL_INT:
+			{
+				p.SetState(760)
+				p.Match(SQLParserL_INT)
+			}
+
+		case SQLParserSTRING, SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_TO, SQLParserT_COMPACTION, SQLParserT_FAMILY, SQLParserT_SLOW, SQLParserT_RECORDING, SQLParserT_RULE, SQLParserT_RULES, SQLParserT_INTO, SQLParserT_EVERY, SQLParserT_ALERT, SQLParserT_ALERTS, SQLParserT_WHEN, SQLParserT_LABELS, SQLParserT_WEBHOOK, SQLParserT_FORMAT, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_TOKENS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL, SQLParserT_TENANT, SQLParserT_TENANTS, SQLParserT_USAGE, SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES, SQLParserT_MAX_DISK, SQLParserT_AUDIT, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+			{
+				p.SetState(761)
+				p.TextValue()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
-type IDropDatabaseStmtContext interface {
+// IDropTenantStmtContext is an interface to support dynamic dispatch.
+type IDropTenantStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDropDatabaseStmtContext differentiates from other interfaces.
-	IsDropDatabaseStmtContext()
+	// IsDropTenantStmtContext differentiates from other interfaces.
+	IsDropTenantStmtContext()
 }
 
-type DropDatabaseStmtContext struct {
+type DropTenantStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewEmptyDropTenantStmtContext() *DropTenantStmtContext {
+	var p = new(DropTenantStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_dropTenantStmt
 	return p
 }
 
-func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
+func (*DropTenantStmtContext) IsDropTenantStmtContext() {}
 
-func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewDropTenantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropTenantStmtContext {
+	var p = new(DropTenantStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_dropTenantStmt
 
 	return p
 }
 
-func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropTenantStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
+func (s *DropTenantStmtContext) T_DROP() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *DropTenantStmtContext) T_TENANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANT, 0)
 }
 
-func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+func (s *DropTenantStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseNameContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4844,45 +12792,45 @@ func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 		return nil
 	}
 
-	return t.(IDatabaseNameContext)
+	return t.(ITextValueContext)
 }
 
-func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropTenantStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropTenantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropTenantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDropDatabaseStmt(s)
+		listenerT.EnterDropTenantStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropTenantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDropDatabaseStmt(s)
+		listenerT.ExitDropTenantStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropTenantStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDropDatabaseStmt(s)
+		return t.VisitDropTenantStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
+func (p *SQLParser) DropTenantStmt() (localctx IDropTenantStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SQLParserRULE_dropDatabaseStmt)
+	localctx = NewDropTenantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, SQLParserRULE_dropTenantStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4902,103 +12850,103 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(342)
+		p.SetState(766)
 		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(343)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(767)
+		p.Match(SQLParserT_TENANT)
 	}
 	{
-		p.SetState(344)
-		p.DatabaseName()
+		p.SetState(768)
+		p.TextValue()
 	}
 
 	return localctx
 }
 
-// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowDatabaseStmtContext interface {
+// IShowTenantsStmtContext is an interface to support dynamic dispatch.
+type IShowTenantsStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowDatabaseStmtContext differentiates from other interfaces.
-	IsShowDatabaseStmtContext()
+	// IsShowTenantsStmtContext differentiates from other interfaces.
+	IsShowTenantsStmtContext()
 }
 
-type ShowDatabaseStmtContext struct {
+type ShowTenantsStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewEmptyShowTenantsStmtContext() *ShowTenantsStmtContext {
+	var p = new(ShowTenantsStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showTenantsStmt
 	return p
 }
 
-func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
+func (*ShowTenantsStmtContext) IsShowTenantsStmtContext() {}
 
-func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewShowTenantsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTenantsStmtContext {
+	var p = new(ShowTenantsStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showTenantsStmt
 
 	return p
 }
 
-func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTenantsStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowTenantsStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAES, 0)
+func (s *ShowTenantsStmtContext) T_TENANTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANTS, 0)
 }
 
-func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTenantsStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTenantsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTenantsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowDatabaseStmt(s)
+		listenerT.EnterShowTenantsStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTenantsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowDatabaseStmt(s)
+		listenerT.ExitShowTenantsStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTenantsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowDatabaseStmt(s)
+		return t.VisitShowTenantsStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
+func (p *SQLParser) ShowTenantsStmt() (localctx IShowTenantsStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SQLParserRULE_showDatabaseStmt)
+	localctx = NewShowTenantsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, SQLParserRULE_showTenantsStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5018,95 +12966,71 @@ func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(346)
+		p.SetState(770)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(347)
-		p.Match(SQLParserT_DATASBAES)
+		p.SetState(771)
+		p.Match(SQLParserT_TENANTS)
 	}
 
 	return localctx
 }
 
-// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
-type IShowNameSpacesStmtContext interface {
+// IShowTenantUsageStmtContext is an interface to support dynamic dispatch.
+type IShowTenantUsageStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowNameSpacesStmtContext differentiates from other interfaces.
-	IsShowNameSpacesStmtContext()
+	// IsShowTenantUsageStmtContext differentiates from other interfaces.
+	IsShowTenantUsageStmtContext()
 }
 
-type ShowNameSpacesStmtContext struct {
+type ShowTenantUsageStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewEmptyShowTenantUsageStmtContext() *ShowTenantUsageStmtContext {
+	var p = new(ShowTenantUsageStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_showTenantUsageStmt
 	return p
 }
 
-func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+func (*ShowTenantUsageStmtContext) IsShowTenantUsageStmtContext() {}
 
-func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewShowTenantUsageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTenantUsageStmtContext {
+	var p = new(ShowTenantUsageStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_showTenantUsageStmt
 
 	return p
 }
 
-func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTenantUsageStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowTenantUsageStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACES, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACE, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *ShowTenantUsageStmtContext) T_TENANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANT, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPrefixContext)
+func (s *ShowTenantUsageStmtContext) T_USAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USAGE, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+func (s *ShowTenantUsageStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5116,45 +13040,45 @@ func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTenantUsageStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTenantUsageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTenantUsageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowNameSpacesStmt(s)
+		listenerT.EnterShowTenantUsageStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTenantUsageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowNameSpacesStmt(s)
+		listenerT.ExitShowTenantUsageStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTenantUsageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowNameSpacesStmt(s)
+		return t.VisitShowTenantUsageStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+func (p *SQLParser) ShowTenantUsageStmt() (localctx IShowTenantUsageStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SQLParserRULE_showNameSpacesStmt)
+	localctx = NewShowTenantUsageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, SQLParserRULE_showTenantUsageStmt)
 	var _la int
 
 	defer func() {
@@ -5175,44 +13099,25 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(349)
+		p.SetState(773)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(350)
-		p.Match(SQLParserT_NAMESPACES)
+		p.SetState(774)
+		p.Match(SQLParserT_TENANT)
 	}
-	p.SetState(355)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(351)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(352)
-			p.Match(SQLParserT_NAMESPACE)
-		}
-		{
-			p.SetState(353)
-			p.Match(SQLParserT_EQUAL)
-		}
-		{
-			p.SetState(354)
-			p.Prefix()
-		}
-
+	{
+		p.SetState(775)
+		p.Match(SQLParserT_USAGE)
 	}
-	p.SetState(358)
+	p.SetState(777)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_LIMIT {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194352) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9221683186763825151) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&8796093284351) != 0 {
 		{
-			p.SetState(357)
-			p.LimitClause()
+			p.SetState(776)
+			p.TextValue()
 		}
 
 	}
@@ -5220,101 +13125,57 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 	return localctx
 }
 
-// IShowMetricsStmtContext is an interface to support dynamic dispatch.
-type IShowMetricsStmtContext interface {
+// IShowAuditLogStmtContext is an interface to support dynamic dispatch.
+type IShowAuditLogStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMetricsStmtContext differentiates from other interfaces.
-	IsShowMetricsStmtContext()
+	// IsShowAuditLogStmtContext differentiates from other interfaces.
+	IsShowAuditLogStmtContext()
 }
 
-type ShowMetricsStmtContext struct {
+type ShowAuditLogStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewEmptyShowAuditLogStmtContext() *ShowAuditLogStmtContext {
+	var p = new(ShowAuditLogStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showAuditLogStmt
 	return p
 }
 
-func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+func (*ShowAuditLogStmtContext) IsShowAuditLogStmtContext() {}
 
-func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewShowAuditLogStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAuditLogStmtContext {
+	var p = new(ShowAuditLogStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showAuditLogStmt
 
 	return p
 }
 
-func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowAuditLogStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowAuditLogStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRICS, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
-}
-
-func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INamespaceContext)
-}
-
-func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
-
-func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ShowAuditLogStmtContext) T_AUDIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AUDIT, 0)
+}
 
-	return t.(IPrefixContext)
+func (s *ShowAuditLogStmtContext) T_LOG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG, 0)
 }
 
-func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+func (s *ShowAuditLogStmtContext) LimitClause() ILimitClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ILimitClauseContext); ok {
@@ -5330,42 +13191,42 @@ func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
 	return t.(ILimitClauseContext)
 }
 
-func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowAuditLogStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowAuditLogStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowAuditLogStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetricsStmt(s)
+		listenerT.EnterShowAuditLogStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowAuditLogStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetricsStmt(s)
+		listenerT.ExitShowAuditLogStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowAuditLogStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetricsStmt(s)
+		return t.VisitShowAuditLogStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+func (p *SQLParser) ShowAuditLogStmt() (localctx IShowAuditLogStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SQLParserRULE_showMetricsStmt)
+	localctx = NewShowAuditLogStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, SQLParserRULE_showAuditLogStmt)
 	var _la int
 
 	defer func() {
@@ -5386,58 +13247,24 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(360)
+		p.SetState(779)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(361)
-		p.Match(SQLParserT_METRICS)
-	}
-	p.SetState(364)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ON {
-		{
-			p.SetState(362)
-			p.Match(SQLParserT_ON)
-		}
-		{
-			p.SetState(363)
-			p.Namespace()
-		}
-
+		p.SetState(780)
+		p.Match(SQLParserT_AUDIT)
 	}
-	p.SetState(370)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(366)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(367)
-			p.Match(SQLParserT_METRIC)
-		}
-		{
-			p.SetState(368)
-			p.Match(SQLParserT_EQUAL)
-		}
-		{
-			p.SetState(369)
-			p.Prefix()
-		}
-
+	{
+		p.SetState(781)
+		p.Match(SQLParserT_LOG)
 	}
-	p.SetState(373)
+	p.SetState(783)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(372)
+			p.SetState(782)
 			p.LimitClause()
 		}
 
@@ -5446,56 +13273,56 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 	return localctx
 }
 
-// IShowFieldsStmtContext is an interface to support dynamic dispatch.
-type IShowFieldsStmtContext interface {
+// IShowRebalanceStmtContext is an interface to support dynamic dispatch.
+type IShowRebalanceStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowFieldsStmtContext differentiates from other interfaces.
-	IsShowFieldsStmtContext()
+	// IsShowRebalanceStmtContext differentiates from other interfaces.
+	IsShowRebalanceStmtContext()
 }
 
-type ShowFieldsStmtContext struct {
+type ShowRebalanceStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowFieldsStmtContext() *ShowFieldsStmtContext {
-	var p = new(ShowFieldsStmtContext)
+func NewEmptyShowRebalanceStmtContext() *ShowRebalanceStmtContext {
+	var p = new(ShowRebalanceStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	p.RuleIndex = SQLParserRULE_showRebalanceStmt
 	return p
 }
 
-func (*ShowFieldsStmtContext) IsShowFieldsStmtContext() {}
+func (*ShowRebalanceStmtContext) IsShowRebalanceStmtContext() {}
 
-func NewShowFieldsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldsStmtContext {
-	var p = new(ShowFieldsStmtContext)
+func NewShowRebalanceStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRebalanceStmtContext {
+	var p = new(ShowRebalanceStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	p.RuleIndex = SQLParserRULE_showRebalanceStmt
 
 	return p
 }
 
-func (s *ShowFieldsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowRebalanceStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowFieldsStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowRebalanceStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowFieldsStmtContext) T_FIELDS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FIELDS, 0)
+func (s *ShowRebalanceStmtContext) T_REBALANCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REBALANCE, 0)
 }
 
-func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
+func (s *ShowRebalanceStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5505,45 +13332,46 @@ func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowFieldsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowRebalanceStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowFieldsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowRebalanceStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowFieldsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowRebalanceStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowFieldsStmt(s)
+		listenerT.EnterShowRebalanceStmt(s)
 	}
 }
 
-func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowRebalanceStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowFieldsStmt(s)
+		listenerT.ExitShowRebalanceStmt(s)
 	}
 }
 
-func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowRebalanceStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowFieldsStmt(s)
+		return t.VisitShowRebalanceStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
+func (p *SQLParser) ShowRebalanceStmt() (localctx IShowRebalanceStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SQLParserRULE_showFieldsStmt)
+	localctx = NewShowRebalanceStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, SQLParserRULE_showRebalanceStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5563,75 +13391,90 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(375)
+		p.SetState(785)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(376)
-		p.Match(SQLParserT_FIELDS)
+		p.SetState(786)
+		p.Match(SQLParserT_REBALANCE)
 	}
-	{
-		p.SetState(377)
-		p.FromClause()
+	p.SetState(788)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194352) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9221683186763825151) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&8796093284351) != 0 {
+		{
+			p.SetState(787)
+			p.TextValue()
+		}
+
 	}
 
 	return localctx
 }
 
-// IShowTagKeysStmtContext is an interface to support dynamic dispatch.
-type IShowTagKeysStmtContext interface {
+// IDecommissionStmtContext is an interface to support dynamic dispatch.
+type IDecommissionStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowTagKeysStmtContext differentiates from other interfaces.
-	IsShowTagKeysStmtContext()
+	// IsDecommissionStmtContext differentiates from other interfaces.
+	IsDecommissionStmtContext()
 }
 
-type ShowTagKeysStmtContext struct {
+type DecommissionStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagKeysStmtContext() *ShowTagKeysStmtContext {
-	var p = new(ShowTagKeysStmtContext)
+func NewEmptyDecommissionStmtContext() *DecommissionStmtContext {
+	var p = new(DecommissionStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	p.RuleIndex = SQLParserRULE_decommissionStmt
 	return p
 }
 
-func (*ShowTagKeysStmtContext) IsShowTagKeysStmtContext() {}
+func (*DecommissionStmtContext) IsDecommissionStmtContext() {}
 
-func NewShowTagKeysStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeysStmtContext {
-	var p = new(ShowTagKeysStmtContext)
+func NewDecommissionStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecommissionStmtContext {
+	var p = new(DecommissionStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	p.RuleIndex = SQLParserRULE_decommissionStmt
 
 	return p
 }
 
-func (s *ShowTagKeysStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DecommissionStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagKeysStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *DecommissionStmtContext) T_DECOMMISSION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DECOMMISSION, 0)
 }
 
-func (s *ShowTagKeysStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
+func (s *DecommissionStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *ShowTagKeysStmtContext) T_KEYS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEYS, 0)
+func (s *DecommissionStmtContext) T_NODE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NODE, 0)
 }
 
-func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
+func (s *DecommissionStmtContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *DecommissionStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *DecommissionStmtContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5641,45 +13484,46 @@ func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowTagKeysStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DecommissionStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagKeysStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DecommissionStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagKeysStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DecommissionStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagKeysStmt(s)
+		listenerT.EnterDecommissionStmt(s)
 	}
 }
 
-func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DecommissionStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagKeysStmt(s)
+		listenerT.ExitDecommissionStmt(s)
 	}
 }
 
-func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DecommissionStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagKeysStmt(s)
+		return t.VisitDecommissionStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
+func (p *SQLParser) DecommissionStmt() (localctx IDecommissionStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SQLParserRULE_showTagKeysStmt)
+	localctx = NewDecommissionStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, SQLParserRULE_decommissionStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5699,79 +13543,98 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(379)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(790)
+		p.Match(SQLParserT_DECOMMISSION)
 	}
 	{
-		p.SetState(380)
-		p.Match(SQLParserT_TAG)
+		p.SetState(791)
+		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(381)
-		p.Match(SQLParserT_KEYS)
+		p.SetState(792)
+		p.Match(SQLParserT_NODE)
 	}
 	{
-		p.SetState(382)
-		p.FromClause()
+		p.SetState(793)
+		p.Match(SQLParserL_INT)
+	}
+	p.SetState(796)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(794)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(795)
+			p.TextValue()
+		}
+
 	}
 
 	return localctx
 }
 
-// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
-type IShowTagValuesStmtContext interface {
+// IDurationValueContext is an interface to support dynamic dispatch.
+type IDurationValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowTagValuesStmtContext differentiates from other interfaces.
-	IsShowTagValuesStmtContext()
+	// IsDurationValueContext differentiates from other interfaces.
+	IsDurationValueContext()
 }
 
-type ShowTagValuesStmtContext struct {
+type DurationValueContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewEmptyDurationValueContext() *DurationValueContext {
+	var p = new(DurationValueContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_durationValue
 	return p
 }
 
-func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
+func (*DurationValueContext) IsDurationValueContext() {}
 
-func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewDurationValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DurationValueContext {
+	var p = new(DurationValueContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_durationValue
 
 	return p
 }
 
-func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DurationValueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
+func (s *DurationValueContext) DurationLit() IDurationLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_VALUES, 0)
+	return t.(IDurationLitContext)
 }
 
-func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
+func (s *DurationValueContext) TextValue() ITextValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(ITextValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5781,57 +13644,129 @@ func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(ITextValueContext)
 }
 
-func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH, 0)
+func (s *DurationValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DurationValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DurationValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDurationValue(s)
+	}
+}
+
+func (s *DurationValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDurationValue(s)
+	}
+}
+
+func (s *DurationValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDurationValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) DurationValue() (localctx IDurationValueContext) {
+	this := p
+	_ = this
+
+	localctx = NewDurationValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, SQLParserRULE_durationValue)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(800)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_ADD, SQLParserT_SUB, SQLParserL_INT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(798)
+			p.DurationLit()
+		}
+
+	case SQLParserSTRING, SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_TO, SQLParserT_COMPACTION, SQLParserT_FAMILY, SQLParserT_SLOW, SQLParserT_RECORDING, SQLParserT_RULE, SQLParserT_RULES, SQLParserT_INTO, SQLParserT_EVERY, SQLParserT_ALERT, SQLParserT_ALERTS, SQLParserT_WHEN, SQLParserT_LABELS, SQLParserT_WEBHOOK, SQLParserT_FORMAT, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_TOKENS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL, SQLParserT_TENANT, SQLParserT_TENANTS, SQLParserT_USAGE, SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES, SQLParserT_MAX_DISK, SQLParserT_AUDIT, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(799)
+			p.TextValue()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
 }
 
-func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEY, 0)
+// ITextValueContext is an interface to support dynamic dispatch.
+type ITextValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTextValueContext differentiates from other interfaces.
+	IsTextValueContext()
 }
 
-func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+type TextValueContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWithTagKeyContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func NewEmptyTextValueContext() *TextValueContext {
+	var p = new(TextValueContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_textValue
+	return p
+}
 
-	if t == nil {
-		return nil
-	}
+func (*TextValueContext) IsTextValueContext() {}
 
-	return t.(IWithTagKeyContext)
-}
+func NewTextValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TextValueContext {
+	var p = new(TextValueContext)
 
-func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_textValue
 
-	return t.(IWhereClauseContext)
+	return p
 }
 
-func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
+func (s *TextValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TextValueContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5841,46 +13776,49 @@ func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(IIdentContext)
 }
 
-func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TextValueContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SQLParserSTRING, 0)
+}
+
+func (s *TextValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TextValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TextValueContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagValuesStmt(s)
+		listenerT.EnterTextValue(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TextValueContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagValuesStmt(s)
+		listenerT.ExitTextValue(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TextValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagValuesStmt(s)
+		return t.VisitTextValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
+func (p *SQLParser) TextValue() (localctx ITextValueContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SQLParserRULE_showTagValuesStmt)
-	var _la int
+	localctx = NewTextValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, SQLParserRULE_textValue)
 
 	defer func() {
 		p.ExitRule()
@@ -5898,60 +13836,26 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(384)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(385)
-		p.Match(SQLParserT_TAG)
-	}
-	{
-		p.SetState(386)
-		p.Match(SQLParserT_VALUES)
-	}
-	{
-		p.SetState(387)
-		p.FromClause()
-	}
-	{
-		p.SetState(388)
-		p.Match(SQLParserT_WITH)
-	}
-	{
-		p.SetState(389)
-		p.Match(SQLParserT_KEY)
-	}
-	{
-		p.SetState(390)
-		p.Match(SQLParserT_EQUAL)
-	}
-	{
-		p.SetState(391)
-		p.WithTagKey()
-	}
-	p.SetState(393)
+	p.SetState(804)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_WHERE {
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_TO, SQLParserT_COMPACTION, SQLParserT_FAMILY, SQLParserT_SLOW, SQLParserT_RECORDING, SQLParserT_RULE, SQLParserT_RULES, SQLParserT_INTO, SQLParserT_EVERY, SQLParserT_ALERT, SQLParserT_ALERTS, SQLParserT_WHEN, SQLParserT_LABELS, SQLParserT_WEBHOOK, SQLParserT_FORMAT, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_TOKENS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL, SQLParserT_TENANT, SQLParserT_TENANTS, SQLParserT_USAGE, SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES, SQLParserT_MAX_DISK, SQLParserT_AUDIT, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(392)
-			p.WhereClause()
+			p.SetState(802)
+			p.Ident()
 		}
 
-	}
-	p.SetState(396)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
+	case SQLParserSTRING:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(395)
-			p.LimitClause()
+			p.SetState(803)
+			p.Match(SQLParserSTRING)
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -6046,7 +13950,7 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 	_ = this
 
 	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SQLParserRULE_prefix)
+	p.EnterRule(localctx, 146, SQLParserRULE_prefix)
 
 	defer func() {
 		p.ExitRule()
@@ -6066,7 +13970,7 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(398)
+		p.SetState(806)
 		p.Ident()
 	}
 
@@ -6162,7 +14066,7 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 	_ = this
 
 	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SQLParserRULE_withTagKey)
+	p.EnterRule(localctx, 148, SQLParserRULE_withTagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -6182,7 +14086,7 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(400)
+		p.SetState(808)
 		p.Ident()
 	}
 
@@ -6278,7 +14182,7 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 	_ = this
 
 	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SQLParserRULE_namespace)
+	p.EnterRule(localctx, 150, SQLParserRULE_namespace)
 
 	defer func() {
 		p.ExitRule()
@@ -6298,7 +14202,7 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(402)
+		p.SetState(810)
 		p.Ident()
 	}
 
@@ -6394,7 +14298,7 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 	_ = this
 
 	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SQLParserRULE_databaseName)
+	p.EnterRule(localctx, 152, SQLParserRULE_databaseName)
 
 	defer func() {
 		p.ExitRule()
@@ -6414,7 +14318,7 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(404)
+		p.SetState(812)
 		p.Ident()
 	}
 
@@ -6510,7 +14414,7 @@ func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
 	_ = this
 
 	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SQLParserRULE_storageName)
+	p.EnterRule(localctx, 154, SQLParserRULE_storageName)
 
 	defer func() {
 		p.ExitRule()
@@ -6530,7 +14434,7 @@ func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(406)
+		p.SetState(814)
 		p.Ident()
 	}
 
@@ -6626,7 +14530,7 @@ func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 	_ = this
 
 	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SQLParserRULE_requestID)
+	p.EnterRule(localctx, 156, SQLParserRULE_requestID)
 
 	defer func() {
 		p.ExitRule()
@@ -6646,7 +14550,7 @@ func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(408)
+		p.SetState(816)
 		p.Ident()
 	}
 
@@ -6734,7 +14638,7 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 	_ = this
 
 	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SQLParserRULE_source)
+	p.EnterRule(localctx, 158, SQLParserRULE_source)
 	var _la int
 
 	defer func() {
@@ -6755,7 +14659,7 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(410)
+		p.SetState(818)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
@@ -6930,7 +14834,7 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	_ = this
 
 	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SQLParserRULE_queryStmt)
+	p.EnterRule(localctx, 160, SQLParserRULE_queryStmt)
 	var _la int
 
 	defer func() {
@@ -6950,72 +14854,72 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(413)
+	p.SetState(821)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_EXPLAIN {
 		{
-			p.SetState(412)
+			p.SetState(820)
 			p.Match(SQLParserT_EXPLAIN)
 		}
 
 	}
 	{
-		p.SetState(415)
+		p.SetState(823)
 		p.SourceAndSelect()
 	}
-	p.SetState(417)
+	p.SetState(825)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(416)
+			p.SetState(824)
 			p.WhereClause()
 		}
 
 	}
-	p.SetState(420)
+	p.SetState(828)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_GROUP {
 		{
-			p.SetState(419)
+			p.SetState(827)
 			p.GroupByClause()
 		}
 
 	}
-	p.SetState(423)
+	p.SetState(831)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ORDER {
 		{
-			p.SetState(422)
+			p.SetState(830)
 			p.OrderByClause()
 		}
 
 	}
-	p.SetState(426)
+	p.SetState(834)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(425)
+			p.SetState(833)
 			p.LimitClause()
 		}
 
 	}
-	p.SetState(429)
+	p.SetState(837)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WITH_VALUE {
 		{
-			p.SetState(428)
+			p.SetState(836)
 			p.Match(SQLParserT_WITH_VALUE)
 		}
 
@@ -7129,7 +15033,7 @@ func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
 	_ = this
 
 	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SQLParserRULE_sourceAndSelect)
+	p.EnterRule(localctx, 162, SQLParserRULE_sourceAndSelect)
 
 	defer func() {
 		p.ExitRule()
@@ -7147,29 +15051,29 @@ func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
 		}
 	}()
 
-	p.SetState(437)
+	p.SetState(845)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_SELECT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(431)
+			p.SetState(839)
 			p.SelectExpr()
 		}
 		{
-			p.SetState(432)
+			p.SetState(840)
 			p.FromClause()
 		}
 
 	case SQLParserT_FROM:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(434)
+			p.SetState(842)
 			p.FromClause()
 		}
 		{
-			p.SetState(435)
+			p.SetState(843)
 			p.SelectExpr()
 		}
 
@@ -7273,7 +15177,7 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 	_ = this
 
 	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SQLParserRULE_selectExpr)
+	p.EnterRule(localctx, 164, SQLParserRULE_selectExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -7293,11 +15197,11 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(439)
+		p.SetState(847)
 		p.Match(SQLParserT_SELECT)
 	}
 	{
-		p.SetState(440)
+		p.SetState(848)
 		p.Fields()
 	}
 
@@ -7426,7 +15330,7 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 	_ = this
 
 	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, SQLParserRULE_fields)
+	p.EnterRule(localctx, 166, SQLParserRULE_fields)
 	var _la int
 
 	defer func() {
@@ -7447,24 +15351,24 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(442)
+		p.SetState(850)
 		p.Field()
 	}
-	p.SetState(447)
+	p.SetState(855)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(443)
+			p.SetState(851)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(444)
+			p.SetState(852)
 			p.Field()
 		}
 
-		p.SetState(449)
+		p.SetState(857)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -7577,7 +15481,7 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 	_ = this
 
 	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SQLParserRULE_field)
+	p.EnterRule(localctx, 168, SQLParserRULE_field)
 	var _la int
 
 	defer func() {
@@ -7598,16 +15502,16 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(450)
+		p.SetState(858)
 		p.fieldExpr(0)
 	}
-	p.SetState(452)
+	p.SetState(860)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_AS {
 		{
-			p.SetState(451)
+			p.SetState(859)
 			p.Alias()
 		}
 
@@ -7709,7 +15613,7 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 	_ = this
 
 	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SQLParserRULE_alias)
+	p.EnterRule(localctx, 170, SQLParserRULE_alias)
 
 	defer func() {
 		p.ExitRule()
@@ -7729,11 +15633,11 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(454)
+		p.SetState(862)
 		p.Match(SQLParserT_AS)
 	}
 	{
-		p.SetState(455)
+		p.SetState(863)
 		p.Ident()
 	}
 
@@ -7837,7 +15741,7 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 	_ = this
 
 	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SQLParserRULE_storageFilter)
+	p.EnterRule(localctx, 172, SQLParserRULE_storageFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -7857,15 +15761,15 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(457)
+		p.SetState(865)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(458)
+		p.SetState(866)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(459)
+		p.SetState(867)
 		p.Ident()
 	}
 
@@ -7969,7 +15873,7 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 	_ = this
 
 	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, SQLParserRULE_databaseFilter)
+	p.EnterRule(localctx, 174, SQLParserRULE_databaseFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -7989,15 +15893,15 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(461)
+		p.SetState(869)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(462)
+		p.SetState(870)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(463)
+		p.SetState(871)
 		p.Ident()
 	}
 
@@ -8101,7 +16005,7 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 	_ = this
 
 	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SQLParserRULE_typeFilter)
+	p.EnterRule(localctx, 176, SQLParserRULE_typeFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8121,15 +16025,15 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(465)
+		p.SetState(873)
 		p.Match(SQLParserT_TYPE)
 	}
 	{
-		p.SetState(466)
+		p.SetState(874)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(467)
+		p.SetState(875)
 		p.Ident()
 	}
 
@@ -8249,7 +16153,7 @@ func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 	_ = this
 
 	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SQLParserRULE_fromClause)
+	p.EnterRule(localctx, 178, SQLParserRULE_fromClause)
 	var _la int
 
 	defer func() {
@@ -8270,24 +16174,24 @@ func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(469)
+		p.SetState(877)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(470)
+		p.SetState(878)
 		p.MetricName()
 	}
-	p.SetState(473)
+	p.SetState(881)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ON {
 		{
-			p.SetState(471)
+			p.SetState(879)
 			p.Match(SQLParserT_ON)
 		}
 		{
-			p.SetState(472)
+			p.SetState(880)
 			p.Namespace()
 		}
 
@@ -8389,7 +16293,7 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 	_ = this
 
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SQLParserRULE_whereClause)
+	p.EnterRule(localctx, 180, SQLParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -8409,11 +16313,11 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(475)
+		p.SetState(883)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(476)
+		p.SetState(884)
 		p.ConditionExpr()
 	}
 
@@ -8529,7 +16433,7 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	_ = this
 
 	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SQLParserRULE_conditionExpr)
+	p.EnterRule(localctx, 182, SQLParserRULE_conditionExpr)
 	var _la int
 
 	defer func() {
@@ -8548,48 +16452,48 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 		}
 	}()
 
-	p.SetState(488)
+	p.SetState(896)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(478)
+			p.SetState(886)
 			p.tagFilterExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(479)
+			p.SetState(887)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(480)
+			p.SetState(888)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(481)
+			p.SetState(889)
 			p.TimeRangeExpr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(483)
+			p.SetState(891)
 			p.TimeRangeExpr()
 		}
-		p.SetState(486)
+		p.SetState(894)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SQLParserT_AND {
 			{
-				p.SetState(484)
+				p.SetState(892)
 				p.Match(SQLParserT_AND)
 			}
 			{
-				p.SetState(485)
+				p.SetState(893)
 				p.tagFilterExpr(0)
 			}
 
@@ -8818,8 +16722,8 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx ITagFilterExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 96
-	p.EnterRecursionRule(localctx, 96, SQLParserRULE_tagFilterExpr, _p)
+	_startState := 184
+	p.EnterRecursionRule(localctx, 184, SQLParserRULE_tagFilterExpr, _p)
 	var _la int
 
 	defer func() {
@@ -8841,75 +16745,75 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(518)
+	p.SetState(926)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(491)
+			p.SetState(899)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(492)
+			p.SetState(900)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(493)
+			p.SetState(901)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(495)
+			p.SetState(903)
 			p.TagKey()
 		}
-		p.SetState(504)
+		p.SetState(912)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_EQUAL:
 			{
-				p.SetState(496)
+				p.SetState(904)
 				p.Match(SQLParserT_EQUAL)
 			}
 
 		case SQLParserT_LIKE:
 			{
-				p.SetState(497)
+				p.SetState(905)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(498)
+				p.SetState(906)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(499)
+				p.SetState(907)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_REGEXP:
 			{
-				p.SetState(500)
+				p.SetState(908)
 				p.Match(SQLParserT_REGEXP)
 			}
 
 		case SQLParserT_NEQREGEXP:
 			{
-				p.SetState(501)
+				p.SetState(909)
 				p.Match(SQLParserT_NEQREGEXP)
 			}
 
 		case SQLParserT_NOTEQUAL:
 			{
-				p.SetState(502)
+				p.SetState(910)
 				p.Match(SQLParserT_NOTEQUAL)
 			}
 
 		case SQLParserT_NOTEQUAL2:
 			{
-				p.SetState(503)
+				p.SetState(911)
 				p.Match(SQLParserT_NOTEQUAL2)
 			}
 
@@ -8917,32 +16821,32 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(506)
+			p.SetState(914)
 			p.TagValue()
 		}
 
 	case 3:
 		{
-			p.SetState(508)
+			p.SetState(916)
 			p.TagKey()
 		}
-		p.SetState(512)
+		p.SetState(920)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_IN:
 			{
-				p.SetState(509)
+				p.SetState(917)
 				p.Match(SQLParserT_IN)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(510)
+				p.SetState(918)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(511)
+				p.SetState(919)
 				p.Match(SQLParserT_IN)
 			}
 
@@ -8950,23 +16854,23 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(514)
+			p.SetState(922)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(515)
+			p.SetState(923)
 			p.TagValueList()
 		}
 		{
-			p.SetState(516)
+			p.SetState(924)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(525)
+	p.SetState(933)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -8976,13 +16880,13 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			_prevctx = localctx
 			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
-			p.SetState(520)
+			p.SetState(928)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(521)
+				p.SetState(929)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -8993,14 +16897,14 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 				}
 			}
 			{
-				p.SetState(522)
+				p.SetState(930)
 				p.tagFilterExpr(2)
 			}
 
 		}
-		p.SetState(527)
+		p.SetState(935)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9128,7 +17032,7 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 	_ = this
 
 	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SQLParserRULE_tagValueList)
+	p.EnterRule(localctx, 186, SQLParserRULE_tagValueList)
 	var _la int
 
 	defer func() {
@@ -9149,24 +17053,24 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
+		p.SetState(936)
 		p.TagValue()
 	}
-	p.SetState(533)
+	p.SetState(941)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(529)
+			p.SetState(937)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(530)
+			p.SetState(938)
 			p.TagValue()
 		}
 
-		p.SetState(535)
+		p.SetState(943)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -9279,7 +17183,7 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 	_ = this
 
 	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SQLParserRULE_metricListFilter)
+	p.EnterRule(localctx, 188, SQLParserRULE_metricListFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -9299,24 +17203,24 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(536)
+		p.SetState(944)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(537)
+		p.SetState(945)
 		p.Match(SQLParserT_IN)
 	}
 
 	{
-		p.SetState(538)
+		p.SetState(946)
 		p.Match(SQLParserT_OPEN_P)
 	}
 	{
-		p.SetState(539)
+		p.SetState(947)
 		p.MetricList()
 	}
 	{
-		p.SetState(540)
+		p.SetState(948)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -9445,7 +17349,7 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 	_ = this
 
 	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SQLParserRULE_metricList)
+	p.EnterRule(localctx, 190, SQLParserRULE_metricList)
 	var _la int
 
 	defer func() {
@@ -9466,24 +17370,24 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(542)
+		p.SetState(950)
 		p.Ident()
 	}
-	p.SetState(547)
+	p.SetState(955)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(543)
+			p.SetState(951)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(544)
+			p.SetState(952)
 			p.Ident()
 		}
 
-		p.SetState(549)
+		p.SetState(957)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -9609,7 +17513,7 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 	_ = this
 
 	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SQLParserRULE_timeRangeExpr)
+	p.EnterRule(localctx, 192, SQLParserRULE_timeRangeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9629,19 +17533,19 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(550)
+		p.SetState(958)
 		p.TimeExpr()
 	}
-	p.SetState(553)
+	p.SetState(961)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(551)
+			p.SetState(959)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(552)
+			p.SetState(960)
 			p.TimeExpr()
 		}
 
@@ -9775,7 +17679,7 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 	_ = this
 
 	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, SQLParserRULE_timeExpr)
+	p.EnterRule(localctx, 194, SQLParserRULE_timeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9795,25 +17699,25 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(555)
+		p.SetState(963)
 		p.Match(SQLParserT_TIME)
 	}
 	{
-		p.SetState(556)
+		p.SetState(964)
 		p.BinaryOperator()
 	}
-	p.SetState(559)
+	p.SetState(967)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(557)
+			p.SetState(965)
 			p.NowExpr()
 		}
 
 	case 2:
 		{
-			p.SetState(558)
+			p.SetState(966)
 			p.Ident()
 		}
 
@@ -9927,7 +17831,7 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 	_ = this
 
 	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SQLParserRULE_nowExpr)
+	p.EnterRule(localctx, 196, SQLParserRULE_nowExpr)
 	var _la int
 
 	defer func() {
@@ -9948,16 +17852,16 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(561)
+		p.SetState(969)
 		p.NowFunc()
 	}
-	p.SetState(563)
+	p.SetState(971)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-121)) & ^0x3f) == 0 && ((int64(1)<<(_la-121))&131) != 0 {
+	if (int64((_la-165)) & ^0x3f) == 0 && ((int64(1)<<(_la-165))&131) != 0 {
 		{
-			p.SetState(562)
+			p.SetState(970)
 			p.DurationLit()
 		}
 
@@ -10067,7 +17971,7 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 	_ = this
 
 	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, SQLParserRULE_nowFunc)
+	p.EnterRule(localctx, 198, SQLParserRULE_nowFunc)
 	var _la int
 
 	defer func() {
@@ -10088,26 +17992,26 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(565)
+		p.SetState(973)
 		p.Match(SQLParserT_NOW)
 	}
 	{
-		p.SetState(566)
+		p.SetState(974)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(568)
+	p.SetState(976)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-65537) != 0 || (int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&1016124674515402751) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9221683186763825151) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&62019328016383) != 0 {
 		{
-			p.SetState(567)
+			p.SetState(975)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(570)
+		p.SetState(978)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -10255,7 +18159,7 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 	_ = this
 
 	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SQLParserRULE_groupByClause)
+	p.EnterRule(localctx, 200, SQLParserRULE_groupByClause)
 	var _la int
 
 	defer func() {
@@ -10276,47 +18180,47 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(572)
+		p.SetState(980)
 		p.Match(SQLParserT_GROUP)
 	}
 	{
-		p.SetState(573)
+		p.SetState(981)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(574)
+		p.SetState(982)
 		p.GroupByKeys()
 	}
-	p.SetState(580)
+	p.SetState(988)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_FILL {
 		{
-			p.SetState(575)
+			p.SetState(983)
 			p.Match(SQLParserT_FILL)
 		}
 		{
-			p.SetState(576)
+			p.SetState(984)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(577)
+			p.SetState(985)
 			p.FillOption()
 		}
 		{
-			p.SetState(578)
+			p.SetState(986)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
-	p.SetState(583)
+	p.SetState(991)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_HAVING {
 		{
-			p.SetState(582)
+			p.SetState(990)
 			p.HavingClause()
 		}
 
@@ -10447,7 +18351,7 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 	_ = this
 
 	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, SQLParserRULE_groupByKeys)
+	p.EnterRule(localctx, 202, SQLParserRULE_groupByKeys)
 	var _la int
 
 	defer func() {
@@ -10468,24 +18372,24 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(585)
+		p.SetState(993)
 		p.GroupByKey()
 	}
-	p.SetState(590)
+	p.SetState(998)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(586)
+			p.SetState(994)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(587)
+			p.SetState(995)
 			p.GroupByKey()
 		}
 
-		p.SetState(592)
+		p.SetState(1000)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10610,7 +18514,7 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 	_ = this
 
 	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SQLParserRULE_groupByKey)
+	p.EnterRule(localctx, 204, SQLParserRULE_groupByKey)
 
 	defer func() {
 		p.ExitRule()
@@ -10628,32 +18532,32 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 		}
 	}()
 
-	p.SetState(599)
+	p.SetState(1007)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(593)
+			p.SetState(1001)
 			p.Ident()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(594)
+			p.SetState(1002)
 			p.Match(SQLParserT_TIME)
 		}
 		{
-			p.SetState(595)
+			p.SetState(1003)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(596)
+			p.SetState(1004)
 			p.DurationLit()
 		}
 		{
-			p.SetState(597)
+			p.SetState(1005)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
@@ -10751,7 +18655,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 	_ = this
 
 	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, SQLParserRULE_fillOption)
+	p.EnterRule(localctx, 206, SQLParserRULE_fillOption)
 	var _la int
 
 	defer func() {
@@ -10772,7 +18676,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(601)
+		p.SetState(1009)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_NULL || _la == SQLParserT_PREVIOUS || _la == SQLParserL_INT || _la == SQLParserL_DEC) {
@@ -10883,7 +18787,7 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 	_ = this
 
 	localctx = NewOrderByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, SQLParserRULE_orderByClause)
+	p.EnterRule(localctx, 208, SQLParserRULE_orderByClause)
 
 	defer func() {
 		p.ExitRule()
@@ -10903,15 +18807,15 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(603)
+		p.SetState(1011)
 		p.Match(SQLParserT_ORDER)
 	}
 	{
-		p.SetState(604)
+		p.SetState(1012)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(605)
+		p.SetState(1013)
 		p.SortFields()
 	}
 
@@ -11023,7 +18927,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 	_ = this
 
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SQLParserRULE_sortField)
+	p.EnterRule(localctx, 210, SQLParserRULE_sortField)
 	var _la int
 
 	defer func() {
@@ -11044,16 +18948,16 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(607)
+		p.SetState(1015)
 		p.fieldExpr(0)
 	}
-	p.SetState(611)
+	p.SetState(1019)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_ASC || _la == SQLParserT_DESC {
 		{
-			p.SetState(608)
+			p.SetState(1016)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ASC || _la == SQLParserT_DESC) {
@@ -11064,7 +18968,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 			}
 		}
 
-		p.SetState(613)
+		p.SetState(1021)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -11194,7 +19098,7 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 	_ = this
 
 	localctx = NewSortFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SQLParserRULE_sortFields)
+	p.EnterRule(localctx, 212, SQLParserRULE_sortFields)
 	var _la int
 
 	defer func() {
@@ -11215,24 +19119,24 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(614)
+		p.SetState(1022)
 		p.SortField()
 	}
-	p.SetState(619)
+	p.SetState(1027)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(615)
+			p.SetState(1023)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(616)
+			p.SetState(1024)
 			p.SortField()
 		}
 
-		p.SetState(621)
+		p.SetState(1029)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -11333,7 +19237,7 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 	_ = this
 
 	localctx = NewHavingClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SQLParserRULE_havingClause)
+	p.EnterRule(localctx, 214, SQLParserRULE_havingClause)
 
 	defer func() {
 		p.ExitRule()
@@ -11353,11 +19257,11 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(622)
+		p.SetState(1030)
 		p.Match(SQLParserT_HAVING)
 	}
 	{
-		p.SetState(623)
+		p.SetState(1031)
 		p.boolExpr(0)
 	}
 
@@ -11526,8 +19430,8 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	localctx = NewBoolExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IBoolExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 128
-	p.EnterRecursionRule(localctx, 128, SQLParserRULE_boolExpr, _p)
+	_startState := 216
+	p.EnterRecursionRule(localctx, 216, SQLParserRULE_boolExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -11548,34 +19452,34 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(631)
+	p.SetState(1039)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(626)
+			p.SetState(1034)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(627)
+			p.SetState(1035)
 			p.boolExpr(0)
 		}
 		{
-			p.SetState(628)
+			p.SetState(1036)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(630)
+			p.SetState(1038)
 			p.BoolExprAtom()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(639)
+	p.SetState(1047)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -11585,24 +19489,24 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			_prevctx = localctx
 			localctx = NewBoolExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_boolExpr)
-			p.SetState(633)
+			p.SetState(1041)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
 			{
-				p.SetState(634)
+				p.SetState(1042)
 				p.BoolExprLogicalOp()
 			}
 			{
-				p.SetState(635)
+				p.SetState(1043)
 				p.boolExpr(3)
 			}
 
 		}
-		p.SetState(641)
+		p.SetState(1049)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11689,7 +19593,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 	_ = this
 
 	localctx = NewBoolExprLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SQLParserRULE_boolExprLogicalOp)
+	p.EnterRule(localctx, 218, SQLParserRULE_boolExprLogicalOp)
 	var _la int
 
 	defer func() {
@@ -11710,7 +19614,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(642)
+		p.SetState(1050)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -11813,7 +19717,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 	_ = this
 
 	localctx = NewBoolExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SQLParserRULE_boolExprAtom)
+	p.EnterRule(localctx, 220, SQLParserRULE_boolExprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -11833,7 +19737,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(644)
+		p.SetState(1052)
 		p.BinaryExpr()
 	}
 
@@ -11970,7 +19874,7 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 	_ = this
 
 	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SQLParserRULE_binaryExpr)
+	p.EnterRule(localctx, 222, SQLParserRULE_binaryExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -11990,15 +19894,15 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(646)
+		p.SetState(1054)
 		p.fieldExpr(0)
 	}
 	{
-		p.SetState(647)
+		p.SetState(1055)
 		p.BinaryOperator()
 	}
 	{
-		p.SetState(648)
+		p.SetState(1056)
 		p.fieldExpr(0)
 	}
 
@@ -12114,7 +20018,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	_ = this
 
 	localctx = NewBinaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SQLParserRULE_binaryOperator)
+	p.EnterRule(localctx, 224, SQLParserRULE_binaryOperator)
 	var _la int
 
 	defer func() {
@@ -12133,63 +20037,63 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 		}
 	}()
 
-	p.SetState(658)
+	p.SetState(1066)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_EQUAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(650)
+			p.SetState(1058)
 			p.Match(SQLParserT_EQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(651)
+			p.SetState(1059)
 			p.Match(SQLParserT_NOTEQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL2:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(652)
+			p.SetState(1060)
 			p.Match(SQLParserT_NOTEQUAL2)
 		}
 
 	case SQLParserT_LESS:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(653)
+			p.SetState(1061)
 			p.Match(SQLParserT_LESS)
 		}
 
 	case SQLParserT_LESSEQUAL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(654)
+			p.SetState(1062)
 			p.Match(SQLParserT_LESSEQUAL)
 		}
 
 	case SQLParserT_GREATER:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(655)
+			p.SetState(1063)
 			p.Match(SQLParserT_GREATER)
 		}
 
 	case SQLParserT_GREATEREQUAL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(656)
+			p.SetState(1064)
 			p.Match(SQLParserT_GREATEREQUAL)
 		}
 
 	case SQLParserT_LIKE, SQLParserT_REGEXP:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(657)
+			p.SetState(1065)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_LIKE || _la == SQLParserT_REGEXP) {
@@ -12401,8 +20305,8 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	localctx = NewFieldExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IFieldExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 138
-	p.EnterRecursionRule(localctx, 138, SQLParserRULE_fieldExpr, _p)
+	_startState := 226
+	p.EnterRecursionRule(localctx, 226, SQLParserRULE_fieldExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -12423,46 +20327,46 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(668)
+	p.SetState(1076)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(661)
+			p.SetState(1069)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(662)
+			p.SetState(1070)
 			p.fieldExpr(0)
 		}
 		{
-			p.SetState(663)
+			p.SetState(1071)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(665)
+			p.SetState(1073)
 			p.ExprFunc()
 		}
 
 	case 3:
 		{
-			p.SetState(666)
+			p.SetState(1074)
 			p.ExprAtom()
 		}
 
 	case 4:
 		{
-			p.SetState(667)
+			p.SetState(1075)
 			p.DurationLit()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(684)
+	p.SetState(1092)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -12470,83 +20374,83 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(682)
+			p.SetState(1090)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(670)
+				p.SetState(1078)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(671)
+					p.SetState(1079)
 					p.Match(SQLParserT_MUL)
 				}
 				{
-					p.SetState(672)
+					p.SetState(1080)
 					p.fieldExpr(9)
 				}
 
 			case 2:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(673)
+				p.SetState(1081)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(674)
+					p.SetState(1082)
 					p.Match(SQLParserT_DIV)
 				}
 				{
-					p.SetState(675)
+					p.SetState(1083)
 					p.fieldExpr(8)
 				}
 
 			case 3:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(676)
+				p.SetState(1084)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(677)
+					p.SetState(1085)
 					p.Match(SQLParserT_ADD)
 				}
 				{
-					p.SetState(678)
+					p.SetState(1086)
 					p.fieldExpr(7)
 				}
 
 			case 4:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(679)
+				p.SetState(1087)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(680)
+					p.SetState(1088)
 					p.Match(SQLParserT_SUB)
 				}
 				{
-					p.SetState(681)
+					p.SetState(1089)
 					p.fieldExpr(6)
 				}
 
 			}
 
 		}
-		p.SetState(686)
+		p.SetState(1094)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -12657,7 +20561,7 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 	_ = this
 
 	localctx = NewDurationLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SQLParserRULE_durationLit)
+	p.EnterRule(localctx, 228, SQLParserRULE_durationLit)
 
 	defer func() {
 		p.ExitRule()
@@ -12677,11 +20581,11 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(687)
+		p.SetState(1095)
 		p.IntNumber()
 	}
 	{
-		p.SetState(688)
+		p.SetState(1096)
 		p.IntervalItem()
 	}
 
@@ -12789,7 +20693,7 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 	_ = this
 
 	localctx = NewIntervalItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SQLParserRULE_intervalItem)
+	p.EnterRule(localctx, 230, SQLParserRULE_intervalItem)
 	var _la int
 
 	defer func() {
@@ -12810,10 +20714,10 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(690)
+		p.SetState(1098)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-96)) & ^0x3f) == 0 && ((int64(1)<<(_la-96))&127) != 0) {
+		if !((int64((_la-139)) & ^0x3f) == 0 && ((int64(1)<<(_la-139))&127) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -12937,7 +20841,7 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 	_ = this
 
 	localctx = NewExprFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SQLParserRULE_exprFunc)
+	p.EnterRule(localctx, 232, SQLParserRULE_exprFunc)
 	var _la int
 
 	defer func() {
@@ -12958,26 +20862,26 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(692)
+		p.SetState(1100)
 		p.FuncName()
 	}
 	{
-		p.SetState(693)
+		p.SetState(1101)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(695)
+	p.SetState(1103)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-65537) != 0 || (int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&1016124674515402751) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9221683186763825151) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&62019328016383) != 0 {
 		{
-			p.SetState(694)
+			p.SetState(1102)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(697)
+		p.SetState(1105)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -13097,7 +21001,7 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 	_ = this
 
 	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, SQLParserRULE_funcName)
+	p.EnterRule(localctx, 234, SQLParserRULE_funcName)
 	var _la int
 
 	defer func() {
@@ -13118,10 +21022,10 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(699)
+		p.SetState(1107)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-86)) & ^0x3f) == 0 && ((int64(1)<<(_la-86))&1023) != 0) {
+		if !((int64((_la-129)) & ^0x3f) == 0 && ((int64(1)<<(_la-129))&1023) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -13254,7 +21158,7 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 	_ = this
 
 	localctx = NewExprFuncParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, SQLParserRULE_exprFuncParams)
+	p.EnterRule(localctx, 236, SQLParserRULE_exprFuncParams)
 	var _la int
 
 	defer func() {
@@ -13275,24 +21179,24 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(701)
+		p.SetState(1109)
 		p.FuncParam()
 	}
-	p.SetState(706)
+	p.SetState(1114)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(702)
+			p.SetState(1110)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(703)
+			p.SetState(1111)
 			p.FuncParam()
 		}
 
-		p.SetState(708)
+		p.SetState(1116)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -13405,7 +21309,7 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	_ = this
 
 	localctx = NewFuncParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SQLParserRULE_funcParam)
+	p.EnterRule(localctx, 238, SQLParserRULE_funcParam)
 
 	defer func() {
 		p.ExitRule()
@@ -13423,20 +21327,20 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 		}
 	}()
 
-	p.SetState(711)
+	p.SetState(1119)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(709)
+			p.SetState(1117)
 			p.fieldExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(710)
+			p.SetState(1118)
 			p.tagFilterExpr(0)
 		}
 
@@ -13582,7 +21486,7 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	_ = this
 
 	localctx = NewExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SQLParserRULE_exprAtom)
+	p.EnterRule(localctx, 240, SQLParserRULE_exprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -13600,21 +21504,21 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 		}
 	}()
 
-	p.SetState(719)
+	p.SetState(1127)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(713)
+			p.SetState(1121)
 			p.Ident()
 		}
-		p.SetState(715)
+		p.SetState(1123)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(714)
+				p.SetState(1122)
 				p.IdentFilter()
 			}
 
@@ -13623,14 +21527,14 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(717)
+			p.SetState(1125)
 			p.DecNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(718)
+			p.SetState(1126)
 			p.IntNumber()
 		}
 
@@ -13736,7 +21640,7 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 	_ = this
 
 	localctx = NewIdentFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SQLParserRULE_identFilter)
+	p.EnterRule(localctx, 242, SQLParserRULE_identFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -13756,15 +21660,15 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(721)
+		p.SetState(1129)
 		p.Match(SQLParserT_OPEN_SB)
 	}
 	{
-		p.SetState(722)
+		p.SetState(1130)
 		p.tagFilterExpr(0)
 	}
 	{
-		p.SetState(723)
+		p.SetState(1131)
 		p.Match(SQLParserT_CLOSE_SB)
 	}
 
@@ -13860,7 +21764,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 	_ = this
 
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, SQLParserRULE_json)
+	p.EnterRule(localctx, 244, SQLParserRULE_json)
 
 	defer func() {
 		p.ExitRule()
@@ -13880,7 +21784,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(725)
+		p.SetState(1133)
 		p.Value()
 	}
 
@@ -14017,7 +21921,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 	_ = this
 
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SQLParserRULE_obj)
+	p.EnterRule(localctx, 246, SQLParserRULE_obj)
 	var _la int
 
 	defer func() {
@@ -14036,50 +21940,50 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 		}
 	}()
 
-	p.SetState(740)
+	p.SetState(1148)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(727)
+			p.SetState(1135)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(728)
+			p.SetState(1136)
 			p.Pair()
 		}
-		p.SetState(733)
+		p.SetState(1141)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(729)
+				p.SetState(1137)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(730)
+				p.SetState(1138)
 				p.Pair()
 			}
 
-			p.SetState(735)
+			p.SetState(1143)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(736)
+			p.SetState(1144)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(738)
+			p.SetState(1146)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(739)
+			p.SetState(1147)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
@@ -14185,7 +22089,7 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 	_ = this
 
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SQLParserRULE_pair)
+	p.EnterRule(localctx, 248, SQLParserRULE_pair)
 
 	defer func() {
 		p.ExitRule()
@@ -14205,15 +22109,15 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(742)
+		p.SetState(1150)
 		p.Match(SQLParserSTRING)
 	}
 	{
-		p.SetState(743)
+		p.SetState(1151)
 		p.Match(SQLParserT_COLON)
 	}
 	{
-		p.SetState(744)
+		p.SetState(1152)
 		p.Value()
 	}
 
@@ -14350,7 +22254,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 	_ = this
 
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SQLParserRULE_arr)
+	p.EnterRule(localctx, 250, SQLParserRULE_arr)
 	var _la int
 
 	defer func() {
@@ -14369,50 +22273,50 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 		}
 	}()
 
-	p.SetState(759)
+	p.SetState(1167)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 86, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(746)
+			p.SetState(1154)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(747)
+			p.SetState(1155)
 			p.Value()
 		}
-		p.SetState(752)
+		p.SetState(1160)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(748)
+				p.SetState(1156)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(749)
+				p.SetState(1157)
 				p.Value()
 			}
 
-			p.SetState(754)
+			p.SetState(1162)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(755)
+			p.SetState(1163)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(757)
+			p.SetState(1165)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(758)
+			p.SetState(1166)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
@@ -14562,7 +22466,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	_ = this
 
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, SQLParserRULE_value)
+	p.EnterRule(localctx, 252, SQLParserRULE_value)
 
 	defer func() {
 		p.ExitRule()
@@ -14580,62 +22484,62 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 		}
 	}()
 
-	p.SetState(769)
+	p.SetState(1177)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(761)
+			p.SetState(1169)
 			p.Match(SQLParserSTRING)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(762)
+			p.SetState(1170)
 			p.IntNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(763)
+			p.SetState(1171)
 			p.DecNumber()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(764)
+			p.SetState(1172)
 			p.Obj()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(765)
+			p.SetState(1173)
 			p.Arr()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(766)
+			p.SetState(1174)
 			p.Match(SQLParserT__0)
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(767)
+			p.SetState(1175)
 			p.Match(SQLParserT__1)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(768)
+			p.SetState(1176)
 			p.Match(SQLParserT__2)
 		}
 
@@ -14729,7 +22633,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	_ = this
 
 	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, SQLParserRULE_intNumber)
+	p.EnterRule(localctx, 254, SQLParserRULE_intNumber)
 	var _la int
 
 	defer func() {
@@ -14749,13 +22653,13 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(772)
+	p.SetState(1180)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(771)
+			p.SetState(1179)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -14768,7 +22672,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 
 	}
 	{
-		p.SetState(774)
+		p.SetState(1182)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -14860,7 +22764,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	_ = this
 
 	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, SQLParserRULE_decNumber)
+	p.EnterRule(localctx, 256, SQLParserRULE_decNumber)
 	var _la int
 
 	defer func() {
@@ -14880,13 +22784,13 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(777)
+	p.SetState(1185)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(776)
+			p.SetState(1184)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -14899,7 +22803,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 
 	}
 	{
-		p.SetState(779)
+		p.SetState(1187)
 		p.Match(SQLParserL_DEC)
 	}
 
@@ -14987,7 +22891,7 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 	_ = this
 
 	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, SQLParserRULE_limitClause)
+	p.EnterRule(localctx, 258, SQLParserRULE_limitClause)
 
 	defer func() {
 		p.ExitRule()
@@ -15007,11 +22911,11 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(781)
+		p.SetState(1189)
 		p.Match(SQLParserT_LIMIT)
 	}
 	{
-		p.SetState(782)
+		p.SetState(1190)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -15107,7 +23011,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 	_ = this
 
 	localctx = NewMetricNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, SQLParserRULE_metricName)
+	p.EnterRule(localctx, 260, SQLParserRULE_metricName)
 
 	defer func() {
 		p.ExitRule()
@@ -15127,7 +23031,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(784)
+		p.SetState(1192)
 		p.Ident()
 	}
 
@@ -15223,7 +23127,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 	_ = this
 
 	localctx = NewTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, SQLParserRULE_tagKey)
+	p.EnterRule(localctx, 262, SQLParserRULE_tagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -15243,7 +23147,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(786)
+		p.SetState(1194)
 		p.Ident()
 	}
 
@@ -15339,7 +23243,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 	_ = this
 
 	localctx = NewTagValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, SQLParserRULE_tagValue)
+	p.EnterRule(localctx, 264, SQLParserRULE_tagValue)
 
 	defer func() {
 		p.ExitRule()
@@ -15359,7 +23263,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(788)
+		p.SetState(1196)
 		p.Ident()
 	}
 
@@ -15496,7 +23400,7 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	_ = this
 
 	localctx = NewIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, SQLParserRULE_ident)
+	p.EnterRule(localctx, 266, SQLParserRULE_ident)
 
 	defer func() {
 		p.ExitRule()
@@ -15517,48 +23421,48 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(792)
+	p.SetState(1200)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserL_ID:
 		{
-			p.SetState(790)
+			p.SetState(1198)
 			p.Match(SQLParserL_ID)
 		}
 
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_TO, SQLParserT_COMPACTION, SQLParserT_FAMILY, SQLParserT_SLOW, SQLParserT_RECORDING, SQLParserT_RULE, SQLParserT_RULES, SQLParserT_INTO, SQLParserT_EVERY, SQLParserT_ALERT, SQLParserT_ALERTS, SQLParserT_WHEN, SQLParserT_LABELS, SQLParserT_WEBHOOK, SQLParserT_FORMAT, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_TOKENS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL, SQLParserT_TENANT, SQLParserT_TENANTS, SQLParserT_USAGE, SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES, SQLParserT_MAX_DISK, SQLParserT_AUDIT, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 		{
-			p.SetState(791)
+			p.SetState(1199)
 			p.NonReservedWords()
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(801)
+	p.SetState(1209)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(794)
+				p.SetState(1202)
 				p.Match(SQLParserT_DOT)
 			}
-			p.SetState(797)
+			p.SetState(1205)
 			p.GetErrorHandler().Sync(p)
 
 			switch p.GetTokenStream().LA(1) {
 			case SQLParserL_ID:
 				{
-					p.SetState(795)
+					p.SetState(1203)
 					p.Match(SQLParserL_ID)
 				}
 
-			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_TO, SQLParserT_COMPACTION, SQLParserT_FAMILY, SQLParserT_SLOW, SQLParserT_RECORDING, SQLParserT_RULE, SQLParserT_RULES, SQLParserT_INTO, SQLParserT_EVERY, SQLParserT_ALERT, SQLParserT_ALERTS, SQLParserT_WHEN, SQLParserT_LABELS, SQLParserT_WEBHOOK, SQLParserT_FORMAT, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_TOKENS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ALL, SQLParserT_TENANT, SQLParserT_TENANTS, SQLParserT_USAGE, SQLParserT_INGEST_RATE, SQLParserT_MAX_SERIES, SQLParserT_MAX_QUERIES, SQLParserT_MAX_DISK, SQLParserT_AUDIT, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 				{
-					p.SetState(796)
+					p.SetState(1204)
 					p.NonReservedWords()
 				}
 
@@ -15567,9 +23471,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			}
 
 		}
-		p.SetState(803)
+		p.SetState(1211)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -15997,6 +23901,146 @@ func (s *NonReservedWordsContext) T_ID() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_ID, 0)
 }
 
+func (s *NonReservedWordsContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *NonReservedWordsContext) T_COMPACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMPACTION, 0)
+}
+
+func (s *NonReservedWordsContext) T_FAMILY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FAMILY, 0)
+}
+
+func (s *NonReservedWordsContext) T_SLOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SLOW, 0)
+}
+
+func (s *NonReservedWordsContext) T_RECORDING() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECORDING, 0)
+}
+
+func (s *NonReservedWordsContext) T_RULE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RULE, 0)
+}
+
+func (s *NonReservedWordsContext) T_RULES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RULES, 0)
+}
+
+func (s *NonReservedWordsContext) T_INTO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTO, 0)
+}
+
+func (s *NonReservedWordsContext) T_EVERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EVERY, 0)
+}
+
+func (s *NonReservedWordsContext) T_ALERT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERT, 0)
+}
+
+func (s *NonReservedWordsContext) T_ALERTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERTS, 0)
+}
+
+func (s *NonReservedWordsContext) T_WHEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHEN, 0)
+}
+
+func (s *NonReservedWordsContext) T_LABELS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABELS, 0)
+}
+
+func (s *NonReservedWordsContext) T_WEBHOOK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WEBHOOK, 0)
+}
+
+func (s *NonReservedWordsContext) T_FORMAT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FORMAT, 0)
+}
+
+func (s *NonReservedWordsContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *NonReservedWordsContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
+}
+
+func (s *NonReservedWordsContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *NonReservedWordsContext) T_TOKEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKEN, 0)
+}
+
+func (s *NonReservedWordsContext) T_TOKENS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKENS, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROLES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLES, 0)
+}
+
+func (s *NonReservedWordsContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
+}
+
+func (s *NonReservedWordsContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ALL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALL, 0)
+}
+
+func (s *NonReservedWordsContext) T_TENANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANT, 0)
+}
+
+func (s *NonReservedWordsContext) T_TENANTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TENANTS, 0)
+}
+
+func (s *NonReservedWordsContext) T_USAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USAGE, 0)
+}
+
+func (s *NonReservedWordsContext) T_INGEST_RATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INGEST_RATE, 0)
+}
+
+func (s *NonReservedWordsContext) T_MAX_SERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MAX_SERIES, 0)
+}
+
+func (s *NonReservedWordsContext) T_MAX_QUERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MAX_QUERIES, 0)
+}
+
+func (s *NonReservedWordsContext) T_MAX_DISK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MAX_DISK, 0)
+}
+
+func (s *NonReservedWordsContext) T_AUDIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AUDIT, 0)
+}
+
+func (s *NonReservedWordsContext) T_REBALANCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REBALANCE, 0)
+}
+
+func (s *NonReservedWordsContext) T_REPLICA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICA, 0)
+}
+
 func (s *NonReservedWordsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -16032,7 +24076,7 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 	_ = this
 
 	localctx = NewNonReservedWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, SQLParserRULE_nonReservedWords)
+	p.EnterRule(localctx, 268, SQLParserRULE_nonReservedWords)
 	var _la int
 
 	defer func() {
@@ -16053,10 +24097,10 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(804)
+		p.SetState(1212)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&549755813887) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9221683186763825151) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&262143) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -16069,21 +24113,21 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 func (p *SQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 48:
+	case 92:
 		var t *TagFilterExprContext = nil
 		if localctx != nil {
 			t = localctx.(*TagFilterExprContext)
 		}
 		return p.TagFilterExpr_Sempred(t, predIndex)
 
-	case 64:
+	case 108:
 		var t *BoolExprContext = nil
 		if localctx != nil {
 			t = localctx.(*BoolExprContext)
 		}
 		return p.BoolExpr_Sempred(t, predIndex)
 
-	case 69:
+	case 113:
 		var t *FieldExprContext = nil
 		if localctx != nil {
 			t = localctx.(*FieldExprContext)
@@ -111,6 +111,138 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showTagValuesStmt.
 	VisitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#alterDatabaseStmt.
+	VisitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#backupStmt.
+	VisitBackupStmt(ctx *BackupStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#restoreStmt.
+	VisitRestoreStmt(ctx *RestoreStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#compactStmt.
+	VisitCompactStmt(ctx *CompactStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#compactOption.
+	VisitCompactOption(ctx *CompactOptionContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#shardIDList.
+	VisitShardIDList(ctx *ShardIDListContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showCompactionStmt.
+	VisitShowCompactionStmt(ctx *ShowCompactionStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showSlowRequestsStmt.
+	VisitShowSlowRequestsStmt(ctx *ShowSlowRequestsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createRecordingRuleStmt.
+	VisitCreateRecordingRuleStmt(ctx *CreateRecordingRuleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#recordingRuleOption.
+	VisitRecordingRuleOption(ctx *RecordingRuleOptionContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showRecordingRulesStmt.
+	VisitShowRecordingRulesStmt(ctx *ShowRecordingRulesStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropRecordingRuleStmt.
+	VisitDropRecordingRuleStmt(ctx *DropRecordingRuleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createAlertStmt.
+	VisitCreateAlertStmt(ctx *CreateAlertStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#alertOption.
+	VisitAlertOption(ctx *AlertOptionContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showAlertsStmt.
+	VisitShowAlertsStmt(ctx *ShowAlertsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropAlertStmt.
+	VisitDropAlertStmt(ctx *DropAlertStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createUserStmt.
+	VisitCreateUserStmt(ctx *CreateUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#alterUserStmt.
+	VisitAlterUserStmt(ctx *AlterUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropUserStmt.
+	VisitDropUserStmt(ctx *DropUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showUsersStmt.
+	VisitShowUsersStmt(ctx *ShowUsersStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createRoleStmt.
+	VisitCreateRoleStmt(ctx *CreateRoleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropRoleStmt.
+	VisitDropRoleStmt(ctx *DropRoleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showRolesStmt.
+	VisitShowRolesStmt(ctx *ShowRolesStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#grantStmt.
+	VisitGrantStmt(ctx *GrantStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#revokeStmt.
+	VisitRevokeStmt(ctx *RevokeStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#roleGrant.
+	VisitRoleGrant(ctx *RoleGrantContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#privilegeGrant.
+	VisitPrivilegeGrant(ctx *PrivilegeGrantContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#privilegeDatabase.
+	VisitPrivilegeDatabase(ctx *PrivilegeDatabaseContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#privilegeList.
+	VisitPrivilegeList(ctx *PrivilegeListContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#privilege.
+	VisitPrivilege(ctx *PrivilegeContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createTokenStmt.
+	VisitCreateTokenStmt(ctx *CreateTokenStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropTokenStmt.
+	VisitDropTokenStmt(ctx *DropTokenStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showTokensStmt.
+	VisitShowTokensStmt(ctx *ShowTokensStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createTenantStmt.
+	VisitCreateTenantStmt(ctx *CreateTenantStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#alterTenantStmt.
+	VisitAlterTenantStmt(ctx *AlterTenantStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#tenantQuota.
+	VisitTenantQuota(ctx *TenantQuotaContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropTenantStmt.
+	VisitDropTenantStmt(ctx *DropTenantStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showTenantsStmt.
+	VisitShowTenantsStmt(ctx *ShowTenantsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showTenantUsageStmt.
+	VisitShowTenantUsageStmt(ctx *ShowTenantUsageStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showAuditLogStmt.
+	VisitShowAuditLogStmt(ctx *ShowAuditLogStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showRebalanceStmt.
+	VisitShowRebalanceStmt(ctx *ShowRebalanceStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#decommissionStmt.
+	VisitDecommissionStmt(ctx *DecommissionStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#durationValue.
+	VisitDurationValue(ctx *DurationValueContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#textValue.
+	VisitTextValue(ctx *TextValueContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#prefix.
 	VisitPrefix(ctx *PrefixContext) interface{}
 
@@ -34,6 +34,16 @@ type listener struct {
 	storageStmt        *storageStmtParser
 	requestStmt        *requestStmtParser
 	brokerStmt         *brokerStmtParser
+	backupStmt         *backupStmtParser
+	compactStmt        *compactStmtParser
+	recordingRuleStmt  *recordingRuleStmtParser
+	alertStmt          *alertStmtParser
+	userStmt           *userStmtParser
+	roleStmt           *roleStmtParser
+	grantStmt          *grantStmtParser
+	tokenStmt          *tokenStmtParser
+	tenantStmt         *tenantStmtParser
+	auditStmt          *auditStmtParser
 }
 
 // EnterQueryStmt is called when production queryStmt is entered.
@@ -48,12 +58,12 @@ func (l *listener) EnterShowMetadataTypesStmt(_ *grammar.ShowMetadataTypesStmtCo
 
 // EnterShowRequestsStmt is called when production showRequestssStmt is entered.
 func (l *listener) EnterShowRequestsStmt(_ *grammar.ShowRequestsStmtContext) {
-	l.requestStmt = newRequestStmtParse()
+	l.requestStmt = newRequestStmtParse(stmt.AliveRequests)
 }
 
 // EnterShowRequestStmt is called when production showRequestStmt is entered.
 func (l *listener) EnterShowRequestStmt(_ *grammar.ShowRequestStmtContext) {
-	l.requestStmt = newRequestStmtParse()
+	l.requestStmt = newRequestStmtParse(stmt.AliveRequests)
 }
 
 // EnterRequestID is called when production requestID is entered.
@@ -336,6 +346,8 @@ func (l *listener) EnterLimitClause(ctx *grammar.LimitClauseContext) {
 		l.queryStmt.visitLimit(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitLimit(ctx)
+	case l.auditStmt != nil:
+		l.auditStmt.visitLimit(ctx)
 	}
 }
 
@@ -397,6 +409,249 @@ func (l *listener) ExitSortField(ctx *grammar.SortFieldContext) {
 	}
 }
 
+// EnterShowSlowRequestsStmt is called when production showSlowRequestsStmt is entered.
+func (l *listener) EnterShowSlowRequestsStmt(_ *grammar.ShowSlowRequestsStmtContext) {
+	l.requestStmt = newRequestStmtParse(stmt.SlowRequests)
+}
+
+// EnterAlterDatabaseStmt is called when production alterDatabaseStmt is entered.
+func (l *listener) EnterAlterDatabaseStmt(ctx *grammar.AlterDatabaseStmtContext) {
+	l.schemasStmt = newSchemasStmtParse(stmt.AlterDatabaseReplicaSchemaType)
+	l.schemasStmt.visitAlterDatabase(ctx)
+}
+
+// EnterShowRebalanceStmt is called when production showRebalanceStmt is entered.
+func (l *listener) EnterShowRebalanceStmt(ctx *grammar.ShowRebalanceStmtContext) {
+	l.storageStmt = newStorageStmtParse(stmt.StorageOpShowRebalance)
+	l.storageStmt.visitStorage(ctx.TextValue())
+}
+
+// EnterDecommissionStmt is called when production decommissionStmt is entered.
+func (l *listener) EnterDecommissionStmt(ctx *grammar.DecommissionStmtContext) {
+	l.storageStmt = newStorageStmtParse(stmt.StorageOpDecommission)
+	l.storageStmt.visitNodeID(ctx.L_INT())
+	l.storageStmt.visitStorage(ctx.TextValue())
+}
+
+// EnterBackupStmt is called when production backupStmt is entered.
+func (l *listener) EnterBackupStmt(ctx *grammar.BackupStmtContext) {
+	l.backupStmt = newBackupStmtParse(stmt.BackupOpBackup)
+	l.backupStmt.visitDatabase(ctx.TextValue(0))
+	l.backupStmt.visitDir(ctx.TextValue(1))
+}
+
+// EnterRestoreStmt is called when production restoreStmt is entered.
+func (l *listener) EnterRestoreStmt(ctx *grammar.RestoreStmtContext) {
+	l.backupStmt = newBackupStmtParse(stmt.BackupOpRestore)
+	l.backupStmt.visitDatabase(ctx.TextValue(0))
+	l.backupStmt.visitDir(ctx.TextValue(1))
+}
+
+// EnterCompactStmt is called when production compactStmt is entered.
+func (l *listener) EnterCompactStmt(ctx *grammar.CompactStmtContext) {
+	if ctx.T_ROLLUP() != nil {
+		l.compactStmt = newCompactStmtParse(stmt.CompactOpRollup)
+	} else {
+		l.compactStmt = newCompactStmtParse(stmt.CompactOpCompact)
+	}
+	l.compactStmt.visitDatabase(ctx.TextValue())
+}
+
+// EnterCompactOption is called when production compactOption is entered.
+func (l *listener) EnterCompactOption(ctx *grammar.CompactOptionContext) {
+	l.compactStmt.visitOption(ctx)
+}
+
+// EnterShardIDList is called when production shardIDList is entered.
+func (l *listener) EnterShardIDList(ctx *grammar.ShardIDListContext) {
+	l.compactStmt.visitShardIDList(ctx)
+}
+
+// EnterShowCompactionStmt is called when production showCompactionStmt is entered.
+func (l *listener) EnterShowCompactionStmt(ctx *grammar.ShowCompactionStmtContext) {
+	l.compactStmt = newCompactStmtParse(stmt.CompactOpShowState)
+	l.compactStmt.visitDatabase(ctx.TextValue())
+}
+
+// EnterCreateRecordingRuleStmt is called when production createRecordingRuleStmt is entered.
+func (l *listener) EnterCreateRecordingRuleStmt(ctx *grammar.CreateRecordingRuleStmtContext) {
+	l.recordingRuleStmt = newRecordingRuleStmtParse(stmt.RecordingRuleOpCreate)
+	l.recordingRuleStmt.visitName(ctx.TextValue())
+}
+
+// EnterRecordingRuleOption is called when production recordingRuleOption is entered.
+func (l *listener) EnterRecordingRuleOption(ctx *grammar.RecordingRuleOptionContext) {
+	l.recordingRuleStmt.visitOption(ctx)
+}
+
+// EnterShowRecordingRulesStmt is called when production showRecordingRulesStmt is entered.
+func (l *listener) EnterShowRecordingRulesStmt(_ *grammar.ShowRecordingRulesStmtContext) {
+	l.recordingRuleStmt = newRecordingRuleStmtParse(stmt.RecordingRuleOpShow)
+}
+
+// EnterDropRecordingRuleStmt is called when production dropRecordingRuleStmt is entered.
+func (l *listener) EnterDropRecordingRuleStmt(ctx *grammar.DropRecordingRuleStmtContext) {
+	l.recordingRuleStmt = newRecordingRuleStmtParse(stmt.RecordingRuleOpDrop)
+	l.recordingRuleStmt.visitName(ctx.TextValue())
+}
+
+// EnterCreateAlertStmt is called when production createAlertStmt is entered.
+func (l *listener) EnterCreateAlertStmt(ctx *grammar.CreateAlertStmtContext) {
+	l.alertStmt = newAlertStmtParse(stmt.AlertOpCreate)
+	l.alertStmt.visitName(ctx.TextValue())
+}
+
+// EnterAlertOption is called when production alertOption is entered.
+func (l *listener) EnterAlertOption(ctx *grammar.AlertOptionContext) {
+	l.alertStmt.visitOption(ctx)
+}
+
+// EnterShowAlertsStmt is called when production showAlertsStmt is entered.
+func (l *listener) EnterShowAlertsStmt(_ *grammar.ShowAlertsStmtContext) {
+	l.alertStmt = newAlertStmtParse(stmt.AlertOpShow)
+}
+
+// EnterDropAlertStmt is called when production dropAlertStmt is entered.
+func (l *listener) EnterDropAlertStmt(ctx *grammar.DropAlertStmtContext) {
+	l.alertStmt = newAlertStmtParse(stmt.AlertOpDrop)
+	l.alertStmt.visitName(ctx.TextValue())
+}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (l *listener) EnterCreateUserStmt(ctx *grammar.CreateUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpCreate)
+	l.userStmt.visitName(ctx.TextValue(0))
+	l.userStmt.visitPassword(ctx.TextValue(1))
+}
+
+// EnterAlterUserStmt is called when production alterUserStmt is entered.
+func (l *listener) EnterAlterUserStmt(ctx *grammar.AlterUserStmtContext) {
+	if ctx.T_REVOKE() != nil {
+		l.userStmt = newUserStmtParse(stmt.UserOpRevokeTokens)
+	} else {
+		l.userStmt = newUserStmtParse(stmt.UserOpAlter)
+		l.userStmt.visitPassword(ctx.TextValue(1))
+	}
+	l.userStmt.visitName(ctx.TextValue(0))
+}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (l *listener) EnterDropUserStmt(ctx *grammar.DropUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpDrop)
+	l.userStmt.visitName(ctx.TextValue())
+}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (l *listener) EnterShowUsersStmt(_ *grammar.ShowUsersStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpShow)
+}
+
+// EnterCreateRoleStmt is called when production createRoleStmt is entered.
+func (l *listener) EnterCreateRoleStmt(ctx *grammar.CreateRoleStmtContext) {
+	l.roleStmt = newRoleStmtParse(stmt.RoleOpCreate)
+	l.roleStmt.visitName(ctx.TextValue())
+}
+
+// EnterDropRoleStmt is called when production dropRoleStmt is entered.
+func (l *listener) EnterDropRoleStmt(ctx *grammar.DropRoleStmtContext) {
+	l.roleStmt = newRoleStmtParse(stmt.RoleOpDrop)
+	l.roleStmt.visitName(ctx.TextValue())
+}
+
+// EnterShowRolesStmt is called when production showRolesStmt is entered.
+func (l *listener) EnterShowRolesStmt(_ *grammar.ShowRolesStmtContext) {
+	l.roleStmt = newRoleStmtParse(stmt.RoleOpShow)
+}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (l *listener) EnterGrantStmt(ctx *grammar.GrantStmtContext) {
+	l.grantStmt = newGrantStmtParse(false)
+	l.grantStmt.visitGrantee(ctx.TextValue(), ctx.T_ROLE() != nil)
+}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (l *listener) EnterRevokeStmt(ctx *grammar.RevokeStmtContext) {
+	l.grantStmt = newGrantStmtParse(true)
+	l.grantStmt.visitGrantee(ctx.TextValue(), ctx.T_ROLE() != nil)
+}
+
+// EnterRoleGrant is called when production roleGrant is entered.
+func (l *listener) EnterRoleGrant(ctx *grammar.RoleGrantContext) {
+	l.grantStmt.visitRoleGrant(ctx)
+}
+
+// EnterPrivilegeGrant is called when production privilegeGrant is entered.
+func (l *listener) EnterPrivilegeGrant(ctx *grammar.PrivilegeGrantContext) {
+	l.grantStmt.visitPrivilegeGrant(ctx)
+}
+
+// EnterPrivilege is called when production privilege is entered.
+func (l *listener) EnterPrivilege(ctx *grammar.PrivilegeContext) {
+	switch {
+	case l.grantStmt != nil:
+		l.grantStmt.visitPrivilege(ctx)
+	case l.tokenStmt != nil:
+		l.tokenStmt.visitPrivilege(ctx)
+	}
+}
+
+// EnterCreateTokenStmt is called when production createTokenStmt is entered.
+func (l *listener) EnterCreateTokenStmt(ctx *grammar.CreateTokenStmtContext) {
+	l.tokenStmt = newTokenStmtParse(stmt.TokenOpCreate)
+	l.tokenStmt.visitName(ctx.TextValue(0))
+	l.tokenStmt.visitScope(ctx.TextValue(1), ctx.TextValue(2))
+}
+
+// EnterDropTokenStmt is called when production dropTokenStmt is entered.
+func (l *listener) EnterDropTokenStmt(ctx *grammar.DropTokenStmtContext) {
+	l.tokenStmt = newTokenStmtParse(stmt.TokenOpDrop)
+	l.tokenStmt.visitName(ctx.TextValue())
+}
+
+// EnterShowTokensStmt is called when production showTokensStmt is entered.
+func (l *listener) EnterShowTokensStmt(_ *grammar.ShowTokensStmtContext) {
+	l.tokenStmt = newTokenStmtParse(stmt.TokenOpShow)
+}
+
+// EnterCreateTenantStmt is called when production createTenantStmt is entered.
+func (l *listener) EnterCreateTenantStmt(ctx *grammar.CreateTenantStmtContext) {
+	l.tenantStmt = newTenantStmtParse(stmt.TenantOpCreate)
+	l.tenantStmt.visitName(ctx.TextValue())
+}
+
+// EnterAlterTenantStmt is called when production alterTenantStmt is entered.
+func (l *listener) EnterAlterTenantStmt(ctx *grammar.AlterTenantStmtContext) {
+	l.tenantStmt = newTenantStmtParse(stmt.TenantOpAlter)
+	l.tenantStmt.visitName(ctx.TextValue())
+}
+
+// EnterTenantQuota is called when production tenantQuota is entered.
+func (l *listener) EnterTenantQuota(ctx *grammar.TenantQuotaContext) {
+	l.tenantStmt.visitQuota(ctx)
+}
+
+// EnterDropTenantStmt is called when production dropTenantStmt is entered.
+func (l *listener) EnterDropTenantStmt(ctx *grammar.DropTenantStmtContext) {
+	l.tenantStmt = newTenantStmtParse(stmt.TenantOpDrop)
+	l.tenantStmt.visitName(ctx.TextValue())
+}
+
+// EnterShowTenantsStmt is called when production showTenantsStmt is entered.
+func (l *listener) EnterShowTenantsStmt(_ *grammar.ShowTenantsStmtContext) {
+	l.tenantStmt = newTenantStmtParse(stmt.TenantOpShow)
+}
+
+// EnterShowTenantUsageStmt is called when production showTenantUsageStmt is entered.
+func (l *listener) EnterShowTenantUsageStmt(ctx *grammar.ShowTenantUsageStmtContext) {
+	l.tenantStmt = newTenantStmtParse(stmt.TenantOpShowUsage)
+	l.tenantStmt.visitName(ctx.TextValue())
+}
+
+// EnterShowAuditLogStmt is called when production showAuditLogStmt is entered.
+func (l *listener) EnterShowAuditLogStmt(_ *grammar.ShowAuditLogStmtContext) {
+	l.auditStmt = newAuditStmtParse()
+}
+
 // statement returns query statement, if failure return error
 func (l *listener) statement() (stmt.Statement, error) {
 	switch {
@@ -418,6 +673,26 @@ func (l *listener) statement() (stmt.Statement, error) {
 		return l.requestStmt.build()
 	case l.brokerStmt != nil:
 		return l.brokerStmt.build()
+	case l.backupStmt != nil:
+		return l.backupStmt.build()
+	case l.compactStmt != nil:
+		return l.compactStmt.build()
+	case l.recordingRuleStmt != nil:
+		return l.recordingRuleStmt.build()
+	case l.alertStmt != nil:
+		return l.alertStmt.build()
+	case l.userStmt != nil:
+		return l.userStmt.build()
+	case l.roleStmt != nil:
+		return l.roleStmt.build()
+	case l.grantStmt != nil:
+		return l.grantStmt.build()
+	case l.tokenStmt != nil:
+		return l.tokenStmt.build()
+	case l.tenantStmt != nil:
+		return l.tenantStmt.build()
+	case l.auditStmt != nil:
+		return l.auditStmt.build()
 	default:
 		return nil, nil
 	}
@@ -50,7 +50,10 @@ func Test_Sql_examples(t *testing.T) {
 		{true, "select x from y where update = 'sss'"},
 		{true, "select x from y where metric = 'sss' and drop='xxx'"},
 		{true, "select used_percent,free as f,total as t,a+1 from mem where time>now()-1h group by node,role"},
+		{true, "select read,write from user where role = 'admin' and tenant = 't1'"},
+		{true, "show databases;"},
 		{false, "drop x from y where drop = 'sss'"},
+		{false, "show databases abc"},
 	}
 	for _, example := range examples {
 		_, err := Parse(example.sql)
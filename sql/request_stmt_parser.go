@@ -29,9 +29,9 @@ type requestStmtParser struct {
 }
 
 // newRequestStmtParse creates a show requests/request statement parser.
-func newRequestStmtParse() *requestStmtParser {
+func newRequestStmtParse(requestType stmt.RequestType) *requestStmtParser {
 	return &requestStmtParser{
-		request: &stmt.Request{},
+		request: &stmt.Request{Type: requestType},
 	}
 }
 
@@ -34,3 +34,17 @@ func TestRequestStmt(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, &stmt.Request{RequestID: "xxx"}, q)
 }
+
+func TestShowSlowRequests(t *testing.T) {
+	q, err := Parse("show slow requests")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Request{Type: stmt.SlowRequests}, q)
+
+	q, err = Parse("SHOW SLOW REQUESTS;")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Request{Type: stmt.SlowRequests}, q)
+
+	q, err = Parse("show slow request")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+}
@@ -0,0 +1,108 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// recordingRuleStmtParser represents recording rule statement parser.
+type recordingRuleStmtParser struct {
+	rule    *stmt.RecordingRule
+	options optionSet
+
+	err error
+}
+
+// newRecordingRuleStmtParse creates a recording rule statement parser.
+func newRecordingRuleStmtParse(opType stmt.RecordingRuleOpType) *recordingRuleStmtParser {
+	return &recordingRuleStmtParser{
+		rule:    &stmt.RecordingRule{Type: opType},
+		options: make(optionSet),
+	}
+}
+
+// visitName visits when production recording rule name is entered.
+func (r *recordingRuleStmtParser) visitName(ctx grammar.ITextValueContext) {
+	r.rule.Name = getTextValue(ctx)
+}
+
+// visitOption visits when production recording rule option(ON/INTO/EVERY/NAMESPACE/AS) is entered.
+func (r *recordingRuleStmtParser) visitOption(ctx *grammar.RecordingRuleOptionContext) {
+	if r.err != nil {
+		return
+	}
+	if r.err = r.options.visit(ctx); r.err != nil {
+		return
+	}
+	value := getTextValue(ctx.TextValue())
+	switch {
+	case ctx.T_ON() != nil:
+		r.rule.Database = value
+	case ctx.T_INTO() != nil:
+		r.rule.Metric = value
+	case ctx.T_NAMESPACE() != nil:
+		r.rule.Namespace = value
+	case ctx.T_AS() != nil:
+		r.rule.SQL = value
+	case ctx.T_EVERY() != nil:
+		r.rule.Interval, r.err = getDurationValue(ctx.DurationValue())
+	}
+}
+
+// build returns the recording rule statement.
+func (r *recordingRuleStmtParser) build() (stmt.Statement, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.rule.Type != stmt.RecordingRuleOpCreate {
+		return r.rule, nil
+	}
+	switch {
+	case r.rule.Name == "":
+		return nil, fmt.Errorf("name of recording rule is required")
+	case r.rule.Database == "":
+		return nil, fmt.Errorf("'ON' of recording rule is required")
+	case r.rule.Metric == "":
+		return nil, fmt.Errorf("'INTO' of recording rule is required")
+	case r.rule.Interval <= 0:
+		return nil, fmt.Errorf("'EVERY' of recording rule is required")
+	}
+	if err := checkRuleQuery(r.rule.SQL); err != nil {
+		return nil, err
+	}
+	return r.rule, nil
+}
+
+// checkRuleQuery checks if the query of recording/alert rule is a valid metric query.
+func checkRuleQuery(sql string) error {
+	if sql == "" {
+		return fmt.Errorf("'AS' query of rule is required")
+	}
+	query, err := Parse(sql)
+	if err != nil {
+		return err
+	}
+	if query == nil || query.StatementType() != stmt.QueryStatement {
+		return fmt.Errorf("query of rule must be metric query: %s", sql)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestRecordingRule(t *testing.T) {
+	q, err := Parse(`create recording rule svc_requests on test into 'svc:requests:1m' every '1m' ` +
+		`as 'select sum(requests) from http group by service, time(1m)'`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.RecordingRule{
+		Type:     stmt.RecordingRuleOpCreate,
+		Name:     "svc_requests",
+		Database: "test",
+		Metric:   "svc:requests:1m",
+		Interval: timeutil.OneMinute,
+		SQL:      "select sum(requests) from http group by service, time(1m)",
+	}, q)
+
+	q, err = Parse(`CREATE RECORDING RULE r1 ON test NAMESPACE ns AS "select f from cpu" EVERY 30s INTO cpu_30s`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.RecordingRule{
+		Type:      stmt.RecordingRuleOpCreate,
+		Name:      "r1",
+		Database:  "test",
+		Namespace: "ns",
+		Metric:    "cpu_30s",
+		Interval:  30 * timeutil.OneSecond,
+		SQL:       "select f from cpu",
+	}, q)
+
+	q, err = Parse("show recording rules")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.RecordingRule{Type: stmt.RecordingRuleOpShow}, q)
+
+	q, err = Parse("DROP RECORDING RULE r1;")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.RecordingRule{Type: stmt.RecordingRuleOpDrop, Name: "r1"}, q)
+
+	for _, sql := range []string{
+		"create recording rule",
+		"create recording rule r1 on test into m every 1m",
+		"create recording rule r1 on test into m as 'select f from cpu'",
+		"create recording rule r1 on test into m every 1x as 'select f from cpu'",
+		"create recording rule r1 on test into m every 1m as 'show databases'",
+		"create recording rule r1 on test into m every 1m as 'select from'",
+		"show recording rule",
+		"drop recording rule",
+	} {
+		q, err = Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
@@ -18,6 +18,9 @@
 package sql
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
@@ -26,6 +29,8 @@ import (
 // schemasStmtParser represents show schemas statement parser.
 type schemasStmtParser struct {
 	schema *stmt.Schema
+
+	err error
 }
 
 // newSchemasStmtParse creates a show schemas statement parser.
@@ -43,7 +48,21 @@ func (s *schemasStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext)
 	s.schema.Value = strutil.GetStringValue(ctx.GetText())
 }
 
+// visitAlterDatabase visits when production alter replica factor of database is entered.
+func (s *schemasStmtParser) visitAlterDatabase(ctx *grammar.AlterDatabaseStmtContext) {
+	s.schema.Value = getTextValue(ctx.TextValue())
+	replicaFactor, err := strconv.Atoi(ctx.L_INT().GetText())
+	if err != nil || replicaFactor <= 0 {
+		s.err = fmt.Errorf("invalid replica factor '%s' of database", ctx.L_INT().GetText())
+		return
+	}
+	s.schema.ReplicaFactor = replicaFactor
+}
+
 // build returns the state statement.
 func (s *schemasStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return s.schema, nil
 }
@@ -47,3 +47,23 @@ func TestCreateDatabase(t *testing.T) {
 		Value: `{"name":"test"}`,
 	}, q)
 }
+
+func TestAlterDatabase(t *testing.T) {
+	q, err := Parse("alter database db replica 3")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Schema{Type: stmt.AlterDatabaseReplicaSchemaType, Value: "db", ReplicaFactor: 3}, q)
+	q, err = Parse("ALTER DATABASE 'db-1' REPLICA 2;")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Schema{Type: stmt.AlterDatabaseReplicaSchemaType, Value: "db-1", ReplicaFactor: 2}, q)
+	for _, sql := range []string{
+		"alter database db",
+		"alter database db replica",
+		"alter database db replica abc",
+		"alter database db replica 0",
+		"alter database db shard 3",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// AlertOpType represents alert rule related operation.
+type AlertOpType int
+
+const (
+	// AlertOpUnknown represents unknown operation.
+	AlertOpUnknown AlertOpType = iota
+	// AlertOpCreate represents create alert rule.
+	AlertOpCreate
+	// AlertOpShow represents show state of all alert rules.
+	AlertOpShow
+	// AlertOpDrop represents drop alert rule.
+	AlertOpDrop
+)
+
+// Alert represents alert rule statement.
+type Alert struct {
+	Type      AlertOpType
+	Name      string
+	Database  string
+	Namespace string
+	SQL       string
+	// condition, compares the latest value of field(all fields if empty) with threshold.
+	Field     string
+	Operator  string
+	Threshold float64
+	// Interval represents the evaluation interval(millisecond).
+	Interval int64
+	// For represents the duration(millisecond) which condition lasts before firing.
+	For     int64
+	Labels  map[string]string
+	Webhook string
+	Format  string
+}
+
+// StatementType returns alert query type.
+func (q *Alert) StatementType() StatementType {
+	return AlertStatement
+}
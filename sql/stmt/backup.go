@@ -0,0 +1,43 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// BackupOpType represents database backup related operation.
+type BackupOpType int
+
+const (
+	// BackupOpUnknown represents unknown operation.
+	BackupOpUnknown BackupOpType = iota
+	// BackupOpBackup represents backup database.
+	BackupOpBackup
+	// BackupOpRestore represents restore database from backup.
+	BackupOpRestore
+)
+
+// Backup represents database backup/restore statement.
+type Backup struct {
+	Type     BackupOpType
+	Database string
+	// Dir represents the backup dir on each storage node, relative to the backup root dir(tsdb.backup-dir).
+	Dir string
+}
+
+// StatementType returns backup query type.
+func (q *Backup) StatementType() StatementType {
+	return BackupStatement
+}
@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// CompactOpType represents database manual compaction related operation.
+type CompactOpType int
+
+const (
+	// CompactOpUnknown represents unknown operation.
+	CompactOpUnknown CompactOpType = iota
+	// CompactOpCompact represents full compaction of level0 files.
+	CompactOpCompact
+	// CompactOpRollup represents rollup of source interval data.
+	CompactOpRollup
+	// CompactOpShowState represents show compaction state.
+	CompactOpShowState
+)
+
+// Compact represents database manual compaction/rollup statement.
+type Compact struct {
+	Type     CompactOpType
+	Database string
+	// ShardIDs represents the target shards, all shards if empty.
+	ShardIDs []int32
+	// FamilyTime represents the target data family which contains this time, all families if 0.
+	FamilyTime int64
+}
+
+// StatementType returns compact query type.
+func (q *Compact) StatementType() StatementType {
+	return CompactStatement
+}
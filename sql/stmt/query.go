@@ -53,6 +53,15 @@ func (q *Query) HasGroupBy() bool {
 	return len(q.GroupBy) > 0
 }
 
+// PointCount returns the number of points in query time range based on query interval.
+func (q *Query) PointCount() int {
+	interval := q.Interval.Int64()
+	if interval <= 0 {
+		return 0
+	}
+	return timeutil.CalPointCount(q.TimeRange.Start, q.TimeRange.End, interval) + 1
+}
+
 // innerQuery represents a wrapper of query for json encoding
 type innerQuery struct {
 	Explain     bool              `json:"Explain,omitempty"`
@@ -98,3 +98,12 @@ func TestQuery_Marshal_Fail(t *testing.T) {
 func TestQuery_StatementType(t *testing.T) {
 	assert.Equal(t, QueryStatement, (&Query{}).StatementType())
 }
+
+func TestQuery_PointCount(t *testing.T) {
+	assert.Zero(t, (&Query{}).PointCount())
+	q := &Query{
+		Interval:  timeutil.Interval(timeutil.OneMinute),
+		TimeRange: timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+	}
+	assert.Equal(t, 61, q.PointCount())
+}
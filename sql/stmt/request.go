@@ -17,8 +17,19 @@
 
 package stmt
 
+// RequestType represents the type of show request statement.
+type RequestType int
+
+const (
+	// AliveRequests represents show current alive requests.
+	AliveRequests RequestType = iota
+	// SlowRequests represents show recent slow requests.
+	SlowRequests
+)
+
 // Request represents show request statement.
 type Request struct {
+	Type      RequestType
 	RequestID string
 }
 
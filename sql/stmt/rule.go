@@ -0,0 +1,50 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// RecordingRuleOpType represents recording rule related operation.
+type RecordingRuleOpType int
+
+const (
+	// RecordingRuleOpUnknown represents unknown operation.
+	RecordingRuleOpUnknown RecordingRuleOpType = iota
+	// RecordingRuleOpCreate represents create recording rule.
+	RecordingRuleOpCreate
+	// RecordingRuleOpShow represents show all recording rules.
+	RecordingRuleOpShow
+	// RecordingRuleOpDrop represents drop recording rule.
+	RecordingRuleOpDrop
+)
+
+// RecordingRule represents recording rule(continuous query) statement.
+type RecordingRule struct {
+	Type      RecordingRuleOpType
+	Name      string
+	Database  string
+	Namespace string
+	// Metric represents the target metric name which the result written into.
+	Metric string
+	// Interval represents the evaluation interval(millisecond).
+	Interval int64
+	SQL      string
+}
+
+// StatementType returns recording rule query type.
+func (q *RecordingRule) StatementType() StatementType {
+	return RecordingRuleStatement
+}
@@ -24,14 +24,17 @@ const (
 	DatabaseSchemaType
 	CreateDatabaseSchemaType
 	DropDatabaseSchemaType
+	AlterDatabaseReplicaSchemaType
 )
 
 // Schema represents show all database schemas statement.
 type Schema struct {
 	Type SchemaType
 	// create stmt: value is database json config.
-	// drop stmt: value is database name.
+	// drop/alter stmt: value is database name.
 	Value string
+	// ReplicaFactor represents the new replica factor of database for alter stmt.
+	ReplicaFactor int
 }
 
 // StatementType returns schema query type.
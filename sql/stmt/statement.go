@@ -29,6 +29,16 @@ const (
 	QueryStatement
 	RequestStatement
 	BrokerStatement
+	BackupStatement
+	CompactStatement
+	RecordingRuleStatement
+	AlertStatement
+	UserStatement
+	RoleStatement
+	GrantStatement
+	TokenStatement
+	TenantStatement
+	AuditStatement
 )
 
 // Statement represents LinDB query language statement
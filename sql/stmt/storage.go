@@ -31,12 +31,18 @@ const (
 	StorageOpDelete
 	// StorageOpRecover represents recover storage metadata.
 	StorageOpRecover
+	// StorageOpShowRebalance represents show replica moves of shard rebalance.
+	StorageOpShowRebalance
+	// StorageOpDecommission represents decommission storage node.
+	StorageOpDecommission
 )
 
 // Storage represent storage statement.
 type Storage struct {
 	Type  StorageOpType
 	Value string
+	// NodeID represents the id of storage node which will be decommissioned.
+	NodeID int64
 }
 
 // StatementType returns storage query type.
@@ -0,0 +1,61 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// TenantOpType represents tenant related operation.
+type TenantOpType int
+
+const (
+	// TenantOpUnknown represents unknown operation.
+	TenantOpUnknown TenantOpType = iota
+	// TenantOpCreate represents create tenant.
+	TenantOpCreate
+	// TenantOpAlter represents alter quotas of tenant.
+	TenantOpAlter
+	// TenantOpDrop represents drop tenant.
+	TenantOpDrop
+	// TenantOpShow represents show all tenants.
+	TenantOpShow
+	// TenantOpShowUsage represents show resource usage of tenant(s).
+	TenantOpShowUsage
+)
+
+// quota names of tenant.
+const (
+	// TenantIngestRate represents the max ingested points per second of cluster.
+	TenantIngestRate = "ingest_rate"
+	// TenantMaxSeries represents the max number of series.
+	TenantMaxSeries = "max_series"
+	// TenantMaxQueries represents the max number of concurrent queries of cluster.
+	TenantMaxQueries = "max_queries"
+	// TenantMaxDisk represents the max disk bytes.
+	TenantMaxDisk = "max_disk"
+)
+
+// Tenant represents tenant management statement.
+type Tenant struct {
+	Type TenantOpType
+	Name string
+	// Quotas represents the quotas specified by statement, key is quota name.
+	Quotas map[string]int64
+}
+
+// StatementType returns tenant query type.
+func (q *Tenant) StatementType() StatementType {
+	return TenantStatement
+}
@@ -0,0 +1,46 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// TokenOpType represents api token related operation.
+type TokenOpType int
+
+const (
+	// TokenOpUnknown represents unknown operation.
+	TokenOpUnknown TokenOpType = iota
+	// TokenOpCreate represents create api token.
+	TokenOpCreate
+	// TokenOpDrop represents drop(revoke) api token.
+	TokenOpDrop
+	// TokenOpShow represents show all api tokens.
+	TokenOpShow
+)
+
+// Token represents api token management statement.
+type Token struct {
+	Type        TokenOpType
+	Name        string
+	Database    string
+	Namespace   string
+	Permissions []string
+}
+
+// StatementType returns api token query type.
+func (q *Token) StatementType() StatementType {
+	return TokenStatement
+}
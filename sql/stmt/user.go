@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// UserOpType represents user related operation.
+type UserOpType int
+
+const (
+	// UserOpUnknown represents unknown operation.
+	UserOpUnknown UserOpType = iota
+	// UserOpCreate represents create user.
+	UserOpCreate
+	// UserOpAlter represents change password of user.
+	UserOpAlter
+	// UserOpDrop represents drop user.
+	UserOpDrop
+	// UserOpShow represents show all users.
+	UserOpShow
+	// UserOpRevokeTokens represents revoke all tokens issued to user by login api.
+	UserOpRevokeTokens
+)
+
+// User represents user management statement.
+type User struct {
+	Type     UserOpType
+	Name     string
+	Password string
+}
+
+// StatementType returns user query type.
+func (q *User) StatementType() StatementType {
+	return UserStatement
+}
+
+// RoleOpType represents role related operation.
+type RoleOpType int
+
+const (
+	// RoleOpUnknown represents unknown operation.
+	RoleOpUnknown RoleOpType = iota
+	// RoleOpCreate represents create role.
+	RoleOpCreate
+	// RoleOpDrop represents drop role.
+	RoleOpDrop
+	// RoleOpShow represents show all roles.
+	RoleOpShow
+)
+
+// Role represents role management statement.
+type Role struct {
+	Type RoleOpType
+	Name string
+}
+
+// StatementType returns role query type.
+func (q *Role) StatementType() StatementType {
+	return RoleStatement
+}
+
+// Grant represents grant/revoke privileges(or role) statement.
+type Grant struct {
+	// Revoke represents revoke statement.
+	Revoke bool
+	// Role represents the role granted to user, privileges are granted if empty.
+	Role        string
+	Permissions []string
+	Database    string
+	Namespace   string
+	// Grantee represents the user(or role if ToRole) which privileges granted to.
+	Grantee string
+	ToRole  bool
+}
+
+// StatementType returns grant query type.
+func (q *Grant) StatementType() StatementType {
+	return GrantStatement
+}
@@ -18,6 +18,11 @@
 package sql
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+
 	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
@@ -26,6 +31,8 @@ import (
 // storageStmtParser represents storage statement parser.
 type storageStmtParser struct {
 	storage *stmt.Storage
+
+	err error
 }
 
 // newStorageStmtParse creates a storage statement parser.
@@ -45,7 +52,25 @@ func (s *storageStmtParser) visitCfg(ctx *grammar.JsonContext) {
 	s.storage.Value = ctx.GetText()
 }
 
+// visitStorage visits when production storage name of rebalance/decommission is entered.
+func (s *storageStmtParser) visitStorage(ctx grammar.ITextValueContext) {
+	s.storage.Value = getTextValue(ctx)
+}
+
+// visitNodeID visits when production node id of decommission is entered.
+func (s *storageStmtParser) visitNodeID(node antlr.TerminalNode) {
+	nodeID, err := strconv.ParseInt(node.GetText(), 10, 64)
+	if err != nil {
+		s.err = fmt.Errorf("invalid node id '%s'", node.GetText())
+		return
+	}
+	s.storage.NodeID = nodeID
+}
+
 // build returns the state statement.
 func (s *storageStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return s.storage, nil
 }
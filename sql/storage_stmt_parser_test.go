@@ -51,3 +51,41 @@ func TestRecoverStorage(t *testing.T) {
 		Value: "test",
 	}, q)
 }
+
+func TestShowRebalance(t *testing.T) {
+	q, err := Parse("show rebalance")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{Type: stmt.StorageOpShowRebalance}, q)
+	q, err = Parse("SHOW REBALANCE '/lindb-storage';")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{Type: stmt.StorageOpShowRebalance, Value: "/lindb-storage"}, q)
+	for _, sql := range []string{
+		"show rebalance s1 s2",
+		"show rebalances",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
+
+func TestDecommission(t *testing.T) {
+	q, err := Parse("decommission storage node 3")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{Type: stmt.StorageOpDecommission, NodeID: 3}, q)
+	q, err = Parse("DECOMMISSION STORAGE NODE 3 ON '/lindb-storage';")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{Type: stmt.StorageOpDecommission, NodeID: 3, Value: "/lindb-storage"}, q)
+	for _, sql := range []string{
+		"decommission storage",
+		"decommission storage node",
+		"decommission storage node abc",
+		"decommission storage node -1",
+		"decommission storage node 3 in s1",
+		"decommission broker node 3",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
@@ -0,0 +1,105 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// tenantStmtParser represents tenant management statement parser.
+type tenantStmtParser struct {
+	tenant  *stmt.Tenant
+	options optionSet
+
+	err error
+}
+
+// newTenantStmtParse creates a tenant management statement parser.
+func newTenantStmtParse(opType stmt.TenantOpType) *tenantStmtParser {
+	parser := &tenantStmtParser{
+		tenant:  &stmt.Tenant{Type: opType},
+		options: make(optionSet),
+	}
+	if opType == stmt.TenantOpCreate || opType == stmt.TenantOpAlter {
+		parser.tenant.Quotas = make(map[string]int64)
+	}
+	return parser
+}
+
+// visitName visits when production tenant name is entered.
+func (t *tenantStmtParser) visitName(ctx grammar.ITextValueContext) {
+	t.tenant.Name = getTextValue(ctx)
+}
+
+// visitQuota visits when production tenant quota is entered.
+func (t *tenantStmtParser) visitQuota(ctx *grammar.TenantQuotaContext) {
+	if t.err != nil {
+		return
+	}
+	if t.err = t.options.visit(ctx); t.err != nil {
+		return
+	}
+	var name string
+	switch {
+	case ctx.T_INGEST_RATE() != nil:
+		name = stmt.TenantIngestRate
+	case ctx.T_MAX_SERIES() != nil:
+		name = stmt.TenantMaxSeries
+	case ctx.T_MAX_QUERIES() != nil:
+		name = stmt.TenantMaxQueries
+	case ctx.T_MAX_DISK() != nil:
+		var size ltoml.Size
+		value := getTextValue(ctx.TextValue())
+		if ctx.L_INT() != nil {
+			value = ctx.L_INT().GetText()
+		}
+		if err := size.UnmarshalText([]byte(value)); err != nil {
+			t.err = fmt.Errorf("invalid %s '%s' of tenant", stmt.TenantMaxDisk, value)
+			return
+		}
+		t.tenant.Quotas[stmt.TenantMaxDisk] = int64(size)
+		return
+	}
+	quota, err := strconv.ParseInt(ctx.L_INT().GetText(), 10, 64)
+	if err != nil {
+		t.err = fmt.Errorf("invalid %s '%s' of tenant", name, ctx.L_INT().GetText())
+		return
+	}
+	t.tenant.Quotas[name] = quota
+}
+
+// build returns the tenant management statement.
+func (t *tenantStmtParser) build() (stmt.Statement, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	switch t.tenant.Type {
+	case stmt.TenantOpShow, stmt.TenantOpShowUsage:
+	default:
+		if t.tenant.Name == "" {
+			return nil, errors.New("tenant name is required")
+		}
+	}
+	return t.tenant, nil
+}
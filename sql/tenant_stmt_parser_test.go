@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestTenant(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt stmt.Statement
+	}{
+		{"create tenant t1", &stmt.Tenant{Type: stmt.TenantOpCreate, Name: "t1", Quotas: map[string]int64{}}},
+		{
+			"CREATE TENANT 't1' INGEST_RATE 1000 MAX_SERIES 10000 MAX_QUERIES 10 MAX_DISK '1GiB'",
+			&stmt.Tenant{Type: stmt.TenantOpCreate, Name: "t1", Quotas: map[string]int64{
+				stmt.TenantIngestRate: 1000,
+				stmt.TenantMaxSeries:  10000,
+				stmt.TenantMaxQueries: 10,
+				stmt.TenantMaxDisk:    1024 * 1024 * 1024,
+			}},
+		},
+		{
+			"alter tenant t1 max_series 0",
+			&stmt.Tenant{Type: stmt.TenantOpAlter, Name: "t1", Quotas: map[string]int64{stmt.TenantMaxSeries: 0}},
+		},
+		{"drop tenant t1", &stmt.Tenant{Type: stmt.TenantOpDrop, Name: "t1"}},
+		{"show tenants", &stmt.Tenant{Type: stmt.TenantOpShow}},
+		{"show tenant usage", &stmt.Tenant{Type: stmt.TenantOpShowUsage}},
+		{"show tenant usage t1", &stmt.Tenant{Type: stmt.TenantOpShowUsage, Name: "t1"}},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+	for _, sql := range []string{
+		"create tenant",
+		"create tenant t1 ingest_rate",
+		"create tenant t1 ingest_rate abc",
+		"create tenant t1 max_series -1",
+		"create tenant t1 max_disk 'abc'",
+		"create tenant t1 max_cpu 1",
+		"alter tenant t1",
+		"drop tenant",
+		"show tenants t1",
+		"show tenant t1",
+		"show tenant usage t1 t2",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
@@ -0,0 +1,64 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// tokenStmtParser represents api token management statement parser.
+type tokenStmtParser struct {
+	token *stmt.Token
+}
+
+// newTokenStmtParse creates an api token management statement parser.
+func newTokenStmtParse(opType stmt.TokenOpType) *tokenStmtParser {
+	return &tokenStmtParser{
+		token: &stmt.Token{Type: opType},
+	}
+}
+
+// visitName visits when production api token name is entered.
+func (t *tokenStmtParser) visitName(ctx grammar.ITextValueContext) {
+	t.token.Name = getTextValue(ctx)
+}
+
+// visitScope visits when production database/namespace of api token is entered.
+func (t *tokenStmtParser) visitScope(database, namespace grammar.ITextValueContext) {
+	t.token.Database = getTextValue(database)
+	t.token.Namespace = getTextValue(namespace)
+}
+
+// visitPrivilege visits when production privilege(READ/WRITE/ALL) is entered.
+func (t *tokenStmtParser) visitPrivilege(ctx *grammar.PrivilegeContext) {
+	t.token.Permissions = append(t.token.Permissions, getPermissions(ctx)...)
+}
+
+// build returns the api token management statement.
+func (t *tokenStmtParser) build() (stmt.Statement, error) {
+	if t.token.Type != stmt.TokenOpShow && t.token.Name == "" {
+		return nil, errors.New("token name is required")
+	}
+	if t.token.Type == stmt.TokenOpCreate && t.token.Database == "" {
+		return nil, errors.New("database of token is required")
+	}
+	return t.token, nil
+}
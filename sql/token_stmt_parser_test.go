@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestToken(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt stmt.Statement
+	}{
+		{
+			"create token t1 on db for write",
+			&stmt.Token{Type: stmt.TokenOpCreate, Name: "t1", Database: "db", Permissions: []string{"write"}},
+		},
+		{
+			"CREATE TOKEN 't1' ON db NAMESPACE ns FOR READ, WRITE",
+			&stmt.Token{Type: stmt.TokenOpCreate, Name: "t1", Database: "db", Namespace: "ns", Permissions: []string{"read", "write"}},
+		},
+		{"drop token t1", &stmt.Token{Type: stmt.TokenOpDrop, Name: "t1"}},
+		{"show tokens", &stmt.Token{Type: stmt.TokenOpShow}},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+	for _, sql := range []string{
+		"create token",
+		"create token t1 on db",
+		"create token t1 on db for",
+		"create token t1 on db for delete",
+		"create token t1 for read",
+		"create token t1 on db namespace for read",
+		"drop token",
+		"show tokens t1",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
@@ -0,0 +1,147 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// userStmtParser represents user management statement parser.
+type userStmtParser struct {
+	user *stmt.User
+}
+
+// newUserStmtParse creates a user management statement parser.
+func newUserStmtParse(opType stmt.UserOpType) *userStmtParser {
+	return &userStmtParser{
+		user: &stmt.User{Type: opType},
+	}
+}
+
+// visitName visits when production user name is entered.
+func (u *userStmtParser) visitName(ctx grammar.ITextValueContext) {
+	u.user.Name = getTextValue(ctx)
+}
+
+// visitPassword visits when production password of user is entered.
+func (u *userStmtParser) visitPassword(ctx grammar.ITextValueContext) {
+	u.user.Password = getTextValue(ctx)
+}
+
+// build returns the user management statement.
+func (u *userStmtParser) build() (stmt.Statement, error) {
+	switch u.user.Type {
+	case stmt.UserOpShow:
+	case stmt.UserOpCreate, stmt.UserOpAlter:
+		if u.user.Name == "" || u.user.Password == "" {
+			return nil, errors.New("user name and password are required")
+		}
+	default:
+		if u.user.Name == "" {
+			return nil, errors.New("user name is required")
+		}
+	}
+	return u.user, nil
+}
+
+// roleStmtParser represents role management statement parser.
+type roleStmtParser struct {
+	role *stmt.Role
+}
+
+// newRoleStmtParse creates a role management statement parser.
+func newRoleStmtParse(opType stmt.RoleOpType) *roleStmtParser {
+	return &roleStmtParser{
+		role: &stmt.Role{Type: opType},
+	}
+}
+
+// visitName visits when production role name is entered.
+func (r *roleStmtParser) visitName(ctx grammar.ITextValueContext) {
+	r.role.Name = getTextValue(ctx)
+}
+
+// build returns the role management statement.
+func (r *roleStmtParser) build() (stmt.Statement, error) {
+	if r.role.Type != stmt.RoleOpShow && r.role.Name == "" {
+		return nil, errors.New("role name is required")
+	}
+	return r.role, nil
+}
+
+// grantStmtParser represents grant/revoke statement parser.
+type grantStmtParser struct {
+	grant *stmt.Grant
+}
+
+// newGrantStmtParse creates a grant/revoke statement parser.
+func newGrantStmtParse(revoke bool) *grantStmtParser {
+	return &grantStmtParser{
+		grant: &stmt.Grant{Revoke: revoke},
+	}
+}
+
+// visitGrantee visits when production grantee(user or role) is entered.
+func (g *grantStmtParser) visitGrantee(ctx grammar.ITextValueContext, toRole bool) {
+	g.grant.Grantee = getTextValue(ctx)
+	g.grant.ToRole = toRole
+}
+
+// visitRoleGrant visits when production granted role is entered.
+func (g *grantStmtParser) visitRoleGrant(ctx *grammar.RoleGrantContext) {
+	g.grant.Role = getTextValue(ctx.TextValue())
+}
+
+// visitPrivilegeGrant visits when production granted privileges(database/namespace) is entered.
+func (g *grantStmtParser) visitPrivilegeGrant(ctx *grammar.PrivilegeGrantContext) {
+	// database is * if privileges of all databases
+	g.grant.Database = strutil.GetStringValue(ctx.PrivilegeDatabase().GetText())
+	g.grant.Namespace = getTextValue(ctx.TextValue())
+}
+
+// visitPrivilege visits when production privilege(READ/WRITE/ALL) is entered.
+func (g *grantStmtParser) visitPrivilege(ctx *grammar.PrivilegeContext) {
+	g.grant.Permissions = append(g.grant.Permissions, getPermissions(ctx)...)
+}
+
+// build returns the grant/revoke statement.
+func (g *grantStmtParser) build() (stmt.Statement, error) {
+	if g.grant.Grantee == "" {
+		return nil, errors.New("grantee is required")
+	}
+	if g.grant.Role == "" && g.grant.Database == "" {
+		return nil, errors.New("role or database of privileges is required")
+	}
+	return g.grant, nil
+}
+
+// getPermissions returns the permissions of privilege, ALL represents read and write.
+func getPermissions(ctx *grammar.PrivilegeContext) []string {
+	switch {
+	case ctx.T_READ() != nil:
+		return []string{"read"}
+	case ctx.T_WRITE() != nil:
+		return []string{"write"}
+	default:
+		return []string{"read", "write"}
+	}
+}
@@ -0,0 +1,105 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestUserAndRole(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt stmt.Statement
+	}{
+		{"create user u1 password 'p@ss word'", &stmt.User{Type: stmt.UserOpCreate, Name: "u1", Password: "p@ss word"}},
+		{"ALTER USER u1 PASSWORD 'abc';", &stmt.User{Type: stmt.UserOpAlter, Name: "u1", Password: "abc"}},
+		{"alter user u1 revoke tokens", &stmt.User{Type: stmt.UserOpRevokeTokens, Name: "u1"}},
+		{"drop user u1", &stmt.User{Type: stmt.UserOpDrop, Name: "u1"}},
+		{"show users", &stmt.User{Type: stmt.UserOpShow}},
+		{"create role r1", &stmt.Role{Type: stmt.RoleOpCreate, Name: "r1"}},
+		{"drop role r1", &stmt.Role{Type: stmt.RoleOpDrop, Name: "r1"}},
+		{"show roles", &stmt.Role{Type: stmt.RoleOpShow}},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+	for _, sql := range []string{
+		"create user u1",
+		"create user u1 pwd 'abc'",
+		"alter user u1",
+		"alter user u1 revoke token",
+		"drop user",
+		"show users u1",
+		"create role",
+		"drop role",
+		"show roles r1",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
+
+func TestGrant(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt stmt.Statement
+	}{
+		{"grant role admin to u1", &stmt.Grant{Role: "admin", Grantee: "u1"}},
+		{"REVOKE ROLE r1 FROM u1", &stmt.Grant{Revoke: true, Role: "r1", Grantee: "u1"}},
+		{"grant read on db to u1", &stmt.Grant{Permissions: []string{"read"}, Database: "db", Grantee: "u1"}},
+		{
+			"GRANT READ, WRITE ON db NAMESPACE ns TO ROLE r1",
+			&stmt.Grant{Permissions: []string{"read", "write"}, Database: "db", Namespace: "ns", Grantee: "r1", ToRole: true},
+		},
+		{
+			"grant all on * to role r1",
+			&stmt.Grant{Permissions: []string{"read", "write"}, Database: "*", Grantee: "r1", ToRole: true},
+		},
+		{
+			"revoke write on db from u1",
+			&stmt.Grant{Revoke: true, Permissions: []string{"write"}, Database: "db", Grantee: "u1"},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+	for _, sql := range []string{
+		"grant",
+		"grant role r1 u1",
+		"grant read to u1",
+		"grant delete on db to u1",
+		"grant read on db",
+		"grant on db to u1",
+		"grant read on db from u1",
+		"revoke read on db to u1",
+		"grant read on db namespace to u1",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q)
+	}
+}
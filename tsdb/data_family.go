@@ -20,6 +20,7 @@ package tsdb
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -59,6 +61,13 @@ type DataFamily interface {
 	Family() kv.Family
 	// WriteRows writes metric rows with same family in batch.
 	WriteRows(rows []metric.StorageRow) error
+	// Import writes history metric rows with same family into a new data file directly,
+	// bypasses write-ahead log and memory database of family, returns the num. of rows written.
+	// The import id is committed with the data file, rows of applied import id are skipped(retried request).
+	Import(importID uint64, rows []metric.StorageRow) (written int, err error)
+	// Rewrite rewrites all data files of source family(copied from other node) with the ids of current node,
+	// then adds rewritten files into current family, inherits the write sequences of source family.
+	Rewrite(source DataFamily, mapping metricsdata.IDMapping) error
 	// ValidateSequence validates replica sequence if valid.
 	ValidateSequence(leader int32, seq int64) bool
 	// CommitSequence commits written sequence after write data.
@@ -514,6 +523,8 @@ func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 	if len(rows) == 0 {
 		return nil
 	}
+	// write is foreground load, slow down background io(compaction etc.) when it's busy.
+	kv.MarkForegroundLoad(len(rows))
 
 	db, err := f.GetOrCreateMemoryDatabase(f.familyTime)
 	if err != nil {
@@ -531,6 +542,13 @@ func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 		releaseFunc()
 	}()
 
+	_ = f.writeRowsTo(db, rows)
+	return nil
+}
+
+// writeRowsTo writes writable rows into memory database, returns the num. of rows written,
+// must be called after WithLock.
+func (f *dataFamily) writeRowsTo(db memdb.MemoryDatabase, rows []metric.StorageRow) (written int) {
 	for idx := range rows {
 		row := rows[idx]
 		if !row.Writable {
@@ -544,6 +562,7 @@ func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 		)
 		err := db.WriteRow(&row)
 		if err == nil {
+			written++
 			f.statistics.WriteMetrics.Incr()
 			f.statistics.WriteFields.Add(float64(len(row.FieldIDs)))
 		} else {
@@ -551,10 +570,165 @@ func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 			f.logger.Error("failed writing row", logger.String("family", f.indicator), logger.Error(err))
 		}
 	}
+	return written
+}
+
+// Import writes history metric rows with same family into a new data file directly.
+// Rows are written into a temporary memory database which isn't visible for query,
+// then the memory database is flushed as a new data file and registered by version edit of kv family,
+// so it bypasses write-ahead log and doesn't change the write/ack sequence of family.
+// Rows which aren't writable(lookup metadata failure) or written failure are skipped.
+// The import id is recorded in the same version edit with the new data file,
+// so that the retried import request which is already applied to this family is skipped.
+func (f *dataFamily) Import(importID uint64, rows []metric.StorageRow) (written int, err error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	if f.isImported(importID) {
+		f.logger.Info("import request already applied to family, skip it",
+			logger.String("family", f.indicator), logger.Any("importID", importID))
+		return len(rows), nil
+	}
+	db, err := newMemoryDBFunc(memdb.MemoryDatabaseCfg{
+		FamilyTime: f.familyTime,
+		Name:       f.shard.Database().Name(),
+		BufferMgr:  f.shard.BufferManager(),
+	})
+	if err != nil {
+		f.statistics.WriteMetricFailures.Add(float64(len(rows)))
+		return 0, err
+	}
+	defer func() {
+		if err0 := db.Close(); err0 != nil {
+			f.logger.Warn("failed to close import memory database",
+				logger.String("family", f.indicator), logger.Error(err0))
+		}
+	}()
+
+	db.AcquireWrite()
+	releaseFunc := db.WithLock()
+	written = f.writeRowsTo(db, rows)
+	releaseFunc()
+	db.CompleteWrite()
+
+	if db.NumOfMetrics() == 0 {
+		return 0, nil
+	}
+	flusher := f.family.NewFlusher()
+	defer flusher.Release()
+	flusher.Import(importID)
 
+	dataFlusher, err := newMetricDataFlusher(flusher)
+	if err != nil {
+		return 0, err
+	}
+	if err := db.FlushFamilyTo(dataFlusher); err != nil {
+		f.logger.Error("failed to flush import memory database",
+			logger.String("family", f.indicator),
+			logger.Int64("memDBSize", db.MemSize()), logger.Error(err))
+		f.statistics.MemDBFlushFailures.Incr()
+		return 0, err
+	}
+	f.logger.Info("import rows into family successfully",
+		logger.String("family", f.indicator), logger.Int("rows", written), logger.Int("skipped", len(rows)-written))
+	return written, nil
+}
+
+// isImported checks if the import request is applied to family.
+func (f *dataFamily) isImported(importID uint64) bool {
+	snapshot := f.family.GetSnapshot()
+	defer snapshot.Close()
+	return snapshot.GetCurrent().IsImported(importID)
+}
+
+// Rewrite rewrites all data files of source family(copied from other node) with the ids of current node,
+// then adds rewritten files into current family, inherits the write sequences of source family,
+// so that replica doesn't write the data which source family includes again.
+func (f *dataFamily) Rewrite(source DataFamily, mapping metricsdata.IDMapping) error {
+	snapshot := source.Family().GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	// rewritten file need rollup only if source file is waiting for rollup,
+	// because the rollup families of source are rewritten also.
+	rollupFiles := current.GetRollupFiles()
+	for _, fileMeta := range current.GetAllFiles() {
+		fileNumber := fileMeta.GetFileNumber()
+		reader, err := snapshot.GetReader(fileNumber)
+		if err != nil {
+			return err
+		}
+		if err := f.rewriteFile(reader, rollupFiles[fileNumber], mapping); err != nil {
+			return err
+		}
+	}
+	sequences := current.GetSequences()
+	if len(sequences) == 0 {
+		return nil
+	}
+	flusher := f.family.NewFlusher()
+	defer flusher.Release()
+	for leader, seq := range sequences {
+		flusher.Sequence(leader, seq)
+	}
+	if err := flusher.Commit(); err != nil {
+		return err
+	}
+	f.mutex.Lock()
+	for leader, seq := range sequences {
+		f.seq[leader] = *atomic.NewInt64(seq)
+		f.persistSeq[leader] = *atomic.NewInt64(seq)
+	}
+	f.mutex.Unlock()
+
+	f.logger.Info("rewrite family successfully",
+		logger.String("family", f.indicator), logger.String("source", source.Indicator()),
+		logger.Any("sequences", sequences))
 	return nil
 }
 
+// rewriteFile rewrites metric blocks of data file as a new data file of current family.
+func (f *dataFamily) rewriteFile(reader table.Reader, rollup []timeutil.Interval, mapping metricsdata.IDMapping) error {
+	type metricBlock struct {
+		metricID       uint32
+		targetMetricID uint32
+		block          []byte
+	}
+	var blocks []metricBlock
+	it := reader.Iterator()
+	for it.HasNext() {
+		metricID := it.Key()
+		block := it.Value()
+		if targetMetricID, ok := mapping.GetMetricID(metricID); ok {
+			blocks = append(blocks, metricBlock{metricID: metricID, targetMetricID: targetMetricID, block: block})
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+	// keys of data file must be in ascending order
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].targetMetricID < blocks[j].targetMetricID
+	})
+	flusher := f.family.NewFlusher()
+	defer flusher.Release()
+	flusher.Rollup(rollup...)
+
+	dataFlusher, err := newMetricDataFlusher(flusher)
+	if err != nil {
+		return err
+	}
+	for idx := range blocks {
+		if err := rewriteMetricData(dataFlusher, blocks[idx].metricID, blocks[idx].block, mapping); err != nil {
+			return err
+		}
+	}
+	return dataFlusher.Close()
+}
+
 // ValidateSequence validates replica sequence if valid.
 func (f *dataFamily) ValidateSequence(leader int32, seq int64) bool {
 	f.mutex.Lock()
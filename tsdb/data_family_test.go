@@ -706,6 +706,352 @@ func TestDataFamily_WriteRows(t *testing.T) {
 	}
 }
 
+func TestDataFamily_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	flusher := kv.NewMockFlusher(ctrl)
+	family.EXPECT().NewFlusher().Return(flusher).AnyTimes()
+	flusher.EXPECT().Release().AnyTimes()
+	flusher.EXPECT().Import(uint64(1)).AnyTimes()
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	v := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	v.EXPECT().IsImported(uint64(1)).Return(false).AnyTimes()
+	v.EXPECT().IsImported(uint64(2)).Return(true).AnyTimes()
+	shard := NewMockShard(ctrl)
+	db := NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	db.EXPECT().Name().Return("db").AnyTimes()
+	shard.EXPECT().BufferManager().Return(memdb.NewMockBufferManager(ctrl)).AnyTimes()
+	memDB := memdb.NewMockMemoryDatabase(ctrl)
+	memDB.EXPECT().WithLock().Return(func() {}).AnyTimes()
+	memDB.EXPECT().CompleteWrite().AnyTimes()
+	memDB.EXPECT().AcquireWrite().AnyTimes()
+	memDB.EXPECT().MemSize().Return(int64(10)).AnyTimes()
+	memDB.EXPECT().Close().Return(fmt.Errorf("err")).AnyTimes()
+
+	newRows := func() []metric.StorageRow {
+		rows := mockBatchRows(&protoMetricsV1.Metric{
+			Name:      "test",
+			Timestamp: timeutil.Now(),
+			SimpleFields: []*protoMetricsV1.SimpleField{{
+				Name:  "f1",
+				Value: 1.0,
+				Type:  protoMetricsV1.SimpleFieldType_LAST,
+			}},
+		})
+		rows[0].Writable = true
+		return rows
+	}
+	cases := []struct {
+		name     string
+		importID uint64
+		rows     []metric.StorageRow
+		prepare  func()
+		written  int
+		wantErr  bool
+	}{
+		{
+			name: "no rows",
+		},
+		{
+			name:     "import already applied",
+			importID: 2,
+			rows:     newRows(),
+			written:  1,
+		},
+		{
+			name: "create memory database failure",
+			rows: newRows(),
+			prepare: func() {
+				newMemoryDBFunc = func(cfg memdb.MemoryDatabaseCfg) (memdb.MemoryDatabase, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "no data written",
+			rows: newRows(),
+			prepare: func() {
+				memDB.EXPECT().WriteRow(gomock.Any()).Return(fmt.Errorf("err"))
+				memDB.EXPECT().NumOfMetrics().Return(0)
+			},
+		},
+		{
+			name: "create data flusher failure",
+			rows: newRows(),
+			prepare: func() {
+				memDB.EXPECT().WriteRow(gomock.Any()).Return(nil)
+				memDB.EXPECT().NumOfMetrics().Return(1)
+				newMetricDataFlusher = func(kvFlusher kv.Flusher) (metricsdata.Flusher, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush metric data failure",
+			rows: newRows(),
+			prepare: func() {
+				memDB.EXPECT().WriteRow(gomock.Any()).Return(nil)
+				memDB.EXPECT().NumOfMetrics().Return(1)
+				memDB.EXPECT().FlushFamilyTo(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "import successfully",
+			rows: newRows(),
+			prepare: func() {
+				memDB.EXPECT().WriteRow(gomock.Any()).DoAndReturn(func(row *metric.StorageRow) error {
+					assert.True(t, row.Writable)
+					return nil
+				})
+				memDB.EXPECT().NumOfMetrics().Return(1)
+				memDB.EXPECT().FlushFamilyTo(gomock.Any()).Return(nil)
+			},
+			written: 1,
+		},
+		{
+			name: "skip not writable row",
+			rows: func() []metric.StorageRow {
+				rows := append(newRows(), newRows()...)
+				rows[1].Writable = false
+				return rows
+			}(),
+			prepare: func() {
+				memDB.EXPECT().WriteRow(gomock.Any()).Return(nil)
+				memDB.EXPECT().NumOfMetrics().Return(1)
+				memDB.EXPECT().FlushFamilyTo(gomock.Any()).Return(nil)
+			},
+			written: 1,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newMemoryDBFunc = memdb.NewMemoryDatabase
+				newMetricDataFlusher = metricsdata.NewFlusher
+			}()
+			newMemoryDBFunc = func(cfg memdb.MemoryDatabaseCfg) (memdb.MemoryDatabase, error) {
+				return memDB, nil
+			}
+			newMetricDataFlusher = func(kvFlusher kv.Flusher) (metricsdata.Flusher, error) {
+				return metricsdata.NewMockFlusher(ctrl), nil
+			}
+			f := &dataFamily{
+				shard:      shard,
+				family:     family,
+				interval:   timeutil.Interval(10 * timeutil.OneSecond),
+				statistics: metrics.NewFamilyStatistics("data", "1"),
+				logger:     logger.GetLogger("TSDB", "Test"),
+			}
+			f.intervalCalc = f.interval.Calculator()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			importID := tt.importID
+			if importID == 0 {
+				importID = 1
+			}
+			written, err := f.Import(importID, tt.rows)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.written, written)
+			// import doesn't use memory database of family
+			assert.Nil(t, f.mutableMemDB)
+		})
+	}
+}
+
+func TestDataFamily_Rewrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mapping := metricsdata.NewMockIDMapping(ctrl)
+	source := NewMockDataFamily(ctrl)
+	source.EXPECT().Indicator().Return("source").AnyTimes()
+	sourceFamily := kv.NewMockFamily(ctrl)
+	source.EXPECT().Family().Return(sourceFamily).AnyTimes()
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	sourceFamily.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	v := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	family := kv.NewMockFamily(ctrl)
+	flusher := kv.NewMockFlusher(ctrl)
+	family.EXPECT().NewFlusher().Return(flusher).AnyTimes()
+	flusher.EXPECT().Release().AnyTimes()
+	dataFlusher := metricsdata.NewMockFlusher(ctrl)
+	reader := table.NewMockReader(ctrl)
+	it := table.NewMockIterator(ctrl)
+	reader.EXPECT().Iterator().Return(it).AnyTimes()
+
+	mockFiles := func() {
+		v.EXPECT().GetRollupFiles().Return(map[table.FileNumber][]timeutil.Interval{10: {timeutil.Interval(timeutil.OneHour)}})
+		v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(10, 1, 10, 1024)})
+		snapshot.EXPECT().GetReader(table.FileNumber(10)).Return(reader, nil)
+	}
+	mockBlocks := func() {
+		gomock.InOrder(
+			it.EXPECT().HasNext().Return(true),
+			it.EXPECT().Key().Return(uint32(1)),
+			it.EXPECT().Value().Return([]byte{1}),
+			it.EXPECT().HasNext().Return(true),
+			it.EXPECT().Key().Return(uint32(2)),
+			it.EXPECT().Value().Return([]byte{2}),
+			it.EXPECT().HasNext().Return(true),
+			it.EXPECT().Key().Return(uint32(3)),
+			it.EXPECT().Value().Return([]byte{3}),
+			it.EXPECT().HasNext().Return(false),
+		)
+		it.EXPECT().Err().Return(nil)
+		mapping.EXPECT().GetMetricID(uint32(1)).Return(uint32(20), true)
+		mapping.EXPECT().GetMetricID(uint32(2)).Return(uint32(10), true)
+		mapping.EXPECT().GetMetricID(uint32(3)).Return(uint32(0), false)
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "get reader failure",
+			prepare: func() {
+				v.EXPECT().GetRollupFiles().Return(nil)
+				v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(10, 1, 10, 1024)})
+				snapshot.EXPECT().GetReader(table.FileNumber(10)).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "iterate data file failure",
+			prepare: func() {
+				mockFiles()
+				it.EXPECT().HasNext().Return(false)
+				it.EXPECT().Err().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "no metric can be mapped, no sequence",
+			prepare: func() {
+				mockFiles()
+				it.EXPECT().HasNext().Return(true)
+				it.EXPECT().Key().Return(uint32(1))
+				it.EXPECT().Value().Return([]byte{1})
+				it.EXPECT().HasNext().Return(false)
+				it.EXPECT().Err().Return(nil)
+				mapping.EXPECT().GetMetricID(uint32(1)).Return(uint32(0), false)
+				v.EXPECT().GetSequences().Return(nil)
+			},
+		},
+		{
+			name: "create data flusher failure",
+			prepare: func() {
+				mockFiles()
+				mockBlocks()
+				flusher.EXPECT().Rollup(timeutil.Interval(timeutil.OneHour))
+				newMetricDataFlusher = func(kvFlusher kv.Flusher) (metricsdata.Flusher, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "rewrite metric data failure",
+			prepare: func() {
+				mockFiles()
+				mockBlocks()
+				flusher.EXPECT().Rollup(timeutil.Interval(timeutil.OneHour))
+				rewriteMetricData = func(_ metricsdata.Flusher, _ uint32, _ []byte, _ metricsdata.IDMapping) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "commit sequence failure",
+			prepare: func() {
+				mockFiles()
+				mockBlocks()
+				flusher.EXPECT().Rollup(timeutil.Interval(timeutil.OneHour))
+				dataFlusher.EXPECT().Close().Return(nil)
+				v.EXPECT().GetSequences().Return(map[int32]int64{1: 100})
+				flusher.EXPECT().Sequence(int32(1), int64(100))
+				flusher.EXPECT().Commit().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "rewrite successfully",
+			prepare: func() {
+				mockFiles()
+				mockBlocks()
+				flusher.EXPECT().Rollup(timeutil.Interval(timeutil.OneHour))
+				var metricIDs []uint32
+				rewriteMetricData = func(_ metricsdata.Flusher, metricID uint32, _ []byte, _ metricsdata.IDMapping) error {
+					metricIDs = append(metricIDs, metricID)
+					return nil
+				}
+				dataFlusher.EXPECT().Close().DoAndReturn(func() error {
+					// sorted by target metric id
+					assert.Equal(t, []uint32{2, 1}, metricIDs)
+					return nil
+				})
+				v.EXPECT().GetSequences().Return(map[int32]int64{1: 100})
+				flusher.EXPECT().Sequence(int32(1), int64(100))
+				flusher.EXPECT().Commit().Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newMetricDataFlusher = metricsdata.NewFlusher
+				rewriteMetricData = metricsdata.Rewrite
+			}()
+			newMetricDataFlusher = func(kvFlusher kv.Flusher) (metricsdata.Flusher, error) {
+				return dataFlusher, nil
+			}
+			rewriteMetricData = func(_ metricsdata.Flusher, _ uint32, _ []byte, _ metricsdata.IDMapping) error {
+				return nil
+			}
+			f := &dataFamily{
+				family:     family,
+				seq:        make(map[int32]atomic.Int64),
+				persistSeq: make(map[int32]atomic.Int64),
+				statistics: metrics.NewFamilyStatistics("data", "1"),
+				logger:     logger.GetLogger("TSDB", "Test"),
+			}
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := f.Rewrite(source, mapping)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rewrite() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(f.seq) > 0 {
+				// inherit sequences of source family
+				assert.True(t, f.ValidateSequence(1, 101))
+				assert.False(t, f.ValidateSequence(1, 100))
+				seq := f.persistSeq[1]
+				assert.Equal(t, int64(100), seq.Load())
+			}
+		})
+	}
+}
+
 func TestDataFamily_GetState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -21,19 +21,20 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"runtime"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"go.uber.org/atomic"
 
-	"github.com/lindb/lindb/internal/concurrent"
-	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/tsdb/metadb"
 	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
 )
@@ -54,8 +55,8 @@ type Database interface {
 	CreateShards(shardIDs []models.ShardID) error
 	// GetShard returns shard by given shard id
 	GetShard(shardID models.ShardID) (Shard, bool)
-	// ExecutorPool returns the pool for querying tasks
-	ExecutorPool() *ExecutorPool
+	// ExecutorPool returns the pool for querying tasks of query class
+	ExecutorPool(class models.QueryClass) *ExecutorPool
 	// Closer closes database's underlying resource
 	io.Closer
 	// Metadata returns the metadata include metric/tag
@@ -72,6 +73,22 @@ type Database interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// Backup creates a point-in-time copy of database under backup dir, returns the manifest of backup.
+	// NOTICE: only includes flushed data, the data in memory database/write-ahead log isn't included.
+	Backup(dir string) (*models.BackupManifest, error)
+	// IsEmpty returns if there is no data family(includes the family only has memory data) in all shards.
+	IsEmpty() bool
+	// Snapshot creates a point-in-time copy of spec shard and metadata under snapshot dir(same layout with backup),
+	// it can be restored as database which only includes this shard.
+	Snapshot(shardID models.ShardID, dir string) (*models.BackupManifest, error)
+	// DropShard closes spec shard and removes all data of it.
+	DropShard(shardID models.ShardID) error
+	// Compact submits compaction/rollup job of shards by manual, the job runs in background.
+	Compact(param *models.CompactParam) error
+	// GetCompactionState returns the compaction state of all shards.
+	GetCompactionState() []models.FamilyCompactionState
+	// GetUsage returns the resource usage(number of series/disk bytes) of all shards.
+	GetUsage() (*models.DatabaseUsage, error)
 }
 
 // database implements Database for storing families,
@@ -79,14 +96,14 @@ type Database interface {
 type database struct {
 	name           string // database-name
 	dir            string
-	config         *models.DatabaseConfig // meta configuration
-	executorPool   *ExecutorPool          // executor pool for querying task
-	mutex          sync.Mutex             // mutex for creating families
-	shardSet       shardSet               // atomic value
-	metadata       metadb.Metadata        // underlying metric metadata
-	metaStore      kv.Store               // underlying meta kv store
-	isFlushing     atomic.Bool            // restrict flusher concurrency
-	flushCondition *sync.Cond             // flush condition
+	config         *models.DatabaseConfig              // meta configuration
+	executorPools  map[models.QueryClass]*ExecutorPool // executor pool of each query class for querying task
+	mutex          sync.Mutex                          // mutex for creating families
+	shardSet       shardSet                            // atomic value
+	metadata       metadb.Metadata                     // underlying metric metadata
+	metaStore      kv.Store                            // underlying meta kv store
+	isFlushing     atomic.Bool                         // restrict flusher concurrency
+	flushCondition *sync.Cond                          // flush condition
 
 	statistics *metrics.DatabaseStatistics
 
@@ -103,30 +120,11 @@ func newDatabase(
 		return nil, fmt.Errorf("database option is invalid, err: %s", err)
 	}
 	db := &database{
-		name:         databaseName,
-		flushChecker: flushChecker,
-		config:       cfg,
-		shardSet:     *newShardSet(),
-		executorPool: &ExecutorPool{
-			Filtering: concurrent.NewPool(
-				databaseName+"-filtering-pool",
-				runtime.GOMAXPROCS(-1), /*nRoutines*/
-				time.Second*5,
-				metrics.NewConcurrentStatistics(databaseName+"-filtering", linmetric.StorageRegistry),
-			),
-			Grouping: concurrent.NewPool(
-				databaseName+"-grouping-pool",
-				runtime.GOMAXPROCS(-1), /*nRoutines*/
-				time.Second*5,
-				metrics.NewConcurrentStatistics(databaseName+"-grouping", linmetric.StorageRegistry),
-			),
-			Scanner: concurrent.NewPool(
-				databaseName+"-scanner-pool",
-				runtime.GOMAXPROCS(-1), /*nRoutines*/
-				time.Second*5,
-				metrics.NewConcurrentStatistics(databaseName+"-scanner", linmetric.StorageRegistry),
-			),
-		},
+		name:           databaseName,
+		flushChecker:   flushChecker,
+		config:         cfg,
+		shardSet:       *newShardSet(),
+		executorPools:  newExecutorPools(databaseName),
 		isFlushing:     *atomic.NewBool(false),
 		flushCondition: sync.NewCond(&sync.Mutex{}),
 		statistics:     metrics.NewDatabaseStatistics(databaseName),
@@ -244,9 +242,12 @@ func (db *database) GetShard(shardID models.ShardID) (Shard, bool) {
 	return db.shardSet.GetShard(shardID)
 }
 
-// ExecutorPool returns the query task execute pool
-func (db *database) ExecutorPool() *ExecutorPool {
-	return db.executorPool
+// ExecutorPool returns the query task execute pool of query class, returns the pool of ad-hoc if class not exist.
+func (db *database) ExecutorPool(class models.QueryClass) *ExecutorPool {
+	if pool, ok := db.executorPools[class]; ok {
+		return pool
+	}
+	return db.executorPools[models.AdHocQuery]
 }
 
 // Close closes database's underlying resource
@@ -286,6 +287,187 @@ func (db *database) EvictSegment() {
 	}
 }
 
+// Backup creates a point-in-time copy of database under backup dir, returns the manifest of backup.
+// NOTICE: only includes flushed data, the data in memory database/write-ahead log isn't included,
+// flush database before backup if needed.
+// The copy is taken in order data => index => metadata, so that all ids referenced by data exist in backup.
+func (db *database) Backup(dir string) (*models.BackupManifest, error) {
+	return db.backup(dir, db.shardSet.Entries(), func(backupPath string) error {
+		return copyFile(optionsPath(db.name), filepath.Join(backupPath, options))
+	})
+}
+
+// Snapshot creates a point-in-time copy of spec shard and metadata under snapshot dir(same layout with backup),
+// it can be restored as database which only includes this shard.
+func (db *database) Snapshot(shardID models.ShardID, dir string) (*models.BackupManifest, error) {
+	shard, ok := db.GetShard(shardID)
+	if !ok {
+		return nil, fmt.Errorf("%w, database: %s, shard: %d", constants.ErrShardNotFound, db.name, shardID)
+	}
+	return db.backup(dir, shardEntries{{shardID: shardID, shard: shard}}, func(backupPath string) error {
+		// options only includes snapshot shard
+		return encodeToml(filepath.Join(backupPath, options), &models.DatabaseConfig{
+			Option:   db.GetOption(),
+			ShardIDs: []models.ShardID{shardID},
+		})
+	})
+}
+
+// backup copies options/shards/metadata of database under backup dir.
+func (db *database) backup(dir string, shards shardEntries, backupOptions func(backupPath string) error) (*models.BackupManifest, error) {
+	backupPath := filepath.Join(dir, db.name)
+	if fileExist(backupPath) {
+		return nil, fmt.Errorf("backup path[%s] of database[%s] already exist", backupPath, db.name)
+	}
+	// flush memory data first, backup includes the data written before backup.
+	if err := db.flushShards(shards); err != nil {
+		return nil, err
+	}
+	if err := mkDirIfNotExist(backupPath); err != nil {
+		return nil, err
+	}
+	if err := backupOptions(backupPath); err != nil {
+		return nil, err
+	}
+	var shardIDs []models.ShardID
+	for _, shardEntry := range shards {
+		if err := shardEntry.shard.Backup(dir); err != nil {
+			return nil, fmt.Errorf("backup shard[%d] of database[%s] error: %s", shardEntry.shardID, db.name, err)
+		}
+		shardIDs = append(shardIDs, shardEntry.shardID)
+	}
+	if err := db.metaStore.Backup(filepath.Join(dir, tagMetaIndicator(db.name))); err != nil {
+		return nil, err
+	}
+	if err := db.metadata.MetadataDatabase().Checkpoint(filepath.Join(backupPath, metaDir)); err != nil {
+		return nil, err
+	}
+	files, err := listBackupFiles(backupPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &models.BackupManifest{
+		Database:  db.name,
+		Timestamp: timeutil.Now(),
+		ShardIDs:  shardIDs,
+		Files:     files,
+	}
+	// write manifest finally, backup is completed if manifest exist.
+	if err := encodeToml(filepath.Join(backupPath, backupManifest), manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// flushShards flushes memory data/index of shards and metadata to disk synchronously,
+// data is flushed before index/metadata, make sure the ids referenced by data file are persisted.
+func (db *database) flushShards(shards shardEntries) error {
+	for _, shardEntry := range shards {
+		for _, family := range GetFamilyManager().GetFamiliesByShard(shardEntry.shard) {
+			// flush is skipped if another flush job is running, so waits it completed before/after flushing.
+			waitFamilyFlushCompleted(family)
+			if err := family.Flush(); err != nil {
+				return fmt.Errorf("flush family[%s] of database[%s] error: %s", family.Indicator(), db.name, err)
+			}
+			waitFamilyFlushCompleted(family)
+		}
+		shardEntry.shard.WaitFlushIndexCompleted()
+		if err := shardEntry.shard.FlushIndex(); err != nil {
+			return fmt.Errorf("flush index of shard[%d] of database[%s] error: %s", shardEntry.shardID, db.name, err)
+		}
+		shardEntry.shard.WaitFlushIndexCompleted()
+	}
+	db.WaitFlushMetaCompleted()
+	if err := db.FlushMeta(); err != nil {
+		return err
+	}
+	db.WaitFlushMetaCompleted()
+	return nil
+}
+
+// waitFamilyFlushCompleted waits the running flush job of family completed.
+func waitFamilyFlushCompleted(family DataFamily) {
+	for family.IsFlushing() {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Compact submits compaction/rollup job of shards by manual, the job runs in background.
+// Shards not in current node are ignored, metadata also is compacted when compacting all data of database.
+func (db *database) Compact(param *models.CompactParam) error {
+	rollup := false
+	switch param.Type {
+	case models.CompactTypeCompact:
+	case models.CompactTypeRollup:
+		rollup = true
+	default:
+		return fmt.Errorf("unknown compact type: %s", param.Type)
+	}
+	shardIDs := make(map[models.ShardID]struct{})
+	for _, shardID := range param.ShardIDs {
+		shardIDs[shardID] = struct{}{}
+	}
+	for _, shardEntry := range db.shardSet.Entries() {
+		if _, ok := shardIDs[shardEntry.shardID]; len(shardIDs) > 0 && !ok {
+			continue
+		}
+		if err := shardEntry.shard.Compact(param.FamilyTime, rollup); err != nil {
+			return fmt.Errorf("compact shard[%d] of database[%s] error: %s", shardEntry.shardID, db.name, err)
+		}
+	}
+	if !rollup && len(shardIDs) == 0 && param.FamilyTime == 0 {
+		for _, familyName := range db.metaStore.ListFamilyNames() {
+			if family := db.metaStore.GetFamily(familyName); family != nil {
+				family.ForceCompact()
+			}
+		}
+	}
+	return nil
+}
+
+// GetCompactionState returns the compaction state of all shards.
+func (db *database) GetCompactionState() []models.FamilyCompactionState {
+	rs := getCompactionState(db.metaStore)
+	for _, shardEntry := range db.shardSet.Entries() {
+		rs = append(rs, shardEntry.shard.GetCompactionState()...)
+	}
+	return rs
+}
+
+// GetUsage returns the resource usage(number of series/disk bytes) of all shards.
+func (db *database) GetUsage() (*models.DatabaseUsage, error) {
+	usage := &models.DatabaseUsage{Database: db.name}
+	for _, state := range getCompactionState(db.metaStore) {
+		usage.MetaBytes += state.Size
+	}
+	for _, shardEntry := range db.shardSet.Entries() {
+		shardUsage := models.ShardUsage{ShardID: shardEntry.shardID}
+		for _, state := range shardEntry.shard.GetCompactionState() {
+			shardUsage.DiskBytes += state.Size
+		}
+		numOfSeries, err := shardEntry.shard.IndexDatabase().NumOfSeries()
+		if err != nil {
+			return nil, fmt.Errorf("get number of series for shard[%d] error: %s", shardEntry.shardID, err)
+		}
+		shardUsage.NumOfSeries = numOfSeries
+		usage.Shards = append(usage.Shards, shardUsage)
+	}
+	return usage, nil
+}
+
+// IsEmpty returns if there is no data family(includes the family only has memory data) in all shards.
+func (db *database) IsEmpty() bool {
+	ahead, _ := db.GetOption().GetAcceptWritableRange()
+	timeRange := timeutil.TimeRange{Start: 0, End: timeutil.Now() + ahead}
+	for _, shardEntry := range db.shardSet.Entries() {
+		shard := shardEntry.shard
+		if len(shard.GetDataFamilies(shard.CurrentInterval().Type(), timeRange)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // dumpDatabaseConfig persists option info to OPTIONS file
 func (db *database) dumpDatabaseConfig(newConfig *models.DatabaseConfig) error {
 	cfgPath := optionsPath(db.name)
@@ -308,7 +490,8 @@ func (db *database) initMetadata() error {
 		tagValueDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagkeymeta.MergerName)})
+			Merger:           string(tagkeymeta.MergerName),
+			Compression:      config.GlobalStorageConfig().TSDB.Compression})
 	if err != nil {
 		return err
 	}
@@ -369,6 +552,31 @@ func (db *database) Flush() error {
 	return nil
 }
 
+// DropShard closes spec shard and removes all data of it, ignore it if shard not exist.
+func (db *database) DropShard(shardID models.ShardID) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	shard, ok := db.GetShard(shardID)
+	if !ok {
+		return nil
+	}
+	newCfg := &models.DatabaseConfig{Option: db.config.Option}
+	for _, id := range db.config.ShardIDs {
+		if id != shardID {
+			newCfg.ShardIDs = append(newCfg.ShardIDs, id)
+		}
+	}
+	if err := db.dumpDatabaseConfig(newCfg); err != nil {
+		return err
+	}
+	db.shardSet.DeleteShard(shardID)
+	if err := shard.Close(); err != nil {
+		return err
+	}
+	return removeDir(shardPath(db.name, shardID))
+}
+
 // Drop drops current database include all data.
 func (db *database) Drop() error {
 	if err := db.Close(); err != nil {
@@ -20,6 +20,7 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
 
@@ -192,7 +194,10 @@ func TestDatabase_New(t *testing.T) {
 			if db != nil {
 				// assert database information after create successfully
 				assert.NotNil(t, db.Metadata())
-				assert.NotNil(t, db.ExecutorPool())
+				assert.NotNil(t, db.ExecutorPool(models.AdHocQuery))
+				assert.NotEqual(t, db.ExecutorPool(models.AdHocQuery), db.ExecutorPool(models.AlertingQuery))
+				// unknown class uses ad-hoc pool
+				assert.Equal(t, db.ExecutorPool(models.AdHocQuery), db.ExecutorPool(""))
 				assert.Equal(t, "db", db.Name())
 				assert.True(t, db.NumOfShards() >= 0)
 				assert.Equal(t, &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10}}}, db.GetOption())
@@ -462,6 +467,23 @@ func Test_ShardSet_multi(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func Test_ShardSet_DeleteShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	set := newShardSet()
+	shard1 := NewMockShard(ctrl)
+	set.InsertShard(models.ShardID(1), shard1)
+	set.InsertShard(models.ShardID(2), shard1)
+	set.DeleteShard(models.ShardID(1))
+	set.DeleteShard(models.ShardID(10))
+	assert.Equal(t, 1, set.GetShardNum())
+	_, ok := set.GetShard(1)
+	assert.False(t, ok)
+	_, ok = set.GetShard(2)
+	assert.True(t, ok)
+}
+
 func TestDatabase_WaitFlushMetaCompleted(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -535,6 +557,100 @@ func TestDatabase_Drop(t *testing.T) {
 	assert.NoError(t, db.Drop())
 }
 
+func TestDatabase_DropShard(t *testing.T) {
+	writeConfigTestLock.Lock()
+	defer writeConfigTestLock.Unlock()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		removeDir = fileutil.RemoveDir
+		encodeToml = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+	tmpDir := t.TempDir()
+	withTestPath(filepath.Join(tmpDir, "data"))
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(tmpDir, "data", "db")))
+
+	shard := NewMockShard(ctrl)
+	db := &database{
+		name:     "db",
+		config:   &models.DatabaseConfig{ShardIDs: []models.ShardID{1, 2}},
+		shardSet: *newShardSet(),
+	}
+	db.shardSet.InsertShard(1, shard)
+	db.shardSet.InsertShard(2, shard)
+	// shard not exist
+	assert.NoError(t, db.DropShard(3))
+	// dump config failure
+	encodeToml = func(_ string, _ interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, db.DropShard(1))
+	encodeToml = ltoml.EncodeToml
+	// close shard failure
+	shard.EXPECT().Close().Return(fmt.Errorf("err"))
+	assert.Error(t, db.DropShard(1))
+	assert.Equal(t, []models.ShardID{2}, db.config.ShardIDs)
+	_, ok := db.GetShard(1)
+	assert.False(t, ok)
+	// remove shard dir failure
+	shard.EXPECT().Close().Return(nil)
+	removeDir = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, db.DropShard(2))
+	// drop successfully
+	removeDir = fileutil.RemoveDir
+	db.shardSet.InsertShard(2, shard)
+	shard.EXPECT().Close().Return(nil)
+	assert.NoError(t, db.DropShard(2))
+	assert.Zero(t, db.NumOfShards())
+}
+
+func TestDatabase_Snapshot(t *testing.T) {
+	writeConfigTestLock.Lock()
+	defer writeConfigTestLock.Unlock()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tmpDir := t.TempDir()
+	withTestPath(filepath.Join(tmpDir, "data"))
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	store := kv.NewMockStore(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Indicator().Return("db/2").AnyTimes()
+	shard.EXPECT().WaitFlushIndexCompleted().AnyTimes()
+	metadata.EXPECT().Flush().Return(nil).AnyTimes()
+	db := &database{
+		name:           "db",
+		config:         &models.DatabaseConfig{ShardIDs: []models.ShardID{1, 2}, Option: &option.DatabaseOption{}},
+		metadata:       metadata,
+		shardSet:       *newShardSet(),
+		metaStore:      store,
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		statistics:     metrics.NewDatabaseStatistics("db"),
+	}
+	db.shardSet.InsertShard(models.ShardID(1), shard)
+	db.shardSet.InsertShard(models.ShardID(2), shard)
+	snapshotDir := filepath.Join(tmpDir, "snapshot")
+	// shard not exist
+	manifest, err := db.Snapshot(3, snapshotDir)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// snapshot successfully, only includes spec shard, flushes memory data of spec shard before snapshot
+	shard.EXPECT().FlushIndex().Return(nil)
+	shard.EXPECT().Backup(snapshotDir).Return(nil)
+	store.EXPECT().Backup(gomock.Any()).Return(nil)
+	metadataDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+	manifest, err = db.Snapshot(2, snapshotDir)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ShardID{2}, manifest.ShardIDs)
+	cfg := &models.DatabaseConfig{}
+	assert.NoError(t, ltoml.DecodeToml(filepath.Join(snapshotDir, "db", options), cfg))
+	assert.Equal(t, []models.ShardID{2}, cfg.ShardIDs)
+}
+
 func TestDatabase_TTL(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -659,3 +775,297 @@ func Benchmark_ShardSet_binarySearch(b *testing.B) {
 		}
 	})
 }
+
+func TestDatabase_Backup(t *testing.T) {
+	writeConfigTestLock.Lock()
+	defer writeConfigTestLock.Unlock()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		copyFile = fileutil.CopyFile
+		encodeToml = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+	tmpDir := t.TempDir()
+	withTestPath(filepath.Join(tmpDir, "data"))
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(tmpDir, "data", "db")))
+	assert.NoError(t, encodeToml(optionsPath("db"), &models.DatabaseConfig{}))
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	store := kv.NewMockStore(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Indicator().Return("db/1").AnyTimes()
+	shard.EXPECT().WaitFlushIndexCompleted().AnyTimes()
+	db := &database{
+		name:           "db",
+		metadata:       metadata,
+		shardSet:       *newShardSet(),
+		metaStore:      store,
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		statistics:     metrics.NewDatabaseStatistics("db"),
+	}
+	db.shardSet.InsertShard(models.ShardID(1), shard)
+	backupDir := filepath.Join(tmpDir, "backup")
+	family := NewMockDataFamily(ctrl)
+	family.EXPECT().Indicator().Return("db/1/20221010").AnyTimes()
+	family.EXPECT().Shard().Return(shard).AnyTimes()
+	family.EXPECT().IsFlushing().Return(false).AnyTimes()
+	mockFlush := func() {
+		family.EXPECT().Flush().Return(nil)
+		shard.EXPECT().FlushIndex().Return(nil)
+		metadata.EXPECT().Flush().Return(nil)
+	}
+
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "flush family failure",
+			prepare: func() {
+				family.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush index failure",
+			prepare: func() {
+				family.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush metadata failure",
+			prepare: func() {
+				family.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(nil)
+				metadata.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy options failure",
+			prepare: func() {
+				mockFlush()
+				copyFile = func(_, _ string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup shard failure",
+			prepare: func() {
+				mockFlush()
+				shard.EXPECT().Backup(backupDir).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup meta store failure",
+			prepare: func() {
+				mockFlush()
+				shard.EXPECT().Backup(backupDir).Return(nil)
+				store.EXPECT().Backup(filepath.Join(backupDir, tagMetaIndicator("db"))).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint metadata failure",
+			prepare: func() {
+				mockFlush()
+				shard.EXPECT().Backup(backupDir).Return(nil)
+				store.EXPECT().Backup(gomock.Any()).Return(nil)
+				metadataDB.EXPECT().Checkpoint(filepath.Join(backupDir, "db", metaDir)).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "write manifest failure",
+			prepare: func() {
+				mockFlush()
+				shard.EXPECT().Backup(backupDir).Return(nil)
+				store.EXPECT().Backup(gomock.Any()).Return(nil)
+				metadataDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				encodeToml = func(_ string, _ interface{}) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup successfully",
+			prepare: func() {
+				mockFlush()
+				shard.EXPECT().Backup(backupDir).Return(nil)
+				store.EXPECT().Backup(gomock.Any()).Return(nil)
+				metadataDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				copyFile = fileutil.CopyFile
+				encodeToml = ltoml.EncodeToml
+				_ = fileutil.RemoveDir(backupDir)
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			GetFamilyManager().AddFamily(family)
+			manifest, err := db.Backup(backupDir)
+			GetFamilyManager().RemoveFamily(family)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Backup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assert.Equal(t, []models.ShardID{1}, manifest.ShardIDs)
+				assert.Equal(t, []string{options}, manifest.Files)
+				assert.True(t, fileutil.Exist(filepath.Join(backupDir, "db", backupManifest)))
+				// backup path exist
+				_, err = db.Backup(backupDir)
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDatabase_Compact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	shard1 := NewMockShard(ctrl)
+	shard2 := NewMockShard(ctrl)
+	db := &database{
+		name:      "db",
+		shardSet:  *newShardSet(),
+		metaStore: store,
+	}
+	db.shardSet.InsertShard(models.ShardID(1), shard1)
+	db.shardSet.InsertShard(models.ShardID(2), shard2)
+
+	// case 1: unknown compact type
+	assert.Error(t, db.Compact(&models.CompactParam{Type: "unknown"}))
+	// case 2: compact shard failure
+	shard1.EXPECT().Compact(int64(0), false).Return(fmt.Errorf("err"))
+	assert.Error(t, db.Compact(&models.CompactParam{Type: models.CompactTypeCompact, ShardIDs: []models.ShardID{1}}))
+	// case 3: rollup target shard
+	shard2.EXPECT().Compact(int64(10), true).Return(nil)
+	assert.NoError(t, db.Compact(&models.CompactParam{
+		Type:       models.CompactTypeRollup,
+		ShardIDs:   []models.ShardID{2, 3},
+		FamilyTime: 10,
+	}))
+	// case 4: compact all data and metadata
+	shard1.EXPECT().Compact(int64(0), false).Return(nil)
+	shard2.EXPECT().Compact(int64(0), false).Return(nil)
+	store.EXPECT().ListFamilyNames().Return([]string{"tagvalue", "unknown"})
+	store.EXPECT().GetFamily("tagvalue").Return(family)
+	store.EXPECT().GetFamily("unknown").Return(nil)
+	family.EXPECT().ForceCompact()
+	assert.NoError(t, db.Compact(&models.CompactParam{Type: models.CompactTypeCompact}))
+}
+
+func TestDatabase_GetCompactionState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	shard := NewMockShard(ctrl)
+	db := &database{
+		name:      "db",
+		shardSet:  *newShardSet(),
+		metaStore: store,
+	}
+	db.shardSet.InsertShard(models.ShardID(1), shard)
+	store.EXPECT().ListFamilyNames().Return(nil)
+	shard.EXPECT().GetCompactionState().Return([]models.FamilyCompactionState{{Family: "10"}})
+	assert.Equal(t, []models.FamilyCompactionState{{Family: "10"}}, db.GetCompactionState())
+}
+
+func TestDatabase_IsEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := NewMockShard(ctrl)
+	db := &database{
+		name:     "db",
+		shardSet: *newShardSet(),
+		config:   &models.DatabaseConfig{Option: &option.DatabaseOption{}},
+	}
+	assert.True(t, db.IsEmpty())
+	db.shardSet.InsertShard(models.ShardID(1), shard)
+	shard.EXPECT().CurrentInterval().Return(timeutil.Interval(10 * timeutil.OneSecond)).Times(2)
+	shard.EXPECT().GetDataFamilies(timeutil.Day, gomock.Any()).Return(nil)
+	assert.True(t, db.IsEmpty())
+	shard.EXPECT().GetDataFamilies(timeutil.Day, gomock.Any()).Return([]DataFamily{NewMockDataFamily(ctrl)})
+	assert.False(t, db.IsEmpty())
+}
+
+func TestDatabase_GetUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	shard := NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	store.EXPECT().Name().Return("meta").AnyTimes()
+	store.EXPECT().ListFamilyNames().Return([]string{"tag"}).AnyTimes()
+	store.EXPECT().GetFamily("tag").Return(family).AnyTimes()
+	family.EXPECT().GetState().Return(kv.FamilyState{Name: "tag", Size: 10}).AnyTimes()
+	db := &database{
+		name:      "db",
+		shardSet:  *newShardSet(),
+		metaStore: store,
+	}
+	db.shardSet.InsertShard(models.ShardID(1), shard)
+
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "get number of series failure",
+			prepare: func() {
+				shard.EXPECT().GetCompactionState().Return(nil)
+				indexDB.EXPECT().NumOfSeries().Return(int64(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get usage successfully",
+			prepare: func() {
+				shard.EXPECT().GetCompactionState().Return([]models.FamilyCompactionState{{Size: 100}, {Size: 20}})
+				indexDB.EXPECT().NumOfSeries().Return(int64(4), nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(_ *testing.T) {
+			tt.prepare()
+			usage, err := db.GetUsage()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &models.DatabaseUsage{
+				Database:  "db",
+				MetaBytes: 10,
+				Shards:    []models.ShardUsage{{ShardID: 1, NumOfSeries: 4, DiskBytes: 120}},
+			}, usage)
+		})
+	}
+}
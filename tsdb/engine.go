@@ -20,9 +20,11 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
@@ -61,6 +63,19 @@ type Engine interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// BackupDatabase creates a point-in-time copy of database under backup dir.
+	BackupDatabase(databaseName, dir string) (*models.BackupManifest, error)
+	// RestoreDatabase rebuilds database from the backup under backup dir,
+	// merges the backup into database if database exists without data.
+	RestoreDatabase(databaseName, dir string) error
+	// SnapshotShard creates a point-in-time copy of spec shard under snapshot dir,
+	// it can be restored by RestoreDatabase on other node.
+	SnapshotShard(databaseName string, shardID models.ShardID, dir string) (*models.BackupManifest, error)
+	// InstallShardSnapshot installs the snapshot of spec shard(created by SnapshotShard on other node) under snapshot dir,
+	// restores it as database if database not exist, else merges it into the existing database.
+	InstallShardSnapshot(databaseName string, shardID models.ShardID, dir string) error
+	// DropShards drops spec shards of database, ignore it if database/shard not exist.
+	DropShards(databaseName string, shardIDs ...models.ShardID) error
 	// Close closes the cached time series databases
 	Close()
 }
@@ -100,6 +115,16 @@ func NewEngine() (Engine, error) {
 // createDatabase creates database instance by database's name
 // return success when creating database's path successfully
 func (e *engine) createDatabase(databaseName string, dbOption *option.DatabaseOption) (Database, error) {
+	db, err := e.openDatabase(databaseName, dbOption)
+	if err != nil {
+		return nil, err
+	}
+	e.dbSet.PutDatabase(databaseName, db)
+	return db, nil
+}
+
+// openDatabase opens database instance by database's name, loads database option from local file if exist.
+func (e *engine) openDatabase(databaseName string, dbOption *option.DatabaseOption) (Database, error) {
 	cfgPath := optionsPath(databaseName)
 	cfg := &models.DatabaseConfig{Option: dbOption}
 	engineLogger.Info("load database option from local storage", logger.String("path", cfgPath))
@@ -109,12 +134,7 @@ func (e *engine) createDatabase(databaseName string, dbOption *option.DatabaseOp
 				databaseName, cfgPath, err)
 		}
 	}
-	db, err := newDatabaseFunc(databaseName, cfg, e.dataFlushChecker)
-	if err != nil {
-		return nil, err
-	}
-	e.dbSet.PutDatabase(databaseName, db)
-	return db, nil
+	return newDatabaseFunc(databaseName, cfg, e.dataFlushChecker)
 }
 
 func (e *engine) CreateShards(
@@ -226,6 +246,226 @@ func (e *engine) EvictSegment() {
 	}
 }
 
+// BackupDatabase creates a point-in-time copy of database under backup dir.
+func (e *engine) BackupDatabase(databaseName, dir string) (*models.BackupManifest, error) {
+	db, ok := e.GetDatabase(databaseName)
+	if !ok {
+		return nil, fmt.Errorf("%w, database: %s", constants.ErrDatabaseNotFound, databaseName)
+	}
+	manifest, err := db.Backup(dir)
+	if err != nil {
+		return nil, err
+	}
+	engineLogger.Info("backup database successfully",
+		logger.String("database", databaseName), logger.String("dir", dir),
+		logger.Int("files", len(manifest.Files)))
+	return manifest, nil
+}
+
+// SnapshotShard creates a point-in-time copy of spec shard under snapshot dir,
+// it can be restored by RestoreDatabase on other node.
+func (e *engine) SnapshotShard(databaseName string, shardID models.ShardID, dir string) (*models.BackupManifest, error) {
+	db, ok := e.GetDatabase(databaseName)
+	if !ok {
+		return nil, fmt.Errorf("%w, database: %s", constants.ErrDatabaseNotFound, databaseName)
+	}
+	manifest, err := db.Snapshot(shardID, dir)
+	if err != nil {
+		return nil, err
+	}
+	engineLogger.Info("snapshot shard successfully",
+		logger.String("database", databaseName), logger.Any("shard", shardID),
+		logger.String("dir", dir), logger.Int("files", len(manifest.Files)))
+	return manifest, nil
+}
+
+// DropShards drops spec shards of database, ignore it if database/shard not exist.
+func (e *engine) DropShards(databaseName string, shardIDs ...models.ShardID) error {
+	db, ok := e.GetDatabase(databaseName)
+	if !ok {
+		return nil
+	}
+	for _, shardID := range shardIDs {
+		if err := db.DropShard(shardID); err != nil {
+			return err
+		}
+		engineLogger.Info("drop shard successfully",
+			logger.String("database", databaseName), logger.Any("shard", shardID))
+	}
+	return nil
+}
+
+// RestoreDatabase rebuilds database from the backup under backup dir,
+// merges the backup into database if database exists without data.
+func (e *engine) RestoreDatabase(databaseName, dir string) (err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if db, ok := e.GetDatabase(databaseName); ok {
+		// database created by cluster before restoring(live cluster)
+		return e.restoreIntoDatabase(db, dir)
+	}
+	manifest, err := restoreDatabaseFiles(databaseName, databaseName, dir)
+	if err != nil {
+		return err
+	}
+	// load database option/shards from restored OPTIONS file
+	if _, err = e.createDatabase(databaseName, &option.DatabaseOption{}); err != nil {
+		removeDatabasePath(databaseName)
+		return err
+	}
+	engineLogger.Info("restore database successfully",
+		logger.String("database", databaseName), logger.String("dir", dir),
+		logger.Int("files", len(manifest.Files)))
+	return nil
+}
+
+// restoreIntoDatabase restores the backup under backup dir into the existing database which has no data,
+// the backup is restored as a temp database, then the shards of it are merged into the database,
+// so that the shards of database referenced by write-ahead log replicas keep available.
+func (e *engine) restoreIntoDatabase(db Database, dir string) (err error) {
+	databaseName := db.Name()
+	if !db.IsEmpty() {
+		return fmt.Errorf("database[%s] already has data, cannot restore it", databaseName)
+	}
+	tempName := restoreDatabaseName(databaseName)
+	// cleanup the temp database of previous restoring
+	removeDatabasePath(tempName)
+	manifest, err := restoreDatabaseFiles(databaseName, tempName, dir)
+	if err != nil {
+		return err
+	}
+	// load database option/shards from restored OPTIONS file
+	source, err := e.openDatabase(tempName, &option.DatabaseOption{})
+	if err != nil {
+		removeDatabasePath(tempName)
+		return err
+	}
+	defer func() {
+		if err0 := source.Drop(); err0 != nil {
+			engineLogger.Warn("drop temp database failure after restore database",
+				logger.String("database", tempName), logger.Error(err0))
+		}
+	}()
+	for _, shardID := range manifest.ShardIDs {
+		if err = mergeShard(databaseName, db, source, shardID); err != nil {
+			return err
+		}
+	}
+	engineLogger.Info("restore database into existing database successfully",
+		logger.String("database", databaseName), logger.String("dir", dir),
+		logger.Int("files", len(manifest.Files)))
+	return nil
+}
+
+// InstallShardSnapshot installs the snapshot of spec shard(created by SnapshotShard on other node) under snapshot dir,
+// restores it as database if database not exist, else merges it into the existing database:
+// 1. restores the snapshot as a temp database which isn't visible;
+// 2. creates the shard in existing database, then merges the temp shard into it,
+// because the ids of metric/field/series are node local, the data of snapshot cannot be used directly;
+// 3. drops the temp database finally.
+// Installing is skipped if the shard already exist in database.
+func (e *engine) InstallShardSnapshot(databaseName string, shardID models.ShardID, dir string) (err error) {
+	db, ok := e.GetDatabase(databaseName)
+	if !ok {
+		return e.RestoreDatabase(databaseName, dir)
+	}
+	if _, ok = db.GetShard(shardID); ok {
+		engineLogger.Info("shard already exist, skip install snapshot",
+			logger.String("database", databaseName), logger.Any("shard", shardID))
+		return nil
+	}
+	tempName := snapshotDatabaseName(databaseName, shardID)
+	// cleanup the temp database of previous installing
+	removeDatabasePath(tempName)
+	if _, err = restoreDatabaseFiles(databaseName, tempName, dir); err != nil {
+		return err
+	}
+	// load database option/shards from restored OPTIONS file
+	source, err := e.openDatabase(tempName, &option.DatabaseOption{})
+	if err != nil {
+		removeDatabasePath(tempName)
+		return err
+	}
+	defer func() {
+		if err0 := source.Drop(); err0 != nil {
+			engineLogger.Warn("drop temp database failure after install shard snapshot",
+				logger.String("database", tempName), logger.Error(err0))
+		}
+	}()
+	if err = mergeShard(databaseName, db, source, shardID); err != nil {
+		return err
+	}
+	engineLogger.Info("install shard snapshot successfully",
+		logger.String("database", databaseName), logger.Any("shard", shardID), logger.String("dir", dir))
+	return nil
+}
+
+// mergeShard creates spec shard in target database if not exist, then merges the shard of source database into it,
+// drops the partial merged shard if merge failure and it's created by merging.
+func mergeShard(databaseName string, target, source Database, shardID models.ShardID) error {
+	sourceShard, ok := source.GetShard(shardID)
+	if !ok {
+		return fmt.Errorf("%w, snapshot of database: %s, shard: %d", constants.ErrShardNotFound, databaseName, shardID)
+	}
+	_, exist := target.GetShard(shardID)
+	if err := target.CreateShards([]models.ShardID{shardID}); err != nil {
+		return err
+	}
+	targetShard, ok := target.GetShard(shardID)
+	if !ok {
+		return fmt.Errorf("%w, database: %s, shard: %d", constants.ErrShardNotFound, databaseName, shardID)
+	}
+	if err := targetShard.Merge(sourceShard); err != nil {
+		if exist {
+			return err
+		}
+		// drop partial merged shard, so that it can be merged again
+		if err0 := target.DropShard(shardID); err0 != nil {
+			engineLogger.Warn("drop shard failure when merge shard failure",
+				logger.String("database", databaseName), logger.Any("shard", shardID), logger.Error(err0))
+		}
+		return err
+	}
+	return nil
+}
+
+// restoreDatabaseFiles restores the files of database backup under backup dir into the path of target database.
+func restoreDatabaseFiles(databaseName, targetName, dir string) (manifest *models.BackupManifest, err error) {
+	backupPath := filepath.Join(dir, databaseName)
+	manifest = &models.BackupManifest{}
+	if err = decodeToml(filepath.Join(backupPath, backupManifest), manifest); err != nil {
+		return nil, fmt.Errorf("load backup manifest of database[%s] error: %s", databaseName, err)
+	}
+	if manifest.Database != databaseName {
+		return nil, fmt.Errorf("backup database[%s] not match database[%s]", manifest.Database, databaseName)
+	}
+	dbPath := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, targetName)
+	if fileExist(dbPath) {
+		return nil, fmt.Errorf("database path[%s] already exist, cannot restore it", dbPath)
+	}
+	for _, file := range manifest.Files {
+		if err = restoreBackupFile(filepath.Join(backupPath, file), filepath.Join(dbPath, file)); err != nil {
+			// cleanup partial restored files
+			removeDatabasePath(targetName)
+			return nil, fmt.Errorf("restore file[%s] of database[%s] error: %s", file, databaseName, err)
+		}
+	}
+	return manifest, nil
+}
+
+// removeDatabasePath removes the path of database, ignore it if not exist.
+func removeDatabasePath(databaseName string) {
+	dbPath := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, databaseName)
+	if !fileExist(dbPath) {
+		return
+	}
+	if err := removeDir(dbPath); err != nil {
+		engineLogger.Warn("remove database path failure",
+			logger.String("path", dbPath), logger.Error(err))
+	}
+}
+
 // load the time series engines if exist
 func (e *engine) load() error {
 	databaseNames, err := listDir(config.GlobalStorageConfig().TSDB.Dir)
@@ -235,6 +475,11 @@ func (e *engine) load() error {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 	for _, databaseName := range databaseNames {
+		if isSnapshotDatabase(databaseName) {
+			// temp database of installing shard snapshot which isn't completed
+			removeDatabasePath(databaseName)
+			continue
+		}
 		_, err := e.createDatabase(databaseName, &option.DatabaseOption{}) // need load config from local file
 		if err != nil {
 			return err
@@ -20,6 +20,7 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"os"
 	"path"
 	"sync"
 	"testing"
@@ -77,6 +78,18 @@ func TestEngine_New(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "skip temp database of installing snapshot",
+			prepare: func() {
+				listDir = func(path string) (strings []string, e error) {
+					return []string{snapshotDatabaseName("db", 1)}, nil
+				}
+				newDatabaseFunc = func(databaseName string, cfg *models.DatabaseConfig,
+					flushChecker DataFlushChecker) (Database, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+		},
 		{
 			name: "create engine err because load database err",
 			prepare: func() {
@@ -453,3 +466,347 @@ func BenchmarkEngine_DatabaseWithLockFreeSlice(b *testing.B) {
 		}
 	})
 }
+
+func TestEngine_BackupDatabase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e := &engine{dbSet: *newDatabaseSet()}
+	// case 1: database not exist
+	manifest, err := e.BackupDatabase("db", "backup")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	db := NewMockDatabase(ctrl)
+	e.dbSet.PutDatabase("db", db)
+	// case 2: backup failure
+	db.EXPECT().Backup("backup").Return(nil, fmt.Errorf("err"))
+	manifest, err = e.BackupDatabase("db", "backup")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 3: backup successfully
+	db.EXPECT().Backup("backup").Return(&models.BackupManifest{Database: "db"}, nil)
+	manifest, err = e.BackupDatabase("db", "backup")
+	assert.NoError(t, err)
+	assert.Equal(t, "db", manifest.Database)
+}
+
+func TestEngine_SnapshotShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e := &engine{dbSet: *newDatabaseSet()}
+	// case 1: database not exist
+	manifest, err := e.SnapshotShard("db", 1, "snapshot")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	db := NewMockDatabase(ctrl)
+	e.dbSet.PutDatabase("db", db)
+	// case 2: snapshot failure
+	db.EXPECT().Snapshot(models.ShardID(1), "snapshot").Return(nil, fmt.Errorf("err"))
+	manifest, err = e.SnapshotShard("db", 1, "snapshot")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 3: snapshot successfully
+	db.EXPECT().Snapshot(models.ShardID(1), "snapshot").Return(&models.BackupManifest{Database: "db"}, nil)
+	manifest, err = e.SnapshotShard("db", 1, "snapshot")
+	assert.NoError(t, err)
+	assert.Equal(t, "db", manifest.Database)
+}
+
+func TestEngine_DropShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e := &engine{dbSet: *newDatabaseSet()}
+	// case 1: database not exist
+	assert.NoError(t, e.DropShards("db", 1))
+	db := NewMockDatabase(ctrl)
+	e.dbSet.PutDatabase("db", db)
+	// case 2: drop failure
+	db.EXPECT().DropShard(models.ShardID(1)).Return(fmt.Errorf("err"))
+	assert.Error(t, e.DropShards("db", 1, 2))
+	// case 3: drop successfully
+	db.EXPECT().DropShard(gomock.Any()).Return(nil).Times(2)
+	assert.NoError(t, e.DropShards("db", 1, 2))
+}
+
+func TestEngine_RestoreDatabase(t *testing.T) {
+	writeConfigTestLock.Lock()
+	defer writeConfigTestLock.Unlock()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		copyFile = fileutil.CopyFile
+		newDatabaseFunc = newDatabase
+		ctrl.Finish()
+	}()
+	tmpDir := t.TempDir()
+	withTestPath(path.Join(tmpDir, "data"))
+	backupDir := path.Join(tmpDir, "backup")
+	// prepare backup files
+	assert.NoError(t, fileutil.MkDirIfNotExist(path.Join(backupDir, "db", "shard", "1")))
+	assert.NoError(t, encodeToml(path.Join(backupDir, "db", options),
+		&models.DatabaseConfig{ShardIDs: []models.ShardID{1}, Option: &option.DatabaseOption{}}))
+	assert.NoError(t, os.WriteFile(path.Join(backupDir, "db", "shard", "1", "000001.sst"), []byte("sst"), 0600))
+	files := []string{options, path.Join("shard", "1", "000001.sst")}
+	assert.NoError(t, encodeToml(path.Join(backupDir, "db", backupManifest),
+		&models.BackupManifest{Database: "db", Files: files}))
+	assert.NoError(t, encodeToml(path.Join(backupDir, "db", "db2"+backupManifest),
+		&models.BackupManifest{Database: "db"}))
+
+	e := &engine{dbSet: *newDatabaseSet()}
+	db := NewMockDatabase(ctrl)
+	// case 1: database exist with data
+	e.dbSet.PutDatabase("exist", db)
+	db.EXPECT().Name().Return("exist")
+	db.EXPECT().IsEmpty().Return(false)
+	assert.Error(t, e.RestoreDatabase("exist", backupDir))
+	// case 2: backup manifest not exist
+	assert.Error(t, e.RestoreDatabase("not_exist", backupDir))
+	// case 3: database not match
+	assert.NoError(t, fileutil.MkDirIfNotExist(path.Join(backupDir, "db2")))
+	assert.NoError(t, os.Rename(path.Join(backupDir, "db", "db2"+backupManifest),
+		path.Join(backupDir, "db2", backupManifest)))
+	assert.Error(t, e.RestoreDatabase("db2", backupDir))
+	// case 4: restore file failure, cleanup restored files
+	copyFile = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, e.RestoreDatabase("db", backupDir))
+	assert.False(t, fileutil.Exist(path.Join(tmpDir, "data", "db")))
+	copyFile = fileutil.CopyFile
+	// case 5: open database failure
+	newDatabaseFunc = func(_ string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, e.RestoreDatabase("db", backupDir))
+	assert.False(t, fileutil.Exist(path.Join(tmpDir, "data", "db")))
+	// case 6: restore successfully
+	newDatabaseFunc = func(_ string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+		return db, nil
+	}
+	assert.NoError(t, e.RestoreDatabase("db", backupDir))
+	assert.True(t, fileutil.Exist(path.Join(tmpDir, "data", "db", "shard", "1", "000001.sst")))
+	db0, ok := e.GetDatabase("db")
+	assert.True(t, ok)
+	assert.Equal(t, db, db0)
+	// case 7: database path exist
+	assert.Error(t, (&engine{dbSet: *newDatabaseSet()}).RestoreDatabase("db", backupDir))
+}
+
+func TestEngine_RestoreDatabase_Exist(t *testing.T) {
+	writeConfigTestLock.Lock()
+	defer writeConfigTestLock.Unlock()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newDatabaseFunc = newDatabase
+		ctrl.Finish()
+	}()
+	tmpDir := t.TempDir()
+	withTestPath(path.Join(tmpDir, "data"))
+	backupDir := path.Join(tmpDir, "backup")
+	// prepare backup files
+	assert.NoError(t, fileutil.MkDirIfNotExist(path.Join(backupDir, "db", "shard", "1")))
+	assert.NoError(t, encodeToml(path.Join(backupDir, "db", options),
+		&models.DatabaseConfig{ShardIDs: []models.ShardID{1}, Option: &option.DatabaseOption{}}))
+	assert.NoError(t, os.WriteFile(path.Join(backupDir, "db", "shard", "1", "000001.sst"), []byte("sst"), 0600))
+	files := []string{options, path.Join("shard", "1", "000001.sst")}
+	assert.NoError(t, encodeToml(path.Join(backupDir, "db", backupManifest),
+		&models.BackupManifest{Database: "db", ShardIDs: []models.ShardID{1}, Files: files}))
+	tempPath := path.Join(tmpDir, "data", restoreDatabaseName("db"))
+
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("db").AnyTimes()
+	db.EXPECT().IsEmpty().Return(true).AnyTimes()
+	source := NewMockDatabase(ctrl)
+	sourceShard := NewMockShard(ctrl)
+	targetShard := NewMockShard(ctrl)
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "open temp database failure",
+			prepare: func() {
+				newDatabaseFunc = func(_ string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "merge exist shard failure, keep shard",
+			prepare: func() {
+				source.EXPECT().GetShard(models.ShardID(1)).Return(sourceShard, true)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(targetShard, true).Times(2)
+				db.EXPECT().CreateShards([]models.ShardID{1}).Return(nil)
+				targetShard.EXPECT().Merge(sourceShard).Return(fmt.Errorf("err"))
+				source.EXPECT().Drop().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "merge into exist database successfully",
+			prepare: func() {
+				source.EXPECT().GetShard(models.ShardID(1)).Return(sourceShard, true)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(targetShard, true).Times(2)
+				db.EXPECT().CreateShards([]models.ShardID{1}).Return(nil)
+				targetShard.EXPECT().Merge(sourceShard).Return(nil)
+				source.EXPECT().Drop().Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newDatabaseFunc = newDatabase
+				_ = fileutil.RemoveDir(path.Join(tmpDir, "data"))
+			}()
+			newDatabaseFunc = func(name string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+				assert.Equal(t, restoreDatabaseName("db"), name)
+				assert.True(t, fileutil.Exist(path.Join(tempPath, "shard", "1", "000001.sst")))
+				return source, nil
+			}
+			e := &engine{dbSet: *newDatabaseSet()}
+			e.dbSet.PutDatabase("db", db)
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := e.RestoreDatabase("db", backupDir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestoreDatabase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEngine_InstallShardSnapshot(t *testing.T) {
+	writeConfigTestLock.Lock()
+	defer writeConfigTestLock.Unlock()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newDatabaseFunc = newDatabase
+		ctrl.Finish()
+	}()
+	tmpDir := t.TempDir()
+	withTestPath(path.Join(tmpDir, "data"))
+	snapshotDir := path.Join(tmpDir, "snapshot")
+	// prepare snapshot files
+	assert.NoError(t, fileutil.MkDirIfNotExist(path.Join(snapshotDir, "db", "shard", "1")))
+	assert.NoError(t, encodeToml(path.Join(snapshotDir, "db", options),
+		&models.DatabaseConfig{ShardIDs: []models.ShardID{1}, Option: &option.DatabaseOption{}}))
+	assert.NoError(t, os.WriteFile(path.Join(snapshotDir, "db", "shard", "1", "000001.sst"), []byte("sst"), 0600))
+	files := []string{options, path.Join("shard", "1", "000001.sst")}
+	assert.NoError(t, encodeToml(path.Join(snapshotDir, "db", backupManifest),
+		&models.BackupManifest{Database: "db", Files: files}))
+	tempPath := path.Join(tmpDir, "data", snapshotDatabaseName("db", 1))
+
+	db := NewMockDatabase(ctrl)
+	source := NewMockDatabase(ctrl)
+	sourceShard := NewMockShard(ctrl)
+	targetShard := NewMockShard(ctrl)
+	cases := []struct {
+		name    string
+		prepare func(e *engine)
+		wantErr bool
+	}{
+		{
+			name: "database not exist, restore database",
+			prepare: func(_ *engine) {
+				newDatabaseFunc = func(_ string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+					return db, nil
+				}
+			},
+		},
+		{
+			name: "shard exist, skip install",
+			prepare: func(e *engine) {
+				e.dbSet.PutDatabase("db", db)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(targetShard, true)
+			},
+		},
+		{
+			name: "open temp database failure",
+			prepare: func(e *engine) {
+				e.dbSet.PutDatabase("db", db)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+				newDatabaseFunc = func(_ string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "shard not exist in snapshot",
+			prepare: func(e *engine) {
+				e.dbSet.PutDatabase("db", db)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+				source.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+				source.EXPECT().Drop().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "create shard failure",
+			prepare: func(e *engine) {
+				e.dbSet.PutDatabase("db", db)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false).Times(2)
+				source.EXPECT().GetShard(models.ShardID(1)).Return(sourceShard, true)
+				db.EXPECT().CreateShards([]models.ShardID{1}).Return(fmt.Errorf("err"))
+				source.EXPECT().Drop().Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "merge shard failure, drop partial merged shard",
+			prepare: func(e *engine) {
+				e.dbSet.PutDatabase("db", db)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false).Times(2)
+				source.EXPECT().GetShard(models.ShardID(1)).Return(sourceShard, true)
+				db.EXPECT().CreateShards([]models.ShardID{1}).Return(nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(targetShard, true)
+				targetShard.EXPECT().Merge(sourceShard).Return(fmt.Errorf("err"))
+				db.EXPECT().DropShard(models.ShardID(1)).Return(fmt.Errorf("err"))
+				source.EXPECT().Drop().Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "merge shard successfully",
+			prepare: func(e *engine) {
+				e.dbSet.PutDatabase("db", db)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false).Times(2)
+				source.EXPECT().GetShard(models.ShardID(1)).Return(sourceShard, true)
+				db.EXPECT().CreateShards([]models.ShardID{1}).Return(nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(targetShard, true)
+				targetShard.EXPECT().Merge(sourceShard).Return(nil)
+				source.EXPECT().Drop().Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newDatabaseFunc = newDatabase
+				_ = fileutil.RemoveDir(path.Join(tmpDir, "data"))
+			}()
+			newDatabaseFunc = func(name string, _ *models.DatabaseConfig, _ DataFlushChecker) (Database, error) {
+				assert.Equal(t, snapshotDatabaseName("db", 1), name)
+				assert.True(t, fileutil.Exist(path.Join(tempPath, "shard", "1", "000001.sst")))
+				return source, nil
+			}
+			e := &engine{dbSet: *newDatabaseSet()}
+			if tt.prepare != nil {
+				tt.prepare(e)
+			}
+			err := e.InstallShardSnapshot("db", 1, snapshotDir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InstallShardSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
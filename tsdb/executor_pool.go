@@ -17,7 +17,15 @@
 
 package tsdb
 
-import "github.com/lindb/lindb/internal/concurrent"
+import (
+	"runtime"
+	"time"
+
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+)
 
 // ExecutorPool represents the executor pool used by query flow for each storage engine
 type ExecutorPool struct {
@@ -25,3 +33,26 @@ type ExecutorPool struct {
 	Grouping  concurrent.Pool
 	Scanner   concurrent.Pool
 }
+
+// newExecutorPools creates the executor pool for each query class of database,
+// so that the flood of a query class cannot block the queries of other classes.
+func newExecutorPools(databaseName string) map[models.QueryClass]*ExecutorPool {
+	newPool := func(class models.QueryClass, name string) concurrent.Pool {
+		prefix := databaseName + "-" + string(class) + "-" + name
+		return concurrent.NewPool(
+			prefix+"-pool",
+			runtime.GOMAXPROCS(-1), /*nRoutines*/
+			time.Second*5,
+			metrics.NewConcurrentStatistics(prefix, linmetric.StorageRegistry),
+		)
+	}
+	pools := make(map[models.QueryClass]*ExecutorPool)
+	for _, class := range models.QueryClasses {
+		pools[class] = &ExecutorPool{
+			Filtering: newPool(class, "filtering"),
+			Grouping:  newPool(class, "grouping"),
+			Scanner:   newPool(class, "scanner"),
+		}
+	}
+	return pools
+}
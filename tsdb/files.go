@@ -19,8 +19,10 @@ package tsdb
 
 import (
 	"fmt"
+	"io/fs"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/models"
@@ -42,6 +44,10 @@ import (
 //	xx/shard/1/index/inverted/
 //	xx/shard/1/segment/day/20191012/
 //	xx/shard/1/segment/month/201910/
+//
+// backup of database[xx] keeps same directory tree under backup dir, with manifest file:
+//
+//	xx/BACKUP => backup manifest file
 const (
 	options          = "OPTIONS"
 	shardDir         = "shard"
@@ -53,8 +59,60 @@ const (
 	forwardIndexDir  = "forward"
 	invertedIndexDir = "inverted"
 	bufferDir        = "buffer"
+	backupManifest   = "BACKUP"
+	tableFileSuffix  = ".sst"
+	// snapshotPrefix is the prefix of temp database which is restored from shard snapshot/backup for merging.
+	snapshotPrefix = "_snapshot_"
+	// restoreSuffix is the suffix of temp database which is restored from backup for merging.
+	restoreSuffix = "_restore"
 )
 
+// listBackupFiles returns the relative path of all files under database backup path.
+func listBackupFiles(backupPath string) (files []string, err error) {
+	err = filepath.WalkDir(backupPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(backupPath, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, rel)
+		return nil
+	})
+	return files, err
+}
+
+// restoreBackupFile restores the file of backup into database path,
+// table files are immutable, so hard-link them if possible, other files(manifest etc.) maybe appended, copy them.
+func restoreBackupFile(src, dst string) error {
+	if err := mkDirIfNotExist(filepath.Dir(dst)); err != nil {
+		return err
+	}
+	if filepath.Ext(src) == tableFileSuffix {
+		return linkOrCopyFile(src, dst)
+	}
+	return copyFile(src, dst)
+}
+
+// snapshotDatabaseName returns the name of temp database which is restored from the snapshot of spec shard.
+func snapshotDatabaseName(database string, shardID models.ShardID) string {
+	return fmt.Sprintf("%s%s_%d", snapshotPrefix, database, shardID)
+}
+
+// restoreDatabaseName returns the name of temp database which is restored from the backup of database.
+func restoreDatabaseName(database string) string {
+	return snapshotPrefix + database + restoreSuffix
+}
+
+// isSnapshotDatabase checks if the database is temp database restored from shard snapshot.
+func isSnapshotDatabase(database string) bool {
+	return strings.HasPrefix(database, snapshotPrefix)
+}
+
 // createDatabasePath creates database's root path if existed.
 func createDatabasePath(database string) (string, error) {
 	dbPath := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, database)
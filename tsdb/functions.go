@@ -45,5 +45,8 @@ var (
 	newMemoryDBFunc        = memdb.NewMemoryDatabase
 	newDataFamilyFunc      = newDataFamily
 	newMetricDataFlusher   = metricsdata.NewFlusher
+	rewriteMetricData      = metricsdata.Rewrite
 	closeFamilyFunc        = closeFamily
+	copyFile               = fileutil.CopyFile
+	linkOrCopyFile         = fileutil.LinkOrCopy
 )
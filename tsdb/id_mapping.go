@@ -0,0 +1,218 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"math"
+	"sort"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+// idMapping implements metricsdata.IDMapping interface,
+// maps the metric/field/series ids of source shard(restored from other node) to the ids of target shard.
+type idMapping struct {
+	metricIDs map[uint32]uint32                // source metric id => target metric id
+	fieldIDs  map[uint32]map[field.ID]field.ID // source metric id => source field id => target field id
+	seriesIDs map[uint32]map[uint32]uint32     // source metric id => source series id => target series id
+}
+
+// newIDMapping creates an empty id mapping.
+func newIDMapping() *idMapping {
+	return &idMapping{
+		metricIDs: make(map[uint32]uint32),
+		fieldIDs:  make(map[uint32]map[field.ID]field.ID),
+		seriesIDs: make(map[uint32]map[uint32]uint32),
+	}
+}
+
+// GetMetricID returns the metric id of target shard, returns false if not found.
+func (m *idMapping) GetMetricID(metricID uint32) (uint32, bool) {
+	targetMetricID, ok := m.metricIDs[metricID]
+	return targetMetricID, ok
+}
+
+// GetFieldID returns the field id of target shard, returns false if not found.
+func (m *idMapping) GetFieldID(metricID uint32, fieldID field.ID) (field.ID, bool) {
+	targetFieldID, ok := m.fieldIDs[metricID][fieldID]
+	return targetFieldID, ok
+}
+
+// GetSeriesID returns the series id of target shard, returns false if not found.
+func (m *idMapping) GetSeriesID(metricID uint32, seriesID uint32) (uint32, bool) {
+	if seriesID == series.IDWithoutTags {
+		// metric without tags uses default series id(0)
+		return seriesID, true
+	}
+	targetSeriesID, ok := m.seriesIDs[metricID][seriesID]
+	return targetSeriesID, ok
+}
+
+// keyValuesIterator implements indexdb.TagIterator interface for tag key/value pairs.
+type keyValuesIterator struct {
+	kvs tag.KeyValues
+	idx int
+}
+
+// newKeyValuesIterator creates an iterator for tag key/value pairs.
+func newKeyValuesIterator(kvs tag.KeyValues) indexdb.TagIterator {
+	return &keyValuesIterator{kvs: kvs, idx: -1}
+}
+
+// HasNext returns if it has next tag key/value pair.
+func (it *keyValuesIterator) HasNext() bool {
+	it.idx++
+	return it.idx < len(it.kvs)
+}
+
+// NextKey returns the tag key of current pair.
+func (it *keyValuesIterator) NextKey() []byte {
+	return []byte(it.kvs[it.idx].Key)
+}
+
+// NextValue returns the tag value of current pair.
+func (it *keyValuesIterator) NextValue() []byte {
+	return []byte(it.kvs[it.idx].Value)
+}
+
+// buildIDMapping builds the id mapping from source shard to target shard,
+// generates metric/field ids and series ids(builds inverted index also) in target shard if not exist.
+func buildIDMapping(target, source Shard) (*idMapping, error) {
+	mapping := newIDMapping()
+	sourceMeta := source.Database().Metadata()
+	sourceMetaDB := sourceMeta.MetadataDatabase()
+	targetMetaDB := target.Database().Metadata().MetadataDatabase()
+	namespaces, err := sourceMetaDB.SuggestNamespace("", math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	for _, ns := range namespaces {
+		names, err := sourceMetaDB.SuggestMetrics(ns, "", math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			sourceMetricID, err := sourceMetaDB.GetMetricID(ns, name)
+			if err != nil {
+				return nil, err
+			}
+			targetMetricID, err := targetMetaDB.GenMetricID(ns, name)
+			if err != nil {
+				return nil, err
+			}
+			fields, err := sourceMetaDB.GetAllFields(ns, name)
+			if err != nil {
+				return nil, err
+			}
+			fieldIDs := make(map[field.ID]field.ID)
+			for _, f := range fields {
+				fieldID, err := targetMetaDB.GenFieldID(ns, name, f.Name, f.Type)
+				if err != nil {
+					return nil, err
+				}
+				fieldIDs[f.ID] = fieldID
+			}
+			seriesIDs, err := buildSeriesIDMapping(target, source, sourceMeta, ns, name, targetMetricID)
+			if err != nil {
+				return nil, err
+			}
+			mapping.metricIDs[uint32(sourceMetricID)] = uint32(targetMetricID)
+			mapping.fieldIDs[uint32(sourceMetricID)] = fieldIDs
+			mapping.seriesIDs[uint32(sourceMetricID)] = seriesIDs
+		}
+	}
+	return mapping, nil
+}
+
+// buildSeriesIDMapping builds the series id mapping of metric by the tags of series.
+func buildSeriesIDMapping(target, source Shard, sourceMeta metadb.Metadata,
+	namespace, metricName string, targetMetricID metric.ID,
+) (map[uint32]uint32, error) {
+	tagKeys, err := sourceMeta.MetadataDatabase().GetAllTagKeys(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint32]uint32)
+	if len(tagKeys) == 0 {
+		return result, nil
+	}
+	sourceIndexDB := source.IndexDatabase()
+	seriesIDs, err := sourceIndexDB.GetSeriesIDsForMetric(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	// collect tags of each series
+	seriesTags := make(map[uint32]tag.KeyValues)
+	for _, tagKey := range tagKeys {
+		scanners, err := sourceIndexDB.GetGroupingScanner(tagKey.ID, seriesIDs)
+		if err != nil {
+			return nil, err
+		}
+		seriesTagValueIDs := make(map[uint32]uint32)
+		tagValueIDs := roaring.New()
+		for _, scanner := range scanners {
+			for _, highKey := range scanner.GetSeriesIDs().GetHighKeys() {
+				lowSeriesIDs, values := scanner.GetSeriesAndTagValue(highKey)
+				if lowSeriesIDs == nil {
+					continue
+				}
+				it := lowSeriesIDs.PeekableIterator()
+				idx := 0
+				for it.HasNext() {
+					seriesID := uint32(highKey)<<16 | uint32(it.Next())
+					if seriesIDs.Contains(seriesID) {
+						seriesTagValueIDs[seriesID] = values[idx]
+						tagValueIDs.Add(values[idx])
+					}
+					idx++
+				}
+			}
+		}
+		tagValues := make(map[uint32]string)
+		if err := sourceMeta.TagMetadata().CollectTagValues(tagKey.ID, tagValueIDs, tagValues); err != nil {
+			return nil, err
+		}
+		for seriesID, tagValueID := range seriesTagValueIDs {
+			if tagValue, ok := tagValues[tagValueID]; ok {
+				seriesTags[seriesID] = append(seriesTags[seriesID], &protoMetricsV1.KeyValue{Key: tagKey.Key, Value: tagValue})
+			}
+		}
+	}
+	targetIndexDB := target.IndexDatabase()
+	for seriesID, kvs := range seriesTags {
+		// tags hash is calculated by sorted tags when writing
+		sort.Sort(kvs)
+		targetSeriesID, isCreated, err := targetIndexDB.GetOrCreateSeriesID(targetMetricID, tag.XXHashOfKeyValues(kvs))
+		if err != nil {
+			return nil, err
+		}
+		if isCreated {
+			targetIndexDB.BuildInvertIndex(namespace, metricName, newKeyValuesIterator(kvs), targetSeriesID)
+		}
+		result[seriesID] = targetSeriesID
+	}
+	return result, nil
+}
@@ -0,0 +1,279 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestIDMapping(t *testing.T) {
+	mapping := newIDMapping()
+	mapping.metricIDs[1] = 10
+	mapping.fieldIDs[1] = map[field.ID]field.ID{1: 2}
+	mapping.seriesIDs[1] = map[uint32]uint32{1: 100}
+
+	metricID, ok := mapping.GetMetricID(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(10), metricID)
+	_, ok = mapping.GetMetricID(2)
+	assert.False(t, ok)
+	fieldID, ok := mapping.GetFieldID(1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, field.ID(2), fieldID)
+	_, ok = mapping.GetFieldID(2, 1)
+	assert.False(t, ok)
+	seriesID, ok := mapping.GetSeriesID(1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(100), seriesID)
+	_, ok = mapping.GetSeriesID(1, 2)
+	assert.False(t, ok)
+	// series without tags
+	seriesID, ok = mapping.GetSeriesID(2, 0)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0), seriesID)
+}
+
+func TestKeyValuesIterator(t *testing.T) {
+	it := newKeyValuesIterator(tag.KeyValues{{Key: "host", Value: "1.1.1.1"}, {Key: "ip", Value: "2.2.2.2"}})
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []byte("host"), it.NextKey())
+	assert.Equal(t, []byte("1.1.1.1"), it.NextValue())
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []byte("ip"), it.NextKey())
+	assert.Equal(t, []byte("2.2.2.2"), it.NextValue())
+	assert.False(t, it.HasNext())
+}
+
+func TestBuildIDMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	target := NewMockShard(ctrl)
+	targetDB := NewMockDatabase(ctrl)
+	target.EXPECT().Database().Return(targetDB).AnyTimes()
+	targetMetadata := metadb.NewMockMetadata(ctrl)
+	targetDB.EXPECT().Metadata().Return(targetMetadata).AnyTimes()
+	targetMetadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	targetMetadata.EXPECT().MetadataDatabase().Return(targetMetadataDB).AnyTimes()
+	targetIndexDB := indexdb.NewMockIndexDatabase(ctrl)
+	target.EXPECT().IndexDatabase().Return(targetIndexDB).AnyTimes()
+
+	source := NewMockShard(ctrl)
+	sourceDB := NewMockDatabase(ctrl)
+	source.EXPECT().Database().Return(sourceDB).AnyTimes()
+	sourceMetadata := metadb.NewMockMetadata(ctrl)
+	sourceDB.EXPECT().Metadata().Return(sourceMetadata).AnyTimes()
+	sourceMetadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	sourceMetadata.EXPECT().MetadataDatabase().Return(sourceMetadataDB).AnyTimes()
+	sourceTagMetadata := metadb.NewMockTagMetadata(ctrl)
+	sourceMetadata.EXPECT().TagMetadata().Return(sourceTagMetadata).AnyTimes()
+	sourceIndexDB := indexdb.NewMockIndexDatabase(ctrl)
+	source.EXPECT().IndexDatabase().Return(sourceIndexDB).AnyTimes()
+	scanner := flow.NewMockGroupingScanner(ctrl)
+
+	mockMetric := func() {
+		sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return([]string{"ns"}, nil)
+		sourceMetadataDB.EXPECT().SuggestMetrics("ns", gomock.Any(), gomock.Any()).Return([]string{"cpu"}, nil)
+	}
+	mockMetricID := func() {
+		mockMetric()
+		sourceMetadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+		targetMetadataDB.EXPECT().GenMetricID("ns", "cpu").Return(metric.ID(10), nil)
+	}
+	mockFieldID := func() {
+		mockMetricID()
+		sourceMetadataDB.EXPECT().GetAllFields("ns", "cpu").Return(field.Metas{{ID: 1, Name: "f1", Type: field.SumField}}, nil)
+		targetMetadataDB.EXPECT().GenFieldID("ns", "cpu", field.Name("f1"), field.SumField).Return(field.ID(2), nil)
+	}
+	mockTags := func() {
+		mockFieldID()
+		sourceMetadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{Key: "host", ID: 1}}, nil)
+	}
+	mockScanner := func() {
+		mockTags()
+		sourceIndexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+		sourceIndexDB.EXPECT().GetGroupingScanner(tag.KeyID(1), gomock.Any()).Return([]flow.GroupingScanner{scanner}, nil)
+		// series 3 isn't series of metric
+		seriesIDs := roaring.BitmapOf(1, 2, 3)
+		scanner.EXPECT().GetSeriesIDs().Return(seriesIDs)
+		scanner.EXPECT().GetSeriesAndTagValue(uint16(0)).Return(seriesIDs.GetContainerAtIndex(0), []uint32{10, 20, 30})
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "suggest namespace failure",
+			prepare: func() {
+				sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "suggest metrics failure",
+			prepare: func() {
+				sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return([]string{"ns"}, nil)
+				sourceMetadataDB.EXPECT().SuggestMetrics("ns", gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get metric id failure",
+			prepare: func() {
+				mockMetric()
+				sourceMetadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "gen metric id failure",
+			prepare: func() {
+				mockMetric()
+				sourceMetadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+				targetMetadataDB.EXPECT().GenMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get fields failure",
+			prepare: func() {
+				mockMetricID()
+				sourceMetadataDB.EXPECT().GetAllFields("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "gen field id failure",
+			prepare: func() {
+				mockMetricID()
+				sourceMetadataDB.EXPECT().GetAllFields("ns", "cpu").Return(field.Metas{{ID: 1, Name: "f1", Type: field.SumField}}, nil)
+				targetMetadataDB.EXPECT().GenFieldID("ns", "cpu", field.Name("f1"), field.SumField).Return(field.ID(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get tag keys failure",
+			prepare: func() {
+				mockFieldID()
+				sourceMetadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric without tags",
+			prepare: func() {
+				mockFieldID()
+				sourceMetadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, nil)
+			},
+		},
+		{
+			name: "get series ids failure",
+			prepare: func() {
+				mockTags()
+				sourceIndexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get grouping scanner failure",
+			prepare: func() {
+				mockTags()
+				sourceIndexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+				sourceIndexDB.EXPECT().GetGroupingScanner(tag.KeyID(1), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "collect tag values failure",
+			prepare: func() {
+				mockScanner()
+				sourceTagMetadata.EXPECT().CollectTagValues(tag.KeyID(1), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get or create series id failure",
+			prepare: func() {
+				mockScanner()
+				sourceTagMetadata.EXPECT().CollectTagValues(tag.KeyID(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error {
+						tagValues[10] = "1.1.1.1"
+						return nil
+					})
+				targetIndexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "build id mapping successfully",
+			prepare: func() {
+				mockScanner()
+				sourceTagMetadata.EXPECT().CollectTagValues(tag.KeyID(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error {
+						assert.Equal(t, []uint32{10, 20}, tagValueIDs.ToArray())
+						tagValues[10] = "1.1.1.1"
+						tagValues[20] = "2.2.2.2"
+						return nil
+					})
+				targetIndexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10),
+					tag.XXHashOfKeyValues(tag.KeyValues{{Key: "host", Value: "1.1.1.1"}})).Return(uint32(100), true, nil)
+				targetIndexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10),
+					tag.XXHashOfKeyValues(tag.KeyValues{{Key: "host", Value: "2.2.2.2"}})).Return(uint32(200), false, nil)
+				targetIndexDB.EXPECT().BuildInvertIndex("ns", "cpu", gomock.Any(), uint32(100))
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			mapping, err := buildIDMapping(target, source)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildIDMapping() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.name == "build id mapping successfully" {
+				metricID, _ := mapping.GetMetricID(1)
+				assert.Equal(t, uint32(10), metricID)
+				fieldID, _ := mapping.GetFieldID(1, 1)
+				assert.Equal(t, field.ID(2), fieldID)
+				seriesID, _ := mapping.GetSeriesID(1, 1)
+				assert.Equal(t, uint32(100), seriesID)
+				seriesID, _ = mapping.GetSeriesID(1, 2)
+				assert.Equal(t, uint32(200), seriesID)
+				_, ok := mapping.GetSeriesID(1, 3)
+				assert.False(t, ok)
+			}
+		})
+	}
+}
@@ -53,8 +53,12 @@ type IDMappingBackend interface {
 	getSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, err error)
 	// genSeries generates series id by metric id/tags hash.
 	genSeriesID(metricID metric.ID, tagsHash uint64, seriesID uint32) error
+	// countSeries counts the number of series which series id generated.
+	countSeries() (int64, error)
 	// sync the backend memory data into persist storage.
 	sync() error
+	// checkpoint creates a consistent snapshot of backend storage into given dir.
+	checkpoint(dir string) error
 }
 
 // idMappingBackend implements IDMappingBackend interface
@@ -134,6 +138,12 @@ func (imb *idMappingBackend) genSeriesID(metricID metric.ID, tagsHash uint64, se
 	return imb.db.Put(key, scratch[:])
 }
 
+// countSeries counts the number of series which series id generated,
+// key of series is metric id + tags hash(sequence key is metric id only).
+func (imb *idMappingBackend) countSeries() (int64, error) {
+	return imb.db.CountKeys(len(metric.EmptyMetricID.MarshalBinary()) + 8)
+}
+
 // Close closes the backend storage resource.
 func (imb *idMappingBackend) Close() error {
 	return imb.db.Close()
@@ -143,3 +153,8 @@ func (imb *idMappingBackend) Close() error {
 func (imb *idMappingBackend) sync() error {
 	return imb.db.Flush()
 }
+
+// checkpoint creates a consistent snapshot of backend storage into given dir.
+func (imb *idMappingBackend) checkpoint(dir string) error {
+	return imb.db.Checkpoint(path.Join(dir, SeriesDB))
+}
@@ -19,6 +19,7 @@ package indexdb
 
 import (
 	"fmt"
+	"path"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -108,6 +109,18 @@ func TestIDMappingBackend_Close_sync(t *testing.T) {
 	assert.NoError(t, backend.Close())
 }
 
+func TestIDMappingBackend_checkpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{
+		db: idStore,
+	}
+	idStore.EXPECT().Checkpoint(path.Join("backup", SeriesDB)).Return(nil)
+	assert.NoError(t, backend.checkpoint("backup"))
+}
+
 func TestIDMappingBackend_getSeriesID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -188,6 +201,21 @@ func TestIDMappingBackend_genSeriesID(t *testing.T) {
 	assert.Error(t, backend.genSeriesID(metric.ID(2), 123, 2))
 }
 
+func TestIDMappingBackend_countSeries(t *testing.T) {
+	backend, err := newIDMappingBackend(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = backend.Close()
+	}()
+	_, err = backend.loadMetricIDMapping(metric.ID(2))
+	assert.NoError(t, err)
+	assert.NoError(t, backend.genSeriesID(metric.ID(2), 123, 1))
+	assert.NoError(t, backend.genSeriesID(metric.ID(2), 456, 2))
+	count, err := backend.countSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
 func TestIDMappingBackend_loadMetricIDMapping(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
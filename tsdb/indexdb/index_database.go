@@ -52,7 +52,11 @@ type indexDatabase struct {
 
 	statistics *metrics.IndexDBStatistics
 
-	rwMutex sync.RWMutex // lock of create metric index
+	numOfSeries   int64 // number of series, counted after seriesCounted is true
+	seriesCounted bool
+
+	rwMutex      sync.RWMutex // lock of create metric index
+	backendMutex sync.RWMutex // lock of closing backend, checkpoint holds read lock which doesn't block writers
 }
 
 // NewIndexDatabase creates a new index database
@@ -89,6 +93,11 @@ func (db *indexDatabase) GetGroupingContext(ctx *flow.ShardExecuteContext) error
 	return db.index.GetGroupingContext(ctx)
 }
 
+// GetGroupingScanner returns the grouping scanners based on tag key ids and series ids
+func (db *indexDatabase) GetGroupingScanner(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error) {
+	return db.index.GetGroupingScanner(tagKeyID, seriesIDs)
+}
+
 // GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 // if generate a new series id returns isCreate is true
 // if generate fail return err
@@ -140,6 +149,9 @@ func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64
 	if err := db.backend.genSeriesID(metricID, tagsHash, seriesID); err != nil {
 		return series.EmptySeriesID, false, err
 	}
+	if db.seriesCounted {
+		db.numOfSeries++
+	}
 
 	return seriesID, true, nil
 }
@@ -174,11 +186,34 @@ func (db *indexDatabase) GetSeriesIDsForMetric(namespace, metricName string) (*r
 	return db.index.GetSeriesIDsForTags(tagKeyIDs)
 }
 
+// NumOfSeries returns the number of series, counts from id mapping storage at first call,
+// then maintains the count incrementally when series id generated.
+func (db *indexDatabase) NumOfSeries() (int64, error) {
+	db.rwMutex.RLock()
+	if db.seriesCounted {
+		defer db.rwMutex.RUnlock()
+		return db.numOfSeries, nil
+	}
+	db.rwMutex.RUnlock()
+
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+	if !db.seriesCounted {
+		count, err := db.backend.countSeries()
+		if err != nil {
+			return 0, err
+		}
+		db.numOfSeries = count
+		db.seriesCounted = true
+	}
+	return db.numOfSeries, nil
+}
+
 // BuildInvertIndex builds the inverted index for tag value => series ids,
 // the tags is considered as an empty key-value pair while tags is nil.
 func (db *indexDatabase) BuildInvertIndex(
 	namespace, metricName string,
-	tagIterator *metric.KeyValueIterator,
+	tagIterator TagIterator,
 	seriesID uint32,
 ) {
 	db.index.buildInvertIndex(namespace, metricName, tagIterator, seriesID)
@@ -199,6 +234,15 @@ func (db *indexDatabase) Flush() error {
 	return db.index.Flush()
 }
 
+// Checkpoint creates a consistent snapshot of series id mapping storage into given dir,
+// backend's checkpoint is consistent itself, so it doesn't block creating series id.
+func (db *indexDatabase) Checkpoint(dir string) error {
+	db.backendMutex.RLock()
+	defer db.backendMutex.RUnlock()
+
+	return db.backend.checkpoint(dir)
+}
+
 // Close closes the database, releases the resources
 func (db *indexDatabase) Close() error {
 	db.cancel()
@@ -207,6 +251,8 @@ func (db *indexDatabase) Close() error {
 		return err
 	}
 
+	db.backendMutex.Lock()
+	defer db.backendMutex.Unlock()
 	db.rwMutex.Lock()
 	defer db.rwMutex.Unlock()
 	if err := db.backend.Close(); err != nil {
@@ -378,6 +378,25 @@ func TestIndexDatabase_GetGroupingContext(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIndexDatabase_GetGroupingScanner(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	index := NewMockInvertedIndex(ctrl)
+	db.(*indexDatabase).index = index
+	index.EXPECT().GetGroupingScanner(tag.KeyID(1), roaring.BitmapOf(1, 2)).Return(nil, fmt.Errorf("err"))
+	scanners, err := db.GetGroupingScanner(tag.KeyID(1), roaring.BitmapOf(1, 2))
+	assert.Error(t, err)
+	assert.Empty(t, scanners)
+	index.EXPECT().Flush().Return(nil)
+	assert.NoError(t, db.Close())
+}
+
 func TestIndexDatabase_GetSeriesIDs(t *testing.T) {
 	testPath := t.TempDir()
 	ctrl := gomock.NewController(t)
@@ -476,3 +495,77 @@ func TestIndexDatabase_Flush(t *testing.T) {
 	backend.EXPECT().sync().Return(fmt.Errorf("err"))
 	assert.Error(t, db.Flush())
 }
+
+func TestIndexDatabase_NumOfSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sequence := unique.NewMockSequence(ctrl)
+	backend := NewMockIDMappingBackend(ctrl)
+	mapping := NewMockMetricIDMapping(ctrl)
+	db := &indexDatabase{
+		backend: backend,
+		metricID2Mapping: map[metric.ID]MetricIDMapping{
+			2: mapping,
+		},
+	}
+	backend.EXPECT().countSeries().Return(int64(0), fmt.Errorf("err"))
+	_, err := db.NumOfSeries()
+	assert.Error(t, err)
+	// count from backend only once
+	backend.EXPECT().countSeries().Return(int64(10), nil)
+	num, err := db.NumOfSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), num)
+	num, err = db.NumOfSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), num)
+	// count new series
+	mapping.EXPECT().GetSeriesID(gomock.Any()).Return(series.EmptySeriesID, false)
+	mapping.EXPECT().GenSeriesID(gomock.Any()).Return(uint32(11))
+	mapping.EXPECT().SeriesSequence().Return(sequence)
+	sequence.EXPECT().HasNext().Return(true)
+	backend.EXPECT().getSeriesID(gomock.Any(), gomock.Any()).Return(series.EmptySeriesID, constants.ErrNotFound)
+	backend.EXPECT().genSeriesID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	_, isCreated, err := db.GetOrCreateSeriesID(2, 11)
+	assert.NoError(t, err)
+	assert.True(t, isCreated)
+	num, err = db.NumOfSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), num)
+}
+
+func TestIndexDatabase_Checkpoint(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createBackendFn = newIDMappingBackend
+		ctrl.Finish()
+	}()
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	backend.EXPECT().checkpoint("backup").Return(fmt.Errorf("err"))
+	assert.Error(t, db.Checkpoint("backup"))
+
+	// checkpoint doesn't block creating series id
+	backend.EXPECT().loadMetricIDMapping(gomock.Any()).Return(newMetricIDMapping(1, 0), nil)
+	backend.EXPECT().getSeriesID(gomock.Any(), gomock.Any()).Return(uint32(1), nil)
+	created := make(chan struct{})
+	backend.EXPECT().checkpoint("backup").DoAndReturn(func(dir string) error {
+		go func() {
+			_, _, err0 := db.GetOrCreateSeriesID(1, 10)
+			assert.NoError(t, err0)
+			close(created)
+		}()
+		<-created
+		return nil
+	})
+	assert.NoError(t, db.Checkpoint("backup"))
+}
@@ -38,12 +38,23 @@ type FileIndexDatabase interface {
 	series.TagValueSuggester
 }
 
+// TagIterator represents the iterator of series' tag key/value pairs.
+type TagIterator interface {
+	// HasNext returns if it has next tag key/value pair.
+	HasNext() bool
+	// NextKey returns the tag key of current pair.
+	NextKey() []byte
+	// NextValue returns the tag value of current pair.
+	NextValue() []byte
+}
+
 // IndexDatabase represents a index database includes memory/file storage, it is shard level.
 // index database will generate series id if tags hash not exist in mapping storage, and
 // builds inverted index for tags => series id
 type IndexDatabase interface {
 	io.Closer
 	flow.GroupingBuilder
+	flow.Grouping
 	series.TagValueSuggester
 	series.Filter
 	// GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
@@ -52,7 +63,12 @@ type IndexDatabase interface {
 	GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, isCreated bool, err error)
 	// BuildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
-	BuildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32)
+	BuildInvertIndex(namespace, metricName string, tagIterator TagIterator, seriesID uint32)
+	// NumOfSeries returns the number of series, counts from id mapping storage at first call,
+	// then maintains the count incrementally when series id generated.
+	NumOfSeries() (int64, error)
 	// Flush flushes index data to disk
 	Flush() error
+	// Checkpoint creates a consistent snapshot of series id mapping storage into given dir.
+	Checkpoint(dir string) error
 }
@@ -27,7 +27,6 @@ import (
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/pkg/logger"
-	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/tsdb/metadb"
 	"github.com/lindb/lindb/tsdb/tblstore/tagindex"
@@ -53,9 +52,11 @@ type InvertedIndex interface {
 	GetSeriesIDsForTags(tagKeyIDs []tag.KeyID) (*roaring.Bitmap, error)
 	// GetGroupingContext returns the context of group by
 	GetGroupingContext(ctx *flow.ShardExecuteContext) error
+	// GetGroupingScanner returns the grouping scanners based on tag key ids and series ids
+	GetGroupingScanner(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error)
 	// buildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
-	buildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32)
+	buildInvertIndex(namespace, metricName string, tagIterator TagIterator, seriesID uint32)
 	// Flush flushes the inverted-index of tag value id=>series ids under tag key
 	Flush() error
 }
@@ -195,6 +196,15 @@ func (index *invertedIndex) GetGroupingContext(ctx *flow.ShardExecuteContext) er
 	return nil
 }
 
+// GetGroupingScanner returns the grouping scanners based on tag key ids and series ids
+func (index *invertedIndex) GetGroupingScanner(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error) {
+	// get kv store snapshot
+	snapshot := index.forwardFamily.GetSnapshot()
+	defer snapshot.Close()
+
+	return index.getGroupingScanners(tagKeyID, seriesIDs, snapshot)
+}
+
 // withLock retrieves the lock of inverted index, and returns the release function.
 func (index *invertedIndex) withLock() (release func()) {
 	index.rwMutex.RLock()
@@ -238,7 +248,7 @@ func (index *invertedIndex) getGroupingScanners(
 
 // buildInvertIndex builds the inverted index for tag value => series ids,
 // the tags is considered as an empty key-value pair while tags is nil.
-func (index *invertedIndex) buildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32) {
+func (index *invertedIndex) buildInvertIndex(namespace, metricName string, tagIterator TagIterator, seriesID uint32) {
 	index.rwMutex.Lock()
 	defer index.rwMutex.Unlock()
 
@@ -245,6 +245,43 @@ func TestInvertedIndex_GetGroupingContext(t *testing.T) {
 	assert.True(t, shardExecuteCtx.SeriesIDsAfterFiltering.IsEmpty())
 }
 
+func TestInvertedIndex_GetGroupingScanner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newForwardReaderFunc = tagindex.NewForwardReader
+		ctrl.Finish()
+	}()
+
+	index := prepareInvertedIndex(ctrl)
+	idx := index.(*invertedIndex)
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	idx.forwardFamily = family
+
+	// case 1: get sst file reader err
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err := index.GetGroupingScanner(1, roaring.BitmapOf(1, 2, 3))
+	assert.Error(t, err)
+	// case 2: only memory scanner
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+	scanners, err := index.GetGroupingScanner(1, roaring.BitmapOf(1, 2, 3))
+	assert.NoError(t, err)
+	assert.Len(t, scanners, 1)
+	assert.Equal(t, roaring.BitmapOf(1, 2), scanners[0].GetSeriesIDs())
+	// case 3: memory and file scanners
+	reader := tagindex.NewMockForwardReader(ctrl)
+	newForwardReaderFunc = func(readers []table.Reader) tagindex.ForwardReader {
+		return reader
+	}
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{table.NewMockReader(ctrl)}, nil)
+	reader.EXPECT().GetGroupingScanner(gomock.Any(), gomock.Any()).Return([]flow.GroupingScanner{nil}, nil)
+	scanners, err = index.GetGroupingScanner(1, roaring.BitmapOf(1, 2, 3))
+	assert.NoError(t, err)
+	assert.Len(t, scanners, 2)
+}
+
 func TestInvertedIndex_FlushInvertedIndexTo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
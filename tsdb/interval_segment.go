@@ -22,6 +22,8 @@ import (
 	"path"
 	"sync"
 
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -41,6 +43,12 @@ type IntervalSegment interface {
 	TTL() error
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// Backup creates a point-in-time copy of all segments under backup dir.
+	Backup(dir string) error
+	// Compact compacts(or rollups) the segments which contain the family time(all segments if 0).
+	Compact(familyTime int64, rollup bool) error
+	// GetCompactionState returns the compaction state of loaded segments.
+	GetCompactionState() []models.FamilyCompactionState
 }
 
 // intervalSegment implements IntervalSegment interface
@@ -191,6 +199,60 @@ func (s *intervalSegment) EvictSegment() {
 	}
 }
 
+// Backup creates a point-in-time copy of all segments under backup dir.
+func (s *intervalSegment) Backup(dir string) error {
+	var result error
+	if err := s.walkSegment(func(segmentName string, _ int64) {
+		if result != nil {
+			return
+		}
+		segment, err := s.getOrLoadSegment(segmentName)
+		if err != nil {
+			result = err
+			return
+		}
+		result = segment.Backup(dir)
+	}); err != nil {
+		return err
+	}
+	return result
+}
+
+// Compact compacts(or rollups) the segments which contain the family time(all segments if 0).
+func (s *intervalSegment) Compact(familyTime int64, rollup bool) error {
+	var segmentTime int64
+	if familyTime > 0 {
+		segmentTime = s.interval.Interval.Calculator().CalcSegmentTime(familyTime)
+	}
+	var result error
+	if err := s.walkSegment(func(segmentName string, baseTime int64) {
+		if result != nil || (segmentTime > 0 && segmentTime != baseTime) {
+			return
+		}
+		segment, err := s.getOrLoadSegment(segmentName)
+		if err != nil {
+			result = err
+			return
+		}
+		segment.Compact(familyTime, rollup)
+	}); err != nil {
+		return err
+	}
+	return result
+}
+
+// GetCompactionState returns the compaction state of loaded segments.
+func (s *intervalSegment) GetCompactionState() []models.FamilyCompactionState {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var rs []models.FamilyCompactionState
+	for _, segment := range s.segments {
+		rs = append(rs, segment.GetCompactionState()...)
+	}
+	return rs
+}
+
 // walkSegment lists all segment under current interval segment dir.
 func (s *intervalSegment) walkSegment(fn func(segmentName string, segmentTime int64)) error {
 	segmentNames, err := listDir(s.dir)
@@ -221,6 +283,13 @@ func (s *intervalSegment) dropSegment(segmentName string) {
 	}
 	delete(s.segments, segmentName)
 
+	// drop cold tier files before removing segment dir, because object keys are prefixed with store's uuid.
+	indicator := ShardSegmentIndicator(s.shard.Database().Name(), s.shard.ShardID(), s.interval.Interval, segmentName)
+	if err := kv.GetStoreManager().DropTieredFiles(indicator); err != nil {
+		s.logger.Warn("remove segment files of cold tier storage failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName),
+			logger.Error(err))
+	}
 	if err := removeDir(path.Join(s.dir, segmentName)); err != nil {
 		s.logger.Warn("remove segment dir failure",
 			logger.String("path", s.dir), logger.String("segment", segmentName),
@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
@@ -307,6 +308,14 @@ func TestIntervalSegment_TTL(t *testing.T) {
 	segmentDir := timeutil.FormatTimestamp(now, "20060102")
 
 	segment := NewMockSegment(ctrl)
+	shard := NewMockShard(ctrl)
+	db := NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	db.EXPECT().Name().Return("db").AnyTimes()
+	storeMgr := kv.NewMockStoreManager(ctrl)
+	kv.InitStoreManager(storeMgr)
+	defer kv.InitStoreManager(nil)
 	cases := []struct {
 		name    string
 		prepare func()
@@ -337,6 +346,7 @@ func TestIntervalSegment_TTL(t *testing.T) {
 					return []string{segmentDir}, nil
 				}
 				segment.EXPECT().Close()
+				storeMgr.EXPECT().DropTieredFiles(gomock.Any()).Return(nil)
 				removeDir = func(path string) error {
 					return fmt.Errorf("err")
 				}
@@ -344,7 +354,7 @@ func TestIntervalSegment_TTL(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "remove data dir successfully",
+			name: "remove cold tier files failure",
 			prepare: func() {
 				listDir = func(path string) ([]string, error) {
 					return []string{segmentDir}, nil
@@ -353,6 +363,21 @@ func TestIntervalSegment_TTL(t *testing.T) {
 				removeDir = func(path string) error {
 					return nil
 				}
+				storeMgr.EXPECT().DropTieredFiles(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "remove cold tier files successfully",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return []string{segmentDir}, nil
+				}
+				segment.EXPECT().Close()
+				removeDir = func(path string) error {
+					return nil
+				}
+				storeMgr.EXPECT().DropTieredFiles(gomock.Any()).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -365,6 +390,7 @@ func TestIntervalSegment_TTL(t *testing.T) {
 				listDir = fileutil.ListDir
 			}()
 			s := &intervalSegment{
+				shard: shard,
 				interval: option.Interval{
 					Interval:  timeutil.Interval(10 * timeutil.OneSecond),
 					Retention: timeutil.Interval(30 * timeutil.OneDay),
@@ -408,3 +434,100 @@ func TestIntervalSegment_EvictSegment(t *testing.T) {
 	s.EvictSegment()
 	assert.Len(t, s.segments, 0)
 }
+
+func TestIntervalSegment_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDir = fileutil.GetDirectoryList
+		ctrl.Finish()
+	}()
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		interval: option.Interval{
+			Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(30 * timeutil.OneDay),
+		},
+		segments: map[string]Segment{
+			"20191012": segment,
+		},
+		logger: logger.GetLogger("TSDB", "Segment"),
+	}
+	// case 1: list segment failure
+	listDir = func(_ string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.Backup("backup"))
+	listDir = func(_ string) ([]string, error) {
+		return []string{"20191012", "20191013"}, nil
+	}
+	// case 2: load segment failure
+	newSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval) (Segment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	defer func() {
+		newSegmentFunc = newSegment
+	}()
+	segment.EXPECT().Backup("backup").Return(nil)
+	assert.Error(t, s.Backup("backup"))
+	// case 3: backup segment failure
+	delete(s.segments, "20191013")
+	listDir = func(_ string) ([]string, error) {
+		return []string{"20191012"}, nil
+	}
+	segment.EXPECT().Backup("backup").Return(fmt.Errorf("err"))
+	assert.Error(t, s.Backup("backup"))
+	// case 4: backup successfully
+	segment.EXPECT().Backup("backup").Return(nil)
+	assert.NoError(t, s.Backup("backup"))
+}
+
+func TestIntervalSegment_Compact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDir = fileutil.GetDirectoryList
+		newSegmentFunc = newSegment
+		ctrl.Finish()
+	}()
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		interval: option.Interval{
+			Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(30 * timeutil.OneDay),
+		},
+		segments: map[string]Segment{
+			"20191012": segment,
+		},
+		logger: logger.GetLogger("TSDB", "Segment"),
+	}
+	// case 1: list segment failure
+	listDir = func(_ string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.Compact(0, false))
+	listDir = func(_ string) ([]string, error) {
+		return []string{"20191012", "20191013"}, nil
+	}
+	// case 2: load segment failure
+	newSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval) (Segment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	segment.EXPECT().Compact(int64(0), false)
+	assert.Error(t, s.Compact(0, false))
+	// case 3: only compact segment which contains family time
+	familyTime, _ := timeutil.ParseTimestamp("20191012 10:30:00", "20060102 15:04:05")
+	segment.EXPECT().Compact(familyTime, true)
+	assert.NoError(t, s.Compact(familyTime, true))
+}
+
+func TestIntervalSegment_GetCompactionState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		segments: map[string]Segment{
+			"20191012": segment,
+		},
+	}
+	segment.EXPECT().GetCompactionState().Return([]models.FamilyCompactionState{{Family: "10"}})
+	assert.Equal(t, []models.FamilyCompactionState{{Family: "10"}}, s.GetCompactionState())
+}
@@ -87,4 +87,6 @@ type MetadataDatabase interface {
 	SuggestNamespace(prefix string, limit int) (namespaces []string, err error)
 	// Sync syncs the pending metadata update event
 	Sync() error
+	// Checkpoint creates a consistent snapshot of metadata storage into given dir.
+	Checkpoint(dir string) error
 }
@@ -96,6 +96,9 @@ type MetadataBackend interface {
 
 	// sync the backend memory data into persist storage.
 	sync() error
+	// snapshot persists sequences and takes a consistent snapshot of backend storage,
+	// the snapshot must be released after used.
+	snapshot() (*metadataSnapshot, error)
 }
 
 // metadataBackend implements the MetadataBackend interface.
@@ -438,6 +441,41 @@ func (mb *metadataBackend) sync() error {
 	return result
 }
 
+// snapshot persists sequences and takes a consistent snapshot of backend storage,
+// the snapshot must be released after used.
+func (mb *metadataBackend) snapshot() (*metadataSnapshot, error) {
+	if err := mb.saveSequences(); err != nil {
+		return nil, err
+	}
+	snapshot := &metadataSnapshot{snapshots: make(map[string]unique.Snapshot)}
+	for name, db := range mb.dbs {
+		snapshot.snapshots[name] = db.NewSnapshot()
+	}
+	return snapshot, nil
+}
+
+// metadataSnapshot represents a point-in-time view of all stores of backend storage.
+type metadataSnapshot struct {
+	snapshots map[string]unique.Snapshot
+}
+
+// checkpoint writes the snapshot of all stores into given dir.
+func (s *metadataSnapshot) checkpoint(dir string) error {
+	for name, snapshot := range s.snapshots {
+		if err := snapshot.Checkpoint(path.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// release releases the snapshot of all stores.
+func (s *metadataSnapshot) release() {
+	for _, snapshot := range s.snapshots {
+		_ = snapshot.Close()
+	}
+}
+
 // Close closes the backend storage.
 func (mb *metadataBackend) Close() error {
 	var result error
@@ -19,6 +19,7 @@ package metadb
 
 import (
 	"fmt"
+	"path"
 	"strings"
 	"testing"
 
@@ -1000,3 +1001,45 @@ func TestMetadataBackend_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataBackend_snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sequence := unique.NewMockSequence(ctrl)
+	store := unique.NewMockIDStore(ctrl)
+	snapshot := unique.NewMockSnapshot(ctrl)
+	backend := &metadataBackend{
+		sequences: []sequenceItem{{
+			sequence: sequence,
+			store:    store,
+			key:      tagKeyIDSequenceKey,
+		}},
+		dbs: map[string]unique.IDStore{
+			TagKeyDB: store,
+		},
+	}
+	// case 1: save sequence failure
+	sequence.EXPECT().Current().Return(uint32(10))
+	store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	s, err := backend.snapshot()
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// case 2: checkpoint failure
+	sequence.EXPECT().Current().Return(uint32(10)).AnyTimes()
+	store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	store.EXPECT().NewSnapshot().Return(snapshot).AnyTimes()
+	s, err = backend.snapshot()
+	assert.NoError(t, err)
+	snapshot.EXPECT().Checkpoint(path.Join("backup", TagKeyDB)).Return(fmt.Errorf("err"))
+	assert.Error(t, s.checkpoint("backup"))
+	snapshot.EXPECT().Close().Return(nil)
+	s.release()
+	// case 3: checkpoint successfully
+	s, err = backend.snapshot()
+	assert.NoError(t, err)
+	snapshot.EXPECT().Checkpoint(path.Join("backup", TagKeyDB)).Return(nil)
+	assert.NoError(t, s.checkpoint("backup"))
+	snapshot.EXPECT().Close().Return(nil)
+	s.release()
+}
@@ -271,6 +271,20 @@ func (mdb *metadataDatabase) Sync() error {
 	return mdb.backend.sync()
 }
 
+// Checkpoint creates a consistent snapshot of metadata storage into given dir.
+// NOTICE: only takes snapshot under lock, copies snapshot without lock, so it doesn't block generating metadata id.
+func (mdb *metadataDatabase) Checkpoint(dir string) error {
+	mdb.rwMux.Lock()
+	snapshot, err := mdb.backend.snapshot()
+	mdb.rwMux.Unlock()
+	if err != nil {
+		return err
+	}
+	defer snapshot.release()
+
+	return snapshot.checkpoint(dir)
+}
+
 // Close closes the resources
 func (mdb *metadataDatabase) Close() error {
 	mdb.rwMux.Lock()
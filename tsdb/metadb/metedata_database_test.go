@@ -807,6 +807,10 @@ func TestMetadataDatabase_Close_Sync(t *testing.T) {
 	db := newMockMetadataDatabase(t, t.TempDir())
 	assert.NoError(t, db.Close())
 	assert.NoError(t, db.Sync())
+	mockBackend.EXPECT().snapshot().Return(nil, fmt.Errorf("err"))
+	assert.Error(t, db.Checkpoint("backup"))
+	mockBackend.EXPECT().snapshot().Return(&metadataSnapshot{}, nil)
+	assert.NoError(t, db.Checkpoint("backup"))
 }
 
 func newMockMetadataDatabase(t *testing.T, dir string) MetadataDatabase {
@@ -19,12 +19,15 @@ package tsdb
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"sync"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
@@ -45,6 +48,13 @@ type Segment interface {
 	NeedEvict() bool
 	// EvictFamily evicts data family.
 	EvictFamily(familyTime int64)
+	// Backup creates a point-in-time copy of segment's kv store under backup dir.
+	Backup(dir string) error
+	// Compact compacts all level0 files of families which contain the family time(all families if 0),
+	// if rollup is true, does rollup job of source families instead.
+	Compact(familyTime int64, rollup bool)
+	// GetCompactionState returns the compaction state of all families.
+	GetCompactionState() []models.FamilyCompactionState
 	// Close closes segment, include kv store.
 	Close()
 }
@@ -171,6 +181,7 @@ func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	familyOption := kv.FamilyOption{
 		CompactThreshold: 0,
 		Merger:           string(metricsdata.MetricDataMerger),
+		Compression:      config.GlobalStorageConfig().TSDB.Compression,
 	}
 	familyName := strconv.Itoa(familyTime)
 	family := s.kvStore.GetFamily(familyName)
@@ -187,6 +198,37 @@ func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	return dataFamily, nil
 }
 
+// Backup creates a point-in-time copy of segment's kv store under backup dir.
+func (s *segment) Backup(dir string) error {
+	return s.kvStore.Backup(filepath.Join(dir, s.indicator))
+}
+
+// Compact compacts all level0 files of families which contain the family time(all families if 0),
+// if rollup is true, does rollup job of source families instead.
+func (s *segment) Compact(familyTime int64, rollup bool) {
+	calc := s.interval.Calculator()
+	if familyTime > 0 && calc.CalcSegmentTime(familyTime) != s.baseTime {
+		return
+	}
+	if rollup {
+		s.kvStore.ForceRollup()
+		return
+	}
+	for _, familyName := range s.kvStore.ListFamilyNames() {
+		if familyTime > 0 && familyName != strconv.Itoa(calc.CalcFamily(familyTime, s.baseTime)) {
+			continue
+		}
+		if family := s.kvStore.GetFamily(familyName); family != nil {
+			family.ForceCompact()
+		}
+	}
+}
+
+// GetCompactionState returns the compaction state of all families.
+func (s *segment) GetCompactionState() []models.FamilyCompactionState {
+	return getCompactionState(s.kvStore)
+}
+
 // Close closes segment, include kv store.
 func (s *segment) Close() {
 	s.mutex.Lock()
@@ -227,3 +269,26 @@ func (s *segment) initDataFamily(familyTime int, family kv.Family) DataFamily {
 	s.families[familyTime] = dataFamily
 	return dataFamily
 }
+
+// getCompactionState returns the compaction state of all families under kv store.
+func getCompactionState(store kv.Store) []models.FamilyCompactionState {
+	var rs []models.FamilyCompactionState
+	for _, familyName := range store.ListFamilyNames() {
+		family := store.GetFamily(familyName)
+		if family == nil {
+			continue
+		}
+		state := family.GetState()
+		rs = append(rs, models.FamilyCompactionState{
+			Store:            store.Name(),
+			Family:           state.Name,
+			Compacting:       state.Compacting,
+			Rolluping:        state.Rolluping,
+			NumOfL0Files:     state.NumOfL0Files,
+			NumOfFiles:       state.NumOfFiles,
+			NumOfRollupFiles: state.NumOfRollupFiles,
+			Size:             state.Size,
+		})
+	}
+	return rs
+}
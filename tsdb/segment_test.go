@@ -19,6 +19,7 @@ package tsdb
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -358,3 +359,65 @@ func TestSegment_NeedEvict(t *testing.T) {
 	assert.True(t, s.NeedEvict())
 	s.EvictFamily(timeutil.Now())
 }
+
+func TestSegment_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := kv.NewMockStore(ctrl)
+	s := &segment{indicator: "db/shard/1/segment/day/20191012", kvStore: store}
+	store.EXPECT().Backup(filepath.Join("backup", "db/shard/1/segment/day/20191012")).Return(nil)
+	assert.NoError(t, s.Backup("backup"))
+}
+
+func TestSegment_Compact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := kv.NewMockStore(ctrl)
+	baseTime, _ := timeutil.ParseTimestamp("20191012 00:00:00", "20060102 15:04:05")
+	s := &segment{
+		kvStore:  store,
+		baseTime: baseTime,
+		interval: timeutil.Interval(10 * timeutil.OneSecond),
+	}
+	family := kv.NewMockFamily(ctrl)
+	// case 1: family time not in segment
+	otherDay, _ := timeutil.ParseTimestamp("20191013 10:30:00", "20060102 15:04:05")
+	s.Compact(otherDay, false)
+	s.Compact(otherDay, true)
+	// case 2: rollup
+	store.EXPECT().ForceRollup()
+	s.Compact(0, true)
+	// case 3: compact all families
+	store.EXPECT().ListFamilyNames().Return([]string{"10", "11", "12"})
+	store.EXPECT().GetFamily("10").Return(family)
+	store.EXPECT().GetFamily("11").Return(nil)
+	store.EXPECT().GetFamily("12").Return(family)
+	family.EXPECT().ForceCompact().Times(2)
+	s.Compact(0, false)
+	// case 4: compact family by time
+	familyTime, _ := timeutil.ParseTimestamp("20191012 11:30:00", "20060102 15:04:05")
+	store.EXPECT().ListFamilyNames().Return([]string{"10", "11", "12"})
+	store.EXPECT().GetFamily("11").Return(family)
+	family.EXPECT().ForceCompact()
+	s.Compact(familyTime, false)
+}
+
+func TestSegment_GetCompactionState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := kv.NewMockStore(ctrl)
+	s := &segment{kvStore: store}
+	family := kv.NewMockFamily(ctrl)
+	store.EXPECT().Name().Return("db/shard/1/segment/day/20191012").AnyTimes()
+	store.EXPECT().ListFamilyNames().Return([]string{"10", "11"})
+	store.EXPECT().GetFamily("10").Return(nil)
+	store.EXPECT().GetFamily("11").Return(family)
+	family.EXPECT().GetState().Return(kv.FamilyState{Name: "11", Compacting: true, NumOfL0Files: 2, NumOfFiles: 3})
+	assert.Equal(t, []models.FamilyCompactionState{{
+		Store:        "db/shard/1/segment/day/20191012",
+		Family:       "11",
+		Compacting:   true,
+		NumOfL0Files: 2,
+		NumOfFiles:   3,
+	}}, s.GetCompactionState())
+}
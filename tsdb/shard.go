@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	commonconstants "github.com/lindb/common/constants"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
@@ -66,6 +68,12 @@ type Shard interface {
 	BufferManager() memdb.BufferManager
 	// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
 	LookupRowMetricMeta(rows []metric.StorageRow) error
+	// Import imports history metric rows into data families directly, bypasses write-ahead log,
+	// returns the num. of rows written, the rows which lookup metadata/write failure are skipped.
+	// The families which already applied the import id are skipped.
+	Import(importID uint64, rows []metric.StorageRow) (written int, err error)
+	// Merge merges the metadata/index/data of source shard(restored from the snapshot of other node) into current shard.
+	Merge(source Shard) error
 	// FlushIndex flushes index data to disk.
 	FlushIndex() error
 	// WaitFlushIndexCompleted waits flush index job completed.
@@ -76,6 +84,12 @@ type Shard interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// Backup creates a point-in-time copy of shard's data/index under backup dir.
+	Backup(dir string) error
+	// Compact compacts(or rollups) the data families which contain the family time(all families if 0).
+	Compact(familyTime int64, rollup bool) error
+	// GetCompactionState returns the compaction state of shard's data/index.
+	GetCompactionState() []models.FamilyCompactionState
 	// Closer releases shard's resource, such as flush data, spawned goroutines etc.
 	io.Closer
 }
@@ -332,6 +346,104 @@ func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) error {
 	return nil
 }
 
+// Import imports history metric rows into data families directly, bypasses write-ahead log.
+// 1. lookups(creates if not exist) metadata/index of rows, then flushes metadata/index,
+// make sure the ids referenced by data file are persisted;
+// 2. groups rows by family time, builds new data file for each family.
+// Rows which lookup metadata failure are not writable, they are skipped and not counted as written.
+// Families which already applied the import id are skipped, so the retried import request is idempotent.
+func (s *shard) Import(importID uint64, rows []metric.StorageRow) (written int, err error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	if err := s.LookupRowMetricMeta(rows); err != nil {
+		return 0, err
+	}
+	// flushing is skipped if another flush job is running, so waits it completed before/after flushing.
+	s.db.WaitFlushMetaCompleted()
+	if err := s.db.FlushMeta(); err != nil {
+		return 0, err
+	}
+	s.db.WaitFlushMetaCompleted()
+	s.WaitFlushIndexCompleted()
+	if err := s.FlushIndex(); err != nil {
+		return 0, err
+	}
+	s.WaitFlushIndexCompleted()
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Timestamp() < rows[j].Timestamp()
+	})
+	calc := s.interval.Calculator()
+	start := 0
+	for start < len(rows) {
+		familyTime := calc.CalcFamilyTime(rows[start].Timestamp())
+		end := start + 1
+		for end < len(rows) && calc.CalcFamilyTime(rows[end].Timestamp()) == familyTime {
+			end++
+		}
+		family, err := s.GetOrCrateDataFamily(familyTime)
+		if err != nil {
+			return written, err
+		}
+		n, err := family.Import(importID, rows[start:end])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		start = end
+	}
+	return written, nil
+}
+
+// Merge merges the metadata/index/data of source shard(restored from the snapshot of other node) into current shard,
+// the ids of metric/field/series are node local, so data is rewritten with the ids of current shard.
+// 1. builds id mapping(creates metadata/index if not exist), then flushes metadata/index,
+// make sure the ids referenced by data file are persisted;
+// 2. rewrites the data families of all intervals(includes rollup intervals) into current shard.
+func (s *shard) Merge(source Shard) error {
+	mapping, err := buildIDMapping(s, source)
+	if err != nil {
+		return err
+	}
+	// flushing is skipped if another flush job is running, so waits it completed before/after flushing.
+	s.db.WaitFlushMetaCompleted()
+	if err := s.db.FlushMeta(); err != nil {
+		return err
+	}
+	s.db.WaitFlushMetaCompleted()
+	s.WaitFlushIndexCompleted()
+	if err := s.FlushIndex(); err != nil {
+		return err
+	}
+	s.WaitFlushIndexCompleted()
+
+	ahead, _ := s.option.GetAcceptWritableRange()
+	timeRange := timeutil.TimeRange{Start: 0, End: timeutil.Now() + ahead}
+	for interval, segment := range s.rollupTargets {
+		calc := interval.Calculator()
+		for _, sourceFamily := range source.GetDataFamilies(interval.Type(), timeRange) {
+			if sourceFamily.Interval() != interval {
+				continue
+			}
+			familyTime := sourceFamily.TimeRange().Start
+			targetSegment, err := segment.GetOrCreateSegment(calc.GetSegment(familyTime))
+			if err != nil {
+				return err
+			}
+			targetFamily, err := targetSegment.GetOrCreateDataFamily(familyTime)
+			if err != nil {
+				return err
+			}
+			if err := targetFamily.Rewrite(sourceFamily, mapping); err != nil {
+				return err
+			}
+		}
+	}
+	s.logger.Info("merge shard successfully",
+		logger.String("shard", s.indicator), logger.String("source", source.Indicator()))
+	return nil
+}
+
 func (s *shard) Close() error {
 	// finally, cleanup temp buffer.
 	defer s.bufferMgr.Cleanup()
@@ -419,6 +531,48 @@ func (s *shard) EvictSegment() {
 	}
 }
 
+// Backup creates a point-in-time copy of shard's data/index under backup dir,
+// data is copied before index, so that all series of data can be found in index.
+func (s *shard) Backup(dir string) error {
+	for _, segment := range s.rollupTargets {
+		if err := segment.Backup(dir); err != nil {
+			return err
+		}
+	}
+	if err := s.indexStore.Backup(filepath.Join(dir, shardIndexIndicator(s.db.Name(), s.id))); err != nil {
+		return err
+	}
+	return s.indexDB.Checkpoint(filepath.Join(dir, shardIndicator(s.db.Name(), s.id), metaDir))
+}
+
+// Compact compacts(or rollups) the data families which contain the family time(all families if 0),
+// index families also are compacted when compacting all families.
+func (s *shard) Compact(familyTime int64, rollup bool) error {
+	if rollup {
+		// only source interval segment need to rollup
+		return s.segment.Compact(familyTime, true)
+	}
+	for _, segment := range s.rollupTargets {
+		if err := segment.Compact(familyTime, false); err != nil {
+			return err
+		}
+	}
+	if familyTime == 0 {
+		s.forwardFamily.ForceCompact()
+		s.invertedFamily.ForceCompact()
+	}
+	return nil
+}
+
+// GetCompactionState returns the compaction state of shard's data/index.
+func (s *shard) GetCompactionState() []models.FamilyCompactionState {
+	rs := getCompactionState(s.indexStore)
+	for _, segment := range s.rollupTargets {
+		rs = append(rs, segment.GetCompactionState()...)
+	}
+	return rs
+}
+
 // initIndexDatabase initializes the index database
 func (s *shard) initIndexDatabase() error {
 	var err error
@@ -430,7 +584,8 @@ func (s *shard) initIndexDatabase() error {
 		forwardIndexDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagindex.SeriesForwardMerger)})
+			Merger:           string(tagindex.SeriesForwardMerger),
+			Compression:      config.GlobalStorageConfig().TSDB.Compression})
 	if err != nil {
 		return err
 	}
@@ -438,7 +593,8 @@ func (s *shard) initIndexDatabase() error {
 		invertedIndexDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagindex.SeriesInvertedMerger)})
+			Merger:           string(tagindex.SeriesInvertedMerger),
+			Compression:      config.GlobalStorageConfig().TSDB.Compression})
 	if err != nil {
 		return err
 	}
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
@@ -525,6 +527,136 @@ func TestShard_lookupRowMeta(t *testing.T) {
 	}
 }
 
+func TestShard_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metadataDB.EXPECT().GenMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil).AnyTimes()
+	metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(field.ID(1), nil).AnyTimes()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	db.EXPECT().WaitFlushMetaCompleted().AnyTimes()
+	segment := NewMockIntervalSegment(ctrl)
+	seg := NewMockSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	s := &shard{
+		indexDB:        indexDB,
+		db:             db,
+		metadata:       metadata,
+		interval:       timeutil.Interval(10 * 1000), // 10s
+		segment:        segment,
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		statistics:     metrics.NewShardStatistics("data", "1"),
+		logger:         logger.GetLogger("TSDB", "Test"),
+	}
+	now := timeutil.Now()
+	newRows := func() []metric.StorageRow {
+		var rows []metric.StorageRow
+		for _, timestamp := range []int64{now, now - 2*timeutil.OneHour, now} {
+			rows = append(rows, mockBatchRows(&protoMetricsV1.Metric{
+				Name:      "test",
+				Timestamp: timestamp,
+				SimpleFields: []*protoMetricsV1.SimpleField{{
+					Name:  "f1",
+					Value: 1.0,
+					Type:  protoMetricsV1.SimpleFieldType_LAST,
+				}},
+			})...)
+		}
+		return rows
+	}
+	cases := []struct {
+		name    string
+		rows    []metric.StorageRow
+		prepare func()
+		written int
+		wantErr bool
+	}{
+		{
+			name: "empty rows",
+		},
+		{
+			name: "flush meta failure",
+			rows: newRows(),
+			prepare: func() {
+				db.EXPECT().FlushMeta().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush index failure",
+			rows: newRows(),
+			prepare: func() {
+				db.EXPECT().FlushMeta().Return(nil)
+				indexDB.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get family failure",
+			rows: newRows(),
+			prepare: func() {
+				db.EXPECT().FlushMeta().Return(nil)
+				indexDB.EXPECT().Flush().Return(nil)
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "import family failure",
+			rows: newRows(),
+			prepare: func() {
+				db.EXPECT().FlushMeta().Return(nil)
+				indexDB.EXPECT().Flush().Return(nil)
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil)
+				seg.EXPECT().GetOrCreateDataFamily(gomock.Any()).Return(family, nil)
+				family.EXPECT().Import(uint64(1), gomock.Any()).Return(0, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "import successfully",
+			rows: newRows(),
+			prepare: func() {
+				db.EXPECT().FlushMeta().Return(nil)
+				indexDB.EXPECT().Flush().Return(nil)
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil).Times(2)
+				seg.EXPECT().GetOrCreateDataFamily(gomock.Any()).Return(family, nil).Times(2)
+				gomock.InOrder(
+					family.EXPECT().Import(uint64(1), gomock.Any()).DoAndReturn(func(_ uint64, rows []metric.StorageRow) (int, error) {
+						assert.Len(t, rows, 1)
+						assert.True(t, rows[0].Writable)
+						return 1, nil
+					}),
+					family.EXPECT().Import(uint64(1), gomock.Any()).DoAndReturn(func(_ uint64, rows []metric.StorageRow) (int, error) {
+						assert.Len(t, rows, 2)
+						// one row skipped
+						return 1, nil
+					}),
+				)
+			},
+			written: 2,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			written, err := s.Import(1, tt.rows)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.written, written)
+		})
+	}
+}
+
 func TestShard_WaitFlushIndexCompleted(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -611,3 +743,228 @@ func mockBatchRows(m *protoMetricsV1.Metric) []metric.StorageRow {
 	br.UnmarshalRows(buf.Bytes())
 	return br.Rows()
 }
+
+func TestShard_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	segment := NewMockIntervalSegment(ctrl)
+	indexStore := kv.NewMockStore(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		id:         1,
+		db:         db,
+		indexStore: indexStore,
+		indexDB:    indexDB,
+	}
+	// case 1: backup segment failure
+	segment.EXPECT().Backup("backup").Return(fmt.Errorf("err"))
+	assert.Error(t, s.Backup("backup"))
+	// case 2: backup index store failure
+	segment.EXPECT().Backup("backup").Return(nil)
+	indexStore.EXPECT().Backup(filepath.Join("backup", "test", "shard", "1", "index")).Return(fmt.Errorf("err"))
+	assert.Error(t, s.Backup("backup"))
+	// case 3: backup successfully
+	segment.EXPECT().Backup("backup").Return(nil)
+	indexStore.EXPECT().Backup(gomock.Any()).Return(nil)
+	indexDB.EXPECT().Checkpoint(filepath.Join("backup", "test", "shard", "1", "meta")).Return(nil)
+	assert.NoError(t, s.Backup("backup"))
+}
+
+func TestShard_Compact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	segment := NewMockIntervalSegment(ctrl)
+	rollupSegment := NewMockIntervalSegment(ctrl)
+	forwardFamily := kv.NewMockFamily(ctrl)
+	invertedFamily := kv.NewMockFamily(ctrl)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		segment:        segment,
+		forwardFamily:  forwardFamily,
+		invertedFamily: invertedFamily,
+	}
+	// case 1: rollup source segment
+	segment.EXPECT().Compact(int64(10), true).Return(nil)
+	assert.NoError(t, s.Compact(10, true))
+	// case 2: compact segment failure
+	segment.EXPECT().Compact(int64(10), false).Return(fmt.Errorf("err"))
+	assert.Error(t, s.Compact(10, false))
+	// case 3: compact family by time
+	s.rollupTargets[100] = rollupSegment
+	segment.EXPECT().Compact(int64(10), false).Return(nil)
+	rollupSegment.EXPECT().Compact(int64(10), false).Return(nil)
+	assert.NoError(t, s.Compact(10, false))
+	// case 4: compact all data/index families
+	segment.EXPECT().Compact(int64(0), false).Return(nil)
+	rollupSegment.EXPECT().Compact(int64(0), false).Return(nil)
+	forwardFamily.EXPECT().ForceCompact()
+	invertedFamily.EXPECT().ForceCompact()
+	assert.NoError(t, s.Compact(0, false))
+}
+
+func TestShard_GetCompactionState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	segment := NewMockIntervalSegment(ctrl)
+	indexStore := kv.NewMockStore(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		indexStore: indexStore,
+	}
+	indexStore.EXPECT().Name().Return("db/shard/1/index")
+	indexStore.EXPECT().ListFamilyNames().Return([]string{"forward"})
+	indexStore.EXPECT().GetFamily("forward").Return(family)
+	family.EXPECT().GetState().Return(kv.FamilyState{Name: "forward"})
+	segment.EXPECT().GetCompactionState().Return([]models.FamilyCompactionState{{Family: "10"}})
+	assert.Equal(t, []models.FamilyCompactionState{
+		{Store: "db/shard/1/index", Family: "forward"},
+		{Family: "10"},
+	}, s.GetCompactionState())
+}
+
+func TestShard_Merge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	db.EXPECT().WaitFlushMetaCompleted().AnyTimes()
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	source := NewMockShard(ctrl)
+	source.EXPECT().Indicator().Return("source/shard/1").AnyTimes()
+	sourceDB := NewMockDatabase(ctrl)
+	source.EXPECT().Database().Return(sourceDB).AnyTimes()
+	sourceMetadata := metadb.NewMockMetadata(ctrl)
+	sourceMetadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	sourceDB.EXPECT().Metadata().Return(sourceMetadata).AnyTimes()
+	sourceMetadata.EXPECT().MetadataDatabase().Return(sourceMetadataDB).AnyTimes()
+	segment := NewMockIntervalSegment(ctrl)
+	seg := NewMockSegment(ctrl)
+	sourceFamily := NewMockDataFamily(ctrl)
+	targetFamily := NewMockDataFamily(ctrl)
+	interval := timeutil.Interval(10 * 1000) // 10s
+	now := timeutil.Now()
+	s := &shard{
+		indexDB:        indexDB,
+		db:             db,
+		metadata:       metadata,
+		option:         &option.DatabaseOption{},
+		interval:       interval,
+		segment:        segment,
+		rollupTargets:  map[timeutil.Interval]IntervalSegment{interval: segment},
+		indicator:      "test/shard/1",
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		statistics:     metrics.NewShardStatistics("data", "1"),
+		logger:         logger.GetLogger("TSDB", "Test"),
+	}
+	mockFamilies := func() {
+		sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return(nil, nil)
+		db.EXPECT().FlushMeta().Return(nil)
+		indexDB.EXPECT().Flush().Return(nil)
+		source.EXPECT().GetDataFamilies(interval.Type(), gomock.Any()).Return([]DataFamily{sourceFamily})
+		sourceFamily.EXPECT().Interval().Return(interval)
+		sourceFamily.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: now, End: now + timeutil.OneHour})
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "build id mapping failure",
+			prepare: func() {
+				sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush meta failure",
+			prepare: func() {
+				sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return(nil, nil)
+				db.EXPECT().FlushMeta().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush index failure",
+			prepare: func() {
+				sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return(nil, nil)
+				db.EXPECT().FlushMeta().Return(nil)
+				indexDB.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get segment failure",
+			prepare: func() {
+				mockFamilies()
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get family failure",
+			prepare: func() {
+				mockFamilies()
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil)
+				seg.EXPECT().GetOrCreateDataFamily(now).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "rewrite family failure",
+			prepare: func() {
+				mockFamilies()
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil)
+				seg.EXPECT().GetOrCreateDataFamily(now).Return(targetFamily, nil)
+				targetFamily.EXPECT().Rewrite(sourceFamily, gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "skip family of other interval",
+			prepare: func() {
+				sourceMetadataDB.EXPECT().SuggestNamespace(gomock.Any(), gomock.Any()).Return(nil, nil)
+				db.EXPECT().FlushMeta().Return(nil)
+				indexDB.EXPECT().Flush().Return(nil)
+				source.EXPECT().GetDataFamilies(interval.Type(), gomock.Any()).Return([]DataFamily{sourceFamily})
+				sourceFamily.EXPECT().Interval().Return(timeutil.Interval(30 * 1000))
+			},
+		},
+		{
+			name: "merge successfully",
+			prepare: func() {
+				mockFamilies()
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil)
+				seg.EXPECT().GetOrCreateDataFamily(now).Return(targetFamily, nil)
+				targetFamily.EXPECT().Rewrite(sourceFamily, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			if err := s.Merge(source); (err != nil) != tt.wantErr {
+				t.Errorf("Merge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
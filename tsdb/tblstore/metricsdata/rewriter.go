@@ -0,0 +1,126 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"sort"
+
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/series/field"
+)
+
+//go:generate mockgen -source ./rewriter.go -destination=./rewriter_mock.go -package metricsdata
+
+// IDMapping represents the mapping of node local ids(metric/field/series),
+// which maps the ids in data file copied from other node to the ids of current node.
+type IDMapping interface {
+	// GetMetricID returns the metric id of current node, returns false if not found.
+	GetMetricID(metricID uint32) (uint32, bool)
+	// GetFieldID returns the field id of current node, returns false if not found.
+	GetFieldID(metricID uint32, fieldID field.ID) (field.ID, bool)
+	// GetSeriesID returns the series id of current node, returns false if not found.
+	GetSeriesID(metricID uint32, seriesID uint32) (uint32, bool)
+}
+
+// fieldMapping represents the field id mapping of metric block.
+type fieldMapping struct {
+	source field.ID
+	target field.Meta
+}
+
+// rewriteSeries represents the field data of series with the series id of current node.
+type rewriteSeries struct {
+	seriesID uint32
+	fields   [][]byte
+}
+
+// Rewrite rewrites the metric block copied from other node with the ids of current node, then writes it by flusher.
+// Metric/field/series which cannot be mapped are dropped, field data is written as is,
+// because the time slot range of metric block isn't changed.
+func Rewrite(flusher Flusher, metricID uint32, metricBlock []byte, mapping IDMapping) error {
+	targetMetricID, ok := mapping.GetMetricID(metricID)
+	if !ok {
+		return nil
+	}
+	reader, err := NewReader("rewrite_operation", metricBlock)
+	if err != nil {
+		return err
+	}
+	var fields []fieldMapping
+	for _, f := range reader.GetFields() {
+		if targetFieldID, ok := mapping.GetFieldID(metricID, f.ID); ok {
+			fields = append(fields, fieldMapping{source: f.ID, target: field.Meta{ID: targetFieldID, Type: f.Type}})
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	// field data must be written in order by field id
+	sort.Slice(fields, func(i, j int) bool { return fields[i].target.ID < fields[j].target.ID })
+	targetFields := make(field.Metas, len(fields))
+	for idx := range fields {
+		targetFields[idx] = fields[idx].target
+	}
+
+	scanner, err := newDataScanner(reader)
+	if err != nil {
+		return err
+	}
+	slotRange := reader.GetTimeRange()
+	var (
+		fieldReader FieldReader
+		series      []rewriteSeries
+	)
+	// scanner only can scan forward, so scans all series in order, then sorts them by series id of current node
+	it := reader.GetSeriesIDs().Iterator()
+	for it.HasNext() {
+		seriesID := it.Next()
+		seriesEntry := scanner.scan(encoding.HighBits(seriesID), encoding.LowBits(seriesID))
+		targetSeriesID, ok := mapping.GetSeriesID(metricID, seriesID)
+		if !ok || len(seriesEntry) == 0 {
+			continue
+		}
+		if fieldReader == nil {
+			fieldReader = newFieldReader(scanner.fieldIndexes(), seriesEntry, slotRange)
+		} else {
+			fieldReader.Reset(seriesEntry, slotRange)
+		}
+		s := rewriteSeries{seriesID: targetSeriesID, fields: make([][]byte, len(fields))}
+		for idx := range fields {
+			s.fields[idx] = fieldReader.GetFieldData(fields[idx].source)
+		}
+		series = append(series, s)
+	}
+	if len(series) == 0 {
+		return nil
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].seriesID < series[j].seriesID })
+
+	flusher.PrepareMetric(targetMetricID, targetFields)
+	for idx := range series {
+		for _, data := range series[idx].fields {
+			if err := flusher.FlushField(data); err != nil {
+				return err
+			}
+		}
+		if err := flusher.FlushSeries(series[idx].seriesID); err != nil {
+			return err
+		}
+	}
+	return flusher.CommitMetric(slotRange)
+}
@@ -0,0 +1,140 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+)
+
+type mockIDMapping struct {
+	metricIDs map[uint32]uint32
+	fieldIDs  map[field.ID]field.ID
+	seriesIDs map[uint32]uint32
+}
+
+func (m *mockIDMapping) GetMetricID(metricID uint32) (uint32, bool) {
+	id, ok := m.metricIDs[metricID]
+	return id, ok
+}
+
+func (m *mockIDMapping) GetFieldID(_ uint32, fieldID field.ID) (field.ID, bool) {
+	id, ok := m.fieldIDs[fieldID]
+	return id, ok
+}
+
+func (m *mockIDMapping) GetSeriesID(_ uint32, seriesID uint32) (uint32, bool) {
+	id, ok := m.seriesIDs[seriesID]
+	return id, ok
+}
+
+func TestRewrite(t *testing.T) {
+	block := mockRealMetricBlock([]uint32{1, 2, 4, 65536 + 1}, 5, 10)
+	mapping := &mockIDMapping{
+		metricIDs: map[uint32]uint32{10: 20},
+		fieldIDs:  map[field.ID]field.ID{2: 5, 10: 3},
+		seriesIDs: map[uint32]uint32{1: 100, 2: 50, 65536 + 1: 3},
+	}
+	nopFlusher := kv.NewNopFlusher()
+	flusher, err := NewFlusher(nopFlusher)
+	assert.NoError(t, err)
+	assert.NoError(t, Rewrite(flusher, 10, block, mapping))
+
+	r, err := NewReader("test", nopFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, field.Metas{{ID: 3, Type: field.MinField}, {ID: 5, Type: field.SumField}}, r.GetFields())
+	assert.Equal(t, []uint32{3, 50, 100}, r.GetSeriesIDs().ToArray())
+	assert.Equal(t, timeutil.SlotRange{Start: 5, End: 10}, r.GetTimeRange())
+
+	source, err := NewReader("source", block)
+	assert.NoError(t, err)
+	sourceScanner, err := newDataScanner(source)
+	assert.NoError(t, err)
+	targetScanner, err := newDataScanner(r)
+	assert.NoError(t, err)
+	sourceData := newFieldReader(sourceScanner.fieldIndexes(), sourceScanner.scan(0, 2), source.GetTimeRange())
+	targetData := newFieldReader(targetScanner.fieldIndexes(), targetScanner.scan(0, 50), r.GetTimeRange())
+	assert.Equal(t, sourceData.GetFieldData(2), targetData.GetFieldData(5))
+	assert.Equal(t, sourceData.GetFieldData(10), targetData.GetFieldData(3))
+}
+
+func TestRewrite_Drop(t *testing.T) {
+	block := mockRealMetricBlock([]uint32{1, 2}, 5, 10)
+	cases := []struct {
+		name    string
+		mapping *mockIDMapping
+	}{
+		{
+			name:    "metric not found",
+			mapping: &mockIDMapping{},
+		},
+		{
+			name:    "fields not found",
+			mapping: &mockIDMapping{metricIDs: map[uint32]uint32{10: 20}},
+		},
+		{
+			name: "series not found",
+			mapping: &mockIDMapping{
+				metricIDs: map[uint32]uint32{10: 20},
+				fieldIDs:  map[field.ID]field.ID{2: 5},
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			// nothing written
+			flusher := NewMockFlusher(ctrl)
+			assert.NoError(t, Rewrite(flusher, 10, block, tt.mapping))
+		})
+	}
+}
+
+func TestRewrite_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mapping := &mockIDMapping{
+		metricIDs: map[uint32]uint32{10: 20},
+		fieldIDs:  map[field.ID]field.ID{2: 5},
+		seriesIDs: map[uint32]uint32{1: 100},
+	}
+	// bad block
+	assert.Error(t, Rewrite(NewMockFlusher(ctrl), 10, []byte{1, 2, 3}, mapping))
+
+	block := mockRealMetricBlock([]uint32{1}, 5, 10)
+	flusher := NewMockFlusher(ctrl)
+	flusher.EXPECT().PrepareMetric(uint32(20), field.Metas{{ID: 5, Type: field.SumField}}).AnyTimes()
+	flusher.EXPECT().FlushField(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, Rewrite(flusher, 10, block, mapping))
+	flusher.EXPECT().FlushField(gomock.Any()).Return(nil).AnyTimes()
+	flusher.EXPECT().FlushSeries(uint32(100)).Return(fmt.Errorf("err"))
+	assert.Error(t, Rewrite(flusher, 10, block, mapping))
+	flusher.EXPECT().FlushSeries(uint32(100)).Return(nil)
+	flusher.EXPECT().CommitMetric(timeutil.SlotRange{Start: 5, End: 10}).Return(nil)
+	assert.NoError(t, Rewrite(flusher, 10, block, mapping))
+}
@@ -130,6 +130,9 @@ const local = {
     linQL: "LinQL",
     database: "Database",
     broker: "Broker",
+    queueWait: "Queue Wait",
+    queued: "Queued",
+    class: "Class",
     runLinQL: "Run Lin Query language",
   },
   MetadataExploreView: {
@@ -130,6 +130,9 @@ const local = {
     linQL: "LinQL",
     database: "数据库",
     broker: "执行计算节点",
+    queueWait: "排队耗时",
+    queued: "排队中",
+    class: "查询类别",
     runLinQL: "执行 LinQL",
   },
   MetadataExploreView: {
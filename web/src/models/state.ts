@@ -37,6 +37,9 @@ export type Request = {
   db: string;
   sql: string;
   start: number;
+  class?: string;
+  queued?: boolean;
+  queueWait: number;
 };
 
 export enum ShardStateType {
@@ -69,6 +69,18 @@ const RequestView: React.FC = () => {
         );
       },
     },
+    {
+      title: RequestView.queueWait,
+      dataIndex: "queueWait",
+      render: (queueWait: number, record: Request) => {
+        const wait = FormatKit.format(queueWait / 1000000, Unit.Milliseconds);
+        return record.queued ? `${wait} (${RequestView.queued})` : wait;
+      },
+    },
+    {
+      title: RequestView.class,
+      dataIndex: "class",
+    },
     {
       title: RequestView.linQL,
       dataIndex: "sql",